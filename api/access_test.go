@@ -0,0 +1,623 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/Shoaibashk/SerialLink/config"
+	"github.com/Shoaibashk/SerialLink/internal/acl"
+	"github.com/Shoaibashk/SerialLink/internal/auth"
+	"github.com/Shoaibashk/SerialLink/internal/console"
+	"github.com/Shoaibashk/SerialLink/internal/debug"
+	"github.com/Shoaibashk/SerialLink/internal/reservation"
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/Shoaibashk/SerialLink/internal/writepolicy"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// newACLServer returns a service whose access policy grants every port to
+// token:alice and token:root and nothing to anyone else
+func newACLServer(t *testing.T, cfg *config.Config) *SerialServer {
+	t.Helper()
+	m := serial.NewManager(false, serial.DefaultConfig())
+	t.Cleanup(m.CloseAll)
+	scanner, err := serial.NewScanner(nil, m)
+	if err != nil {
+		t.Fatalf("scanner: %v", err)
+	}
+	if cfg == nil {
+		cfg = config.DefaultConfig()
+	}
+	s := NewSerialServer(m, scanner, cfg, log.New(io.Discard))
+	s.SetAccessPolicy(acl.New([]acl.Rule{{
+		Clients: []string{"token:alice", "token:root"},
+		Ports:   []*regexp.Regexp{regexp.MustCompile(`.*`)},
+	}}))
+	return s
+}
+
+// as returns a context authenticated as identity
+func as(identity string) context.Context {
+	return context.WithValue(context.Background(), identityKey{}, auth.Identity{Name: identity})
+}
+
+// openLoopback opens a tcp:// port to a loopback listener as ctx's caller
+// and returns its name and session ID
+func openLoopback(t *testing.T, s *SerialServer, ctx context.Context) (portName, sessionID string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			t.Cleanup(func() { conn.Close() })
+		}
+	}()
+
+	portName = "tcp://" + listener.Addr().String()
+	resp, err := s.OpenPort(ctx, &pb.OpenPortRequest{
+		PortName: portName,
+		Config:   &pb.PortConfig{BaudRate: 9600, DataBits: pb.DataBits_DATA_BITS_8, StopBits: pb.StopBits_STOP_BITS_1},
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("open %s: %v %v", portName, err, resp.GetMessage())
+	}
+	return portName, resp.SessionId
+}
+
+// testStream is a server stream of a call made under ctx that drops what
+// is sent
+type testStream[T any] struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s testStream[T]) Context() context.Context { return s.ctx }
+func (s testStream[T]) Send(*T) error            { return nil }
+
+// asToken returns a context authenticated as an access token named name
+// limited to port
+func asToken(name, port string) context.Context {
+	return context.WithValue(context.Background(), identityKey{}, auth.Identity{Name: name, Scope: &auth.Scope{Port: port}})
+}
+
+// withClientID adds the client ID metadata a client sends to ctx
+func withClientID(ctx context.Context, clientID string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(clientIDMetadataKey, clientID))
+}
+
+// requireDenied fails unless err is a PermissionDenied status
+func requireDenied(t *testing.T, err error) {
+	t.Helper()
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("error %v is not PermissionDenied", err)
+	}
+}
+
+func TestLeakedSessionIDGrantsNothing(t *testing.T) {
+	s := newACLServer(t, nil)
+	port, session := openLoopback(t, s, as("token:alice"))
+	mallory := as("token:mallory")
+
+	calls := []struct {
+		name string
+		call func() error
+	}{
+		{"ClosePort", func() error {
+			_, err := s.ClosePort(mallory, &pb.ClosePortRequest{SessionId: session})
+			return err
+		}},
+		{"ConfigurePort", func() error {
+			_, err := s.ConfigurePort(mallory, &pb.ConfigurePortRequest{PortName: port, SessionId: session, Config: &pb.PortConfig{BaudRate: 115200}})
+			return err
+		}},
+		{"Write", func() error {
+			_, err := s.Write(mallory, &pb.WriteRequest{PortName: port, SessionId: session, Data: []byte("AT\r")})
+			return err
+		}},
+		{"Read", func() error {
+			_, err := s.Read(mallory, &pb.ReadRequest{PortName: port, SessionId: session})
+			return err
+		}},
+		{"SetPowerState", func() error {
+			_, err := s.SetPowerState(mallory, &pb.SetPowerStateRequest{PortName: port, SessionId: session, State: pb.PowerState_POWER_STATE_DORMANT})
+			return err
+		}},
+		{"SetShaping", func() error {
+			_, err := s.SetShaping(mallory, &pb.SetShapingRequest{PortName: port, SessionId: session})
+			return err
+		}},
+		{"GetRecentErrors", func() error {
+			_, err := s.GetRecentErrors(mallory, &pb.GetRecentErrorsRequest{PortName: port, SessionId: session})
+			return err
+		}},
+		{"DiagnoseLine", func() error {
+			_, err := s.DiagnoseLine(mallory, &pb.DiagnoseLineRequest{PortName: port, SessionId: session})
+			return err
+		}},
+		{"ReadMeter", func() error {
+			_, err := s.ReadMeter(mallory, &pb.ReadMeterRequest{PortName: port, SessionId: session})
+			return err
+		}},
+		{"SendSMS", func() error {
+			_, err := s.SendSMS(mallory, &pb.SendSMSRequest{PortName: port, SessionId: session, Number: "+4917612345678", Text: "hi"})
+			return err
+		}},
+		{"PrintText", func() error {
+			_, err := s.PrintText(mallory, &pb.PrintTextRequest{PortName: port, SessionId: session, Text: "hi"})
+			return err
+		}},
+		{"StartGcodeJob", func() error {
+			_, err := s.StartGcodeJob(mallory, &pb.StartGcodeJobRequest{PortName: port, SessionId: session, Program: []byte("G28\n")})
+			return err
+		}},
+		{"ConnectMachine", func() error {
+			_, err := s.ConnectMachine(mallory, &pb.ConnectMachineRequest{PortName: port, SessionId: session})
+			return err
+		}},
+		{"BridgePorts", func() error {
+			end := &pb.BridgeEndpoint{PortName: port, SessionId: session}
+			_, err := s.BridgePorts(mallory, &pb.BridgePortsRequest{Name: "tap", A: end, B: end})
+			return err
+		}},
+		{"StopBridge", func() error {
+			_, err := s.StopBridge(mallory, &pb.StopBridgeRequest{Name: "tap", SessionId: session})
+			return err
+		}},
+		{"StreamTimedRead", func() error {
+			return s.StreamTimedRead(&pb.StreamTimedReadRequest{PortName: port, SessionId: session},
+				testStream[pb.StreamTimedReadResponse]{ctx: mallory})
+		}},
+		{"StreamAnnotated", func() error {
+			return s.StreamAnnotated(&pb.StreamAnnotatedRequest{PortName: port, SessionId: session},
+				testStream[pb.StreamAnnotatedResponse]{ctx: mallory})
+		}},
+		{"Paste", func() error {
+			return s.Paste(&pb.PasteRequest{PortName: port, SessionId: session, Data: []byte("AT\r")},
+				testStream[pb.PasteResponse]{ctx: mallory})
+		}},
+	}
+	for _, tc := range calls {
+		t.Run(tc.name, func(t *testing.T) {
+			requireDenied(t, tc.call())
+		})
+	}
+
+	t.Run("REST configure", func(t *testing.T) {
+		rest := NewRESTServer(s, log.New(io.Discard))
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"session_id":"`+session+`","baud_rate":115200}`))
+		r = r.WithContext(mallory)
+		r.SetPathValue("name", port)
+		w := httptest.NewRecorder()
+		rest.handleConfigure(w, r)
+		if w.Code != http.StatusForbidden {
+			t.Fatalf("status %d, want %d: %s", w.Code, http.StatusForbidden, w.Body)
+		}
+	})
+
+	if s.manager.GetSession(port) == nil {
+		t.Fatal("the owner's session was closed")
+	}
+	resp, err := s.ConfigurePort(as("token:alice"), &pb.ConfigurePortRequest{PortName: port, SessionId: session, Config: &pb.PortConfig{
+		BaudRate: 115200, DataBits: pb.DataBits_DATA_BITS_8, StopBits: pb.StopBits_STOP_BITS_1,
+	}})
+	if err != nil || !resp.Success {
+		t.Fatalf("owner's configure: %v %v", err, resp.GetMessage())
+	}
+}
+
+func TestEventStreamShowsSessionIDToOwner(t *testing.T) {
+	s := newACLServer(t, nil)
+	port, session := openLoopback(t, s, as("token:alice"))
+	events := NewHTTPServer(s.manager, log.New(io.Discard))
+	events.SetAdmins([]string{"token:root"})
+
+	for _, tc := range []struct {
+		identity string
+		shown    bool
+	}{
+		{"token:alice", true},
+		{"token:root", true},
+		{"token:bob", false},
+	} {
+		t.Run(tc.identity, func(t *testing.T) {
+			// A cancelled request ends the stream after its status event
+			ctx, cancel := context.WithCancel(as(tc.identity))
+			cancel()
+			r := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+			r.SetPathValue("name", port)
+			w := httptest.NewRecorder()
+			events.handlePortEvents(w, r)
+
+			if shown := strings.Contains(w.Body.String(), session); shown != tc.shown {
+				t.Fatalf("session ID shown %v, want %v: %s", shown, tc.shown, w.Body)
+			}
+		})
+	}
+}
+
+func TestReservationsFollowAccess(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Auth.Admins = []string{"token:root"}
+	s := newACLServer(t, cfg)
+	book, err := reservation.Open(reservation.Options{}, log.New(io.Discard))
+	if err != nil {
+		t.Fatalf("reservations: %v", err)
+	}
+	s.SetReservationBook(book)
+	events := NewHTTPServer(s.manager, log.New(io.Discard))
+	events.SetReservationBook(book)
+	events.SetAccessPolicy(s.access, false)
+
+	start := time.Now().Add(time.Hour)
+	for _, tc := range []struct {
+		caller, port, holder string
+		code                 codes.Code
+	}{
+		{"token:mallory", "/dev/ttyUSB0", "", codes.PermissionDenied},
+		{"token:alice", "/dev/ttyUSB1", "", codes.OK},
+		{"token:alice", "/dev/ttyUSB2", "token:bob", codes.PermissionDenied},
+		{"token:root", "/dev/ttyUSB3", "token:bob", codes.OK},
+	} {
+		t.Run(tc.caller+" "+tc.port, func(t *testing.T) {
+			_, err := s.CreateReservation(as(tc.caller), &pb.CreateReservationRequest{
+				PortName:  tc.port,
+				Holder:    tc.holder,
+				StartTime: start.UnixNano(),
+				EndTime:   start.Add(time.Hour).UnixNano(),
+			})
+			if status.Code(err) != tc.code {
+				t.Fatalf("error %v, want %v", err, tc.code)
+			}
+		})
+	}
+
+	for caller, want := range map[string]int{"token:alice": 2, "token:mallory": 0} {
+		resp, err := s.ListReservations(as(caller), &pb.ListReservationsRequest{})
+		if err != nil {
+			t.Fatalf("list as %s: %v", caller, err)
+		}
+		if len(resp.Reservations) != want {
+			t.Fatalf("%s sees %d reservations, want %d", caller, len(resp.Reservations), want)
+		}
+
+		r := httptest.NewRequest(http.MethodGet, "/v1/reservations.ics", nil).WithContext(as(caller))
+		w := httptest.NewRecorder()
+		events.handleReservationsICS(w, r)
+		if got := strings.Count(w.Body.String(), "BEGIN:VEVENT"); got != want {
+			t.Fatalf("%s sees %d calendar events, want %d", caller, got, want)
+		}
+	}
+}
+
+func TestRecordingsFollowAccess(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Auth.Admins = []string{"token:root"}
+	s := newACLServer(t, cfg)
+	dir := t.TempDir()
+	s.SetRecordingOptions(console.RecordingOptions{Directory: dir})
+
+	ids := make(map[string]string)
+	for _, user := range []string{"token:alice", "token:bob"} {
+		path := console.RecordingPath(dir, user, "/dev/ttyUSB0", user+"-session")
+		recorder, err := console.NewRecorder(path, user, "/dev/ttyUSB0", user+"-session", 80, 24)
+		if err != nil {
+			t.Fatalf("record: %v", err)
+		}
+		recorder.Output([]byte("login: "))
+		if err := recorder.Close(); err != nil {
+			t.Fatalf("close recording: %v", err)
+		}
+		id, _ := filepath.Rel(dir, path)
+		ids[user] = filepath.ToSlash(id)
+	}
+	policy := s.access
+
+	for _, tc := range []struct {
+		name   string
+		policy *acl.Policy
+		caller string
+		sees   []string
+	}{
+		{"own only", policy, "token:bob", []string{"token:bob"}},
+		{"allowed port", policy, "token:alice", []string{"token:alice", "token:bob"}},
+		{"no policy", nil, "token:alice", []string{"token:alice"}},
+		{"admin", nil, "token:root", []string{"token:alice", "token:bob"}},
+		{"stranger", nil, "token:mallory", nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s.SetAccessPolicy(tc.policy)
+			resp, err := s.ListRecordings(as(tc.caller), &pb.ListRecordingsRequest{})
+			if err != nil {
+				t.Fatalf("list: %v", err)
+			}
+			var users []string
+			for _, r := range resp.Recordings {
+				users = append(users, r.User)
+			}
+			slices.Sort(users)
+			if !slices.Equal(users, tc.sees) {
+				t.Fatalf("sees recordings of %v, want %v", users, tc.sees)
+			}
+
+			for user, id := range ids {
+				err := s.FetchRecording(&pb.FetchRecordingRequest{Id: id}, testStream[pb.FetchRecordingResponse]{ctx: as(tc.caller)})
+				if want := slices.Contains(tc.sees, user); (err == nil) != want || (err != nil && status.Code(err) != codes.NotFound) {
+					t.Fatalf("fetch of %s's recording: %v", user, err)
+				}
+			}
+		})
+	}
+}
+
+func TestResetTargetNeedsThePort(t *testing.T) {
+	s := newACLServer(t, nil)
+	port, _ := openLoopback(t, s, as("token:alice"))
+	s.config.GPIO.ResetLines = []config.ResetLineConfig{{Port: port, Line: 17}}
+
+	// mallory may not use the port; root may, but alice has it open
+	for _, caller := range []string{"token:mallory", "token:root"} {
+		_, err := s.ResetTarget(as(caller), &pb.ResetTargetRequest{PortName: port})
+		requireDenied(t, err)
+	}
+}
+
+func TestRecentOutputFollowsAccess(t *testing.T) {
+	s := newACLServer(t, nil)
+	_, err := s.GetRecentOutput(as("token:mallory"), &pb.GetRecentOutputRequest{PortName: "/dev/ttyUSB0"})
+	requireDenied(t, err)
+	// Allowed callers get as far as the console configuration
+	_, err = s.GetRecentOutput(as("token:alice"), &pb.GetRecentOutputRequest{PortName: "/dev/ttyUSB0"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("error %v is not NotFound", err)
+	}
+}
+
+func TestCheckAccess(t *testing.T) {
+	s := newACLServer(t, nil)
+
+	for _, tc := range []struct {
+		name     string
+		ctx      context.Context
+		port     string
+		clientID string
+		allowed  bool
+	}{
+		{"allowed identity", as("token:alice"), "/dev/ttyUSB0", "", true},
+		{"denied identity", as("token:mallory"), "/dev/ttyUSB0", "", false},
+		{"denied identity sending an allowed client ID", as("token:mallory"), "/dev/ttyUSB0", "token:alice", false},
+		{"token on its port", asToken("access:dash", "/dev/ttyUSB0"), "/dev/ttyUSB0", "", true},
+		{"token on another port", asToken("access:dash", "/dev/ttyUSB0"), "/dev/ttyUSB1", "", false},
+		{"token named like an allowed identity", asToken("token:alice", "/dev/ttyUSB0"), "/dev/ttyUSB1", "", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := s.checkAccess(tc.ctx, tc.port, tc.clientID)
+			if tc.allowed {
+				if err != nil {
+					t.Fatalf("refused: %v", err)
+				}
+				return
+			}
+			requireDenied(t, err)
+		})
+	}
+
+	// Refusals happen before the port is touched
+	_, err := s.OpenPort(as("token:mallory"), &pb.OpenPortRequest{PortName: "/dev/ttyUSB0", ClientId: "token:alice"})
+	requireDenied(t, err)
+}
+
+func TestSessionIDsShownToOwnerAndAdmins(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Auth.Admins = []string{"token:root"}
+	s := newACLServer(t, cfg)
+	port, session := openLoopback(t, s, as("token:alice"))
+
+	for _, tc := range []struct {
+		name  string
+		ctx   context.Context
+		shown bool
+	}{
+		{"owner", as("token:alice"), true},
+		{"admin", as("token:root"), true},
+		{"token named like an admin", asToken("token:root", port), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			status, err := s.GetPortStatus(tc.ctx, &pb.GetPortStatusRequest{PortName: port})
+			if err != nil {
+				t.Fatalf("status: %v", err)
+			}
+			sessions, err := s.ListSessions(tc.ctx, &pb.ListSessionsRequest{})
+			if err != nil {
+				t.Fatalf("sessions: %v", err)
+			}
+			if len(sessions.Sessions) != 1 {
+				t.Fatalf("%d sessions listed, want 1", len(sessions.Sessions))
+			}
+			want := ""
+			if tc.shown {
+				want = session
+			}
+			for _, id := range []string{status.Status.SessionId, sessions.Sessions[0].SessionId} {
+				if id != want {
+					t.Fatalf("session ID %q, want %q", id, want)
+				}
+			}
+		})
+	}
+
+	// Callers the policy refuses do not see the port at all
+	sessions, err := s.ListSessions(as("token:mallory"), &pb.ListSessionsRequest{})
+	if err != nil || len(sessions.Sessions) != 0 {
+		t.Fatalf("mallory lists %v (%v)", sessions.GetSessions(), err)
+	}
+}
+
+func TestAdminOnlyCalls(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Auth.Admins = []string{"token:root", "group:oncall"}
+	cfg.Debug.AllowToggle = true
+	s := newACLServer(t, cfg)
+	s.SetDebugServer(debug.New("127.0.0.1:0", s.manager, log.New(io.Discard)))
+	oncall := context.WithValue(context.Background(), identityKey{}, auth.Identity{Name: "token:carol", Groups: []string{"oncall"}})
+
+	calls := map[string]func(ctx context.Context) error{
+		"ForceClose": func(ctx context.Context) error {
+			_, err := s.ForceClose(ctx, &pb.ForceCloseRequest{PortName: "/dev/ttyUSB0"})
+			return err
+		},
+		"SetDebugEndpoints": func(ctx context.Context) error {
+			_, err := s.SetDebugEndpoints(ctx, &pb.SetDebugEndpointsRequest{Enabled: false})
+			return err
+		},
+	}
+	for name, call := range calls {
+		for _, tc := range []struct {
+			caller string
+			ctx    context.Context
+			admin  bool
+		}{
+			{"admin", as("token:root"), true},
+			{"admin group", oncall, true},
+			{"port user", as("token:alice"), false},
+			{"token named like an admin", asToken("token:root", "/dev/ttyUSB0"), false},
+		} {
+			t.Run(name+" by "+tc.caller, func(t *testing.T) {
+				err := call(tc.ctx)
+				if tc.admin {
+					if err != nil {
+						t.Fatalf("refused: %v", err)
+					}
+					return
+				}
+				requireDenied(t, err)
+			})
+		}
+	}
+}
+
+func TestReservationHeldByIdentityOnly(t *testing.T) {
+	s := newACLServer(t, nil)
+	book, err := reservation.Open(reservation.Options{}, log.New(io.Discard))
+	if err != nil {
+		t.Fatalf("reservations: %v", err)
+	}
+	s.SetReservationBook(book)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	port := "tcp://" + listener.Addr().String()
+	r, err := s.CreateReservation(as("token:alice"), &pb.CreateReservationRequest{
+		PortName:  port,
+		StartTime: time.Now().UnixNano(),
+		EndTime:   time.Now().Add(time.Hour).UnixNano(),
+	})
+	if err != nil {
+		t.Fatalf("reserve: %v", err)
+	}
+
+	// root may use the port, but sending the holder's name as its client
+	// ID neither opens the port nor cancels the reservation
+	_, err = s.OpenPort(as("token:root"), &pb.OpenPortRequest{PortName: port, ClientId: "token:alice"})
+	requireDenied(t, err)
+	_, err = s.CancelReservation(withClientID(as("token:root"), "token:alice"), &pb.CancelReservationRequest{ReservationId: r.Reservation.ReservationId})
+	requireDenied(t, err)
+
+	go func() {
+		if conn, err := listener.Accept(); err == nil {
+			t.Cleanup(func() { conn.Close() })
+		}
+	}()
+	resp, err := s.OpenPort(as("token:alice"), &pb.OpenPortRequest{
+		PortName: port,
+		Config:   &pb.PortConfig{BaudRate: 9600, DataBits: pb.DataBits_DATA_BITS_8, StopBits: pb.StopBits_STOP_BITS_1},
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("holder's open: %v %v", err, resp.GetMessage())
+	}
+}
+
+func TestWritePolicyApprovals(t *testing.T) {
+	s := newACLServer(t, nil)
+	port, session := openLoopback(t, s, as("token:alice"))
+	s.SetWriteGuard(writepolicy.NewGuard([]writepolicy.Policy{{
+		Port:            port,
+		Deny:            []*regexp.Regexp{regexp.MustCompile(`^REBOOT$`)},
+		RequireApproval: []*regexp.Regexp{regexp.MustCompile(`^FLASH`)},
+		Approvers:       []string{"token:root"},
+	}}, log.New(io.Discard)))
+
+	_, err := s.Write(as("token:alice"), &pb.WriteRequest{PortName: port, SessionId: session, Data: []byte("REBOOT\r\n")})
+	requireDenied(t, err)
+
+	// Payloads needing approval are refused wherever they are written from
+	for _, data := range []string{"REBOOT\r\n", "FLASH 0x0800\r\n"} {
+		if _, err := s.manager.Write(port, session, []byte(data)); !errors.Is(err, writepolicy.ErrDenied) && !errors.Is(err, writepolicy.ErrNeedsApproval) {
+			t.Fatalf("manager write of %q: %v", data, err)
+		}
+	}
+
+	held, err := s.Write(as("token:alice"), &pb.WriteRequest{PortName: port, SessionId: session, Data: []byte("FLASH 0x0800\r\n")})
+	if err != nil || held.ApprovalId == "" {
+		t.Fatalf("write not held: %v %v", err, held.GetMessage())
+	}
+	for _, tc := range []struct {
+		name string
+		ctx  context.Context
+	}{
+		{"requester", as("token:alice")},
+		{"non-approver", as("token:bob")},
+		{"token named like an approver", asToken("access:token:root", port)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.ApproveWrite(tc.ctx, &pb.ApproveWriteRequest{ApprovalId: held.ApprovalId})
+			requireDenied(t, err)
+		})
+	}
+
+	approved, err := s.ApproveWrite(as("token:root"), &pb.ApproveWriteRequest{ApprovalId: held.ApprovalId})
+	if err != nil || !approved.Success {
+		t.Fatalf("approval: %v %v", err, approved.GetMessage())
+	}
+	// An approval releases its payload once
+	if _, err := s.manager.Write(port, session, []byte("FLASH 0x0800\r\n")); !errors.Is(err, writepolicy.ErrNeedsApproval) {
+		t.Fatalf("second write after one approval: %v", err)
+	}
+}
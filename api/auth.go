@@ -0,0 +1,275 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/Shoaibashk/SerialLink/internal/auth"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authorizationMetadataKey carries "Bearer TOKEN", or "Basic" credentials
+// for LDAP, on gRPC calls and HTTP requests
+const authorizationMetadataKey = "authorization"
+
+// tokenQueryParameter carries the token of WebSocket and event stream
+// requests from browsers, which cannot set headers on them
+const tokenQueryParameter = "access_token"
+
+// Calls of the gateways' port monitors, which are not RPCs, for access
+// token scopes
+const (
+	// eventsMethod is the HTTP event stream of a port
+	eventsMethod = "events"
+	// monitorMethod is a WebSocket monitor of a port
+	monitorMethod = "monitor"
+)
+
+// scopeLevel is how much of a port a call uses
+type scopeLevel int
+
+// Scope levels; a token allowing one level allows those below it
+const (
+	// scopeStream only follows the data read from the port
+	scopeStream scopeLevel = iota
+	// scopeRead reads the port and its status
+	scopeRead
+	// scopeWrite opens, writes, configures and closes the port
+	scopeWrite
+)
+
+// scopedMethods are the calls an access token may make on its port, by
+// the level they need
+var scopedMethods = map[string]scopeLevel{
+	eventsMethod:          scopeStream,
+	monitorMethod:         scopeStream,
+	"GetPortInfo":         scopeRead,
+	"GetPortCapabilities": scopeRead,
+	"GetPortStatus":       scopeRead,
+	"GetPortConfig":       scopeRead,
+	"Read":                scopeRead,
+	"StreamRead":          scopeRead,
+	"StreamTimedRead":     scopeRead,
+	"StreamAnnotated":     scopeRead,
+	"GetRecentOutput":     scopeRead,
+	"GetRecentErrors":     scopeRead,
+	"OpenPort":            scopeWrite,
+	"ClosePort":           scopeWrite,
+	"Write":               scopeWrite,
+	"ConfigurePort":       scopeWrite,
+	"UploadFirmware":      scopeWrite,
+}
+
+// identityKey is the context key of the authenticated identity
+type identityKey struct{}
+
+// AuthIdentity returns the identity a call was authenticated as
+func AuthIdentity(ctx context.Context) (auth.Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(auth.Identity)
+	return identity, ok
+}
+
+// TokenAuth rejects calls without valid credentials with UNAUTHENTICATED
+// before they reach the service. Credentials are checked by the configured
+// backends; authenticated calls carry the identity they return, which
+// becomes the client identity when no client certificate names one.
+type TokenAuth struct {
+	authenticator auth.Authenticator
+	// basic accepts "Basic" credentials, for LDAP
+	basic  bool
+	logger *log.Logger
+}
+
+// NewTokenAuth creates authentication by the authenticator. With basic,
+// usernames and passwords are accepted besides bearer tokens.
+func NewTokenAuth(authenticator auth.Authenticator, basic bool, logger *log.Logger) *TokenAuth {
+	return &TokenAuth{authenticator: authenticator, basic: basic, logger: logger}
+}
+
+// UnaryInterceptor authenticates unary calls
+func (a *TokenAuth) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkScope(ctx, path.Base(info.FullMethod), requestPortName(req)); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor authenticates streams
+func (a *TokenAuth) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		stream := grpc.ServerStream(&contextServerStream{ServerStream: ss, ctx: ctx})
+		if identity, _ := AuthIdentity(ctx); identity.Scope != nil {
+			method := path.Base(info.FullMethod)
+			if _, ok := scopedMethods[method]; !ok {
+				return checkScope(ctx, method, "")
+			}
+			stream = &scopedServerStream{ServerStream: stream, method: method}
+		}
+		return handler(srv, stream)
+	}
+}
+
+// scopedServerStream checks the messages of an access token's stream
+// against its scope
+type scopedServerStream struct {
+	grpc.ServerStream
+	method string
+}
+
+func (s *scopedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return checkScope(s.Context(), s.method, requestPortName(m))
+}
+
+// checkScope refuses calls outside the scope of an access token: other
+// ports, calls not in scopedMethods and those above the token's level
+func checkScope(ctx context.Context, method, portName string) error {
+	identity, _ := AuthIdentity(ctx)
+	scope := identity.Scope
+	if scope == nil {
+		return nil
+	}
+	allowed, kind := scopeWrite, "an access token"
+	switch {
+	case scope.StreamOnly:
+		allowed, kind = scopeStream, "a stream link"
+	case scope.ReadOnly:
+		allowed, kind = scopeRead, "a read-only access token"
+	}
+	level, ok := scopedMethods[method]
+	switch {
+	case !ok || level > allowed:
+		return status.Errorf(codes.PermissionDenied, "%s is %s for %s and may not call %s", identity.Name, kind, scope.Port, method)
+	case portName != scope.Port:
+		return status.Errorf(codes.PermissionDenied, "%s is limited to %s", identity.Name, scope.Port)
+	}
+	return nil
+}
+
+// requestPortName returns the port a request names, if any
+func requestPortName(req interface{}) string {
+	if r, ok := req.(interface{ GetPortName() string }); ok {
+		return r.GetPortName()
+	}
+	return ""
+}
+
+// authenticate verifies the bearer token of a gRPC call
+func (a *TokenAuth) authenticate(ctx context.Context, method string) (context.Context, error) {
+	var header string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationMetadataKey); len(values) > 0 {
+			header = values[0]
+		}
+	}
+	return a.verify(ctx, header, "", method, ClientAddress(ctx))
+}
+
+// Middleware authenticates HTTP requests by their Authorization header or,
+// for browsers, the access_token query parameter
+func (a *TokenAuth) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := a.verify(r.Context(), r.Header.Get(authorizationMetadataKey), r.URL.Query().Get(tokenQueryParameter), r.Method+" "+r.URL.Path, r.RemoteAddr)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="seriallink"`)
+			if a.basic {
+				w.Header().Add("WWW-Authenticate", `Basic realm="seriallink"`)
+			}
+			writeError(w, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// protect wraps a handler in the middleware; a nil TokenAuth leaves it
+// unprotected
+func (a *TokenAuth) protect(next http.Handler) http.Handler {
+	if a == nil {
+		return next
+	}
+	return a.Middleware(next)
+}
+
+// verify checks the credentials of an Authorization header, or the token
+// itself when there is none, and adds the identity to ctx
+func (a *TokenAuth) verify(ctx context.Context, header, token, method, client string) (context.Context, error) {
+	creds := auth.Credentials{Token: token}
+	if header != "" {
+		scheme, value, _ := strings.Cut(header, " ")
+		value = strings.TrimSpace(value)
+		switch {
+		case strings.EqualFold(scheme, "bearer"):
+			creds = auth.Credentials{Token: value}
+		case strings.EqualFold(scheme, "basic") && a.basic:
+			decoded, err := base64.StdEncoding.DecodeString(value)
+			username, password, ok := strings.Cut(string(decoded), ":")
+			if err != nil || !ok {
+				return ctx, status.Error(codes.Unauthenticated, "malformed basic credentials")
+			}
+			creds = auth.Credentials{Username: username, Password: password}
+		case a.basic:
+			return ctx, status.Error(codes.Unauthenticated, "authorization must be a bearer token or basic credentials")
+		default:
+			return ctx, status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+		}
+	}
+	if creds.Token == "" && creds.Username == "" {
+		if a.basic {
+			return ctx, status.Error(codes.Unauthenticated, "API token or credentials required")
+		}
+		return ctx, status.Error(codes.Unauthenticated, "API token required")
+	}
+
+	identity, err := a.authenticator.Authenticate(ctx, creds)
+	if err != nil {
+		a.logger.Warn("rejected call with invalid credentials", "method", method, "client", client, "user", creds.Username, "error", err)
+		switch {
+		case errors.Is(err, auth.ErrExpiredToken):
+			return ctx, status.Error(codes.Unauthenticated, "token has expired")
+		case errors.Is(err, auth.ErrInvalidCredentials):
+			return ctx, status.Error(codes.Unauthenticated, "invalid username or password")
+		case errors.Is(err, auth.ErrInvalidToken), errors.Is(err, auth.ErrUnsupported):
+			return ctx, status.Error(codes.Unauthenticated, "invalid token")
+		}
+		// The identity provider could not be reached
+		return ctx, status.Error(codes.Unavailable, "authentication failed")
+	}
+	return context.WithValue(ctx, identityKey{}, identity), nil
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"crypto/x509"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// clientAddressKey is the context key for the resolved client address
+type clientAddressKey struct{}
+
+// ClientAddressResolver determines the real client address of a request,
+// honouring x-forwarded-for / x-real-ip metadata from trusted proxies
+type ClientAddressResolver struct {
+	trusted      []*net.IPNet
+	useForwarded bool
+}
+
+// NewClientAddressResolver creates a resolver. Forwarded metadata is only
+// honoured when useForwarded is set and the direct peer is trusted.
+func NewClientAddressResolver(trustedProxies []string, useForwarded bool) (*ClientAddressResolver, error) {
+	trusted, err := ParseTrustedProxies(trustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientAddressResolver{trusted: trusted, useForwarded: useForwarded}, nil
+}
+
+// resolve returns the client address for ctx
+func (r *ClientAddressResolver) resolve(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	if r.useForwarded && isTrustedAddr(r.trusted, p.Addr) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("x-forwarded-for"); len(values) > 0 {
+				// The left-most entry is the original client
+				first, _, _ := strings.Cut(values[0], ",")
+				if addr := strings.TrimSpace(first); addr != "" {
+					return addr
+				}
+			}
+			if values := md.Get("x-real-ip"); len(values) > 0 && values[0] != "" {
+				return values[0]
+			}
+		}
+	}
+
+	return p.Addr.String()
+}
+
+// UnaryInterceptor stores the resolved client address in the request context
+func (r *ClientAddressResolver) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(context.WithValue(ctx, clientAddressKey{}, r.resolve(ctx)), req)
+	}
+}
+
+// StreamInterceptor stores the resolved client address in the stream context
+func (r *ClientAddressResolver) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := context.WithValue(ss.Context(), clientAddressKey{}, r.resolve(ss.Context()))
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// ClientAddress returns the real client address for a request, falling back
+// to the transport peer when no resolver ran
+func ClientAddress(ctx context.Context) string {
+	if addr, ok := ctx.Value(clientAddressKey{}).(string); ok && addr != "" {
+		return addr
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// ClientIdentity identifies the caller for authorization: the SPIFFE ID or
+// common name of its verified client certificate, else the identity of
+// authenticated calls ("token:NAME", "oidc:USER" or "ldap:USER"), else its
+// IP address. Set
+// peerVerified when the TLS configuration verifies peer certificates itself,
+// as SPIFFE does, so the handshake records no verified chains.
+func ClientIdentity(ctx context.Context, peerVerified bool) string {
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			var cert *x509.Certificate
+			if len(info.State.VerifiedChains) > 0 && len(info.State.VerifiedChains[0]) > 0 {
+				cert = info.State.VerifiedChains[0][0]
+			} else if peerVerified && len(info.State.PeerCertificates) > 0 {
+				cert = info.State.PeerCertificates[0]
+			}
+			if cert != nil {
+				for _, uri := range cert.URIs {
+					if uri.Scheme == "spiffe" {
+						return uri.String()
+					}
+				}
+				if cert.Subject.CommonName != "" {
+					return cert.Subject.CommonName
+				}
+			}
+		}
+	}
+
+	if identity, ok := AuthIdentity(ctx); ok {
+		return identity.Name
+	}
+
+	addr := ClientAddress(ctx)
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// contextServerStream overrides the context of a wrapped server stream
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the wrapped context
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// ClientGroups returns the groups of an authenticated caller as
+// "group:NAME", for access rules
+func ClientGroups(ctx context.Context) []string {
+	identity, ok := AuthIdentity(ctx)
+	if !ok {
+		return nil
+	}
+	groups := make([]string, 0, len(identity.Groups))
+	for _, group := range identity.Groups {
+		groups = append(groups, "group:"+group)
+	}
+	return groups
+}
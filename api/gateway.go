@@ -0,0 +1,173 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/Shoaibashk/SerialLink/internal/overload"
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// The WebSocket and REST gateways call the SerialServer like gRPC clients,
+// so write policies, input checks, reservations and session rules apply to
+// them alike.
+
+// requestContext returns the context of a gateway call: like a gRPC call,
+// it carries the client's address and, over mutual TLS, its certificate as
+// the peer, and the client ID it names for overload priorities
+func requestContext(ctx context.Context, r *http.Request, clientID string) context.Context {
+	p := &peer.Peer{Addr: &net.TCPAddr{}}
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		p.Addr = addr
+	}
+	if r.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{State: *r.TLS}
+	}
+	ctx = peer.NewContext(ctx, p)
+	if clientID != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(clientIDMetadataKey, clientID))
+	}
+	return ctx
+}
+
+// admitGateway rejects new work while the agent is overloaded, as the
+// overload interceptors do for gRPC calls; guard may be nil
+func admitGateway(ctx context.Context, guard *overload.Guard, clientID string) error {
+	if guard == nil {
+		return nil
+	}
+	if err := guard.Admit(guard.Priority(overloadIdentities(ctx, clientID)...)); err != nil {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return nil
+}
+
+// lineSettings are line settings given to a gateway; unset fields keep
+// those of the base configuration
+type lineSettings struct {
+	BaudRate       int    `json:"baud_rate,omitempty"`
+	DataBits       int    `json:"data_bits,omitempty"`
+	StopBits       int    `json:"stop_bits,omitempty"`
+	Parity         string `json:"parity,omitempty"`
+	FlowControl    string `json:"flow_control,omitempty"`
+	LatencyProfile string `json:"latency_profile,omitempty"`
+}
+
+// empty reports whether no setting is given
+func (l lineSettings) empty() bool {
+	return l == lineSettings{}
+}
+
+// lineStates are the control line states of an open; unset ones keep
+// those of the port's configuration
+type lineStates struct {
+	DTR string `json:"dtr,omitempty"`
+	RTS string `json:"rts,omitempty"`
+}
+
+// apply sets the states on an open request
+func (l lineStates) apply(req *pb.OpenPortRequest) error {
+	for _, line := range []struct {
+		value  string
+		target *pb.LineState
+	}{{l.DTR, &req.Dtr}, {l.RTS, &req.Rts}} {
+		if line.value == "" {
+			continue
+		}
+		state, err := serial.ParseLineState(line.value)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		*line.target = convertLineStateBack(state)
+	}
+	return nil
+}
+
+// applyOpenOptions sets the line settings, control line states and
+// priority a gateway client gave for an open on req
+func (s *SerialServer) applyOpenOptions(req *pb.OpenPortRequest, settings lineSettings, states lineStates, priority string) error {
+	var err error
+	if req.Config, err = s.openConfig(settings); err != nil {
+		return err
+	}
+	if err := states.apply(req); err != nil {
+		return err
+	}
+	if priority != "" {
+		p, err := serial.ParsePriority(priority)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		req.Priority = convertPriorityBack(p)
+	}
+	return nil
+}
+
+// portConfig applies the settings over base
+func (s *SerialServer) portConfig(base serial.PortConfig, l lineSettings) (*pb.PortConfig, error) {
+	cfg := base
+	if l.BaudRate != 0 {
+		cfg.BaudRate = l.BaudRate
+	}
+	if l.DataBits != 0 {
+		cfg.DataBits = l.DataBits
+	}
+	var err error
+	if l.StopBits != 0 {
+		if cfg.StopBits, err = serial.ParseStopBits(l.StopBits); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if l.Parity != "" {
+		if cfg.Parity, err = serial.ParseParity(l.Parity); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if l.FlowControl != "" {
+		if cfg.FlowControl, err = serial.ParseFlowControl(l.FlowControl); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if l.LatencyProfile != "" {
+		if cfg.LatencyProfile, err = serial.ParseLatencyProfile(l.LatencyProfile); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return s.convertFromSerialConfig(cfg), nil
+}
+
+// openConfig returns the configuration of an open with the settings, nil
+// without any so the port's device profile or the defaults apply
+func (s *SerialServer) openConfig(l lineSettings) (*pb.PortConfig, error) {
+	if l.empty() {
+		return nil, nil
+	}
+	base, err := s.config.Serial.Defaults.ToPortConfig()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return s.portConfig(base, l)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/Shoaibashk/SerialLink/config"
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/Shoaibashk/SerialLink/internal/wsframe"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newFuzzServer returns a service with the default configuration and no
+// open ports
+func newFuzzServer(t testing.TB) *SerialServer {
+	m := serial.NewManager(false, serial.DefaultConfig())
+	scanner, err := serial.NewScanner(nil, m)
+	if err != nil {
+		t.Fatalf("scanner: %v", err)
+	}
+	return NewSerialServer(m, scanner, config.DefaultConfig(), log.New(io.Discard))
+}
+
+// requireInvalidArgument fails unless err is nil or an InvalidArgument
+// status, the only error malformed client input may cause
+func requireInvalidArgument(t *testing.T, err error) {
+	t.Helper()
+	if err != nil && status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("error %v is not InvalidArgument", err)
+	}
+}
+
+func FuzzRESTRequestBody(f *testing.F) {
+	for _, seed := range []string{
+		``,
+		`{}`,
+		`{"baud_rate":115200,"data_bits":8,"stop_bits":1,"parity":"none","flow_control":"none"}`,
+		`{"client_id":"dash","exclusive":false,"priority":"critical","dtr":"on","rts":"off","metadata":{"purpose":"soak"}}`,
+		`{"session_id":"COM3-7f3a","text":"AT\r","data":"AQID","flush":true}`,
+		`{"session_id":"x","max_bytes":4096,"timeout_ms":250}`,
+		`{"stop_bits":3,"parity":"sideways","latency_profile":"warp"}`,
+		`{"baud_rate":-1,"data_bits":99}`,
+		`[1,2,3]`,
+		`{"metadata":{"a":`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	s := newFuzzServer(f)
+	f.Fuzz(func(t *testing.T, body []byte) {
+		var open restOpenRequest
+		if err := decodeBody(bytes.NewReader(body), &open); err != nil {
+			requireInvalidArgument(t, err)
+		} else {
+			req := &pb.OpenPortRequest{PortName: "/dev/ttyUSB0"}
+			requireInvalidArgument(t, s.applyOpenOptions(req, open.lineSettings, open.lineStates, open.Priority))
+		}
+
+		var configure restConfigureRequest
+		if err := decodeBody(bytes.NewReader(body), &configure); err != nil {
+			requireInvalidArgument(t, err)
+		} else {
+			_, err := s.portConfig(serial.DefaultConfig(), configure.lineSettings)
+			requireInvalidArgument(t, err)
+		}
+
+		for _, v := range []interface{}{&restSessionRequest{}, &restWriteRequest{}, &restReadRequest{}} {
+			requireInvalidArgument(t, decodeBody(bytes.NewReader(body), v))
+		}
+	})
+}
+
+func FuzzWSOpenFrame(f *testing.F) {
+	for _, seed := range []string{
+		`{"type":"open","id":"1","port":"/dev/ttyUSB0"}`,
+		`{"type":"open","id":"2","port":"COM3","options":{"baud_rate":"9600","parity":"even","dtr":"off","priority":"bulk","exclusive":"false"}}`,
+		`{"type":"open","options":{"baud_rate":"fast","stop_bits":"2"}}`,
+		`{"type":"write","session_id":"s","data":"SGVsbG8="}`,
+		`{"type":"open","options":{"latency_profile":"low","flow_control":"xonxoff"}}`,
+		`{"type":1}`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	s := newFuzzServer(f)
+	codecs := []wsframe.Codec{}
+	for _, name := range []string{wsframe.EncodingJSON, wsframe.EncodingCBOR, wsframe.EncodingMessagePack} {
+		codec, err := wsframe.Lookup(name)
+		if err != nil {
+			f.Fatalf("codec %s: %v", name, err)
+		}
+		codecs = append(codecs, codec)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, codec := range codecs {
+			var frame wsframe.Frame
+			if err := codec.Unmarshal(data, &frame); err != nil || frame.Type != wsframe.TypeOpen {
+				continue
+			}
+
+			opts := frame.Options
+			settings, err := lineSettingsOf(opts)
+			if err != nil {
+				requireInvalidArgument(t, err)
+				continue
+			}
+			req := &pb.OpenPortRequest{PortName: frame.Port}
+			states := lineStates{DTR: opts["dtr"], RTS: opts["rts"]}
+			requireInvalidArgument(t, s.applyOpenOptions(req, settings, states, opts["priority"]))
+		}
+	})
+}
@@ -18,19 +18,58 @@ limitations under the License.
 package api
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io"
+	"net"
+	"net/url"
+	"path/filepath"
+	"regexp"
 	"runtime"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
 	"github.com/Shoaibashk/SerialLink/config"
+	"github.com/Shoaibashk/SerialLink/internal/acl"
+	"github.com/Shoaibashk/SerialLink/internal/alarm"
+	"github.com/Shoaibashk/SerialLink/internal/auth"
+	"github.com/Shoaibashk/SerialLink/internal/barcode"
+	"github.com/Shoaibashk/SerialLink/internal/bridge"
+	"github.com/Shoaibashk/SerialLink/internal/bus"
+	"github.com/Shoaibashk/SerialLink/internal/console"
+	"github.com/Shoaibashk/SerialLink/internal/debug"
+	"github.com/Shoaibashk/SerialLink/internal/decode"
+	"github.com/Shoaibashk/SerialLink/internal/dedup"
+	"github.com/Shoaibashk/SerialLink/internal/devicestate"
+	"github.com/Shoaibashk/SerialLink/internal/escpos"
+	"github.com/Shoaibashk/SerialLink/internal/flash"
+	"github.com/Shoaibashk/SerialLink/internal/gcode"
+	"github.com/Shoaibashk/SerialLink/internal/gpio"
+	"github.com/Shoaibashk/SerialLink/internal/history"
+	"github.com/Shoaibashk/SerialLink/internal/inputguard"
+	"github.com/Shoaibashk/SerialLink/internal/meter"
+	"github.com/Shoaibashk/SerialLink/internal/modem"
+	"github.com/Shoaibashk/SerialLink/internal/overload"
+	"github.com/Shoaibashk/SerialLink/internal/paste"
+	"github.com/Shoaibashk/SerialLink/internal/poller"
+	"github.com/Shoaibashk/SerialLink/internal/redact"
+	"github.com/Shoaibashk/SerialLink/internal/reservation"
 	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/Shoaibashk/SerialLink/internal/testrunner"
+	"github.com/Shoaibashk/SerialLink/internal/usage"
+	"github.com/Shoaibashk/SerialLink/internal/verify"
+	"github.com/Shoaibashk/SerialLink/internal/writepolicy"
 	"github.com/charmbracelet/log"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -41,6 +80,24 @@ var (
 	BuildDate = "unknown"
 )
 
+// maxForceCloseGrace bounds how long ForceClose waits after notifying the
+// session's client
+const maxForceCloseGrace = time.Minute
+
+// Snapshot intervals of StreamPortStatus
+const (
+	defaultStatusInterval = time.Second
+	minStatusInterval     = 100 * time.Millisecond
+)
+
+// Line mode of StreamRead
+const (
+	// lineHold is how long the start of a line waits for its newline
+	lineHold = 200 * time.Millisecond
+	// dedupReportInterval is how often a run still going on is reported
+	dedupReportInterval = 10 * time.Second
+)
+
 // SerialServer implements the gRPC SerialService
 type SerialServer struct {
 	pb.UnimplementedSerialServiceServer
@@ -49,8 +106,38 @@ type SerialServer struct {
 	config    *config.Config
 	startTime time.Time
 	readers   map[string]*serial.Reader
+	capturing map[string]bool
 	readersMu sync.RWMutex
+	bridges   *bridge.Set
+	gcode     *gcode.Set
+	uploads   *flash.Uploader
+	console   *console.Collector
+	recording console.RecordingOptions
+	redactor  *redact.Redactor
+	buses     *bus.Registry
+	polling   *poller.Engine
+	history   *history.Store
+	alarms    *alarm.Monitor
+	devices   *devicestate.Tracker
+	writes    *writepolicy.Guard
+	inputs    *inputguard.Guard
+	tests     *testrunner.Runner
+	bookings  *reservation.Book
+	access    *acl.Policy
+	tokens    *auth.AccessTokens
+	usage     *usage.Ledger
+	debug     *debug.Server
 	logger    *log.Logger
+
+	// cpu is the CPU time sample GetAgentStats measures usage against
+	cpuMu      sync.Mutex
+	cpuSample  time.Duration
+	cpuSampled time.Time
+	cpuPercent float64
+
+	// activeRecordings are the paths of recordings still being written
+	activeRecordings   map[string]bool
+	activeRecordingsMu sync.Mutex
 }
 
 // NewSerialServer creates a new SerialServer
@@ -61,10 +148,101 @@ func NewSerialServer(manager *serial.Manager, scanner *serial.Scanner, cfg *conf
 		config:    cfg,
 		startTime: time.Now(),
 		readers:   make(map[string]*serial.Reader),
+		capturing: make(map[string]bool),
+		bridges:   bridge.NewSet(manager, logger),
+		gcode:     gcode.NewSet(manager, logger),
+		uploads:   flash.NewUploader(manager, scannedPortNames(scanner)),
 		logger:    logger,
+
+		activeRecordings: make(map[string]bool),
 	}
 }
 
+// SetConsoleCollector enables access to console logger buffers
+func (s *SerialServer) SetConsoleCollector(collector *console.Collector) {
+	s.console = collector
+}
+
+// SetRecordingOptions enables asciicast recording of interactive sessions
+func (s *SerialServer) SetRecordingOptions(opts console.RecordingOptions) {
+	s.recording = opts
+}
+
+// SetRedactor masks secrets in session recordings and bridge data logs
+func (s *SerialServer) SetRedactor(redactor *redact.Redactor) {
+	s.redactor = redactor
+	s.bridges.SetRedactor(redactor)
+}
+
+// SetBusRegistry enables the I2C and SPI bus RPCs
+func (s *SerialServer) SetBusRegistry(registry *bus.Registry) {
+	s.buses = registry
+}
+
+// SetPollingEngine enables streaming of polled device values
+func (s *SerialServer) SetPollingEngine(engine *poller.Engine) {
+	s.polling = engine
+}
+
+// SetHistoryStore enables QueryHistory
+func (s *SerialServer) SetHistoryStore(store *history.Store) {
+	s.history = store
+}
+
+// SetAlarmMonitor enables the alarm RPCs
+func (s *SerialServer) SetAlarmMonitor(monitor *alarm.Monitor) {
+	s.alarms = monitor
+}
+
+// SetDeviceTracker enables the device state RPCs
+func (s *SerialServer) SetDeviceTracker(tracker *devicestate.Tracker) {
+	s.devices = tracker
+}
+
+// SetWriteGuard applies per-port write policies to everything written to
+// the ports and enables the write approval RPCs
+func (s *SerialServer) SetWriteGuard(guard *writepolicy.Guard) {
+	s.writes = guard
+	s.manager.SetWriteFilter(guard.Admit)
+}
+
+// SetInputGuard screens the keystrokes of interactive sessions on guarded
+// ports
+func (s *SerialServer) SetInputGuard(guard *inputguard.Guard) {
+	s.inputs = guard
+}
+
+// SetTestRunner enables the test suite RPCs
+func (s *SerialServer) SetTestRunner(runner *testrunner.Runner) {
+	s.tests = runner
+}
+
+// SetReservationBook enables the reservation RPCs and restricts reserved
+// ports to their holders
+func (s *SerialServer) SetReservationBook(book *reservation.Book) {
+	s.bookings = book
+}
+
+// SetAccessPolicy restricts ports to the clients the policy allows
+func (s *SerialServer) SetAccessPolicy(policy *acl.Policy) {
+	s.access = policy
+}
+
+// SetAccessTokens enables CreateAccessToken
+func (s *SerialServer) SetAccessTokens(tokens *auth.AccessTokens) {
+	s.tokens = tokens
+}
+
+// SetUsageLedger enables GetUsageReport
+func (s *SerialServer) SetUsageLedger(ledger *usage.Ledger) {
+	s.usage = ledger
+}
+
+// SetDebugServer enables SetDebugEndpoints
+func (s *SerialServer) SetDebugServer(server *debug.Server) {
+	s.debug = server
+}
+
 // UnaryLoggingInterceptor returns a gRPC unary interceptor for logging requests
 func UnaryLoggingInterceptor(logger *log.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
@@ -76,12 +254,14 @@ func UnaryLoggingInterceptor(logger *log.Logger) grpc.UnaryServerInterceptor {
 			st, _ := status.FromError(err)
 			logger.Warn("gRPC request failed",
 				"method", info.FullMethod,
+				"client", ClientAddress(ctx),
 				"duration", duration,
 				"code", st.Code().String(),
 				"error", st.Message())
 		} else {
 			logger.Debug("gRPC request completed",
 				"method", info.FullMethod,
+				"client", ClientAddress(ctx),
 				"duration", duration,
 				"code", codes.OK.String())
 		}
@@ -94,7 +274,7 @@ func UnaryLoggingInterceptor(logger *log.Logger) grpc.UnaryServerInterceptor {
 func StreamLoggingInterceptor(logger *log.Logger) grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 		start := time.Now()
-		logger.Debug("gRPC stream started", "method", info.FullMethod)
+		logger.Debug("gRPC stream started", "method", info.FullMethod, "client", ClientAddress(ss.Context()))
 
 		err := handler(srv, ss)
 		duration := time.Since(start)
@@ -103,12 +283,14 @@ func StreamLoggingInterceptor(logger *log.Logger) grpc.StreamServerInterceptor {
 			st, _ := status.FromError(err)
 			logger.Warn("gRPC stream ended with error",
 				"method", info.FullMethod,
+				"client", ClientAddress(ss.Context()),
 				"duration", duration,
 				"code", st.Code().String(),
 				"error", st.Message())
 		} else {
 			logger.Debug("gRPC stream completed",
 				"method", info.FullMethod,
+				"client", ClientAddress(ss.Context()),
 				"duration", duration)
 		}
 
@@ -122,13 +304,18 @@ func StreamLoggingInterceptor(logger *log.Logger) grpc.StreamServerInterceptor {
 
 // ListPorts returns all available serial ports
 func (s *SerialServer) ListPorts(ctx context.Context, req *pb.ListPortsRequest) (*pb.ListPortsResponse, error) {
-	ports, err := s.scanner.Scan()
+	result, err := s.scanner.ScanPorts()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to scan ports: %v", err)
 	}
 
 	var response pb.ListPortsResponse
-	for _, p := range ports {
+	for _, incomplete := range result.Incomplete {
+		s.logger.Warn("port scan incomplete", "provider", incomplete.Provider, "error", incomplete.Err)
+		response.Partial = true
+		response.IncompleteProviders = append(response.IncompleteProviders, incomplete.Provider)
+	}
+	for _, p := range result.Ports {
 		if req.OnlyAvailable && p.IsOpen {
 			continue
 		}
@@ -153,6 +340,35 @@ func (s *SerialServer) GetPortInfo(ctx context.Context, req *pb.GetPortInfoReque
 	return &pb.GetPortInfoResponse{Port: s.convertPortInfo(*port)}, nil
 }
 
+// GetPortCapabilities reports what a port and its driver support on the
+// agent's platform
+func (s *SerialServer) GetPortCapabilities(ctx context.Context, req *pb.GetPortCapabilitiesRequest) (*pb.GetPortCapabilitiesResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if !serial.IsNetworkPort(req.PortName) && s.manager.GetSession(req.PortName) == nil {
+		if _, err := s.scanner.GetPort(req.PortName); err != nil {
+			return nil, status.Errorf(codes.NotFound, "port not found: %v", err)
+		}
+	}
+
+	caps := s.manager.PortCapabilities(req.PortName)
+	return &pb.GetPortCapabilitiesResponse{
+		PortName:        req.PortName,
+		Platform:        caps.Platform,
+		Driver:          caps.Driver,
+		MaxBaudRate:     int32(caps.MaxBaudRate),
+		LowLatency:      caps.LowLatency,
+		ReadPolling:     caps.ReadPolling,
+		CustomBaud:      caps.CustomBaud,
+		MarkSpaceParity: caps.MarkSpaceParity,
+		ModemStatus:     caps.ModemStatus,
+		ControlLines:    caps.ControlLines,
+		Break:           caps.Break,
+		Rs485:           caps.RS485,
+	}, nil
+}
+
 // ============================================================================
 // Port Management
 // ============================================================================
@@ -168,44 +384,186 @@ func (s *SerialServer) OpenPort(ctx context.Context, req *pb.OpenPortRequest) (*
 		clientID = "default-client"
 	}
 
+	if err := s.checkAccess(ctx, req.PortName, clientID); err != nil {
+		return nil, err
+	}
+	if err := s.checkReservation(ctx, req.PortName); err != nil {
+		s.logger.Warn("open of reserved port refused", "port", req.PortName, "client_id", clientID, "client", ClientAddress(ctx))
+		return nil, err
+	}
+
+	if err := validateMetadata(req.Metadata); err != nil {
+		return nil, err
+	}
+
+	steps, err := s.initSteps(ctx, req.PortName, req.Init)
+	if err != nil {
+		return nil, err
+	}
+
 	cfg := s.convertToSerialConfig(req.Config)
 
-	session, err := s.manager.OpenPort(req.PortName, cfg, clientID, req.Exclusive)
+	// Settings given by the client always win over device profiles
+	if req.Config == nil && s.config.Serial.AutoProfiles {
+		if profile := s.scanner.DeviceProfile(req.PortName); profile != nil && profile.HasSettings() {
+			if profiled, err := profile.Apply(cfg); err == nil {
+				cfg = profiled
+				s.logger.Debug("applied device profile", "port", req.PortName, "family", profile.Family, "baud", cfg.BaudRate)
+			}
+		}
+	}
+	// Line states of the request apply over the configuration and profile
+	cfg.DTR = convertLineState(req.Dtr, cfg.DTR)
+	cfg.RTS = convertLineState(req.Rts, cfg.RTS)
+
+	var initialize func(rw io.ReadWriter) error
+	var initResponses [][]byte
+	if len(steps) > 0 {
+		initialize = func(rw io.ReadWriter) error {
+			var err error
+			initResponses, err = verify.RunSequence(ctx, rw, steps)
+			return err
+		}
+	}
+
+	session, err := s.manager.OpenPortWithInit(req.PortName, cfg, clientID, req.Exclusive, req.Metadata, 50*time.Millisecond, initialize)
 	if err != nil {
+		s.logger.Warn("failed to open port", "port", req.PortName, "client_id", clientID, "client", ClientAddress(ctx), "error", err)
 		if err == serial.ErrPortLocked {
 			return &pb.OpenPortResponse{
 				Success: false,
 				Message: "port is locked by another client",
 			}, nil
 		}
+		if errors.Is(err, serial.ErrInitFailed) {
+			return &pb.OpenPortResponse{
+				Success:       false,
+				Message:       err.Error(),
+				InitResponses: initResponses,
+			}, nil
+		}
 		return nil, status.Errorf(codes.Internal, "failed to open port: %v", err)
 	}
+	session.SetPriority(convertPriority(req.Priority, serial.PriorityNormal))
+	session.SetOwner(s.clientIdentity(ctx), ClientGroups(ctx)...)
+	if window := time.Duration(s.config.Serial.WriteCoalesceMs) * time.Millisecond; window > 0 && !req.NoWriteCoalescing {
+		if err := s.manager.SetWriteCoalescing(req.PortName, session.ID, window); err != nil {
+			s.logger.Warn("failed to enable write coalescing", "port", req.PortName, "session", session.ID, "error", err)
+		}
+	}
+
+	s.logger.Info("port opened", "port", req.PortName, "session", session.ID, "short_id", session.ShortID, "client_id", clientID, "client", ClientAddress(ctx), "priority", session.Priority(), "metadata", session.Metadata)
 
 	return &pb.OpenPortResponse{
-		Success:   true,
-		Message:   "port opened successfully",
-		SessionId: session.ID,
+		Success:        true,
+		Message:        "port opened successfully",
+		SessionId:      session.ID,
+		ShortSessionId: session.ShortID,
+		InitResponses:  initResponses,
 	}, nil
 }
 
-// ClosePort closes a serial port
-func (s *SerialServer) ClosePort(ctx context.Context, req *pb.ClosePortRequest) (*pb.ClosePortResponse, error) {
-	if req.PortName == "" {
-		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+// Session metadata limits
+const (
+	maxMetadataEntries     = 32
+	maxMetadataKeyLength   = 64
+	maxMetadataValueLength = 256
+)
+
+// validateMetadata checks the metadata of an open request against the limits
+func validateMetadata(metadata map[string]string) error {
+	if len(metadata) > maxMetadataEntries {
+		return status.Errorf(codes.InvalidArgument, "at most %d metadata entries are allowed", maxMetadataEntries)
 	}
-	if req.SessionId == "" {
-		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	for key, value := range metadata {
+		if key == "" || len(key) > maxMetadataKeyLength {
+			return status.Errorf(codes.InvalidArgument, "metadata keys must be 1 to %d bytes: %q", maxMetadataKeyLength, key)
+		}
+		if len(value) > maxMetadataValueLength {
+			return status.Errorf(codes.InvalidArgument, "metadata value of %q is longer than %d bytes", key, maxMetadataValueLength)
+		}
 	}
+	return nil
+}
 
-	// Stop any active reader
-	s.readersMu.Lock()
-	if reader, exists := s.readers[req.PortName]; exists {
-		reader.Stop()
-		delete(s.readers, req.PortName)
+// initSteps converts the init sequence of an open request, applying the
+// port's write policy to every command
+func (s *SerialServer) initSteps(ctx context.Context, portName string, sequence []*pb.InitStep) ([]verify.Step, error) {
+	steps := make([]verify.Step, 0, len(sequence))
+	for i, step := range sequence {
+		if len(step.Expected) > 0 && step.ExpectedPattern != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "init step %d: at most one of expected and expected_pattern may be set", i+1)
+		}
+		if len(step.Data) == 0 && len(step.Expected) == 0 && step.ExpectedPattern == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "init step %d: data or an expected response is required", i+1)
+		}
+		if len(step.Data) > 0 {
+			if err := s.checkUnheldWrite(ctx, portName, step.Data); err != nil {
+				return nil, err
+			}
+		}
+
+		converted := verify.Step{
+			Command: step.Data,
+			Options: verify.Options{
+				Terminator: step.Terminator,
+				Timeout:    time.Duration(step.TimeoutMs) * time.Millisecond,
+			},
+		}
+		if len(step.Expected) > 0 {
+			converted.Expected = step.Expected
+		}
+		if step.ExpectedPattern != "" {
+			pattern, err := regexp.Compile(step.ExpectedPattern)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "init step %d: invalid expected_pattern: %v", i+1, err)
+			}
+			converted.Pattern = pattern
+		}
+		steps = append(steps, converted)
+	}
+	return steps, nil
+}
+
+// ClosePort closes a serial port. Given only a session ID the port is
+// looked up; given only a port name the caller must be the session's
+// opener.
+func (s *SerialServer) ClosePort(ctx context.Context, req *pb.ClosePortRequest) (*pb.ClosePortResponse, error) {
+	if req.PortName == "" && req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name or session_id is required")
+	}
+
+	portName, sessionID := req.PortName, req.SessionId
+	if portName == "" {
+		session := s.manager.GetSessionByID(sessionID)
+		if session == nil {
+			return &pb.ClosePortResponse{
+				Success: false,
+				Message: "invalid session ID",
+			}, nil
+		}
+		portName = session.PortName
+	}
+	if err := s.checkSessionAccess(ctx, portName); err != nil {
+		return nil, err
+	}
+	if sessionID == "" {
+		session := s.manager.GetSession(portName)
+		if session == nil {
+			return &pb.ClosePortResponse{
+				Success: false,
+				Message: serial.ErrPortNotOpen.Error(),
+			}, nil
+		}
+		if session.Owner() == "" || session.Owner() != s.clientIdentity(ctx) {
+			return nil, status.Error(codes.PermissionDenied, "session_id is required to close a session opened by another client")
+		}
+		sessionID = session.ID
 	}
-	s.readersMu.Unlock()
 
-	err := s.manager.ClosePort(req.PortName, req.SessionId)
+	s.stopReader(portName)
+
+	err := s.manager.ClosePort(portName, sessionID)
 	if err != nil {
 		if err == serial.ErrInvalidSession {
 			return &pb.ClosePortResponse{
@@ -222,422 +580,4142 @@ func (s *SerialServer) ClosePort(ctx context.Context, req *pb.ClosePortRequest)
 	}, nil
 }
 
-// GetPortStatus returns the status of a port
-func (s *SerialServer) GetPortStatus(ctx context.Context, req *pb.GetPortStatusRequest) (*pb.GetPortStatusResponse, error) {
+// ForceClose closes a port's session regardless of its session ID, for
+// administrators taking over a stuck port. With notify, the reason is sent
+// to the port's event stream first as a "notice" event, and the session
+// gets the grace period to finish before it is closed.
+func (s *SerialServer) ForceClose(ctx context.Context, req *pb.ForceCloseRequest) (*pb.ForceCloseResponse, error) {
 	if req.PortName == "" {
 		return nil, status.Error(codes.InvalidArgument, "port_name is required")
 	}
+	grace := time.Duration(req.GraceMs) * time.Millisecond
+	if grace > maxForceCloseGrace {
+		return nil, status.Errorf(codes.InvalidArgument, "grace period exceeds %s", maxForceCloseGrace)
+	}
+	admin := s.clientIdentity(ctx)
+	if !s.isAdmin(ctx) {
+		s.logger.Warn("force close refused", "port", req.PortName, "identities", s.callerIdentities(ctx, ""), "client", ClientAddress(ctx))
+		return nil, status.Errorf(codes.PermissionDenied, "%s may not force-close sessions (auth.admins)", admin)
+	}
 
-	session, err := s.manager.GetStatus(req.PortName)
-	if err != nil {
-		if err == serial.ErrPortNotOpen {
-			return &pb.GetPortStatusResponse{
-				Status: &pb.PortStatus{
-					PortName: req.PortName,
-					IsOpen:   false,
-				},
-			}, nil
-		}
-		return nil, status.Errorf(codes.Internal, "failed to get port status: %v", err)
+	session := s.manager.GetSession(req.PortName)
+	if session == nil {
+		return &pb.ForceCloseResponse{
+			Success: false,
+			Message: serial.ErrPortNotOpen.Error(),
+		}, nil
 	}
+	resp := &pb.ForceCloseResponse{SessionId: session.ID, ClientId: session.ClientID}
 
-	return &pb.GetPortStatusResponse{
-		Status: &pb.PortStatus{
-			PortName:      session.PortName,
-			IsOpen:        true,
-			IsLocked:      session.Exclusive,
-			LockedBy:      session.ClientID,
-			SessionId:     session.ID,
-			CurrentConfig: s.convertFromSerialConfig(session.Config),
-			Statistics: &pb.PortStatistics{
-				BytesSent:     session.Statistics.BytesSent,
-				BytesReceived: session.Statistics.BytesReceived,
-				Errors:        session.Statistics.Errors,
-				OpenedAt:      session.Statistics.OpenedAt.Unix(),
-				LastActivity:  session.Statistics.LastActivity.Unix(),
-			},
-		},
-	}, nil
-}
+	if req.Notify {
+		message := req.Reason
+		if message == "" {
+			message = "session is being closed by " + admin
+		}
+		// Subscribe before notifying so a quick close is not missed
+		events := s.manager.SubscribeEvents()
+		defer s.manager.UnsubscribeEvents(events)
+		s.manager.EmitPortEvent(req.PortName, serial.PortEventNotice, message)
 
-// ============================================================================
-// Data Transfer
-// ============================================================================
-// Write writes data to a port
-func (s *SerialServer) Write(ctx context.Context, req *pb.WriteRequest) (*pb.WriteResponse, error) {
-	if req.PortName == "" {
-		return nil, status.Error(codes.InvalidArgument, "port_name is required")
-	}
-	if req.SessionId == "" {
-		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+		timer := time.NewTimer(grace)
+		defer timer.Stop()
+	wait:
+		for grace > 0 {
+			select {
+			case <-timer.C:
+				break wait
+			case event, ok := <-events:
+				if !ok || (event.Type == serial.PortEventClosed && event.SessionID == session.ID) {
+					break wait
+				}
+			case <-ctx.Done():
+				return nil, status.FromContextError(ctx.Err()).Err()
+			}
+		}
 	}
 
-	n, err := s.manager.Write(req.PortName, req.SessionId, req.Data)
-	if err != nil {
-		return &pb.WriteResponse{
-			Success: false,
-			Message: err.Error(),
-		}, nil
+	s.stopReader(req.PortName)
+	err := s.manager.ClosePortWithReason(req.PortName, session.ID, serial.CloseReasonForced)
+	switch {
+	case errors.Is(err, serial.ErrPortNotOpen), errors.Is(err, serial.ErrInvalidSession):
+		// The client closed the session itself during the grace period
+		resp.Success = true
+		resp.Message = "session was closed by its client"
+		return resp, nil
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to close port: %v", err)
 	}
 
-	if req.Flush {
-		_ = s.manager.Flush(req.PortName, req.SessionId)
+	s.logger.Warn("session force-closed", "port", req.PortName, "session", session.ID, "client_id", session.ClientID, "admin", admin, "reason", req.Reason)
+	resp.Success = true
+	resp.Message = "port closed"
+	return resp, nil
+}
+
+// CloseRemovedPort ends the session of a port whose device disappeared
+func (s *SerialServer) CloseRemovedPort(portName string) {
+	session := s.manager.GetSession(portName)
+	if session == nil {
+		return
 	}
 
-	return &pb.WriteResponse{
-		Success:      true,
-		BytesWritten: uint32(n),
-		Message:      "data written successfully",
-	}, nil
+	s.stopReader(portName)
+	if err := s.manager.ClosePortWithReason(portName, session.ID, serial.CloseReasonDeviceRemoved); err != nil {
+		s.logger.Debug("failed to close port of removed device", "port", portName, "session", session.ID, "error", err)
+		return
+	}
+	s.logger.Warn("port closed, device removed", "port", portName, "session", session.ID, "client_id", session.ClientID)
 }
 
-// Read reads data from a port
-func (s *SerialServer) Read(ctx context.Context, req *pb.ReadRequest) (*pb.ReadResponse, error) {
+// GetPortStatus returns the status of a port
+func (s *SerialServer) GetPortStatus(ctx context.Context, req *pb.GetPortStatusRequest) (*pb.GetPortStatusResponse, error) {
 	if req.PortName == "" {
 		return nil, status.Error(codes.InvalidArgument, "port_name is required")
 	}
-	if req.SessionId == "" {
-		return nil, status.Error(codes.InvalidArgument, "session_id is required")
-	}
-
-	maxBytes := int(req.MaxBytes)
-	if maxBytes <= 0 {
-		maxBytes = 1024
+	if err := s.checkAccess(ctx, req.PortName, ""); err != nil {
+		return nil, err
 	}
 
-	var data []byte
-	var err error
-
-	if req.TimeoutMs > 0 {
-		result := serial.ReadWithTimeout(s.manager, req.PortName, req.SessionId, maxBytes, time.Duration(req.TimeoutMs)*time.Millisecond)
-		data = result.Data
-		err = result.Error
-	} else {
-		data, err = s.manager.Read(req.PortName, req.SessionId, maxBytes)
+	portStatus, err := s.portStatus(ctx, req.PortName)
+	if err != nil {
+		return nil, err
 	}
+	return &pb.GetPortStatusResponse{Status: portStatus}, nil
+}
 
+// portStatus returns the current status of a port; the session ID is
+// left out unless the caller may see it
+func (s *SerialServer) portStatus(ctx context.Context, portName string) (*pb.PortStatus, error) {
+	session, err := s.manager.GetStatus(portName)
 	if err != nil {
-		return &pb.ReadResponse{
-			Success: false,
-			Message: err.Error(),
-		}, nil
+		if err == serial.ErrPortNotOpen {
+			portStatus := &pb.PortStatus{
+				PortName: portName,
+				IsOpen:   false,
+			}
+			// Until the port is opened again, tell how its last session ended
+			if closed, ok := s.manager.LastClose(portName); ok {
+				portStatus.CloseReason = convertCloseReason(closed.Reason)
+				portStatus.ClosedAt = closed.ClosedAt.UnixNano()
+			}
+			return portStatus, nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get port status: %v", err)
 	}
 
-	return &pb.ReadResponse{
-		Success:   true,
-		Data:      data,
-		BytesRead: uint32(len(data)),
-		Message:   "data read successfully",
-	}, nil
+	portStatus := &pb.PortStatus{
+		PortName:      session.PortName,
+		IsOpen:        true,
+		IsLocked:      session.Exclusive,
+		LockedBy:      session.ClientID,
+		CurrentConfig: s.convertFromSerialConfig(session.Config),
+		Priority:      convertPriorityBack(session.Priority()),
+		PowerState:    convertPowerStateBack(session.PowerState()),
+		Metadata:      session.Metadata,
+		Shaping:       convertShapingBack(session.Shaping()),
+		Statistics: &pb.PortStatistics{
+			BytesSent:     session.Statistics.BytesSent,
+			BytesReceived: session.Statistics.BytesReceived,
+			Errors:        session.Statistics.Errors,
+			OpenedAt:      session.Statistics.OpenedAt.Unix(),
+			LastActivity:  session.Statistics.LastActivity.Unix(),
+			GarbageBytes:  session.Statistics.GarbageBytes,
+			BreakCount:    session.Statistics.BreakCount,
+			LineQuality:   session.Statistics.LineQuality,
+		},
+	}
+	if s.visibleSessionID(ctx, session.ID) != "" {
+		portStatus.SessionId = session.ID
+		portStatus.ShortSessionId = session.ShortID
+	}
+	return portStatus, nil
 }
 
-// ============================================================================
-// Streaming
-// ============================================================================
-
-// StreamRead streams data from a port
-func (s *SerialServer) StreamRead(req *pb.StreamReadRequest, stream pb.SerialService_StreamReadServer) error {
+// StreamPortStatus sends the status of a port when the stream starts, on
+// every event of the port and every interval; with on_change, interval
+// snapshots are only sent when the status changed
+func (s *SerialServer) StreamPortStatus(req *pb.StreamPortStatusRequest, stream pb.SerialService_StreamPortStatusServer) error {
 	if req.PortName == "" {
 		return status.Error(codes.InvalidArgument, "port_name is required")
 	}
-	if req.SessionId == "" {
-		return status.Error(codes.InvalidArgument, "session_id is required")
+	if err := s.checkAccess(stream.Context(), req.PortName, ""); err != nil {
+		return err
 	}
-
-	chunkSize := int(req.ChunkSize)
-	if chunkSize <= 0 {
-		chunkSize = 1024
+	interval := defaultStatusInterval
+	if req.IntervalMs > 0 {
+		interval = max(time.Duration(req.IntervalMs)*time.Millisecond, minStatusInterval)
 	}
 
-	reader := serial.NewReader(s.manager, req.PortName, req.SessionId, chunkSize)
-
-	s.readersMu.Lock()
-	s.readers[req.PortName] = reader
-	s.readersMu.Unlock()
+	// Subscribe before the first snapshot so no change is missed
+	events := s.manager.SubscribeEvents()
+	defer s.manager.UnsubscribeEvents(events)
 
-	if err := reader.Start(stream.Context()); err != nil {
-		return status.Errorf(codes.Internal, "failed to start reader: %v", err)
+	var last *pb.PortStatus
+	send := func(always bool) error {
+		current, err := s.portStatus(stream.Context(), req.PortName)
+		if err != nil {
+			return err
+		}
+		if !always && last != nil && !portStatusChanged(last, current) {
+			return nil
+		}
+		last = current
+		return stream.Send(&pb.StreamPortStatusResponse{
+			Status:    current,
+			Timestamp: time.Now().UnixNano(),
+		})
+	}
+	if err := send(true); err != nil {
+		return err
 	}
-	defer func() {
-		reader.Stop()
-		s.readersMu.Lock()
-		delete(s.readers, req.PortName)
-		s.readersMu.Unlock()
-	}()
-
-	subscription := reader.Subscribe()
 
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 	for {
+		var err error
 		select {
 		case <-stream.Context().Done():
 			return nil
-		case event, ok := <-subscription:
+		case event, ok := <-events:
 			if !ok {
 				return nil
 			}
-
-			if event.Error != nil {
-				if event.Error == serial.ErrPortClosed {
-					return nil
-				}
+			if event.PortName != req.PortName {
 				continue
 			}
+			err = send(true)
+		case <-ticker.C:
+			err = send(!req.OnChange)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
 
-			chunk := &pb.DataChunk{
+// GetRecentErrors returns the latest failed reads and writes of a session,
+// oldest first, so clients can debug flaky behavior without the agent log
+func (s *SerialServer) GetRecentErrors(ctx context.Context, req *pb.GetRecentErrorsRequest) (*pb.GetRecentErrorsResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+
+	session, records, err := s.manager.RecentErrors(req.PortName, req.SessionId, int(req.Limit))
+	if err != nil {
+		if errors.Is(err, serial.ErrInvalidSession) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Errorf(codes.NotFound, "port not open: %v", err)
+	}
+
+	resp := &pb.GetRecentErrorsResponse{
+		PortName:  session.PortName,
+		SessionId: session.ID,
+		Errors:    make([]*pb.ErrorRecord, 0, len(records)),
+	}
+	for _, record := range records {
+		resp.Errors = append(resp.Errors, &pb.ErrorRecord{
+			Timestamp:  record.Timestamp.UnixNano(),
+			Operation:  record.Operation,
+			ErrorClass: record.Class,
+			Message:    record.Message,
+		})
+	}
+	return resp, nil
+}
+
+// SetPowerState makes a session dormant or wakes it. A dormant session is
+// not read or streamed until the client next uses it, it is woken, or data
+// matching a wake pattern arrives.
+func (s *SerialServer) SetPowerState(ctx context.Context, req *pb.SetPowerStateRequest) (*pb.SetPowerStateResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+
+	var err error
+	switch req.State {
+	case pb.PowerState_POWER_STATE_DORMANT:
+		patterns := make([]*regexp.Regexp, 0, len(req.WakePatterns))
+		for _, p := range req.WakePatterns {
+			pattern, compileErr := regexp.Compile(p)
+			if compileErr != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid wake pattern %q: %v", p, compileErr)
+			}
+			patterns = append(patterns, pattern)
+		}
+		err = s.manager.SetDormant(req.PortName, req.SessionId, patterns)
+	case pb.PowerState_POWER_STATE_ACTIVE:
+		if len(req.WakePatterns) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "wake_patterns only apply to dormant sessions")
+		}
+		err = s.manager.Wake(req.PortName, req.SessionId)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "state must be active or dormant")
+	}
+	if err != nil {
+		if errors.Is(err, serial.ErrInvalidSession) || errors.Is(err, serial.ErrPortNotOpen) || errors.Is(err, serial.ErrPortClosed) {
+			return &pb.SetPowerStateResponse{Message: err.Error()}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to set power state: %v", err)
+	}
+
+	session := s.manager.GetSessionByID(req.SessionId)
+	if session == nil {
+		return &pb.SetPowerStateResponse{Message: serial.ErrPortClosed.Error()}, nil
+	}
+	state := session.PowerState()
+	s.logger.Info("session power state changed", "port", req.PortName, "session", req.SessionId, "state", state, "wake_patterns", len(req.WakePatterns))
+	return &pb.SetPowerStateResponse{
+		State:   convertPowerStateBack(state),
+		Message: "session is " + state.String(),
+	}, nil
+}
+
+// SetShaping throttles a session's traffic to emulate a slow link. A
+// missing or zero shaping lifts the limits.
+func (s *SerialServer) SetShaping(ctx context.Context, req *pb.SetShapingRequest) (*pb.SetShapingResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+
+	shaping := convertShaping(req.Shaping)
+	if err := s.manager.SetShaping(req.PortName, req.SessionId, shaping); err != nil {
+		if errors.Is(err, serial.ErrInvalidConfig) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, serial.ErrInvalidSession) || errors.Is(err, serial.ErrPortNotOpen) || errors.Is(err, serial.ErrPortClosed) {
+			return &pb.SetShapingResponse{Success: false, Message: err.Error()}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to set shaping: %v", err)
+	}
+
+	s.logger.Info("session shaping changed", "port", req.PortName, "session", req.SessionId,
+		"rx_bytes_per_sec", shaping.RxBytesPerSec, "tx_bytes_per_sec", shaping.TxBytesPerSec)
+
+	message := "traffic is unlimited"
+	if shaping.Enabled() {
+		message = "traffic is shaped"
+	}
+	return &pb.SetShapingResponse{
+		Success: true,
+		Message: message,
+		Shaping: convertShapingBack(shaping),
+	}, nil
+}
+
+// ============================================================================
+// Data Transfer
+// ============================================================================
+// Write writes data to a port
+func (s *SerialServer) Write(ctx context.Context, req *pb.WriteRequest) (*pb.WriteResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if req.TimeoutMs > 0 && req.ExecuteAt > 0 {
+		return nil, status.Error(codes.InvalidArgument, "timeout_ms cannot be combined with execute_at")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+
+	reason, err := s.checkWrite(ctx, req.PortName, req.Data)
+	if err != nil {
+		return nil, err
+	}
+	if req.DryRun {
+		return s.dryRunWrite(req, reason), nil
+	}
+	if reason != "" {
+		// Only hold writes the requester could have sent itself
+		if _, err := s.manager.ValidateSession(req.PortName, req.SessionId); err != nil {
+			return &pb.WriteResponse{
+				Success: false,
+				Message: err.Error(),
+			}, nil
+		}
+		pending := s.writes.Hold(req.PortName, req.SessionId, req.Data, reason, s.clientIdentity(ctx))
+		return &pb.WriteResponse{
+			Success:    false,
+			Message:    "write held for approval: " + reason,
+			ApprovalId: pending.ID,
+		}, nil
+	}
+
+	var n int
+	sentAt := time.Now()
+	if req.ExecuteAt > 0 {
+		// Scheduled write: hold the request until the trigger time
+		n, sentAt, err = s.manager.WriteAt(ctx, req.PortName, req.SessionId, req.Data, time.Unix(0, req.ExecuteAt))
+	} else if req.TimeoutMs > 0 {
+		n, err = s.manager.WriteWithin(req.PortName, req.SessionId, req.Data, time.Duration(req.TimeoutMs)*time.Millisecond)
+	} else {
+		n, err = s.manager.Write(req.PortName, req.SessionId, req.Data)
+	}
+	if err != nil {
+		return &pb.WriteResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	if req.Flush {
+		_ = s.manager.Flush(req.PortName, req.SessionId)
+	}
+
+	return &pb.WriteResponse{
+		Success:      true,
+		BytesWritten: uint32(n),
+		Message:      "data written successfully",
+		SentAt:       sentAt.UnixNano(),
+	}, nil
+}
+
+// dryRunWrite checks a write as Write would and reports the bytes it would
+// send, without touching the port
+func (s *SerialServer) dryRunWrite(req *pb.WriteRequest, approvalReason string) *pb.WriteResponse {
+	if _, err := s.manager.ValidateSession(req.PortName, req.SessionId); err != nil {
+		return &pb.WriteResponse{
+			Success: false,
+			Message: err.Error(),
+		}
+	}
+	if req.ExecuteAt > 0 {
+		if err := serial.ValidateSchedule(time.Unix(0, req.ExecuteAt)); err != nil {
+			return &pb.WriteResponse{
+				Success: false,
+				Message: err.Error(),
+			}
+		}
+	}
+
+	message := fmt.Sprintf("dry run: %d bytes would be written", len(req.Data))
+	if approvalReason != "" {
+		message = "dry run: write would be held for approval: " + approvalReason
+	}
+	return &pb.WriteResponse{
+		Success:  true,
+		Message:  message,
+		WireData: req.Data,
+	}
+}
+
+// SynchronizedWrite sends payloads to several ports at the same instant
+func (s *SerialServer) SynchronizedWrite(ctx context.Context, req *pb.SynchronizedWriteRequest) (*pb.SynchronizedWriteResponse, error) {
+	if len(req.Writes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "writes is required")
+	}
+
+	writes := make([]serial.SyncWrite, 0, len(req.Writes))
+	for _, w := range req.Writes {
+		if w.PortName == "" || w.SessionId == "" {
+			return nil, status.Error(codes.InvalidArgument, "port_name and session_id are required for every write")
+		}
+		if err := s.checkSessionAccess(ctx, w.PortName); err != nil {
+			return nil, err
+		}
+		if err := s.checkUnheldWrite(ctx, w.PortName, w.Data); err != nil {
+			return nil, err
+		}
+		writes = append(writes, serial.SyncWrite{PortName: w.PortName, SessionID: w.SessionId, Data: w.Data})
+	}
+
+	if req.DryRun {
+		if err := s.manager.ValidateSynchronizedWrite(writes); err != nil {
+			return &pb.SynchronizedWriteResponse{
+				Success: false,
+				Message: err.Error(),
+			}, nil
+		}
+		resp := &pb.SynchronizedWriteResponse{
+			Success: true,
+			Message: "dry run: nothing was written",
+			Results: make([]*pb.SyncWriteResult, 0, len(writes)),
+		}
+		for _, w := range writes {
+			resp.Results = append(resp.Results, &pb.SyncWriteResult{PortName: w.PortName, WireData: w.Data})
+		}
+		return resp, nil
+	}
+
+	results, err := s.manager.SynchronizedWrite(writes)
+	if err != nil {
+		return &pb.SynchronizedWriteResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &pb.SynchronizedWriteResponse{
+		Success: true,
+		Message: "data written successfully",
+		Results: make([]*pb.SyncWriteResult, 0, len(results)),
+	}
+
+	var first, last time.Time
+	for _, r := range results {
+		result := &pb.SyncWriteResult{
+			PortName:     r.PortName,
+			BytesWritten: uint32(r.BytesWritten),
+			StartedAt:    r.StartedAt.UnixNano(),
+			CompletedAt:  r.CompletedAt.UnixNano(),
+		}
+		if r.Error != nil {
+			result.Error = r.Error.Error()
+			resp.Success = false
+			resp.Message = "one or more writes failed"
+		}
+		resp.Results = append(resp.Results, result)
+
+		if first.IsZero() || r.StartedAt.Before(first) {
+			first = r.StartedAt
+		}
+		if r.StartedAt.After(last) {
+			last = r.StartedAt
+		}
+	}
+	resp.SkewNs = last.Sub(first).Nanoseconds()
+
+	return resp, nil
+}
+
+// Read reads data from a port
+func (s *SerialServer) Read(ctx context.Context, req *pb.ReadRequest) (*pb.ReadResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkAgentReaders(req.PortName); err != nil {
+		return nil, err
+	}
+
+	maxBytes := int(req.MaxBytes)
+	if maxBytes <= 0 {
+		maxBytes = 1024
+	}
+
+	// A timeout applies to this read only; the session keeps its own
+	data, err := s.manager.ReadWithin(req.PortName, req.SessionId, maxBytes, time.Duration(req.TimeoutMs)*time.Millisecond)
+	if err != nil {
+		return &pb.ReadResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.ReadResponse{
+		Success:   true,
+		Data:      data,
+		BytesRead: uint32(len(data)),
+		Message:   "data read successfully",
+	}, nil
+}
+
+// ============================================================================
+// Write Policy
+// ============================================================================
+
+// ListPendingWrites returns the writes held for approval
+func (s *SerialServer) ListPendingWrites(ctx context.Context, req *pb.ListPendingWritesRequest) (*pb.ListPendingWritesResponse, error) {
+	if s.writes == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no write policies are configured")
+	}
+
+	visible := s.visiblePorts(ctx)
+	pending := s.writes.List()
+	resp := &pb.ListPendingWritesResponse{Writes: make([]*pb.PendingWrite, 0, len(pending))}
+	for _, p := range pending {
+		if !visible(p.PortName) {
+			continue
+		}
+		write := convertPendingWrite(p)
+		write.SessionId = s.visibleSessionID(ctx, p.SessionID)
+		resp.Writes = append(resp.Writes, write)
+	}
+	return resp, nil
+}
+
+// ApproveWrite sends a held write on behalf of its requester. The approver
+// must be a different client.
+func (s *SerialServer) ApproveWrite(ctx context.Context, req *pb.ApproveWriteRequest) (*pb.ApproveWriteResponse, error) {
+	if s.writes == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no write policies are configured")
+	}
+	if req.ApprovalId == "" {
+		return nil, status.Error(codes.InvalidArgument, "approval_id is required")
+	}
+
+	pending, err := s.writes.Approve(req.ApprovalId, s.clientIdentity(ctx))
+	if err != nil {
+		return nil, approvalError(req.ApprovalId, err)
+	}
+
+	sentAt := time.Now()
+	n, err := s.manager.Write(pending.PortName, pending.SessionID, pending.Data)
+	if err != nil {
+		return &pb.ApproveWriteResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+	return &pb.ApproveWriteResponse{
+		Success:      true,
+		BytesWritten: uint32(n),
+		Message:      "approved write sent",
+		SentAt:       sentAt.UnixNano(),
+	}, nil
+}
+
+// RejectWrite discards a held write
+func (s *SerialServer) RejectWrite(ctx context.Context, req *pb.RejectWriteRequest) (*pb.RejectWriteResponse, error) {
+	if s.writes == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no write policies are configured")
+	}
+	if req.ApprovalId == "" {
+		return nil, status.Error(codes.InvalidArgument, "approval_id is required")
+	}
+
+	pending, err := s.writes.Reject(req.ApprovalId, s.clientIdentity(ctx))
+	if err != nil {
+		return nil, approvalError(req.ApprovalId, err)
+	}
+	return &pb.RejectWriteResponse{Write: convertPendingWrite(pending)}, nil
+}
+
+// checkWrite applies the port's write policy to a payload. It returns the
+// reason when the payload needs approval, or an error when it is denied.
+func (s *SerialServer) checkWrite(ctx context.Context, portName string, data []byte) (string, error) {
+	if s.writes == nil {
+		return "", nil
+	}
+	switch verdict, reason := s.writes.Check(portName, data, ClientAddress(ctx)); verdict {
+	case writepolicy.Denied:
+		return "", status.Errorf(codes.PermissionDenied, "write to %s denied: %s", portName, reason)
+	case writepolicy.NeedsApproval:
+		return reason, nil
+	}
+	return "", nil
+}
+
+// checkUnheldWrite applies the port's write policy where writes cannot be
+// held for approval; payloads needing approval must be sent with Write
+func (s *SerialServer) checkUnheldWrite(ctx context.Context, portName string, data []byte) error {
+	reason, err := s.checkWrite(ctx, portName, data)
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		return status.Errorf(codes.FailedPrecondition, "write to %s needs approval (%s); send it with Write", portName, reason)
+	}
+	return nil
+}
+
+// clientIdentity identifies the caller for write approvals
+func (s *SerialServer) clientIdentity(ctx context.Context) string {
+	return ClientIdentity(ctx, s.config.TLS.Enabled && s.config.TLS.SPIFFE.Enabled)
+}
+
+// approvalError converts an approval failure to a gRPC status
+func approvalError(id string, err error) error {
+	switch {
+	case errors.Is(err, writepolicy.ErrNotFound):
+		return status.Errorf(codes.NotFound, "pending write %q not found or expired", id)
+	case errors.Is(err, writepolicy.ErrSelfApproval), errors.Is(err, writepolicy.ErrNotApprover):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Errorf(codes.Internal, "failed to decide pending write: %v", err)
+}
+
+// ============================================================================
+// Reservations
+// ============================================================================
+
+// CreateReservation books a port the caller may use for a time window. The
+// holder defaults to the caller's identity and must be one of the caller's
+// identities or groups; administrators may book for anyone.
+func (s *SerialServer) CreateReservation(ctx context.Context, req *pb.CreateReservationRequest) (*pb.CreateReservationResponse, error) {
+	if s.bookings == nil {
+		return nil, status.Error(codes.FailedPrecondition, "reservations are not enabled")
+	}
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if err := s.checkAccess(ctx, req.PortName, ""); err != nil {
+		return nil, err
+	}
+
+	identity := s.clientIdentity(ctx)
+	holder := req.Holder
+	if holder == "" {
+		holder = identity
+	}
+	if !slices.Contains(s.callerIdentities(ctx, ""), holder) && !s.isAdmin(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s may not book %s for %s", identity, req.PortName, holder)
+	}
+
+	r, err := s.bookings.Create(reservation.Reservation{
+		PortName:  req.PortName,
+		Holder:    holder,
+		Start:     time.Unix(0, req.StartTime),
+		End:       time.Unix(0, req.EndTime),
+		Note:      req.Note,
+		CreatedBy: identity,
+	})
+	if err != nil {
+		return nil, reservationError(req.PortName, err)
+	}
+	return &pb.CreateReservationResponse{Reservation: convertReservation(r, time.Now())}, nil
+}
+
+// ListReservations returns the current and upcoming reservations of the
+// ports the caller may see
+func (s *SerialServer) ListReservations(ctx context.Context, req *pb.ListReservationsRequest) (*pb.ListReservationsResponse, error) {
+	if s.bookings == nil {
+		return nil, status.Error(codes.FailedPrecondition, "reservations are not enabled")
+	}
+
+	now := time.Now()
+	visible := s.visiblePorts(ctx)
+	reservations := s.bookings.List(req.PortName)
+	resp := &pb.ListReservationsResponse{Reservations: make([]*pb.Reservation, 0, len(reservations))}
+	for _, r := range reservations {
+		if !visible(r.PortName) {
+			continue
+		}
+		resp.Reservations = append(resp.Reservations, convertReservation(r, now))
+	}
+	return resp, nil
+}
+
+// CancelReservation removes a reservation on behalf of its holder or creator
+func (s *SerialServer) CancelReservation(ctx context.Context, req *pb.CancelReservationRequest) (*pb.CancelReservationResponse, error) {
+	if s.bookings == nil {
+		return nil, status.Error(codes.FailedPrecondition, "reservations are not enabled")
+	}
+	if req.ReservationId == "" {
+		return nil, status.Error(codes.InvalidArgument, "reservation_id is required")
+	}
+
+	r, err := s.bookings.Cancel(req.ReservationId, s.callerIdentities(ctx, "")...)
+	if err != nil {
+		return nil, reservationError(req.ReservationId, err)
+	}
+	return &pb.CancelReservationResponse{Reservation: convertReservation(r, time.Now())}, nil
+}
+
+// EnforceReservation closes a port opened by anyone but the holder when its
+// reservation window starts
+func (s *SerialServer) EnforceReservation(r reservation.Reservation) {
+	session := s.manager.GetSession(r.PortName)
+	if session == nil || r.HeldBy(session.OwnerIdentities()...) {
+		return
+	}
+
+	s.stopReader(r.PortName)
+	if err := s.manager.ClosePortWithReason(r.PortName, session.ID, serial.CloseReasonForced); err != nil {
+		s.logger.Warn("failed to close port for reservation", "port", r.PortName, "session", session.ID, "error", err)
+		return
+	}
+	s.logger.Info("port closed for reservation", "port", r.PortName, "session", session.ID, "owner", session.Owner(), "holder", r.Holder)
+}
+
+// checkReservation refuses to open a port reserved by another client. Only
+// the caller's authenticated identity and groups can hold a reservation.
+func (s *SerialServer) checkReservation(ctx context.Context, portName string) error {
+	if s.bookings == nil {
+		return nil
+	}
+	r, ok := s.bookings.Active(portName, time.Now())
+	if !ok || r.HeldBy(s.callerIdentities(ctx, "")...) {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "%s is reserved by %s until %s", portName, r.Holder, r.End.Format(time.RFC3339))
+}
+
+// clientIDPrefix marks client IDs among a caller's identities. Clients
+// choose their IDs, so rules naming one must do so explicitly and are only
+// as strong as the clients' honesty.
+const clientIDPrefix = "client:"
+
+// callerIdentities lists the names a caller is known by to reservations
+// and the access policy: its authenticated identity, its groups as
+// "group:NAME" and the client ID it sent as "client:ID"
+func (s *SerialServer) callerIdentities(ctx context.Context, clientID string) []string {
+	identities := append([]string{s.clientIdentity(ctx)}, ClientGroups(ctx)...)
+	if clientID != "" {
+		identities = append(identities, clientIDPrefix+clientID)
+	}
+	return identities
+}
+
+// isAdmin reports whether the caller is one of auth.admins, the one list
+// guarding every administrative call. Nobody is when it is empty, and
+// access tokens never are, whatever their name.
+func (s *SerialServer) isAdmin(ctx context.Context) bool {
+	if caller, ok := AuthIdentity(ctx); ok && caller.Scope != nil {
+		return false
+	}
+	return slices.ContainsFunc(s.callerIdentities(ctx, ""), func(id string) bool {
+		return slices.Contains(s.config.Auth.Admins, id)
+	})
+}
+
+// visibleSessionID returns the ID of a session as the caller may see it.
+// Holding a session ID is enough to use the session, so those of open
+// sessions are only shown to their opener and to administrators.
+func (s *SerialServer) visibleSessionID(ctx context.Context, sessionID string) string {
+	session := s.manager.GetSessionByID(sessionID)
+	if session == nil || session.Owner() == s.clientIdentity(ctx) || s.isAdmin(ctx) {
+		return sessionID
+	}
+	return ""
+}
+
+// visiblePorts returns whether the access policy lets the caller see a
+// port, for listings across ports
+func (s *SerialServer) visiblePorts(ctx context.Context) func(portName string) bool {
+	if s.access == nil {
+		return func(string) bool { return true }
+	}
+	identities := s.callerIdentities(ctx, "")
+	return func(portName string) bool { return s.access.Allowed(portName, identities...) }
+}
+
+// reservationError converts a reservation failure to a gRPC status
+func reservationError(subject string, err error) error {
+	switch {
+	case errors.Is(err, reservation.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, reservation.ErrConflict):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, reservation.ErrNotFound):
+		return status.Errorf(codes.NotFound, "reservation %q not found or expired", subject)
+	case errors.Is(err, reservation.ErrNotHolder):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Errorf(codes.Internal, "reservation failed: %v", err)
+}
+
+// ============================================================================
+// Access Control
+// ============================================================================
+
+// checkAccess refuses callers the access policy does not allow on a port.
+// Access tokens are limited to their port instead, which the issuer was
+// allowed to use when minting them.
+func (s *SerialServer) checkAccess(ctx context.Context, portName, clientID string) error {
+	if identity, ok := AuthIdentity(ctx); ok && identity.Scope != nil {
+		if identity.Scope.Port != portName {
+			return status.Errorf(codes.PermissionDenied, "%s is limited to %s", identity.Name, identity.Scope.Port)
+		}
+		return nil
+	}
+	if s.access == nil {
+		return nil
+	}
+	identities := s.callerIdentities(ctx, clientID)
+	if s.access.Allowed(portName, identities...) {
+		return nil
+	}
+	s.logger.Warn("port access denied", "port", portName, "identities", identities, "client", ClientAddress(ctx))
+	return status.Errorf(codes.PermissionDenied, "%s may not use %s", identities[0], portName)
+}
+
+// checkSessionAccess applies the access policy to a call on an open
+// session. A session ID grants nothing by itself: the caller must be
+// allowed the port under its own identities.
+func (s *SerialServer) checkSessionAccess(ctx context.Context, portName string) error {
+	return s.checkAccess(ctx, portName, "")
+}
+
+// CreateAccessToken mints a short-lived token limited to one port,
+// read-only unless asked otherwise, to embed in browser dashboards without
+// handing out long-lived credentials
+func (s *SerialServer) CreateAccessToken(ctx context.Context, req *pb.CreateAccessTokenRequest) (*pb.CreateAccessTokenResponse, error) {
+	token, claims, err := s.issueAccessToken(ctx, "access tokens", req.Name, req.TtlSeconds, auth.AccessClaims{
+		Port:     req.PortName,
+		ReadOnly: !req.AllowWrite,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("access token issued", "name", claims.Name, "port", claims.Port, "read_only", claims.ReadOnly,
+		"ttl", time.Duration(claims.ExpiresAt-claims.IssuedAt)*time.Second, "issuer", claims.Issuer)
+	return &pb.CreateAccessTokenResponse{
+		Token:     token,
+		Identity:  "access:" + claims.Name,
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0).UnixNano(),
+	}, nil
+}
+
+// CreateStreamLink mints URLs of the HTTP event stream and the WebSocket
+// gateway that carry a token allowing nothing but following one port's
+// data, so a console feed can be shared with someone without an account.
+// A link made with once is accepted a single time.
+func (s *SerialServer) CreateStreamLink(ctx context.Context, req *pb.CreateStreamLinkRequest) (*pb.CreateStreamLinkResponse, error) {
+	server := s.config.Server
+	if !server.HTTPEnabled && !server.WebSocketEnabled {
+		return nil, status.Error(codes.FailedPrecondition, "stream links require server.http_enabled or server.websocket_enabled")
+	}
+	claims := auth.AccessClaims{Port: req.PortName, ReadOnly: true, Stream: true}
+	if req.Once {
+		id, err := auth.NewTokenID()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to mint stream link: %v", err)
+		}
+		claims.ID = id
+	}
+	token, claims, err := s.issueAccessToken(ctx, "stream links", req.Name, req.TtlSeconds, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	host := s.config.Auth.AccessTokens.LinkHost
+	if host == "" {
+		host = "localhost"
+		if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(":authority")) > 0 {
+			authority := md.Get(":authority")[0]
+			if h, _, err := net.SplitHostPort(authority); err == nil {
+				authority = h
+			}
+			if authority != "" {
+				host = strings.Trim(authority, "[]")
+			}
+		}
+	}
+	// Port names hold slashes, so paths are given escaped
+	link := func(scheme, address, path string) string {
+		_, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return ""
+		}
+		if s.config.TLS.Enabled {
+			scheme += "s"
+		}
+		return scheme + "://" + net.JoinHostPort(host, port) + path + "?" +
+			url.Values{tokenQueryParameter: {token}}.Encode()
+	}
+
+	resp := &pb.CreateStreamLinkResponse{
+		Token:     token,
+		Identity:  "access:" + claims.Name,
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0).UnixNano(),
+	}
+	if server.HTTPEnabled {
+		resp.EventsUrl = link("http", server.HTTPAddress, "/v1/ports/"+url.PathEscape(claims.Port)+"/events")
+	}
+	if server.WebSocketEnabled {
+		resp.WebsocketUrl = link("ws", server.WebSocketAddress, "/v1/ws")
+	}
+	s.logger.Info("stream link issued", "name", claims.Name, "port", claims.Port, "once", req.Once,
+		"ttl", time.Duration(claims.ExpiresAt-claims.IssuedAt)*time.Second, "issuer", claims.Issuer)
+	return resp, nil
+}
+
+// issueAccessToken signs claims for a port after checking the caller may
+// mint kind, filling in the issuer, the holder's name (default: the
+// issuer) and the lifetime
+func (s *SerialServer) issueAccessToken(ctx context.Context, kind, name string, ttlSeconds uint32, claims auth.AccessClaims) (string, auth.AccessClaims, error) {
+	if s.tokens == nil {
+		return "", claims, status.Errorf(codes.FailedPrecondition, "%s require auth.enabled", kind)
+	}
+	if claims.Port == "" {
+		return "", claims, status.Error(codes.InvalidArgument, "port name is required")
+	}
+	identities := s.callerIdentities(ctx, "")
+	issuer := identities[0]
+	if caller, ok := AuthIdentity(ctx); ok && caller.Scope != nil {
+		return "", claims, status.Errorf(codes.PermissionDenied, "%s may not mint %s", issuer, kind)
+	}
+	if !slices.ContainsFunc(identities, func(id string) bool { return slices.Contains(s.config.Auth.AccessTokens.Issuers, id) }) {
+		s.logger.Warn("access token refused", "port", claims.Port, "identities", identities, "client", ClientAddress(ctx))
+		return "", claims, status.Errorf(codes.PermissionDenied, "%s may not mint %s (auth.access_tokens.issuers)", issuer, kind)
+	}
+	// Nobody hands out more than they may use themselves
+	if err := s.checkAccess(ctx, claims.Port, ""); err != nil {
+		return "", claims, err
+	}
+
+	maxTTL := time.Duration(s.config.Auth.AccessTokens.MaxSeconds) * time.Second
+	ttl := min(auth.DefaultAccessTokenTTL, maxTTL)
+	if ttlSeconds > 0 {
+		ttl = time.Duration(ttlSeconds) * time.Second
+	}
+	if ttl > maxTTL {
+		return "", claims, status.Errorf(codes.InvalidArgument, "token lifetime exceeds %s (auth.access_tokens.max_seconds)", maxTTL)
+	}
+	claims.Name = name
+	if claims.Name == "" {
+		claims.Name = issuer
+	}
+	claims.Issuer = issuer
+
+	now := time.Now()
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = now.Add(ttl).Unix()
+	token, err := s.tokens.Issue(claims)
+	if err != nil {
+		return "", claims, status.Errorf(codes.Internal, "failed to mint access token: %v", err)
+	}
+	return token, claims, nil
+}
+
+// ============================================================================
+// Usage Accounting
+// ============================================================================
+
+// GetUsageReport returns the cumulative use of ports per client
+func (s *SerialServer) GetUsageReport(ctx context.Context, req *pb.GetUsageReportRequest) (*pb.GetUsageReportResponse, error) {
+	if s.usage == nil {
+		return nil, status.Error(codes.FailedPrecondition, "usage accounting is not enabled")
+	}
+
+	records := s.usage.Report(req.ClientId, req.PortName)
+	resp := &pb.GetUsageReportResponse{
+		Records:     make([]*pb.UsageRecord, 0, len(records)),
+		Since:       s.usage.Since().UnixNano(),
+		GeneratedAt: time.Now().UnixNano(),
+	}
+	for _, r := range records {
+		resp.Records = append(resp.Records, &pb.UsageRecord{
+			ClientId:      r.ClientID,
+			PortName:      r.PortName,
+			Sessions:      r.Sessions,
+			OpenSeconds:   r.OpenTime.Seconds(),
+			BytesSent:     r.BytesSent,
+			BytesReceived: r.BytesReceived,
+			FirstUsed:     r.FirstUsed.UnixNano(),
+			LastUsed:      r.LastUsed.UnixNano(),
+		})
+	}
+	return resp, nil
+}
+
+// ============================================================================
+// Streaming
+// ============================================================================
+
+// StreamRead streams data from a port
+func (s *SerialServer) StreamRead(req *pb.StreamReadRequest, stream pb.SerialService_StreamReadServer) error {
+	if req.PortName == "" {
+		return status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(stream.Context(), req.PortName); err != nil {
+		return err
+	}
+
+	chunkSize := int(req.ChunkSize)
+	if chunkSize <= 0 {
+		chunkSize = 1024
+		if req.Chunking != pb.StreamChunking_STREAM_CHUNKING_UNSPECIFIED {
+			chunkSize = serial.DefaultBatchSize
+		}
+	}
+	match, err := convertStreamFilter(req.Filter)
+	if err != nil {
+		return err
+	}
+
+	reader := serial.NewReader(s.manager, req.PortName, req.SessionId, chunkSize)
+	reader.SetConsumer("StreamRead")
+
+	s.readersMu.Lock()
+	if s.capturing[req.PortName] {
+		s.readersMu.Unlock()
+		return status.Errorf(codes.FailedPrecondition, "a timing capture is reading %s", req.PortName)
+	}
+	if err := s.checkAgentReaders(req.PortName); err != nil {
+		s.readersMu.Unlock()
+		return err
+	}
+	s.readers[req.PortName] = reader
+	s.readersMu.Unlock()
+
+	if err := reader.Start(stream.Context()); err != nil {
+		return status.Errorf(codes.Internal, "failed to start reader: %v", err)
+	}
+	defer func() {
+		reader.Stop()
+		s.readersMu.Lock()
+		delete(s.readers, req.PortName)
+		s.readersMu.Unlock()
+	}()
+
+	// Streams run at their session's priority unless they ask otherwise
+	var subscription <-chan serial.DataEvent
+	if req.Priority == pb.SessionPriority_SESSION_PRIORITY_UNSPECIFIED {
+		subscription = reader.Subscribe()
+	} else {
+		subscription = reader.SubscribeWithPriority(convertPriority(req.Priority, serial.PriorityNormal))
+	}
+
+	if req.DedupLines || match != nil {
+		return s.streamLines(req, stream, subscription, match)
+	}
+	if req.Chunking != pb.StreamChunking_STREAM_CHUNKING_UNSPECIFIED {
+		return s.streamBatched(req, stream, subscription, serial.NewBatcher(convertChunking(req.Chunking), chunkSize))
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-subscription:
+			if !ok {
+				return nil
+			}
+
+			if event.Error != nil {
+				if event.Error == serial.ErrPortClosed {
+					return nil
+				}
+				continue
+			}
+
+			chunk := &pb.DataChunk{
+				PortName: req.PortName,
+				Data:     event.Data,
+				Sequence: event.Sequence,
+			}
+
+			if req.IncludeTimestamps {
+				chunk.Timestamp = event.Timestamp.UnixNano()
+			}
+
+			if err := stream.Send(&pb.StreamReadResponse{Chunk: chunk}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// streamBatched streams data in chunks grouped by the batcher: reads are
+// sent as they arrive at low data rates and merged at high ones
+func (s *SerialServer) streamBatched(req *pb.StreamReadRequest, stream pb.SerialService_StreamReadServer, subscription <-chan serial.DataEvent, batcher *serial.Batcher) error {
+	ticker := time.NewTicker(serial.BatchDelay / 2)
+	defer ticker.Stop()
+
+	throughput := batcher.Throughput()
+	send := func(events []serial.DataEvent) error {
+		if batcher.Throughput() != throughput {
+			throughput = batcher.Throughput()
+			s.logger.Debug("stream batching changed", "port", req.PortName, "batching", throughput, "rate", int(batcher.Rate()))
+		}
+		for _, event := range events {
+			chunk := &pb.DataChunk{
+				PortName: req.PortName,
+				Data:     event.Data,
+				Sequence: event.Sequence,
+			}
+			if req.IncludeTimestamps {
+				chunk.Timestamp = event.Timestamp.UnixNano()
+			}
+			if err := stream.Send(&pb.StreamReadResponse{Chunk: chunk}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case now := <-ticker.C:
+			if err := send(batcher.Tick(now)); err != nil {
+				return err
+			}
+		case event, ok := <-subscription:
+			if !ok {
+				return send(batcher.Flush())
+			}
+			if event.Error != nil {
+				if event.Error == serial.ErrPortClosed {
+					return send(batcher.Flush())
+				}
+				continue
+			}
+			if err := send(batcher.Add(event, time.Now())); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// streamLines streams data line by line, only the lines that match when
+// match is set and with runs of identical lines collapsed into one response
+// with a repeat count when the request asks for it
+func (s *SerialServer) streamLines(req *pb.StreamReadRequest, stream pb.SerialService_StreamReadServer, subscription <-chan serial.DataEvent, match func([]byte) bool) error {
+	lines := dedup.NewStream(lineHold)
+	if match != nil {
+		lines.Match(match)
+	}
+	if req.DedupLines {
+		lines.Collapse(dedupReportInterval)
+	}
+	ticker := time.NewTicker(lineHold / 2)
+	defer ticker.Stop()
+
+	var sequence uint32
+	send := func(events []dedup.Event) error {
+		for _, event := range events {
+			sequence++
+			chunk := &pb.DataChunk{
 				PortName: req.PortName,
-				Data:     event.Data,
-				Sequence: event.Sequence,
+				Data:     event.Line,
+				Sequence: sequence,
+			}
+			if req.IncludeTimestamps {
+				chunk.Timestamp = event.Time.UnixNano()
+			}
+			if err := stream.Send(&pb.StreamReadResponse{Chunk: chunk, RepeatCount: uint32(event.Repeats)}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case now := <-ticker.C:
+			if err := send(lines.Tick(now)); err != nil {
+				return err
+			}
+		case event, ok := <-subscription:
+			if !ok {
+				return send(lines.Flush())
+			}
+			if event.Error != nil {
+				if event.Error == serial.ErrPortClosed {
+					return send(lines.Flush())
+				}
+				continue
+			}
+			if err := send(lines.Write(event.Timestamp, event.Data)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// convertStreamFilter returns the predicate of a stream filter, nil when
+// it keeps everything
+func convertStreamFilter(filter *pb.StreamFilter) (func([]byte) bool, error) {
+	if filter == nil || filter.Pattern == "" && len(filter.Prefix) == 0 {
+		return nil, nil
+	}
+	var pattern *regexp.Regexp
+	if filter.Pattern != "" {
+		var err error
+		if pattern, err = regexp.Compile(filter.Pattern); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter pattern: %v", err)
+		}
+	}
+	prefix := filter.Prefix
+	return func(line []byte) bool {
+		return bytes.HasPrefix(line, prefix) && (pattern == nil || pattern.Match(line))
+	}, nil
+}
+
+// StreamTimedRead streams data with a timestamp per read system call, for
+// protocol analysis where inter-byte gaps matter
+func (s *SerialServer) StreamTimedRead(req *pb.StreamTimedReadRequest, stream pb.SerialService_StreamTimedReadServer) error {
+	if req.PortName == "" {
+		return status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(stream.Context(), req.PortName); err != nil {
+		return err
+	}
+	session, err := s.manager.ValidateSession(req.PortName, req.SessionId)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "invalid session: %v", err)
+	}
+
+	// Both would consume the data
+	s.readersMu.Lock()
+	if _, reading := s.readers[req.PortName]; reading || s.capturing[req.PortName] {
+		s.readersMu.Unlock()
+		return status.Errorf(codes.FailedPrecondition, "%s is already being streamed", req.PortName)
+	}
+	if err := s.checkAgentReaders(req.PortName); err != nil {
+		s.readersMu.Unlock()
+		return err
+	}
+	s.capturing[req.PortName] = true
+	s.readersMu.Unlock()
+	defer func() {
+		s.readersMu.Lock()
+		delete(s.capturing, req.PortName)
+		s.readersMu.Unlock()
+	}()
+
+	ctx := stream.Context()
+	if req.DurationMs > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(req.DurationMs)*time.Millisecond)
+		defer cancel()
+	}
+
+	charTime := session.Config.CharTime().Nanoseconds()
+	err = s.manager.CaptureTiming(ctx, req.PortName, req.SessionId, serial.TimingOptions{PerByte: req.PerByte}, func(read serial.TimedRead) error {
+		return stream.Send(&pb.StreamTimedReadResponse{Chunk: &pb.TimedChunk{
+			Data:        read.Data,
+			StartedAt:   read.Started.UnixNano(),
+			CompletedAt: read.Completed.UnixNano(),
+			GapNs:       read.Gap.Nanoseconds(),
+			Sequence:    read.Sequence,
+			CharTimeNs:  charTime,
+		}})
+	})
+	if err != nil && !errors.Is(err, serial.ErrPortClosed) && ctx.Err() == nil {
+		return status.Errorf(codes.Internal, "timing capture failed: %v", err)
+	}
+	return nil
+}
+
+// StreamWrite writes streaming data to a port
+func (s *SerialServer) StreamWrite(stream pb.SerialService_StreamWriteServer) error {
+	var totalBytes uint64
+	var chunksProcessed uint32
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&pb.StreamWriteResponse{
+				Success:           true,
+				TotalBytesWritten: totalBytes,
+				ChunksProcessed:   chunksProcessed,
+				Message:           "stream completed successfully",
+			})
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "receive error: %v", err)
+		}
+
+		// Get session for this port
+		session := s.manager.GetSession(chunk.GetChunk().PortName)
+		if session == nil {
+			return status.Error(codes.NotFound, "port not open")
+		}
+		if err := s.checkSessionAccess(stream.Context(), session.PortName); err != nil {
+			return err
+		}
+
+		if err := s.checkUnheldWrite(stream.Context(), chunk.GetChunk().PortName, chunk.GetChunk().Data); err != nil {
+			return err
+		}
+
+		n, err := s.manager.Write(chunk.GetChunk().PortName, session.ID, chunk.GetChunk().Data)
+		if err != nil {
+			return status.Errorf(codes.Internal, "write failed: %v", err)
+		}
+
+		atomic.AddUint64(&totalBytes, uint64(n))
+		atomic.AddUint32(&chunksProcessed, 1)
+	}
+}
+
+// BiDirectionalStream handles bidirectional streaming
+func (s *SerialServer) BiDirectionalStream(stream pb.SerialService_BiDirectionalStreamServer) error {
+	ctx := stream.Context()
+	errChan := make(chan error, 2)
+	var portName string
+	var sessionID string
+	var recorder atomic.Pointer[console.Recorder]
+	defer func() {
+		rec := recorder.Load()
+		if rec != nil {
+			s.activeRecordingsMu.Lock()
+			delete(s.activeRecordings, rec.Path())
+			s.activeRecordingsMu.Unlock()
+		}
+		if err := rec.Close(); err != nil {
+			s.logger.Warn("failed to finish session recording", "error", err)
+			return
+		}
+		if rec != nil && s.recording.Upload != nil {
+			s.recording.Upload(rec.Path())
+		}
+	}()
+
+	// Notices of the input guard are sent with the port output
+	notices := make(chan []byte, 8)
+
+	// Handle incoming writes in separate goroutine
+	go s.handleBiDirectionalWrites(stream, &portName, &sessionID, &recorder, notices, errChan)
+
+	// Wait for port to be set or error
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for portName == "" {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errChan:
+			return err
+		case <-ticker.C:
+			continue
+		}
+	}
+
+	if err := s.checkAgentReaders(portName); err != nil {
+		return err
+	}
+
+	// Create reader for outgoing data and handle reads
+	reader := serial.NewReader(s.manager, portName, sessionID, 1024)
+	reader.SetConsumer("BiDirectionalStream")
+	if err := reader.Start(ctx); err != nil {
+		return status.Errorf(codes.Internal, "failed to start reader: %v", err)
+	}
+	defer reader.Stop()
+
+	return s.handleBiDirectionalReads(stream, ctx, errChan, reader, portName, &recorder, notices)
+}
+
+// handleBiDirectionalWrites handles incoming writes from the client
+func (s *SerialServer) handleBiDirectionalWrites(
+	stream pb.SerialService_BiDirectionalStreamServer,
+	portName *string,
+	sessionID *string,
+	recorder *atomic.Pointer[console.Recorder],
+	notices chan<- []byte,
+	errChan chan error,
+) {
+	var screen *inputguard.Session
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			errChan <- nil
+			return
+		}
+		if err != nil {
+			errChan <- err
+			return
+		}
+
+		// Initialize port and session on first message
+		if *portName == "" {
+			*portName = chunk.GetChunk().PortName
+			session := s.manager.GetSession(*portName)
+			if session == nil {
+				errChan <- status.Error(codes.NotFound, "port not open")
+				return
+			}
+			if err := s.checkSessionAccess(stream.Context(), *portName); err != nil {
+				errChan <- err
+				return
+			}
+			*sessionID = session.ID
+			recorder.Store(s.startRecording(stream.Context(), *portName, *sessionID))
+			screen = s.inputs.Session(*portName)
+		}
+
+		data := chunk.GetChunk().Data
+		if screen != nil {
+			result := screen.Input(data)
+			for _, blocked := range result.Blocked {
+				s.logger.Warn("interactive input blocked", "port", *portName, "client", s.clientIdentity(stream.Context()), "input", blocked)
+			}
+			if len(result.Notice) > 0 {
+				select {
+				case notices <- result.Notice:
+				case <-stream.Context().Done():
+					return
+				}
+			}
+			data = result.Write
+			if len(data) == 0 {
+				continue
+			}
+		}
+
+		if err := s.checkUnheldWrite(stream.Context(), *portName, data); err != nil {
+			errChan <- err
+			return
+		}
+
+		recorder.Load().Input(data)
+
+		// Write data to the serial port
+		_, err = s.manager.Write(*portName, *sessionID, data)
+		if err != nil {
+			errChan <- status.Errorf(codes.Internal, "write failed: %v", err)
+			return
+		}
+	}
+}
+
+// handleBiDirectionalReads handles reading from the serial port and sending to client
+func (s *SerialServer) handleBiDirectionalReads(
+	stream pb.SerialService_BiDirectionalStreamServer,
+	ctx context.Context,
+	errChan chan error,
+	reader *serial.Reader,
+	portName string,
+	recorder *atomic.Pointer[console.Recorder],
+	notices <-chan []byte,
+) error {
+	subscription := reader.Subscribe()
+	var sequence uint32
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errChan:
+			return err
+		case notice := <-notices:
+			recorder.Load().Output(notice)
+			sequence++
+			chunk := &pb.DataChunk{
+				PortName:  portName,
+				Data:      notice,
+				Timestamp: time.Now().UnixNano(),
+				Sequence:  sequence,
+			}
+			if err := stream.Send(&pb.BiDirectionalStreamResponse{Chunk: chunk}); err != nil {
+				return err
+			}
+		case event, ok := <-subscription:
+			if !ok {
+				return nil
+			}
+			if event.Error != nil {
+				continue
+			}
+
+			recorder.Load().Output(event.Data)
+
+			sequence++
+			chunk := &pb.DataChunk{
+				PortName:  portName,
+				Data:      event.Data,
+				Timestamp: event.Timestamp.UnixNano(),
+				Sequence:  sequence,
+			}
+
+			if err := stream.Send(&pb.BiDirectionalStreamResponse{Chunk: chunk}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// startRecording creates an asciicast recording of an interactive session
+// when recording is always on or the client asked for it with the
+// x-seriallink-record metadata. Terminal size may be given as
+// x-seriallink-terminal-size ("COLSxROWS"). Returns nil when not recording.
+func (s *SerialServer) startRecording(ctx context.Context, portName, sessionID string) *console.Recorder {
+	if s.recording.Directory == "" {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	requested := false
+	if values := md.Get("x-seriallink-record"); len(values) > 0 {
+		requested, _ = strconv.ParseBool(values[0])
+	}
+	if !s.recording.Always && !requested {
+		return nil
+	}
+
+	width, height := 80, 24
+	if values := md.Get("x-seriallink-terminal-size"); len(values) > 0 {
+		var cols, rows int
+		if _, err := fmt.Sscanf(values[0], "%dx%d", &cols, &rows); err == nil && cols > 0 && rows > 0 {
+			width, height = cols, rows
+		}
+	}
+
+	user := s.clientIdentity(ctx)
+	path := console.RecordingPath(s.recording.Directory, user, portName, sessionID)
+	recorder, err := console.NewRecorder(path, user, portName, sessionID, width, height)
+	if err != nil {
+		s.logger.Warn("failed to start session recording", "port", portName, "error", err)
+		return nil
+	}
+	recorder.SetRedactor(s.redactor)
+
+	s.activeRecordingsMu.Lock()
+	s.activeRecordings[path] = true
+	s.activeRecordingsMu.Unlock()
+
+	s.logger.Info("recording interactive session", "port", portName, "user", user, "file", path)
+	return recorder
+}
+
+// StreamScans streams one event per barcode read from a scanner on a port
+func (s *SerialServer) StreamScans(req *pb.StreamScansRequest, stream pb.SerialService_StreamScansServer) error {
+	if req.PortName == "" {
+		return status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(stream.Context(), req.PortName); err != nil {
+		return err
+	}
+
+	framer := barcode.NewFramer(s.scannerProfile(req))
+
+	reader := serial.NewReader(s.manager, req.PortName, req.SessionId, 256)
+	reader.SetConsumer("StreamScans")
+
+	s.readersMu.Lock()
+	if err := s.checkAgentReaders(req.PortName); err != nil {
+		s.readersMu.Unlock()
+		return err
+	}
+	s.readers[req.PortName] = reader
+	s.readersMu.Unlock()
+
+	if err := reader.Start(stream.Context()); err != nil {
+		return status.Errorf(codes.Internal, "failed to start reader: %v", err)
+	}
+	defer func() {
+		reader.Stop()
+		s.readersMu.Lock()
+		delete(s.readers, req.PortName)
+		s.readersMu.Unlock()
+	}()
+
+	subscription := reader.Subscribe()
+
+	// Idle flushing for scanners without a terminator
+	var idle <-chan time.Time
+	var idleTimer *time.Timer
+	if framer.IdleTimeout() > 0 {
+		idleTimer = time.NewTimer(framer.IdleTimeout())
+		defer idleTimer.Stop()
+		idle = idleTimer.C
+	}
+
+	var sequence uint64
+	send := func(scan barcode.Scan) error {
+		sequence++
+		return stream.Send(&pb.StreamScansResponse{
+			Scan: &pb.ScanEvent{
+				PortName:  req.PortName,
+				Data:      scan.Data,
+				Timestamp: scan.Timestamp.UnixNano(),
+				Sequence:  sequence,
+			},
+		})
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-idle:
+			if scan, ok := framer.Flush(); ok {
+				if err := send(scan); err != nil {
+					return err
+				}
+			}
+		case event, ok := <-subscription:
+			if !ok {
+				return nil
+			}
+
+			if event.Error != nil {
+				if event.Error == serial.ErrPortClosed {
+					return nil
+				}
+				continue
+			}
+
+			for _, scan := range framer.Feed(event.Data, event.Timestamp) {
+				if err := send(scan); err != nil {
+					return err
+				}
+			}
+			if idleTimer != nil {
+				idleTimer.Reset(framer.IdleTimeout())
+			}
+		}
+	}
+}
+
+// scannerProfile returns the configured scanner profile of a port with the
+// request's overrides applied
+func (s *SerialServer) scannerProfile(req *pb.StreamScansRequest) barcode.Profile {
+	var profile barcode.Profile
+	for _, p := range s.config.Serial.ScannerProfiles {
+		if p.Port == req.PortName {
+			profile = p.ToProfile()
+			break
+		}
+	}
+
+	if req.Terminators != "" {
+		profile.Terminators = req.Terminators
+	}
+	if req.Prefix != "" {
+		profile.Prefix = req.Prefix
+	}
+	if req.Suffix != "" {
+		profile.Suffix = req.Suffix
+	}
+	if req.DebounceMs > 0 {
+		profile.Debounce = time.Duration(req.DebounceMs) * time.Millisecond
+	}
+	if req.IdleMs > 0 {
+		profile.IdleTimeout = time.Duration(req.IdleMs) * time.Millisecond
+	}
+	if req.MinLength > 0 {
+		profile.MinLength = int(req.MinLength)
+	}
+	return profile
+}
+
+// StreamAnnotated streams the frames a protocol decoder finds in the data
+// of a port, each with a description next to its raw bytes
+func (s *SerialServer) StreamAnnotated(req *pb.StreamAnnotatedRequest, stream pb.SerialService_StreamAnnotatedServer) error {
+	if req.PortName == "" {
+		return status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(stream.Context(), req.PortName); err != nil {
+		return err
+	}
+	session, err := s.manager.ValidateSession(req.PortName, req.SessionId)
+	if err != nil {
+		return status.Errorf(codes.FailedPrecondition, "invalid session: %v", err)
+	}
+
+	name, err := convertFrameDecoder(req.Decoder)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	opts := decode.Options{
+		Pattern:    req.Pattern,
+		Terminator: req.Terminator,
+		Gap:        time.Duration(req.GapMs) * time.Millisecond,
+	}
+	if name == decode.ModbusRTU && opts.Gap == 0 {
+		opts.Gap = decode.ModbusGap(session.Config.BaudRate)
+	}
+	decoder, err := decode.New(name, opts)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	reader := serial.NewReader(s.manager, req.PortName, req.SessionId, 256)
+	reader.SetConsumer("StreamAnnotated")
+
+	s.readersMu.Lock()
+	if err := s.checkAgentReaders(req.PortName); err != nil {
+		s.readersMu.Unlock()
+		return err
+	}
+	s.readers[req.PortName] = reader
+	s.readersMu.Unlock()
+
+	if err := reader.Start(stream.Context()); err != nil {
+		return status.Errorf(codes.Internal, "failed to start reader: %v", err)
+	}
+	defer func() {
+		reader.Stop()
+		s.readersMu.Lock()
+		delete(s.readers, req.PortName)
+		s.readersMu.Unlock()
+	}()
+
+	subscription := reader.Subscribe()
+
+	// Gap flushing for frames that end in silence
+	var gap <-chan time.Time
+	var gapTimer *time.Timer
+	if decoder.Gap() > 0 {
+		gapTimer = time.NewTimer(decoder.Gap())
+		defer gapTimer.Stop()
+		gap = gapTimer.C
+	}
+
+	var sequence uint64
+	send := func(frames []decode.Frame) error {
+		for _, frame := range frames {
+			sequence++
+			fields := make([]*pb.FrameField, len(frame.Fields))
+			for i, field := range frame.Fields {
+				fields[i] = &pb.FrameField{Name: field.Name, Value: field.Value}
+			}
+			err := stream.Send(&pb.StreamAnnotatedResponse{
+				Frame: &pb.AnnotatedFrame{
+					PortName:  req.PortName,
+					Decoder:   req.Decoder,
+					Raw:       frame.Raw,
+					Timestamp: frame.Timestamp.UnixNano(),
+					Sequence:  sequence,
+					Summary:   frame.Summary,
+					Fields:    fields,
+					Valid:     frame.Error == "",
+					Error:     frame.Error,
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-gap:
+			if err := send(decoder.Flush()); err != nil {
+				return err
+			}
+		case event, ok := <-subscription:
+			if !ok {
+				return send(decoder.Flush())
+			}
+
+			if event.Error != nil {
+				if event.Error == serial.ErrPortClosed {
+					return send(decoder.Flush())
+				}
+				continue
+			}
+
+			if err := send(decoder.Feed(event.Data, event.Timestamp)); err != nil {
+				return err
+			}
+			if gapTimer != nil {
+				gapTimer.Reset(decoder.Gap())
+			}
+		}
+	}
+}
+
+// convertFrameDecoder returns the decoder name of a proto decoder
+func convertFrameDecoder(d pb.FrameDecoder) (string, error) {
+	switch d {
+	case pb.FrameDecoder_FRAME_DECODER_MODBUS_RTU:
+		return decode.ModbusRTU, nil
+	case pb.FrameDecoder_FRAME_DECODER_NMEA:
+		return decode.NMEA, nil
+	case pb.FrameDecoder_FRAME_DECODER_AT:
+		return decode.AT, nil
+	case pb.FrameDecoder_FRAME_DECODER_CUSTOM:
+		return decode.Custom, nil
+	case pb.FrameDecoder_FRAME_DECODER_MEGATEC:
+		return decode.Megatec, nil
+	default:
+		return "", errors.New("decoder is required")
+	}
+}
+
+// ============================================================================
+// Port Configuration
+// ============================================================================
+
+// ConfigurePort configures a port
+func (s *SerialServer) ConfigurePort(ctx context.Context, req *pb.ConfigurePortRequest) (*pb.ConfigurePortResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+
+	cfg := s.convertToSerialConfig(req.Config)
+
+	err := s.manager.Configure(req.PortName, req.SessionId, cfg)
+	if err != nil {
+		return &pb.ConfigurePortResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.ConfigurePortResponse{
+		Success: true,
+		Message: "port configured successfully",
+	}, nil
+}
+
+// GetPortConfig returns the current configuration of a port
+func (s *SerialServer) GetPortConfig(ctx context.Context, req *pb.GetPortConfigRequest) (*pb.GetPortConfigResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+
+	session, err := s.manager.GetStatus(req.PortName)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "port not open: %v", err)
+	}
+
+	return &pb.GetPortConfigResponse{Config: s.convertFromSerialConfig(session.Config)}, nil
+}
+
+// GetRecentOutput returns the recent output buffered for a console-logged port
+func (s *SerialServer) GetRecentOutput(ctx context.Context, req *pb.GetRecentOutputRequest) (*pb.GetRecentOutputResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if err := s.checkAccess(ctx, req.PortName, ""); err != nil {
+		return nil, err
+	}
+
+	var logger *console.Logger
+	if s.console != nil {
+		logger = s.console.Logger(req.PortName)
+	}
+	if logger == nil {
+		return nil, status.Errorf(codes.NotFound, "port %s is not configured for console logging", req.PortName)
+	}
+
+	data, total := logger.Recent(int(req.MaxBytes))
+	return &pb.GetRecentOutputResponse{
+		PortName:   req.PortName,
+		Data:       data,
+		TotalBytes: total,
+	}, nil
+}
+
+// GetKeywordStats returns how many console lines of each port held the
+// configured keywords, in total and within sliding windows
+func (s *SerialServer) GetKeywordStats(ctx context.Context, req *pb.GetKeywordStatsRequest) (*pb.GetKeywordStatsResponse, error) {
+	if s.console == nil || len(s.config.Console.Keywords.Words) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "console keywords are not configured")
+	}
+
+	all := s.console.KeywordStats()
+	if req.PortName != "" {
+		if _, logged := all[req.PortName]; !logged {
+			return nil, status.Errorf(codes.NotFound, "port %s is not configured for console logging", req.PortName)
+		}
+		all = map[string][]console.KeywordStats{req.PortName: all[req.PortName]}
+	}
+
+	resp := &pb.GetKeywordStatsResponse{}
+	for port, stats := range all {
+		ps := &pb.PortKeywordStats{PortName: port}
+		for _, st := range stats {
+			kc := &pb.KeywordCount{Keyword: st.Keyword, Total: st.Total}
+			for _, w := range st.Windows {
+				kc.Windows = append(kc.Windows, &pb.KeywordWindow{
+					WindowSeconds: uint32(w.Window / time.Second),
+					Count:         w.Count,
+				})
+			}
+			ps.Keywords = append(ps.Keywords, kc)
+		}
+		resp.Ports = append(resp.Ports, ps)
+	}
+	slices.SortFunc(resp.Ports, func(a, b *pb.PortKeywordStats) int { return strings.Compare(a.PortName, b.PortName) })
+	return resp, nil
+}
+
+// ============================================================================
+// Session Recordings
+// ============================================================================
+
+// recordingChunkSize is the size of the chunks FetchRecording streams
+const recordingChunkSize = 64 * 1024
+
+// ListRecordings lists the recorded interactive sessions the caller may
+// see, newest first
+func (s *SerialServer) ListRecordings(ctx context.Context, req *pb.ListRecordingsRequest) (*pb.ListRecordingsResponse, error) {
+	if s.recording.Directory == "" {
+		return nil, status.Error(codes.FailedPrecondition, "session recording is not enabled (console.recording.enabled)")
+	}
+
+	recordings, err := console.ListRecordings(s.recording.Directory)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list recordings: %v", err)
+	}
+
+	visible := s.visibleRecordings(ctx)
+	s.activeRecordingsMu.Lock()
+	defer s.activeRecordingsMu.Unlock()
+
+	resp := &pb.ListRecordingsResponse{}
+	for _, r := range recordings {
+		if !visible(r) {
+			continue
+		}
+		if req.User != "" && r.User != req.User {
+			continue
+		}
+		if req.PortName != "" && r.PortName != req.PortName {
+			continue
+		}
+		if req.Since != 0 && r.Started.UnixNano() < req.Since {
+			continue
+		}
+		resp.Recordings = append(resp.Recordings, &pb.Recording{
+			Id:         r.ID,
+			User:       r.User,
+			PortName:   r.PortName,
+			SessionId:  s.visibleSessionID(ctx, r.SessionID),
+			StartedAt:  r.Started.UnixNano(),
+			DurationMs: uint64(r.Duration.Milliseconds()),
+			SizeBytes:  uint64(r.Size),
+			Active:     s.activeRecordings[filepath.Join(s.recording.Directory, filepath.FromSlash(r.ID))],
+		})
+		if req.Limit > 0 && len(resp.Recordings) >= int(req.Limit) {
+			break
+		}
+	}
+	return resp, nil
+}
+
+// visibleRecordings returns whether the caller may see a recording: its
+// own, those of ports the access policy allows it, and every one for
+// administrators
+func (s *SerialServer) visibleRecordings(ctx context.Context) func(r console.RecordingInfo) bool {
+	if s.isAdmin(ctx) {
+		return func(console.RecordingInfo) bool { return true }
+	}
+	identity := s.clientIdentity(ctx)
+	allowed := s.visiblePorts(ctx)
+	return func(r console.RecordingInfo) bool {
+		return r.User == identity || (s.access != nil && allowed(r.PortName))
+	}
+}
+
+// FetchRecording streams the asciicast file of a recording the caller may
+// see
+func (s *SerialServer) FetchRecording(req *pb.FetchRecordingRequest, stream pb.SerialService_FetchRecordingServer) error {
+	if s.recording.Directory == "" {
+		return status.Error(codes.FailedPrecondition, "session recording is not enabled (console.recording.enabled)")
+	}
+	if req.Id == "" {
+		return status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	info, err := console.StatRecording(s.recording.Directory, req.Id)
+	if err == nil && !s.visibleRecordings(stream.Context())(info) {
+		// Hidden recordings are not found, as in the listing
+		err = console.ErrRecordingNotFound
+	}
+	if errors.Is(err, console.ErrRecordingNotFound) {
+		return status.Errorf(codes.NotFound, "recording %s not found", req.Id)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to open recording: %v", err)
+	}
+
+	file, err := console.OpenRecording(s.recording.Directory, req.Id)
+	if errors.Is(err, console.ErrRecordingNotFound) {
+		return status.Errorf(codes.NotFound, "recording %s not found", req.Id)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to open recording: %v", err)
+	}
+	defer file.Close()
+
+	s.logger.Info("recording fetched", "id", req.Id, "client", s.clientIdentity(stream.Context()))
+
+	buffer := make([]byte, recordingChunkSize)
+	for {
+		n, err := file.Read(buffer)
+		if n > 0 {
+			if err := stream.Send(&pb.FetchRecordingResponse{Data: buffer[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to read recording: %v", err)
+		}
+	}
+}
+
+// ============================================================================
+// Cellular Modems
+// ============================================================================
+
+// SendSMS sends a text message through a cellular modem on a port
+func (s *SerialServer) SendSMS(ctx context.Context, req *pb.SendSMSRequest) (*pb.SendSMSResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+	if req.Number == "" {
+		return nil, status.Error(codes.InvalidArgument, "number is required")
+	}
+	if err := validateSMSMode(req.Mode); err != nil {
+		return nil, err
+	}
+
+	var reference int
+	err := s.modemCommand(ctx, req.PortName, req.SessionId, func(at *modem.AT) error {
+		var err error
+		reference, err = modem.SendSMS(ctx, at, req.Number, req.Text, req.Mode)
+		return err
+	})
+	if err != nil {
+		s.logger.Warn("failed to send SMS", "port", req.PortName, "error", err)
+		return &pb.SendSMSResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &pb.SendSMSResponse{
+		Success:   true,
+		Message:   "message sent",
+		Reference: uint32(reference),
+	}, nil
+}
+
+// ReadSMS lists the messages stored on a cellular modem
+func (s *SerialServer) ReadSMS(ctx context.Context, req *pb.ReadSMSRequest) (*pb.ReadSMSResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+	if err := validateSMSMode(req.Mode); err != nil {
+		return nil, err
+	}
+
+	var messages []modem.Message
+	err := s.modemCommand(ctx, req.PortName, req.SessionId, func(at *modem.AT) error {
+		var err error
+		messages, err = modem.ReadSMS(ctx, at, req.Mode, req.UnreadOnly, req.Delete)
+		return err
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to read messages: %v", err)
+	}
+
+	resp := &pb.ReadSMSResponse{Messages: make([]*pb.SMSMessage, 0, len(messages))}
+	for _, msg := range messages {
+		m := &pb.SMSMessage{
+			Index:  uint32(msg.Index),
+			Status: msg.Status,
+			Sender: msg.Sender,
+			Text:   msg.Text,
+		}
+		if !msg.Timestamp.IsZero() {
+			m.Timestamp = msg.Timestamp.Unix()
+		}
+		resp.Messages = append(resp.Messages, m)
+	}
+	return resp, nil
+}
+
+// GetModemStatus returns the signal strength and network registration of a
+// cellular modem
+func (s *SerialServer) GetModemStatus(ctx context.Context, req *pb.GetModemStatusRequest) (*pb.GetModemStatusResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+
+	var modemStatus modem.Status
+	err := s.modemCommand(ctx, req.PortName, req.SessionId, func(at *modem.AT) error {
+		var err error
+		modemStatus, err = modem.QueryStatus(ctx, at)
+		return err
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to query modem: %v", err)
+	}
+
+	return &pb.GetModemStatusResponse{
+		SignalRssi:   uint32(modemStatus.SignalRSSI),
+		SignalDbm:    int32(modemStatus.SignalDBm),
+		Registration: modemStatus.Registration,
+		Operator:     modemStatus.Operator,
+	}, nil
+}
+
+// HandOffPPP prepares a cellular modem for a PPP data call and releases the
+// port so pppd can take it over
+func (s *SerialServer) HandOffPPP(ctx context.Context, req *pb.HandOffPPPRequest) (*pb.HandOffPPPResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+	if req.Apn == "" {
+		return nil, status.Error(codes.InvalidArgument, "apn is required")
+	}
+
+	err := s.modemCommand(ctx, req.PortName, req.SessionId, func(at *modem.AT) error {
+		return modem.PreparePPP(ctx, at, req.Apn)
+	})
+	if err != nil {
+		return &pb.HandOffPPPResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	s.stopReader(req.PortName)
+	if err := s.manager.ClosePort(req.PortName, req.SessionId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to release port: %v", err)
+	}
+
+	s.logger.Info("port handed off for PPP", "port", req.PortName, "apn", req.Apn, "client", ClientAddress(ctx))
+	return &pb.HandOffPPPResponse{
+		Success:    true,
+		Message:    "port released for pppd",
+		DialString: modem.DialString,
+	}, nil
+}
+
+// modemCommand runs AT commands with exclusive access to a port
+func (s *SerialServer) modemCommand(ctx context.Context, portName, sessionID string, fn func(at *modem.AT) error) error {
+	return s.manager.Transact(portName, sessionID, 100*time.Millisecond, func(rw io.ReadWriter) error {
+		return fn(modem.NewAT(rw))
+	})
+}
+
+func validateSMSMode(mode string) error {
+	if mode != "" && mode != modem.ModeText && mode != modem.ModePDU {
+		return status.Errorf(codes.InvalidArgument, "mode must be %s or %s", modem.ModeText, modem.ModePDU)
+	}
+	return nil
+}
+
+// ============================================================================
+// Receipt Printers (ESC/POS)
+// ============================================================================
+
+// PrintText prints text on an ESC/POS printer
+func (s *SerialServer) PrintText(ctx context.Context, req *pb.PrintTextRequest) (*pb.PrintResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+
+	data, err := escpos.Text(req.Text, escpos.TextOptions{
+		Bold:      req.Bold,
+		Underline: req.Underline,
+		Align:     req.Align,
+		Size:      int(req.Size),
+		CodePage:  req.CodePage,
+		FeedLines: int(req.FeedLines),
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Cut {
+		data = append(data, escpos.Cut(false, 0)...)
+	}
+
+	return s.sendPrintJob(req.PortName, req.SessionId, data), nil
+}
+
+// PrintRaster prints an image on an ESC/POS printer
+func (s *SerialServer) PrintRaster(ctx context.Context, req *pb.PrintRasterRequest) (*pb.PrintResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+	if len(req.Image) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "image is required")
+	}
+
+	data, err := escpos.Raster(req.Image, escpos.RasterOptions{
+		Width:  int(req.Width),
+		Dither: req.Dither,
+		Align:  req.Align,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Cut {
+		data = append(data, escpos.Cut(false, 0)...)
+	}
+
+	return s.sendPrintJob(req.PortName, req.SessionId, data), nil
+}
+
+// CutPaper feeds and cuts the paper on an ESC/POS printer
+func (s *SerialServer) CutPaper(ctx context.Context, req *pb.CutPaperRequest) (*pb.PrintResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+
+	return s.sendPrintJob(req.PortName, req.SessionId, escpos.Cut(req.Partial, int(req.FeedLines))), nil
+}
+
+// GetPrinterStatus polls the real-time status of an ESC/POS printer
+func (s *SerialServer) GetPrinterStatus(ctx context.Context, req *pb.GetPrinterStatusRequest) (*pb.GetPrinterStatusResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+
+	var printerStatus escpos.Status
+	err := s.manager.Transact(req.PortName, req.SessionId, 50*time.Millisecond, func(rw io.ReadWriter) error {
+		var err error
+		printerStatus, err = escpos.QueryStatus(ctx, rw, time.Second)
+		return err
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to query printer: %v", err)
+	}
+
+	return &pb.GetPrinterStatusResponse{
+		Online:       printerStatus.Online,
+		CoverOpen:    printerStatus.CoverOpen,
+		PaperNearEnd: printerStatus.PaperNearEnd,
+		PaperOut:     printerStatus.PaperOut,
+		CutterError:  printerStatus.CutterError,
+		Error:        printerStatus.Error,
+		Summary:      printerStatus.String(),
+	}, nil
+}
+
+// sendPrintJob writes a printer job to a port
+func (s *SerialServer) sendPrintJob(portName, sessionID string, data []byte) *pb.PrintResponse {
+	n, err := s.manager.Write(portName, sessionID, data)
+	if err != nil {
+		return &pb.PrintResponse{
+			Success:      false,
+			Message:      err.Error(),
+			BytesWritten: uint32(n),
+		}
+	}
+
+	_ = s.manager.Flush(portName, sessionID)
+	return &pb.PrintResponse{
+		Success:      true,
+		Message:      "sent to printer",
+		BytesWritten: uint32(n),
+	}
+}
+
+// ============================================================================
+// Energy Meters (IEC 62056-21)
+// ============================================================================
+
+// ReadMeter runs an IEC 62056-21 mode C data readout on a port: the
+// exchange starts at 300 baud, 7E1, and switches to the speed the meter
+// offers. The session's line settings are restored afterwards.
+func (s *SerialServer) ReadMeter(ctx context.Context, req *pb.ReadMeterRequest) (*pb.ReadMeterResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+	if err := s.checkAgentReaders(req.PortName); err != nil {
+		return nil, err
+	}
+	if err := s.checkUnheldWrite(ctx, req.PortName, meter.Request(req.Address)); err != nil {
+		return nil, err
+	}
+
+	var readout meter.Readout
+	err := s.manager.Transact(req.PortName, req.SessionId, 50*time.Millisecond, func(rw io.ReadWriter) error {
+		var err error
+		readout, err = meter.Read(ctx, rw, meter.Options{
+			Address:     req.Address,
+			MaxBaudRate: int(req.MaxBaudRate),
+			Timeout:     time.Duration(req.TimeoutMs) * time.Millisecond,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, meterError(err)
+	}
+
+	resp := &pb.ReadMeterResponse{
+		Manufacturer:   readout.Manufacturer,
+		Identification: readout.Identification,
+		BaudRate:       uint32(readout.BaudRate),
+		Data:           readout.Data,
+	}
+	for _, v := range readout.Values {
+		resp.Values = append(resp.Values, &pb.MeterValue{
+			Code:    v.Code,
+			Value:   v.Value,
+			Unit:    v.Unit,
+			Number:  v.Number,
+			Numeric: v.Numeric,
+		})
+	}
+	return resp, nil
+}
+
+// meterError converts a readout failure to a gRPC status
+func meterError(err error) error {
+	switch {
+	case errors.Is(err, meter.ErrInvalid), errors.Is(err, serial.ErrInvalidConfig):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, meter.ErrNoResponse):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, meter.ErrProtocol):
+		return status.Error(codes.DataLoss, err.Error())
+	case errors.Is(err, serial.ErrInvalidSession):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, serial.ErrPortNotOpen), errors.Is(err, serial.ErrPortClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return status.FromContextError(err).Err()
+	}
+	return status.Errorf(codes.Internal, "meter readout failed: %v", err)
+}
+
+// ============================================================================
+// Bus Bridges (I2C/SPI)
+// ============================================================================
+
+// ListBusDevices lists the I2C and SPI devices exposed by bus providers
+func (s *SerialServer) ListBusDevices(ctx context.Context, req *pb.ListBusDevicesRequest) (*pb.ListBusDevicesResponse, error) {
+	if s.buses == nil {
+		return &pb.ListBusDevicesResponse{}, nil
+	}
+
+	filter := bus.Type(req.BusType)
+	if filter != "" && filter != bus.TypeI2C && filter != bus.TypeSPI {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown bus_type %q", req.BusType)
+	}
+
+	devices, err := s.buses.Devices(filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list bus devices: %v", err)
+	}
+
+	resp := &pb.ListBusDevicesResponse{
+		Devices: make([]*pb.BusDevice, 0, len(devices)),
+	}
+	for _, d := range devices {
+		resp.Devices = append(resp.Devices, &pb.BusDevice{
+			Name:        d.QualifiedName(),
+			BusType:     string(d.Type),
+			Provider:    d.Provider,
+			Description: d.Description,
+		})
+	}
+	return resp, nil
+}
+
+// I2CTransfer writes to and/or reads from a target on an I2C bus
+func (s *SerialServer) I2CTransfer(ctx context.Context, req *pb.I2CTransferRequest) (*pb.I2CTransferResponse, error) {
+	if req.Device == "" {
+		return nil, status.Error(codes.InvalidArgument, "device is required")
+	}
+	if s.buses == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no bus providers are enabled")
+	}
+	if req.Address > 0x3ff {
+		return nil, status.Error(codes.InvalidArgument, "address must be a 7- or 10-bit I2C address")
+	}
+
+	data, err := s.buses.I2CTransfer(req.Device, uint16(req.Address), req.Write, int(req.ReadLength))
+	if err != nil {
+		return nil, busError(err)
+	}
+
+	return &pb.I2CTransferResponse{Data: data}, nil
+}
+
+// SPITransfer runs a full-duplex transfer on an SPI bus
+func (s *SerialServer) SPITransfer(ctx context.Context, req *pb.SPITransferRequest) (*pb.SPITransferResponse, error) {
+	if req.Device == "" {
+		return nil, status.Error(codes.InvalidArgument, "device is required")
+	}
+	if s.buses == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no bus providers are enabled")
+	}
+	if req.Mode > 3 || req.BitsPerWord > 32 {
+		return nil, status.Error(codes.InvalidArgument, "mode must be 0-3 and bits_per_word at most 32")
+	}
+
+	data, err := s.buses.SPITransfer(req.Device, bus.SPIConfig{
+		Mode:        uint8(req.Mode),
+		SpeedHz:     req.SpeedHz,
+		BitsPerWord: uint8(req.BitsPerWord),
+	}, req.Data)
+	if err != nil {
+		return nil, busError(err)
+	}
+
+	return &pb.SPITransferResponse{Data: data}, nil
+}
+
+// busError maps bus errors to gRPC status codes
+func busError(err error) error {
+	switch {
+	case errors.Is(err, bus.ErrDeviceNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, bus.ErrWrongBusType), errors.Is(err, bus.ErrInvalidTransfer):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "bus transfer failed: %v", err)
+	}
+}
+
+// ============================================================================
+// Polling
+// ============================================================================
+
+// StreamPolledValues streams the values parsed by configured pollers,
+// starting with the latest sample of each
+func (s *SerialServer) StreamPolledValues(req *pb.StreamPolledValuesRequest, stream pb.SerialService_StreamPolledValuesServer) error {
+	if s.polling == nil {
+		return status.Error(codes.FailedPrecondition, "no pollers are configured")
+	}
+
+	names := s.polling.Names()
+	for _, name := range req.Pollers {
+		if !slices.Contains(names, name) {
+			return status.Errorf(codes.NotFound, "poller %q is not configured", name)
+		}
+	}
+	wanted := func(sample poller.Sample) bool {
+		return len(req.Pollers) == 0 || slices.Contains(req.Pollers, sample.Poller)
+	}
+
+	// Subscribe before sending the latest samples so none are missed
+	samples := s.polling.Subscribe()
+	defer s.polling.Unsubscribe(samples)
+
+	for _, sample := range s.polling.Latest() {
+		if wanted(sample) {
+			if err := stream.Send(&pb.StreamPolledValuesResponse{Sample: polledSample(sample)}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case sample := <-samples:
+			if !wanted(sample) {
+				continue
+			}
+			if err := stream.Send(&pb.StreamPolledValuesResponse{Sample: polledSample(sample)}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// QueryHistory returns the recorded history of a poller's values
+func (s *SerialServer) QueryHistory(ctx context.Context, req *pb.QueryHistoryRequest) (*pb.QueryHistoryResponse, error) {
+	if s.history == nil {
+		return nil, status.Error(codes.FailedPrecondition, "polling history is not enabled")
+	}
+	if req.Poller == "" {
+		return nil, status.Error(codes.InvalidArgument, "poller is required")
+	}
+
+	end := time.Now()
+	if req.EndTime > 0 {
+		end = time.Unix(0, req.EndTime)
+	}
+	start := end.Add(-time.Hour)
+	if req.StartTime > 0 {
+		start = time.Unix(0, req.StartTime)
+	}
+	if start.After(end) {
+		return nil, status.Error(codes.InvalidArgument, "start_time must not be after end_time")
+	}
+
+	series, step, err := s.history.Query(req.Poller, req.Field, start, end, time.Duration(req.StepSeconds)*time.Second)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query history: %v", err)
+	}
+
+	resp := &pb.QueryHistoryResponse{StepSeconds: uint32(step / time.Second)}
+	for _, sr := range series {
+		points := make([]*pb.HistoryPoint, 0, len(sr.Points))
+		for _, p := range sr.Points {
+			points = append(points, &pb.HistoryPoint{
+				Timestamp: p.Time.UnixNano(),
+				Min:       p.Min,
+				Max:       p.Max,
+				Avg:       p.Avg,
+				Count:     uint32(p.Count),
+			})
+		}
+		resp.Series = append(resp.Series, &pb.HistorySeries{
+			Poller: sr.Poller,
+			Field:  sr.Field,
+			Points: points,
+		})
+	}
+	return resp, nil
+}
+
+// ListAlarms returns the alarms that are active or awaiting acknowledgment
+func (s *SerialServer) ListAlarms(ctx context.Context, req *pb.ListAlarmsRequest) (*pb.ListAlarmsResponse, error) {
+	if s.alarms == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no alarm rules are configured")
+	}
+
+	alarms := s.alarms.List(req.IncludeHistory)
+	resp := &pb.ListAlarmsResponse{Alarms: make([]*pb.Alarm, 0, len(alarms))}
+	for _, a := range alarms {
+		resp.Alarms = append(resp.Alarms, convertAlarm(a))
+	}
+	return resp, nil
+}
+
+// AcknowledgeAlarm records that an operator has seen an alarm
+func (s *SerialServer) AcknowledgeAlarm(ctx context.Context, req *pb.AcknowledgeAlarmRequest) (*pb.AcknowledgeAlarmResponse, error) {
+	if s.alarms == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no alarm rules are configured")
+	}
+	if req.AlarmId == "" {
+		return nil, status.Error(codes.InvalidArgument, "alarm_id is required")
+	}
+
+	by := req.AcknowledgedBy
+	if by == "" {
+		by = ClientAddress(ctx)
+	}
+
+	a, err := s.alarms.Acknowledge(req.AlarmId, by)
+	switch {
+	case errors.Is(err, alarm.ErrNotFound):
+		return nil, status.Errorf(codes.NotFound, "alarm %q not found", req.AlarmId)
+	case errors.Is(err, alarm.ErrAlreadyAcknowledged):
+		return nil, status.Errorf(codes.FailedPrecondition, "alarm %q was already acknowledged by %s", req.AlarmId, a.AcknowledgedBy)
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to acknowledge alarm: %v", err)
+	}
+
+	return &pb.AcknowledgeAlarmResponse{Alarm: convertAlarm(a)}, nil
+}
+
+// ============================================================================
+// Device State
+// ============================================================================
+
+// ListDeviceStates returns the current state of tracked devices
+func (s *SerialServer) ListDeviceStates(ctx context.Context, req *pb.ListDeviceStatesRequest) (*pb.ListDeviceStatesResponse, error) {
+	wanted, err := s.deviceFilter(req.Devices)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListDeviceStatesResponse{}
+	for _, st := range s.devices.List() {
+		if wanted(st) {
+			resp.States = append(resp.States, convertDeviceState(st))
+		}
+	}
+	return resp, nil
+}
+
+// StreamDeviceStates sends the current state of tracked devices, then every
+// transition
+func (s *SerialServer) StreamDeviceStates(req *pb.StreamDeviceStatesRequest, stream pb.SerialService_StreamDeviceStatesServer) error {
+	wanted, err := s.deviceFilter(req.Devices)
+	if err != nil {
+		return err
+	}
+
+	// Subscribe before sending the current states so no transition is missed
+	transitions := s.devices.Subscribe()
+	defer s.devices.Unsubscribe(transitions)
+
+	for _, st := range s.devices.List() {
+		if wanted(st) {
+			if err := stream.Send(&pb.StreamDeviceStatesResponse{State: convertDeviceState(st)}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case st := <-transitions:
+			if !wanted(st) {
+				continue
+			}
+			if err := stream.Send(&pb.StreamDeviceStatesResponse{State: convertDeviceState(st)}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// deviceFilter checks the requested devices and returns a filter for them;
+// no devices selects all
+func (s *SerialServer) deviceFilter(devices []string) (func(devicestate.Status) bool, error) {
+	if s.devices == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no devices are configured")
+	}
+	names := s.devices.Names()
+	for _, name := range devices {
+		if !slices.Contains(names, name) {
+			return nil, status.Errorf(codes.NotFound, "device %q is not configured", name)
+		}
+	}
+	return func(st devicestate.Status) bool {
+		return len(devices) == 0 || slices.Contains(devices, st.Device)
+	}, nil
+}
+
+// ============================================================================
+// Test Suites
+// ============================================================================
+
+// ListTestSuites returns the configured test suites
+func (s *SerialServer) ListTestSuites(ctx context.Context, req *pb.ListTestSuitesRequest) (*pb.ListTestSuitesResponse, error) {
+	if s.tests == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no test suites are configured")
+	}
+
+	resp := &pb.ListTestSuitesResponse{}
+	for _, suite := range s.tests.Suites() {
+		ts := &pb.TestSuite{
+			Name:      suite.Name,
+			RunOnOpen: suite.RunOnOpen,
+		}
+		if suite.Port != nil {
+			ts.Port = suite.Port.String()
+		}
+		for _, step := range suite.Steps {
+			ts.Steps = append(ts.Steps, step.Name)
+		}
+		resp.Suites = append(resp.Suites, ts)
+	}
+	return resp, nil
+}
+
+// RunTestSuite runs a test suite on an open session and returns its report
+func (s *SerialServer) RunTestSuite(ctx context.Context, req *pb.RunTestSuiteRequest) (*pb.RunTestSuiteResponse, error) {
+	if s.tests == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no test suites are configured")
+	}
+	if req.Suite == "" {
+		return nil, status.Error(codes.InvalidArgument, "suite is required")
+	}
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+
+	for _, suite := range s.tests.Suites() {
+		if suite.Name != req.Suite {
+			continue
+		}
+		for _, step := range suite.Steps {
+			if len(step.Send) == 0 {
+				continue
+			}
+			if err := s.checkUnheldWrite(ctx, req.PortName, step.Send); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	report, err := s.tests.Run(ctx, req.Suite, req.PortName, req.SessionId, testrunner.TriggerRequest)
+	switch {
+	case errors.Is(err, testrunner.ErrUnknownSuite):
+		return nil, status.Errorf(codes.NotFound, "test suite %q not found", req.Suite)
+	case err != nil:
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to run test suite: %v", err)
+	}
+	return &pb.RunTestSuiteResponse{Report: convertTestReport(report)}, nil
+}
+
+// ============================================================================
+// Health & Diagnostics
+// ============================================================================
+
+// DiagnoseLine sweeps common line settings and ranks them by readability
+func (s *SerialServer) DiagnoseLine(ctx context.Context, req *pb.DiagnoseLineRequest) (*pb.DiagnoseLineResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+
+	opts := serial.DiagnoseOptions{
+		Sample: time.Duration(req.SampleMs) * time.Millisecond,
+		Probe:  req.Probe,
+	}
+	for _, baud := range req.BaudRates {
+		opts.BaudRates = append(opts.BaudRates, int(baud))
+	}
+
+	candidates, err := s.manager.DiagnoseLine(ctx, req.PortName, req.SessionId, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "diagnosis failed: %v", err)
+	}
+
+	resp := &pb.DiagnoseLineResponse{Candidates: make([]*pb.LineCandidate, 0, len(candidates))}
+	for _, c := range candidates {
+		resp.Candidates = append(resp.Candidates, &pb.LineCandidate{
+			Config:        s.convertFromSerialConfig(c.Config),
+			Score:         c.Score,
+			BytesReceived: uint64(c.BytesReceived),
+			Sample:        c.Sample,
+		})
+	}
+	return resp, nil
+}
+
+// Verify sends a command and compares the response with the expected bytes
+// or pattern, for functional tests
+func (s *SerialServer) Verify(ctx context.Context, req *pb.VerifyRequest) (*pb.VerifyResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+	if (len(req.Expected) > 0) == (req.ExpectedPattern != "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of expected and expected_pattern is required")
+	}
+
+	var pattern *regexp.Regexp
+	if req.ExpectedPattern != "" {
+		var err error
+		if pattern, err = regexp.Compile(req.ExpectedPattern); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expected_pattern: %v", err)
+		}
+	}
+	if err := s.checkUnheldWrite(ctx, req.PortName, req.Command); err != nil {
+		return nil, err
+	}
+
+	opts := verify.Options{
+		Terminator: req.Terminator,
+		Length:     int(req.ResponseLength),
+		Timeout:    time.Duration(req.TimeoutMs) * time.Millisecond,
+		Idle:       time.Duration(req.IdleMs) * time.Millisecond,
+	}
+	var response []byte
+	err := s.manager.Transact(req.PortName, req.SessionId, 50*time.Millisecond, func(rw io.ReadWriter) error {
+		var err error
+		response, err = verify.Exchange(ctx, rw, req.Command, opts)
+		return err
+	})
+	if err != nil {
+		return &pb.VerifyResponse{
+			Passed:   false,
+			Response: response,
+			Message:  err.Error(),
+		}, nil
+	}
+
+	expected := req.Expected
+	if pattern != nil {
+		expected = nil
+	}
+	passed, diff := verify.Check(response, expected, pattern)
+	message := "response matches"
+	if !passed {
+		message = "response differs from expected"
+	}
+	return &pb.VerifyResponse{
+		Passed:   passed,
+		Response: response,
+		Diff:     diff,
+		Message:  message,
+	}, nil
+}
+
+// Paste sends a text one line at a time, pacing the lines by a prompt, the
+// line's echo or a delay, and streams the progress. Each line is its own
+// transaction, so other operations on the port run in between.
+func (s *SerialServer) Paste(req *pb.PasteRequest, stream pb.SerialService_PasteServer) error {
+	if req.PortName == "" {
+		return status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(stream.Context(), req.PortName); err != nil {
+		return err
+	}
+
+	opts := paste.Options{
+		Echo:    req.WaitEcho,
+		Delay:   time.Duration(req.LineDelayMs) * time.Millisecond,
+		Timeout: time.Duration(req.LineTimeoutMs) * time.Millisecond,
+	}
+	if req.LineEnding != "" {
+		opts.LineEnding = []byte(req.LineEnding)
+	}
+	if req.PromptPattern != "" {
+		var err error
+		if opts.Prompt, err = regexp.Compile(req.PromptPattern); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid prompt_pattern: %v", err)
+		}
+	}
+	if err := opts.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := stream.Context()
+	lines := paste.Lines(req.Data, req.SkipBlankLines)
+	if len(lines) == 0 {
+		return status.Error(codes.InvalidArgument, "data holds no lines")
+	}
+	// Rejected as a whole rather than stopping halfway
+	for i, line := range lines {
+		if err := s.checkUnheldWrite(ctx, req.PortName, line); err != nil {
+			return status.Errorf(status.Code(err), "line %d: %s", i+1, status.Convert(err).Message())
+		}
+	}
+
+	start := time.Now()
+	for i, line := range lines {
+		var response []byte
+		err := s.manager.Transact(req.PortName, req.SessionId, 50*time.Millisecond, func(rw io.ReadWriter) error {
+			if i == 0 {
+				if err := paste.Discard(rw); err != nil {
+					return err
+				}
 			}
+			var err error
+			response, err = paste.Line(ctx, rw, line, opts)
+			return err
+		})
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case errors.Is(err, serial.ErrInvalidSession), errors.Is(err, serial.ErrPortNotOpen):
+			return status.Errorf(codes.FailedPrecondition, "invalid session: %v", err)
+		case errors.Is(err, paste.ErrTimeout):
+			return status.Errorf(codes.DeadlineExceeded, "line %d: %v", i+1, err)
+		case err != nil:
+			return status.Errorf(codes.Internal, "line %d: %v", i+1, err)
+		}
+
+		err = stream.Send(&pb.PasteResponse{
+			Line:       uint32(i + 1),
+			TotalLines: uint32(len(lines)),
+			Text:       line,
+			Response:   response,
+			ElapsedMs:  time.Since(start).Milliseconds(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResetTarget pulses the GPIO reset line wired to the device on a port. The
+// caller must be allowed the port, and it must not be reserved or opened by
+// another client.
+func (s *SerialServer) ResetTarget(ctx context.Context, req *pb.ResetTargetRequest) (*pb.ResetTargetResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if err := s.checkAccess(ctx, req.PortName, ""); err != nil {
+		return nil, err
+	}
+	if err := s.checkReservation(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+	if session := s.manager.GetSession(req.PortName); session != nil {
+		if session.Owner() == "" || session.Owner() != s.clientIdentity(ctx) {
+			return nil, status.Errorf(codes.PermissionDenied, "%s is open by another client", req.PortName)
+		}
+	}
+
+	var resetLine *config.ResetLineConfig
+	for i := range s.config.GPIO.ResetLines {
+		if s.config.GPIO.ResetLines[i].Port == req.PortName {
+			resetLine = &s.config.GPIO.ResetLines[i]
+			break
+		}
+	}
+	if resetLine == nil {
+		return nil, status.Errorf(codes.NotFound, "no reset line configured for port %s", req.PortName)
+	}
+
+	chip := resetLine.Chip
+	if chip == "" {
+		chip = "gpiochip0"
+	}
+	pulse := time.Duration(resetLine.PulseMs) * time.Millisecond
+	if req.PulseMs > 0 {
+		pulse = time.Duration(req.PulseMs) * time.Millisecond
+	}
+	if pulse <= 0 {
+		pulse = 100 * time.Millisecond
+	}
+
+	err := gpio.Pulse(gpio.Line{Chip: chip, Offset: resetLine.Line, ActiveLow: resetLine.ActiveLow}, pulse)
+	if err != nil {
+		s.logger.Warn("failed to reset target", "port", req.PortName, "line", resetLine.Line, "error", err)
+		return &pb.ResetTargetResponse{
+			Success: false,
+			Message: err.Error(),
+		}, nil
+	}
+
+	s.logger.Info("target reset", "port", req.PortName, "chip", chip, "line", resetLine.Line, "pulse", pulse, "client", ClientAddress(ctx))
+	return &pb.ResetTargetResponse{
+		Success: true,
+		Message: fmt.Sprintf("pulsed %s line %d for %s", chip, resetLine.Line, pulse),
+	}, nil
+}
+
+// Ping checks if the server is alive
+func (s *SerialServer) Ping(ctx context.Context, req *pb.PingRequest) (*pb.PingResponse, error) {
+	message := req.Message
+	if message == "" {
+		message = "pong"
+	}
+
+	return &pb.PingResponse{
+		Message:    message,
+		ServerTime: time.Now().Unix(),
+	}, nil
+}
+
+// GetAgentInfo returns information about the agent
+func (s *SerialServer) GetAgentInfo(ctx context.Context, req *pb.GetAgentInfoRequest) (*pb.GetAgentInfoResponse, error) {
+	return &pb.GetAgentInfoResponse{
+		Info: &pb.AgentInfo{
+			Version:       Version,
+			BuildCommit:   Commit,
+			BuildDate:     BuildDate,
+			Os:            runtime.GOOS,
+			Arch:          runtime.GOARCH,
+			UptimeSeconds: int64(time.Since(s.startTime).Seconds()),
+			SupportedFeatures: []string{
+				"grpc",
+				"port-scan",
+				"port-lock",
+				"streaming",
+				"bidirectional-streaming",
+			},
+			Config: &pb.AgentConfig{
+				GrpcAddress:    s.config.Server.GRPCAddress,
+				TlsEnabled:     s.config.TLS.Enabled,
+				MaxConnections: uint32(s.config.Server.MaxConnections),
+			},
+		},
+	}, nil
+}
+
+// GetMemoryStats reports the memory held in stream queues against the
+// configured limits, with the Go runtime's heap figures
+func (s *SerialServer) GetMemoryStats(ctx context.Context, req *pb.GetMemoryStatsRequest) (*pb.GetMemoryStatsResponse, error) {
+	stats := s.manager.MemoryStats()
+
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	resp := &pb.GetMemoryStatsResponse{
+		GlobalLimitBytes:  stats.Limits.Global,
+		SessionLimitBytes: stats.Limits.PerSession,
+		StreamLimitBytes:  stats.Limits.PerStream,
+		Streams:           uint32(stats.Streams),
+		BufferedBytes:     stats.Buffered,
+		PeakBufferedBytes: stats.Peak,
+		DroppedBytes:      stats.Dropped,
+		HeapAllocBytes:    mem.HeapAlloc,
+		HeapSysBytes:      mem.HeapSys,
+	}
+	visible := s.visiblePorts(ctx)
+	for _, session := range stats.Sessions {
+		if !visible(session.PortName) {
+			continue
+		}
+		resp.Sessions = append(resp.Sessions, &pb.SessionMemoryStats{
+			SessionId:         s.visibleSessionID(ctx, session.SessionID),
+			PortName:          session.PortName,
+			ClientId:          session.ClientID,
+			Streams:           uint32(session.Streams),
+			BufferedBytes:     session.Buffered,
+			PeakBufferedBytes: session.Peak,
+			DroppedBytes:      session.Dropped,
+		})
+	}
+	return resp, nil
+}
+
+// ListStreams reports, per read subscription, the data delivered and
+// dropped and how far the consumer lags behind, to find slow consumers
+func (s *SerialServer) ListStreams(ctx context.Context, req *pb.ListStreamsRequest) (*pb.ListStreamsResponse, error) {
+	visible := s.visiblePorts(ctx)
+	resp := &pb.ListStreamsResponse{}
+	for _, stream := range s.manager.Streams() {
+		if req.PortName != "" && stream.PortName != req.PortName {
+			continue
+		}
+		if !visible(stream.PortName) {
+			continue
+		}
+		resp.Streams = append(resp.Streams, &pb.StreamInfo{
+			Id:              stream.ID,
+			PortName:        stream.PortName,
+			SessionId:       s.visibleSessionID(ctx, stream.SessionID),
+			ClientId:        stream.ClientID,
+			Consumer:        stream.Consumer,
+			Priority:        convertPriorityBack(stream.Priority),
+			StartedAt:       stream.Started.UnixNano(),
+			DeliveredChunks: stream.Delivered,
+			DeliveredBytes:  stream.DeliveredBytes,
+			DroppedChunks:   stream.Dropped,
+			DroppedBytes:    stream.DroppedBytes,
+			QueuedChunks:    uint32(stream.Queued),
+			QueuedBytes:     stream.QueuedBytes,
+			LagMs:           stream.Lag.Milliseconds(),
+		})
+	}
+	return resp, nil
+}
+
+// ListSessions returns the open sessions across all ports, optionally of
+// one port or client. Under an access policy, only sessions on ports the
+// caller may use are listed; session IDs are only given to the sessions'
+// openers and administrators.
+func (s *SerialServer) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	visible := s.visiblePorts(ctx)
+	resp := &pb.ListSessionsResponse{}
+	for _, session := range s.manager.Sessions() {
+		if req.PortName != "" && session.PortName != req.PortName {
+			continue
+		}
+		if req.ClientId != "" && session.ClientID != req.ClientId {
+			continue
+		}
+		if !visible(session.PortName) {
+			continue
+		}
+		info := &pb.SessionInfo{
+			PortName:   session.PortName,
+			ClientId:   session.ClientID,
+			Owner:      session.Owner(),
+			Exclusive:  session.Exclusive,
+			Priority:   convertPriorityBack(session.Priority()),
+			PowerState: convertPowerStateBack(session.PowerState()),
+			Metadata:   session.Metadata,
+			Statistics: &pb.PortStatistics{
+				BytesSent:     session.Statistics.BytesSent,
+				BytesReceived: session.Statistics.BytesReceived,
+				Errors:        session.Statistics.Errors,
+				OpenedAt:      session.Statistics.OpenedAt.Unix(),
+				LastActivity:  session.Statistics.LastActivity.Unix(),
+				GarbageBytes:  session.Statistics.GarbageBytes,
+				BreakCount:    session.Statistics.BreakCount,
+				LineQuality:   session.Statistics.LineQuality,
+			},
+			AgeMs: time.Since(session.Statistics.OpenedAt).Milliseconds(),
+		}
+		if s.visibleSessionID(ctx, session.ID) != "" {
+			info.SessionId = session.ID
+			info.ShortSessionId = session.ShortID
+		}
+		resp.Sessions = append(resp.Sessions, info)
+	}
+	slices.SortFunc(resp.Sessions, func(a, b *pb.SessionInfo) int { return strings.Compare(a.PortName, b.PortName) })
+	return resp, nil
+}
 
-			if req.IncludeTimestamps {
-				chunk.Timestamp = event.Timestamp.UnixNano()
-			}
+// GetAgentStats returns totals across all sessions and the agent's resource
+// use, for health dashboards
+func (s *SerialServer) GetAgentStats(ctx context.Context, req *pb.GetAgentStatsRequest) (*pb.GetAgentStatsResponse, error) {
+	totals := s.manager.Totals()
+	memory := s.manager.MemoryStats()
 
-			if err := stream.Send(&pb.StreamReadResponse{Chunk: chunk}); err != nil {
-				return err
-			}
-		}
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	resp := &pb.GetAgentStatsResponse{
+		OpenPorts:      uint32(totals.OpenPorts),
+		OpenSessions:   uint32(totals.OpenSessions),
+		SessionsOpened: totals.SessionsOpened,
+		BytesSent:      totals.BytesSent,
+		BytesReceived:  totals.BytesReceived,
+		Errors:         totals.Errors,
+		Streams:        uint32(memory.Streams),
+		BufferedBytes:  memory.Buffered,
+		HeapAllocBytes: mem.HeapAlloc,
+		SysBytes:       mem.Sys,
+		Goroutines:     uint32(runtime.NumGoroutine()),
+		UptimeSeconds:  int64(time.Since(s.startTime).Seconds()),
+	}
+	if cpu, ok := overload.ProcessCPUTime(); ok {
+		resp.CpuSeconds = cpu.Seconds()
+		resp.CpuPercent = s.cpuUsage(cpu)
 	}
+	return resp, nil
 }
 
-// StreamWrite writes streaming data to a port
-func (s *SerialServer) StreamWrite(stream pb.SerialService_StreamWriteServer) error {
-	var totalBytes uint64
-	var chunksProcessed uint32
+// SetDebugEndpoints starts or stops the debug listener serving pprof,
+// expvar and runtime dumps
+func (s *SerialServer) SetDebugEndpoints(ctx context.Context, req *pb.SetDebugEndpointsRequest) (*pb.SetDebugEndpointsResponse, error) {
+	if s.debug == nil || !s.config.Debug.AllowToggle {
+		return nil, status.Error(codes.FailedPrecondition, "debug endpoints cannot be toggled at runtime (debug.allow_toggle is off)")
+	}
+	identity := s.clientIdentity(ctx)
+	if !s.isAdmin(ctx) {
+		s.logger.Warn("debug endpoint toggle refused", "identities", s.callerIdentities(ctx, ""), "client", ClientAddress(ctx))
+		return nil, status.Errorf(codes.PermissionDenied, "%s may not toggle debug endpoints (auth.admins)", identity)
+	}
 
-	for {
-		chunk, err := stream.Recv()
-		if err == io.EOF {
-			return stream.SendAndClose(&pb.StreamWriteResponse{
-				Success:           true,
-				TotalBytesWritten: totalBytes,
-				ChunksProcessed:   chunksProcessed,
-				Message:           "stream completed successfully",
-			})
+	var err error
+	if req.Enabled {
+		err = s.debug.Start()
+	} else {
+		err = s.debug.Stop()
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to toggle debug endpoints: %v", err)
+	}
+
+	running, address := s.debug.Running()
+	s.logger.Info("debug endpoints toggled", "enabled", running, "address", address, "client", identity)
+	message := "debug endpoints disabled"
+	if running {
+		message = "debug endpoints listening on " + address
+	}
+	return &pb.SetDebugEndpointsResponse{
+		Enabled: running,
+		Address: address,
+		Message: message,
+	}, nil
+}
+
+// cpuUsage returns the agent's CPU use as a share of all cores since the
+// previous sample. Samples are at least a second apart, so frequent callers
+// see a steady value.
+func (s *SerialServer) cpuUsage(cpu time.Duration) float64 {
+	s.cpuMu.Lock()
+	defer s.cpuMu.Unlock()
+
+	if s.cpuSampled.IsZero() {
+		s.cpuSampled = s.startTime
+	}
+	now := time.Now()
+	elapsed := now.Sub(s.cpuSampled)
+	if elapsed < time.Second {
+		return s.cpuPercent
+	}
+	s.cpuPercent = 100 * float64(cpu-s.cpuSample) / (float64(elapsed) * float64(runtime.NumCPU()))
+	s.cpuSample = cpu
+	s.cpuSampled = now
+	return s.cpuPercent
+}
+
+// ============================================================================
+// Bridges
+// ============================================================================
+
+// BridgePorts forwards the data of one open port to another, and back
+// unless one_way is set. The caller must hold both sessions.
+func (s *SerialServer) BridgePorts(ctx context.Context, req *pb.BridgePortsRequest) (*pb.BridgePortsResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if req.A == nil || req.B == nil || req.A.SessionId == "" || req.B.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "both ends with their session_id are required")
+	}
+	for _, end := range []*pb.BridgeEndpoint{req.A, req.B} {
+		if err := s.checkSessionAccess(ctx, end.PortName); err != nil {
+			return nil, err
 		}
-		if err != nil {
-			return status.Errorf(codes.Internal, "receive error: %v", err)
+	}
+
+	rules, err := convertBridgeRules(req.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	def := bridge.Definition{
+		Name:   req.Name,
+		A:      bridge.Endpoint{PortName: req.A.PortName, SessionID: req.A.SessionId, BaudRate: int(req.A.BaudRate)},
+		B:      bridge.Endpoint{PortName: req.B.PortName, SessionID: req.B.SessionId, BaudRate: int(req.B.BaudRate)},
+		OneWay: req.OneWay,
+		Log:    req.LogData,
+		Rules:  rules,
+	}
+
+	// Forwarded data is not checked payload by payload
+	destinations := []string{def.B.PortName}
+	if !def.OneWay {
+		destinations = append(destinations, def.A.PortName)
+	}
+	for _, portName := range destinations {
+		if s.writes != nil && s.writes.Covers(portName) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s has a write policy and cannot receive bridged data", portName)
 		}
+	}
 
-		// Get session for this port
-		session := s.manager.GetSession(chunk.GetChunk().PortName)
-		if session == nil {
-			return status.Error(codes.NotFound, "port not open")
+	s.readersMu.Lock()
+	defer s.readersMu.Unlock()
+
+	sources := []string{def.A.PortName}
+	if !def.OneWay {
+		sources = append(sources, def.B.PortName)
+	}
+	for _, portName := range sources {
+		if _, reading := s.readers[portName]; reading || s.capturing[portName] {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s is already being streamed", portName)
+		}
+		if s.gcode.Reading(portName) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s is read by a G-code job", portName)
 		}
+	}
 
-		n, err := s.manager.Write(chunk.GetChunk().PortName, session.ID, chunk.GetChunk().Data)
+	info, err := s.bridges.Start(def)
+	if err != nil {
+		return nil, bridgeError(req.Name, err)
+	}
+	s.logger.Info("ports bridged", "bridge", req.Name, "a", def.A.PortName, "b", def.B.PortName, "client", s.clientIdentity(ctx))
+	return &pb.BridgePortsResponse{Bridge: convertBridge(info)}, nil
+}
+
+// ListBridges returns the running bridges
+func (s *SerialServer) ListBridges(ctx context.Context, req *pb.ListBridgesRequest) (*pb.ListBridgesResponse, error) {
+	infos := s.bridges.List()
+	resp := &pb.ListBridgesResponse{Bridges: make([]*pb.Bridge, 0, len(infos))}
+	for _, info := range infos {
+		resp.Bridges = append(resp.Bridges, convertBridge(info))
+	}
+	return resp, nil
+}
+
+// StopBridge stops a bridge on behalf of the holder of either of its
+// sessions. The ports stay open.
+func (s *SerialServer) StopBridge(ctx context.Context, req *pb.StopBridgeRequest) (*pb.StopBridgeResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	if err := s.checkBridgeAccess(ctx, req.SessionId); err != nil {
+		return nil, err
+	}
+	info, err := s.bridges.Stop(req.Name, req.SessionId)
+	if err != nil {
+		return nil, bridgeError(req.Name, err)
+	}
+	return &pb.StopBridgeResponse{Bridge: convertBridge(info)}, nil
+}
+
+// SetBridgeRules replaces the interception rules of a running bridge on
+// behalf of the holder of either of its sessions; no rules forward the data
+// unchanged again
+func (s *SerialServer) SetBridgeRules(ctx context.Context, req *pb.SetBridgeRulesRequest) (*pb.SetBridgeRulesResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	rules, err := convertBridgeRules(req.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkBridgeAccess(ctx, req.SessionId); err != nil {
+		return nil, err
+	}
+	info, err := s.bridges.SetRules(req.Name, req.SessionId, rules)
+	if err != nil {
+		return nil, bridgeError(req.Name, err)
+	}
+	s.logger.Info("bridge rules changed", "bridge", req.Name, "rules", len(rules), "client", s.clientIdentity(ctx))
+	return &pb.SetBridgeRulesResponse{Bridge: convertBridge(info)}, nil
+}
+
+// checkBridgeAccess applies the access policy to the port of the session
+// a bridge call is made on behalf of
+func (s *SerialServer) checkBridgeAccess(ctx context.Context, sessionID string) error {
+	session := s.manager.GetSessionByID(sessionID)
+	if session == nil {
+		return nil
+	}
+	return s.checkSessionAccess(ctx, session.PortName)
+}
+
+// checkAgentReaders refuses to read a port a bridge or G-code job reads,
+// as it would lose the data
+func (s *SerialServer) checkAgentReaders(portName string) error {
+	if name, bridged := s.bridges.Reading(portName); bridged {
+		return status.Errorf(codes.FailedPrecondition, "%s is read by bridge %s", portName, name)
+	}
+	if s.gcode.Reading(portName) {
+		return status.Errorf(codes.FailedPrecondition, "%s is read by a G-code job", portName)
+	}
+	return nil
+}
+
+// bridgeError converts a bridge failure to a gRPC status
+func bridgeError(name string, err error) error {
+	switch {
+	case errors.Is(err, bridge.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, bridge.ErrExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, bridge.ErrPortBridged), errors.Is(err, serial.ErrPortNotOpen):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, bridge.ErrNotFound):
+		return status.Errorf(codes.NotFound, "bridge %q not found", name)
+	case errors.Is(err, bridge.ErrNotHolder), errors.Is(err, serial.ErrInvalidSession):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Errorf(codes.Internal, "bridge failed: %v", err)
+}
+
+func convertBridge(info bridge.Info) *pb.Bridge {
+	b := &pb.Bridge{
+		Name:      info.Name,
+		PortA:     info.A.PortName,
+		PortB:     info.B.PortName,
+		OneWay:    info.OneWay,
+		LogData:   info.Log,
+		StartedAt: info.Started.UnixNano(),
+		BytesAToB: info.BytesAToB,
+		BytesBToA: info.BytesBToA,
+		Rules:     make([]*pb.BridgeRule, 0, len(info.RuleStats)),
+	}
+	for _, rule := range info.RuleStats {
+		b.Rules = append(b.Rules, &pb.BridgeRule{
+			Direction: convertBridgeDirection(rule.Direction),
+			Pattern:   rule.Pattern.String(),
+			Action:    convertBridgeAction(rule.Action),
+			Data:      rule.Data,
+			DelayMs:   uint32(rule.Delay.Milliseconds()),
+			Hits:      rule.Hits,
+		})
+	}
+	return b
+}
+
+// convertBridgeRules compiles the interception rules of a request
+func convertBridgeRules(rules []*pb.BridgeRule) ([]bridge.Rule, error) {
+	converted := make([]bridge.Rule, 0, len(rules))
+	for i, rule := range rules {
+		pattern, err := regexp.Compile(rule.Pattern)
 		if err != nil {
-			return status.Errorf(codes.Internal, "write failed: %v", err)
+			return nil, status.Errorf(codes.InvalidArgument, "rule %d: invalid pattern: %v", i+1, err)
 		}
 
-		atomic.AddUint64(&totalBytes, uint64(n))
-		atomic.AddUint32(&chunksProcessed, 1)
+		r := bridge.Rule{
+			Pattern: pattern,
+			Data:    rule.Data,
+			Delay:   time.Duration(rule.DelayMs) * time.Millisecond,
+		}
+		switch rule.Direction {
+		case pb.BridgeDirection_BRIDGE_DIRECTION_A_TO_B:
+			r.Direction = bridge.DirectionAToB
+		case pb.BridgeDirection_BRIDGE_DIRECTION_B_TO_A:
+			r.Direction = bridge.DirectionBToA
+		}
+		switch rule.Action {
+		case pb.BridgeRuleAction_BRIDGE_RULE_ACTION_DROP:
+			r.Action = bridge.ActionDrop
+		case pb.BridgeRuleAction_BRIDGE_RULE_ACTION_REPLACE:
+			r.Action = bridge.ActionReplace
+		case pb.BridgeRuleAction_BRIDGE_RULE_ACTION_DELAY:
+			r.Action = bridge.ActionDelay
+		case pb.BridgeRuleAction_BRIDGE_RULE_ACTION_INJECT:
+			r.Action = bridge.ActionInject
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "rule %d: action is required", i+1)
+		}
+		if err := r.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "rule %d: %v", i+1, err)
+		}
+		converted = append(converted, r)
 	}
+	return converted, nil
 }
 
-// BiDirectionalStream handles bidirectional streaming
-func (s *SerialServer) BiDirectionalStream(stream pb.SerialService_BiDirectionalStreamServer) error {
-	ctx := stream.Context()
-	errChan := make(chan error, 2)
-	var portName string
-	var sessionID string
+func convertBridgeDirection(d bridge.Direction) pb.BridgeDirection {
+	switch d {
+	case bridge.DirectionAToB:
+		return pb.BridgeDirection_BRIDGE_DIRECTION_A_TO_B
+	case bridge.DirectionBToA:
+		return pb.BridgeDirection_BRIDGE_DIRECTION_B_TO_A
+	default:
+		return pb.BridgeDirection_BRIDGE_DIRECTION_BOTH
+	}
+}
 
-	// Handle incoming writes in separate goroutine
-	go s.handleBiDirectionalWrites(stream, &portName, &sessionID, errChan)
+func convertBridgeAction(a bridge.Action) pb.BridgeRuleAction {
+	switch a {
+	case bridge.ActionDrop:
+		return pb.BridgeRuleAction_BRIDGE_RULE_ACTION_DROP
+	case bridge.ActionReplace:
+		return pb.BridgeRuleAction_BRIDGE_RULE_ACTION_REPLACE
+	case bridge.ActionDelay:
+		return pb.BridgeRuleAction_BRIDGE_RULE_ACTION_DELAY
+	case bridge.ActionInject:
+		return pb.BridgeRuleAction_BRIDGE_RULE_ACTION_INJECT
+	default:
+		return pb.BridgeRuleAction_BRIDGE_RULE_ACTION_UNSPECIFIED
+	}
+}
 
-	// Wait for port to be set or error
-	ticker := time.NewTicker(100 * time.Millisecond)
-	defer ticker.Stop()
+// ============================================================================
+// G-code
+// ============================================================================
 
-	for portName == "" {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case err := <-errChan:
-			return err
-		case <-ticker.C:
-			continue
+// StartGcodeJob starts sending a G-code program to a printer or CNC
+// controller with the flow control of its firmware. The job runs on the
+// agent until it ends, whether or not the client stays connected.
+func (s *SerialServer) StartGcodeJob(ctx context.Context, req *pb.StartGcodeJobRequest) (*pb.StartGcodeJobResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+
+	def := gcode.Definition{
+		PortName:        req.PortName,
+		SessionID:       req.SessionId,
+		Name:            req.Name,
+		Program:         req.Program,
+		ResponseTimeout: time.Duration(req.ResponseTimeoutMs) * time.Millisecond,
+		BufferSize:      int(req.BufferSize),
+	}
+	var err error
+	if def.Dialect, err = convertGcodeDialect(req.Dialect); err != nil {
+		return nil, err
+	}
+
+	// Rejected as a whole rather than stopping halfway
+	for i, command := range gcode.Parse(req.Program) {
+		if err := s.checkUnheldWrite(ctx, req.PortName, command); err != nil {
+			return nil, status.Errorf(status.Code(err), "command %d: %s", i+1, status.Convert(err).Message())
 		}
 	}
 
-	// Create reader for outgoing data and handle reads
-	reader := serial.NewReader(s.manager, portName, sessionID, 1024)
-	if err := reader.Start(ctx); err != nil {
-		return status.Errorf(codes.Internal, "failed to start reader: %v", err)
+	s.readersMu.Lock()
+	defer s.readersMu.Unlock()
+	if _, reading := s.readers[req.PortName]; reading || s.capturing[req.PortName] {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is already being streamed", req.PortName)
+	}
+	if name, bridged := s.bridges.Reading(req.PortName); bridged {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is read by bridge %s", req.PortName, name)
 	}
-	defer reader.Stop()
 
-	return s.handleBiDirectionalReads(stream, ctx, errChan, reader, portName)
+	info, err := s.gcode.Start(def)
+	if err != nil {
+		return nil, gcodeError(req.PortName, err)
+	}
+	s.logger.Info("G-code job requested", "port", req.PortName, "name", req.Name, "client", s.clientIdentity(ctx))
+	return &pb.StartGcodeJobResponse{Job: convertGcodeJob(info)}, nil
 }
 
-// handleBiDirectionalWrites handles incoming writes from the client
-func (s *SerialServer) handleBiDirectionalWrites(
-	stream pb.SerialService_BiDirectionalStreamServer,
-	portName *string,
-	sessionID *string,
-	errChan chan error,
-) {
+// ControlGcodeJob pauses, resumes or cancels the job of a port on behalf of
+// the holder of its session
+func (s *SerialServer) ControlGcodeJob(ctx context.Context, req *pb.ControlGcodeJobRequest) (*pb.ControlGcodeJobResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+
+	var info gcode.Info
+	var err error
+	switch req.Action {
+	case pb.GcodeJobAction_GCODE_JOB_ACTION_PAUSE:
+		info, err = s.gcode.Pause(req.PortName, req.SessionId)
+	case pb.GcodeJobAction_GCODE_JOB_ACTION_RESUME:
+		info, err = s.gcode.Resume(req.PortName, req.SessionId)
+	case pb.GcodeJobAction_GCODE_JOB_ACTION_CANCEL:
+		info, err = s.gcode.Cancel(req.PortName, req.SessionId)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "action is required")
+	}
+	if err != nil {
+		return nil, gcodeError(req.PortName, err)
+	}
+	return &pb.ControlGcodeJobResponse{Job: convertGcodeJob(info)}, nil
+}
+
+// GetGcodeJob returns the progress of the job of a port, which may have
+// finished
+func (s *SerialServer) GetGcodeJob(ctx context.Context, req *pb.GetGcodeJobRequest) (*pb.GetGcodeJobResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	info, err := s.gcode.Get(req.PortName)
+	if err != nil {
+		return nil, gcodeError(req.PortName, err)
+	}
+	return &pb.GetGcodeJobResponse{Job: convertGcodeJob(info)}, nil
+}
+
+// StreamGcodeJob sends the progress of the job of a port at an interval
+// until it ends, the last message carrying its final state
+func (s *SerialServer) StreamGcodeJob(req *pb.StreamGcodeJobRequest, stream pb.SerialService_StreamGcodeJobServer) error {
+	if req.PortName == "" {
+		return status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	interval := defaultStatusInterval
+	if req.IntervalMs > 0 {
+		interval = max(time.Duration(req.IntervalMs)*time.Millisecond, minStatusInterval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 	for {
-		chunk, err := stream.Recv()
-		if err == io.EOF {
-			errChan <- nil
-			return
-		}
+		info, err := s.gcode.Get(req.PortName)
 		if err != nil {
-			errChan <- err
-			return
+			return gcodeError(req.PortName, err)
 		}
-
-		// Initialize port and session on first message
-		if *portName == "" {
-			*portName = chunk.GetChunk().PortName
-			session := s.manager.GetSession(*portName)
-			if session == nil {
-				errChan <- status.Error(codes.NotFound, "port not open")
-				return
-			}
-			*sessionID = session.ID
+		if err := stream.Send(&pb.StreamGcodeJobResponse{Job: convertGcodeJob(info)}); err != nil {
+			return err
+		}
+		if info.State.Finished() {
+			return nil
 		}
 
-		// Write data to the serial port
-		_, err = s.manager.Write(*portName, *sessionID, chunk.GetChunk().Data)
-		if err != nil {
-			errChan <- status.Errorf(codes.Internal, "write failed: %v", err)
-			return
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
 		}
 	}
 }
 
-// handleBiDirectionalReads handles reading from the serial port and sending to client
-func (s *SerialServer) handleBiDirectionalReads(
-	stream pb.SerialService_BiDirectionalStreamServer,
-	ctx context.Context,
-	errChan chan error,
-	reader *serial.Reader,
-	portName string,
-) error {
-	subscription := reader.Subscribe()
-	var sequence uint32
+// ConnectMachine puts an open port in G-code mode, so the status of its
+// printer or CNC controller is polled and it can be jogged between jobs,
+// until DisconnectMachine
+func (s *SerialServer) ConnectMachine(ctx context.Context, req *pb.ConnectMachineRequest) (*pb.ConnectMachineResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+	dialect, err := convertGcodeDialect(req.Dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	s.readersMu.Lock()
+	defer s.readersMu.Unlock()
+	if _, reading := s.readers[req.PortName]; reading || s.capturing[req.PortName] {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is already being streamed", req.PortName)
+	}
+	if name, bridged := s.bridges.Reading(req.PortName); bridged {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s is read by bridge %s", req.PortName, name)
+	}
+
+	machine, err := s.gcode.Connect(gcode.MachineDefinition{
+		PortName:       req.PortName,
+		SessionID:      req.SessionId,
+		Dialect:        dialect,
+		StatusInterval: time.Duration(req.StatusIntervalMs) * time.Millisecond,
+		BufferSize:     int(req.BufferSize),
+	})
+	if err != nil {
+		return nil, gcodeError(req.PortName, err)
+	}
+	s.logger.Info("machine connect requested", "port", req.PortName, "client", s.clientIdentity(ctx))
+	return &pb.ConnectMachineResponse{Status: s.convertMachineStatus(machine)}, nil
+}
+
+// DisconnectMachine takes a port out of G-code mode on behalf of the holder
+// of its session; the port stays open
+func (s *SerialServer) DisconnectMachine(ctx context.Context, req *pb.DisconnectMachineRequest) (*pb.DisconnectMachineResponse, error) {
+	if req.PortName == "" {
+		return nil, status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
+	if err := s.gcode.Disconnect(req.PortName, req.SessionId); err != nil {
+		return nil, gcodeError(req.PortName, err)
+	}
+	return &pb.DisconnectMachineResponse{
+		Success: true,
+		Message: fmt.Sprintf("%s disconnected", req.PortName),
+	}, nil
+}
+
+// StreamMachineStatus sends the status of the machine of a port at an
+// interval, or when it changes, until the machine is disconnected
+func (s *SerialServer) StreamMachineStatus(req *pb.StreamMachineStatusRequest, stream pb.SerialService_StreamMachineStatusServer) error {
+	if req.PortName == "" {
+		return status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	interval := defaultStatusInterval
+	if req.IntervalMs > 0 {
+		interval = max(time.Duration(req.IntervalMs)*time.Millisecond, minStatusInterval)
+	}
 
+	var last *pb.MachineStatus
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 	for {
-		select {
-		case <-ctx.Done():
+		machine, err := s.gcode.Status(req.PortName)
+		switch {
+		case errors.Is(err, gcode.ErrNotConnected) && last != nil:
 			return nil
-		case err := <-errChan:
-			return err
-		case event, ok := <-subscription:
-			if !ok {
-				return nil
-			}
-			if event.Error != nil {
-				continue
-			}
-
-			sequence++
-			chunk := &pb.DataChunk{
-				PortName:  portName,
-				Data:      event.Data,
-				Timestamp: event.Timestamp.UnixNano(),
-				Sequence:  sequence,
-			}
+		case err != nil:
+			return gcodeError(req.PortName, err)
+		}
 
-			if err := stream.Send(&pb.BiDirectionalStreamResponse{Chunk: chunk}); err != nil {
+		current := s.convertMachineStatus(machine)
+		if !req.OnChange || last == nil || machineStatusChanged(last, current) {
+			last = current
+			if err := stream.Send(&pb.StreamMachineStatusResponse{
+				Status:    current,
+				Timestamp: time.Now().UnixNano(),
+			}); err != nil {
 				return err
 			}
 		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
 	}
 }
 
-// ============================================================================
-// Port Configuration
-// ============================================================================
-
-// ConfigurePort configures a port
-func (s *SerialServer) ConfigurePort(ctx context.Context, req *pb.ConfigurePortRequest) (*pb.ConfigurePortResponse, error) {
+// JogMachine moves the machine of a port by a distance on behalf of the
+// holder of its session, once it has taken the move. Jogging is refused
+// while a job runs.
+func (s *SerialServer) JogMachine(ctx context.Context, req *pb.JogMachineRequest) (*pb.JogMachineResponse, error) {
 	if req.PortName == "" {
 		return nil, status.Error(codes.InvalidArgument, "port_name is required")
 	}
-	if req.SessionId == "" {
-		return nil, status.Error(codes.InvalidArgument, "session_id is required")
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
 	}
 
-	cfg := s.convertToSerialConfig(req.Config)
-
-	err := s.manager.Configure(req.PortName, req.SessionId, cfg)
+	jog := gcode.Jog{X: req.X, Y: req.Y, Z: req.Z, FeedRate: req.FeedRate}
+	machine, err := s.gcode.Status(req.PortName)
 	if err != nil {
-		return &pb.ConfigurePortResponse{
-			Success: false,
-			Message: err.Error(),
-		}, nil
+		return nil, gcodeError(req.PortName, err)
+	}
+	lines, err := jog.Lines(machine.Dialect)
+	if err != nil {
+		return nil, gcodeError(req.PortName, err)
+	}
+	for _, line := range lines {
+		if err := s.checkUnheldWrite(ctx, req.PortName, line); err != nil {
+			return nil, err
+		}
 	}
 
-	return &pb.ConfigurePortResponse{
-		Success: true,
-		Message: "port configured successfully",
-	}, nil
+	if err := s.gcode.Jog(ctx, req.PortName, req.SessionId, jog); err != nil {
+		return nil, gcodeError(req.PortName, err)
+	}
+	machine, err = s.gcode.Status(req.PortName)
+	if err != nil {
+		return nil, gcodeError(req.PortName, err)
+	}
+	return &pb.JogMachineResponse{Status: s.convertMachineStatus(machine)}, nil
 }
 
-// GetPortConfig returns the current configuration of a port
-func (s *SerialServer) GetPortConfig(ctx context.Context, req *pb.GetPortConfigRequest) (*pb.GetPortConfigResponse, error) {
+// SendMachineCommand sends a feed hold, cycle start or reset to the machine
+// of a port on behalf of the holder of its session
+func (s *SerialServer) SendMachineCommand(ctx context.Context, req *pb.SendMachineCommandRequest) (*pb.SendMachineCommandResponse, error) {
 	if req.PortName == "" {
 		return nil, status.Error(codes.InvalidArgument, "port_name is required")
 	}
+	if err := s.checkSessionAccess(ctx, req.PortName); err != nil {
+		return nil, err
+	}
 
-	session, err := s.manager.GetStatus(req.PortName)
+	var command gcode.Command
+	switch req.Command {
+	case pb.MachineCommand_MACHINE_COMMAND_FEED_HOLD:
+		command = gcode.CommandFeedHold
+	case pb.MachineCommand_MACHINE_COMMAND_CYCLE_START:
+		command = gcode.CommandCycleStart
+	case pb.MachineCommand_MACHINE_COMMAND_RESET:
+		command = gcode.CommandReset
+	default:
+		return nil, status.Error(codes.InvalidArgument, "command is required")
+	}
+
+	if err := s.gcode.Command(req.PortName, req.SessionId, command); err != nil {
+		return nil, gcodeError(req.PortName, err)
+	}
+	s.logger.Info("machine command sent", "port", req.PortName, "command", command, "client", s.clientIdentity(ctx))
+
+	machine, err := s.gcode.Status(req.PortName)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "port not open: %v", err)
+		return nil, gcodeError(req.PortName, err)
 	}
+	return &pb.SendMachineCommandResponse{Status: s.convertMachineStatus(machine)}, nil
+}
 
-	return &pb.GetPortConfigResponse{Config: s.convertFromSerialConfig(session.Config)}, nil
+// gcodeError converts a G-code job or machine failure to a gRPC status
+func gcodeError(portName string, err error) error {
+	switch {
+	case errors.Is(err, gcode.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, gcode.ErrBusy), errors.Is(err, gcode.ErrFinished), errors.Is(err, gcode.ErrConnected),
+		errors.Is(err, gcode.ErrNotReady), errors.Is(err, serial.ErrPortNotOpen), errors.Is(err, serial.ErrPortClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, gcode.ErrNotFound):
+		return status.Errorf(codes.NotFound, "no G-code job on %s", portName)
+	case errors.Is(err, gcode.ErrNotConnected):
+		return status.Errorf(codes.NotFound, "no machine is connected on %s", portName)
+	case errors.Is(err, gcode.ErrNotHolder), errors.Is(err, serial.ErrInvalidSession):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return status.FromContextError(err).Err()
+	}
+	return status.Errorf(codes.Internal, "G-code failed: %v", err)
+}
+
+// convertGcodeDialect returns the dialect of a request
+func convertGcodeDialect(d pb.GcodeDialect) (gcode.Dialect, error) {
+	switch d {
+	case pb.GcodeDialect_GCODE_DIALECT_MARLIN:
+		return gcode.DialectMarlin, nil
+	case pb.GcodeDialect_GCODE_DIALECT_GRBL:
+		return gcode.DialectGRBL, nil
+	}
+	return "", status.Error(codes.InvalidArgument, "dialect is required")
+}
+
+func convertGcodeDialectBack(d gcode.Dialect) pb.GcodeDialect {
+	switch d {
+	case gcode.DialectMarlin:
+		return pb.GcodeDialect_GCODE_DIALECT_MARLIN
+	case gcode.DialectGRBL:
+		return pb.GcodeDialect_GCODE_DIALECT_GRBL
+	default:
+		return pb.GcodeDialect_GCODE_DIALECT_UNSPECIFIED
+	}
+}
+
+// convertMachineStatus converts a machine's status with the job of its
+// port, if any
+func (s *SerialServer) convertMachineStatus(machine gcode.Status) *pb.MachineStatus {
+	m := &pb.MachineStatus{
+		PortName:        machine.PortName,
+		Dialect:         convertGcodeDialectBack(machine.Dialect),
+		State:           machine.State,
+		MachinePosition: machine.Position,
+		WorkPosition:    machine.WorkPosition,
+		Temperatures:    make([]*pb.MachineTemperature, 0, len(machine.Temperatures)),
+		FeedRate:        machine.FeedRate,
+		SpindleSpeed:    machine.SpindleSpeed,
+		Error:           machine.Error,
+	}
+	if !machine.Updated.IsZero() {
+		m.UpdatedAt = machine.Updated.UnixNano()
+	}
+	for _, t := range machine.Temperatures {
+		m.Temperatures = append(m.Temperatures, &pb.MachineTemperature{Sensor: t.Sensor, Current: t.Current, Target: t.Target})
+	}
+	if job, err := s.gcode.Get(machine.PortName); err == nil {
+		m.Job = convertGcodeJob(job)
+	}
+	return m
+}
+
+// machineStatusChanged reports whether the machine or its job reported
+// anything new
+func machineStatusChanged(a, b *pb.MachineStatus) bool {
+	if a.UpdatedAt != b.UpdatedAt || (a.Job == nil) != (b.Job == nil) {
+		return true
+	}
+	return a.Job != nil && (a.Job.State != b.Job.State || a.Job.AcknowledgedLines != b.Job.AcknowledgedLines)
+}
+
+func convertGcodeJob(info gcode.Info) *pb.GcodeJob {
+	job := &pb.GcodeJob{
+		PortName:          info.PortName,
+		Name:              info.Name,
+		Error:             info.Error,
+		TotalLines:        uint32(info.Lines),
+		SentLines:         uint32(info.Sent),
+		AcknowledgedLines: uint32(info.Acknowledged),
+		StartedAt:         info.Started.UnixNano(),
+		ElapsedMs:         info.Elapsed().Milliseconds(),
+		RemainingMs:       info.Remaining().Milliseconds(),
+	}
+	if info.Lines > 0 {
+		job.Progress = 100 * float64(info.Acknowledged) / float64(info.Lines)
+	}
+	if !info.Finished.IsZero() {
+		job.FinishedAt = info.Finished.UnixNano()
+	}
+	job.Dialect = convertGcodeDialectBack(info.Dialect)
+	switch info.State {
+	case gcode.StateRunning:
+		job.State = pb.GcodeJobState_GCODE_JOB_STATE_RUNNING
+	case gcode.StatePaused:
+		job.State = pb.GcodeJobState_GCODE_JOB_STATE_PAUSED
+	case gcode.StateCompleted:
+		job.State = pb.GcodeJobState_GCODE_JOB_STATE_COMPLETED
+	case gcode.StateFailed:
+		job.State = pb.GcodeJobState_GCODE_JOB_STATE_FAILED
+	case gcode.StateCancelled:
+		job.State = pb.GcodeJobState_GCODE_JOB_STATE_CANCELLED
+	}
+	return job
 }
 
 // ============================================================================
-// Health & Diagnostics
+// Firmware Upload
 // ============================================================================
 
-// Ping checks if the server is alive
-func (s *SerialServer) Ping(ctx context.Context, req *pb.PingRequest) (*pb.PingResponse, error) {
-	message := req.Message
-	if message == "" {
-		message = "pong"
+// UploadFirmware resets an Arduino-style board into its bootloader and
+// flashes a firmware image, streaming the progress. The port must not be
+// open; the upload holds it until it ends.
+func (s *SerialServer) UploadFirmware(req *pb.UploadFirmwareRequest, stream pb.SerialService_UploadFirmwareServer) error {
+	if req.PortName == "" {
+		return status.Error(codes.InvalidArgument, "port_name is required")
+	}
+	image, err := flash.ParseImage(req.Firmware)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	protocol, err := convertBootloaderProtocol(req.Protocol)
+	if err != nil {
+		return err
+	}
+	reset, err := convertBootloaderReset(req.ResetMethod)
+	if err != nil {
+		return err
 	}
 
-	return &pb.PingResponse{
-		Message:    message,
-		ServerTime: time.Now().Unix(),
-	}, nil
+	ctx := stream.Context()
+	if err := s.checkAccess(ctx, req.PortName, req.ClientId); err != nil {
+		return err
+	}
+	if err := s.checkReservation(ctx, req.PortName); err != nil {
+		return err
+	}
+
+	s.logger.Info("firmware upload started", "port", req.PortName, "protocol", protocol, "bytes", len(image), "client", ClientAddress(ctx))
+	var sendErr error
+	result, err := s.uploads.Upload(ctx, flash.Request{
+		PortName: req.PortName,
+		Image:    image,
+		Protocol: protocol,
+		Reset:    reset,
+		BaudRate: int(req.BaudRate),
+		Verify:   !req.SkipVerify,
+		ClientID: req.ClientId,
+	}, func(p flash.Progress) {
+		if sendErr == nil {
+			sendErr = stream.Send(&pb.UploadFirmwareResponse{
+				Stage:      convertUploadStage(p.Stage),
+				PortName:   p.PortName,
+				BytesDone:  uint32(p.Done),
+				BytesTotal: uint32(p.Total),
+				Message:    p.Message,
+			})
+		}
+	})
+	if err != nil {
+		s.logger.Warn("firmware upload failed", "port", req.PortName, "error", err)
+		return uploadError(req.PortName, err)
+	}
+	if sendErr != nil {
+		return sendErr
+	}
+
+	s.logger.Info("firmware uploaded", "port", result.PortName, "device", result.Device, "bytes", result.Bytes, "verified", result.Verified, "duration", result.Duration)
+	return stream.Send(&pb.UploadFirmwareResponse{
+		Stage:      pb.UploadStage_UPLOAD_STAGE_DONE,
+		PortName:   result.PortName,
+		BytesDone:  uint32(result.Bytes),
+		BytesTotal: uint32(result.Bytes),
+		Message:    fmt.Sprintf("wrote %d bytes", result.Bytes),
+		Device:     result.Device,
+		Verified:   result.Verified,
+		DurationMs: uint64(result.Duration.Milliseconds()),
+	})
 }
 
-// GetAgentInfo returns information about the agent
-func (s *SerialServer) GetAgentInfo(ctx context.Context, req *pb.GetAgentInfoRequest) (*pb.GetAgentInfoResponse, error) {
-	return &pb.GetAgentInfoResponse{
-		Info: &pb.AgentInfo{
-			Version:       Version,
-			BuildCommit:   Commit,
-			BuildDate:     BuildDate,
-			Os:            runtime.GOOS,
-			Arch:          runtime.GOARCH,
-			UptimeSeconds: int64(time.Since(s.startTime).Seconds()),
-			SupportedFeatures: []string{
-				"grpc",
-				"port-scan",
-				"port-lock",
-				"streaming",
-				"bidirectional-streaming",
-			},
-			Config: &pb.AgentConfig{
-				GrpcAddress:    s.config.Server.GRPCAddress,
-				TlsEnabled:     s.config.TLS.Enabled,
-				MaxConnections: uint32(s.config.Server.MaxConnections),
-			},
-		},
-	}, nil
+// uploadError converts an upload failure to a gRPC status
+func uploadError(portName string, err error) error {
+	switch {
+	case errors.Is(err, serial.ErrPortLocked):
+		return status.Errorf(codes.FailedPrecondition, "%s is open; close its sessions before uploading", portName)
+	case errors.Is(err, flash.ErrInvalidImage):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, flash.ErrNoBootloader), errors.Is(err, flash.ErrPortNotFound):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, flash.ErrProtocol), errors.Is(err, flash.ErrVerifyFailed):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return status.FromContextError(err).Err()
+	}
+	return status.Errorf(codes.Internal, "upload failed: %v", err)
+}
+
+func convertBootloaderProtocol(p pb.BootloaderProtocol) (flash.Protocol, error) {
+	switch p {
+	case pb.BootloaderProtocol_BOOTLOADER_PROTOCOL_UNSPECIFIED, pb.BootloaderProtocol_BOOTLOADER_PROTOCOL_STK500V1:
+		return flash.ProtocolSTK500v1, nil
+	case pb.BootloaderProtocol_BOOTLOADER_PROTOCOL_AVR109:
+		return flash.ProtocolAVR109, nil
+	}
+	return "", status.Errorf(codes.InvalidArgument, "unknown bootloader protocol %d", p)
+}
+
+func convertBootloaderReset(r pb.BootloaderReset) (flash.Reset, error) {
+	switch r {
+	case pb.BootloaderReset_BOOTLOADER_RESET_UNSPECIFIED:
+		return flash.ResetAuto, nil
+	case pb.BootloaderReset_BOOTLOADER_RESET_DTR:
+		return flash.ResetDTR, nil
+	case pb.BootloaderReset_BOOTLOADER_RESET_TOUCH_1200:
+		return flash.ResetTouch1200, nil
+	case pb.BootloaderReset_BOOTLOADER_RESET_NONE:
+		return flash.ResetNone, nil
+	}
+	return "", status.Errorf(codes.InvalidArgument, "unknown bootloader reset %d", r)
+}
+
+func convertUploadStage(stage flash.Stage) pb.UploadStage {
+	switch stage {
+	case flash.StageResetting:
+		return pb.UploadStage_UPLOAD_STAGE_RESETTING
+	case flash.StageConnecting:
+		return pb.UploadStage_UPLOAD_STAGE_CONNECTING
+	case flash.StageErasing:
+		return pb.UploadStage_UPLOAD_STAGE_ERASING
+	case flash.StageWriting:
+		return pb.UploadStage_UPLOAD_STAGE_WRITING
+	case flash.StageVerifying:
+		return pb.UploadStage_UPLOAD_STAGE_VERIFYING
+	case flash.StageDone:
+		return pb.UploadStage_UPLOAD_STAGE_DONE
+	}
+	return pb.UploadStage_UPLOAD_STAGE_UNSPECIFIED
 }
 
 // ============================================================================
 // Helper functions
 // ============================================================================
 
+// scannedPortNames lists the names of the ports a scanner finds
+func scannedPortNames(scanner *serial.Scanner) func() ([]string, error) {
+	return func() ([]string, error) {
+		ports, err := scanner.Scan()
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(ports))
+		for _, p := range ports {
+			names = append(names, p.Name)
+		}
+		return names, nil
+	}
+}
+
+// stopReader stops the active reader of a port, if any
+func (s *SerialServer) stopReader(portName string) {
+	s.readersMu.Lock()
+	defer s.readersMu.Unlock()
+
+	if reader, exists := s.readers[portName]; exists {
+		reader.Stop()
+		delete(s.readers, portName)
+	}
+}
+
 func (s *SerialServer) convertPortInfo(p serial.PortInfo) *pb.PortInfo {
 	return &pb.PortInfo{
 		Name:         p.Name,
@@ -649,10 +4727,16 @@ func (s *SerialServer) convertPortInfo(p serial.PortInfo) *pb.PortInfo {
 		PortType:     convertPortType(p.PortType),
 		IsOpen:       p.IsOpen,
 		LockedBy:     p.LockedBy,
+		DeviceFamily: p.DeviceFamily,
 	}
 }
 
 func (s *SerialServer) convertToSerialConfig(cfg *pb.PortConfig) serial.PortConfig {
+	// Validated when the configuration is loaded
+	defaultLatency, _ := serial.ParseLatencyProfile(s.config.Serial.Defaults.LatencyProfile)
+	defaultDTR, _ := serial.ParseLineState(s.config.Serial.Defaults.DTR)
+	defaultRTS, _ := serial.ParseLineState(s.config.Serial.Defaults.RTS)
+
 	if cfg == nil {
 		return serial.PortConfig{
 			BaudRate:       s.config.Serial.Defaults.BaudRate,
@@ -662,6 +4746,9 @@ func (s *SerialServer) convertToSerialConfig(cfg *pb.PortConfig) serial.PortConf
 			FlowControl:    serial.FlowControlNone,
 			ReadTimeoutMs:  s.config.Serial.Defaults.ReadTimeoutMs,
 			WriteTimeoutMs: s.config.Serial.Defaults.WriteTimeoutMs,
+			LatencyProfile: defaultLatency,
+			DTR:            defaultDTR,
+			RTS:            defaultRTS,
 		}
 	}
 
@@ -673,6 +4760,9 @@ func (s *SerialServer) convertToSerialConfig(cfg *pb.PortConfig) serial.PortConf
 		FlowControl:    convertFlowControl(cfg.FlowControl),
 		ReadTimeoutMs:  int(cfg.ReadTimeoutMs),
 		WriteTimeoutMs: int(cfg.WriteTimeoutMs),
+		LatencyProfile: convertLatencyProfile(cfg.LatencyProfile, defaultLatency),
+		DTR:            convertLineState(cfg.Dtr, defaultDTR),
+		RTS:            convertLineState(cfg.Rts, defaultRTS),
 	}
 }
 
@@ -685,6 +4775,157 @@ func (s *SerialServer) convertFromSerialConfig(cfg serial.PortConfig) *pb.PortCo
 		FlowControl:    convertFlowControlBack(cfg.FlowControl),
 		ReadTimeoutMs:  uint32(cfg.ReadTimeoutMs),
 		WriteTimeoutMs: uint32(cfg.WriteTimeoutMs),
+		LatencyProfile: convertLatencyProfileBack(cfg.LatencyProfile),
+		Dtr:            convertLineStateBack(cfg.DTR),
+		Rts:            convertLineStateBack(cfg.RTS),
+	}
+}
+
+// convertLineState maps an unspecified line state to fallback
+func convertLineState(ls pb.LineState, fallback serial.LineState) serial.LineState {
+	switch ls {
+	case pb.LineState_LINE_STATE_DEFAULT:
+		return serial.LineStateDefault
+	case pb.LineState_LINE_STATE_HIGH:
+		return serial.LineStateHigh
+	case pb.LineState_LINE_STATE_LOW:
+		return serial.LineStateLow
+	case pb.LineState_LINE_STATE_UNTOUCHED:
+		return serial.LineStateUntouched
+	default:
+		return fallback
+	}
+}
+
+func convertLineStateBack(ls serial.LineState) pb.LineState {
+	switch ls {
+	case serial.LineStateHigh:
+		return pb.LineState_LINE_STATE_HIGH
+	case serial.LineStateLow:
+		return pb.LineState_LINE_STATE_LOW
+	case serial.LineStateUntouched:
+		return pb.LineState_LINE_STATE_UNTOUCHED
+	default:
+		return pb.LineState_LINE_STATE_DEFAULT
+	}
+}
+
+// convertLatencyProfile maps an unspecified profile to serial.defaults
+func convertLatencyProfile(lp pb.LatencyProfile, fallback serial.LatencyProfile) serial.LatencyProfile {
+	switch lp {
+	case pb.LatencyProfile_LATENCY_PROFILE_DEFAULT:
+		return serial.LatencyProfileDefault
+	case pb.LatencyProfile_LATENCY_PROFILE_LOW:
+		return serial.LatencyProfileLow
+	default:
+		return fallback
+	}
+}
+
+func convertLatencyProfileBack(lp serial.LatencyProfile) pb.LatencyProfile {
+	switch lp {
+	case serial.LatencyProfileLow:
+		return pb.LatencyProfile_LATENCY_PROFILE_LOW
+	default:
+		return pb.LatencyProfile_LATENCY_PROFILE_DEFAULT
+	}
+}
+
+func convertPriority(p pb.SessionPriority, fallback serial.Priority) serial.Priority {
+	switch p {
+	case pb.SessionPriority_SESSION_PRIORITY_BULK:
+		return serial.PriorityBulk
+	case pb.SessionPriority_SESSION_PRIORITY_NORMAL:
+		return serial.PriorityNormal
+	case pb.SessionPriority_SESSION_PRIORITY_CRITICAL:
+		return serial.PriorityCritical
+	default:
+		return fallback
+	}
+}
+
+func convertPriorityBack(p serial.Priority) pb.SessionPriority {
+	switch p {
+	case serial.PriorityBulk:
+		return pb.SessionPriority_SESSION_PRIORITY_BULK
+	case serial.PriorityCritical:
+		return pb.SessionPriority_SESSION_PRIORITY_CRITICAL
+	default:
+		return pb.SessionPriority_SESSION_PRIORITY_NORMAL
+	}
+}
+
+func convertChunking(c pb.StreamChunking) serial.BatchMode {
+	switch c {
+	case pb.StreamChunking_STREAM_CHUNKING_LOW_LATENCY:
+		return serial.BatchLowLatency
+	case pb.StreamChunking_STREAM_CHUNKING_THROUGHPUT:
+		return serial.BatchThroughput
+	default:
+		return serial.BatchAdaptive
+	}
+}
+
+// portStatusChanged reports whether a port's session or statistics differ
+// between two snapshots. Configuration changes arrive as port events.
+func portStatusChanged(a, b *pb.PortStatus) bool {
+	if a.IsOpen != b.IsOpen || a.SessionId != b.SessionId || a.PowerState != b.PowerState || a.Priority != b.Priority ||
+		a.ClosedAt != b.ClosedAt || convertShaping(a.Shaping) != convertShaping(b.Shaping) {
+		return true
+	}
+	if a.Statistics == nil || b.Statistics == nil {
+		return a.Statistics != b.Statistics
+	}
+	x, y := a.Statistics, b.Statistics
+	return x.BytesSent != y.BytesSent || x.BytesReceived != y.BytesReceived || x.Errors != y.Errors ||
+		x.GarbageBytes != y.GarbageBytes || x.BreakCount != y.BreakCount || x.LineQuality != y.LineQuality
+}
+
+func convertPowerStateBack(p serial.PowerState) pb.PowerState {
+	if p == serial.PowerDormant {
+		return pb.PowerState_POWER_STATE_DORMANT
+	}
+	return pb.PowerState_POWER_STATE_ACTIVE
+}
+
+// convertShaping returns the shaping of a request, the zero Shaping for nil
+func convertShaping(s *pb.BandwidthShaping) serial.Shaping {
+	if s == nil {
+		return serial.Shaping{}
+	}
+	return serial.Shaping{
+		RxBytesPerSec: int(s.RxBytesPerSec),
+		TxBytesPerSec: int(s.TxBytesPerSec),
+		RxBurst:       int(s.RxBurst),
+		TxBurst:       int(s.TxBurst),
+	}
+}
+
+// convertShapingBack returns a session's shaping, nil when unlimited
+func convertShapingBack(s serial.Shaping) *pb.BandwidthShaping {
+	if !s.Enabled() {
+		return nil
+	}
+	return &pb.BandwidthShaping{
+		RxBytesPerSec: uint32(s.RxBytesPerSec),
+		TxBytesPerSec: uint32(s.TxBytesPerSec),
+		RxBurst:       uint32(s.RxBurst),
+		TxBurst:       uint32(s.TxBurst),
+	}
+}
+
+func convertCloseReason(r serial.CloseReason) pb.CloseReason {
+	switch r {
+	case serial.CloseReasonClient:
+		return pb.CloseReason_CLOSE_REASON_CLIENT
+	case serial.CloseReasonForced:
+		return pb.CloseReason_CLOSE_REASON_FORCED
+	case serial.CloseReasonDeviceRemoved:
+		return pb.CloseReason_CLOSE_REASON_DEVICE_REMOVED
+	case serial.CloseReasonShutdown:
+		return pb.CloseReason_CLOSE_REASON_AGENT_SHUTDOWN
+	default:
+		return pb.CloseReason_CLOSE_REASON_UNSPECIFIED
 	}
 }
 
@@ -696,7 +4937,8 @@ func convertPortType(pt serial.PortType) pb.PortType {
 		return pb.PortType_PORT_TYPE_NATIVE
 	case serial.PortTypeBluetooth:
 		return pb.PortType_PORT_TYPE_BLUETOOTH
-	case serial.PortTypeVirtual:
+	case serial.PortTypeVirtual, serial.PortTypeNetwork:
+		// Remote serial servers have no dedicated proto type yet
 		return pb.PortType_PORT_TYPE_VIRTUAL
 	default:
 		return pb.PortType_PORT_TYPE_UNSPECIFIED
@@ -788,3 +5030,116 @@ func convertFlowControlBack(fc serial.FlowControl) pb.FlowControl {
 		return pb.FlowControl_FLOW_CONTROL_NONE
 	}
 }
+
+// polledSample converts a poller sample to its protobuf form
+func polledSample(sample poller.Sample) *pb.PolledSample {
+	values := make([]*pb.PolledValue, 0, len(sample.Values))
+	for _, v := range sample.Values {
+		values = append(values, &pb.PolledValue{Name: v.Name, Value: v.Value, Unit: v.Unit})
+	}
+
+	result := &pb.PolledSample{
+		Poller:    sample.Poller,
+		PortName:  sample.PortName,
+		Timestamp: sample.Timestamp.UnixNano(),
+		Values:    values,
+	}
+	if sample.Err != nil {
+		result.Error = sample.Err.Error()
+	}
+	return result
+}
+
+// convertAlarm converts an alarm to its protobuf form
+func convertAlarm(a alarm.Alarm) *pb.Alarm {
+	result := &pb.Alarm{
+		Id:             a.ID,
+		Rule:           a.Rule.Name,
+		Poller:         a.Rule.Poller,
+		Field:          a.Rule.Field,
+		PortName:       a.PortName,
+		Severity:       a.Rule.Severity,
+		Message:        a.Description(),
+		Condition:      a.Rule.Condition,
+		Threshold:      a.Rule.Threshold,
+		Value:          a.Value,
+		State:          a.State,
+		RaisedAt:       a.RaisedAt.UnixNano(),
+		Acknowledged:   a.Acknowledged,
+		AcknowledgedBy: a.AcknowledgedBy,
+	}
+	if !a.ClearedAt.IsZero() {
+		result.ClearedAt = a.ClearedAt.UnixNano()
+	}
+	if !a.AcknowledgedAt.IsZero() {
+		result.AcknowledgedAt = a.AcknowledgedAt.UnixNano()
+	}
+	return result
+}
+
+// convertDeviceState converts a device status to its protobuf form
+func convertDeviceState(st devicestate.Status) *pb.DeviceState {
+	return &pb.DeviceState{
+		Device:        st.Device,
+		PortName:      st.PortName,
+		State:         st.State,
+		PreviousState: st.Previous,
+		Reason:        st.Reason,
+		Since:         st.Since.UnixNano(),
+	}
+}
+
+// convertPendingWrite converts a held write to its protobuf form
+func convertPendingWrite(p writepolicy.Pending) *pb.PendingWrite {
+	return &pb.PendingWrite{
+		ApprovalId:  p.ID,
+		PortName:    p.PortName,
+		SessionId:   p.SessionID,
+		Data:        p.Data,
+		Reason:      p.Reason,
+		RequestedBy: p.RequestedBy,
+		RequestedAt: p.RequestedAt.UnixNano(),
+		ExpiresAt:   p.ExpiresAt.UnixNano(),
+	}
+}
+
+// convertReservation converts a reservation to its protobuf form
+func convertReservation(r reservation.Reservation, now time.Time) *pb.Reservation {
+	return &pb.Reservation{
+		ReservationId: r.ID,
+		PortName:      r.PortName,
+		Holder:        r.Holder,
+		StartTime:     r.Start.UnixNano(),
+		EndTime:       r.End.UnixNano(),
+		Note:          r.Note,
+		CreatedBy:     r.CreatedBy,
+		CreatedAt:     r.CreatedAt.UnixNano(),
+		Active:        r.ActiveAt(now),
+	}
+}
+
+// convertTestReport converts a test report to its protobuf form
+func convertTestReport(r testrunner.Report) *pb.TestReport {
+	result := &pb.TestReport{
+		Suite:       r.Suite,
+		PortName:    r.PortName,
+		Trigger:     r.Trigger,
+		StartedAt:   r.StartedAt.UnixNano(),
+		DurationMs:  uint32(r.Duration.Milliseconds()),
+		Passed:      r.Passed,
+		ReportFiles: r.Files,
+	}
+	for _, step := range r.Steps {
+		result.Steps = append(result.Steps, &pb.TestStepResult{
+			Name:       step.Name,
+			Passed:     step.Passed,
+			Skipped:    step.Skipped,
+			Response:   step.Response,
+			Value:      step.Value,
+			Message:    step.Message,
+			Diff:       step.Diff,
+			DurationMs: uint32(step.Duration.Milliseconds()),
+		})
+	}
+	return result
+}
@@ -0,0 +1,379 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/acl"
+	"github.com/Shoaibashk/SerialLink/internal/reservation"
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// sseKeepAliveInterval is how often an idle event stream sends a comment
+	// so proxies do not time out the connection
+	sseKeepAliveInterval = 15 * time.Second
+
+	// sseMaxLineLength bounds buffered data before a partial line is emitted
+	sseMaxLineLength = 4096
+)
+
+// HTTPServer serves the plain HTTP endpoints of the agent
+type HTTPServer struct {
+	manager  *serial.Manager
+	metrics  http.Handler
+	bookings *reservation.Book
+	auth     *TokenAuth
+	access   *acl.Policy
+	// peerVerified is set when client certificates are verified outside
+	// the standard chain check (SPIFFE)
+	peerVerified bool
+	// admins are shown the session IDs of every port
+	admins []string
+	logger *log.Logger
+}
+
+// NewHTTPServer creates a new HTTPServer
+func NewHTTPServer(manager *serial.Manager, logger *log.Logger) *HTTPServer {
+	return &HTTPServer{
+		manager: manager,
+		logger:  logger,
+	}
+}
+
+// SetMetrics serves the given handler at /metrics
+func (s *HTTPServer) SetMetrics(handler http.Handler) {
+	s.metrics = handler
+}
+
+// SetReservationBook serves the reservations as an iCalendar feed at
+// /v1/reservations.ics
+func (s *HTTPServer) SetReservationBook(book *reservation.Book) {
+	s.bookings = book
+}
+
+// SetTokenAuth requires an API token on every request
+func (s *HTTPServer) SetTokenAuth(auth *TokenAuth) {
+	s.auth = auth
+}
+
+// SetAccessPolicy restricts port event streams to the clients the policy
+// allows
+func (s *HTTPServer) SetAccessPolicy(policy *acl.Policy, peerVerified bool) {
+	s.access = policy
+	s.peerVerified = peerVerified
+}
+
+// SetAdmins names the administrators (auth.admins), who are shown the
+// session IDs of sessions they did not open
+func (s *HTTPServer) SetAdmins(admins []string) {
+	s.admins = admins
+}
+
+// visibleSessionID returns the ID of a session as the caller may see it,
+// as the gRPC service shows it: those of open sessions only to their
+// opener and to administrators
+func (s *HTTPServer) visibleSessionID(ctx context.Context, sessionID string) string {
+	session := s.manager.GetSessionByID(sessionID)
+	identity := ClientIdentity(ctx, s.peerVerified)
+	if session == nil || session.Owner() == identity {
+		return sessionID
+	}
+	if caller, _ := AuthIdentity(ctx); caller.Scope == nil {
+		identities := append([]string{identity}, ClientGroups(ctx)...)
+		if slices.ContainsFunc(identities, func(id string) bool { return slices.Contains(s.admins, id) }) {
+			return sessionID
+		}
+	}
+	return ""
+}
+
+// Handler returns the HTTP handler with all routes registered. Port names
+// containing slashes must be URL-escaped (e.g. %2Fdev%2FttyUSB0).
+func (s *HTTPServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/ports/{name}/events", s.handlePortEvents)
+	if s.metrics != nil {
+		mux.Handle("GET /metrics", s.metrics)
+	}
+	if s.bookings != nil {
+		mux.HandleFunc("GET /v1/reservations.ics", s.handleReservationsICS)
+	}
+	return s.logRequests(s.auth.protect(mux))
+}
+
+// logRequests logs each request once it completes
+func (s *HTTPServer) logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		next.ServeHTTP(w, r)
+		s.logger.Debug("HTTP request completed",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"client", r.RemoteAddr,
+			"duration", time.Since(start))
+	})
+}
+
+// visiblePorts returns whether the access policy lets the caller see a
+// port, for listings across ports
+func (s *HTTPServer) visiblePorts(ctx context.Context) func(portName string) bool {
+	if s.access == nil {
+		return func(string) bool { return true }
+	}
+	identities := append([]string{ClientIdentity(ctx, s.peerVerified)}, ClientGroups(ctx)...)
+	return func(portName string) bool { return s.access.Allowed(portName, identities...) }
+}
+
+// handleReservationsICS serves current and upcoming reservations, of one
+// port with ?port=NAME, for calendar subscriptions. Ports the caller may
+// not see are left out.
+func (s *HTTPServer) handleReservationsICS(w http.ResponseWriter, r *http.Request) {
+	visible := s.visiblePorts(requestContext(r.Context(), r, ""))
+	var reservations []reservation.Reservation
+	for _, booked := range s.bookings.List(r.URL.Query().Get("port")) {
+		if visible(booked.PortName) {
+			reservations = append(reservations, booked)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := reservation.WriteICS(&buf, reservations, time.Now()); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="reservations.ics"`)
+	w.Header().Set("Cache-Control", "no-cache")
+	_, _ = w.Write(buf.Bytes())
+}
+
+// sseStatus is the payload of status and lifecycle events
+type sseStatus struct {
+	Port      string `json:"port"`
+	Open      bool   `json:"open"`
+	SessionID string `json:"session_id,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Message   string `json:"message,omitempty"`
+	Timestamp string `json:"timestamp"`
+	// Metadata is the session's metadata given at open
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// handlePortEvents streams a port as Server-Sent Events. Data read from the
+// port by its session owner is sent line by line as "line" events; open,
+// close and configure changes are sent as "opened", "closed" and
+// "configured" events, line-quality warnings as "line_quality" events,
+// threshold alarm changes for the port as "alarm" events, device state
+// changes as "device_state" events, sessions going dormant or waking as
+// "power_state" events, failed reads and writes as "error" events and
+// messages to the session's client, such as a warning before an
+// administrator closes it, as "notice" events.
+// Monitoring never consumes data from the port.
+func (s *HTTPServer) handlePortEvents(w http.ResponseWriter, r *http.Request) {
+	portName := r.PathValue("name")
+	if portName == "" {
+		http.Error(w, "port name is required", http.StatusBadRequest)
+		return
+	}
+	if err := checkScope(r.Context(), eventsMethod, portName); err != nil {
+		http.Error(w, status.Convert(err).Message(), http.StatusForbidden)
+		return
+	}
+	ctx := requestContext(r.Context(), r, "")
+	if identity, _ := AuthIdentity(r.Context()); s.access != nil && identity.Scope == nil {
+		identities := append([]string{ClientIdentity(ctx, s.peerVerified)}, ClientGroups(ctx)...)
+		if !s.access.Allowed(portName, identities...) {
+			s.logger.Warn("port access denied", "port", portName, "identities", identities, "client", r.RemoteAddr)
+			http.Error(w, fmt.Sprintf("%s may not use %s", identities[0], portName), http.StatusForbidden)
+			return
+		}
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	events := s.manager.SubscribeEvents()
+	defer s.manager.UnsubscribeEvents(events)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	var (
+		data      <-chan []byte
+		sessionID string
+		line      []byte
+	)
+
+	attach := func(session *serial.Session) {
+		ch, err := s.manager.SubscribeToReads(portName, session.ID, "http events")
+		if err != nil {
+			return
+		}
+		data = ch
+		sessionID = session.ID
+	}
+	detach := func() {
+		if data != nil {
+			_ = s.manager.UnsubscribeFromReads(portName, sessionID, data)
+		}
+		data = nil
+		sessionID = ""
+	}
+	defer detach()
+
+	status := sseStatus{Port: portName, Timestamp: time.Now().Format(time.RFC3339Nano)}
+	if session := s.manager.GetSession(portName); session != nil {
+		attach(session)
+		status.Open = true
+		status.SessionID = s.visibleSessionID(ctx, session.ID)
+		status.ClientID = session.ClientID
+		status.Metadata = session.Metadata
+	}
+	if err := writeSSEJSON(w, "status", status); err != nil {
+		return
+	}
+	flusher.Flush()
+
+	s.logger.Info("SSE monitor attached", "port", portName, "client", r.RemoteAddr)
+	defer s.logger.Info("SSE monitor detached", "port", portName, "client", r.RemoteAddr)
+
+	keepAlive := time.NewTicker(sseKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		var err error
+
+		select {
+		case <-r.Context().Done():
+			return
+
+		case <-keepAlive.C:
+			_, err = io.WriteString(w, ": keep-alive\n\n")
+
+		case chunk, ok := <-data:
+			if !ok {
+				// Session closed; flush any partial line
+				err = writeSSELines(w, line, true)
+				line = nil
+				data = nil
+				sessionID = ""
+				break
+			}
+			line = append(line, chunk...)
+			if err = writeSSELines(w, line, false); err == nil {
+				line = trailingPartial(line)
+			}
+
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if event.PortName != portName {
+				continue
+			}
+			if event.Type == serial.PortEventOpened && data == nil {
+				if session := s.manager.GetSession(portName); session != nil {
+					attach(session)
+				}
+			}
+			payload := sseStatus{
+				Port:      event.PortName,
+				Open:      event.SessionID != "" && event.Type != serial.PortEventClosed,
+				SessionID: s.visibleSessionID(ctx, event.SessionID),
+				ClientID:  event.ClientID,
+				Message:   event.Message,
+				Timestamp: event.Timestamp.Format(time.RFC3339Nano),
+			}
+			payload.Metadata = event.Metadata
+			err = writeSSEJSON(w, string(event.Type), payload)
+		}
+
+		if err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// writeSSELines writes every complete line in buf as a "line" event. When
+// final is set, or the pending partial line is too long, the remainder is
+// written as well.
+func writeSSELines(w io.Writer, buf []byte, final bool) error {
+	for {
+		i := bytes.IndexByte(buf, '\n')
+		if i < 0 {
+			break
+		}
+		if err := writeSSE(w, "line", string(buf[:i])); err != nil {
+			return err
+		}
+		buf = buf[i+1:]
+	}
+
+	if len(buf) > 0 && (final || len(buf) >= sseMaxLineLength) {
+		return writeSSE(w, "line", string(buf))
+	}
+	return nil
+}
+
+// trailingPartial returns the bytes after the last newline that
+// writeSSELines left unsent
+func trailingPartial(buf []byte) []byte {
+	if i := bytes.LastIndexByte(buf, '\n'); i >= 0 {
+		buf = buf[i+1:]
+	}
+	if len(buf) >= sseMaxLineLength {
+		return nil
+	}
+	return append([]byte(nil), buf...)
+}
+
+// writeSSE writes a single event. Carriage returns are dropped since they
+// end a field in the event stream format, and invalid UTF-8 is replaced.
+func writeSSE(w io.Writer, event, data string) error {
+	data = strings.ToValidUTF8(strings.ReplaceAll(data, "\r", ""), "\uFFFD")
+	_, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+	return err
+}
+
+// writeSSEJSON writes an event with a JSON payload
+func writeSSEJSON(w io.Writer, event string, v interface{}) error {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return writeSSE(w, event, string(payload))
+}
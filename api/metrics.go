@@ -0,0 +1,65 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// RPCMetrics measures gRPC calls for the metrics endpoint
+type RPCMetrics struct {
+	latency *metrics.Histogram
+}
+
+// NewRPCMetrics creates the RPC latency histograms
+func NewRPCMetrics() *RPCMetrics {
+	return &RPCMetrics{
+		latency: metrics.NewHistogram("seriallink_grpc_request_duration_seconds",
+			"Duration of gRPC calls; for streams, until the stream ended.",
+			metrics.DefaultBuckets, "method", "type", "code"),
+	}
+}
+
+// UnaryInterceptor times unary calls
+func (m *RPCMetrics) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.latency.Observe(time.Since(start).Seconds(), info.FullMethod, "unary", status.Code(err).String())
+		return resp, err
+	}
+}
+
+// StreamInterceptor times streams
+func (m *RPCMetrics) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		m.latency.Observe(time.Since(start).Seconds(), info.FullMethod, "stream", status.Code(err).String())
+		return err
+	}
+}
+
+// Collect implements metrics.Collector
+func (m *RPCMetrics) Collect() []metrics.Family {
+	return m.latency.Collect()
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/Shoaibashk/SerialLink/internal/overload"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// clientIDMetadataKey lets clients name themselves for overload priorities
+// on calls that carry no client ID
+const clientIDMetadataKey = "x-seriallink-client-id"
+
+// overloadIdentities returns the identities a client's priority may be
+// assigned to: its client ID, then its IP address
+func overloadIdentities(ctx context.Context, clientID string) []string {
+	if clientID == "" {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(clientIDMetadataKey); len(values) > 0 {
+				clientID = values[0]
+			}
+		}
+	}
+
+	addr := ClientAddress(ctx)
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return []string{clientID, addr}
+}
+
+// UnaryOverloadInterceptor rejects OpenPort with RESOURCE_EXHAUSTED while
+// the agent is overloaded, unless the client's priority is exempt
+func UnaryOverloadInterceptor(guard *overload.Guard) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		open, ok := req.(*pb.OpenPortRequest)
+		if !ok {
+			return handler(ctx, req)
+		}
+		if err := guard.Admit(guard.Priority(overloadIdentities(ctx, open.ClientId)...)); err != nil {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamOverloadInterceptor rejects new streams with RESOURCE_EXHAUSTED
+// while the agent is overloaded and ends running streams the guard sheds
+func StreamOverloadInterceptor(guard *overload.Guard) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		identities := overloadIdentities(ss.Context(), "")
+		priority := guard.Priority(identities...)
+		if err := guard.Admit(priority); err != nil {
+			return status.Error(codes.ResourceExhausted, err.Error())
+		}
+
+		client := identities[1]
+		if identities[0] != "" {
+			client = identities[0]
+		}
+		ctx, done := guard.Track(ss.Context(), client, info.FullMethod, priority)
+		defer done()
+
+		err := handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+		if errors.Is(context.Cause(ctx), overload.ErrShed) {
+			return status.Error(codes.ResourceExhausted, overload.ErrShed.Error())
+		}
+		return err
+	}
+}
@@ -0,0 +1,16327 @@
+// SerialLink agent API. Run "make proto" after changing this file to
+// regenerate the Go code in gen/go.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: seriallink/v1/serial.proto
+
+package seriallinkv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DataBits int32
+
+const (
+	DataBits_DATA_BITS_UNSPECIFIED DataBits = 0
+	DataBits_DATA_BITS_5           DataBits = 5
+	DataBits_DATA_BITS_6           DataBits = 6
+	DataBits_DATA_BITS_7           DataBits = 7
+	DataBits_DATA_BITS_8           DataBits = 8
+)
+
+// Enum value maps for DataBits.
+var (
+	DataBits_name = map[int32]string{
+		0: "DATA_BITS_UNSPECIFIED",
+		5: "DATA_BITS_5",
+		6: "DATA_BITS_6",
+		7: "DATA_BITS_7",
+		8: "DATA_BITS_8",
+	}
+	DataBits_value = map[string]int32{
+		"DATA_BITS_UNSPECIFIED": 0,
+		"DATA_BITS_5":           5,
+		"DATA_BITS_6":           6,
+		"DATA_BITS_7":           7,
+		"DATA_BITS_8":           8,
+	}
+)
+
+func (x DataBits) Enum() *DataBits {
+	p := new(DataBits)
+	*p = x
+	return p
+}
+
+func (x DataBits) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DataBits) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[0].Descriptor()
+}
+
+func (DataBits) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[0]
+}
+
+func (x DataBits) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DataBits.Descriptor instead.
+func (DataBits) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{0}
+}
+
+type StopBits int32
+
+const (
+	StopBits_STOP_BITS_UNSPECIFIED StopBits = 0
+	StopBits_STOP_BITS_1           StopBits = 1
+	StopBits_STOP_BITS_1_5         StopBits = 2
+	StopBits_STOP_BITS_2           StopBits = 3
+)
+
+// Enum value maps for StopBits.
+var (
+	StopBits_name = map[int32]string{
+		0: "STOP_BITS_UNSPECIFIED",
+		1: "STOP_BITS_1",
+		2: "STOP_BITS_1_5",
+		3: "STOP_BITS_2",
+	}
+	StopBits_value = map[string]int32{
+		"STOP_BITS_UNSPECIFIED": 0,
+		"STOP_BITS_1":           1,
+		"STOP_BITS_1_5":         2,
+		"STOP_BITS_2":           3,
+	}
+)
+
+func (x StopBits) Enum() *StopBits {
+	p := new(StopBits)
+	*p = x
+	return p
+}
+
+func (x StopBits) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StopBits) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[1].Descriptor()
+}
+
+func (StopBits) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[1]
+}
+
+func (x StopBits) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StopBits.Descriptor instead.
+func (StopBits) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{1}
+}
+
+type Parity int32
+
+const (
+	Parity_PARITY_UNSPECIFIED Parity = 0
+	Parity_PARITY_NONE        Parity = 1
+	Parity_PARITY_ODD         Parity = 2
+	Parity_PARITY_EVEN        Parity = 3
+	Parity_PARITY_MARK        Parity = 4
+	Parity_PARITY_SPACE       Parity = 5
+)
+
+// Enum value maps for Parity.
+var (
+	Parity_name = map[int32]string{
+		0: "PARITY_UNSPECIFIED",
+		1: "PARITY_NONE",
+		2: "PARITY_ODD",
+		3: "PARITY_EVEN",
+		4: "PARITY_MARK",
+		5: "PARITY_SPACE",
+	}
+	Parity_value = map[string]int32{
+		"PARITY_UNSPECIFIED": 0,
+		"PARITY_NONE":        1,
+		"PARITY_ODD":         2,
+		"PARITY_EVEN":        3,
+		"PARITY_MARK":        4,
+		"PARITY_SPACE":       5,
+	}
+)
+
+func (x Parity) Enum() *Parity {
+	p := new(Parity)
+	*p = x
+	return p
+}
+
+func (x Parity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Parity) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[2].Descriptor()
+}
+
+func (Parity) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[2]
+}
+
+func (x Parity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Parity.Descriptor instead.
+func (Parity) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{2}
+}
+
+type FlowControl int32
+
+const (
+	FlowControl_FLOW_CONTROL_UNSPECIFIED FlowControl = 0
+	FlowControl_FLOW_CONTROL_NONE        FlowControl = 1
+	FlowControl_FLOW_CONTROL_HARDWARE    FlowControl = 2
+	FlowControl_FLOW_CONTROL_SOFTWARE    FlowControl = 3
+)
+
+// Enum value maps for FlowControl.
+var (
+	FlowControl_name = map[int32]string{
+		0: "FLOW_CONTROL_UNSPECIFIED",
+		1: "FLOW_CONTROL_NONE",
+		2: "FLOW_CONTROL_HARDWARE",
+		3: "FLOW_CONTROL_SOFTWARE",
+	}
+	FlowControl_value = map[string]int32{
+		"FLOW_CONTROL_UNSPECIFIED": 0,
+		"FLOW_CONTROL_NONE":        1,
+		"FLOW_CONTROL_HARDWARE":    2,
+		"FLOW_CONTROL_SOFTWARE":    3,
+	}
+)
+
+func (x FlowControl) Enum() *FlowControl {
+	p := new(FlowControl)
+	*p = x
+	return p
+}
+
+func (x FlowControl) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlowControl) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[3].Descriptor()
+}
+
+func (FlowControl) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[3]
+}
+
+func (x FlowControl) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlowControl.Descriptor instead.
+func (FlowControl) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{3}
+}
+
+type LatencyProfile int32
+
+const (
+	LatencyProfile_LATENCY_PROFILE_UNSPECIFIED LatencyProfile = 0
+	LatencyProfile_LATENCY_PROFILE_DEFAULT     LatencyProfile = 1
+	LatencyProfile_LATENCY_PROFILE_LOW         LatencyProfile = 2
+)
+
+// Enum value maps for LatencyProfile.
+var (
+	LatencyProfile_name = map[int32]string{
+		0: "LATENCY_PROFILE_UNSPECIFIED",
+		1: "LATENCY_PROFILE_DEFAULT",
+		2: "LATENCY_PROFILE_LOW",
+	}
+	LatencyProfile_value = map[string]int32{
+		"LATENCY_PROFILE_UNSPECIFIED": 0,
+		"LATENCY_PROFILE_DEFAULT":     1,
+		"LATENCY_PROFILE_LOW":         2,
+	}
+)
+
+func (x LatencyProfile) Enum() *LatencyProfile {
+	p := new(LatencyProfile)
+	*p = x
+	return p
+}
+
+func (x LatencyProfile) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LatencyProfile) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[4].Descriptor()
+}
+
+func (LatencyProfile) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[4]
+}
+
+func (x LatencyProfile) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LatencyProfile.Descriptor instead.
+func (LatencyProfile) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{4}
+}
+
+type LineState int32
+
+const (
+	LineState_LINE_STATE_UNSPECIFIED LineState = 0
+	LineState_LINE_STATE_DEFAULT     LineState = 1
+	LineState_LINE_STATE_HIGH        LineState = 2
+	LineState_LINE_STATE_LOW         LineState = 3
+	LineState_LINE_STATE_UNTOUCHED   LineState = 4
+)
+
+// Enum value maps for LineState.
+var (
+	LineState_name = map[int32]string{
+		0: "LINE_STATE_UNSPECIFIED",
+		1: "LINE_STATE_DEFAULT",
+		2: "LINE_STATE_HIGH",
+		3: "LINE_STATE_LOW",
+		4: "LINE_STATE_UNTOUCHED",
+	}
+	LineState_value = map[string]int32{
+		"LINE_STATE_UNSPECIFIED": 0,
+		"LINE_STATE_DEFAULT":     1,
+		"LINE_STATE_HIGH":        2,
+		"LINE_STATE_LOW":         3,
+		"LINE_STATE_UNTOUCHED":   4,
+	}
+)
+
+func (x LineState) Enum() *LineState {
+	p := new(LineState)
+	*p = x
+	return p
+}
+
+func (x LineState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LineState) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[5].Descriptor()
+}
+
+func (LineState) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[5]
+}
+
+func (x LineState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LineState.Descriptor instead.
+func (LineState) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{5}
+}
+
+type SessionPriority int32
+
+const (
+	SessionPriority_SESSION_PRIORITY_UNSPECIFIED SessionPriority = 0
+	SessionPriority_SESSION_PRIORITY_BULK        SessionPriority = 1
+	SessionPriority_SESSION_PRIORITY_NORMAL      SessionPriority = 2
+	SessionPriority_SESSION_PRIORITY_CRITICAL    SessionPriority = 3
+)
+
+// Enum value maps for SessionPriority.
+var (
+	SessionPriority_name = map[int32]string{
+		0: "SESSION_PRIORITY_UNSPECIFIED",
+		1: "SESSION_PRIORITY_BULK",
+		2: "SESSION_PRIORITY_NORMAL",
+		3: "SESSION_PRIORITY_CRITICAL",
+	}
+	SessionPriority_value = map[string]int32{
+		"SESSION_PRIORITY_UNSPECIFIED": 0,
+		"SESSION_PRIORITY_BULK":        1,
+		"SESSION_PRIORITY_NORMAL":      2,
+		"SESSION_PRIORITY_CRITICAL":    3,
+	}
+)
+
+func (x SessionPriority) Enum() *SessionPriority {
+	p := new(SessionPriority)
+	*p = x
+	return p
+}
+
+func (x SessionPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[6].Descriptor()
+}
+
+func (SessionPriority) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[6]
+}
+
+func (x SessionPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionPriority.Descriptor instead.
+func (SessionPriority) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{6}
+}
+
+type PortType int32
+
+const (
+	PortType_PORT_TYPE_UNSPECIFIED PortType = 0
+	PortType_PORT_TYPE_USB         PortType = 1
+	PortType_PORT_TYPE_NATIVE      PortType = 2
+	PortType_PORT_TYPE_BLUETOOTH   PortType = 3
+	PortType_PORT_TYPE_VIRTUAL     PortType = 4
+)
+
+// Enum value maps for PortType.
+var (
+	PortType_name = map[int32]string{
+		0: "PORT_TYPE_UNSPECIFIED",
+		1: "PORT_TYPE_USB",
+		2: "PORT_TYPE_NATIVE",
+		3: "PORT_TYPE_BLUETOOTH",
+		4: "PORT_TYPE_VIRTUAL",
+	}
+	PortType_value = map[string]int32{
+		"PORT_TYPE_UNSPECIFIED": 0,
+		"PORT_TYPE_USB":         1,
+		"PORT_TYPE_NATIVE":      2,
+		"PORT_TYPE_BLUETOOTH":   3,
+		"PORT_TYPE_VIRTUAL":     4,
+	}
+)
+
+func (x PortType) Enum() *PortType {
+	p := new(PortType)
+	*p = x
+	return p
+}
+
+func (x PortType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PortType) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[7].Descriptor()
+}
+
+func (PortType) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[7]
+}
+
+func (x PortType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PortType.Descriptor instead.
+func (PortType) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{7}
+}
+
+type PowerState int32
+
+const (
+	PowerState_POWER_STATE_UNSPECIFIED PowerState = 0
+	PowerState_POWER_STATE_ACTIVE      PowerState = 1
+	PowerState_POWER_STATE_DORMANT     PowerState = 2
+)
+
+// Enum value maps for PowerState.
+var (
+	PowerState_name = map[int32]string{
+		0: "POWER_STATE_UNSPECIFIED",
+		1: "POWER_STATE_ACTIVE",
+		2: "POWER_STATE_DORMANT",
+	}
+	PowerState_value = map[string]int32{
+		"POWER_STATE_UNSPECIFIED": 0,
+		"POWER_STATE_ACTIVE":      1,
+		"POWER_STATE_DORMANT":     2,
+	}
+)
+
+func (x PowerState) Enum() *PowerState {
+	p := new(PowerState)
+	*p = x
+	return p
+}
+
+func (x PowerState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PowerState) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[8].Descriptor()
+}
+
+func (PowerState) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[8]
+}
+
+func (x PowerState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PowerState.Descriptor instead.
+func (PowerState) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{8}
+}
+
+type CloseReason int32
+
+const (
+	CloseReason_CLOSE_REASON_UNSPECIFIED    CloseReason = 0
+	CloseReason_CLOSE_REASON_CLIENT         CloseReason = 1
+	CloseReason_CLOSE_REASON_FORCED         CloseReason = 2
+	CloseReason_CLOSE_REASON_DEVICE_REMOVED CloseReason = 3
+	CloseReason_CLOSE_REASON_AGENT_SHUTDOWN CloseReason = 4
+)
+
+// Enum value maps for CloseReason.
+var (
+	CloseReason_name = map[int32]string{
+		0: "CLOSE_REASON_UNSPECIFIED",
+		1: "CLOSE_REASON_CLIENT",
+		2: "CLOSE_REASON_FORCED",
+		3: "CLOSE_REASON_DEVICE_REMOVED",
+		4: "CLOSE_REASON_AGENT_SHUTDOWN",
+	}
+	CloseReason_value = map[string]int32{
+		"CLOSE_REASON_UNSPECIFIED":    0,
+		"CLOSE_REASON_CLIENT":         1,
+		"CLOSE_REASON_FORCED":         2,
+		"CLOSE_REASON_DEVICE_REMOVED": 3,
+		"CLOSE_REASON_AGENT_SHUTDOWN": 4,
+	}
+)
+
+func (x CloseReason) Enum() *CloseReason {
+	p := new(CloseReason)
+	*p = x
+	return p
+}
+
+func (x CloseReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CloseReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[9].Descriptor()
+}
+
+func (CloseReason) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[9]
+}
+
+func (x CloseReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CloseReason.Descriptor instead.
+func (CloseReason) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{9}
+}
+
+type BridgeDirection int32
+
+const (
+	BridgeDirection_BRIDGE_DIRECTION_BOTH   BridgeDirection = 0
+	BridgeDirection_BRIDGE_DIRECTION_A_TO_B BridgeDirection = 1
+	BridgeDirection_BRIDGE_DIRECTION_B_TO_A BridgeDirection = 2
+)
+
+// Enum value maps for BridgeDirection.
+var (
+	BridgeDirection_name = map[int32]string{
+		0: "BRIDGE_DIRECTION_BOTH",
+		1: "BRIDGE_DIRECTION_A_TO_B",
+		2: "BRIDGE_DIRECTION_B_TO_A",
+	}
+	BridgeDirection_value = map[string]int32{
+		"BRIDGE_DIRECTION_BOTH":   0,
+		"BRIDGE_DIRECTION_A_TO_B": 1,
+		"BRIDGE_DIRECTION_B_TO_A": 2,
+	}
+)
+
+func (x BridgeDirection) Enum() *BridgeDirection {
+	p := new(BridgeDirection)
+	*p = x
+	return p
+}
+
+func (x BridgeDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BridgeDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[10].Descriptor()
+}
+
+func (BridgeDirection) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[10]
+}
+
+func (x BridgeDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BridgeDirection.Descriptor instead.
+func (BridgeDirection) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{10}
+}
+
+type BridgeRuleAction int32
+
+const (
+	BridgeRuleAction_BRIDGE_RULE_ACTION_UNSPECIFIED BridgeRuleAction = 0
+	BridgeRuleAction_BRIDGE_RULE_ACTION_DROP        BridgeRuleAction = 1
+	BridgeRuleAction_BRIDGE_RULE_ACTION_REPLACE     BridgeRuleAction = 2
+	BridgeRuleAction_BRIDGE_RULE_ACTION_DELAY       BridgeRuleAction = 3
+	BridgeRuleAction_BRIDGE_RULE_ACTION_INJECT      BridgeRuleAction = 4
+)
+
+// Enum value maps for BridgeRuleAction.
+var (
+	BridgeRuleAction_name = map[int32]string{
+		0: "BRIDGE_RULE_ACTION_UNSPECIFIED",
+		1: "BRIDGE_RULE_ACTION_DROP",
+		2: "BRIDGE_RULE_ACTION_REPLACE",
+		3: "BRIDGE_RULE_ACTION_DELAY",
+		4: "BRIDGE_RULE_ACTION_INJECT",
+	}
+	BridgeRuleAction_value = map[string]int32{
+		"BRIDGE_RULE_ACTION_UNSPECIFIED": 0,
+		"BRIDGE_RULE_ACTION_DROP":        1,
+		"BRIDGE_RULE_ACTION_REPLACE":     2,
+		"BRIDGE_RULE_ACTION_DELAY":       3,
+		"BRIDGE_RULE_ACTION_INJECT":      4,
+	}
+)
+
+func (x BridgeRuleAction) Enum() *BridgeRuleAction {
+	p := new(BridgeRuleAction)
+	*p = x
+	return p
+}
+
+func (x BridgeRuleAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BridgeRuleAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[11].Descriptor()
+}
+
+func (BridgeRuleAction) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[11]
+}
+
+func (x BridgeRuleAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BridgeRuleAction.Descriptor instead.
+func (BridgeRuleAction) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{11}
+}
+
+type FrameDecoder int32
+
+const (
+	FrameDecoder_FRAME_DECODER_UNSPECIFIED FrameDecoder = 0
+	FrameDecoder_FRAME_DECODER_MODBUS_RTU  FrameDecoder = 1
+	FrameDecoder_FRAME_DECODER_NMEA        FrameDecoder = 2
+	FrameDecoder_FRAME_DECODER_AT          FrameDecoder = 3
+	FrameDecoder_FRAME_DECODER_CUSTOM      FrameDecoder = 4
+	FrameDecoder_FRAME_DECODER_MEGATEC     FrameDecoder = 5
+)
+
+// Enum value maps for FrameDecoder.
+var (
+	FrameDecoder_name = map[int32]string{
+		0: "FRAME_DECODER_UNSPECIFIED",
+		1: "FRAME_DECODER_MODBUS_RTU",
+		2: "FRAME_DECODER_NMEA",
+		3: "FRAME_DECODER_AT",
+		4: "FRAME_DECODER_CUSTOM",
+		5: "FRAME_DECODER_MEGATEC",
+	}
+	FrameDecoder_value = map[string]int32{
+		"FRAME_DECODER_UNSPECIFIED": 0,
+		"FRAME_DECODER_MODBUS_RTU":  1,
+		"FRAME_DECODER_NMEA":        2,
+		"FRAME_DECODER_AT":          3,
+		"FRAME_DECODER_CUSTOM":      4,
+		"FRAME_DECODER_MEGATEC":     5,
+	}
+)
+
+func (x FrameDecoder) Enum() *FrameDecoder {
+	p := new(FrameDecoder)
+	*p = x
+	return p
+}
+
+func (x FrameDecoder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FrameDecoder) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[12].Descriptor()
+}
+
+func (FrameDecoder) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[12]
+}
+
+func (x FrameDecoder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FrameDecoder.Descriptor instead.
+func (FrameDecoder) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{12}
+}
+
+type GcodeDialect int32
+
+const (
+	GcodeDialect_GCODE_DIALECT_UNSPECIFIED GcodeDialect = 0
+	GcodeDialect_GCODE_DIALECT_MARLIN      GcodeDialect = 1
+	GcodeDialect_GCODE_DIALECT_GRBL        GcodeDialect = 2
+)
+
+// Enum value maps for GcodeDialect.
+var (
+	GcodeDialect_name = map[int32]string{
+		0: "GCODE_DIALECT_UNSPECIFIED",
+		1: "GCODE_DIALECT_MARLIN",
+		2: "GCODE_DIALECT_GRBL",
+	}
+	GcodeDialect_value = map[string]int32{
+		"GCODE_DIALECT_UNSPECIFIED": 0,
+		"GCODE_DIALECT_MARLIN":      1,
+		"GCODE_DIALECT_GRBL":        2,
+	}
+)
+
+func (x GcodeDialect) Enum() *GcodeDialect {
+	p := new(GcodeDialect)
+	*p = x
+	return p
+}
+
+func (x GcodeDialect) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GcodeDialect) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[13].Descriptor()
+}
+
+func (GcodeDialect) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[13]
+}
+
+func (x GcodeDialect) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GcodeDialect.Descriptor instead.
+func (GcodeDialect) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{13}
+}
+
+type GcodeJobState int32
+
+const (
+	GcodeJobState_GCODE_JOB_STATE_UNSPECIFIED GcodeJobState = 0
+	GcodeJobState_GCODE_JOB_STATE_RUNNING     GcodeJobState = 1
+	GcodeJobState_GCODE_JOB_STATE_PAUSED      GcodeJobState = 2
+	GcodeJobState_GCODE_JOB_STATE_COMPLETED   GcodeJobState = 3
+	GcodeJobState_GCODE_JOB_STATE_FAILED      GcodeJobState = 4
+	GcodeJobState_GCODE_JOB_STATE_CANCELLED   GcodeJobState = 5
+)
+
+// Enum value maps for GcodeJobState.
+var (
+	GcodeJobState_name = map[int32]string{
+		0: "GCODE_JOB_STATE_UNSPECIFIED",
+		1: "GCODE_JOB_STATE_RUNNING",
+		2: "GCODE_JOB_STATE_PAUSED",
+		3: "GCODE_JOB_STATE_COMPLETED",
+		4: "GCODE_JOB_STATE_FAILED",
+		5: "GCODE_JOB_STATE_CANCELLED",
+	}
+	GcodeJobState_value = map[string]int32{
+		"GCODE_JOB_STATE_UNSPECIFIED": 0,
+		"GCODE_JOB_STATE_RUNNING":     1,
+		"GCODE_JOB_STATE_PAUSED":      2,
+		"GCODE_JOB_STATE_COMPLETED":   3,
+		"GCODE_JOB_STATE_FAILED":      4,
+		"GCODE_JOB_STATE_CANCELLED":   5,
+	}
+)
+
+func (x GcodeJobState) Enum() *GcodeJobState {
+	p := new(GcodeJobState)
+	*p = x
+	return p
+}
+
+func (x GcodeJobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GcodeJobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[14].Descriptor()
+}
+
+func (GcodeJobState) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[14]
+}
+
+func (x GcodeJobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GcodeJobState.Descriptor instead.
+func (GcodeJobState) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{14}
+}
+
+type GcodeJobAction int32
+
+const (
+	GcodeJobAction_GCODE_JOB_ACTION_UNSPECIFIED GcodeJobAction = 0
+	GcodeJobAction_GCODE_JOB_ACTION_PAUSE       GcodeJobAction = 1
+	GcodeJobAction_GCODE_JOB_ACTION_RESUME      GcodeJobAction = 2
+	GcodeJobAction_GCODE_JOB_ACTION_CANCEL      GcodeJobAction = 3
+)
+
+// Enum value maps for GcodeJobAction.
+var (
+	GcodeJobAction_name = map[int32]string{
+		0: "GCODE_JOB_ACTION_UNSPECIFIED",
+		1: "GCODE_JOB_ACTION_PAUSE",
+		2: "GCODE_JOB_ACTION_RESUME",
+		3: "GCODE_JOB_ACTION_CANCEL",
+	}
+	GcodeJobAction_value = map[string]int32{
+		"GCODE_JOB_ACTION_UNSPECIFIED": 0,
+		"GCODE_JOB_ACTION_PAUSE":       1,
+		"GCODE_JOB_ACTION_RESUME":      2,
+		"GCODE_JOB_ACTION_CANCEL":      3,
+	}
+)
+
+func (x GcodeJobAction) Enum() *GcodeJobAction {
+	p := new(GcodeJobAction)
+	*p = x
+	return p
+}
+
+func (x GcodeJobAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GcodeJobAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[15].Descriptor()
+}
+
+func (GcodeJobAction) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[15]
+}
+
+func (x GcodeJobAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GcodeJobAction.Descriptor instead.
+func (GcodeJobAction) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{15}
+}
+
+type MachineCommand int32
+
+const (
+	MachineCommand_MACHINE_COMMAND_UNSPECIFIED MachineCommand = 0
+	MachineCommand_MACHINE_COMMAND_FEED_HOLD   MachineCommand = 1
+	MachineCommand_MACHINE_COMMAND_CYCLE_START MachineCommand = 2
+	MachineCommand_MACHINE_COMMAND_RESET       MachineCommand = 3
+)
+
+// Enum value maps for MachineCommand.
+var (
+	MachineCommand_name = map[int32]string{
+		0: "MACHINE_COMMAND_UNSPECIFIED",
+		1: "MACHINE_COMMAND_FEED_HOLD",
+		2: "MACHINE_COMMAND_CYCLE_START",
+		3: "MACHINE_COMMAND_RESET",
+	}
+	MachineCommand_value = map[string]int32{
+		"MACHINE_COMMAND_UNSPECIFIED": 0,
+		"MACHINE_COMMAND_FEED_HOLD":   1,
+		"MACHINE_COMMAND_CYCLE_START": 2,
+		"MACHINE_COMMAND_RESET":       3,
+	}
+)
+
+func (x MachineCommand) Enum() *MachineCommand {
+	p := new(MachineCommand)
+	*p = x
+	return p
+}
+
+func (x MachineCommand) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MachineCommand) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[16].Descriptor()
+}
+
+func (MachineCommand) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[16]
+}
+
+func (x MachineCommand) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MachineCommand.Descriptor instead.
+func (MachineCommand) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{16}
+}
+
+type StreamChunking int32
+
+const (
+	StreamChunking_STREAM_CHUNKING_UNSPECIFIED StreamChunking = 0
+	StreamChunking_STREAM_CHUNKING_ADAPTIVE    StreamChunking = 1
+	StreamChunking_STREAM_CHUNKING_LOW_LATENCY StreamChunking = 2
+	StreamChunking_STREAM_CHUNKING_THROUGHPUT  StreamChunking = 3
+)
+
+// Enum value maps for StreamChunking.
+var (
+	StreamChunking_name = map[int32]string{
+		0: "STREAM_CHUNKING_UNSPECIFIED",
+		1: "STREAM_CHUNKING_ADAPTIVE",
+		2: "STREAM_CHUNKING_LOW_LATENCY",
+		3: "STREAM_CHUNKING_THROUGHPUT",
+	}
+	StreamChunking_value = map[string]int32{
+		"STREAM_CHUNKING_UNSPECIFIED": 0,
+		"STREAM_CHUNKING_ADAPTIVE":    1,
+		"STREAM_CHUNKING_LOW_LATENCY": 2,
+		"STREAM_CHUNKING_THROUGHPUT":  3,
+	}
+)
+
+func (x StreamChunking) Enum() *StreamChunking {
+	p := new(StreamChunking)
+	*p = x
+	return p
+}
+
+func (x StreamChunking) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StreamChunking) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[17].Descriptor()
+}
+
+func (StreamChunking) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[17]
+}
+
+func (x StreamChunking) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StreamChunking.Descriptor instead.
+func (StreamChunking) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{17}
+}
+
+type BootloaderProtocol int32
+
+const (
+	BootloaderProtocol_BOOTLOADER_PROTOCOL_UNSPECIFIED BootloaderProtocol = 0
+	BootloaderProtocol_BOOTLOADER_PROTOCOL_STK500V1    BootloaderProtocol = 1
+	BootloaderProtocol_BOOTLOADER_PROTOCOL_AVR109      BootloaderProtocol = 2
+)
+
+// Enum value maps for BootloaderProtocol.
+var (
+	BootloaderProtocol_name = map[int32]string{
+		0: "BOOTLOADER_PROTOCOL_UNSPECIFIED",
+		1: "BOOTLOADER_PROTOCOL_STK500V1",
+		2: "BOOTLOADER_PROTOCOL_AVR109",
+	}
+	BootloaderProtocol_value = map[string]int32{
+		"BOOTLOADER_PROTOCOL_UNSPECIFIED": 0,
+		"BOOTLOADER_PROTOCOL_STK500V1":    1,
+		"BOOTLOADER_PROTOCOL_AVR109":      2,
+	}
+)
+
+func (x BootloaderProtocol) Enum() *BootloaderProtocol {
+	p := new(BootloaderProtocol)
+	*p = x
+	return p
+}
+
+func (x BootloaderProtocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BootloaderProtocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[18].Descriptor()
+}
+
+func (BootloaderProtocol) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[18]
+}
+
+func (x BootloaderProtocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BootloaderProtocol.Descriptor instead.
+func (BootloaderProtocol) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{18}
+}
+
+type BootloaderReset int32
+
+const (
+	BootloaderReset_BOOTLOADER_RESET_UNSPECIFIED BootloaderReset = 0
+	BootloaderReset_BOOTLOADER_RESET_DTR         BootloaderReset = 1
+	BootloaderReset_BOOTLOADER_RESET_TOUCH_1200  BootloaderReset = 2
+	BootloaderReset_BOOTLOADER_RESET_NONE        BootloaderReset = 3
+)
+
+// Enum value maps for BootloaderReset.
+var (
+	BootloaderReset_name = map[int32]string{
+		0: "BOOTLOADER_RESET_UNSPECIFIED",
+		1: "BOOTLOADER_RESET_DTR",
+		2: "BOOTLOADER_RESET_TOUCH_1200",
+		3: "BOOTLOADER_RESET_NONE",
+	}
+	BootloaderReset_value = map[string]int32{
+		"BOOTLOADER_RESET_UNSPECIFIED": 0,
+		"BOOTLOADER_RESET_DTR":         1,
+		"BOOTLOADER_RESET_TOUCH_1200":  2,
+		"BOOTLOADER_RESET_NONE":        3,
+	}
+)
+
+func (x BootloaderReset) Enum() *BootloaderReset {
+	p := new(BootloaderReset)
+	*p = x
+	return p
+}
+
+func (x BootloaderReset) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BootloaderReset) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[19].Descriptor()
+}
+
+func (BootloaderReset) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[19]
+}
+
+func (x BootloaderReset) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BootloaderReset.Descriptor instead.
+func (BootloaderReset) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{19}
+}
+
+type UploadStage int32
+
+const (
+	UploadStage_UPLOAD_STAGE_UNSPECIFIED UploadStage = 0
+	UploadStage_UPLOAD_STAGE_RESETTING   UploadStage = 1
+	UploadStage_UPLOAD_STAGE_CONNECTING  UploadStage = 2
+	UploadStage_UPLOAD_STAGE_ERASING     UploadStage = 3
+	UploadStage_UPLOAD_STAGE_WRITING     UploadStage = 4
+	UploadStage_UPLOAD_STAGE_VERIFYING   UploadStage = 5
+	UploadStage_UPLOAD_STAGE_DONE        UploadStage = 6
+)
+
+// Enum value maps for UploadStage.
+var (
+	UploadStage_name = map[int32]string{
+		0: "UPLOAD_STAGE_UNSPECIFIED",
+		1: "UPLOAD_STAGE_RESETTING",
+		2: "UPLOAD_STAGE_CONNECTING",
+		3: "UPLOAD_STAGE_ERASING",
+		4: "UPLOAD_STAGE_WRITING",
+		5: "UPLOAD_STAGE_VERIFYING",
+		6: "UPLOAD_STAGE_DONE",
+	}
+	UploadStage_value = map[string]int32{
+		"UPLOAD_STAGE_UNSPECIFIED": 0,
+		"UPLOAD_STAGE_RESETTING":   1,
+		"UPLOAD_STAGE_CONNECTING":  2,
+		"UPLOAD_STAGE_ERASING":     3,
+		"UPLOAD_STAGE_WRITING":     4,
+		"UPLOAD_STAGE_VERIFYING":   5,
+		"UPLOAD_STAGE_DONE":        6,
+	}
+)
+
+func (x UploadStage) Enum() *UploadStage {
+	p := new(UploadStage)
+	*p = x
+	return p
+}
+
+func (x UploadStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UploadStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_seriallink_v1_serial_proto_enumTypes[20].Descriptor()
+}
+
+func (UploadStage) Type() protoreflect.EnumType {
+	return &file_seriallink_v1_serial_proto_enumTypes[20]
+}
+
+func (x UploadStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UploadStage.Descriptor instead.
+func (UploadStage) EnumDescriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{20}
+}
+
+type PortConfig struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BaudRate       uint32                 `protobuf:"varint,1,opt,name=baud_rate,json=baudRate,proto3" json:"baud_rate,omitempty"`
+	DataBits       DataBits               `protobuf:"varint,2,opt,name=data_bits,json=dataBits,proto3,enum=seriallink.v1.DataBits" json:"data_bits,omitempty"`
+	StopBits       StopBits               `protobuf:"varint,3,opt,name=stop_bits,json=stopBits,proto3,enum=seriallink.v1.StopBits" json:"stop_bits,omitempty"`
+	Parity         Parity                 `protobuf:"varint,4,opt,name=parity,proto3,enum=seriallink.v1.Parity" json:"parity,omitempty"`
+	FlowControl    FlowControl            `protobuf:"varint,5,opt,name=flow_control,json=flowControl,proto3,enum=seriallink.v1.FlowControl" json:"flow_control,omitempty"`
+	ReadTimeoutMs  uint32                 `protobuf:"varint,6,opt,name=read_timeout_ms,json=readTimeoutMs,proto3" json:"read_timeout_ms,omitempty"`
+	WriteTimeoutMs uint32                 `protobuf:"varint,7,opt,name=write_timeout_ms,json=writeTimeoutMs,proto3" json:"write_timeout_ms,omitempty"`
+	LatencyProfile LatencyProfile         `protobuf:"varint,8,opt,name=latency_profile,json=latencyProfile,proto3,enum=seriallink.v1.LatencyProfile" json:"latency_profile,omitempty"`
+	Dtr            LineState              `protobuf:"varint,9,opt,name=dtr,proto3,enum=seriallink.v1.LineState" json:"dtr,omitempty"`
+	Rts            LineState              `protobuf:"varint,10,opt,name=rts,proto3,enum=seriallink.v1.LineState" json:"rts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PortConfig) Reset() {
+	*x = PortConfig{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortConfig) ProtoMessage() {}
+
+func (x *PortConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortConfig.ProtoReflect.Descriptor instead.
+func (*PortConfig) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PortConfig) GetBaudRate() uint32 {
+	if x != nil {
+		return x.BaudRate
+	}
+	return 0
+}
+
+func (x *PortConfig) GetDataBits() DataBits {
+	if x != nil {
+		return x.DataBits
+	}
+	return DataBits_DATA_BITS_UNSPECIFIED
+}
+
+func (x *PortConfig) GetStopBits() StopBits {
+	if x != nil {
+		return x.StopBits
+	}
+	return StopBits_STOP_BITS_UNSPECIFIED
+}
+
+func (x *PortConfig) GetParity() Parity {
+	if x != nil {
+		return x.Parity
+	}
+	return Parity_PARITY_UNSPECIFIED
+}
+
+func (x *PortConfig) GetFlowControl() FlowControl {
+	if x != nil {
+		return x.FlowControl
+	}
+	return FlowControl_FLOW_CONTROL_UNSPECIFIED
+}
+
+func (x *PortConfig) GetReadTimeoutMs() uint32 {
+	if x != nil {
+		return x.ReadTimeoutMs
+	}
+	return 0
+}
+
+func (x *PortConfig) GetWriteTimeoutMs() uint32 {
+	if x != nil {
+		return x.WriteTimeoutMs
+	}
+	return 0
+}
+
+func (x *PortConfig) GetLatencyProfile() LatencyProfile {
+	if x != nil {
+		return x.LatencyProfile
+	}
+	return LatencyProfile_LATENCY_PROFILE_UNSPECIFIED
+}
+
+func (x *PortConfig) GetDtr() LineState {
+	if x != nil {
+		return x.Dtr
+	}
+	return LineState_LINE_STATE_UNSPECIFIED
+}
+
+func (x *PortConfig) GetRts() LineState {
+	if x != nil {
+		return x.Rts
+	}
+	return LineState_LINE_STATE_UNSPECIFIED
+}
+
+type PortInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	HardwareId    string                 `protobuf:"bytes,3,opt,name=hardware_id,json=hardwareId,proto3" json:"hardware_id,omitempty"`
+	Manufacturer  string                 `protobuf:"bytes,4,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Product       string                 `protobuf:"bytes,5,opt,name=product,proto3" json:"product,omitempty"`
+	SerialNumber  string                 `protobuf:"bytes,6,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	PortType      PortType               `protobuf:"varint,7,opt,name=port_type,json=portType,proto3,enum=seriallink.v1.PortType" json:"port_type,omitempty"`
+	IsOpen        bool                   `protobuf:"varint,8,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	LockedBy      string                 `protobuf:"bytes,9,opt,name=locked_by,json=lockedBy,proto3" json:"locked_by,omitempty"`
+	DeviceFamily  string                 `protobuf:"bytes,10,opt,name=device_family,json=deviceFamily,proto3" json:"device_family,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortInfo) Reset() {
+	*x = PortInfo{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortInfo) ProtoMessage() {}
+
+func (x *PortInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortInfo.ProtoReflect.Descriptor instead.
+func (*PortInfo) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PortInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PortInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PortInfo) GetHardwareId() string {
+	if x != nil {
+		return x.HardwareId
+	}
+	return ""
+}
+
+func (x *PortInfo) GetManufacturer() string {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return ""
+}
+
+func (x *PortInfo) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *PortInfo) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *PortInfo) GetPortType() PortType {
+	if x != nil {
+		return x.PortType
+	}
+	return PortType_PORT_TYPE_UNSPECIFIED
+}
+
+func (x *PortInfo) GetIsOpen() bool {
+	if x != nil {
+		return x.IsOpen
+	}
+	return false
+}
+
+func (x *PortInfo) GetLockedBy() string {
+	if x != nil {
+		return x.LockedBy
+	}
+	return ""
+}
+
+func (x *PortInfo) GetDeviceFamily() string {
+	if x != nil {
+		return x.DeviceFamily
+	}
+	return ""
+}
+
+type PortStatistics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BytesSent     uint64                 `protobuf:"varint,1,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	BytesReceived uint64                 `protobuf:"varint,2,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+	Errors        uint64                 `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	OpenedAt      int64                  `protobuf:"varint,4,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	LastActivity  int64                  `protobuf:"varint,5,opt,name=last_activity,json=lastActivity,proto3" json:"last_activity,omitempty"`
+	GarbageBytes  uint64                 `protobuf:"varint,6,opt,name=garbage_bytes,json=garbageBytes,proto3" json:"garbage_bytes,omitempty"`
+	BreakCount    uint64                 `protobuf:"varint,7,opt,name=break_count,json=breakCount,proto3" json:"break_count,omitempty"`
+	LineQuality   float64                `protobuf:"fixed64,8,opt,name=line_quality,json=lineQuality,proto3" json:"line_quality,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortStatistics) Reset() {
+	*x = PortStatistics{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortStatistics) ProtoMessage() {}
+
+func (x *PortStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortStatistics.ProtoReflect.Descriptor instead.
+func (*PortStatistics) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PortStatistics) GetBytesSent() uint64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+func (x *PortStatistics) GetBytesReceived() uint64 {
+	if x != nil {
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *PortStatistics) GetErrors() uint64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *PortStatistics) GetOpenedAt() int64 {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return 0
+}
+
+func (x *PortStatistics) GetLastActivity() int64 {
+	if x != nil {
+		return x.LastActivity
+	}
+	return 0
+}
+
+func (x *PortStatistics) GetGarbageBytes() uint64 {
+	if x != nil {
+		return x.GarbageBytes
+	}
+	return 0
+}
+
+func (x *PortStatistics) GetBreakCount() uint64 {
+	if x != nil {
+		return x.BreakCount
+	}
+	return 0
+}
+
+func (x *PortStatistics) GetLineQuality() float64 {
+	if x != nil {
+		return x.LineQuality
+	}
+	return 0
+}
+
+type PortStatus struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PortName       string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	IsOpen         bool                   `protobuf:"varint,2,opt,name=is_open,json=isOpen,proto3" json:"is_open,omitempty"`
+	IsLocked       bool                   `protobuf:"varint,3,opt,name=is_locked,json=isLocked,proto3" json:"is_locked,omitempty"`
+	LockedBy       string                 `protobuf:"bytes,4,opt,name=locked_by,json=lockedBy,proto3" json:"locked_by,omitempty"`
+	SessionId      string                 `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CurrentConfig  *PortConfig            `protobuf:"bytes,6,opt,name=current_config,json=currentConfig,proto3" json:"current_config,omitempty"`
+	Statistics     *PortStatistics        `protobuf:"bytes,7,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	Priority       SessionPriority        `protobuf:"varint,8,opt,name=priority,proto3,enum=seriallink.v1.SessionPriority" json:"priority,omitempty"`
+	PowerState     PowerState             `protobuf:"varint,9,opt,name=power_state,json=powerState,proto3,enum=seriallink.v1.PowerState" json:"power_state,omitempty"`
+	CloseReason    CloseReason            `protobuf:"varint,10,opt,name=close_reason,json=closeReason,proto3,enum=seriallink.v1.CloseReason" json:"close_reason,omitempty"`
+	ClosedAt       int64                  `protobuf:"varint,11,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	Metadata       map[string]string      `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ShortSessionId string                 `protobuf:"bytes,13,opt,name=short_session_id,json=shortSessionId,proto3" json:"short_session_id,omitempty"`
+	Shaping        *BandwidthShaping      `protobuf:"bytes,14,opt,name=shaping,proto3" json:"shaping,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PortStatus) Reset() {
+	*x = PortStatus{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortStatus) ProtoMessage() {}
+
+func (x *PortStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortStatus.ProtoReflect.Descriptor instead.
+func (*PortStatus) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PortStatus) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *PortStatus) GetIsOpen() bool {
+	if x != nil {
+		return x.IsOpen
+	}
+	return false
+}
+
+func (x *PortStatus) GetIsLocked() bool {
+	if x != nil {
+		return x.IsLocked
+	}
+	return false
+}
+
+func (x *PortStatus) GetLockedBy() string {
+	if x != nil {
+		return x.LockedBy
+	}
+	return ""
+}
+
+func (x *PortStatus) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PortStatus) GetCurrentConfig() *PortConfig {
+	if x != nil {
+		return x.CurrentConfig
+	}
+	return nil
+}
+
+func (x *PortStatus) GetStatistics() *PortStatistics {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+func (x *PortStatus) GetPriority() SessionPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return SessionPriority_SESSION_PRIORITY_UNSPECIFIED
+}
+
+func (x *PortStatus) GetPowerState() PowerState {
+	if x != nil {
+		return x.PowerState
+	}
+	return PowerState_POWER_STATE_UNSPECIFIED
+}
+
+func (x *PortStatus) GetCloseReason() CloseReason {
+	if x != nil {
+		return x.CloseReason
+	}
+	return CloseReason_CLOSE_REASON_UNSPECIFIED
+}
+
+func (x *PortStatus) GetClosedAt() int64 {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return 0
+}
+
+func (x *PortStatus) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PortStatus) GetShortSessionId() string {
+	if x != nil {
+		return x.ShortSessionId
+	}
+	return ""
+}
+
+func (x *PortStatus) GetShaping() *BandwidthShaping {
+	if x != nil {
+		return x.Shaping
+	}
+	return nil
+}
+
+type ListPortsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OnlyAvailable bool                   `protobuf:"varint,1,opt,name=only_available,json=onlyAvailable,proto3" json:"only_available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPortsRequest) Reset() {
+	*x = ListPortsRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPortsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortsRequest) ProtoMessage() {}
+
+func (x *ListPortsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortsRequest.ProtoReflect.Descriptor instead.
+func (*ListPortsRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPortsRequest) GetOnlyAvailable() bool {
+	if x != nil {
+		return x.OnlyAvailable
+	}
+	return false
+}
+
+type ListPortsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Ports               []*PortInfo            `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
+	Partial             bool                   `protobuf:"varint,2,opt,name=partial,proto3" json:"partial,omitempty"`
+	IncompleteProviders []string               `protobuf:"bytes,3,rep,name=incomplete_providers,json=incompleteProviders,proto3" json:"incomplete_providers,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListPortsResponse) Reset() {
+	*x = ListPortsResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPortsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortsResponse) ProtoMessage() {}
+
+func (x *ListPortsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortsResponse.ProtoReflect.Descriptor instead.
+func (*ListPortsResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPortsResponse) GetPorts() []*PortInfo {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *ListPortsResponse) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+func (x *ListPortsResponse) GetIncompleteProviders() []string {
+	if x != nil {
+		return x.IncompleteProviders
+	}
+	return nil
+}
+
+type GetPortInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortInfoRequest) Reset() {
+	*x = GetPortInfoRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortInfoRequest) ProtoMessage() {}
+
+func (x *GetPortInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetPortInfoRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPortInfoRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+type GetPortInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Port          *PortInfo              `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortInfoResponse) Reset() {
+	*x = GetPortInfoResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortInfoResponse) ProtoMessage() {}
+
+func (x *GetPortInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetPortInfoResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPortInfoResponse) GetPort() *PortInfo {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+type OpenPortRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PortName          string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Config            *PortConfig            `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	ClientId          string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Exclusive         bool                   `protobuf:"varint,4,opt,name=exclusive,proto3" json:"exclusive,omitempty"`
+	Priority          SessionPriority        `protobuf:"varint,5,opt,name=priority,proto3,enum=seriallink.v1.SessionPriority" json:"priority,omitempty"`
+	Init              []*InitStep            `protobuf:"bytes,6,rep,name=init,proto3" json:"init,omitempty"`
+	Metadata          map[string]string      `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	NoWriteCoalescing bool                   `protobuf:"varint,8,opt,name=no_write_coalescing,json=noWriteCoalescing,proto3" json:"no_write_coalescing,omitempty"`
+	Dtr               LineState              `protobuf:"varint,9,opt,name=dtr,proto3,enum=seriallink.v1.LineState" json:"dtr,omitempty"`
+	Rts               LineState              `protobuf:"varint,10,opt,name=rts,proto3,enum=seriallink.v1.LineState" json:"rts,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OpenPortRequest) Reset() {
+	*x = OpenPortRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenPortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenPortRequest) ProtoMessage() {}
+
+func (x *OpenPortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenPortRequest.ProtoReflect.Descriptor instead.
+func (*OpenPortRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OpenPortRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *OpenPortRequest) GetConfig() *PortConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *OpenPortRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OpenPortRequest) GetExclusive() bool {
+	if x != nil {
+		return x.Exclusive
+	}
+	return false
+}
+
+func (x *OpenPortRequest) GetPriority() SessionPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return SessionPriority_SESSION_PRIORITY_UNSPECIFIED
+}
+
+func (x *OpenPortRequest) GetInit() []*InitStep {
+	if x != nil {
+		return x.Init
+	}
+	return nil
+}
+
+func (x *OpenPortRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *OpenPortRequest) GetNoWriteCoalescing() bool {
+	if x != nil {
+		return x.NoWriteCoalescing
+	}
+	return false
+}
+
+func (x *OpenPortRequest) GetDtr() LineState {
+	if x != nil {
+		return x.Dtr
+	}
+	return LineState_LINE_STATE_UNSPECIFIED
+}
+
+func (x *OpenPortRequest) GetRts() LineState {
+	if x != nil {
+		return x.Rts
+	}
+	return LineState_LINE_STATE_UNSPECIFIED
+}
+
+type InitStep struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Data            []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Expected        []byte                 `protobuf:"bytes,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	ExpectedPattern string                 `protobuf:"bytes,3,opt,name=expected_pattern,json=expectedPattern,proto3" json:"expected_pattern,omitempty"`
+	Terminator      []byte                 `protobuf:"bytes,4,opt,name=terminator,proto3" json:"terminator,omitempty"`
+	TimeoutMs       uint32                 `protobuf:"varint,5,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InitStep) Reset() {
+	*x = InitStep{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitStep) ProtoMessage() {}
+
+func (x *InitStep) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitStep.ProtoReflect.Descriptor instead.
+func (*InitStep) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InitStep) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *InitStep) GetExpected() []byte {
+	if x != nil {
+		return x.Expected
+	}
+	return nil
+}
+
+func (x *InitStep) GetExpectedPattern() string {
+	if x != nil {
+		return x.ExpectedPattern
+	}
+	return ""
+}
+
+func (x *InitStep) GetTerminator() []byte {
+	if x != nil {
+		return x.Terminator
+	}
+	return nil
+}
+
+func (x *InitStep) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type OpenPortResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	SessionId      string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	InitResponses  [][]byte               `protobuf:"bytes,4,rep,name=init_responses,json=initResponses,proto3" json:"init_responses,omitempty"`
+	ShortSessionId string                 `protobuf:"bytes,5,opt,name=short_session_id,json=shortSessionId,proto3" json:"short_session_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OpenPortResponse) Reset() {
+	*x = OpenPortResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenPortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenPortResponse) ProtoMessage() {}
+
+func (x *OpenPortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenPortResponse.ProtoReflect.Descriptor instead.
+func (*OpenPortResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OpenPortResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *OpenPortResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *OpenPortResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *OpenPortResponse) GetInitResponses() [][]byte {
+	if x != nil {
+		return x.InitResponses
+	}
+	return nil
+}
+
+func (x *OpenPortResponse) GetShortSessionId() string {
+	if x != nil {
+		return x.ShortSessionId
+	}
+	return ""
+}
+
+type ClosePortRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosePortRequest) Reset() {
+	*x = ClosePortRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosePortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePortRequest) ProtoMessage() {}
+
+func (x *ClosePortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePortRequest.ProtoReflect.Descriptor instead.
+func (*ClosePortRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ClosePortRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *ClosePortRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type ClosePortResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClosePortResponse) Reset() {
+	*x = ClosePortResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClosePortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePortResponse) ProtoMessage() {}
+
+func (x *ClosePortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePortResponse.ProtoReflect.Descriptor instead.
+func (*ClosePortResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ClosePortResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ClosePortResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetPortStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortStatusRequest) Reset() {
+	*x = GetPortStatusRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortStatusRequest) ProtoMessage() {}
+
+func (x *GetPortStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPortStatusRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPortStatusRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+type GetPortStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *PortStatus            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortStatusResponse) Reset() {
+	*x = GetPortStatusResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortStatusResponse) ProtoMessage() {}
+
+func (x *GetPortStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetPortStatusResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetPortStatusResponse) GetStatus() *PortStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type WriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Flush         bool                   `protobuf:"varint,4,opt,name=flush,proto3" json:"flush,omitempty"`
+	ExecuteAt     int64                  `protobuf:"varint,5,opt,name=execute_at,json=executeAt,proto3" json:"execute_at,omitempty"`
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	TimeoutMs     uint32                 `protobuf:"varint,7,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteRequest) Reset() {
+	*x = WriteRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteRequest) ProtoMessage() {}
+
+func (x *WriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteRequest.ProtoReflect.Descriptor instead.
+func (*WriteRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WriteRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *WriteRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *WriteRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *WriteRequest) GetFlush() bool {
+	if x != nil {
+		return x.Flush
+	}
+	return false
+}
+
+func (x *WriteRequest) GetExecuteAt() int64 {
+	if x != nil {
+		return x.ExecuteAt
+	}
+	return 0
+}
+
+func (x *WriteRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *WriteRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type WriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	BytesWritten  uint32                 `protobuf:"varint,2,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	SentAt        int64                  `protobuf:"varint,4,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	ApprovalId    string                 `protobuf:"bytes,5,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	WireData      []byte                 `protobuf:"bytes,6,opt,name=wire_data,json=wireData,proto3" json:"wire_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteResponse) Reset() {
+	*x = WriteResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteResponse) ProtoMessage() {}
+
+func (x *WriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteResponse.ProtoReflect.Descriptor instead.
+func (*WriteResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WriteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *WriteResponse) GetBytesWritten() uint32 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+func (x *WriteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WriteResponse) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+func (x *WriteResponse) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *WriteResponse) GetWireData() []byte {
+	if x != nil {
+		return x.WireData
+	}
+	return nil
+}
+
+type ReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	MaxBytes      uint32                 `protobuf:"varint,3,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	TimeoutMs     uint32                 `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadRequest) Reset() {
+	*x = ReadRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRequest) ProtoMessage() {}
+
+func (x *ReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRequest.ProtoReflect.Descriptor instead.
+func (*ReadRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReadRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *ReadRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ReadRequest) GetMaxBytes() uint32 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *ReadRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type ReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	BytesRead     uint32                 `protobuf:"varint,3,opt,name=bytes_read,json=bytesRead,proto3" json:"bytes_read,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadResponse) Reset() {
+	*x = ReadResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadResponse) ProtoMessage() {}
+
+func (x *ReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadResponse.ProtoReflect.Descriptor instead.
+func (*ReadResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReadResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReadResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ReadResponse) GetBytesRead() uint32 {
+	if x != nil {
+		return x.BytesRead
+	}
+	return 0
+}
+
+func (x *ReadResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DataChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Sequence      uint32                 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataChunk) Reset() {
+	*x = DataChunk{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataChunk) ProtoMessage() {}
+
+func (x *DataChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataChunk.ProtoReflect.Descriptor instead.
+func (*DataChunk) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DataChunk) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *DataChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DataChunk) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *DataChunk) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type StreamReadRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PortName          string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId         string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ChunkSize         uint32                 `protobuf:"varint,3,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	IncludeTimestamps bool                   `protobuf:"varint,4,opt,name=include_timestamps,json=includeTimestamps,proto3" json:"include_timestamps,omitempty"`
+	Priority          SessionPriority        `protobuf:"varint,5,opt,name=priority,proto3,enum=seriallink.v1.SessionPriority" json:"priority,omitempty"`
+	DedupLines        bool                   `protobuf:"varint,6,opt,name=dedup_lines,json=dedupLines,proto3" json:"dedup_lines,omitempty"`
+	Filter            *StreamFilter          `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	Chunking          StreamChunking         `protobuf:"varint,8,opt,name=chunking,proto3,enum=seriallink.v1.StreamChunking" json:"chunking,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StreamReadRequest) Reset() {
+	*x = StreamReadRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamReadRequest) ProtoMessage() {}
+
+func (x *StreamReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamReadRequest.ProtoReflect.Descriptor instead.
+func (*StreamReadRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StreamReadRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *StreamReadRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *StreamReadRequest) GetChunkSize() uint32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+func (x *StreamReadRequest) GetIncludeTimestamps() bool {
+	if x != nil {
+		return x.IncludeTimestamps
+	}
+	return false
+}
+
+func (x *StreamReadRequest) GetPriority() SessionPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return SessionPriority_SESSION_PRIORITY_UNSPECIFIED
+}
+
+func (x *StreamReadRequest) GetDedupLines() bool {
+	if x != nil {
+		return x.DedupLines
+	}
+	return false
+}
+
+func (x *StreamReadRequest) GetFilter() *StreamFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *StreamReadRequest) GetChunking() StreamChunking {
+	if x != nil {
+		return x.Chunking
+	}
+	return StreamChunking_STREAM_CHUNKING_UNSPECIFIED
+}
+
+type StreamFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Prefix        []byte                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamFilter) Reset() {
+	*x = StreamFilter{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamFilter) ProtoMessage() {}
+
+func (x *StreamFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamFilter.ProtoReflect.Descriptor instead.
+func (*StreamFilter) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *StreamFilter) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *StreamFilter) GetPrefix() []byte {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+type StreamReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         *DataChunk             `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	RepeatCount   uint32                 `protobuf:"varint,2,opt,name=repeat_count,json=repeatCount,proto3" json:"repeat_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamReadResponse) Reset() {
+	*x = StreamReadResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamReadResponse) ProtoMessage() {}
+
+func (x *StreamReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamReadResponse.ProtoReflect.Descriptor instead.
+func (*StreamReadResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StreamReadResponse) GetChunk() *DataChunk {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *StreamReadResponse) GetRepeatCount() uint32 {
+	if x != nil {
+		return x.RepeatCount
+	}
+	return 0
+}
+
+type StreamTimedReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PerByte       bool                   `protobuf:"varint,3,opt,name=per_byte,json=perByte,proto3" json:"per_byte,omitempty"`
+	DurationMs    uint32                 `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamTimedReadRequest) Reset() {
+	*x = StreamTimedReadRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamTimedReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTimedReadRequest) ProtoMessage() {}
+
+func (x *StreamTimedReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTimedReadRequest.ProtoReflect.Descriptor instead.
+func (*StreamTimedReadRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StreamTimedReadRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *StreamTimedReadRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *StreamTimedReadRequest) GetPerByte() bool {
+	if x != nil {
+		return x.PerByte
+	}
+	return false
+}
+
+func (x *StreamTimedReadRequest) GetDurationMs() uint32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type TimedChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt   int64                  `protobuf:"varint,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	GapNs         int64                  `protobuf:"varint,4,opt,name=gap_ns,json=gapNs,proto3" json:"gap_ns,omitempty"`
+	Sequence      uint32                 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	CharTimeNs    int64                  `protobuf:"varint,6,opt,name=char_time_ns,json=charTimeNs,proto3" json:"char_time_ns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimedChunk) Reset() {
+	*x = TimedChunk{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimedChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimedChunk) ProtoMessage() {}
+
+func (x *TimedChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimedChunk.ProtoReflect.Descriptor instead.
+func (*TimedChunk) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TimedChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *TimedChunk) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *TimedChunk) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *TimedChunk) GetGapNs() int64 {
+	if x != nil {
+		return x.GapNs
+	}
+	return 0
+}
+
+func (x *TimedChunk) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *TimedChunk) GetCharTimeNs() int64 {
+	if x != nil {
+		return x.CharTimeNs
+	}
+	return 0
+}
+
+type StreamTimedReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         *TimedChunk            `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamTimedReadResponse) Reset() {
+	*x = StreamTimedReadResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamTimedReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamTimedReadResponse) ProtoMessage() {}
+
+func (x *StreamTimedReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamTimedReadResponse.ProtoReflect.Descriptor instead.
+func (*StreamTimedReadResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StreamTimedReadResponse) GetChunk() *TimedChunk {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type StreamWriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         *DataChunk             `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamWriteRequest) Reset() {
+	*x = StreamWriteRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamWriteRequest) ProtoMessage() {}
+
+func (x *StreamWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamWriteRequest.ProtoReflect.Descriptor instead.
+func (*StreamWriteRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StreamWriteRequest) GetChunk() *DataChunk {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type StreamWriteResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Success           bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TotalBytesWritten uint64                 `protobuf:"varint,2,opt,name=total_bytes_written,json=totalBytesWritten,proto3" json:"total_bytes_written,omitempty"`
+	ChunksProcessed   uint32                 `protobuf:"varint,3,opt,name=chunks_processed,json=chunksProcessed,proto3" json:"chunks_processed,omitempty"`
+	Message           string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StreamWriteResponse) Reset() {
+	*x = StreamWriteResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamWriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamWriteResponse) ProtoMessage() {}
+
+func (x *StreamWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamWriteResponse.ProtoReflect.Descriptor instead.
+func (*StreamWriteResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StreamWriteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StreamWriteResponse) GetTotalBytesWritten() uint64 {
+	if x != nil {
+		return x.TotalBytesWritten
+	}
+	return 0
+}
+
+func (x *StreamWriteResponse) GetChunksProcessed() uint32 {
+	if x != nil {
+		return x.ChunksProcessed
+	}
+	return 0
+}
+
+func (x *StreamWriteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BiDirectionalStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         *DataChunk             `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BiDirectionalStreamRequest) Reset() {
+	*x = BiDirectionalStreamRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BiDirectionalStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BiDirectionalStreamRequest) ProtoMessage() {}
+
+func (x *BiDirectionalStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BiDirectionalStreamRequest.ProtoReflect.Descriptor instead.
+func (*BiDirectionalStreamRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BiDirectionalStreamRequest) GetChunk() *DataChunk {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type BiDirectionalStreamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         *DataChunk             `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BiDirectionalStreamResponse) Reset() {
+	*x = BiDirectionalStreamResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BiDirectionalStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BiDirectionalStreamResponse) ProtoMessage() {}
+
+func (x *BiDirectionalStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BiDirectionalStreamResponse.ProtoReflect.Descriptor instead.
+func (*BiDirectionalStreamResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BiDirectionalStreamResponse) GetChunk() *DataChunk {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type ConfigurePortRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Config        *PortConfig            `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigurePortRequest) Reset() {
+	*x = ConfigurePortRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigurePortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurePortRequest) ProtoMessage() {}
+
+func (x *ConfigurePortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurePortRequest.ProtoReflect.Descriptor instead.
+func (*ConfigurePortRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ConfigurePortRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *ConfigurePortRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ConfigurePortRequest) GetConfig() *PortConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ConfigurePortResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigurePortResponse) Reset() {
+	*x = ConfigurePortResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigurePortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurePortResponse) ProtoMessage() {}
+
+func (x *ConfigurePortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurePortResponse.ProtoReflect.Descriptor instead.
+func (*ConfigurePortResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ConfigurePortResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConfigurePortResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetPortConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortConfigRequest) Reset() {
+	*x = GetPortConfigRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortConfigRequest) ProtoMessage() {}
+
+func (x *GetPortConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetPortConfigRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetPortConfigRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+type GetPortConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *PortConfig            `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortConfigResponse) Reset() {
+	*x = GetPortConfigResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortConfigResponse) ProtoMessage() {}
+
+func (x *GetPortConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetPortConfigResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetPortConfigResponse) GetConfig() *PortConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type PingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingRequest) ProtoMessage() {}
+
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PingRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ServerTime    int64                  `protobuf:"varint,2,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PingResponse) Reset() {
+	*x = PingResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResponse) ProtoMessage() {}
+
+func (x *PingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResponse.ProtoReflect.Descriptor instead.
+func (*PingResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PingResponse) GetServerTime() int64 {
+	if x != nil {
+		return x.ServerTime
+	}
+	return 0
+}
+
+type GetAgentInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentInfoRequest) Reset() {
+	*x = GetAgentInfoRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentInfoRequest) ProtoMessage() {}
+
+func (x *GetAgentInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentInfoRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{36}
+}
+
+type AgentConfig struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GrpcAddress    string                 `protobuf:"bytes,1,opt,name=grpc_address,json=grpcAddress,proto3" json:"grpc_address,omitempty"`
+	TlsEnabled     bool                   `protobuf:"varint,2,opt,name=tls_enabled,json=tlsEnabled,proto3" json:"tls_enabled,omitempty"`
+	MaxConnections uint32                 `protobuf:"varint,3,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AgentConfig) Reset() {
+	*x = AgentConfig{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentConfig) ProtoMessage() {}
+
+func (x *AgentConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentConfig.ProtoReflect.Descriptor instead.
+func (*AgentConfig) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AgentConfig) GetGrpcAddress() string {
+	if x != nil {
+		return x.GrpcAddress
+	}
+	return ""
+}
+
+func (x *AgentConfig) GetTlsEnabled() bool {
+	if x != nil {
+		return x.TlsEnabled
+	}
+	return false
+}
+
+func (x *AgentConfig) GetMaxConnections() uint32 {
+	if x != nil {
+		return x.MaxConnections
+	}
+	return 0
+}
+
+type AgentInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Version           string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	BuildCommit       string                 `protobuf:"bytes,2,opt,name=build_commit,json=buildCommit,proto3" json:"build_commit,omitempty"`
+	BuildDate         string                 `protobuf:"bytes,3,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	Os                string                 `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
+	Arch              string                 `protobuf:"bytes,5,opt,name=arch,proto3" json:"arch,omitempty"`
+	UptimeSeconds     int64                  `protobuf:"varint,6,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	SupportedFeatures []string               `protobuf:"bytes,7,rep,name=supported_features,json=supportedFeatures,proto3" json:"supported_features,omitempty"`
+	Config            *AgentConfig           `protobuf:"bytes,8,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AgentInfo) Reset() {
+	*x = AgentInfo{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentInfo) ProtoMessage() {}
+
+func (x *AgentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentInfo.ProtoReflect.Descriptor instead.
+func (*AgentInfo) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AgentInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *AgentInfo) GetBuildCommit() string {
+	if x != nil {
+		return x.BuildCommit
+	}
+	return ""
+}
+
+func (x *AgentInfo) GetBuildDate() string {
+	if x != nil {
+		return x.BuildDate
+	}
+	return ""
+}
+
+func (x *AgentInfo) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *AgentInfo) GetArch() string {
+	if x != nil {
+		return x.Arch
+	}
+	return ""
+}
+
+func (x *AgentInfo) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *AgentInfo) GetSupportedFeatures() []string {
+	if x != nil {
+		return x.SupportedFeatures
+	}
+	return nil
+}
+
+func (x *AgentInfo) GetConfig() *AgentConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type GetAgentInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          *AgentInfo             `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentInfoResponse) Reset() {
+	*x = GetAgentInfoResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentInfoResponse) ProtoMessage() {}
+
+func (x *GetAgentInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentInfoResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetAgentInfoResponse) GetInfo() *AgentInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type GetMemoryStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoryStatsRequest) Reset() {
+	*x = GetMemoryStatsRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoryStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoryStatsRequest) ProtoMessage() {}
+
+func (x *GetMemoryStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoryStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoryStatsRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{40}
+}
+
+type SessionMemoryStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SessionId         string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PortName          string                 `protobuf:"bytes,2,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	ClientId          string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Streams           uint32                 `protobuf:"varint,4,opt,name=streams,proto3" json:"streams,omitempty"`
+	BufferedBytes     int64                  `protobuf:"varint,5,opt,name=buffered_bytes,json=bufferedBytes,proto3" json:"buffered_bytes,omitempty"`
+	PeakBufferedBytes int64                  `protobuf:"varint,6,opt,name=peak_buffered_bytes,json=peakBufferedBytes,proto3" json:"peak_buffered_bytes,omitempty"`
+	DroppedBytes      uint64                 `protobuf:"varint,7,opt,name=dropped_bytes,json=droppedBytes,proto3" json:"dropped_bytes,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SessionMemoryStats) Reset() {
+	*x = SessionMemoryStats{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionMemoryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionMemoryStats) ProtoMessage() {}
+
+func (x *SessionMemoryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionMemoryStats.ProtoReflect.Descriptor instead.
+func (*SessionMemoryStats) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SessionMemoryStats) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionMemoryStats) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *SessionMemoryStats) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SessionMemoryStats) GetStreams() uint32 {
+	if x != nil {
+		return x.Streams
+	}
+	return 0
+}
+
+func (x *SessionMemoryStats) GetBufferedBytes() int64 {
+	if x != nil {
+		return x.BufferedBytes
+	}
+	return 0
+}
+
+func (x *SessionMemoryStats) GetPeakBufferedBytes() int64 {
+	if x != nil {
+		return x.PeakBufferedBytes
+	}
+	return 0
+}
+
+func (x *SessionMemoryStats) GetDroppedBytes() uint64 {
+	if x != nil {
+		return x.DroppedBytes
+	}
+	return 0
+}
+
+type GetMemoryStatsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GlobalLimitBytes  int64                  `protobuf:"varint,1,opt,name=global_limit_bytes,json=globalLimitBytes,proto3" json:"global_limit_bytes,omitempty"`
+	SessionLimitBytes int64                  `protobuf:"varint,2,opt,name=session_limit_bytes,json=sessionLimitBytes,proto3" json:"session_limit_bytes,omitempty"`
+	StreamLimitBytes  int64                  `protobuf:"varint,3,opt,name=stream_limit_bytes,json=streamLimitBytes,proto3" json:"stream_limit_bytes,omitempty"`
+	Streams           uint32                 `protobuf:"varint,4,opt,name=streams,proto3" json:"streams,omitempty"`
+	BufferedBytes     int64                  `protobuf:"varint,5,opt,name=buffered_bytes,json=bufferedBytes,proto3" json:"buffered_bytes,omitempty"`
+	PeakBufferedBytes int64                  `protobuf:"varint,6,opt,name=peak_buffered_bytes,json=peakBufferedBytes,proto3" json:"peak_buffered_bytes,omitempty"`
+	DroppedBytes      uint64                 `protobuf:"varint,7,opt,name=dropped_bytes,json=droppedBytes,proto3" json:"dropped_bytes,omitempty"`
+	HeapAllocBytes    uint64                 `protobuf:"varint,8,opt,name=heap_alloc_bytes,json=heapAllocBytes,proto3" json:"heap_alloc_bytes,omitempty"`
+	HeapSysBytes      uint64                 `protobuf:"varint,9,opt,name=heap_sys_bytes,json=heapSysBytes,proto3" json:"heap_sys_bytes,omitempty"`
+	Sessions          []*SessionMemoryStats  `protobuf:"bytes,10,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetMemoryStatsResponse) Reset() {
+	*x = GetMemoryStatsResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoryStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoryStatsResponse) ProtoMessage() {}
+
+func (x *GetMemoryStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoryStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetMemoryStatsResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetMemoryStatsResponse) GetGlobalLimitBytes() int64 {
+	if x != nil {
+		return x.GlobalLimitBytes
+	}
+	return 0
+}
+
+func (x *GetMemoryStatsResponse) GetSessionLimitBytes() int64 {
+	if x != nil {
+		return x.SessionLimitBytes
+	}
+	return 0
+}
+
+func (x *GetMemoryStatsResponse) GetStreamLimitBytes() int64 {
+	if x != nil {
+		return x.StreamLimitBytes
+	}
+	return 0
+}
+
+func (x *GetMemoryStatsResponse) GetStreams() uint32 {
+	if x != nil {
+		return x.Streams
+	}
+	return 0
+}
+
+func (x *GetMemoryStatsResponse) GetBufferedBytes() int64 {
+	if x != nil {
+		return x.BufferedBytes
+	}
+	return 0
+}
+
+func (x *GetMemoryStatsResponse) GetPeakBufferedBytes() int64 {
+	if x != nil {
+		return x.PeakBufferedBytes
+	}
+	return 0
+}
+
+func (x *GetMemoryStatsResponse) GetDroppedBytes() uint64 {
+	if x != nil {
+		return x.DroppedBytes
+	}
+	return 0
+}
+
+func (x *GetMemoryStatsResponse) GetHeapAllocBytes() uint64 {
+	if x != nil {
+		return x.HeapAllocBytes
+	}
+	return 0
+}
+
+func (x *GetMemoryStatsResponse) GetHeapSysBytes() uint64 {
+	if x != nil {
+		return x.HeapSysBytes
+	}
+	return 0
+}
+
+func (x *GetMemoryStatsResponse) GetSessions() []*SessionMemoryStats {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type GetRecentOutputRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	MaxBytes      uint32                 `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecentOutputRequest) Reset() {
+	*x = GetRecentOutputRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecentOutputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentOutputRequest) ProtoMessage() {}
+
+func (x *GetRecentOutputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentOutputRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentOutputRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetRecentOutputRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *GetRecentOutputRequest) GetMaxBytes() uint32 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+type GetRecentOutputResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	TotalBytes    uint64                 `protobuf:"varint,3,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecentOutputResponse) Reset() {
+	*x = GetRecentOutputResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecentOutputResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentOutputResponse) ProtoMessage() {}
+
+func (x *GetRecentOutputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentOutputResponse.ProtoReflect.Descriptor instead.
+func (*GetRecentOutputResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetRecentOutputResponse) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *GetRecentOutputResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetRecentOutputResponse) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+type GetRecentErrorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecentErrorsRequest) Reset() {
+	*x = GetRecentErrorsRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecentErrorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentErrorsRequest) ProtoMessage() {}
+
+func (x *GetRecentErrorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentErrorsRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentErrorsRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetRecentErrorsRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *GetRecentErrorsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetRecentErrorsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ErrorRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Operation     string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	ErrorClass    string                 `protobuf:"bytes,3,opt,name=error_class,json=errorClass,proto3" json:"error_class,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorRecord) Reset() {
+	*x = ErrorRecord{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorRecord) ProtoMessage() {}
+
+func (x *ErrorRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorRecord.ProtoReflect.Descriptor instead.
+func (*ErrorRecord) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ErrorRecord) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ErrorRecord) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ErrorRecord) GetErrorClass() string {
+	if x != nil {
+		return x.ErrorClass
+	}
+	return ""
+}
+
+func (x *ErrorRecord) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetRecentErrorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Errors        []*ErrorRecord         `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecentErrorsResponse) Reset() {
+	*x = GetRecentErrorsResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecentErrorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentErrorsResponse) ProtoMessage() {}
+
+func (x *GetRecentErrorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentErrorsResponse.ProtoReflect.Descriptor instead.
+func (*GetRecentErrorsResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetRecentErrorsResponse) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *GetRecentErrorsResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetRecentErrorsResponse) GetErrors() []*ErrorRecord {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type DiagnoseLineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	BaudRates     []uint32               `protobuf:"varint,3,rep,packed,name=baud_rates,json=baudRates,proto3" json:"baud_rates,omitempty"`
+	SampleMs      uint32                 `protobuf:"varint,4,opt,name=sample_ms,json=sampleMs,proto3" json:"sample_ms,omitempty"`
+	Probe         []byte                 `protobuf:"bytes,5,opt,name=probe,proto3" json:"probe,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnoseLineRequest) Reset() {
+	*x = DiagnoseLineRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnoseLineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnoseLineRequest) ProtoMessage() {}
+
+func (x *DiagnoseLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnoseLineRequest.ProtoReflect.Descriptor instead.
+func (*DiagnoseLineRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DiagnoseLineRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *DiagnoseLineRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DiagnoseLineRequest) GetBaudRates() []uint32 {
+	if x != nil {
+		return x.BaudRates
+	}
+	return nil
+}
+
+func (x *DiagnoseLineRequest) GetSampleMs() uint32 {
+	if x != nil {
+		return x.SampleMs
+	}
+	return 0
+}
+
+func (x *DiagnoseLineRequest) GetProbe() []byte {
+	if x != nil {
+		return x.Probe
+	}
+	return nil
+}
+
+type LineCandidate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *PortConfig            `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	BytesReceived uint64                 `protobuf:"varint,3,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+	Sample        []byte                 `protobuf:"bytes,4,opt,name=sample,proto3" json:"sample,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineCandidate) Reset() {
+	*x = LineCandidate{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineCandidate) ProtoMessage() {}
+
+func (x *LineCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineCandidate.ProtoReflect.Descriptor instead.
+func (*LineCandidate) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *LineCandidate) GetConfig() *PortConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *LineCandidate) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *LineCandidate) GetBytesReceived() uint64 {
+	if x != nil {
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *LineCandidate) GetSample() []byte {
+	if x != nil {
+		return x.Sample
+	}
+	return nil
+}
+
+type DiagnoseLineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candidates    []*LineCandidate       `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnoseLineResponse) Reset() {
+	*x = DiagnoseLineResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnoseLineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnoseLineResponse) ProtoMessage() {}
+
+func (x *DiagnoseLineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnoseLineResponse.ProtoReflect.Descriptor instead.
+func (*DiagnoseLineResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DiagnoseLineResponse) GetCandidates() []*LineCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type VerifyRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PortName        string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId       string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Command         []byte                 `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
+	Expected        []byte                 `protobuf:"bytes,4,opt,name=expected,proto3" json:"expected,omitempty"`
+	ExpectedPattern string                 `protobuf:"bytes,5,opt,name=expected_pattern,json=expectedPattern,proto3" json:"expected_pattern,omitempty"`
+	Terminator      []byte                 `protobuf:"bytes,6,opt,name=terminator,proto3" json:"terminator,omitempty"`
+	ResponseLength  uint32                 `protobuf:"varint,7,opt,name=response_length,json=responseLength,proto3" json:"response_length,omitempty"`
+	TimeoutMs       uint32                 `protobuf:"varint,8,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	IdleMs          uint32                 `protobuf:"varint,9,opt,name=idle_ms,json=idleMs,proto3" json:"idle_ms,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VerifyRequest) Reset() {
+	*x = VerifyRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyRequest) ProtoMessage() {}
+
+func (x *VerifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyRequest.ProtoReflect.Descriptor instead.
+func (*VerifyRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *VerifyRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *VerifyRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *VerifyRequest) GetCommand() []byte {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *VerifyRequest) GetExpected() []byte {
+	if x != nil {
+		return x.Expected
+	}
+	return nil
+}
+
+func (x *VerifyRequest) GetExpectedPattern() string {
+	if x != nil {
+		return x.ExpectedPattern
+	}
+	return ""
+}
+
+func (x *VerifyRequest) GetTerminator() []byte {
+	if x != nil {
+		return x.Terminator
+	}
+	return nil
+}
+
+func (x *VerifyRequest) GetResponseLength() uint32 {
+	if x != nil {
+		return x.ResponseLength
+	}
+	return 0
+}
+
+func (x *VerifyRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *VerifyRequest) GetIdleMs() uint32 {
+	if x != nil {
+		return x.IdleMs
+	}
+	return 0
+}
+
+type VerifyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Passed        bool                   `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
+	Response      []byte                 `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Diff          string                 `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyResponse) Reset() {
+	*x = VerifyResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyResponse) ProtoMessage() {}
+
+func (x *VerifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyResponse.ProtoReflect.Descriptor instead.
+func (*VerifyResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *VerifyResponse) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *VerifyResponse) GetResponse() []byte {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *VerifyResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *VerifyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SyncWrite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncWrite) Reset() {
+	*x = SyncWrite{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncWrite) ProtoMessage() {}
+
+func (x *SyncWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncWrite.ProtoReflect.Descriptor instead.
+func (*SyncWrite) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SyncWrite) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *SyncWrite) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SyncWrite) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SynchronizedWriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Writes        []*SyncWrite           `protobuf:"bytes,1,rep,name=writes,proto3" json:"writes,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SynchronizedWriteRequest) Reset() {
+	*x = SynchronizedWriteRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SynchronizedWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SynchronizedWriteRequest) ProtoMessage() {}
+
+func (x *SynchronizedWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SynchronizedWriteRequest.ProtoReflect.Descriptor instead.
+func (*SynchronizedWriteRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SynchronizedWriteRequest) GetWrites() []*SyncWrite {
+	if x != nil {
+		return x.Writes
+	}
+	return nil
+}
+
+func (x *SynchronizedWriteRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type SyncWriteResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	BytesWritten  uint32                 `protobuf:"varint,2,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt   int64                  `protobuf:"varint,4,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	WireData      []byte                 `protobuf:"bytes,6,opt,name=wire_data,json=wireData,proto3" json:"wire_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncWriteResult) Reset() {
+	*x = SyncWriteResult{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncWriteResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncWriteResult) ProtoMessage() {}
+
+func (x *SyncWriteResult) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncWriteResult.ProtoReflect.Descriptor instead.
+func (*SyncWriteResult) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SyncWriteResult) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *SyncWriteResult) GetBytesWritten() uint32 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+func (x *SyncWriteResult) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *SyncWriteResult) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *SyncWriteResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SyncWriteResult) GetWireData() []byte {
+	if x != nil {
+		return x.WireData
+	}
+	return nil
+}
+
+type SynchronizedWriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Results       []*SyncWriteResult     `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	SkewNs        int64                  `protobuf:"varint,4,opt,name=skew_ns,json=skewNs,proto3" json:"skew_ns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SynchronizedWriteResponse) Reset() {
+	*x = SynchronizedWriteResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SynchronizedWriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SynchronizedWriteResponse) ProtoMessage() {}
+
+func (x *SynchronizedWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SynchronizedWriteResponse.ProtoReflect.Descriptor instead.
+func (*SynchronizedWriteResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SynchronizedWriteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SynchronizedWriteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SynchronizedWriteResponse) GetResults() []*SyncWriteResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SynchronizedWriteResponse) GetSkewNs() int64 {
+	if x != nil {
+		return x.SkewNs
+	}
+	return 0
+}
+
+type ResetTargetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	PulseMs       uint32                 `protobuf:"varint,2,opt,name=pulse_ms,json=pulseMs,proto3" json:"pulse_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetTargetRequest) Reset() {
+	*x = ResetTargetRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetTargetRequest) ProtoMessage() {}
+
+func (x *ResetTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetTargetRequest.ProtoReflect.Descriptor instead.
+func (*ResetTargetRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ResetTargetRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *ResetTargetRequest) GetPulseMs() uint32 {
+	if x != nil {
+		return x.PulseMs
+	}
+	return 0
+}
+
+type ResetTargetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetTargetResponse) Reset() {
+	*x = ResetTargetResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetTargetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetTargetResponse) ProtoMessage() {}
+
+func (x *ResetTargetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetTargetResponse.ProtoReflect.Descriptor instead.
+func (*ResetTargetResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ResetTargetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResetTargetResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListBusDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BusType       string                 `protobuf:"bytes,1,opt,name=bus_type,json=busType,proto3" json:"bus_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBusDevicesRequest) Reset() {
+	*x = ListBusDevicesRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBusDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBusDevicesRequest) ProtoMessage() {}
+
+func (x *ListBusDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBusDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListBusDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListBusDevicesRequest) GetBusType() string {
+	if x != nil {
+		return x.BusType
+	}
+	return ""
+}
+
+type BusDevice struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BusType       string                 `protobuf:"bytes,2,opt,name=bus_type,json=busType,proto3" json:"bus_type,omitempty"`
+	Provider      string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BusDevice) Reset() {
+	*x = BusDevice{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BusDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusDevice) ProtoMessage() {}
+
+func (x *BusDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusDevice.ProtoReflect.Descriptor instead.
+func (*BusDevice) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *BusDevice) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BusDevice) GetBusType() string {
+	if x != nil {
+		return x.BusType
+	}
+	return ""
+}
+
+func (x *BusDevice) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *BusDevice) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ListBusDevicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []*BusDevice           `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBusDevicesResponse) Reset() {
+	*x = ListBusDevicesResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBusDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBusDevicesResponse) ProtoMessage() {}
+
+func (x *ListBusDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBusDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListBusDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListBusDevicesResponse) GetDevices() []*BusDevice {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type I2CTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Address       uint32                 `protobuf:"varint,2,opt,name=address,proto3" json:"address,omitempty"`
+	Write         []byte                 `protobuf:"bytes,3,opt,name=write,proto3" json:"write,omitempty"`
+	ReadLength    uint32                 `protobuf:"varint,4,opt,name=read_length,json=readLength,proto3" json:"read_length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *I2CTransferRequest) Reset() {
+	*x = I2CTransferRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *I2CTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*I2CTransferRequest) ProtoMessage() {}
+
+func (x *I2CTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use I2CTransferRequest.ProtoReflect.Descriptor instead.
+func (*I2CTransferRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *I2CTransferRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *I2CTransferRequest) GetAddress() uint32 {
+	if x != nil {
+		return x.Address
+	}
+	return 0
+}
+
+func (x *I2CTransferRequest) GetWrite() []byte {
+	if x != nil {
+		return x.Write
+	}
+	return nil
+}
+
+func (x *I2CTransferRequest) GetReadLength() uint32 {
+	if x != nil {
+		return x.ReadLength
+	}
+	return 0
+}
+
+type I2CTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *I2CTransferResponse) Reset() {
+	*x = I2CTransferResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *I2CTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*I2CTransferResponse) ProtoMessage() {}
+
+func (x *I2CTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use I2CTransferResponse.ProtoReflect.Descriptor instead.
+func (*I2CTransferResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *I2CTransferResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SPITransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Mode          uint32                 `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	SpeedHz       uint32                 `protobuf:"varint,4,opt,name=speed_hz,json=speedHz,proto3" json:"speed_hz,omitempty"`
+	BitsPerWord   uint32                 `protobuf:"varint,5,opt,name=bits_per_word,json=bitsPerWord,proto3" json:"bits_per_word,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SPITransferRequest) Reset() {
+	*x = SPITransferRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SPITransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SPITransferRequest) ProtoMessage() {}
+
+func (x *SPITransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SPITransferRequest.ProtoReflect.Descriptor instead.
+func (*SPITransferRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SPITransferRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *SPITransferRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SPITransferRequest) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *SPITransferRequest) GetSpeedHz() uint32 {
+	if x != nil {
+		return x.SpeedHz
+	}
+	return 0
+}
+
+func (x *SPITransferRequest) GetBitsPerWord() uint32 {
+	if x != nil {
+		return x.BitsPerWord
+	}
+	return 0
+}
+
+type SPITransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SPITransferResponse) Reset() {
+	*x = SPITransferResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SPITransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SPITransferResponse) ProtoMessage() {}
+
+func (x *SPITransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SPITransferResponse.ProtoReflect.Descriptor instead.
+func (*SPITransferResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SPITransferResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SendSMSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Number        string                 `protobuf:"bytes,3,opt,name=number,proto3" json:"number,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Mode          string                 `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendSMSRequest) Reset() {
+	*x = SendSMSRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendSMSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendSMSRequest) ProtoMessage() {}
+
+func (x *SendSMSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendSMSRequest.ProtoReflect.Descriptor instead.
+func (*SendSMSRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SendSMSRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *SendSMSRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SendSMSRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *SendSMSRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SendSMSRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type SendSMSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Reference     uint32                 `protobuf:"varint,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendSMSResponse) Reset() {
+	*x = SendSMSResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendSMSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendSMSResponse) ProtoMessage() {}
+
+func (x *SendSMSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendSMSResponse.ProtoReflect.Descriptor instead.
+func (*SendSMSResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SendSMSResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendSMSResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendSMSResponse) GetReference() uint32 {
+	if x != nil {
+		return x.Reference
+	}
+	return 0
+}
+
+type ReadSMSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,4,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	Delete        bool                   `protobuf:"varint,5,opt,name=delete,proto3" json:"delete,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadSMSRequest) Reset() {
+	*x = ReadSMSRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadSMSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSMSRequest) ProtoMessage() {}
+
+func (x *ReadSMSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSMSRequest.ProtoReflect.Descriptor instead.
+func (*ReadSMSRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ReadSMSRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *ReadSMSRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ReadSMSRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ReadSMSRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *ReadSMSRequest) GetDelete() bool {
+	if x != nil {
+		return x.Delete
+	}
+	return false
+}
+
+type SMSMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Sender        string                 `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SMSMessage) Reset() {
+	*x = SMSMessage{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SMSMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SMSMessage) ProtoMessage() {}
+
+func (x *SMSMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SMSMessage.ProtoReflect.Descriptor instead.
+func (*SMSMessage) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SMSMessage) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SMSMessage) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SMSMessage) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *SMSMessage) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *SMSMessage) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ReadSMSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Messages      []*SMSMessage          `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadSMSResponse) Reset() {
+	*x = ReadSMSResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadSMSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSMSResponse) ProtoMessage() {}
+
+func (x *ReadSMSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSMSResponse.ProtoReflect.Descriptor instead.
+func (*ReadSMSResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ReadSMSResponse) GetMessages() []*SMSMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type GetModemStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModemStatusRequest) Reset() {
+	*x = GetModemStatusRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModemStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModemStatusRequest) ProtoMessage() {}
+
+func (x *GetModemStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModemStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetModemStatusRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetModemStatusRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *GetModemStatusRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetModemStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SignalRssi    uint32                 `protobuf:"varint,1,opt,name=signal_rssi,json=signalRssi,proto3" json:"signal_rssi,omitempty"`
+	SignalDbm     int32                  `protobuf:"varint,2,opt,name=signal_dbm,json=signalDbm,proto3" json:"signal_dbm,omitempty"`
+	Registration  string                 `protobuf:"bytes,3,opt,name=registration,proto3" json:"registration,omitempty"`
+	Operator      string                 `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModemStatusResponse) Reset() {
+	*x = GetModemStatusResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModemStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModemStatusResponse) ProtoMessage() {}
+
+func (x *GetModemStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModemStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetModemStatusResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetModemStatusResponse) GetSignalRssi() uint32 {
+	if x != nil {
+		return x.SignalRssi
+	}
+	return 0
+}
+
+func (x *GetModemStatusResponse) GetSignalDbm() int32 {
+	if x != nil {
+		return x.SignalDbm
+	}
+	return 0
+}
+
+func (x *GetModemStatusResponse) GetRegistration() string {
+	if x != nil {
+		return x.Registration
+	}
+	return ""
+}
+
+func (x *GetModemStatusResponse) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+type HandOffPPPRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Apn           string                 `protobuf:"bytes,3,opt,name=apn,proto3" json:"apn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandOffPPPRequest) Reset() {
+	*x = HandOffPPPRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandOffPPPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandOffPPPRequest) ProtoMessage() {}
+
+func (x *HandOffPPPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandOffPPPRequest.ProtoReflect.Descriptor instead.
+func (*HandOffPPPRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *HandOffPPPRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *HandOffPPPRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *HandOffPPPRequest) GetApn() string {
+	if x != nil {
+		return x.Apn
+	}
+	return ""
+}
+
+type HandOffPPPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	DialString    string                 `protobuf:"bytes,3,opt,name=dial_string,json=dialString,proto3" json:"dial_string,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandOffPPPResponse) Reset() {
+	*x = HandOffPPPResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandOffPPPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandOffPPPResponse) ProtoMessage() {}
+
+func (x *HandOffPPPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandOffPPPResponse.ProtoReflect.Descriptor instead.
+func (*HandOffPPPResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *HandOffPPPResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HandOffPPPResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HandOffPPPResponse) GetDialString() string {
+	if x != nil {
+		return x.DialString
+	}
+	return ""
+}
+
+type PrintTextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Bold          bool                   `protobuf:"varint,4,opt,name=bold,proto3" json:"bold,omitempty"`
+	Underline     bool                   `protobuf:"varint,5,opt,name=underline,proto3" json:"underline,omitempty"`
+	Align         string                 `protobuf:"bytes,6,opt,name=align,proto3" json:"align,omitempty"`
+	Size          uint32                 `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	CodePage      string                 `protobuf:"bytes,8,opt,name=code_page,json=codePage,proto3" json:"code_page,omitempty"`
+	FeedLines     uint32                 `protobuf:"varint,9,opt,name=feed_lines,json=feedLines,proto3" json:"feed_lines,omitempty"`
+	Cut           bool                   `protobuf:"varint,10,opt,name=cut,proto3" json:"cut,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrintTextRequest) Reset() {
+	*x = PrintTextRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrintTextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrintTextRequest) ProtoMessage() {}
+
+func (x *PrintTextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrintTextRequest.ProtoReflect.Descriptor instead.
+func (*PrintTextRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PrintTextRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *PrintTextRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PrintTextRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PrintTextRequest) GetBold() bool {
+	if x != nil {
+		return x.Bold
+	}
+	return false
+}
+
+func (x *PrintTextRequest) GetUnderline() bool {
+	if x != nil {
+		return x.Underline
+	}
+	return false
+}
+
+func (x *PrintTextRequest) GetAlign() string {
+	if x != nil {
+		return x.Align
+	}
+	return ""
+}
+
+func (x *PrintTextRequest) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PrintTextRequest) GetCodePage() string {
+	if x != nil {
+		return x.CodePage
+	}
+	return ""
+}
+
+func (x *PrintTextRequest) GetFeedLines() uint32 {
+	if x != nil {
+		return x.FeedLines
+	}
+	return 0
+}
+
+func (x *PrintTextRequest) GetCut() bool {
+	if x != nil {
+		return x.Cut
+	}
+	return false
+}
+
+type PrintRasterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Image         []byte                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Width         uint32                 `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Dither        bool                   `protobuf:"varint,5,opt,name=dither,proto3" json:"dither,omitempty"`
+	Align         string                 `protobuf:"bytes,6,opt,name=align,proto3" json:"align,omitempty"`
+	Cut           bool                   `protobuf:"varint,7,opt,name=cut,proto3" json:"cut,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrintRasterRequest) Reset() {
+	*x = PrintRasterRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrintRasterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrintRasterRequest) ProtoMessage() {}
+
+func (x *PrintRasterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrintRasterRequest.ProtoReflect.Descriptor instead.
+func (*PrintRasterRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PrintRasterRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *PrintRasterRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PrintRasterRequest) GetImage() []byte {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *PrintRasterRequest) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *PrintRasterRequest) GetDither() bool {
+	if x != nil {
+		return x.Dither
+	}
+	return false
+}
+
+func (x *PrintRasterRequest) GetAlign() string {
+	if x != nil {
+		return x.Align
+	}
+	return ""
+}
+
+func (x *PrintRasterRequest) GetCut() bool {
+	if x != nil {
+		return x.Cut
+	}
+	return false
+}
+
+type CutPaperRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Partial       bool                   `protobuf:"varint,3,opt,name=partial,proto3" json:"partial,omitempty"`
+	FeedLines     uint32                 `protobuf:"varint,4,opt,name=feed_lines,json=feedLines,proto3" json:"feed_lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CutPaperRequest) Reset() {
+	*x = CutPaperRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CutPaperRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CutPaperRequest) ProtoMessage() {}
+
+func (x *CutPaperRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CutPaperRequest.ProtoReflect.Descriptor instead.
+func (*CutPaperRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CutPaperRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *CutPaperRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *CutPaperRequest) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+func (x *CutPaperRequest) GetFeedLines() uint32 {
+	if x != nil {
+		return x.FeedLines
+	}
+	return 0
+}
+
+type PrintResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	BytesWritten  uint32                 `protobuf:"varint,3,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrintResponse) Reset() {
+	*x = PrintResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrintResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrintResponse) ProtoMessage() {}
+
+func (x *PrintResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrintResponse.ProtoReflect.Descriptor instead.
+func (*PrintResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *PrintResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PrintResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PrintResponse) GetBytesWritten() uint32 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+type GetPrinterStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrinterStatusRequest) Reset() {
+	*x = GetPrinterStatusRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrinterStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrinterStatusRequest) ProtoMessage() {}
+
+func (x *GetPrinterStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrinterStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPrinterStatusRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetPrinterStatusRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *GetPrinterStatusRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetPrinterStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Online        bool                   `protobuf:"varint,1,opt,name=online,proto3" json:"online,omitempty"`
+	CoverOpen     bool                   `protobuf:"varint,2,opt,name=cover_open,json=coverOpen,proto3" json:"cover_open,omitempty"`
+	PaperNearEnd  bool                   `protobuf:"varint,3,opt,name=paper_near_end,json=paperNearEnd,proto3" json:"paper_near_end,omitempty"`
+	PaperOut      bool                   `protobuf:"varint,4,opt,name=paper_out,json=paperOut,proto3" json:"paper_out,omitempty"`
+	CutterError   bool                   `protobuf:"varint,5,opt,name=cutter_error,json=cutterError,proto3" json:"cutter_error,omitempty"`
+	Error         bool                   `protobuf:"varint,6,opt,name=error,proto3" json:"error,omitempty"`
+	Summary       string                 `protobuf:"bytes,7,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrinterStatusResponse) Reset() {
+	*x = GetPrinterStatusResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrinterStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrinterStatusResponse) ProtoMessage() {}
+
+func (x *GetPrinterStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrinterStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetPrinterStatusResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetPrinterStatusResponse) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *GetPrinterStatusResponse) GetCoverOpen() bool {
+	if x != nil {
+		return x.CoverOpen
+	}
+	return false
+}
+
+func (x *GetPrinterStatusResponse) GetPaperNearEnd() bool {
+	if x != nil {
+		return x.PaperNearEnd
+	}
+	return false
+}
+
+func (x *GetPrinterStatusResponse) GetPaperOut() bool {
+	if x != nil {
+		return x.PaperOut
+	}
+	return false
+}
+
+func (x *GetPrinterStatusResponse) GetCutterError() bool {
+	if x != nil {
+		return x.CutterError
+	}
+	return false
+}
+
+func (x *GetPrinterStatusResponse) GetError() bool {
+	if x != nil {
+		return x.Error
+	}
+	return false
+}
+
+func (x *GetPrinterStatusResponse) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+type StreamScansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Terminators   string                 `protobuf:"bytes,3,opt,name=terminators,proto3" json:"terminators,omitempty"`
+	Prefix        string                 `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Suffix        string                 `protobuf:"bytes,5,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	DebounceMs    uint32                 `protobuf:"varint,6,opt,name=debounce_ms,json=debounceMs,proto3" json:"debounce_ms,omitempty"`
+	IdleMs        uint32                 `protobuf:"varint,7,opt,name=idle_ms,json=idleMs,proto3" json:"idle_ms,omitempty"`
+	MinLength     uint32                 `protobuf:"varint,8,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamScansRequest) Reset() {
+	*x = StreamScansRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamScansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamScansRequest) ProtoMessage() {}
+
+func (x *StreamScansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamScansRequest.ProtoReflect.Descriptor instead.
+func (*StreamScansRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *StreamScansRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *StreamScansRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *StreamScansRequest) GetTerminators() string {
+	if x != nil {
+		return x.Terminators
+	}
+	return ""
+}
+
+func (x *StreamScansRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *StreamScansRequest) GetSuffix() string {
+	if x != nil {
+		return x.Suffix
+	}
+	return ""
+}
+
+func (x *StreamScansRequest) GetDebounceMs() uint32 {
+	if x != nil {
+		return x.DebounceMs
+	}
+	return 0
+}
+
+func (x *StreamScansRequest) GetIdleMs() uint32 {
+	if x != nil {
+		return x.IdleMs
+	}
+	return 0
+}
+
+func (x *StreamScansRequest) GetMinLength() uint32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+type ScanEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Sequence      uint64                 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanEvent) Reset() {
+	*x = ScanEvent{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanEvent) ProtoMessage() {}
+
+func (x *ScanEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanEvent.ProtoReflect.Descriptor instead.
+func (*ScanEvent) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ScanEvent) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *ScanEvent) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ScanEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ScanEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type StreamScansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scan          *ScanEvent             `protobuf:"bytes,1,opt,name=scan,proto3" json:"scan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamScansResponse) Reset() {
+	*x = StreamScansResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamScansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamScansResponse) ProtoMessage() {}
+
+func (x *StreamScansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamScansResponse.ProtoReflect.Descriptor instead.
+func (*StreamScansResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *StreamScansResponse) GetScan() *ScanEvent {
+	if x != nil {
+		return x.Scan
+	}
+	return nil
+}
+
+type StreamPolledValuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pollers       []string               `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamPolledValuesRequest) Reset() {
+	*x = StreamPolledValuesRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPolledValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPolledValuesRequest) ProtoMessage() {}
+
+func (x *StreamPolledValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPolledValuesRequest.ProtoReflect.Descriptor instead.
+func (*StreamPolledValuesRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *StreamPolledValuesRequest) GetPollers() []string {
+	if x != nil {
+		return x.Pollers
+	}
+	return nil
+}
+
+type PolledValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Unit          string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolledValue) Reset() {
+	*x = PolledValue{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolledValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolledValue) ProtoMessage() {}
+
+func (x *PolledValue) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolledValue.ProtoReflect.Descriptor instead.
+func (*PolledValue) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *PolledValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PolledValue) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *PolledValue) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type PolledSample struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Poller        string                 `protobuf:"bytes,1,opt,name=poller,proto3" json:"poller,omitempty"`
+	PortName      string                 `protobuf:"bytes,2,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Values        []*PolledValue         `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolledSample) Reset() {
+	*x = PolledSample{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolledSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolledSample) ProtoMessage() {}
+
+func (x *PolledSample) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolledSample.ProtoReflect.Descriptor instead.
+func (*PolledSample) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *PolledSample) GetPoller() string {
+	if x != nil {
+		return x.Poller
+	}
+	return ""
+}
+
+func (x *PolledSample) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *PolledSample) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PolledSample) GetValues() []*PolledValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *PolledSample) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type StreamPolledValuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sample        *PolledSample          `protobuf:"bytes,1,opt,name=sample,proto3" json:"sample,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamPolledValuesResponse) Reset() {
+	*x = StreamPolledValuesResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPolledValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPolledValuesResponse) ProtoMessage() {}
+
+func (x *StreamPolledValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPolledValuesResponse.ProtoReflect.Descriptor instead.
+func (*StreamPolledValuesResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *StreamPolledValuesResponse) GetSample() *PolledSample {
+	if x != nil {
+		return x.Sample
+	}
+	return nil
+}
+
+type QueryHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Poller        string                 `protobuf:"bytes,1,opt,name=poller,proto3" json:"poller,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	StartTime     int64                  `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       int64                  `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	StepSeconds   uint32                 `protobuf:"varint,5,opt,name=step_seconds,json=stepSeconds,proto3" json:"step_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryHistoryRequest) Reset() {
+	*x = QueryHistoryRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryHistoryRequest) ProtoMessage() {}
+
+func (x *QueryHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *QueryHistoryRequest) GetPoller() string {
+	if x != nil {
+		return x.Poller
+	}
+	return ""
+}
+
+func (x *QueryHistoryRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *QueryHistoryRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *QueryHistoryRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *QueryHistoryRequest) GetStepSeconds() uint32 {
+	if x != nil {
+		return x.StepSeconds
+	}
+	return 0
+}
+
+type HistoryPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Min           float64                `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	Avg           float64                `protobuf:"fixed64,4,opt,name=avg,proto3" json:"avg,omitempty"`
+	Count         uint32                 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryPoint) Reset() {
+	*x = HistoryPoint{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryPoint) ProtoMessage() {}
+
+func (x *HistoryPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryPoint.ProtoReflect.Descriptor instead.
+func (*HistoryPoint) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *HistoryPoint) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetAvg() float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+func (x *HistoryPoint) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type HistorySeries struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Poller        string                 `protobuf:"bytes,1,opt,name=poller,proto3" json:"poller,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Points        []*HistoryPoint        `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistorySeries) Reset() {
+	*x = HistorySeries{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistorySeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistorySeries) ProtoMessage() {}
+
+func (x *HistorySeries) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistorySeries.ProtoReflect.Descriptor instead.
+func (*HistorySeries) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *HistorySeries) GetPoller() string {
+	if x != nil {
+		return x.Poller
+	}
+	return ""
+}
+
+func (x *HistorySeries) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *HistorySeries) GetPoints() []*HistoryPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type QueryHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        []*HistorySeries       `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	StepSeconds   uint32                 `protobuf:"varint,2,opt,name=step_seconds,json=stepSeconds,proto3" json:"step_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryHistoryResponse) Reset() {
+	*x = QueryHistoryResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryHistoryResponse) ProtoMessage() {}
+
+func (x *QueryHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QueryHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *QueryHistoryResponse) GetSeries() []*HistorySeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *QueryHistoryResponse) GetStepSeconds() uint32 {
+	if x != nil {
+		return x.StepSeconds
+	}
+	return 0
+}
+
+type Alarm struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rule           string                 `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Poller         string                 `protobuf:"bytes,3,opt,name=poller,proto3" json:"poller,omitempty"`
+	Field          string                 `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`
+	PortName       string                 `protobuf:"bytes,5,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Severity       string                 `protobuf:"bytes,6,opt,name=severity,proto3" json:"severity,omitempty"`
+	Message        string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Condition      string                 `protobuf:"bytes,8,opt,name=condition,proto3" json:"condition,omitempty"`
+	Threshold      float64                `protobuf:"fixed64,9,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Value          float64                `protobuf:"fixed64,10,opt,name=value,proto3" json:"value,omitempty"`
+	State          string                 `protobuf:"bytes,11,opt,name=state,proto3" json:"state,omitempty"`
+	RaisedAt       int64                  `protobuf:"varint,12,opt,name=raised_at,json=raisedAt,proto3" json:"raised_at,omitempty"`
+	ClearedAt      int64                  `protobuf:"varint,13,opt,name=cleared_at,json=clearedAt,proto3" json:"cleared_at,omitempty"`
+	Acknowledged   bool                   `protobuf:"varint,14,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	AcknowledgedAt int64                  `protobuf:"varint,15,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
+	AcknowledgedBy string                 `protobuf:"bytes,16,opt,name=acknowledged_by,json=acknowledgedBy,proto3" json:"acknowledged_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Alarm) Reset() {
+	*x = Alarm{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alarm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alarm) ProtoMessage() {}
+
+func (x *Alarm) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alarm.ProtoReflect.Descriptor instead.
+func (*Alarm) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *Alarm) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Alarm) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *Alarm) GetPoller() string {
+	if x != nil {
+		return x.Poller
+	}
+	return ""
+}
+
+func (x *Alarm) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Alarm) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *Alarm) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Alarm) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Alarm) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *Alarm) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Alarm) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Alarm) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Alarm) GetRaisedAt() int64 {
+	if x != nil {
+		return x.RaisedAt
+	}
+	return 0
+}
+
+func (x *Alarm) GetClearedAt() int64 {
+	if x != nil {
+		return x.ClearedAt
+	}
+	return 0
+}
+
+func (x *Alarm) GetAcknowledged() bool {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return false
+}
+
+func (x *Alarm) GetAcknowledgedAt() int64 {
+	if x != nil {
+		return x.AcknowledgedAt
+	}
+	return 0
+}
+
+func (x *Alarm) GetAcknowledgedBy() string {
+	if x != nil {
+		return x.AcknowledgedBy
+	}
+	return ""
+}
+
+type ListAlarmsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IncludeHistory bool                   `protobuf:"varint,1,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListAlarmsRequest) Reset() {
+	*x = ListAlarmsRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlarmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlarmsRequest) ProtoMessage() {}
+
+func (x *ListAlarmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlarmsRequest.ProtoReflect.Descriptor instead.
+func (*ListAlarmsRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListAlarmsRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+type ListAlarmsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alarms        []*Alarm               `protobuf:"bytes,1,rep,name=alarms,proto3" json:"alarms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlarmsResponse) Reset() {
+	*x = ListAlarmsResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlarmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlarmsResponse) ProtoMessage() {}
+
+func (x *ListAlarmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlarmsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlarmsResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListAlarmsResponse) GetAlarms() []*Alarm {
+	if x != nil {
+		return x.Alarms
+	}
+	return nil
+}
+
+type AcknowledgeAlarmRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AlarmId        string                 `protobuf:"bytes,1,opt,name=alarm_id,json=alarmId,proto3" json:"alarm_id,omitempty"`
+	AcknowledgedBy string                 `protobuf:"bytes,2,opt,name=acknowledged_by,json=acknowledgedBy,proto3" json:"acknowledged_by,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AcknowledgeAlarmRequest) Reset() {
+	*x = AcknowledgeAlarmRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeAlarmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeAlarmRequest) ProtoMessage() {}
+
+func (x *AcknowledgeAlarmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeAlarmRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeAlarmRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *AcknowledgeAlarmRequest) GetAlarmId() string {
+	if x != nil {
+		return x.AlarmId
+	}
+	return ""
+}
+
+func (x *AcknowledgeAlarmRequest) GetAcknowledgedBy() string {
+	if x != nil {
+		return x.AcknowledgedBy
+	}
+	return ""
+}
+
+type AcknowledgeAlarmResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alarm         *Alarm                 `protobuf:"bytes,1,opt,name=alarm,proto3" json:"alarm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeAlarmResponse) Reset() {
+	*x = AcknowledgeAlarmResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeAlarmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeAlarmResponse) ProtoMessage() {}
+
+func (x *AcknowledgeAlarmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeAlarmResponse.ProtoReflect.Descriptor instead.
+func (*AcknowledgeAlarmResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *AcknowledgeAlarmResponse) GetAlarm() *Alarm {
+	if x != nil {
+		return x.Alarm
+	}
+	return nil
+}
+
+type DeviceState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	PortName      string                 `protobuf:"bytes,2,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	PreviousState string                 `protobuf:"bytes,4,opt,name=previous_state,json=previousState,proto3" json:"previous_state,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Since         int64                  `protobuf:"varint,6,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeviceState) Reset() {
+	*x = DeviceState{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceState) ProtoMessage() {}
+
+func (x *DeviceState) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceState.ProtoReflect.Descriptor instead.
+func (*DeviceState) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *DeviceState) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *DeviceState) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *DeviceState) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *DeviceState) GetPreviousState() string {
+	if x != nil {
+		return x.PreviousState
+	}
+	return ""
+}
+
+func (x *DeviceState) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeviceState) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+type ListDeviceStatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []string               `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeviceStatesRequest) Reset() {
+	*x = ListDeviceStatesRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeviceStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeviceStatesRequest) ProtoMessage() {}
+
+func (x *ListDeviceStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeviceStatesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeviceStatesRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListDeviceStatesRequest) GetDevices() []string {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type ListDeviceStatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	States        []*DeviceState         `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeviceStatesResponse) Reset() {
+	*x = ListDeviceStatesResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeviceStatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeviceStatesResponse) ProtoMessage() {}
+
+func (x *ListDeviceStatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeviceStatesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeviceStatesResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListDeviceStatesResponse) GetStates() []*DeviceState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type StreamDeviceStatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []string               `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamDeviceStatesRequest) Reset() {
+	*x = StreamDeviceStatesRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDeviceStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDeviceStatesRequest) ProtoMessage() {}
+
+func (x *StreamDeviceStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDeviceStatesRequest.ProtoReflect.Descriptor instead.
+func (*StreamDeviceStatesRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *StreamDeviceStatesRequest) GetDevices() []string {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type StreamDeviceStatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         *DeviceState           `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamDeviceStatesResponse) Reset() {
+	*x = StreamDeviceStatesResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDeviceStatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDeviceStatesResponse) ProtoMessage() {}
+
+func (x *StreamDeviceStatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDeviceStatesResponse.ProtoReflect.Descriptor instead.
+func (*StreamDeviceStatesResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *StreamDeviceStatesResponse) GetState() *DeviceState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type PendingWrite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId    string                 `protobuf:"bytes,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	PortName      string                 `protobuf:"bytes,2,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,6,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestedAt   int64                  `protobuf:"varint,7,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingWrite) Reset() {
+	*x = PendingWrite{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingWrite) ProtoMessage() {}
+
+func (x *PendingWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingWrite.ProtoReflect.Descriptor instead.
+func (*PendingWrite) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *PendingWrite) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *PendingWrite) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *PendingWrite) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PendingWrite) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PendingWrite) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PendingWrite) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *PendingWrite) GetRequestedAt() int64 {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return 0
+}
+
+func (x *PendingWrite) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ListPendingWritesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingWritesRequest) Reset() {
+	*x = ListPendingWritesRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingWritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingWritesRequest) ProtoMessage() {}
+
+func (x *ListPendingWritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingWritesRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingWritesRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{103}
+}
+
+type ListPendingWritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Writes        []*PendingWrite        `protobuf:"bytes,1,rep,name=writes,proto3" json:"writes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingWritesResponse) Reset() {
+	*x = ListPendingWritesResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingWritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingWritesResponse) ProtoMessage() {}
+
+func (x *ListPendingWritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingWritesResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingWritesResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListPendingWritesResponse) GetWrites() []*PendingWrite {
+	if x != nil {
+		return x.Writes
+	}
+	return nil
+}
+
+type ApproveWriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId    string                 `protobuf:"bytes,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveWriteRequest) Reset() {
+	*x = ApproveWriteRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveWriteRequest) ProtoMessage() {}
+
+func (x *ApproveWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveWriteRequest.ProtoReflect.Descriptor instead.
+func (*ApproveWriteRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ApproveWriteRequest) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+type ApproveWriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	BytesWritten  uint32                 `protobuf:"varint,2,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	SentAt        int64                  `protobuf:"varint,4,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveWriteResponse) Reset() {
+	*x = ApproveWriteResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveWriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveWriteResponse) ProtoMessage() {}
+
+func (x *ApproveWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveWriteResponse.ProtoReflect.Descriptor instead.
+func (*ApproveWriteResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ApproveWriteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApproveWriteResponse) GetBytesWritten() uint32 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+func (x *ApproveWriteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApproveWriteResponse) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+type RejectWriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId    string                 `protobuf:"bytes,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectWriteRequest) Reset() {
+	*x = RejectWriteRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectWriteRequest) ProtoMessage() {}
+
+func (x *RejectWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectWriteRequest.ProtoReflect.Descriptor instead.
+func (*RejectWriteRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *RejectWriteRequest) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+type RejectWriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Write         *PendingWrite          `protobuf:"bytes,1,opt,name=write,proto3" json:"write,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectWriteResponse) Reset() {
+	*x = RejectWriteResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectWriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectWriteResponse) ProtoMessage() {}
+
+func (x *RejectWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectWriteResponse.ProtoReflect.Descriptor instead.
+func (*RejectWriteResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *RejectWriteResponse) GetWrite() *PendingWrite {
+	if x != nil {
+		return x.Write
+	}
+	return nil
+}
+
+type TestSuite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Port          string                 `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	RunOnOpen     bool                   `protobuf:"varint,3,opt,name=run_on_open,json=runOnOpen,proto3" json:"run_on_open,omitempty"`
+	Steps         []string               `protobuf:"bytes,4,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestSuite) Reset() {
+	*x = TestSuite{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestSuite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestSuite) ProtoMessage() {}
+
+func (x *TestSuite) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSuite.ProtoReflect.Descriptor instead.
+func (*TestSuite) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *TestSuite) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TestSuite) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *TestSuite) GetRunOnOpen() bool {
+	if x != nil {
+		return x.RunOnOpen
+	}
+	return false
+}
+
+func (x *TestSuite) GetSteps() []string {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type ListTestSuitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTestSuitesRequest) Reset() {
+	*x = ListTestSuitesRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTestSuitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTestSuitesRequest) ProtoMessage() {}
+
+func (x *ListTestSuitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTestSuitesRequest.ProtoReflect.Descriptor instead.
+func (*ListTestSuitesRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{110}
+}
+
+type ListTestSuitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suites        []*TestSuite           `protobuf:"bytes,1,rep,name=suites,proto3" json:"suites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTestSuitesResponse) Reset() {
+	*x = ListTestSuitesResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTestSuitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTestSuitesResponse) ProtoMessage() {}
+
+func (x *ListTestSuitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTestSuitesResponse.ProtoReflect.Descriptor instead.
+func (*ListTestSuitesResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListTestSuitesResponse) GetSuites() []*TestSuite {
+	if x != nil {
+		return x.Suites
+	}
+	return nil
+}
+
+type RunTestSuiteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suite         string                 `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
+	PortName      string                 `protobuf:"bytes,2,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunTestSuiteRequest) Reset() {
+	*x = RunTestSuiteRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunTestSuiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunTestSuiteRequest) ProtoMessage() {}
+
+func (x *RunTestSuiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunTestSuiteRequest.ProtoReflect.Descriptor instead.
+func (*RunTestSuiteRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *RunTestSuiteRequest) GetSuite() string {
+	if x != nil {
+		return x.Suite
+	}
+	return ""
+}
+
+func (x *RunTestSuiteRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *RunTestSuiteRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type TestStepResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Passed        bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Skipped       bool                   `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Response      []byte                 `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+	Value         *float64               `protobuf:"fixed64,5,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Diff          string                 `protobuf:"bytes,7,opt,name=diff,proto3" json:"diff,omitempty"`
+	DurationMs    uint32                 `protobuf:"varint,8,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestStepResult) Reset() {
+	*x = TestStepResult{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestStepResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestStepResult) ProtoMessage() {}
+
+func (x *TestStepResult) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestStepResult.ProtoReflect.Descriptor instead.
+func (*TestStepResult) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *TestStepResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TestStepResult) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *TestStepResult) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+func (x *TestStepResult) GetResponse() []byte {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *TestStepResult) GetValue() float64 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+func (x *TestStepResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TestStepResult) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *TestStepResult) GetDurationMs() uint32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type TestReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suite         string                 `protobuf:"bytes,1,opt,name=suite,proto3" json:"suite,omitempty"`
+	PortName      string                 `protobuf:"bytes,2,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Trigger       string                 `protobuf:"bytes,3,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	DurationMs    uint32                 `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Passed        bool                   `protobuf:"varint,6,opt,name=passed,proto3" json:"passed,omitempty"`
+	Steps         []*TestStepResult      `protobuf:"bytes,7,rep,name=steps,proto3" json:"steps,omitempty"`
+	ReportFiles   []string               `protobuf:"bytes,8,rep,name=report_files,json=reportFiles,proto3" json:"report_files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestReport) Reset() {
+	*x = TestReport{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestReport) ProtoMessage() {}
+
+func (x *TestReport) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestReport.ProtoReflect.Descriptor instead.
+func (*TestReport) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *TestReport) GetSuite() string {
+	if x != nil {
+		return x.Suite
+	}
+	return ""
+}
+
+func (x *TestReport) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *TestReport) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *TestReport) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *TestReport) GetDurationMs() uint32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *TestReport) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *TestReport) GetSteps() []*TestStepResult {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *TestReport) GetReportFiles() []string {
+	if x != nil {
+		return x.ReportFiles
+	}
+	return nil
+}
+
+type RunTestSuiteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *TestReport            `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunTestSuiteResponse) Reset() {
+	*x = RunTestSuiteResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunTestSuiteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunTestSuiteResponse) ProtoMessage() {}
+
+func (x *RunTestSuiteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunTestSuiteResponse.ProtoReflect.Descriptor instead.
+func (*RunTestSuiteResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *RunTestSuiteResponse) GetReport() *TestReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type Reservation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	PortName      string                 `protobuf:"bytes,2,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Holder        string                 `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	StartTime     int64                  `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       int64                  `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Active        bool                   `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reservation) Reset() {
+	*x = Reservation{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reservation) ProtoMessage() {}
+
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *Reservation) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *Reservation) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *Reservation) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *Reservation) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *Reservation) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *Reservation) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Reservation) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Reservation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Reservation) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type CreateReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Holder        string                 `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	StartTime     int64                  `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       int64                  `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReservationRequest) Reset() {
+	*x = CreateReservationRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReservationRequest) ProtoMessage() {}
+
+func (x *CreateReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReservationRequest.ProtoReflect.Descriptor instead.
+func (*CreateReservationRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CreateReservationRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+func (x *CreateReservationRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *CreateReservationRequest) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *CreateReservationRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CreateReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReservationResponse) Reset() {
+	*x = CreateReservationResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReservationResponse) ProtoMessage() {}
+
+func (x *CreateReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReservationResponse.ProtoReflect.Descriptor instead.
+func (*CreateReservationResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *CreateReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type ListReservationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsRequest) Reset() {
+	*x = ListReservationsRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsRequest) ProtoMessage() {}
+
+func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ListReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListReservationsRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+type ListReservationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservations  []*Reservation         `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsResponse) Reset() {
+	*x = ListReservationsResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsResponse) ProtoMessage() {}
+
+func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ListReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListReservationsResponse) GetReservations() []*Reservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+type CancelReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReservationRequest) Reset() {
+	*x = CancelReservationRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationRequest) ProtoMessage() {}
+
+func (x *CancelReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationRequest.ProtoReflect.Descriptor instead.
+func (*CancelReservationRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *CancelReservationRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+type CancelReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReservationResponse) Reset() {
+	*x = CancelReservationResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReservationResponse) ProtoMessage() {}
+
+func (x *CancelReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReservationResponse.ProtoReflect.Descriptor instead.
+func (*CancelReservationResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CancelReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+type UsageRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	PortName      string                 `protobuf:"bytes,2,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Sessions      uint64                 `protobuf:"varint,3,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	OpenSeconds   float64                `protobuf:"fixed64,4,opt,name=open_seconds,json=openSeconds,proto3" json:"open_seconds,omitempty"`
+	BytesSent     uint64                 `protobuf:"varint,5,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	BytesReceived uint64                 `protobuf:"varint,6,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+	FirstUsed     int64                  `protobuf:"varint,7,opt,name=first_used,json=firstUsed,proto3" json:"first_used,omitempty"`
+	LastUsed      int64                  `protobuf:"varint,8,opt,name=last_used,json=lastUsed,proto3" json:"last_used,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UsageRecord) Reset() {
+	*x = UsageRecord{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UsageRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageRecord) ProtoMessage() {}
+
+func (x *UsageRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageRecord.ProtoReflect.Descriptor instead.
+func (*UsageRecord) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *UsageRecord) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *UsageRecord) GetSessions() uint64 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetOpenSeconds() float64 {
+	if x != nil {
+		return x.OpenSeconds
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetBytesSent() uint64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetBytesReceived() uint64 {
+	if x != nil {
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetFirstUsed() int64 {
+	if x != nil {
+		return x.FirstUsed
+	}
+	return 0
+}
+
+func (x *UsageRecord) GetLastUsed() int64 {
+	if x != nil {
+		return x.LastUsed
+	}
+	return 0
+}
+
+type GetUsageReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientId      string                 `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	PortName      string                 `protobuf:"bytes,2,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageReportRequest) Reset() {
+	*x = GetUsageReportRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageReportRequest) ProtoMessage() {}
+
+func (x *GetUsageReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageReportRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageReportRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetUsageReportRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *GetUsageReportRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+type GetUsageReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*UsageRecord         `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Since         int64                  `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	GeneratedAt   int64                  `protobuf:"varint,3,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageReportResponse) Reset() {
+	*x = GetUsageReportResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageReportResponse) ProtoMessage() {}
+
+func (x *GetUsageReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageReportResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageReportResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetUsageReportResponse) GetRecords() []*UsageRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *GetUsageReportResponse) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *GetUsageReportResponse) GetGeneratedAt() int64 {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return 0
+}
+
+type SetPowerStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	State         PowerState             `protobuf:"varint,3,opt,name=state,proto3,enum=seriallink.v1.PowerState" json:"state,omitempty"`
+	WakePatterns  []string               `protobuf:"bytes,4,rep,name=wake_patterns,json=wakePatterns,proto3" json:"wake_patterns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPowerStateRequest) Reset() {
+	*x = SetPowerStateRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPowerStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPowerStateRequest) ProtoMessage() {}
+
+func (x *SetPowerStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPowerStateRequest.ProtoReflect.Descriptor instead.
+func (*SetPowerStateRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *SetPowerStateRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *SetPowerStateRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SetPowerStateRequest) GetState() PowerState {
+	if x != nil {
+		return x.State
+	}
+	return PowerState_POWER_STATE_UNSPECIFIED
+}
+
+func (x *SetPowerStateRequest) GetWakePatterns() []string {
+	if x != nil {
+		return x.WakePatterns
+	}
+	return nil
+}
+
+type SetPowerStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         PowerState             `protobuf:"varint,1,opt,name=state,proto3,enum=seriallink.v1.PowerState" json:"state,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPowerStateResponse) Reset() {
+	*x = SetPowerStateResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPowerStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPowerStateResponse) ProtoMessage() {}
+
+func (x *SetPowerStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPowerStateResponse.ProtoReflect.Descriptor instead.
+func (*SetPowerStateResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *SetPowerStateResponse) GetState() PowerState {
+	if x != nil {
+		return x.State
+	}
+	return PowerState_POWER_STATE_UNSPECIFIED
+}
+
+func (x *SetPowerStateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type StreamPortStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	IntervalMs    uint32                 `protobuf:"varint,2,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	OnChange      bool                   `protobuf:"varint,3,opt,name=on_change,json=onChange,proto3" json:"on_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamPortStatusRequest) Reset() {
+	*x = StreamPortStatusRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPortStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPortStatusRequest) ProtoMessage() {}
+
+func (x *StreamPortStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPortStatusRequest.ProtoReflect.Descriptor instead.
+func (*StreamPortStatusRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *StreamPortStatusRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *StreamPortStatusRequest) GetIntervalMs() uint32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *StreamPortStatusRequest) GetOnChange() bool {
+	if x != nil {
+		return x.OnChange
+	}
+	return false
+}
+
+type StreamPortStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *PortStatus            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamPortStatusResponse) Reset() {
+	*x = StreamPortStatusResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamPortStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPortStatusResponse) ProtoMessage() {}
+
+func (x *StreamPortStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPortStatusResponse.ProtoReflect.Descriptor instead.
+func (*StreamPortStatusResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *StreamPortStatusResponse) GetStatus() *PortStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *StreamPortStatusResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type GetAgentStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentStatsRequest) Reset() {
+	*x = GetAgentStatsRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentStatsRequest) ProtoMessage() {}
+
+func (x *GetAgentStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentStatsRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{130}
+}
+
+type GetAgentStatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OpenPorts      uint32                 `protobuf:"varint,1,opt,name=open_ports,json=openPorts,proto3" json:"open_ports,omitempty"`
+	OpenSessions   uint32                 `protobuf:"varint,2,opt,name=open_sessions,json=openSessions,proto3" json:"open_sessions,omitempty"`
+	SessionsOpened uint64                 `protobuf:"varint,3,opt,name=sessions_opened,json=sessionsOpened,proto3" json:"sessions_opened,omitempty"`
+	BytesSent      uint64                 `protobuf:"varint,4,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	BytesReceived  uint64                 `protobuf:"varint,5,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+	Errors         uint64                 `protobuf:"varint,6,opt,name=errors,proto3" json:"errors,omitempty"`
+	Streams        uint32                 `protobuf:"varint,7,opt,name=streams,proto3" json:"streams,omitempty"`
+	BufferedBytes  int64                  `protobuf:"varint,8,opt,name=buffered_bytes,json=bufferedBytes,proto3" json:"buffered_bytes,omitempty"`
+	HeapAllocBytes uint64                 `protobuf:"varint,9,opt,name=heap_alloc_bytes,json=heapAllocBytes,proto3" json:"heap_alloc_bytes,omitempty"`
+	SysBytes       uint64                 `protobuf:"varint,10,opt,name=sys_bytes,json=sysBytes,proto3" json:"sys_bytes,omitempty"`
+	Goroutines     uint32                 `protobuf:"varint,11,opt,name=goroutines,proto3" json:"goroutines,omitempty"`
+	CpuPercent     float64                `protobuf:"fixed64,12,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	CpuSeconds     float64                `protobuf:"fixed64,13,opt,name=cpu_seconds,json=cpuSeconds,proto3" json:"cpu_seconds,omitempty"`
+	UptimeSeconds  int64                  `protobuf:"varint,14,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAgentStatsResponse) Reset() {
+	*x = GetAgentStatsResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentStatsResponse) ProtoMessage() {}
+
+func (x *GetAgentStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentStatsResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetAgentStatsResponse) GetOpenPorts() uint32 {
+	if x != nil {
+		return x.OpenPorts
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetOpenSessions() uint32 {
+	if x != nil {
+		return x.OpenSessions
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetSessionsOpened() uint64 {
+	if x != nil {
+		return x.SessionsOpened
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetBytesSent() uint64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetBytesReceived() uint64 {
+	if x != nil {
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetErrors() uint64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetStreams() uint32 {
+	if x != nil {
+		return x.Streams
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetBufferedBytes() int64 {
+	if x != nil {
+		return x.BufferedBytes
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetHeapAllocBytes() uint64 {
+	if x != nil {
+		return x.HeapAllocBytes
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetSysBytes() uint64 {
+	if x != nil {
+		return x.SysBytes
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetGoroutines() uint32 {
+	if x != nil {
+		return x.Goroutines
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetCpuSeconds() float64 {
+	if x != nil {
+		return x.CpuSeconds
+	}
+	return 0
+}
+
+func (x *GetAgentStatsResponse) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+type SetDebugEndpointsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDebugEndpointsRequest) Reset() {
+	*x = SetDebugEndpointsRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDebugEndpointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDebugEndpointsRequest) ProtoMessage() {}
+
+func (x *SetDebugEndpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDebugEndpointsRequest.ProtoReflect.Descriptor instead.
+func (*SetDebugEndpointsRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *SetDebugEndpointsRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetDebugEndpointsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDebugEndpointsResponse) Reset() {
+	*x = SetDebugEndpointsResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDebugEndpointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDebugEndpointsResponse) ProtoMessage() {}
+
+func (x *SetDebugEndpointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDebugEndpointsResponse.ProtoReflect.Descriptor instead.
+func (*SetDebugEndpointsResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *SetDebugEndpointsResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetDebugEndpointsResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SetDebugEndpointsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BridgeEndpoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	BaudRate      uint32                 `protobuf:"varint,3,opt,name=baud_rate,json=baudRate,proto3" json:"baud_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BridgeEndpoint) Reset() {
+	*x = BridgeEndpoint{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BridgeEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeEndpoint) ProtoMessage() {}
+
+func (x *BridgeEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeEndpoint.ProtoReflect.Descriptor instead.
+func (*BridgeEndpoint) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *BridgeEndpoint) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *BridgeEndpoint) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *BridgeEndpoint) GetBaudRate() uint32 {
+	if x != nil {
+		return x.BaudRate
+	}
+	return 0
+}
+
+type BridgePortsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	A             *BridgeEndpoint        `protobuf:"bytes,2,opt,name=a,proto3" json:"a,omitempty"`
+	B             *BridgeEndpoint        `protobuf:"bytes,3,opt,name=b,proto3" json:"b,omitempty"`
+	OneWay        bool                   `protobuf:"varint,4,opt,name=one_way,json=oneWay,proto3" json:"one_way,omitempty"`
+	LogData       bool                   `protobuf:"varint,5,opt,name=log_data,json=logData,proto3" json:"log_data,omitempty"`
+	Rules         []*BridgeRule          `protobuf:"bytes,6,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BridgePortsRequest) Reset() {
+	*x = BridgePortsRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BridgePortsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgePortsRequest) ProtoMessage() {}
+
+func (x *BridgePortsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgePortsRequest.ProtoReflect.Descriptor instead.
+func (*BridgePortsRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *BridgePortsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BridgePortsRequest) GetA() *BridgeEndpoint {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *BridgePortsRequest) GetB() *BridgeEndpoint {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+func (x *BridgePortsRequest) GetOneWay() bool {
+	if x != nil {
+		return x.OneWay
+	}
+	return false
+}
+
+func (x *BridgePortsRequest) GetLogData() bool {
+	if x != nil {
+		return x.LogData
+	}
+	return false
+}
+
+func (x *BridgePortsRequest) GetRules() []*BridgeRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type Bridge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PortA         string                 `protobuf:"bytes,2,opt,name=port_a,json=portA,proto3" json:"port_a,omitempty"`
+	PortB         string                 `protobuf:"bytes,3,opt,name=port_b,json=portB,proto3" json:"port_b,omitempty"`
+	OneWay        bool                   `protobuf:"varint,4,opt,name=one_way,json=oneWay,proto3" json:"one_way,omitempty"`
+	LogData       bool                   `protobuf:"varint,5,opt,name=log_data,json=logData,proto3" json:"log_data,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	BytesAToB     uint64                 `protobuf:"varint,7,opt,name=bytes_a_to_b,json=bytesAToB,proto3" json:"bytes_a_to_b,omitempty"`
+	BytesBToA     uint64                 `protobuf:"varint,8,opt,name=bytes_b_to_a,json=bytesBToA,proto3" json:"bytes_b_to_a,omitempty"`
+	Rules         []*BridgeRule          `protobuf:"bytes,9,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bridge) Reset() {
+	*x = Bridge{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bridge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bridge) ProtoMessage() {}
+
+func (x *Bridge) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bridge.ProtoReflect.Descriptor instead.
+func (*Bridge) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *Bridge) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Bridge) GetPortA() string {
+	if x != nil {
+		return x.PortA
+	}
+	return ""
+}
+
+func (x *Bridge) GetPortB() string {
+	if x != nil {
+		return x.PortB
+	}
+	return ""
+}
+
+func (x *Bridge) GetOneWay() bool {
+	if x != nil {
+		return x.OneWay
+	}
+	return false
+}
+
+func (x *Bridge) GetLogData() bool {
+	if x != nil {
+		return x.LogData
+	}
+	return false
+}
+
+func (x *Bridge) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *Bridge) GetBytesAToB() uint64 {
+	if x != nil {
+		return x.BytesAToB
+	}
+	return 0
+}
+
+func (x *Bridge) GetBytesBToA() uint64 {
+	if x != nil {
+		return x.BytesBToA
+	}
+	return 0
+}
+
+func (x *Bridge) GetRules() []*BridgeRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type BridgePortsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bridge        *Bridge                `protobuf:"bytes,1,opt,name=bridge,proto3" json:"bridge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BridgePortsResponse) Reset() {
+	*x = BridgePortsResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BridgePortsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgePortsResponse) ProtoMessage() {}
+
+func (x *BridgePortsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgePortsResponse.ProtoReflect.Descriptor instead.
+func (*BridgePortsResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *BridgePortsResponse) GetBridge() *Bridge {
+	if x != nil {
+		return x.Bridge
+	}
+	return nil
+}
+
+type ListBridgesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBridgesRequest) Reset() {
+	*x = ListBridgesRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBridgesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBridgesRequest) ProtoMessage() {}
+
+func (x *ListBridgesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBridgesRequest.ProtoReflect.Descriptor instead.
+func (*ListBridgesRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{138}
+}
+
+type ListBridgesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bridges       []*Bridge              `protobuf:"bytes,1,rep,name=bridges,proto3" json:"bridges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBridgesResponse) Reset() {
+	*x = ListBridgesResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBridgesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBridgesResponse) ProtoMessage() {}
+
+func (x *ListBridgesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBridgesResponse.ProtoReflect.Descriptor instead.
+func (*ListBridgesResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ListBridgesResponse) GetBridges() []*Bridge {
+	if x != nil {
+		return x.Bridges
+	}
+	return nil
+}
+
+type StopBridgeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopBridgeRequest) Reset() {
+	*x = StopBridgeRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopBridgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopBridgeRequest) ProtoMessage() {}
+
+func (x *StopBridgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopBridgeRequest.ProtoReflect.Descriptor instead.
+func (*StopBridgeRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *StopBridgeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StopBridgeRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type StopBridgeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bridge        *Bridge                `protobuf:"bytes,1,opt,name=bridge,proto3" json:"bridge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopBridgeResponse) Reset() {
+	*x = StopBridgeResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopBridgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopBridgeResponse) ProtoMessage() {}
+
+func (x *StopBridgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopBridgeResponse.ProtoReflect.Descriptor instead.
+func (*StopBridgeResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *StopBridgeResponse) GetBridge() *Bridge {
+	if x != nil {
+		return x.Bridge
+	}
+	return nil
+}
+
+type BridgeRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Direction     BridgeDirection        `protobuf:"varint,1,opt,name=direction,proto3,enum=seriallink.v1.BridgeDirection" json:"direction,omitempty"`
+	Pattern       string                 `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Action        BridgeRuleAction       `protobuf:"varint,3,opt,name=action,proto3,enum=seriallink.v1.BridgeRuleAction" json:"action,omitempty"`
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	DelayMs       uint32                 `protobuf:"varint,5,opt,name=delay_ms,json=delayMs,proto3" json:"delay_ms,omitempty"`
+	Hits          uint64                 `protobuf:"varint,6,opt,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BridgeRule) Reset() {
+	*x = BridgeRule{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BridgeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BridgeRule) ProtoMessage() {}
+
+func (x *BridgeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BridgeRule.ProtoReflect.Descriptor instead.
+func (*BridgeRule) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *BridgeRule) GetDirection() BridgeDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return BridgeDirection_BRIDGE_DIRECTION_BOTH
+}
+
+func (x *BridgeRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *BridgeRule) GetAction() BridgeRuleAction {
+	if x != nil {
+		return x.Action
+	}
+	return BridgeRuleAction_BRIDGE_RULE_ACTION_UNSPECIFIED
+}
+
+func (x *BridgeRule) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BridgeRule) GetDelayMs() uint32 {
+	if x != nil {
+		return x.DelayMs
+	}
+	return 0
+}
+
+func (x *BridgeRule) GetHits() uint64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+type SetBridgeRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Rules         []*BridgeRule          `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBridgeRulesRequest) Reset() {
+	*x = SetBridgeRulesRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBridgeRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBridgeRulesRequest) ProtoMessage() {}
+
+func (x *SetBridgeRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBridgeRulesRequest.ProtoReflect.Descriptor instead.
+func (*SetBridgeRulesRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *SetBridgeRulesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetBridgeRulesRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SetBridgeRulesRequest) GetRules() []*BridgeRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type SetBridgeRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bridge        *Bridge                `protobuf:"bytes,1,opt,name=bridge,proto3" json:"bridge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBridgeRulesResponse) Reset() {
+	*x = SetBridgeRulesResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBridgeRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBridgeRulesResponse) ProtoMessage() {}
+
+func (x *SetBridgeRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBridgeRulesResponse.ProtoReflect.Descriptor instead.
+func (*SetBridgeRulesResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *SetBridgeRulesResponse) GetBridge() *Bridge {
+	if x != nil {
+		return x.Bridge
+	}
+	return nil
+}
+
+type StreamAnnotatedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Decoder       FrameDecoder           `protobuf:"varint,3,opt,name=decoder,proto3,enum=seriallink.v1.FrameDecoder" json:"decoder,omitempty"`
+	Pattern       string                 `protobuf:"bytes,4,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Terminator    []byte                 `protobuf:"bytes,5,opt,name=terminator,proto3" json:"terminator,omitempty"`
+	GapMs         uint32                 `protobuf:"varint,6,opt,name=gap_ms,json=gapMs,proto3" json:"gap_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamAnnotatedRequest) Reset() {
+	*x = StreamAnnotatedRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamAnnotatedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamAnnotatedRequest) ProtoMessage() {}
+
+func (x *StreamAnnotatedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamAnnotatedRequest.ProtoReflect.Descriptor instead.
+func (*StreamAnnotatedRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *StreamAnnotatedRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *StreamAnnotatedRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *StreamAnnotatedRequest) GetDecoder() FrameDecoder {
+	if x != nil {
+		return x.Decoder
+	}
+	return FrameDecoder_FRAME_DECODER_UNSPECIFIED
+}
+
+func (x *StreamAnnotatedRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *StreamAnnotatedRequest) GetTerminator() []byte {
+	if x != nil {
+		return x.Terminator
+	}
+	return nil
+}
+
+func (x *StreamAnnotatedRequest) GetGapMs() uint32 {
+	if x != nil {
+		return x.GapMs
+	}
+	return 0
+}
+
+type FrameField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FrameField) Reset() {
+	*x = FrameField{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FrameField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameField) ProtoMessage() {}
+
+func (x *FrameField) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameField.ProtoReflect.Descriptor instead.
+func (*FrameField) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *FrameField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FrameField) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AnnotatedFrame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Decoder       FrameDecoder           `protobuf:"varint,2,opt,name=decoder,proto3,enum=seriallink.v1.FrameDecoder" json:"decoder,omitempty"`
+	Raw           []byte                 `protobuf:"bytes,3,opt,name=raw,proto3" json:"raw,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Sequence      uint64                 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Summary       string                 `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	Fields        []*FrameField          `protobuf:"bytes,7,rep,name=fields,proto3" json:"fields,omitempty"`
+	Valid         bool                   `protobuf:"varint,8,opt,name=valid,proto3" json:"valid,omitempty"`
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnotatedFrame) Reset() {
+	*x = AnnotatedFrame{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnotatedFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnotatedFrame) ProtoMessage() {}
+
+func (x *AnnotatedFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnotatedFrame.ProtoReflect.Descriptor instead.
+func (*AnnotatedFrame) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *AnnotatedFrame) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *AnnotatedFrame) GetDecoder() FrameDecoder {
+	if x != nil {
+		return x.Decoder
+	}
+	return FrameDecoder_FRAME_DECODER_UNSPECIFIED
+}
+
+func (x *AnnotatedFrame) GetRaw() []byte {
+	if x != nil {
+		return x.Raw
+	}
+	return nil
+}
+
+func (x *AnnotatedFrame) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *AnnotatedFrame) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *AnnotatedFrame) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *AnnotatedFrame) GetFields() []*FrameField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *AnnotatedFrame) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *AnnotatedFrame) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type StreamAnnotatedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Frame         *AnnotatedFrame        `protobuf:"bytes,1,opt,name=frame,proto3" json:"frame,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamAnnotatedResponse) Reset() {
+	*x = StreamAnnotatedResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamAnnotatedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamAnnotatedResponse) ProtoMessage() {}
+
+func (x *StreamAnnotatedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamAnnotatedResponse.ProtoReflect.Descriptor instead.
+func (*StreamAnnotatedResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *StreamAnnotatedResponse) GetFrame() *AnnotatedFrame {
+	if x != nil {
+		return x.Frame
+	}
+	return nil
+}
+
+type BandwidthShaping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RxBytesPerSec uint32                 `protobuf:"varint,1,opt,name=rx_bytes_per_sec,json=rxBytesPerSec,proto3" json:"rx_bytes_per_sec,omitempty"`
+	TxBytesPerSec uint32                 `protobuf:"varint,2,opt,name=tx_bytes_per_sec,json=txBytesPerSec,proto3" json:"tx_bytes_per_sec,omitempty"`
+	RxBurst       uint32                 `protobuf:"varint,3,opt,name=rx_burst,json=rxBurst,proto3" json:"rx_burst,omitempty"`
+	TxBurst       uint32                 `protobuf:"varint,4,opt,name=tx_burst,json=txBurst,proto3" json:"tx_burst,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BandwidthShaping) Reset() {
+	*x = BandwidthShaping{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BandwidthShaping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BandwidthShaping) ProtoMessage() {}
+
+func (x *BandwidthShaping) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BandwidthShaping.ProtoReflect.Descriptor instead.
+func (*BandwidthShaping) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *BandwidthShaping) GetRxBytesPerSec() uint32 {
+	if x != nil {
+		return x.RxBytesPerSec
+	}
+	return 0
+}
+
+func (x *BandwidthShaping) GetTxBytesPerSec() uint32 {
+	if x != nil {
+		return x.TxBytesPerSec
+	}
+	return 0
+}
+
+func (x *BandwidthShaping) GetRxBurst() uint32 {
+	if x != nil {
+		return x.RxBurst
+	}
+	return 0
+}
+
+func (x *BandwidthShaping) GetTxBurst() uint32 {
+	if x != nil {
+		return x.TxBurst
+	}
+	return 0
+}
+
+type SetShapingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Shaping       *BandwidthShaping      `protobuf:"bytes,3,opt,name=shaping,proto3" json:"shaping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetShapingRequest) Reset() {
+	*x = SetShapingRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetShapingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetShapingRequest) ProtoMessage() {}
+
+func (x *SetShapingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetShapingRequest.ProtoReflect.Descriptor instead.
+func (*SetShapingRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *SetShapingRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *SetShapingRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SetShapingRequest) GetShaping() *BandwidthShaping {
+	if x != nil {
+		return x.Shaping
+	}
+	return nil
+}
+
+type SetShapingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Shaping       *BandwidthShaping      `protobuf:"bytes,3,opt,name=shaping,proto3" json:"shaping,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetShapingResponse) Reset() {
+	*x = SetShapingResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetShapingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetShapingResponse) ProtoMessage() {}
+
+func (x *SetShapingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetShapingResponse.ProtoReflect.Descriptor instead.
+func (*SetShapingResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *SetShapingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetShapingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetShapingResponse) GetShaping() *BandwidthShaping {
+	if x != nil {
+		return x.Shaping
+	}
+	return nil
+}
+
+type ListStreamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStreamsRequest) Reset() {
+	*x = ListStreamsRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStreamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStreamsRequest) ProtoMessage() {}
+
+func (x *ListStreamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStreamsRequest.ProtoReflect.Descriptor instead.
+func (*ListStreamsRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *ListStreamsRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+type StreamInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PortName        string                 `protobuf:"bytes,2,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId       string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ClientId        string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Consumer        string                 `protobuf:"bytes,5,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Priority        SessionPriority        `protobuf:"varint,6,opt,name=priority,proto3,enum=seriallink.v1.SessionPriority" json:"priority,omitempty"`
+	StartedAt       int64                  `protobuf:"varint,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	DeliveredChunks uint64                 `protobuf:"varint,8,opt,name=delivered_chunks,json=deliveredChunks,proto3" json:"delivered_chunks,omitempty"`
+	DeliveredBytes  uint64                 `protobuf:"varint,9,opt,name=delivered_bytes,json=deliveredBytes,proto3" json:"delivered_bytes,omitempty"`
+	DroppedChunks   uint64                 `protobuf:"varint,10,opt,name=dropped_chunks,json=droppedChunks,proto3" json:"dropped_chunks,omitempty"`
+	DroppedBytes    uint64                 `protobuf:"varint,11,opt,name=dropped_bytes,json=droppedBytes,proto3" json:"dropped_bytes,omitempty"`
+	QueuedChunks    uint32                 `protobuf:"varint,12,opt,name=queued_chunks,json=queuedChunks,proto3" json:"queued_chunks,omitempty"`
+	QueuedBytes     int64                  `protobuf:"varint,13,opt,name=queued_bytes,json=queuedBytes,proto3" json:"queued_bytes,omitempty"`
+	LagMs           int64                  `protobuf:"varint,14,opt,name=lag_ms,json=lagMs,proto3" json:"lag_ms,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StreamInfo) Reset() {
+	*x = StreamInfo{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamInfo) ProtoMessage() {}
+
+func (x *StreamInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamInfo.ProtoReflect.Descriptor instead.
+func (*StreamInfo) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *StreamInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StreamInfo) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *StreamInfo) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *StreamInfo) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *StreamInfo) GetConsumer() string {
+	if x != nil {
+		return x.Consumer
+	}
+	return ""
+}
+
+func (x *StreamInfo) GetPriority() SessionPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return SessionPriority_SESSION_PRIORITY_UNSPECIFIED
+}
+
+func (x *StreamInfo) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *StreamInfo) GetDeliveredChunks() uint64 {
+	if x != nil {
+		return x.DeliveredChunks
+	}
+	return 0
+}
+
+func (x *StreamInfo) GetDeliveredBytes() uint64 {
+	if x != nil {
+		return x.DeliveredBytes
+	}
+	return 0
+}
+
+func (x *StreamInfo) GetDroppedChunks() uint64 {
+	if x != nil {
+		return x.DroppedChunks
+	}
+	return 0
+}
+
+func (x *StreamInfo) GetDroppedBytes() uint64 {
+	if x != nil {
+		return x.DroppedBytes
+	}
+	return 0
+}
+
+func (x *StreamInfo) GetQueuedChunks() uint32 {
+	if x != nil {
+		return x.QueuedChunks
+	}
+	return 0
+}
+
+func (x *StreamInfo) GetQueuedBytes() int64 {
+	if x != nil {
+		return x.QueuedBytes
+	}
+	return 0
+}
+
+func (x *StreamInfo) GetLagMs() int64 {
+	if x != nil {
+		return x.LagMs
+	}
+	return 0
+}
+
+type ListStreamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Streams       []*StreamInfo          `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStreamsResponse) Reset() {
+	*x = ListStreamsResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStreamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStreamsResponse) ProtoMessage() {}
+
+func (x *ListStreamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStreamsResponse.ProtoReflect.Descriptor instead.
+func (*ListStreamsResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *ListStreamsResponse) GetStreams() []*StreamInfo {
+	if x != nil {
+		return x.Streams
+	}
+	return nil
+}
+
+type PasteRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PortName       string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId      string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Data           []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	LineEnding     string                 `protobuf:"bytes,4,opt,name=line_ending,json=lineEnding,proto3" json:"line_ending,omitempty"`
+	PromptPattern  string                 `protobuf:"bytes,5,opt,name=prompt_pattern,json=promptPattern,proto3" json:"prompt_pattern,omitempty"`
+	WaitEcho       bool                   `protobuf:"varint,6,opt,name=wait_echo,json=waitEcho,proto3" json:"wait_echo,omitempty"`
+	LineDelayMs    uint32                 `protobuf:"varint,7,opt,name=line_delay_ms,json=lineDelayMs,proto3" json:"line_delay_ms,omitempty"`
+	LineTimeoutMs  uint32                 `protobuf:"varint,8,opt,name=line_timeout_ms,json=lineTimeoutMs,proto3" json:"line_timeout_ms,omitempty"`
+	SkipBlankLines bool                   `protobuf:"varint,9,opt,name=skip_blank_lines,json=skipBlankLines,proto3" json:"skip_blank_lines,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PasteRequest) Reset() {
+	*x = PasteRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasteRequest) ProtoMessage() {}
+
+func (x *PasteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasteRequest.ProtoReflect.Descriptor instead.
+func (*PasteRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *PasteRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *PasteRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PasteRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PasteRequest) GetLineEnding() string {
+	if x != nil {
+		return x.LineEnding
+	}
+	return ""
+}
+
+func (x *PasteRequest) GetPromptPattern() string {
+	if x != nil {
+		return x.PromptPattern
+	}
+	return ""
+}
+
+func (x *PasteRequest) GetWaitEcho() bool {
+	if x != nil {
+		return x.WaitEcho
+	}
+	return false
+}
+
+func (x *PasteRequest) GetLineDelayMs() uint32 {
+	if x != nil {
+		return x.LineDelayMs
+	}
+	return 0
+}
+
+func (x *PasteRequest) GetLineTimeoutMs() uint32 {
+	if x != nil {
+		return x.LineTimeoutMs
+	}
+	return 0
+}
+
+func (x *PasteRequest) GetSkipBlankLines() bool {
+	if x != nil {
+		return x.SkipBlankLines
+	}
+	return false
+}
+
+type PasteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          uint32                 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	TotalLines    uint32                 `protobuf:"varint,2,opt,name=total_lines,json=totalLines,proto3" json:"total_lines,omitempty"`
+	Text          []byte                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Response      []byte                 `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+	ElapsedMs     int64                  `protobuf:"varint,5,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasteResponse) Reset() {
+	*x = PasteResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasteResponse) ProtoMessage() {}
+
+func (x *PasteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasteResponse.ProtoReflect.Descriptor instead.
+func (*PasteResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *PasteResponse) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *PasteResponse) GetTotalLines() uint32 {
+	if x != nil {
+		return x.TotalLines
+	}
+	return 0
+}
+
+func (x *PasteResponse) GetText() []byte {
+	if x != nil {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *PasteResponse) GetResponse() []byte {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *PasteResponse) GetElapsedMs() int64 {
+	if x != nil {
+		return x.ElapsedMs
+	}
+	return 0
+}
+
+type GcodeJob struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PortName          string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Dialect           GcodeDialect           `protobuf:"varint,3,opt,name=dialect,proto3,enum=seriallink.v1.GcodeDialect" json:"dialect,omitempty"`
+	State             GcodeJobState          `protobuf:"varint,4,opt,name=state,proto3,enum=seriallink.v1.GcodeJobState" json:"state,omitempty"`
+	Error             string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	TotalLines        uint32                 `protobuf:"varint,6,opt,name=total_lines,json=totalLines,proto3" json:"total_lines,omitempty"`
+	SentLines         uint32                 `protobuf:"varint,7,opt,name=sent_lines,json=sentLines,proto3" json:"sent_lines,omitempty"`
+	AcknowledgedLines uint32                 `protobuf:"varint,8,opt,name=acknowledged_lines,json=acknowledgedLines,proto3" json:"acknowledged_lines,omitempty"`
+	Progress          float64                `protobuf:"fixed64,9,opt,name=progress,proto3" json:"progress,omitempty"`
+	StartedAt         int64                  `protobuf:"varint,10,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt        int64                  `protobuf:"varint,11,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	ElapsedMs         int64                  `protobuf:"varint,12,opt,name=elapsed_ms,json=elapsedMs,proto3" json:"elapsed_ms,omitempty"`
+	RemainingMs       int64                  `protobuf:"varint,13,opt,name=remaining_ms,json=remainingMs,proto3" json:"remaining_ms,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GcodeJob) Reset() {
+	*x = GcodeJob{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GcodeJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GcodeJob) ProtoMessage() {}
+
+func (x *GcodeJob) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GcodeJob.ProtoReflect.Descriptor instead.
+func (*GcodeJob) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *GcodeJob) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *GcodeJob) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GcodeJob) GetDialect() GcodeDialect {
+	if x != nil {
+		return x.Dialect
+	}
+	return GcodeDialect_GCODE_DIALECT_UNSPECIFIED
+}
+
+func (x *GcodeJob) GetState() GcodeJobState {
+	if x != nil {
+		return x.State
+	}
+	return GcodeJobState_GCODE_JOB_STATE_UNSPECIFIED
+}
+
+func (x *GcodeJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GcodeJob) GetTotalLines() uint32 {
+	if x != nil {
+		return x.TotalLines
+	}
+	return 0
+}
+
+func (x *GcodeJob) GetSentLines() uint32 {
+	if x != nil {
+		return x.SentLines
+	}
+	return 0
+}
+
+func (x *GcodeJob) GetAcknowledgedLines() uint32 {
+	if x != nil {
+		return x.AcknowledgedLines
+	}
+	return 0
+}
+
+func (x *GcodeJob) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *GcodeJob) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *GcodeJob) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *GcodeJob) GetElapsedMs() int64 {
+	if x != nil {
+		return x.ElapsedMs
+	}
+	return 0
+}
+
+func (x *GcodeJob) GetRemainingMs() int64 {
+	if x != nil {
+		return x.RemainingMs
+	}
+	return 0
+}
+
+type StartGcodeJobRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PortName          string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId         string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Dialect           GcodeDialect           `protobuf:"varint,4,opt,name=dialect,proto3,enum=seriallink.v1.GcodeDialect" json:"dialect,omitempty"`
+	Program           []byte                 `protobuf:"bytes,5,opt,name=program,proto3" json:"program,omitempty"`
+	ResponseTimeoutMs uint32                 `protobuf:"varint,6,opt,name=response_timeout_ms,json=responseTimeoutMs,proto3" json:"response_timeout_ms,omitempty"`
+	BufferSize        uint32                 `protobuf:"varint,7,opt,name=buffer_size,json=bufferSize,proto3" json:"buffer_size,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StartGcodeJobRequest) Reset() {
+	*x = StartGcodeJobRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGcodeJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGcodeJobRequest) ProtoMessage() {}
+
+func (x *StartGcodeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGcodeJobRequest.ProtoReflect.Descriptor instead.
+func (*StartGcodeJobRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *StartGcodeJobRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *StartGcodeJobRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *StartGcodeJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartGcodeJobRequest) GetDialect() GcodeDialect {
+	if x != nil {
+		return x.Dialect
+	}
+	return GcodeDialect_GCODE_DIALECT_UNSPECIFIED
+}
+
+func (x *StartGcodeJobRequest) GetProgram() []byte {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+func (x *StartGcodeJobRequest) GetResponseTimeoutMs() uint32 {
+	if x != nil {
+		return x.ResponseTimeoutMs
+	}
+	return 0
+}
+
+func (x *StartGcodeJobRequest) GetBufferSize() uint32 {
+	if x != nil {
+		return x.BufferSize
+	}
+	return 0
+}
+
+type StartGcodeJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *GcodeJob              `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGcodeJobResponse) Reset() {
+	*x = StartGcodeJobResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGcodeJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGcodeJobResponse) ProtoMessage() {}
+
+func (x *StartGcodeJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGcodeJobResponse.ProtoReflect.Descriptor instead.
+func (*StartGcodeJobResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *StartGcodeJobResponse) GetJob() *GcodeJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ControlGcodeJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Action        GcodeJobAction         `protobuf:"varint,3,opt,name=action,proto3,enum=seriallink.v1.GcodeJobAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlGcodeJobRequest) Reset() {
+	*x = ControlGcodeJobRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlGcodeJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlGcodeJobRequest) ProtoMessage() {}
+
+func (x *ControlGcodeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlGcodeJobRequest.ProtoReflect.Descriptor instead.
+func (*ControlGcodeJobRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *ControlGcodeJobRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *ControlGcodeJobRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ControlGcodeJobRequest) GetAction() GcodeJobAction {
+	if x != nil {
+		return x.Action
+	}
+	return GcodeJobAction_GCODE_JOB_ACTION_UNSPECIFIED
+}
+
+type ControlGcodeJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *GcodeJob              `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlGcodeJobResponse) Reset() {
+	*x = ControlGcodeJobResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlGcodeJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlGcodeJobResponse) ProtoMessage() {}
+
+func (x *ControlGcodeJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlGcodeJobResponse.ProtoReflect.Descriptor instead.
+func (*ControlGcodeJobResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *ControlGcodeJobResponse) GetJob() *GcodeJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetGcodeJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGcodeJobRequest) Reset() {
+	*x = GetGcodeJobRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGcodeJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGcodeJobRequest) ProtoMessage() {}
+
+func (x *GetGcodeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGcodeJobRequest.ProtoReflect.Descriptor instead.
+func (*GetGcodeJobRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *GetGcodeJobRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+type GetGcodeJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *GcodeJob              `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGcodeJobResponse) Reset() {
+	*x = GetGcodeJobResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGcodeJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGcodeJobResponse) ProtoMessage() {}
+
+func (x *GetGcodeJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGcodeJobResponse.ProtoReflect.Descriptor instead.
+func (*GetGcodeJobResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *GetGcodeJobResponse) GetJob() *GcodeJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type StreamGcodeJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	IntervalMs    uint32                 `protobuf:"varint,2,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamGcodeJobRequest) Reset() {
+	*x = StreamGcodeJobRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamGcodeJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamGcodeJobRequest) ProtoMessage() {}
+
+func (x *StreamGcodeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamGcodeJobRequest.ProtoReflect.Descriptor instead.
+func (*StreamGcodeJobRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *StreamGcodeJobRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *StreamGcodeJobRequest) GetIntervalMs() uint32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+type StreamGcodeJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *GcodeJob              `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamGcodeJobResponse) Reset() {
+	*x = StreamGcodeJobResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamGcodeJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamGcodeJobResponse) ProtoMessage() {}
+
+func (x *StreamGcodeJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamGcodeJobResponse.ProtoReflect.Descriptor instead.
+func (*StreamGcodeJobResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *StreamGcodeJobResponse) GetJob() *GcodeJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type MachineTemperature struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sensor        string                 `protobuf:"bytes,1,opt,name=sensor,proto3" json:"sensor,omitempty"`
+	Current       float64                `protobuf:"fixed64,2,opt,name=current,proto3" json:"current,omitempty"`
+	Target        float64                `protobuf:"fixed64,3,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MachineTemperature) Reset() {
+	*x = MachineTemperature{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MachineTemperature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachineTemperature) ProtoMessage() {}
+
+func (x *MachineTemperature) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachineTemperature.ProtoReflect.Descriptor instead.
+func (*MachineTemperature) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *MachineTemperature) GetSensor() string {
+	if x != nil {
+		return x.Sensor
+	}
+	return ""
+}
+
+func (x *MachineTemperature) GetCurrent() float64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *MachineTemperature) GetTarget() float64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+type MachineStatus struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PortName        string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Dialect         GcodeDialect           `protobuf:"varint,2,opt,name=dialect,proto3,enum=seriallink.v1.GcodeDialect" json:"dialect,omitempty"`
+	State           string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	MachinePosition map[string]float64     `protobuf:"bytes,4,rep,name=machine_position,json=machinePosition,proto3" json:"machine_position,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	WorkPosition    map[string]float64     `protobuf:"bytes,5,rep,name=work_position,json=workPosition,proto3" json:"work_position,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	Temperatures    []*MachineTemperature  `protobuf:"bytes,6,rep,name=temperatures,proto3" json:"temperatures,omitempty"`
+	FeedRate        float64                `protobuf:"fixed64,7,opt,name=feed_rate,json=feedRate,proto3" json:"feed_rate,omitempty"`
+	SpindleSpeed    float64                `protobuf:"fixed64,8,opt,name=spindle_speed,json=spindleSpeed,proto3" json:"spindle_speed,omitempty"`
+	Error           string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	UpdatedAt       int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Job             *GcodeJob              `protobuf:"bytes,11,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MachineStatus) Reset() {
+	*x = MachineStatus{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MachineStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachineStatus) ProtoMessage() {}
+
+func (x *MachineStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachineStatus.ProtoReflect.Descriptor instead.
+func (*MachineStatus) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *MachineStatus) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *MachineStatus) GetDialect() GcodeDialect {
+	if x != nil {
+		return x.Dialect
+	}
+	return GcodeDialect_GCODE_DIALECT_UNSPECIFIED
+}
+
+func (x *MachineStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *MachineStatus) GetMachinePosition() map[string]float64 {
+	if x != nil {
+		return x.MachinePosition
+	}
+	return nil
+}
+
+func (x *MachineStatus) GetWorkPosition() map[string]float64 {
+	if x != nil {
+		return x.WorkPosition
+	}
+	return nil
+}
+
+func (x *MachineStatus) GetTemperatures() []*MachineTemperature {
+	if x != nil {
+		return x.Temperatures
+	}
+	return nil
+}
+
+func (x *MachineStatus) GetFeedRate() float64 {
+	if x != nil {
+		return x.FeedRate
+	}
+	return 0
+}
+
+func (x *MachineStatus) GetSpindleSpeed() float64 {
+	if x != nil {
+		return x.SpindleSpeed
+	}
+	return 0
+}
+
+func (x *MachineStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MachineStatus) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *MachineStatus) GetJob() *GcodeJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ConnectMachineRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PortName         string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId        string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Dialect          GcodeDialect           `protobuf:"varint,3,opt,name=dialect,proto3,enum=seriallink.v1.GcodeDialect" json:"dialect,omitempty"`
+	StatusIntervalMs uint32                 `protobuf:"varint,4,opt,name=status_interval_ms,json=statusIntervalMs,proto3" json:"status_interval_ms,omitempty"`
+	BufferSize       uint32                 `protobuf:"varint,5,opt,name=buffer_size,json=bufferSize,proto3" json:"buffer_size,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ConnectMachineRequest) Reset() {
+	*x = ConnectMachineRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectMachineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectMachineRequest) ProtoMessage() {}
+
+func (x *ConnectMachineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectMachineRequest.ProtoReflect.Descriptor instead.
+func (*ConnectMachineRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *ConnectMachineRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *ConnectMachineRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ConnectMachineRequest) GetDialect() GcodeDialect {
+	if x != nil {
+		return x.Dialect
+	}
+	return GcodeDialect_GCODE_DIALECT_UNSPECIFIED
+}
+
+func (x *ConnectMachineRequest) GetStatusIntervalMs() uint32 {
+	if x != nil {
+		return x.StatusIntervalMs
+	}
+	return 0
+}
+
+func (x *ConnectMachineRequest) GetBufferSize() uint32 {
+	if x != nil {
+		return x.BufferSize
+	}
+	return 0
+}
+
+type ConnectMachineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *MachineStatus         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectMachineResponse) Reset() {
+	*x = ConnectMachineResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectMachineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectMachineResponse) ProtoMessage() {}
+
+func (x *ConnectMachineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectMachineResponse.ProtoReflect.Descriptor instead.
+func (*ConnectMachineResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *ConnectMachineResponse) GetStatus() *MachineStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type DisconnectMachineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectMachineRequest) Reset() {
+	*x = DisconnectMachineRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectMachineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectMachineRequest) ProtoMessage() {}
+
+func (x *DisconnectMachineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectMachineRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectMachineRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *DisconnectMachineRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *DisconnectMachineRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type DisconnectMachineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectMachineResponse) Reset() {
+	*x = DisconnectMachineResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectMachineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectMachineResponse) ProtoMessage() {}
+
+func (x *DisconnectMachineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectMachineResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectMachineResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *DisconnectMachineResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DisconnectMachineResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type StreamMachineStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	IntervalMs    uint32                 `protobuf:"varint,2,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	OnChange      bool                   `protobuf:"varint,3,opt,name=on_change,json=onChange,proto3" json:"on_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamMachineStatusRequest) Reset() {
+	*x = StreamMachineStatusRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMachineStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMachineStatusRequest) ProtoMessage() {}
+
+func (x *StreamMachineStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMachineStatusRequest.ProtoReflect.Descriptor instead.
+func (*StreamMachineStatusRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *StreamMachineStatusRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *StreamMachineStatusRequest) GetIntervalMs() uint32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *StreamMachineStatusRequest) GetOnChange() bool {
+	if x != nil {
+		return x.OnChange
+	}
+	return false
+}
+
+type StreamMachineStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *MachineStatus         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamMachineStatusResponse) Reset() {
+	*x = StreamMachineStatusResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMachineStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMachineStatusResponse) ProtoMessage() {}
+
+func (x *StreamMachineStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMachineStatusResponse.ProtoReflect.Descriptor instead.
+func (*StreamMachineStatusResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *StreamMachineStatusResponse) GetStatus() *MachineStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *StreamMachineStatusResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type JogMachineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	X             float64                `protobuf:"fixed64,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,4,opt,name=y,proto3" json:"y,omitempty"`
+	Z             float64                `protobuf:"fixed64,5,opt,name=z,proto3" json:"z,omitempty"`
+	FeedRate      float64                `protobuf:"fixed64,6,opt,name=feed_rate,json=feedRate,proto3" json:"feed_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JogMachineRequest) Reset() {
+	*x = JogMachineRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JogMachineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JogMachineRequest) ProtoMessage() {}
+
+func (x *JogMachineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JogMachineRequest.ProtoReflect.Descriptor instead.
+func (*JogMachineRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *JogMachineRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *JogMachineRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *JogMachineRequest) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *JogMachineRequest) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *JogMachineRequest) GetZ() float64 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *JogMachineRequest) GetFeedRate() float64 {
+	if x != nil {
+		return x.FeedRate
+	}
+	return 0
+}
+
+type JogMachineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *MachineStatus         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JogMachineResponse) Reset() {
+	*x = JogMachineResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JogMachineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JogMachineResponse) ProtoMessage() {}
+
+func (x *JogMachineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JogMachineResponse.ProtoReflect.Descriptor instead.
+func (*JogMachineResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *JogMachineResponse) GetStatus() *MachineStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type SendMachineCommandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Command       MachineCommand         `protobuf:"varint,3,opt,name=command,proto3,enum=seriallink.v1.MachineCommand" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMachineCommandRequest) Reset() {
+	*x = SendMachineCommandRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMachineCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMachineCommandRequest) ProtoMessage() {}
+
+func (x *SendMachineCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMachineCommandRequest.ProtoReflect.Descriptor instead.
+func (*SendMachineCommandRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *SendMachineCommandRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *SendMachineCommandRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SendMachineCommandRequest) GetCommand() MachineCommand {
+	if x != nil {
+		return x.Command
+	}
+	return MachineCommand_MACHINE_COMMAND_UNSPECIFIED
+}
+
+type SendMachineCommandResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *MachineStatus         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendMachineCommandResponse) Reset() {
+	*x = SendMachineCommandResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendMachineCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMachineCommandResponse) ProtoMessage() {}
+
+func (x *SendMachineCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMachineCommandResponse.ProtoReflect.Descriptor instead.
+func (*SendMachineCommandResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *SendMachineCommandResponse) GetStatus() *MachineStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ReadMeterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	MaxBaudRate   uint32                 `protobuf:"varint,4,opt,name=max_baud_rate,json=maxBaudRate,proto3" json:"max_baud_rate,omitempty"`
+	TimeoutMs     uint32                 `protobuf:"varint,5,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadMeterRequest) Reset() {
+	*x = ReadMeterRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadMeterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadMeterRequest) ProtoMessage() {}
+
+func (x *ReadMeterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadMeterRequest.ProtoReflect.Descriptor instead.
+func (*ReadMeterRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *ReadMeterRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *ReadMeterRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ReadMeterRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ReadMeterRequest) GetMaxBaudRate() uint32 {
+	if x != nil {
+		return x.MaxBaudRate
+	}
+	return 0
+}
+
+func (x *ReadMeterRequest) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type MeterValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Unit          string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	Number        float64                `protobuf:"fixed64,4,opt,name=number,proto3" json:"number,omitempty"`
+	Numeric       bool                   `protobuf:"varint,5,opt,name=numeric,proto3" json:"numeric,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeterValue) Reset() {
+	*x = MeterValue{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeterValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeterValue) ProtoMessage() {}
+
+func (x *MeterValue) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeterValue.ProtoReflect.Descriptor instead.
+func (*MeterValue) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *MeterValue) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *MeterValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *MeterValue) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *MeterValue) GetNumber() float64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *MeterValue) GetNumeric() bool {
+	if x != nil {
+		return x.Numeric
+	}
+	return false
+}
+
+type ReadMeterResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Manufacturer   string                 `protobuf:"bytes,1,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Identification string                 `protobuf:"bytes,2,opt,name=identification,proto3" json:"identification,omitempty"`
+	BaudRate       uint32                 `protobuf:"varint,3,opt,name=baud_rate,json=baudRate,proto3" json:"baud_rate,omitempty"`
+	Values         []*MeterValue          `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
+	Data           []byte                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReadMeterResponse) Reset() {
+	*x = ReadMeterResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadMeterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadMeterResponse) ProtoMessage() {}
+
+func (x *ReadMeterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadMeterResponse.ProtoReflect.Descriptor instead.
+func (*ReadMeterResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *ReadMeterResponse) GetManufacturer() string {
+	if x != nil {
+		return x.Manufacturer
+	}
+	return ""
+}
+
+func (x *ReadMeterResponse) GetIdentification() string {
+	if x != nil {
+		return x.Identification
+	}
+	return ""
+}
+
+func (x *ReadMeterResponse) GetBaudRate() uint32 {
+	if x != nil {
+		return x.BaudRate
+	}
+	return 0
+}
+
+func (x *ReadMeterResponse) GetValues() []*MeterValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *ReadMeterResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetKeywordStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKeywordStatsRequest) Reset() {
+	*x = GetKeywordStatsRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeywordStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeywordStatsRequest) ProtoMessage() {}
+
+func (x *GetKeywordStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeywordStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetKeywordStatsRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *GetKeywordStatsRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+type KeywordWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WindowSeconds uint32                 `protobuf:"varint,1,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeywordWindow) Reset() {
+	*x = KeywordWindow{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeywordWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeywordWindow) ProtoMessage() {}
+
+func (x *KeywordWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeywordWindow.ProtoReflect.Descriptor instead.
+func (*KeywordWindow) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *KeywordWindow) GetWindowSeconds() uint32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *KeywordWindow) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type KeywordCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Total         uint64                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Windows       []*KeywordWindow       `protobuf:"bytes,3,rep,name=windows,proto3" json:"windows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeywordCount) Reset() {
+	*x = KeywordCount{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeywordCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeywordCount) ProtoMessage() {}
+
+func (x *KeywordCount) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeywordCount.ProtoReflect.Descriptor instead.
+func (*KeywordCount) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *KeywordCount) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *KeywordCount) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *KeywordCount) GetWindows() []*KeywordWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type PortKeywordStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Keywords      []*KeywordCount        `protobuf:"bytes,2,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortKeywordStats) Reset() {
+	*x = PortKeywordStats{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortKeywordStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortKeywordStats) ProtoMessage() {}
+
+func (x *PortKeywordStats) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortKeywordStats.ProtoReflect.Descriptor instead.
+func (*PortKeywordStats) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *PortKeywordStats) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *PortKeywordStats) GetKeywords() []*KeywordCount {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+type GetKeywordStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ports         []*PortKeywordStats    `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKeywordStatsResponse) Reset() {
+	*x = GetKeywordStatsResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKeywordStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeywordStatsResponse) ProtoMessage() {}
+
+func (x *GetKeywordStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeywordStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetKeywordStatsResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *GetKeywordStatsResponse) GetPorts() []*PortKeywordStats {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+type ListRecordingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          string                 `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	PortName      string                 `protobuf:"bytes,2,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Since         int64                  `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	Limit         uint32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecordingsRequest) Reset() {
+	*x = ListRecordingsRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecordingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecordingsRequest) ProtoMessage() {}
+
+func (x *ListRecordingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecordingsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecordingsRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *ListRecordingsRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ListRecordingsRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *ListRecordingsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListRecordingsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Recording struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	User          string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	PortName      string                 `protobuf:"bytes,3,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	SessionId     string                 `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	StartedAt     int64                  `protobuf:"varint,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	DurationMs    uint64                 `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	SizeBytes     uint64                 `protobuf:"varint,7,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Active        bool                   `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recording) Reset() {
+	*x = Recording{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recording) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recording) ProtoMessage() {}
+
+func (x *Recording) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recording.ProtoReflect.Descriptor instead.
+func (*Recording) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *Recording) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Recording) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *Recording) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *Recording) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *Recording) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *Recording) GetDurationMs() uint64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *Recording) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Recording) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type ListRecordingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recordings    []*Recording           `protobuf:"bytes,1,rep,name=recordings,proto3" json:"recordings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecordingsResponse) Reset() {
+	*x = ListRecordingsResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecordingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecordingsResponse) ProtoMessage() {}
+
+func (x *ListRecordingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecordingsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecordingsResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *ListRecordingsResponse) GetRecordings() []*Recording {
+	if x != nil {
+		return x.Recordings
+	}
+	return nil
+}
+
+type FetchRecordingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchRecordingRequest) Reset() {
+	*x = FetchRecordingRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchRecordingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchRecordingRequest) ProtoMessage() {}
+
+func (x *FetchRecordingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchRecordingRequest.ProtoReflect.Descriptor instead.
+func (*FetchRecordingRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *FetchRecordingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type FetchRecordingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FetchRecordingResponse) Reset() {
+	*x = FetchRecordingResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FetchRecordingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchRecordingResponse) ProtoMessage() {}
+
+func (x *FetchRecordingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchRecordingResponse.ProtoReflect.Descriptor instead.
+func (*FetchRecordingResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *FetchRecordingResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetPortCapabilitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortCapabilitiesRequest) Reset() {
+	*x = GetPortCapabilitiesRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortCapabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortCapabilitiesRequest) ProtoMessage() {}
+
+func (x *GetPortCapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortCapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetPortCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *GetPortCapabilitiesRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+type GetPortCapabilitiesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PortName        string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Platform        string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	Driver          string                 `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
+	MaxBaudRate     int32                  `protobuf:"varint,4,opt,name=max_baud_rate,json=maxBaudRate,proto3" json:"max_baud_rate,omitempty"`
+	LowLatency      bool                   `protobuf:"varint,5,opt,name=low_latency,json=lowLatency,proto3" json:"low_latency,omitempty"`
+	ReadPolling     bool                   `protobuf:"varint,6,opt,name=read_polling,json=readPolling,proto3" json:"read_polling,omitempty"`
+	CustomBaud      bool                   `protobuf:"varint,7,opt,name=custom_baud,json=customBaud,proto3" json:"custom_baud,omitempty"`
+	MarkSpaceParity bool                   `protobuf:"varint,8,opt,name=mark_space_parity,json=markSpaceParity,proto3" json:"mark_space_parity,omitempty"`
+	ModemStatus     bool                   `protobuf:"varint,9,opt,name=modem_status,json=modemStatus,proto3" json:"modem_status,omitempty"`
+	ControlLines    bool                   `protobuf:"varint,10,opt,name=control_lines,json=controlLines,proto3" json:"control_lines,omitempty"`
+	Break           bool                   `protobuf:"varint,11,opt,name=break,proto3" json:"break,omitempty"`
+	Rs485           bool                   `protobuf:"varint,12,opt,name=rs485,proto3" json:"rs485,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPortCapabilitiesResponse) Reset() {
+	*x = GetPortCapabilitiesResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortCapabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortCapabilitiesResponse) ProtoMessage() {}
+
+func (x *GetPortCapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortCapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetPortCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *GetPortCapabilitiesResponse) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *GetPortCapabilitiesResponse) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *GetPortCapabilitiesResponse) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *GetPortCapabilitiesResponse) GetMaxBaudRate() int32 {
+	if x != nil {
+		return x.MaxBaudRate
+	}
+	return 0
+}
+
+func (x *GetPortCapabilitiesResponse) GetLowLatency() bool {
+	if x != nil {
+		return x.LowLatency
+	}
+	return false
+}
+
+func (x *GetPortCapabilitiesResponse) GetReadPolling() bool {
+	if x != nil {
+		return x.ReadPolling
+	}
+	return false
+}
+
+func (x *GetPortCapabilitiesResponse) GetCustomBaud() bool {
+	if x != nil {
+		return x.CustomBaud
+	}
+	return false
+}
+
+func (x *GetPortCapabilitiesResponse) GetMarkSpaceParity() bool {
+	if x != nil {
+		return x.MarkSpaceParity
+	}
+	return false
+}
+
+func (x *GetPortCapabilitiesResponse) GetModemStatus() bool {
+	if x != nil {
+		return x.ModemStatus
+	}
+	return false
+}
+
+func (x *GetPortCapabilitiesResponse) GetControlLines() bool {
+	if x != nil {
+		return x.ControlLines
+	}
+	return false
+}
+
+func (x *GetPortCapabilitiesResponse) GetBreak() bool {
+	if x != nil {
+		return x.Break
+	}
+	return false
+}
+
+func (x *GetPortCapabilitiesResponse) GetRs485() bool {
+	if x != nil {
+		return x.Rs485
+	}
+	return false
+}
+
+type UploadFirmwareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Firmware      []byte                 `protobuf:"bytes,2,opt,name=firmware,proto3" json:"firmware,omitempty"`
+	Protocol      BootloaderProtocol     `protobuf:"varint,3,opt,name=protocol,proto3,enum=seriallink.v1.BootloaderProtocol" json:"protocol,omitempty"`
+	ResetMethod   BootloaderReset        `protobuf:"varint,4,opt,name=reset_method,json=resetMethod,proto3,enum=seriallink.v1.BootloaderReset" json:"reset_method,omitempty"`
+	BaudRate      uint32                 `protobuf:"varint,5,opt,name=baud_rate,json=baudRate,proto3" json:"baud_rate,omitempty"`
+	SkipVerify    bool                   `protobuf:"varint,6,opt,name=skip_verify,json=skipVerify,proto3" json:"skip_verify,omitempty"`
+	ClientId      string                 `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFirmwareRequest) Reset() {
+	*x = UploadFirmwareRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFirmwareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFirmwareRequest) ProtoMessage() {}
+
+func (x *UploadFirmwareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFirmwareRequest.ProtoReflect.Descriptor instead.
+func (*UploadFirmwareRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *UploadFirmwareRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *UploadFirmwareRequest) GetFirmware() []byte {
+	if x != nil {
+		return x.Firmware
+	}
+	return nil
+}
+
+func (x *UploadFirmwareRequest) GetProtocol() BootloaderProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return BootloaderProtocol_BOOTLOADER_PROTOCOL_UNSPECIFIED
+}
+
+func (x *UploadFirmwareRequest) GetResetMethod() BootloaderReset {
+	if x != nil {
+		return x.ResetMethod
+	}
+	return BootloaderReset_BOOTLOADER_RESET_UNSPECIFIED
+}
+
+func (x *UploadFirmwareRequest) GetBaudRate() uint32 {
+	if x != nil {
+		return x.BaudRate
+	}
+	return 0
+}
+
+func (x *UploadFirmwareRequest) GetSkipVerify() bool {
+	if x != nil {
+		return x.SkipVerify
+	}
+	return false
+}
+
+func (x *UploadFirmwareRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type UploadFirmwareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stage         UploadStage            `protobuf:"varint,1,opt,name=stage,proto3,enum=seriallink.v1.UploadStage" json:"stage,omitempty"`
+	PortName      string                 `protobuf:"bytes,2,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	BytesDone     uint32                 `protobuf:"varint,3,opt,name=bytes_done,json=bytesDone,proto3" json:"bytes_done,omitempty"`
+	BytesTotal    uint32                 `protobuf:"varint,4,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytes_total,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Device        string                 `protobuf:"bytes,6,opt,name=device,proto3" json:"device,omitempty"`
+	Verified      bool                   `protobuf:"varint,7,opt,name=verified,proto3" json:"verified,omitempty"`
+	DurationMs    uint64                 `protobuf:"varint,8,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFirmwareResponse) Reset() {
+	*x = UploadFirmwareResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFirmwareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFirmwareResponse) ProtoMessage() {}
+
+func (x *UploadFirmwareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFirmwareResponse.ProtoReflect.Descriptor instead.
+func (*UploadFirmwareResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *UploadFirmwareResponse) GetStage() UploadStage {
+	if x != nil {
+		return x.Stage
+	}
+	return UploadStage_UPLOAD_STAGE_UNSPECIFIED
+}
+
+func (x *UploadFirmwareResponse) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *UploadFirmwareResponse) GetBytesDone() uint32 {
+	if x != nil {
+		return x.BytesDone
+	}
+	return 0
+}
+
+func (x *UploadFirmwareResponse) GetBytesTotal() uint32 {
+	if x != nil {
+		return x.BytesTotal
+	}
+	return 0
+}
+
+func (x *UploadFirmwareResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UploadFirmwareResponse) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *UploadFirmwareResponse) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *UploadFirmwareResponse) GetDurationMs() uint64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *ListSessionsRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type SessionInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SessionId      string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ShortSessionId string                 `protobuf:"bytes,2,opt,name=short_session_id,json=shortSessionId,proto3" json:"short_session_id,omitempty"`
+	PortName       string                 `protobuf:"bytes,3,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	ClientId       string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Owner          string                 `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	Exclusive      bool                   `protobuf:"varint,6,opt,name=exclusive,proto3" json:"exclusive,omitempty"`
+	Priority       SessionPriority        `protobuf:"varint,7,opt,name=priority,proto3,enum=seriallink.v1.SessionPriority" json:"priority,omitempty"`
+	PowerState     PowerState             `protobuf:"varint,8,opt,name=power_state,json=powerState,proto3,enum=seriallink.v1.PowerState" json:"power_state,omitempty"`
+	Metadata       map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Statistics     *PortStatistics        `protobuf:"bytes,10,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	AgeMs          int64                  `protobuf:"varint,11,opt,name=age_ms,json=ageMs,proto3" json:"age_ms,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionInfo) ProtoMessage() {}
+
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *SessionInfo) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetShortSessionId() string {
+	if x != nil {
+		return x.ShortSessionId
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetExclusive() bool {
+	if x != nil {
+		return x.Exclusive
+	}
+	return false
+}
+
+func (x *SessionInfo) GetPriority() SessionPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return SessionPriority_SESSION_PRIORITY_UNSPECIFIED
+}
+
+func (x *SessionInfo) GetPowerState() PowerState {
+	if x != nil {
+		return x.PowerState
+	}
+	return PowerState_POWER_STATE_UNSPECIFIED
+}
+
+func (x *SessionInfo) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SessionInfo) GetStatistics() *PortStatistics {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+func (x *SessionInfo) GetAgeMs() int64 {
+	if x != nil {
+		return x.AgeMs
+	}
+	return 0
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*SessionInfo         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type CreateAccessTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PortName      string                 `protobuf:"bytes,2,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	AllowWrite    bool                   `protobuf:"varint,3,opt,name=allow_write,json=allowWrite,proto3" json:"allow_write,omitempty"`
+	TtlSeconds    uint32                 `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccessTokenRequest) Reset() {
+	*x = CreateAccessTokenRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessTokenRequest) ProtoMessage() {}
+
+func (x *CreateAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *CreateAccessTokenRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAccessTokenRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *CreateAccessTokenRequest) GetAllowWrite() bool {
+	if x != nil {
+		return x.AllowWrite
+	}
+	return false
+}
+
+func (x *CreateAccessTokenRequest) GetTtlSeconds() uint32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type CreateAccessTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Identity      string                 `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccessTokenResponse) Reset() {
+	*x = CreateAccessTokenResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccessTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessTokenResponse) ProtoMessage() {}
+
+func (x *CreateAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *CreateAccessTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateAccessTokenResponse) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *CreateAccessTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ForceCloseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortName      string                 `protobuf:"bytes,1,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Notify        bool                   `protobuf:"varint,3,opt,name=notify,proto3" json:"notify,omitempty"`
+	GraceMs       uint32                 `protobuf:"varint,4,opt,name=grace_ms,json=graceMs,proto3" json:"grace_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceCloseRequest) Reset() {
+	*x = ForceCloseRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceCloseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceCloseRequest) ProtoMessage() {}
+
+func (x *ForceCloseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceCloseRequest.ProtoReflect.Descriptor instead.
+func (*ForceCloseRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *ForceCloseRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *ForceCloseRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ForceCloseRequest) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+func (x *ForceCloseRequest) GetGraceMs() uint32 {
+	if x != nil {
+		return x.GraceMs
+	}
+	return 0
+}
+
+type ForceCloseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	SessionId     string                 `protobuf:"bytes,3,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ClientId      string                 `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceCloseResponse) Reset() {
+	*x = ForceCloseResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceCloseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceCloseResponse) ProtoMessage() {}
+
+func (x *ForceCloseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[201]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceCloseResponse.ProtoReflect.Descriptor instead.
+func (*ForceCloseResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *ForceCloseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ForceCloseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ForceCloseResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ForceCloseResponse) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type CreateStreamLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PortName      string                 `protobuf:"bytes,2,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	TtlSeconds    uint32                 `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	Once          bool                   `protobuf:"varint,4,opt,name=once,proto3" json:"once,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStreamLinkRequest) Reset() {
+	*x = CreateStreamLinkRequest{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStreamLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStreamLinkRequest) ProtoMessage() {}
+
+func (x *CreateStreamLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[202]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStreamLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateStreamLinkRequest) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *CreateStreamLinkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateStreamLinkRequest) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *CreateStreamLinkRequest) GetTtlSeconds() uint32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *CreateStreamLinkRequest) GetOnce() bool {
+	if x != nil {
+		return x.Once
+	}
+	return false
+}
+
+type CreateStreamLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventsUrl     string                 `protobuf:"bytes,1,opt,name=events_url,json=eventsUrl,proto3" json:"events_url,omitempty"`
+	WebsocketUrl  string                 `protobuf:"bytes,2,opt,name=websocket_url,json=websocketUrl,proto3" json:"websocket_url,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Identity      string                 `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateStreamLinkResponse) Reset() {
+	*x = CreateStreamLinkResponse{}
+	mi := &file_seriallink_v1_serial_proto_msgTypes[203]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStreamLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStreamLinkResponse) ProtoMessage() {}
+
+func (x *CreateStreamLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_seriallink_v1_serial_proto_msgTypes[203]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStreamLinkResponse.ProtoReflect.Descriptor instead.
+func (*CreateStreamLinkResponse) Descriptor() ([]byte, []int) {
+	return file_seriallink_v1_serial_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *CreateStreamLinkResponse) GetEventsUrl() string {
+	if x != nil {
+		return x.EventsUrl
+	}
+	return ""
+}
+
+func (x *CreateStreamLinkResponse) GetWebsocketUrl() string {
+	if x != nil {
+		return x.WebsocketUrl
+	}
+	return ""
+}
+
+func (x *CreateStreamLinkResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateStreamLinkResponse) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *CreateStreamLinkResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+var File_seriallink_v1_serial_proto protoreflect.FileDescriptor
+
+const file_seriallink_v1_serial_proto_rawDesc = "" +
+	"\n" +
+	"\x1aseriallink/v1/serial.proto\x12\rseriallink.v1\"\xf5\x03\n" +
+	"\n" +
+	"PortConfig\x12\x1b\n" +
+	"\tbaud_rate\x18\x01 \x01(\rR\bbaudRate\x124\n" +
+	"\tdata_bits\x18\x02 \x01(\x0e2\x17.seriallink.v1.DataBitsR\bdataBits\x124\n" +
+	"\tstop_bits\x18\x03 \x01(\x0e2\x17.seriallink.v1.StopBitsR\bstopBits\x12-\n" +
+	"\x06parity\x18\x04 \x01(\x0e2\x15.seriallink.v1.ParityR\x06parity\x12=\n" +
+	"\fflow_control\x18\x05 \x01(\x0e2\x1a.seriallink.v1.FlowControlR\vflowControl\x12&\n" +
+	"\x0fread_timeout_ms\x18\x06 \x01(\rR\rreadTimeoutMs\x12(\n" +
+	"\x10write_timeout_ms\x18\a \x01(\rR\x0ewriteTimeoutMs\x12F\n" +
+	"\x0flatency_profile\x18\b \x01(\x0e2\x1d.seriallink.v1.LatencyProfileR\x0elatencyProfile\x12*\n" +
+	"\x03dtr\x18\t \x01(\x0e2\x18.seriallink.v1.LineStateR\x03dtr\x12*\n" +
+	"\x03rts\x18\n" +
+	" \x01(\x0e2\x18.seriallink.v1.LineStateR\x03rts\"\xd5\x02\n" +
+	"\bPortInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vhardware_id\x18\x03 \x01(\tR\n" +
+	"hardwareId\x12\"\n" +
+	"\fmanufacturer\x18\x04 \x01(\tR\fmanufacturer\x12\x18\n" +
+	"\aproduct\x18\x05 \x01(\tR\aproduct\x12#\n" +
+	"\rserial_number\x18\x06 \x01(\tR\fserialNumber\x124\n" +
+	"\tport_type\x18\a \x01(\x0e2\x17.seriallink.v1.PortTypeR\bportType\x12\x17\n" +
+	"\ais_open\x18\b \x01(\bR\x06isOpen\x12\x1b\n" +
+	"\tlocked_by\x18\t \x01(\tR\blockedBy\x12#\n" +
+	"\rdevice_family\x18\n" +
+	" \x01(\tR\fdeviceFamily\"\x99\x02\n" +
+	"\x0ePortStatistics\x12\x1d\n" +
+	"\n" +
+	"bytes_sent\x18\x01 \x01(\x04R\tbytesSent\x12%\n" +
+	"\x0ebytes_received\x18\x02 \x01(\x04R\rbytesReceived\x12\x16\n" +
+	"\x06errors\x18\x03 \x01(\x04R\x06errors\x12\x1b\n" +
+	"\topened_at\x18\x04 \x01(\x03R\bopenedAt\x12#\n" +
+	"\rlast_activity\x18\x05 \x01(\x03R\flastActivity\x12#\n" +
+	"\rgarbage_bytes\x18\x06 \x01(\x04R\fgarbageBytes\x12\x1f\n" +
+	"\vbreak_count\x18\a \x01(\x04R\n" +
+	"breakCount\x12!\n" +
+	"\fline_quality\x18\b \x01(\x01R\vlineQuality\"\xd7\x05\n" +
+	"\n" +
+	"PortStatus\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x17\n" +
+	"\ais_open\x18\x02 \x01(\bR\x06isOpen\x12\x1b\n" +
+	"\tis_locked\x18\x03 \x01(\bR\bisLocked\x12\x1b\n" +
+	"\tlocked_by\x18\x04 \x01(\tR\blockedBy\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x05 \x01(\tR\tsessionId\x12@\n" +
+	"\x0ecurrent_config\x18\x06 \x01(\v2\x19.seriallink.v1.PortConfigR\rcurrentConfig\x12=\n" +
+	"\n" +
+	"statistics\x18\a \x01(\v2\x1d.seriallink.v1.PortStatisticsR\n" +
+	"statistics\x12:\n" +
+	"\bpriority\x18\b \x01(\x0e2\x1e.seriallink.v1.SessionPriorityR\bpriority\x12:\n" +
+	"\vpower_state\x18\t \x01(\x0e2\x19.seriallink.v1.PowerStateR\n" +
+	"powerState\x12=\n" +
+	"\fclose_reason\x18\n" +
+	" \x01(\x0e2\x1a.seriallink.v1.CloseReasonR\vcloseReason\x12\x1b\n" +
+	"\tclosed_at\x18\v \x01(\x03R\bclosedAt\x12C\n" +
+	"\bmetadata\x18\f \x03(\v2'.seriallink.v1.PortStatus.MetadataEntryR\bmetadata\x12(\n" +
+	"\x10short_session_id\x18\r \x01(\tR\x0eshortSessionId\x129\n" +
+	"\ashaping\x18\x0e \x01(\v2\x1f.seriallink.v1.BandwidthShapingR\ashaping\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"9\n" +
+	"\x10ListPortsRequest\x12%\n" +
+	"\x0eonly_available\x18\x01 \x01(\bR\ronlyAvailable\"\x8f\x01\n" +
+	"\x11ListPortsResponse\x12-\n" +
+	"\x05ports\x18\x01 \x03(\v2\x17.seriallink.v1.PortInfoR\x05ports\x12\x18\n" +
+	"\apartial\x18\x02 \x01(\bR\apartial\x121\n" +
+	"\x14incomplete_providers\x18\x03 \x03(\tR\x13incompleteProviders\"1\n" +
+	"\x12GetPortInfoRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\"B\n" +
+	"\x13GetPortInfoResponse\x12+\n" +
+	"\x04port\x18\x01 \x01(\v2\x17.seriallink.v1.PortInfoR\x04port\"\x94\x04\n" +
+	"\x0fOpenPortRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x121\n" +
+	"\x06config\x18\x02 \x01(\v2\x19.seriallink.v1.PortConfigR\x06config\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12\x1c\n" +
+	"\texclusive\x18\x04 \x01(\bR\texclusive\x12:\n" +
+	"\bpriority\x18\x05 \x01(\x0e2\x1e.seriallink.v1.SessionPriorityR\bpriority\x12+\n" +
+	"\x04init\x18\x06 \x03(\v2\x17.seriallink.v1.InitStepR\x04init\x12H\n" +
+	"\bmetadata\x18\a \x03(\v2,.seriallink.v1.OpenPortRequest.MetadataEntryR\bmetadata\x12.\n" +
+	"\x13no_write_coalescing\x18\b \x01(\bR\x11noWriteCoalescing\x12*\n" +
+	"\x03dtr\x18\t \x01(\x0e2\x18.seriallink.v1.LineStateR\x03dtr\x12*\n" +
+	"\x03rts\x18\n" +
+	" \x01(\x0e2\x18.seriallink.v1.LineStateR\x03rts\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa4\x01\n" +
+	"\bInitStep\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x1a\n" +
+	"\bexpected\x18\x02 \x01(\fR\bexpected\x12)\n" +
+	"\x10expected_pattern\x18\x03 \x01(\tR\x0fexpectedPattern\x12\x1e\n" +
+	"\n" +
+	"terminator\x18\x04 \x01(\fR\n" +
+	"terminator\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x05 \x01(\rR\ttimeoutMs\"\xb6\x01\n" +
+	"\x10OpenPortResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12%\n" +
+	"\x0einit_responses\x18\x04 \x03(\fR\rinitResponses\x12(\n" +
+	"\x10short_session_id\x18\x05 \x01(\tR\x0eshortSessionId\"N\n" +
+	"\x10ClosePortRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"G\n" +
+	"\x11ClosePortResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"3\n" +
+	"\x14GetPortStatusRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\"J\n" +
+	"\x15GetPortStatusResponse\x121\n" +
+	"\x06status\x18\x01 \x01(\v2\x19.seriallink.v1.PortStatusR\x06status\"\xcb\x01\n" +
+	"\fWriteRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12\x14\n" +
+	"\x05flush\x18\x04 \x01(\bR\x05flush\x12\x1d\n" +
+	"\n" +
+	"execute_at\x18\x05 \x01(\x03R\texecuteAt\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\a \x01(\rR\ttimeoutMs\"\xbf\x01\n" +
+	"\rWriteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rbytes_written\x18\x02 \x01(\rR\fbytesWritten\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x17\n" +
+	"\asent_at\x18\x04 \x01(\x03R\x06sentAt\x12\x1f\n" +
+	"\vapproval_id\x18\x05 \x01(\tR\n" +
+	"approvalId\x12\x1b\n" +
+	"\twire_data\x18\x06 \x01(\fR\bwireData\"\x85\x01\n" +
+	"\vReadRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tmax_bytes\x18\x03 \x01(\rR\bmaxBytes\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x04 \x01(\rR\ttimeoutMs\"u\n" +
+	"\fReadResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x1d\n" +
+	"\n" +
+	"bytes_read\x18\x03 \x01(\rR\tbytesRead\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"v\n" +
+	"\tDataChunk\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12\x1a\n" +
+	"\bsequence\x18\x04 \x01(\rR\bsequence\"\xea\x02\n" +
+	"\x11StreamReadRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x03 \x01(\rR\tchunkSize\x12-\n" +
+	"\x12include_timestamps\x18\x04 \x01(\bR\x11includeTimestamps\x12:\n" +
+	"\bpriority\x18\x05 \x01(\x0e2\x1e.seriallink.v1.SessionPriorityR\bpriority\x12\x1f\n" +
+	"\vdedup_lines\x18\x06 \x01(\bR\n" +
+	"dedupLines\x123\n" +
+	"\x06filter\x18\a \x01(\v2\x1b.seriallink.v1.StreamFilterR\x06filter\x129\n" +
+	"\bchunking\x18\b \x01(\x0e2\x1d.seriallink.v1.StreamChunkingR\bchunking\"@\n" +
+	"\fStreamFilter\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\fR\x06prefix\"g\n" +
+	"\x12StreamReadResponse\x12.\n" +
+	"\x05chunk\x18\x01 \x01(\v2\x18.seriallink.v1.DataChunkR\x05chunk\x12!\n" +
+	"\frepeat_count\x18\x02 \x01(\rR\vrepeatCount\"\x90\x01\n" +
+	"\x16StreamTimedReadRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x19\n" +
+	"\bper_byte\x18\x03 \x01(\bR\aperByte\x12\x1f\n" +
+	"\vduration_ms\x18\x04 \x01(\rR\n" +
+	"durationMs\"\xb7\x01\n" +
+	"\n" +
+	"TimedChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x02 \x01(\x03R\tstartedAt\x12!\n" +
+	"\fcompleted_at\x18\x03 \x01(\x03R\vcompletedAt\x12\x15\n" +
+	"\x06gap_ns\x18\x04 \x01(\x03R\x05gapNs\x12\x1a\n" +
+	"\bsequence\x18\x05 \x01(\rR\bsequence\x12 \n" +
+	"\fchar_time_ns\x18\x06 \x01(\x03R\n" +
+	"charTimeNs\"J\n" +
+	"\x17StreamTimedReadResponse\x12/\n" +
+	"\x05chunk\x18\x01 \x01(\v2\x19.seriallink.v1.TimedChunkR\x05chunk\"D\n" +
+	"\x12StreamWriteRequest\x12.\n" +
+	"\x05chunk\x18\x01 \x01(\v2\x18.seriallink.v1.DataChunkR\x05chunk\"\xa4\x01\n" +
+	"\x13StreamWriteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12.\n" +
+	"\x13total_bytes_written\x18\x02 \x01(\x04R\x11totalBytesWritten\x12)\n" +
+	"\x10chunks_processed\x18\x03 \x01(\rR\x0fchunksProcessed\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"L\n" +
+	"\x1aBiDirectionalStreamRequest\x12.\n" +
+	"\x05chunk\x18\x01 \x01(\v2\x18.seriallink.v1.DataChunkR\x05chunk\"M\n" +
+	"\x1bBiDirectionalStreamResponse\x12.\n" +
+	"\x05chunk\x18\x01 \x01(\v2\x18.seriallink.v1.DataChunkR\x05chunk\"\x85\x01\n" +
+	"\x14ConfigurePortRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x121\n" +
+	"\x06config\x18\x03 \x01(\v2\x19.seriallink.v1.PortConfigR\x06config\"K\n" +
+	"\x15ConfigurePortResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"3\n" +
+	"\x14GetPortConfigRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\"J\n" +
+	"\x15GetPortConfigResponse\x121\n" +
+	"\x06config\x18\x01 \x01(\v2\x19.seriallink.v1.PortConfigR\x06config\"'\n" +
+	"\vPingRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"I\n" +
+	"\fPingResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1f\n" +
+	"\vserver_time\x18\x02 \x01(\x03R\n" +
+	"serverTime\"\x15\n" +
+	"\x13GetAgentInfoRequest\"z\n" +
+	"\vAgentConfig\x12!\n" +
+	"\fgrpc_address\x18\x01 \x01(\tR\vgrpcAddress\x12\x1f\n" +
+	"\vtls_enabled\x18\x02 \x01(\bR\n" +
+	"tlsEnabled\x12'\n" +
+	"\x0fmax_connections\x18\x03 \x01(\rR\x0emaxConnections\"\x95\x02\n" +
+	"\tAgentInfo\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12!\n" +
+	"\fbuild_commit\x18\x02 \x01(\tR\vbuildCommit\x12\x1d\n" +
+	"\n" +
+	"build_date\x18\x03 \x01(\tR\tbuildDate\x12\x0e\n" +
+	"\x02os\x18\x04 \x01(\tR\x02os\x12\x12\n" +
+	"\x04arch\x18\x05 \x01(\tR\x04arch\x12%\n" +
+	"\x0euptime_seconds\x18\x06 \x01(\x03R\ruptimeSeconds\x12-\n" +
+	"\x12supported_features\x18\a \x03(\tR\x11supportedFeatures\x122\n" +
+	"\x06config\x18\b \x01(\v2\x1a.seriallink.v1.AgentConfigR\x06config\"D\n" +
+	"\x14GetAgentInfoResponse\x12,\n" +
+	"\x04info\x18\x01 \x01(\v2\x18.seriallink.v1.AgentInfoR\x04info\"\x17\n" +
+	"\x15GetMemoryStatsRequest\"\x83\x02\n" +
+	"\x12SessionMemoryStats\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tport_name\x18\x02 \x01(\tR\bportName\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12\x18\n" +
+	"\astreams\x18\x04 \x01(\rR\astreams\x12%\n" +
+	"\x0ebuffered_bytes\x18\x05 \x01(\x03R\rbufferedBytes\x12.\n" +
+	"\x13peak_buffered_bytes\x18\x06 \x01(\x03R\x11peakBufferedBytes\x12#\n" +
+	"\rdropped_bytes\x18\a \x01(\x04R\fdroppedBytes\"\xc9\x03\n" +
+	"\x16GetMemoryStatsResponse\x12,\n" +
+	"\x12global_limit_bytes\x18\x01 \x01(\x03R\x10globalLimitBytes\x12.\n" +
+	"\x13session_limit_bytes\x18\x02 \x01(\x03R\x11sessionLimitBytes\x12,\n" +
+	"\x12stream_limit_bytes\x18\x03 \x01(\x03R\x10streamLimitBytes\x12\x18\n" +
+	"\astreams\x18\x04 \x01(\rR\astreams\x12%\n" +
+	"\x0ebuffered_bytes\x18\x05 \x01(\x03R\rbufferedBytes\x12.\n" +
+	"\x13peak_buffered_bytes\x18\x06 \x01(\x03R\x11peakBufferedBytes\x12#\n" +
+	"\rdropped_bytes\x18\a \x01(\x04R\fdroppedBytes\x12(\n" +
+	"\x10heap_alloc_bytes\x18\b \x01(\x04R\x0eheapAllocBytes\x12$\n" +
+	"\x0eheap_sys_bytes\x18\t \x01(\x04R\fheapSysBytes\x12=\n" +
+	"\bsessions\x18\n" +
+	" \x03(\v2!.seriallink.v1.SessionMemoryStatsR\bsessions\"R\n" +
+	"\x16GetRecentOutputRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1b\n" +
+	"\tmax_bytes\x18\x02 \x01(\rR\bmaxBytes\"k\n" +
+	"\x17GetRecentOutputResponse\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x1f\n" +
+	"\vtotal_bytes\x18\x03 \x01(\x04R\n" +
+	"totalBytes\"j\n" +
+	"\x16GetRecentErrorsRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\"\x84\x01\n" +
+	"\vErrorRecord\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12\x1f\n" +
+	"\verror_class\x18\x03 \x01(\tR\n" +
+	"errorClass\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x89\x01\n" +
+	"\x17GetRecentErrorsResponse\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x122\n" +
+	"\x06errors\x18\x03 \x03(\v2\x1a.seriallink.v1.ErrorRecordR\x06errors\"\xa3\x01\n" +
+	"\x13DiagnoseLineRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"baud_rates\x18\x03 \x03(\rR\tbaudRates\x12\x1b\n" +
+	"\tsample_ms\x18\x04 \x01(\rR\bsampleMs\x12\x14\n" +
+	"\x05probe\x18\x05 \x01(\fR\x05probe\"\x97\x01\n" +
+	"\rLineCandidate\x121\n" +
+	"\x06config\x18\x01 \x01(\v2\x19.seriallink.v1.PortConfigR\x06config\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12%\n" +
+	"\x0ebytes_received\x18\x03 \x01(\x04R\rbytesReceived\x12\x16\n" +
+	"\x06sample\x18\x04 \x01(\fR\x06sample\"T\n" +
+	"\x14DiagnoseLineResponse\x12<\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2\x1c.seriallink.v1.LineCandidateR\n" +
+	"candidates\"\xad\x02\n" +
+	"\rVerifyRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x18\n" +
+	"\acommand\x18\x03 \x01(\fR\acommand\x12\x1a\n" +
+	"\bexpected\x18\x04 \x01(\fR\bexpected\x12)\n" +
+	"\x10expected_pattern\x18\x05 \x01(\tR\x0fexpectedPattern\x12\x1e\n" +
+	"\n" +
+	"terminator\x18\x06 \x01(\fR\n" +
+	"terminator\x12'\n" +
+	"\x0fresponse_length\x18\a \x01(\rR\x0eresponseLength\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\b \x01(\rR\ttimeoutMs\x12\x17\n" +
+	"\aidle_ms\x18\t \x01(\rR\x06idleMs\"r\n" +
+	"\x0eVerifyResponse\x12\x16\n" +
+	"\x06passed\x18\x01 \x01(\bR\x06passed\x12\x1a\n" +
+	"\bresponse\x18\x02 \x01(\fR\bresponse\x12\x12\n" +
+	"\x04diff\x18\x03 \x01(\tR\x04diff\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"[\n" +
+	"\tSyncWrite\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"e\n" +
+	"\x18SynchronizedWriteRequest\x120\n" +
+	"\x06writes\x18\x01 \x03(\v2\x18.seriallink.v1.SyncWriteR\x06writes\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\xc8\x01\n" +
+	"\x0fSyncWriteResult\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12#\n" +
+	"\rbytes_written\x18\x02 \x01(\rR\fbytesWritten\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\x03R\tstartedAt\x12!\n" +
+	"\fcompleted_at\x18\x04 \x01(\x03R\vcompletedAt\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1b\n" +
+	"\twire_data\x18\x06 \x01(\fR\bwireData\"\xa2\x01\n" +
+	"\x19SynchronizedWriteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
+	"\aresults\x18\x03 \x03(\v2\x1e.seriallink.v1.SyncWriteResultR\aresults\x12\x17\n" +
+	"\askew_ns\x18\x04 \x01(\x03R\x06skewNs\"L\n" +
+	"\x12ResetTargetRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x19\n" +
+	"\bpulse_ms\x18\x02 \x01(\rR\apulseMs\"I\n" +
+	"\x13ResetTargetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"2\n" +
+	"\x15ListBusDevicesRequest\x12\x19\n" +
+	"\bbus_type\x18\x01 \x01(\tR\abusType\"x\n" +
+	"\tBusDevice\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\bbus_type\x18\x02 \x01(\tR\abusType\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"L\n" +
+	"\x16ListBusDevicesResponse\x122\n" +
+	"\adevices\x18\x01 \x03(\v2\x18.seriallink.v1.BusDeviceR\adevices\"}\n" +
+	"\x12I2CTransferRequest\x12\x16\n" +
+	"\x06device\x18\x01 \x01(\tR\x06device\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\rR\aaddress\x12\x14\n" +
+	"\x05write\x18\x03 \x01(\fR\x05write\x12\x1f\n" +
+	"\vread_length\x18\x04 \x01(\rR\n" +
+	"readLength\")\n" +
+	"\x13I2CTransferResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\x93\x01\n" +
+	"\x12SPITransferRequest\x12\x16\n" +
+	"\x06device\x18\x01 \x01(\tR\x06device\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\rR\x04mode\x12\x19\n" +
+	"\bspeed_hz\x18\x04 \x01(\rR\aspeedHz\x12\"\n" +
+	"\rbits_per_word\x18\x05 \x01(\rR\vbitsPerWord\")\n" +
+	"\x13SPITransferResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\x8c\x01\n" +
+	"\x0eSendSMSRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x16\n" +
+	"\x06number\x18\x03 \x01(\tR\x06number\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\tR\x04mode\"c\n" +
+	"\x0fSendSMSResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\rR\treference\"\x99\x01\n" +
+	"\x0eReadSMSRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12\x1f\n" +
+	"\vunread_only\x18\x04 \x01(\bR\n" +
+	"unreadOnly\x12\x16\n" +
+	"\x06delete\x18\x05 \x01(\bR\x06delete\"\x84\x01\n" +
+	"\n" +
+	"SMSMessage\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x16\n" +
+	"\x06sender\x18\x03 \x01(\tR\x06sender\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\"H\n" +
+	"\x0fReadSMSResponse\x125\n" +
+	"\bmessages\x18\x01 \x03(\v2\x19.seriallink.v1.SMSMessageR\bmessages\"S\n" +
+	"\x15GetModemStatusRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"\x98\x01\n" +
+	"\x16GetModemStatusResponse\x12\x1f\n" +
+	"\vsignal_rssi\x18\x01 \x01(\rR\n" +
+	"signalRssi\x12\x1d\n" +
+	"\n" +
+	"signal_dbm\x18\x02 \x01(\x05R\tsignalDbm\x12\"\n" +
+	"\fregistration\x18\x03 \x01(\tR\fregistration\x12\x1a\n" +
+	"\boperator\x18\x04 \x01(\tR\boperator\"a\n" +
+	"\x11HandOffPPPRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x10\n" +
+	"\x03apn\x18\x03 \x01(\tR\x03apn\"i\n" +
+	"\x12HandOffPPPResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
+	"\vdial_string\x18\x03 \x01(\tR\n" +
+	"dialString\"\x8c\x02\n" +
+	"\x10PrintTextRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x12\n" +
+	"\x04bold\x18\x04 \x01(\bR\x04bold\x12\x1c\n" +
+	"\tunderline\x18\x05 \x01(\bR\tunderline\x12\x14\n" +
+	"\x05align\x18\x06 \x01(\tR\x05align\x12\x12\n" +
+	"\x04size\x18\a \x01(\rR\x04size\x12\x1b\n" +
+	"\tcode_page\x18\b \x01(\tR\bcodePage\x12\x1d\n" +
+	"\n" +
+	"feed_lines\x18\t \x01(\rR\tfeedLines\x12\x10\n" +
+	"\x03cut\x18\n" +
+	" \x01(\bR\x03cut\"\xbc\x01\n" +
+	"\x12PrintRasterRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\fR\x05image\x12\x14\n" +
+	"\x05width\x18\x04 \x01(\rR\x05width\x12\x16\n" +
+	"\x06dither\x18\x05 \x01(\bR\x06dither\x12\x14\n" +
+	"\x05align\x18\x06 \x01(\tR\x05align\x12\x10\n" +
+	"\x03cut\x18\a \x01(\bR\x03cut\"\x86\x01\n" +
+	"\x0fCutPaperRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x18\n" +
+	"\apartial\x18\x03 \x01(\bR\apartial\x12\x1d\n" +
+	"\n" +
+	"feed_lines\x18\x04 \x01(\rR\tfeedLines\"h\n" +
+	"\rPrintResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12#\n" +
+	"\rbytes_written\x18\x03 \x01(\rR\fbytesWritten\"U\n" +
+	"\x17GetPrinterStatusRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"\xe7\x01\n" +
+	"\x18GetPrinterStatusResponse\x12\x16\n" +
+	"\x06online\x18\x01 \x01(\bR\x06online\x12\x1d\n" +
+	"\n" +
+	"cover_open\x18\x02 \x01(\bR\tcoverOpen\x12$\n" +
+	"\x0epaper_near_end\x18\x03 \x01(\bR\fpaperNearEnd\x12\x1b\n" +
+	"\tpaper_out\x18\x04 \x01(\bR\bpaperOut\x12!\n" +
+	"\fcutter_error\x18\x05 \x01(\bR\vcutterError\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\bR\x05error\x12\x18\n" +
+	"\asummary\x18\a \x01(\tR\asummary\"\xfb\x01\n" +
+	"\x12StreamScansRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12 \n" +
+	"\vterminators\x18\x03 \x01(\tR\vterminators\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x12\x16\n" +
+	"\x06suffix\x18\x05 \x01(\tR\x06suffix\x12\x1f\n" +
+	"\vdebounce_ms\x18\x06 \x01(\rR\n" +
+	"debounceMs\x12\x17\n" +
+	"\aidle_ms\x18\a \x01(\rR\x06idleMs\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\b \x01(\rR\tminLength\"v\n" +
+	"\tScanEvent\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12\x1a\n" +
+	"\bsequence\x18\x04 \x01(\x04R\bsequence\"C\n" +
+	"\x13StreamScansResponse\x12,\n" +
+	"\x04scan\x18\x01 \x01(\v2\x18.seriallink.v1.ScanEventR\x04scan\"5\n" +
+	"\x19StreamPolledValuesRequest\x12\x18\n" +
+	"\apollers\x18\x01 \x03(\tR\apollers\"K\n" +
+	"\vPolledValue\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\"\xab\x01\n" +
+	"\fPolledSample\x12\x16\n" +
+	"\x06poller\x18\x01 \x01(\tR\x06poller\x12\x1b\n" +
+	"\tport_name\x18\x02 \x01(\tR\bportName\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x122\n" +
+	"\x06values\x18\x04 \x03(\v2\x1a.seriallink.v1.PolledValueR\x06values\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"Q\n" +
+	"\x1aStreamPolledValuesResponse\x123\n" +
+	"\x06sample\x18\x01 \x01(\v2\x1b.seriallink.v1.PolledSampleR\x06sample\"\xa0\x01\n" +
+	"\x13QueryHistoryRequest\x12\x16\n" +
+	"\x06poller\x18\x01 \x01(\tR\x06poller\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x04 \x01(\x03R\aendTime\x12!\n" +
+	"\fstep_seconds\x18\x05 \x01(\rR\vstepSeconds\"x\n" +
+	"\fHistoryPoint\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12\x10\n" +
+	"\x03min\x18\x02 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x03 \x01(\x01R\x03max\x12\x10\n" +
+	"\x03avg\x18\x04 \x01(\x01R\x03avg\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\rR\x05count\"r\n" +
+	"\rHistorySeries\x12\x16\n" +
+	"\x06poller\x18\x01 \x01(\tR\x06poller\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x123\n" +
+	"\x06points\x18\x03 \x03(\v2\x1b.seriallink.v1.HistoryPointR\x06points\"o\n" +
+	"\x14QueryHistoryResponse\x124\n" +
+	"\x06series\x18\x01 \x03(\v2\x1c.seriallink.v1.HistorySeriesR\x06series\x12!\n" +
+	"\fstep_seconds\x18\x02 \x01(\rR\vstepSeconds\"\xc6\x03\n" +
+	"\x05Alarm\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04rule\x18\x02 \x01(\tR\x04rule\x12\x16\n" +
+	"\x06poller\x18\x03 \x01(\tR\x06poller\x12\x14\n" +
+	"\x05field\x18\x04 \x01(\tR\x05field\x12\x1b\n" +
+	"\tport_name\x18\x05 \x01(\tR\bportName\x12\x1a\n" +
+	"\bseverity\x18\x06 \x01(\tR\bseverity\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\x12\x1c\n" +
+	"\tcondition\x18\b \x01(\tR\tcondition\x12\x1c\n" +
+	"\tthreshold\x18\t \x01(\x01R\tthreshold\x12\x14\n" +
+	"\x05value\x18\n" +
+	" \x01(\x01R\x05value\x12\x14\n" +
+	"\x05state\x18\v \x01(\tR\x05state\x12\x1b\n" +
+	"\traised_at\x18\f \x01(\x03R\braisedAt\x12\x1d\n" +
+	"\n" +
+	"cleared_at\x18\r \x01(\x03R\tclearedAt\x12\"\n" +
+	"\facknowledged\x18\x0e \x01(\bR\facknowledged\x12'\n" +
+	"\x0facknowledged_at\x18\x0f \x01(\x03R\x0eacknowledgedAt\x12'\n" +
+	"\x0facknowledged_by\x18\x10 \x01(\tR\x0eacknowledgedBy\"<\n" +
+	"\x11ListAlarmsRequest\x12'\n" +
+	"\x0finclude_history\x18\x01 \x01(\bR\x0eincludeHistory\"B\n" +
+	"\x12ListAlarmsResponse\x12,\n" +
+	"\x06alarms\x18\x01 \x03(\v2\x14.seriallink.v1.AlarmR\x06alarms\"]\n" +
+	"\x17AcknowledgeAlarmRequest\x12\x19\n" +
+	"\balarm_id\x18\x01 \x01(\tR\aalarmId\x12'\n" +
+	"\x0facknowledged_by\x18\x02 \x01(\tR\x0eacknowledgedBy\"F\n" +
+	"\x18AcknowledgeAlarmResponse\x12*\n" +
+	"\x05alarm\x18\x01 \x01(\v2\x14.seriallink.v1.AlarmR\x05alarm\"\xad\x01\n" +
+	"\vDeviceState\x12\x16\n" +
+	"\x06device\x18\x01 \x01(\tR\x06device\x12\x1b\n" +
+	"\tport_name\x18\x02 \x01(\tR\bportName\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12%\n" +
+	"\x0eprevious_state\x18\x04 \x01(\tR\rpreviousState\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05since\x18\x06 \x01(\x03R\x05since\"3\n" +
+	"\x17ListDeviceStatesRequest\x12\x18\n" +
+	"\adevices\x18\x01 \x03(\tR\adevices\"N\n" +
+	"\x18ListDeviceStatesResponse\x122\n" +
+	"\x06states\x18\x01 \x03(\v2\x1a.seriallink.v1.DeviceStateR\x06states\"5\n" +
+	"\x19StreamDeviceStatesRequest\x12\x18\n" +
+	"\adevices\x18\x01 \x03(\tR\adevices\"N\n" +
+	"\x1aStreamDeviceStatesResponse\x120\n" +
+	"\x05state\x18\x01 \x01(\v2\x1a.seriallink.v1.DeviceStateR\x05state\"\xfc\x01\n" +
+	"\fPendingWrite\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\tR\n" +
+	"approvalId\x12\x1b\n" +
+	"\tport_name\x18\x02 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12!\n" +
+	"\frequested_by\x18\x06 \x01(\tR\vrequestedBy\x12!\n" +
+	"\frequested_at\x18\a \x01(\x03R\vrequestedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\x03R\texpiresAt\"\x1a\n" +
+	"\x18ListPendingWritesRequest\"P\n" +
+	"\x19ListPendingWritesResponse\x123\n" +
+	"\x06writes\x18\x01 \x03(\v2\x1b.seriallink.v1.PendingWriteR\x06writes\"6\n" +
+	"\x13ApproveWriteRequest\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\tR\n" +
+	"approvalId\"\x88\x01\n" +
+	"\x14ApproveWriteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rbytes_written\x18\x02 \x01(\rR\fbytesWritten\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x17\n" +
+	"\asent_at\x18\x04 \x01(\x03R\x06sentAt\"5\n" +
+	"\x12RejectWriteRequest\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\tR\n" +
+	"approvalId\"H\n" +
+	"\x13RejectWriteResponse\x121\n" +
+	"\x05write\x18\x01 \x01(\v2\x1b.seriallink.v1.PendingWriteR\x05write\"i\n" +
+	"\tTestSuite\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\tR\x04port\x12\x1e\n" +
+	"\vrun_on_open\x18\x03 \x01(\bR\trunOnOpen\x12\x14\n" +
+	"\x05steps\x18\x04 \x03(\tR\x05steps\"\x17\n" +
+	"\x15ListTestSuitesRequest\"J\n" +
+	"\x16ListTestSuitesResponse\x120\n" +
+	"\x06suites\x18\x01 \x03(\v2\x18.seriallink.v1.TestSuiteR\x06suites\"g\n" +
+	"\x13RunTestSuiteRequest\x12\x14\n" +
+	"\x05suite\x18\x01 \x01(\tR\x05suite\x12\x1b\n" +
+	"\tport_name\x18\x02 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\"\xe6\x01\n" +
+	"\x0eTestStepResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12\x18\n" +
+	"\askipped\x18\x03 \x01(\bR\askipped\x12\x1a\n" +
+	"\bresponse\x18\x04 \x01(\fR\bresponse\x12\x19\n" +
+	"\x05value\x18\x05 \x01(\x01H\x00R\x05value\x88\x01\x01\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12\x12\n" +
+	"\x04diff\x18\a \x01(\tR\x04diff\x12\x1f\n" +
+	"\vduration_ms\x18\b \x01(\rR\n" +
+	"durationMsB\b\n" +
+	"\x06_value\"\x89\x02\n" +
+	"\n" +
+	"TestReport\x12\x14\n" +
+	"\x05suite\x18\x01 \x01(\tR\x05suite\x12\x1b\n" +
+	"\tport_name\x18\x02 \x01(\tR\bportName\x12\x18\n" +
+	"\atrigger\x18\x03 \x01(\tR\atrigger\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\x03R\tstartedAt\x12\x1f\n" +
+	"\vduration_ms\x18\x05 \x01(\rR\n" +
+	"durationMs\x12\x16\n" +
+	"\x06passed\x18\x06 \x01(\bR\x06passed\x123\n" +
+	"\x05steps\x18\a \x03(\v2\x1d.seriallink.v1.TestStepResultR\x05steps\x12!\n" +
+	"\freport_files\x18\b \x03(\tR\vreportFiles\"I\n" +
+	"\x14RunTestSuiteResponse\x121\n" +
+	"\x06report\x18\x01 \x01(\v2\x19.seriallink.v1.TestReportR\x06report\"\x8d\x02\n" +
+	"\vReservation\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x1b\n" +
+	"\tport_name\x18\x02 \x01(\tR\bportName\x12\x16\n" +
+	"\x06holder\x18\x03 \x01(\tR\x06holder\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x05 \x01(\x03R\aendTime\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x16\n" +
+	"\x06active\x18\t \x01(\bR\x06active\"\x9d\x01\n" +
+	"\x18CreateReservationRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x16\n" +
+	"\x06holder\x18\x02 \x01(\tR\x06holder\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x04 \x01(\x03R\aendTime\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"Y\n" +
+	"\x19CreateReservationResponse\x12<\n" +
+	"\vreservation\x18\x01 \x01(\v2\x1a.seriallink.v1.ReservationR\vreservation\"6\n" +
+	"\x17ListReservationsRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\"Z\n" +
+	"\x18ListReservationsResponse\x12>\n" +
+	"\freservations\x18\x01 \x03(\v2\x1a.seriallink.v1.ReservationR\freservations\"R\n" +
+	"\x18CancelReservationRequest\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationIdJ\x04\b\x02\x10\x03R\tclient_id\"Y\n" +
+	"\x19CancelReservationResponse\x12<\n" +
+	"\vreservation\x18\x01 \x01(\v2\x1a.seriallink.v1.ReservationR\vreservation\"\x88\x02\n" +
+	"\vUsageRecord\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x1b\n" +
+	"\tport_name\x18\x02 \x01(\tR\bportName\x12\x1a\n" +
+	"\bsessions\x18\x03 \x01(\x04R\bsessions\x12!\n" +
+	"\fopen_seconds\x18\x04 \x01(\x01R\vopenSeconds\x12\x1d\n" +
+	"\n" +
+	"bytes_sent\x18\x05 \x01(\x04R\tbytesSent\x12%\n" +
+	"\x0ebytes_received\x18\x06 \x01(\x04R\rbytesReceived\x12\x1d\n" +
+	"\n" +
+	"first_used\x18\a \x01(\x03R\tfirstUsed\x12\x1b\n" +
+	"\tlast_used\x18\b \x01(\x03R\blastUsed\"Q\n" +
+	"\x15GetUsageReportRequest\x12\x1b\n" +
+	"\tclient_id\x18\x01 \x01(\tR\bclientId\x12\x1b\n" +
+	"\tport_name\x18\x02 \x01(\tR\bportName\"\x87\x01\n" +
+	"\x16GetUsageReportResponse\x124\n" +
+	"\arecords\x18\x01 \x03(\v2\x1a.seriallink.v1.UsageRecordR\arecords\x12\x14\n" +
+	"\x05since\x18\x02 \x01(\x03R\x05since\x12!\n" +
+	"\fgenerated_at\x18\x03 \x01(\x03R\vgeneratedAt\"\xa8\x01\n" +
+	"\x14SetPowerStateRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12/\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x19.seriallink.v1.PowerStateR\x05state\x12#\n" +
+	"\rwake_patterns\x18\x04 \x03(\tR\fwakePatterns\"b\n" +
+	"\x15SetPowerStateResponse\x12/\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x19.seriallink.v1.PowerStateR\x05state\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"t\n" +
+	"\x17StreamPortStatusRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1f\n" +
+	"\vinterval_ms\x18\x02 \x01(\rR\n" +
+	"intervalMs\x12\x1b\n" +
+	"\ton_change\x18\x03 \x01(\bR\bonChange\"k\n" +
+	"\x18StreamPortStatusResponse\x121\n" +
+	"\x06status\x18\x01 \x01(\v2\x19.seriallink.v1.PortStatusR\x06status\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\"\x16\n" +
+	"\x14GetAgentStatsRequest\"\xf3\x03\n" +
+	"\x15GetAgentStatsResponse\x12\x1d\n" +
+	"\n" +
+	"open_ports\x18\x01 \x01(\rR\topenPorts\x12#\n" +
+	"\ropen_sessions\x18\x02 \x01(\rR\fopenSessions\x12'\n" +
+	"\x0fsessions_opened\x18\x03 \x01(\x04R\x0esessionsOpened\x12\x1d\n" +
+	"\n" +
+	"bytes_sent\x18\x04 \x01(\x04R\tbytesSent\x12%\n" +
+	"\x0ebytes_received\x18\x05 \x01(\x04R\rbytesReceived\x12\x16\n" +
+	"\x06errors\x18\x06 \x01(\x04R\x06errors\x12\x18\n" +
+	"\astreams\x18\a \x01(\rR\astreams\x12%\n" +
+	"\x0ebuffered_bytes\x18\b \x01(\x03R\rbufferedBytes\x12(\n" +
+	"\x10heap_alloc_bytes\x18\t \x01(\x04R\x0eheapAllocBytes\x12\x1b\n" +
+	"\tsys_bytes\x18\n" +
+	" \x01(\x04R\bsysBytes\x12\x1e\n" +
+	"\n" +
+	"goroutines\x18\v \x01(\rR\n" +
+	"goroutines\x12\x1f\n" +
+	"\vcpu_percent\x18\f \x01(\x01R\n" +
+	"cpuPercent\x12\x1f\n" +
+	"\vcpu_seconds\x18\r \x01(\x01R\n" +
+	"cpuSeconds\x12%\n" +
+	"\x0euptime_seconds\x18\x0e \x01(\x03R\ruptimeSeconds\"4\n" +
+	"\x18SetDebugEndpointsRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\"i\n" +
+	"\x19SetDebugEndpointsResponse\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"i\n" +
+	"\x0eBridgeEndpoint\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tbaud_rate\x18\x03 \x01(\rR\bbaudRate\"\xe7\x01\n" +
+	"\x12BridgePortsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12+\n" +
+	"\x01a\x18\x02 \x01(\v2\x1d.seriallink.v1.BridgeEndpointR\x01a\x12+\n" +
+	"\x01b\x18\x03 \x01(\v2\x1d.seriallink.v1.BridgeEndpointR\x01b\x12\x17\n" +
+	"\aone_way\x18\x04 \x01(\bR\x06oneWay\x12\x19\n" +
+	"\blog_data\x18\x05 \x01(\bR\alogData\x12/\n" +
+	"\x05rules\x18\x06 \x03(\v2\x19.seriallink.v1.BridgeRuleR\x05rules\"\x90\x02\n" +
+	"\x06Bridge\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x15\n" +
+	"\x06port_a\x18\x02 \x01(\tR\x05portA\x12\x15\n" +
+	"\x06port_b\x18\x03 \x01(\tR\x05portB\x12\x17\n" +
+	"\aone_way\x18\x04 \x01(\bR\x06oneWay\x12\x19\n" +
+	"\blog_data\x18\x05 \x01(\bR\alogData\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\x03R\tstartedAt\x12\x1f\n" +
+	"\fbytes_a_to_b\x18\a \x01(\x04R\tbytesAToB\x12\x1f\n" +
+	"\fbytes_b_to_a\x18\b \x01(\x04R\tbytesBToA\x12/\n" +
+	"\x05rules\x18\t \x03(\v2\x19.seriallink.v1.BridgeRuleR\x05rules\"D\n" +
+	"\x13BridgePortsResponse\x12-\n" +
+	"\x06bridge\x18\x01 \x01(\v2\x15.seriallink.v1.BridgeR\x06bridge\"\x14\n" +
+	"\x12ListBridgesRequest\"F\n" +
+	"\x13ListBridgesResponse\x12/\n" +
+	"\abridges\x18\x01 \x03(\v2\x15.seriallink.v1.BridgeR\abridges\"F\n" +
+	"\x11StopBridgeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"C\n" +
+	"\x12StopBridgeResponse\x12-\n" +
+	"\x06bridge\x18\x01 \x01(\v2\x15.seriallink.v1.BridgeR\x06bridge\"\xe0\x01\n" +
+	"\n" +
+	"BridgeRule\x12<\n" +
+	"\tdirection\x18\x01 \x01(\x0e2\x1e.seriallink.v1.BridgeDirectionR\tdirection\x12\x18\n" +
+	"\apattern\x18\x02 \x01(\tR\apattern\x127\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x1f.seriallink.v1.BridgeRuleActionR\x06action\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\x12\x19\n" +
+	"\bdelay_ms\x18\x05 \x01(\rR\adelayMs\x12\x12\n" +
+	"\x04hits\x18\x06 \x01(\x04R\x04hits\"{\n" +
+	"\x15SetBridgeRulesRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12/\n" +
+	"\x05rules\x18\x03 \x03(\v2\x19.seriallink.v1.BridgeRuleR\x05rules\"G\n" +
+	"\x16SetBridgeRulesResponse\x12-\n" +
+	"\x06bridge\x18\x01 \x01(\v2\x15.seriallink.v1.BridgeR\x06bridge\"\xdc\x01\n" +
+	"\x16StreamAnnotatedRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x125\n" +
+	"\adecoder\x18\x03 \x01(\x0e2\x1b.seriallink.v1.FrameDecoderR\adecoder\x12\x18\n" +
+	"\apattern\x18\x04 \x01(\tR\apattern\x12\x1e\n" +
+	"\n" +
+	"terminator\x18\x05 \x01(\fR\n" +
+	"terminator\x12\x15\n" +
+	"\x06gap_ms\x18\x06 \x01(\rR\x05gapMs\"6\n" +
+	"\n" +
+	"FrameField\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\xa9\x02\n" +
+	"\x0eAnnotatedFrame\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x125\n" +
+	"\adecoder\x18\x02 \x01(\x0e2\x1b.seriallink.v1.FrameDecoderR\adecoder\x12\x10\n" +
+	"\x03raw\x18\x03 \x01(\fR\x03raw\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\x12\x1a\n" +
+	"\bsequence\x18\x05 \x01(\x04R\bsequence\x12\x18\n" +
+	"\asummary\x18\x06 \x01(\tR\asummary\x121\n" +
+	"\x06fields\x18\a \x03(\v2\x19.seriallink.v1.FrameFieldR\x06fields\x12\x14\n" +
+	"\x05valid\x18\b \x01(\bR\x05valid\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"N\n" +
+	"\x17StreamAnnotatedResponse\x123\n" +
+	"\x05frame\x18\x01 \x01(\v2\x1d.seriallink.v1.AnnotatedFrameR\x05frame\"\x9a\x01\n" +
+	"\x10BandwidthShaping\x12'\n" +
+	"\x10rx_bytes_per_sec\x18\x01 \x01(\rR\rrxBytesPerSec\x12'\n" +
+	"\x10tx_bytes_per_sec\x18\x02 \x01(\rR\rtxBytesPerSec\x12\x19\n" +
+	"\brx_burst\x18\x03 \x01(\rR\arxBurst\x12\x19\n" +
+	"\btx_burst\x18\x04 \x01(\rR\atxBurst\"\x8a\x01\n" +
+	"\x11SetShapingRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x129\n" +
+	"\ashaping\x18\x03 \x01(\v2\x1f.seriallink.v1.BandwidthShapingR\ashaping\"\x83\x01\n" +
+	"\x12SetShapingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\ashaping\x18\x03 \x01(\v2\x1f.seriallink.v1.BandwidthShapingR\ashaping\"1\n" +
+	"\x12ListStreamsRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\"\xeb\x03\n" +
+	"\n" +
+	"StreamInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\tport_name\x18\x02 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tclient_id\x18\x04 \x01(\tR\bclientId\x12\x1a\n" +
+	"\bconsumer\x18\x05 \x01(\tR\bconsumer\x12:\n" +
+	"\bpriority\x18\x06 \x01(\x0e2\x1e.seriallink.v1.SessionPriorityR\bpriority\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\a \x01(\x03R\tstartedAt\x12)\n" +
+	"\x10delivered_chunks\x18\b \x01(\x04R\x0fdeliveredChunks\x12'\n" +
+	"\x0fdelivered_bytes\x18\t \x01(\x04R\x0edeliveredBytes\x12%\n" +
+	"\x0edropped_chunks\x18\n" +
+	" \x01(\x04R\rdroppedChunks\x12#\n" +
+	"\rdropped_bytes\x18\v \x01(\x04R\fdroppedBytes\x12#\n" +
+	"\rqueued_chunks\x18\f \x01(\rR\fqueuedChunks\x12!\n" +
+	"\fqueued_bytes\x18\r \x01(\x03R\vqueuedBytes\x12\x15\n" +
+	"\x06lag_ms\x18\x0e \x01(\x03R\x05lagMs\"J\n" +
+	"\x13ListStreamsResponse\x123\n" +
+	"\astreams\x18\x01 \x03(\v2\x19.seriallink.v1.StreamInfoR\astreams\"\xb9\x02\n" +
+	"\fPasteRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12\x1f\n" +
+	"\vline_ending\x18\x04 \x01(\tR\n" +
+	"lineEnding\x12%\n" +
+	"\x0eprompt_pattern\x18\x05 \x01(\tR\rpromptPattern\x12\x1b\n" +
+	"\twait_echo\x18\x06 \x01(\bR\bwaitEcho\x12\"\n" +
+	"\rline_delay_ms\x18\a \x01(\rR\vlineDelayMs\x12&\n" +
+	"\x0fline_timeout_ms\x18\b \x01(\rR\rlineTimeoutMs\x12(\n" +
+	"\x10skip_blank_lines\x18\t \x01(\bR\x0eskipBlankLines\"\x93\x01\n" +
+	"\rPasteResponse\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\rR\x04line\x12\x1f\n" +
+	"\vtotal_lines\x18\x02 \x01(\rR\n" +
+	"totalLines\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\fR\x04text\x12\x1a\n" +
+	"\bresponse\x18\x04 \x01(\fR\bresponse\x12\x1d\n" +
+	"\n" +
+	"elapsed_ms\x18\x05 \x01(\x03R\telapsedMs\"\xc9\x03\n" +
+	"\bGcodeJob\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x125\n" +
+	"\adialect\x18\x03 \x01(\x0e2\x1b.seriallink.v1.GcodeDialectR\adialect\x122\n" +
+	"\x05state\x18\x04 \x01(\x0e2\x1c.seriallink.v1.GcodeJobStateR\x05state\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1f\n" +
+	"\vtotal_lines\x18\x06 \x01(\rR\n" +
+	"totalLines\x12\x1d\n" +
+	"\n" +
+	"sent_lines\x18\a \x01(\rR\tsentLines\x12-\n" +
+	"\x12acknowledged_lines\x18\b \x01(\rR\x11acknowledgedLines\x12\x1a\n" +
+	"\bprogress\x18\t \x01(\x01R\bprogress\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\n" +
+	" \x01(\x03R\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\v \x01(\x03R\n" +
+	"finishedAt\x12\x1d\n" +
+	"\n" +
+	"elapsed_ms\x18\f \x01(\x03R\telapsedMs\x12!\n" +
+	"\fremaining_ms\x18\r \x01(\x03R\vremainingMs\"\x88\x02\n" +
+	"\x14StartGcodeJobRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x125\n" +
+	"\adialect\x18\x04 \x01(\x0e2\x1b.seriallink.v1.GcodeDialectR\adialect\x12\x18\n" +
+	"\aprogram\x18\x05 \x01(\fR\aprogram\x12.\n" +
+	"\x13response_timeout_ms\x18\x06 \x01(\rR\x11responseTimeoutMs\x12\x1f\n" +
+	"\vbuffer_size\x18\a \x01(\rR\n" +
+	"bufferSize\"B\n" +
+	"\x15StartGcodeJobResponse\x12)\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.seriallink.v1.GcodeJobR\x03job\"\x8b\x01\n" +
+	"\x16ControlGcodeJobRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x125\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x1d.seriallink.v1.GcodeJobActionR\x06action\"D\n" +
+	"\x17ControlGcodeJobResponse\x12)\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.seriallink.v1.GcodeJobR\x03job\"1\n" +
+	"\x12GetGcodeJobRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\"@\n" +
+	"\x13GetGcodeJobResponse\x12)\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.seriallink.v1.GcodeJobR\x03job\"U\n" +
+	"\x15StreamGcodeJobRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1f\n" +
+	"\vinterval_ms\x18\x02 \x01(\rR\n" +
+	"intervalMs\"C\n" +
+	"\x16StreamGcodeJobResponse\x12)\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.seriallink.v1.GcodeJobR\x03job\"^\n" +
+	"\x12MachineTemperature\x12\x16\n" +
+	"\x06sensor\x18\x01 \x01(\tR\x06sensor\x12\x18\n" +
+	"\acurrent\x18\x02 \x01(\x01R\acurrent\x12\x16\n" +
+	"\x06target\x18\x03 \x01(\x01R\x06target\"\x9a\x05\n" +
+	"\rMachineStatus\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x125\n" +
+	"\adialect\x18\x02 \x01(\x0e2\x1b.seriallink.v1.GcodeDialectR\adialect\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\\\n" +
+	"\x10machine_position\x18\x04 \x03(\v21.seriallink.v1.MachineStatus.MachinePositionEntryR\x0fmachinePosition\x12S\n" +
+	"\rwork_position\x18\x05 \x03(\v2..seriallink.v1.MachineStatus.WorkPositionEntryR\fworkPosition\x12E\n" +
+	"\ftemperatures\x18\x06 \x03(\v2!.seriallink.v1.MachineTemperatureR\ftemperatures\x12\x1b\n" +
+	"\tfeed_rate\x18\a \x01(\x01R\bfeedRate\x12#\n" +
+	"\rspindle_speed\x18\b \x01(\x01R\fspindleSpeed\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\x12)\n" +
+	"\x03job\x18\v \x01(\v2\x17.seriallink.v1.GcodeJobR\x03job\x1aB\n" +
+	"\x14MachinePositionEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a?\n" +
+	"\x11WorkPositionEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xd9\x01\n" +
+	"\x15ConnectMachineRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x125\n" +
+	"\adialect\x18\x03 \x01(\x0e2\x1b.seriallink.v1.GcodeDialectR\adialect\x12,\n" +
+	"\x12status_interval_ms\x18\x04 \x01(\rR\x10statusIntervalMs\x12\x1f\n" +
+	"\vbuffer_size\x18\x05 \x01(\rR\n" +
+	"bufferSize\"N\n" +
+	"\x16ConnectMachineResponse\x124\n" +
+	"\x06status\x18\x01 \x01(\v2\x1c.seriallink.v1.MachineStatusR\x06status\"V\n" +
+	"\x18DisconnectMachineRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"O\n" +
+	"\x19DisconnectMachineResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"w\n" +
+	"\x1aStreamMachineStatusRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1f\n" +
+	"\vinterval_ms\x18\x02 \x01(\rR\n" +
+	"intervalMs\x12\x1b\n" +
+	"\ton_change\x18\x03 \x01(\bR\bonChange\"q\n" +
+	"\x1bStreamMachineStatusResponse\x124\n" +
+	"\x06status\x18\x01 \x01(\v2\x1c.seriallink.v1.MachineStatusR\x06status\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\"\x96\x01\n" +
+	"\x11JogMachineRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\f\n" +
+	"\x01x\x18\x03 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x04 \x01(\x01R\x01y\x12\f\n" +
+	"\x01z\x18\x05 \x01(\x01R\x01z\x12\x1b\n" +
+	"\tfeed_rate\x18\x06 \x01(\x01R\bfeedRate\"J\n" +
+	"\x12JogMachineResponse\x124\n" +
+	"\x06status\x18\x01 \x01(\v2\x1c.seriallink.v1.MachineStatusR\x06status\"\x90\x01\n" +
+	"\x19SendMachineCommandRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x127\n" +
+	"\acommand\x18\x03 \x01(\x0e2\x1d.seriallink.v1.MachineCommandR\acommand\"R\n" +
+	"\x1aSendMachineCommandResponse\x124\n" +
+	"\x06status\x18\x01 \x01(\v2\x1c.seriallink.v1.MachineStatusR\x06status\"\xab\x01\n" +
+	"\x10ReadMeterRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\"\n" +
+	"\rmax_baud_rate\x18\x04 \x01(\rR\vmaxBaudRate\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x05 \x01(\rR\ttimeoutMs\"|\n" +
+	"\n" +
+	"MeterValue\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12\x16\n" +
+	"\x06number\x18\x04 \x01(\x01R\x06number\x12\x18\n" +
+	"\anumeric\x18\x05 \x01(\bR\anumeric\"\xc3\x01\n" +
+	"\x11ReadMeterResponse\x12\"\n" +
+	"\fmanufacturer\x18\x01 \x01(\tR\fmanufacturer\x12&\n" +
+	"\x0eidentification\x18\x02 \x01(\tR\x0eidentification\x12\x1b\n" +
+	"\tbaud_rate\x18\x03 \x01(\rR\bbaudRate\x121\n" +
+	"\x06values\x18\x04 \x03(\v2\x19.seriallink.v1.MeterValueR\x06values\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\"5\n" +
+	"\x16GetKeywordStatsRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\"L\n" +
+	"\rKeywordWindow\x12%\n" +
+	"\x0ewindow_seconds\x18\x01 \x01(\rR\rwindowSeconds\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\"v\n" +
+	"\fKeywordCount\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\x126\n" +
+	"\awindows\x18\x03 \x03(\v2\x1c.seriallink.v1.KeywordWindowR\awindows\"h\n" +
+	"\x10PortKeywordStats\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x127\n" +
+	"\bkeywords\x18\x02 \x03(\v2\x1b.seriallink.v1.KeywordCountR\bkeywords\"P\n" +
+	"\x17GetKeywordStatsResponse\x125\n" +
+	"\x05ports\x18\x01 \x03(\v2\x1f.seriallink.v1.PortKeywordStatsR\x05ports\"t\n" +
+	"\x15ListRecordingsRequest\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x12\x1b\n" +
+	"\tport_name\x18\x02 \x01(\tR\bportName\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\rR\x05limit\"\xe2\x01\n" +
+	"\tRecording\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04user\x18\x02 \x01(\tR\x04user\x12\x1b\n" +
+	"\tport_name\x18\x03 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x04 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\x03R\tstartedAt\x12\x1f\n" +
+	"\vduration_ms\x18\x06 \x01(\x04R\n" +
+	"durationMs\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\a \x01(\x04R\tsizeBytes\x12\x16\n" +
+	"\x06active\x18\b \x01(\bR\x06active\"R\n" +
+	"\x16ListRecordingsResponse\x128\n" +
+	"\n" +
+	"recordings\x18\x01 \x03(\v2\x18.seriallink.v1.RecordingR\n" +
+	"recordings\"'\n" +
+	"\x15FetchRecordingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\",\n" +
+	"\x16FetchRecordingResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"9\n" +
+	"\x1aGetPortCapabilitiesRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\"\x97\x03\n" +
+	"\x1bGetPortCapabilitiesResponse\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x16\n" +
+	"\x06driver\x18\x03 \x01(\tR\x06driver\x12\"\n" +
+	"\rmax_baud_rate\x18\x04 \x01(\x05R\vmaxBaudRate\x12\x1f\n" +
+	"\vlow_latency\x18\x05 \x01(\bR\n" +
+	"lowLatency\x12!\n" +
+	"\fread_polling\x18\x06 \x01(\bR\vreadPolling\x12\x1f\n" +
+	"\vcustom_baud\x18\a \x01(\bR\n" +
+	"customBaud\x12*\n" +
+	"\x11mark_space_parity\x18\b \x01(\bR\x0fmarkSpaceParity\x12!\n" +
+	"\fmodem_status\x18\t \x01(\bR\vmodemStatus\x12#\n" +
+	"\rcontrol_lines\x18\n" +
+	" \x01(\bR\fcontrolLines\x12\x14\n" +
+	"\x05break\x18\v \x01(\bR\x05break\x12\x14\n" +
+	"\x05rs485\x18\f \x01(\bR\x05rs485\"\xad\x02\n" +
+	"\x15UploadFirmwareRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1a\n" +
+	"\bfirmware\x18\x02 \x01(\fR\bfirmware\x12=\n" +
+	"\bprotocol\x18\x03 \x01(\x0e2!.seriallink.v1.BootloaderProtocolR\bprotocol\x12A\n" +
+	"\freset_method\x18\x04 \x01(\x0e2\x1e.seriallink.v1.BootloaderResetR\vresetMethod\x12\x1b\n" +
+	"\tbaud_rate\x18\x05 \x01(\rR\bbaudRate\x12\x1f\n" +
+	"\vskip_verify\x18\x06 \x01(\bR\n" +
+	"skipVerify\x12\x1b\n" +
+	"\tclient_id\x18\a \x01(\tR\bclientId\"\x96\x02\n" +
+	"\x16UploadFirmwareResponse\x120\n" +
+	"\x05stage\x18\x01 \x01(\x0e2\x1a.seriallink.v1.UploadStageR\x05stage\x12\x1b\n" +
+	"\tport_name\x18\x02 \x01(\tR\bportName\x12\x1d\n" +
+	"\n" +
+	"bytes_done\x18\x03 \x01(\rR\tbytesDone\x12\x1f\n" +
+	"\vbytes_total\x18\x04 \x01(\rR\n" +
+	"bytesTotal\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x16\n" +
+	"\x06device\x18\x06 \x01(\tR\x06device\x12\x1a\n" +
+	"\bverified\x18\a \x01(\bR\bverified\x12\x1f\n" +
+	"\vduration_ms\x18\b \x01(\x04R\n" +
+	"durationMs\"O\n" +
+	"\x13ListSessionsRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\"\x95\x04\n" +
+	"\vSessionInfo\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12(\n" +
+	"\x10short_session_id\x18\x02 \x01(\tR\x0eshortSessionId\x12\x1b\n" +
+	"\tport_name\x18\x03 \x01(\tR\bportName\x12\x1b\n" +
+	"\tclient_id\x18\x04 \x01(\tR\bclientId\x12\x14\n" +
+	"\x05owner\x18\x05 \x01(\tR\x05owner\x12\x1c\n" +
+	"\texclusive\x18\x06 \x01(\bR\texclusive\x12:\n" +
+	"\bpriority\x18\a \x01(\x0e2\x1e.seriallink.v1.SessionPriorityR\bpriority\x12:\n" +
+	"\vpower_state\x18\b \x01(\x0e2\x19.seriallink.v1.PowerStateR\n" +
+	"powerState\x12D\n" +
+	"\bmetadata\x18\t \x03(\v2(.seriallink.v1.SessionInfo.MetadataEntryR\bmetadata\x12=\n" +
+	"\n" +
+	"statistics\x18\n" +
+	" \x01(\v2\x1d.seriallink.v1.PortStatisticsR\n" +
+	"statistics\x12\x15\n" +
+	"\x06age_ms\x18\v \x01(\x03R\x05ageMs\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"N\n" +
+	"\x14ListSessionsResponse\x126\n" +
+	"\bsessions\x18\x01 \x03(\v2\x1a.seriallink.v1.SessionInfoR\bsessions\"\x8d\x01\n" +
+	"\x18CreateAccessTokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tport_name\x18\x02 \x01(\tR\bportName\x12\x1f\n" +
+	"\vallow_write\x18\x03 \x01(\bR\n" +
+	"allowWrite\x12\x1f\n" +
+	"\vttl_seconds\x18\x04 \x01(\rR\n" +
+	"ttlSeconds\"l\n" +
+	"\x19CreateAccessTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bidentity\x18\x02 \x01(\tR\bidentity\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\"{\n" +
+	"\x11ForceCloseRequest\x12\x1b\n" +
+	"\tport_name\x18\x01 \x01(\tR\bportName\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06notify\x18\x03 \x01(\bR\x06notify\x12\x19\n" +
+	"\bgrace_ms\x18\x04 \x01(\rR\agraceMs\"\x84\x01\n" +
+	"\x12ForceCloseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x03 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tclient_id\x18\x04 \x01(\tR\bclientId\"\x7f\n" +
+	"\x17CreateStreamLinkRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tport_name\x18\x02 \x01(\tR\bportName\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\rR\n" +
+	"ttlSeconds\x12\x12\n" +
+	"\x04once\x18\x04 \x01(\bR\x04once\"\xaf\x01\n" +
+	"\x18CreateStreamLinkResponse\x12\x1d\n" +
+	"\n" +
+	"events_url\x18\x01 \x01(\tR\teventsUrl\x12#\n" +
+	"\rwebsocket_url\x18\x02 \x01(\tR\fwebsocketUrl\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\x1a\n" +
+	"\bidentity\x18\x04 \x01(\tR\bidentity\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\x03R\texpiresAt*i\n" +
+	"\bDataBits\x12\x19\n" +
+	"\x15DATA_BITS_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vDATA_BITS_5\x10\x05\x12\x0f\n" +
+	"\vDATA_BITS_6\x10\x06\x12\x0f\n" +
+	"\vDATA_BITS_7\x10\a\x12\x0f\n" +
+	"\vDATA_BITS_8\x10\b*Z\n" +
+	"\bStopBits\x12\x19\n" +
+	"\x15STOP_BITS_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vSTOP_BITS_1\x10\x01\x12\x11\n" +
+	"\rSTOP_BITS_1_5\x10\x02\x12\x0f\n" +
+	"\vSTOP_BITS_2\x10\x03*u\n" +
+	"\x06Parity\x12\x16\n" +
+	"\x12PARITY_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vPARITY_NONE\x10\x01\x12\x0e\n" +
+	"\n" +
+	"PARITY_ODD\x10\x02\x12\x0f\n" +
+	"\vPARITY_EVEN\x10\x03\x12\x0f\n" +
+	"\vPARITY_MARK\x10\x04\x12\x10\n" +
+	"\fPARITY_SPACE\x10\x05*x\n" +
+	"\vFlowControl\x12\x1c\n" +
+	"\x18FLOW_CONTROL_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11FLOW_CONTROL_NONE\x10\x01\x12\x19\n" +
+	"\x15FLOW_CONTROL_HARDWARE\x10\x02\x12\x19\n" +
+	"\x15FLOW_CONTROL_SOFTWARE\x10\x03*g\n" +
+	"\x0eLatencyProfile\x12\x1f\n" +
+	"\x1bLATENCY_PROFILE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17LATENCY_PROFILE_DEFAULT\x10\x01\x12\x17\n" +
+	"\x13LATENCY_PROFILE_LOW\x10\x02*\x82\x01\n" +
+	"\tLineState\x12\x1a\n" +
+	"\x16LINE_STATE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12LINE_STATE_DEFAULT\x10\x01\x12\x13\n" +
+	"\x0fLINE_STATE_HIGH\x10\x02\x12\x12\n" +
+	"\x0eLINE_STATE_LOW\x10\x03\x12\x18\n" +
+	"\x14LINE_STATE_UNTOUCHED\x10\x04*\x8a\x01\n" +
+	"\x0fSessionPriority\x12 \n" +
+	"\x1cSESSION_PRIORITY_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SESSION_PRIORITY_BULK\x10\x01\x12\x1b\n" +
+	"\x17SESSION_PRIORITY_NORMAL\x10\x02\x12\x1d\n" +
+	"\x19SESSION_PRIORITY_CRITICAL\x10\x03*~\n" +
+	"\bPortType\x12\x19\n" +
+	"\x15PORT_TYPE_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rPORT_TYPE_USB\x10\x01\x12\x14\n" +
+	"\x10PORT_TYPE_NATIVE\x10\x02\x12\x17\n" +
+	"\x13PORT_TYPE_BLUETOOTH\x10\x03\x12\x15\n" +
+	"\x11PORT_TYPE_VIRTUAL\x10\x04*Z\n" +
+	"\n" +
+	"PowerState\x12\x1b\n" +
+	"\x17POWER_STATE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12POWER_STATE_ACTIVE\x10\x01\x12\x17\n" +
+	"\x13POWER_STATE_DORMANT\x10\x02*\x9f\x01\n" +
+	"\vCloseReason\x12\x1c\n" +
+	"\x18CLOSE_REASON_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13CLOSE_REASON_CLIENT\x10\x01\x12\x17\n" +
+	"\x13CLOSE_REASON_FORCED\x10\x02\x12\x1f\n" +
+	"\x1bCLOSE_REASON_DEVICE_REMOVED\x10\x03\x12\x1f\n" +
+	"\x1bCLOSE_REASON_AGENT_SHUTDOWN\x10\x04*f\n" +
+	"\x0fBridgeDirection\x12\x19\n" +
+	"\x15BRIDGE_DIRECTION_BOTH\x10\x00\x12\x1b\n" +
+	"\x17BRIDGE_DIRECTION_A_TO_B\x10\x01\x12\x1b\n" +
+	"\x17BRIDGE_DIRECTION_B_TO_A\x10\x02*\xb0\x01\n" +
+	"\x10BridgeRuleAction\x12\"\n" +
+	"\x1eBRIDGE_RULE_ACTION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17BRIDGE_RULE_ACTION_DROP\x10\x01\x12\x1e\n" +
+	"\x1aBRIDGE_RULE_ACTION_REPLACE\x10\x02\x12\x1c\n" +
+	"\x18BRIDGE_RULE_ACTION_DELAY\x10\x03\x12\x1d\n" +
+	"\x19BRIDGE_RULE_ACTION_INJECT\x10\x04*\xae\x01\n" +
+	"\fFrameDecoder\x12\x1d\n" +
+	"\x19FRAME_DECODER_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18FRAME_DECODER_MODBUS_RTU\x10\x01\x12\x16\n" +
+	"\x12FRAME_DECODER_NMEA\x10\x02\x12\x14\n" +
+	"\x10FRAME_DECODER_AT\x10\x03\x12\x18\n" +
+	"\x14FRAME_DECODER_CUSTOM\x10\x04\x12\x19\n" +
+	"\x15FRAME_DECODER_MEGATEC\x10\x05*_\n" +
+	"\fGcodeDialect\x12\x1d\n" +
+	"\x19GCODE_DIALECT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14GCODE_DIALECT_MARLIN\x10\x01\x12\x16\n" +
+	"\x12GCODE_DIALECT_GRBL\x10\x02*\xc3\x01\n" +
+	"\rGcodeJobState\x12\x1f\n" +
+	"\x1bGCODE_JOB_STATE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17GCODE_JOB_STATE_RUNNING\x10\x01\x12\x1a\n" +
+	"\x16GCODE_JOB_STATE_PAUSED\x10\x02\x12\x1d\n" +
+	"\x19GCODE_JOB_STATE_COMPLETED\x10\x03\x12\x1a\n" +
+	"\x16GCODE_JOB_STATE_FAILED\x10\x04\x12\x1d\n" +
+	"\x19GCODE_JOB_STATE_CANCELLED\x10\x05*\x88\x01\n" +
+	"\x0eGcodeJobAction\x12 \n" +
+	"\x1cGCODE_JOB_ACTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16GCODE_JOB_ACTION_PAUSE\x10\x01\x12\x1b\n" +
+	"\x17GCODE_JOB_ACTION_RESUME\x10\x02\x12\x1b\n" +
+	"\x17GCODE_JOB_ACTION_CANCEL\x10\x03*\x8c\x01\n" +
+	"\x0eMachineCommand\x12\x1f\n" +
+	"\x1bMACHINE_COMMAND_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19MACHINE_COMMAND_FEED_HOLD\x10\x01\x12\x1f\n" +
+	"\x1bMACHINE_COMMAND_CYCLE_START\x10\x02\x12\x19\n" +
+	"\x15MACHINE_COMMAND_RESET\x10\x03*\x90\x01\n" +
+	"\x0eStreamChunking\x12\x1f\n" +
+	"\x1bSTREAM_CHUNKING_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18STREAM_CHUNKING_ADAPTIVE\x10\x01\x12\x1f\n" +
+	"\x1bSTREAM_CHUNKING_LOW_LATENCY\x10\x02\x12\x1e\n" +
+	"\x1aSTREAM_CHUNKING_THROUGHPUT\x10\x03*{\n" +
+	"\x12BootloaderProtocol\x12#\n" +
+	"\x1fBOOTLOADER_PROTOCOL_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cBOOTLOADER_PROTOCOL_STK500V1\x10\x01\x12\x1e\n" +
+	"\x1aBOOTLOADER_PROTOCOL_AVR109\x10\x02*\x89\x01\n" +
+	"\x0fBootloaderReset\x12 \n" +
+	"\x1cBOOTLOADER_RESET_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14BOOTLOADER_RESET_DTR\x10\x01\x12\x1f\n" +
+	"\x1bBOOTLOADER_RESET_TOUCH_1200\x10\x02\x12\x19\n" +
+	"\x15BOOTLOADER_RESET_NONE\x10\x03*\xcb\x01\n" +
+	"\vUploadStage\x12\x1c\n" +
+	"\x18UPLOAD_STAGE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16UPLOAD_STAGE_RESETTING\x10\x01\x12\x1b\n" +
+	"\x17UPLOAD_STAGE_CONNECTING\x10\x02\x12\x18\n" +
+	"\x14UPLOAD_STAGE_ERASING\x10\x03\x12\x18\n" +
+	"\x14UPLOAD_STAGE_WRITING\x10\x04\x12\x1a\n" +
+	"\x16UPLOAD_STAGE_VERIFYING\x10\x05\x12\x15\n" +
+	"\x11UPLOAD_STAGE_DONE\x10\x062\x8c9\n" +
+	"\rSerialService\x12N\n" +
+	"\tListPorts\x12\x1f.seriallink.v1.ListPortsRequest\x1a .seriallink.v1.ListPortsResponse\x12T\n" +
+	"\vGetPortInfo\x12!.seriallink.v1.GetPortInfoRequest\x1a\".seriallink.v1.GetPortInfoResponse\x12l\n" +
+	"\x13GetPortCapabilities\x12).seriallink.v1.GetPortCapabilitiesRequest\x1a*.seriallink.v1.GetPortCapabilitiesResponse\x12K\n" +
+	"\bOpenPort\x12\x1e.seriallink.v1.OpenPortRequest\x1a\x1f.seriallink.v1.OpenPortResponse\x12N\n" +
+	"\tClosePort\x12\x1f.seriallink.v1.ClosePortRequest\x1a .seriallink.v1.ClosePortResponse\x12Z\n" +
+	"\rGetPortStatus\x12#.seriallink.v1.GetPortStatusRequest\x1a$.seriallink.v1.GetPortStatusResponse\x12B\n" +
+	"\x05Write\x12\x1b.seriallink.v1.WriteRequest\x1a\x1c.seriallink.v1.WriteResponse\x12?\n" +
+	"\x04Read\x12\x1a.seriallink.v1.ReadRequest\x1a\x1b.seriallink.v1.ReadResponse\x12S\n" +
+	"\n" +
+	"StreamRead\x12 .seriallink.v1.StreamReadRequest\x1a!.seriallink.v1.StreamReadResponse0\x01\x12b\n" +
+	"\x0fStreamTimedRead\x12%.seriallink.v1.StreamTimedReadRequest\x1a&.seriallink.v1.StreamTimedReadResponse0\x01\x12V\n" +
+	"\vStreamWrite\x12!.seriallink.v1.StreamWriteRequest\x1a\".seriallink.v1.StreamWriteResponse(\x01\x12p\n" +
+	"\x13BiDirectionalStream\x12).seriallink.v1.BiDirectionalStreamRequest\x1a*.seriallink.v1.BiDirectionalStreamResponse(\x010\x01\x12Z\n" +
+	"\rConfigurePort\x12#.seriallink.v1.ConfigurePortRequest\x1a$.seriallink.v1.ConfigurePortResponse\x12Z\n" +
+	"\rGetPortConfig\x12#.seriallink.v1.GetPortConfigRequest\x1a$.seriallink.v1.GetPortConfigResponse\x12?\n" +
+	"\x04Ping\x12\x1a.seriallink.v1.PingRequest\x1a\x1b.seriallink.v1.PingResponse\x12W\n" +
+	"\fGetAgentInfo\x12\".seriallink.v1.GetAgentInfoRequest\x1a#.seriallink.v1.GetAgentInfoResponse\x12]\n" +
+	"\x0eGetMemoryStats\x12$.seriallink.v1.GetMemoryStatsRequest\x1a%.seriallink.v1.GetMemoryStatsResponse\x12T\n" +
+	"\vListStreams\x12!.seriallink.v1.ListStreamsRequest\x1a\".seriallink.v1.ListStreamsResponse\x12W\n" +
+	"\fListSessions\x12\".seriallink.v1.ListSessionsRequest\x1a#.seriallink.v1.ListSessionsResponse\x12f\n" +
+	"\x11CreateAccessToken\x12'.seriallink.v1.CreateAccessTokenRequest\x1a(.seriallink.v1.CreateAccessTokenResponse\x12c\n" +
+	"\x10CreateStreamLink\x12&.seriallink.v1.CreateStreamLinkRequest\x1a'.seriallink.v1.CreateStreamLinkResponse\x12Q\n" +
+	"\n" +
+	"ForceClose\x12 .seriallink.v1.ForceCloseRequest\x1a!.seriallink.v1.ForceCloseResponse\x12`\n" +
+	"\x0fGetRecentOutput\x12%.seriallink.v1.GetRecentOutputRequest\x1a&.seriallink.v1.GetRecentOutputResponse\x12`\n" +
+	"\x0fGetKeywordStats\x12%.seriallink.v1.GetKeywordStatsRequest\x1a&.seriallink.v1.GetKeywordStatsResponse\x12]\n" +
+	"\x0eListRecordings\x12$.seriallink.v1.ListRecordingsRequest\x1a%.seriallink.v1.ListRecordingsResponse\x12_\n" +
+	"\x0eFetchRecording\x12$.seriallink.v1.FetchRecordingRequest\x1a%.seriallink.v1.FetchRecordingResponse0\x01\x12`\n" +
+	"\x0fGetRecentErrors\x12%.seriallink.v1.GetRecentErrorsRequest\x1a&.seriallink.v1.GetRecentErrorsResponse\x12W\n" +
+	"\fDiagnoseLine\x12\".seriallink.v1.DiagnoseLineRequest\x1a#.seriallink.v1.DiagnoseLineResponse\x12E\n" +
+	"\x06Verify\x12\x1c.seriallink.v1.VerifyRequest\x1a\x1d.seriallink.v1.VerifyResponse\x12N\n" +
+	"\tReadMeter\x12\x1f.seriallink.v1.ReadMeterRequest\x1a .seriallink.v1.ReadMeterResponse\x12]\n" +
+	"\x0eListTestSuites\x12$.seriallink.v1.ListTestSuitesRequest\x1a%.seriallink.v1.ListTestSuitesResponse\x12W\n" +
+	"\fRunTestSuite\x12\".seriallink.v1.RunTestSuiteRequest\x1a#.seriallink.v1.RunTestSuiteResponse\x12f\n" +
+	"\x11SynchronizedWrite\x12'.seriallink.v1.SynchronizedWriteRequest\x1a(.seriallink.v1.SynchronizedWriteResponse\x12T\n" +
+	"\vResetTarget\x12!.seriallink.v1.ResetTargetRequest\x1a\".seriallink.v1.ResetTargetResponse\x12]\n" +
+	"\x0eListBusDevices\x12$.seriallink.v1.ListBusDevicesRequest\x1a%.seriallink.v1.ListBusDevicesResponse\x12T\n" +
+	"\vI2CTransfer\x12!.seriallink.v1.I2CTransferRequest\x1a\".seriallink.v1.I2CTransferResponse\x12T\n" +
+	"\vSPITransfer\x12!.seriallink.v1.SPITransferRequest\x1a\".seriallink.v1.SPITransferResponse\x12H\n" +
+	"\aSendSMS\x12\x1d.seriallink.v1.SendSMSRequest\x1a\x1e.seriallink.v1.SendSMSResponse\x12H\n" +
+	"\aReadSMS\x12\x1d.seriallink.v1.ReadSMSRequest\x1a\x1e.seriallink.v1.ReadSMSResponse\x12]\n" +
+	"\x0eGetModemStatus\x12$.seriallink.v1.GetModemStatusRequest\x1a%.seriallink.v1.GetModemStatusResponse\x12Q\n" +
+	"\n" +
+	"HandOffPPP\x12 .seriallink.v1.HandOffPPPRequest\x1a!.seriallink.v1.HandOffPPPResponse\x12J\n" +
+	"\tPrintText\x12\x1f.seriallink.v1.PrintTextRequest\x1a\x1c.seriallink.v1.PrintResponse\x12V\n" +
+	"\vStreamScans\x12!.seriallink.v1.StreamScansRequest\x1a\".seriallink.v1.StreamScansResponse0\x01\x12b\n" +
+	"\x0fStreamAnnotated\x12%.seriallink.v1.StreamAnnotatedRequest\x1a&.seriallink.v1.StreamAnnotatedResponse0\x01\x12D\n" +
+	"\x05Paste\x12\x1b.seriallink.v1.PasteRequest\x1a\x1c.seriallink.v1.PasteResponse0\x01\x12k\n" +
+	"\x12StreamPolledValues\x12(.seriallink.v1.StreamPolledValuesRequest\x1a).seriallink.v1.StreamPolledValuesResponse0\x01\x12W\n" +
+	"\fQueryHistory\x12\".seriallink.v1.QueryHistoryRequest\x1a#.seriallink.v1.QueryHistoryResponse\x12Q\n" +
+	"\n" +
+	"ListAlarms\x12 .seriallink.v1.ListAlarmsRequest\x1a!.seriallink.v1.ListAlarmsResponse\x12c\n" +
+	"\x10AcknowledgeAlarm\x12&.seriallink.v1.AcknowledgeAlarmRequest\x1a'.seriallink.v1.AcknowledgeAlarmResponse\x12c\n" +
+	"\x10ListDeviceStates\x12&.seriallink.v1.ListDeviceStatesRequest\x1a'.seriallink.v1.ListDeviceStatesResponse\x12k\n" +
+	"\x12StreamDeviceStates\x12(.seriallink.v1.StreamDeviceStatesRequest\x1a).seriallink.v1.StreamDeviceStatesResponse0\x01\x12e\n" +
+	"\x10StreamPortStatus\x12&.seriallink.v1.StreamPortStatusRequest\x1a'.seriallink.v1.StreamPortStatusResponse0\x01\x12f\n" +
+	"\x11ListPendingWrites\x12'.seriallink.v1.ListPendingWritesRequest\x1a(.seriallink.v1.ListPendingWritesResponse\x12W\n" +
+	"\fApproveWrite\x12\".seriallink.v1.ApproveWriteRequest\x1a#.seriallink.v1.ApproveWriteResponse\x12T\n" +
+	"\vRejectWrite\x12!.seriallink.v1.RejectWriteRequest\x1a\".seriallink.v1.RejectWriteResponse\x12N\n" +
+	"\vPrintRaster\x12!.seriallink.v1.PrintRasterRequest\x1a\x1c.seriallink.v1.PrintResponse\x12H\n" +
+	"\bCutPaper\x12\x1e.seriallink.v1.CutPaperRequest\x1a\x1c.seriallink.v1.PrintResponse\x12c\n" +
+	"\x10GetPrinterStatus\x12&.seriallink.v1.GetPrinterStatusRequest\x1a'.seriallink.v1.GetPrinterStatusResponse\x12f\n" +
+	"\x11CreateReservation\x12'.seriallink.v1.CreateReservationRequest\x1a(.seriallink.v1.CreateReservationResponse\x12c\n" +
+	"\x10ListReservations\x12&.seriallink.v1.ListReservationsRequest\x1a'.seriallink.v1.ListReservationsResponse\x12f\n" +
+	"\x11CancelReservation\x12'.seriallink.v1.CancelReservationRequest\x1a(.seriallink.v1.CancelReservationResponse\x12]\n" +
+	"\x0eGetUsageReport\x12$.seriallink.v1.GetUsageReportRequest\x1a%.seriallink.v1.GetUsageReportResponse\x12Z\n" +
+	"\rSetPowerState\x12#.seriallink.v1.SetPowerStateRequest\x1a$.seriallink.v1.SetPowerStateResponse\x12Q\n" +
+	"\n" +
+	"SetShaping\x12 .seriallink.v1.SetShapingRequest\x1a!.seriallink.v1.SetShapingResponse\x12Z\n" +
+	"\rGetAgentStats\x12#.seriallink.v1.GetAgentStatsRequest\x1a$.seriallink.v1.GetAgentStatsResponse\x12f\n" +
+	"\x11SetDebugEndpoints\x12'.seriallink.v1.SetDebugEndpointsRequest\x1a(.seriallink.v1.SetDebugEndpointsResponse\x12T\n" +
+	"\vBridgePorts\x12!.seriallink.v1.BridgePortsRequest\x1a\".seriallink.v1.BridgePortsResponse\x12T\n" +
+	"\vListBridges\x12!.seriallink.v1.ListBridgesRequest\x1a\".seriallink.v1.ListBridgesResponse\x12Q\n" +
+	"\n" +
+	"StopBridge\x12 .seriallink.v1.StopBridgeRequest\x1a!.seriallink.v1.StopBridgeResponse\x12]\n" +
+	"\x0eSetBridgeRules\x12$.seriallink.v1.SetBridgeRulesRequest\x1a%.seriallink.v1.SetBridgeRulesResponse\x12Z\n" +
+	"\rStartGcodeJob\x12#.seriallink.v1.StartGcodeJobRequest\x1a$.seriallink.v1.StartGcodeJobResponse\x12`\n" +
+	"\x0fControlGcodeJob\x12%.seriallink.v1.ControlGcodeJobRequest\x1a&.seriallink.v1.ControlGcodeJobResponse\x12T\n" +
+	"\vGetGcodeJob\x12!.seriallink.v1.GetGcodeJobRequest\x1a\".seriallink.v1.GetGcodeJobResponse\x12_\n" +
+	"\x0eStreamGcodeJob\x12$.seriallink.v1.StreamGcodeJobRequest\x1a%.seriallink.v1.StreamGcodeJobResponse0\x01\x12]\n" +
+	"\x0eConnectMachine\x12$.seriallink.v1.ConnectMachineRequest\x1a%.seriallink.v1.ConnectMachineResponse\x12f\n" +
+	"\x11DisconnectMachine\x12'.seriallink.v1.DisconnectMachineRequest\x1a(.seriallink.v1.DisconnectMachineResponse\x12n\n" +
+	"\x13StreamMachineStatus\x12).seriallink.v1.StreamMachineStatusRequest\x1a*.seriallink.v1.StreamMachineStatusResponse0\x01\x12Q\n" +
+	"\n" +
+	"JogMachine\x12 .seriallink.v1.JogMachineRequest\x1a!.seriallink.v1.JogMachineResponse\x12i\n" +
+	"\x12SendMachineCommand\x12(.seriallink.v1.SendMachineCommandRequest\x1a).seriallink.v1.SendMachineCommandResponse\x12_\n" +
+	"\x0eUploadFirmware\x12$.seriallink.v1.UploadFirmwareRequest\x1a%.seriallink.v1.UploadFirmwareResponse0\x01BJZHgithub.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1;seriallinkv1b\x06proto3"
+
+var (
+	file_seriallink_v1_serial_proto_rawDescOnce sync.Once
+	file_seriallink_v1_serial_proto_rawDescData []byte
+)
+
+func file_seriallink_v1_serial_proto_rawDescGZIP() []byte {
+	file_seriallink_v1_serial_proto_rawDescOnce.Do(func() {
+		file_seriallink_v1_serial_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_seriallink_v1_serial_proto_rawDesc), len(file_seriallink_v1_serial_proto_rawDesc)))
+	})
+	return file_seriallink_v1_serial_proto_rawDescData
+}
+
+var file_seriallink_v1_serial_proto_enumTypes = make([]protoimpl.EnumInfo, 21)
+var file_seriallink_v1_serial_proto_msgTypes = make([]protoimpl.MessageInfo, 209)
+var file_seriallink_v1_serial_proto_goTypes = []any{
+	(DataBits)(0),                       // 0: seriallink.v1.DataBits
+	(StopBits)(0),                       // 1: seriallink.v1.StopBits
+	(Parity)(0),                         // 2: seriallink.v1.Parity
+	(FlowControl)(0),                    // 3: seriallink.v1.FlowControl
+	(LatencyProfile)(0),                 // 4: seriallink.v1.LatencyProfile
+	(LineState)(0),                      // 5: seriallink.v1.LineState
+	(SessionPriority)(0),                // 6: seriallink.v1.SessionPriority
+	(PortType)(0),                       // 7: seriallink.v1.PortType
+	(PowerState)(0),                     // 8: seriallink.v1.PowerState
+	(CloseReason)(0),                    // 9: seriallink.v1.CloseReason
+	(BridgeDirection)(0),                // 10: seriallink.v1.BridgeDirection
+	(BridgeRuleAction)(0),               // 11: seriallink.v1.BridgeRuleAction
+	(FrameDecoder)(0),                   // 12: seriallink.v1.FrameDecoder
+	(GcodeDialect)(0),                   // 13: seriallink.v1.GcodeDialect
+	(GcodeJobState)(0),                  // 14: seriallink.v1.GcodeJobState
+	(GcodeJobAction)(0),                 // 15: seriallink.v1.GcodeJobAction
+	(MachineCommand)(0),                 // 16: seriallink.v1.MachineCommand
+	(StreamChunking)(0),                 // 17: seriallink.v1.StreamChunking
+	(BootloaderProtocol)(0),             // 18: seriallink.v1.BootloaderProtocol
+	(BootloaderReset)(0),                // 19: seriallink.v1.BootloaderReset
+	(UploadStage)(0),                    // 20: seriallink.v1.UploadStage
+	(*PortConfig)(nil),                  // 21: seriallink.v1.PortConfig
+	(*PortInfo)(nil),                    // 22: seriallink.v1.PortInfo
+	(*PortStatistics)(nil),              // 23: seriallink.v1.PortStatistics
+	(*PortStatus)(nil),                  // 24: seriallink.v1.PortStatus
+	(*ListPortsRequest)(nil),            // 25: seriallink.v1.ListPortsRequest
+	(*ListPortsResponse)(nil),           // 26: seriallink.v1.ListPortsResponse
+	(*GetPortInfoRequest)(nil),          // 27: seriallink.v1.GetPortInfoRequest
+	(*GetPortInfoResponse)(nil),         // 28: seriallink.v1.GetPortInfoResponse
+	(*OpenPortRequest)(nil),             // 29: seriallink.v1.OpenPortRequest
+	(*InitStep)(nil),                    // 30: seriallink.v1.InitStep
+	(*OpenPortResponse)(nil),            // 31: seriallink.v1.OpenPortResponse
+	(*ClosePortRequest)(nil),            // 32: seriallink.v1.ClosePortRequest
+	(*ClosePortResponse)(nil),           // 33: seriallink.v1.ClosePortResponse
+	(*GetPortStatusRequest)(nil),        // 34: seriallink.v1.GetPortStatusRequest
+	(*GetPortStatusResponse)(nil),       // 35: seriallink.v1.GetPortStatusResponse
+	(*WriteRequest)(nil),                // 36: seriallink.v1.WriteRequest
+	(*WriteResponse)(nil),               // 37: seriallink.v1.WriteResponse
+	(*ReadRequest)(nil),                 // 38: seriallink.v1.ReadRequest
+	(*ReadResponse)(nil),                // 39: seriallink.v1.ReadResponse
+	(*DataChunk)(nil),                   // 40: seriallink.v1.DataChunk
+	(*StreamReadRequest)(nil),           // 41: seriallink.v1.StreamReadRequest
+	(*StreamFilter)(nil),                // 42: seriallink.v1.StreamFilter
+	(*StreamReadResponse)(nil),          // 43: seriallink.v1.StreamReadResponse
+	(*StreamTimedReadRequest)(nil),      // 44: seriallink.v1.StreamTimedReadRequest
+	(*TimedChunk)(nil),                  // 45: seriallink.v1.TimedChunk
+	(*StreamTimedReadResponse)(nil),     // 46: seriallink.v1.StreamTimedReadResponse
+	(*StreamWriteRequest)(nil),          // 47: seriallink.v1.StreamWriteRequest
+	(*StreamWriteResponse)(nil),         // 48: seriallink.v1.StreamWriteResponse
+	(*BiDirectionalStreamRequest)(nil),  // 49: seriallink.v1.BiDirectionalStreamRequest
+	(*BiDirectionalStreamResponse)(nil), // 50: seriallink.v1.BiDirectionalStreamResponse
+	(*ConfigurePortRequest)(nil),        // 51: seriallink.v1.ConfigurePortRequest
+	(*ConfigurePortResponse)(nil),       // 52: seriallink.v1.ConfigurePortResponse
+	(*GetPortConfigRequest)(nil),        // 53: seriallink.v1.GetPortConfigRequest
+	(*GetPortConfigResponse)(nil),       // 54: seriallink.v1.GetPortConfigResponse
+	(*PingRequest)(nil),                 // 55: seriallink.v1.PingRequest
+	(*PingResponse)(nil),                // 56: seriallink.v1.PingResponse
+	(*GetAgentInfoRequest)(nil),         // 57: seriallink.v1.GetAgentInfoRequest
+	(*AgentConfig)(nil),                 // 58: seriallink.v1.AgentConfig
+	(*AgentInfo)(nil),                   // 59: seriallink.v1.AgentInfo
+	(*GetAgentInfoResponse)(nil),        // 60: seriallink.v1.GetAgentInfoResponse
+	(*GetMemoryStatsRequest)(nil),       // 61: seriallink.v1.GetMemoryStatsRequest
+	(*SessionMemoryStats)(nil),          // 62: seriallink.v1.SessionMemoryStats
+	(*GetMemoryStatsResponse)(nil),      // 63: seriallink.v1.GetMemoryStatsResponse
+	(*GetRecentOutputRequest)(nil),      // 64: seriallink.v1.GetRecentOutputRequest
+	(*GetRecentOutputResponse)(nil),     // 65: seriallink.v1.GetRecentOutputResponse
+	(*GetRecentErrorsRequest)(nil),      // 66: seriallink.v1.GetRecentErrorsRequest
+	(*ErrorRecord)(nil),                 // 67: seriallink.v1.ErrorRecord
+	(*GetRecentErrorsResponse)(nil),     // 68: seriallink.v1.GetRecentErrorsResponse
+	(*DiagnoseLineRequest)(nil),         // 69: seriallink.v1.DiagnoseLineRequest
+	(*LineCandidate)(nil),               // 70: seriallink.v1.LineCandidate
+	(*DiagnoseLineResponse)(nil),        // 71: seriallink.v1.DiagnoseLineResponse
+	(*VerifyRequest)(nil),               // 72: seriallink.v1.VerifyRequest
+	(*VerifyResponse)(nil),              // 73: seriallink.v1.VerifyResponse
+	(*SyncWrite)(nil),                   // 74: seriallink.v1.SyncWrite
+	(*SynchronizedWriteRequest)(nil),    // 75: seriallink.v1.SynchronizedWriteRequest
+	(*SyncWriteResult)(nil),             // 76: seriallink.v1.SyncWriteResult
+	(*SynchronizedWriteResponse)(nil),   // 77: seriallink.v1.SynchronizedWriteResponse
+	(*ResetTargetRequest)(nil),          // 78: seriallink.v1.ResetTargetRequest
+	(*ResetTargetResponse)(nil),         // 79: seriallink.v1.ResetTargetResponse
+	(*ListBusDevicesRequest)(nil),       // 80: seriallink.v1.ListBusDevicesRequest
+	(*BusDevice)(nil),                   // 81: seriallink.v1.BusDevice
+	(*ListBusDevicesResponse)(nil),      // 82: seriallink.v1.ListBusDevicesResponse
+	(*I2CTransferRequest)(nil),          // 83: seriallink.v1.I2CTransferRequest
+	(*I2CTransferResponse)(nil),         // 84: seriallink.v1.I2CTransferResponse
+	(*SPITransferRequest)(nil),          // 85: seriallink.v1.SPITransferRequest
+	(*SPITransferResponse)(nil),         // 86: seriallink.v1.SPITransferResponse
+	(*SendSMSRequest)(nil),              // 87: seriallink.v1.SendSMSRequest
+	(*SendSMSResponse)(nil),             // 88: seriallink.v1.SendSMSResponse
+	(*ReadSMSRequest)(nil),              // 89: seriallink.v1.ReadSMSRequest
+	(*SMSMessage)(nil),                  // 90: seriallink.v1.SMSMessage
+	(*ReadSMSResponse)(nil),             // 91: seriallink.v1.ReadSMSResponse
+	(*GetModemStatusRequest)(nil),       // 92: seriallink.v1.GetModemStatusRequest
+	(*GetModemStatusResponse)(nil),      // 93: seriallink.v1.GetModemStatusResponse
+	(*HandOffPPPRequest)(nil),           // 94: seriallink.v1.HandOffPPPRequest
+	(*HandOffPPPResponse)(nil),          // 95: seriallink.v1.HandOffPPPResponse
+	(*PrintTextRequest)(nil),            // 96: seriallink.v1.PrintTextRequest
+	(*PrintRasterRequest)(nil),          // 97: seriallink.v1.PrintRasterRequest
+	(*CutPaperRequest)(nil),             // 98: seriallink.v1.CutPaperRequest
+	(*PrintResponse)(nil),               // 99: seriallink.v1.PrintResponse
+	(*GetPrinterStatusRequest)(nil),     // 100: seriallink.v1.GetPrinterStatusRequest
+	(*GetPrinterStatusResponse)(nil),    // 101: seriallink.v1.GetPrinterStatusResponse
+	(*StreamScansRequest)(nil),          // 102: seriallink.v1.StreamScansRequest
+	(*ScanEvent)(nil),                   // 103: seriallink.v1.ScanEvent
+	(*StreamScansResponse)(nil),         // 104: seriallink.v1.StreamScansResponse
+	(*StreamPolledValuesRequest)(nil),   // 105: seriallink.v1.StreamPolledValuesRequest
+	(*PolledValue)(nil),                 // 106: seriallink.v1.PolledValue
+	(*PolledSample)(nil),                // 107: seriallink.v1.PolledSample
+	(*StreamPolledValuesResponse)(nil),  // 108: seriallink.v1.StreamPolledValuesResponse
+	(*QueryHistoryRequest)(nil),         // 109: seriallink.v1.QueryHistoryRequest
+	(*HistoryPoint)(nil),                // 110: seriallink.v1.HistoryPoint
+	(*HistorySeries)(nil),               // 111: seriallink.v1.HistorySeries
+	(*QueryHistoryResponse)(nil),        // 112: seriallink.v1.QueryHistoryResponse
+	(*Alarm)(nil),                       // 113: seriallink.v1.Alarm
+	(*ListAlarmsRequest)(nil),           // 114: seriallink.v1.ListAlarmsRequest
+	(*ListAlarmsResponse)(nil),          // 115: seriallink.v1.ListAlarmsResponse
+	(*AcknowledgeAlarmRequest)(nil),     // 116: seriallink.v1.AcknowledgeAlarmRequest
+	(*AcknowledgeAlarmResponse)(nil),    // 117: seriallink.v1.AcknowledgeAlarmResponse
+	(*DeviceState)(nil),                 // 118: seriallink.v1.DeviceState
+	(*ListDeviceStatesRequest)(nil),     // 119: seriallink.v1.ListDeviceStatesRequest
+	(*ListDeviceStatesResponse)(nil),    // 120: seriallink.v1.ListDeviceStatesResponse
+	(*StreamDeviceStatesRequest)(nil),   // 121: seriallink.v1.StreamDeviceStatesRequest
+	(*StreamDeviceStatesResponse)(nil),  // 122: seriallink.v1.StreamDeviceStatesResponse
+	(*PendingWrite)(nil),                // 123: seriallink.v1.PendingWrite
+	(*ListPendingWritesRequest)(nil),    // 124: seriallink.v1.ListPendingWritesRequest
+	(*ListPendingWritesResponse)(nil),   // 125: seriallink.v1.ListPendingWritesResponse
+	(*ApproveWriteRequest)(nil),         // 126: seriallink.v1.ApproveWriteRequest
+	(*ApproveWriteResponse)(nil),        // 127: seriallink.v1.ApproveWriteResponse
+	(*RejectWriteRequest)(nil),          // 128: seriallink.v1.RejectWriteRequest
+	(*RejectWriteResponse)(nil),         // 129: seriallink.v1.RejectWriteResponse
+	(*TestSuite)(nil),                   // 130: seriallink.v1.TestSuite
+	(*ListTestSuitesRequest)(nil),       // 131: seriallink.v1.ListTestSuitesRequest
+	(*ListTestSuitesResponse)(nil),      // 132: seriallink.v1.ListTestSuitesResponse
+	(*RunTestSuiteRequest)(nil),         // 133: seriallink.v1.RunTestSuiteRequest
+	(*TestStepResult)(nil),              // 134: seriallink.v1.TestStepResult
+	(*TestReport)(nil),                  // 135: seriallink.v1.TestReport
+	(*RunTestSuiteResponse)(nil),        // 136: seriallink.v1.RunTestSuiteResponse
+	(*Reservation)(nil),                 // 137: seriallink.v1.Reservation
+	(*CreateReservationRequest)(nil),    // 138: seriallink.v1.CreateReservationRequest
+	(*CreateReservationResponse)(nil),   // 139: seriallink.v1.CreateReservationResponse
+	(*ListReservationsRequest)(nil),     // 140: seriallink.v1.ListReservationsRequest
+	(*ListReservationsResponse)(nil),    // 141: seriallink.v1.ListReservationsResponse
+	(*CancelReservationRequest)(nil),    // 142: seriallink.v1.CancelReservationRequest
+	(*CancelReservationResponse)(nil),   // 143: seriallink.v1.CancelReservationResponse
+	(*UsageRecord)(nil),                 // 144: seriallink.v1.UsageRecord
+	(*GetUsageReportRequest)(nil),       // 145: seriallink.v1.GetUsageReportRequest
+	(*GetUsageReportResponse)(nil),      // 146: seriallink.v1.GetUsageReportResponse
+	(*SetPowerStateRequest)(nil),        // 147: seriallink.v1.SetPowerStateRequest
+	(*SetPowerStateResponse)(nil),       // 148: seriallink.v1.SetPowerStateResponse
+	(*StreamPortStatusRequest)(nil),     // 149: seriallink.v1.StreamPortStatusRequest
+	(*StreamPortStatusResponse)(nil),    // 150: seriallink.v1.StreamPortStatusResponse
+	(*GetAgentStatsRequest)(nil),        // 151: seriallink.v1.GetAgentStatsRequest
+	(*GetAgentStatsResponse)(nil),       // 152: seriallink.v1.GetAgentStatsResponse
+	(*SetDebugEndpointsRequest)(nil),    // 153: seriallink.v1.SetDebugEndpointsRequest
+	(*SetDebugEndpointsResponse)(nil),   // 154: seriallink.v1.SetDebugEndpointsResponse
+	(*BridgeEndpoint)(nil),              // 155: seriallink.v1.BridgeEndpoint
+	(*BridgePortsRequest)(nil),          // 156: seriallink.v1.BridgePortsRequest
+	(*Bridge)(nil),                      // 157: seriallink.v1.Bridge
+	(*BridgePortsResponse)(nil),         // 158: seriallink.v1.BridgePortsResponse
+	(*ListBridgesRequest)(nil),          // 159: seriallink.v1.ListBridgesRequest
+	(*ListBridgesResponse)(nil),         // 160: seriallink.v1.ListBridgesResponse
+	(*StopBridgeRequest)(nil),           // 161: seriallink.v1.StopBridgeRequest
+	(*StopBridgeResponse)(nil),          // 162: seriallink.v1.StopBridgeResponse
+	(*BridgeRule)(nil),                  // 163: seriallink.v1.BridgeRule
+	(*SetBridgeRulesRequest)(nil),       // 164: seriallink.v1.SetBridgeRulesRequest
+	(*SetBridgeRulesResponse)(nil),      // 165: seriallink.v1.SetBridgeRulesResponse
+	(*StreamAnnotatedRequest)(nil),      // 166: seriallink.v1.StreamAnnotatedRequest
+	(*FrameField)(nil),                  // 167: seriallink.v1.FrameField
+	(*AnnotatedFrame)(nil),              // 168: seriallink.v1.AnnotatedFrame
+	(*StreamAnnotatedResponse)(nil),     // 169: seriallink.v1.StreamAnnotatedResponse
+	(*BandwidthShaping)(nil),            // 170: seriallink.v1.BandwidthShaping
+	(*SetShapingRequest)(nil),           // 171: seriallink.v1.SetShapingRequest
+	(*SetShapingResponse)(nil),          // 172: seriallink.v1.SetShapingResponse
+	(*ListStreamsRequest)(nil),          // 173: seriallink.v1.ListStreamsRequest
+	(*StreamInfo)(nil),                  // 174: seriallink.v1.StreamInfo
+	(*ListStreamsResponse)(nil),         // 175: seriallink.v1.ListStreamsResponse
+	(*PasteRequest)(nil),                // 176: seriallink.v1.PasteRequest
+	(*PasteResponse)(nil),               // 177: seriallink.v1.PasteResponse
+	(*GcodeJob)(nil),                    // 178: seriallink.v1.GcodeJob
+	(*StartGcodeJobRequest)(nil),        // 179: seriallink.v1.StartGcodeJobRequest
+	(*StartGcodeJobResponse)(nil),       // 180: seriallink.v1.StartGcodeJobResponse
+	(*ControlGcodeJobRequest)(nil),      // 181: seriallink.v1.ControlGcodeJobRequest
+	(*ControlGcodeJobResponse)(nil),     // 182: seriallink.v1.ControlGcodeJobResponse
+	(*GetGcodeJobRequest)(nil),          // 183: seriallink.v1.GetGcodeJobRequest
+	(*GetGcodeJobResponse)(nil),         // 184: seriallink.v1.GetGcodeJobResponse
+	(*StreamGcodeJobRequest)(nil),       // 185: seriallink.v1.StreamGcodeJobRequest
+	(*StreamGcodeJobResponse)(nil),      // 186: seriallink.v1.StreamGcodeJobResponse
+	(*MachineTemperature)(nil),          // 187: seriallink.v1.MachineTemperature
+	(*MachineStatus)(nil),               // 188: seriallink.v1.MachineStatus
+	(*ConnectMachineRequest)(nil),       // 189: seriallink.v1.ConnectMachineRequest
+	(*ConnectMachineResponse)(nil),      // 190: seriallink.v1.ConnectMachineResponse
+	(*DisconnectMachineRequest)(nil),    // 191: seriallink.v1.DisconnectMachineRequest
+	(*DisconnectMachineResponse)(nil),   // 192: seriallink.v1.DisconnectMachineResponse
+	(*StreamMachineStatusRequest)(nil),  // 193: seriallink.v1.StreamMachineStatusRequest
+	(*StreamMachineStatusResponse)(nil), // 194: seriallink.v1.StreamMachineStatusResponse
+	(*JogMachineRequest)(nil),           // 195: seriallink.v1.JogMachineRequest
+	(*JogMachineResponse)(nil),          // 196: seriallink.v1.JogMachineResponse
+	(*SendMachineCommandRequest)(nil),   // 197: seriallink.v1.SendMachineCommandRequest
+	(*SendMachineCommandResponse)(nil),  // 198: seriallink.v1.SendMachineCommandResponse
+	(*ReadMeterRequest)(nil),            // 199: seriallink.v1.ReadMeterRequest
+	(*MeterValue)(nil),                  // 200: seriallink.v1.MeterValue
+	(*ReadMeterResponse)(nil),           // 201: seriallink.v1.ReadMeterResponse
+	(*GetKeywordStatsRequest)(nil),      // 202: seriallink.v1.GetKeywordStatsRequest
+	(*KeywordWindow)(nil),               // 203: seriallink.v1.KeywordWindow
+	(*KeywordCount)(nil),                // 204: seriallink.v1.KeywordCount
+	(*PortKeywordStats)(nil),            // 205: seriallink.v1.PortKeywordStats
+	(*GetKeywordStatsResponse)(nil),     // 206: seriallink.v1.GetKeywordStatsResponse
+	(*ListRecordingsRequest)(nil),       // 207: seriallink.v1.ListRecordingsRequest
+	(*Recording)(nil),                   // 208: seriallink.v1.Recording
+	(*ListRecordingsResponse)(nil),      // 209: seriallink.v1.ListRecordingsResponse
+	(*FetchRecordingRequest)(nil),       // 210: seriallink.v1.FetchRecordingRequest
+	(*FetchRecordingResponse)(nil),      // 211: seriallink.v1.FetchRecordingResponse
+	(*GetPortCapabilitiesRequest)(nil),  // 212: seriallink.v1.GetPortCapabilitiesRequest
+	(*GetPortCapabilitiesResponse)(nil), // 213: seriallink.v1.GetPortCapabilitiesResponse
+	(*UploadFirmwareRequest)(nil),       // 214: seriallink.v1.UploadFirmwareRequest
+	(*UploadFirmwareResponse)(nil),      // 215: seriallink.v1.UploadFirmwareResponse
+	(*ListSessionsRequest)(nil),         // 216: seriallink.v1.ListSessionsRequest
+	(*SessionInfo)(nil),                 // 217: seriallink.v1.SessionInfo
+	(*ListSessionsResponse)(nil),        // 218: seriallink.v1.ListSessionsResponse
+	(*CreateAccessTokenRequest)(nil),    // 219: seriallink.v1.CreateAccessTokenRequest
+	(*CreateAccessTokenResponse)(nil),   // 220: seriallink.v1.CreateAccessTokenResponse
+	(*ForceCloseRequest)(nil),           // 221: seriallink.v1.ForceCloseRequest
+	(*ForceCloseResponse)(nil),          // 222: seriallink.v1.ForceCloseResponse
+	(*CreateStreamLinkRequest)(nil),     // 223: seriallink.v1.CreateStreamLinkRequest
+	(*CreateStreamLinkResponse)(nil),    // 224: seriallink.v1.CreateStreamLinkResponse
+	nil,                                 // 225: seriallink.v1.PortStatus.MetadataEntry
+	nil,                                 // 226: seriallink.v1.OpenPortRequest.MetadataEntry
+	nil,                                 // 227: seriallink.v1.MachineStatus.MachinePositionEntry
+	nil,                                 // 228: seriallink.v1.MachineStatus.WorkPositionEntry
+	nil,                                 // 229: seriallink.v1.SessionInfo.MetadataEntry
+}
+var file_seriallink_v1_serial_proto_depIdxs = []int32{
+	0,   // 0: seriallink.v1.PortConfig.data_bits:type_name -> seriallink.v1.DataBits
+	1,   // 1: seriallink.v1.PortConfig.stop_bits:type_name -> seriallink.v1.StopBits
+	2,   // 2: seriallink.v1.PortConfig.parity:type_name -> seriallink.v1.Parity
+	3,   // 3: seriallink.v1.PortConfig.flow_control:type_name -> seriallink.v1.FlowControl
+	4,   // 4: seriallink.v1.PortConfig.latency_profile:type_name -> seriallink.v1.LatencyProfile
+	5,   // 5: seriallink.v1.PortConfig.dtr:type_name -> seriallink.v1.LineState
+	5,   // 6: seriallink.v1.PortConfig.rts:type_name -> seriallink.v1.LineState
+	7,   // 7: seriallink.v1.PortInfo.port_type:type_name -> seriallink.v1.PortType
+	21,  // 8: seriallink.v1.PortStatus.current_config:type_name -> seriallink.v1.PortConfig
+	23,  // 9: seriallink.v1.PortStatus.statistics:type_name -> seriallink.v1.PortStatistics
+	6,   // 10: seriallink.v1.PortStatus.priority:type_name -> seriallink.v1.SessionPriority
+	8,   // 11: seriallink.v1.PortStatus.power_state:type_name -> seriallink.v1.PowerState
+	9,   // 12: seriallink.v1.PortStatus.close_reason:type_name -> seriallink.v1.CloseReason
+	225, // 13: seriallink.v1.PortStatus.metadata:type_name -> seriallink.v1.PortStatus.MetadataEntry
+	170, // 14: seriallink.v1.PortStatus.shaping:type_name -> seriallink.v1.BandwidthShaping
+	22,  // 15: seriallink.v1.ListPortsResponse.ports:type_name -> seriallink.v1.PortInfo
+	22,  // 16: seriallink.v1.GetPortInfoResponse.port:type_name -> seriallink.v1.PortInfo
+	21,  // 17: seriallink.v1.OpenPortRequest.config:type_name -> seriallink.v1.PortConfig
+	6,   // 18: seriallink.v1.OpenPortRequest.priority:type_name -> seriallink.v1.SessionPriority
+	30,  // 19: seriallink.v1.OpenPortRequest.init:type_name -> seriallink.v1.InitStep
+	226, // 20: seriallink.v1.OpenPortRequest.metadata:type_name -> seriallink.v1.OpenPortRequest.MetadataEntry
+	5,   // 21: seriallink.v1.OpenPortRequest.dtr:type_name -> seriallink.v1.LineState
+	5,   // 22: seriallink.v1.OpenPortRequest.rts:type_name -> seriallink.v1.LineState
+	24,  // 23: seriallink.v1.GetPortStatusResponse.status:type_name -> seriallink.v1.PortStatus
+	6,   // 24: seriallink.v1.StreamReadRequest.priority:type_name -> seriallink.v1.SessionPriority
+	42,  // 25: seriallink.v1.StreamReadRequest.filter:type_name -> seriallink.v1.StreamFilter
+	17,  // 26: seriallink.v1.StreamReadRequest.chunking:type_name -> seriallink.v1.StreamChunking
+	40,  // 27: seriallink.v1.StreamReadResponse.chunk:type_name -> seriallink.v1.DataChunk
+	45,  // 28: seriallink.v1.StreamTimedReadResponse.chunk:type_name -> seriallink.v1.TimedChunk
+	40,  // 29: seriallink.v1.StreamWriteRequest.chunk:type_name -> seriallink.v1.DataChunk
+	40,  // 30: seriallink.v1.BiDirectionalStreamRequest.chunk:type_name -> seriallink.v1.DataChunk
+	40,  // 31: seriallink.v1.BiDirectionalStreamResponse.chunk:type_name -> seriallink.v1.DataChunk
+	21,  // 32: seriallink.v1.ConfigurePortRequest.config:type_name -> seriallink.v1.PortConfig
+	21,  // 33: seriallink.v1.GetPortConfigResponse.config:type_name -> seriallink.v1.PortConfig
+	58,  // 34: seriallink.v1.AgentInfo.config:type_name -> seriallink.v1.AgentConfig
+	59,  // 35: seriallink.v1.GetAgentInfoResponse.info:type_name -> seriallink.v1.AgentInfo
+	62,  // 36: seriallink.v1.GetMemoryStatsResponse.sessions:type_name -> seriallink.v1.SessionMemoryStats
+	67,  // 37: seriallink.v1.GetRecentErrorsResponse.errors:type_name -> seriallink.v1.ErrorRecord
+	21,  // 38: seriallink.v1.LineCandidate.config:type_name -> seriallink.v1.PortConfig
+	70,  // 39: seriallink.v1.DiagnoseLineResponse.candidates:type_name -> seriallink.v1.LineCandidate
+	74,  // 40: seriallink.v1.SynchronizedWriteRequest.writes:type_name -> seriallink.v1.SyncWrite
+	76,  // 41: seriallink.v1.SynchronizedWriteResponse.results:type_name -> seriallink.v1.SyncWriteResult
+	81,  // 42: seriallink.v1.ListBusDevicesResponse.devices:type_name -> seriallink.v1.BusDevice
+	90,  // 43: seriallink.v1.ReadSMSResponse.messages:type_name -> seriallink.v1.SMSMessage
+	103, // 44: seriallink.v1.StreamScansResponse.scan:type_name -> seriallink.v1.ScanEvent
+	106, // 45: seriallink.v1.PolledSample.values:type_name -> seriallink.v1.PolledValue
+	107, // 46: seriallink.v1.StreamPolledValuesResponse.sample:type_name -> seriallink.v1.PolledSample
+	110, // 47: seriallink.v1.HistorySeries.points:type_name -> seriallink.v1.HistoryPoint
+	111, // 48: seriallink.v1.QueryHistoryResponse.series:type_name -> seriallink.v1.HistorySeries
+	113, // 49: seriallink.v1.ListAlarmsResponse.alarms:type_name -> seriallink.v1.Alarm
+	113, // 50: seriallink.v1.AcknowledgeAlarmResponse.alarm:type_name -> seriallink.v1.Alarm
+	118, // 51: seriallink.v1.ListDeviceStatesResponse.states:type_name -> seriallink.v1.DeviceState
+	118, // 52: seriallink.v1.StreamDeviceStatesResponse.state:type_name -> seriallink.v1.DeviceState
+	123, // 53: seriallink.v1.ListPendingWritesResponse.writes:type_name -> seriallink.v1.PendingWrite
+	123, // 54: seriallink.v1.RejectWriteResponse.write:type_name -> seriallink.v1.PendingWrite
+	130, // 55: seriallink.v1.ListTestSuitesResponse.suites:type_name -> seriallink.v1.TestSuite
+	134, // 56: seriallink.v1.TestReport.steps:type_name -> seriallink.v1.TestStepResult
+	135, // 57: seriallink.v1.RunTestSuiteResponse.report:type_name -> seriallink.v1.TestReport
+	137, // 58: seriallink.v1.CreateReservationResponse.reservation:type_name -> seriallink.v1.Reservation
+	137, // 59: seriallink.v1.ListReservationsResponse.reservations:type_name -> seriallink.v1.Reservation
+	137, // 60: seriallink.v1.CancelReservationResponse.reservation:type_name -> seriallink.v1.Reservation
+	144, // 61: seriallink.v1.GetUsageReportResponse.records:type_name -> seriallink.v1.UsageRecord
+	8,   // 62: seriallink.v1.SetPowerStateRequest.state:type_name -> seriallink.v1.PowerState
+	8,   // 63: seriallink.v1.SetPowerStateResponse.state:type_name -> seriallink.v1.PowerState
+	24,  // 64: seriallink.v1.StreamPortStatusResponse.status:type_name -> seriallink.v1.PortStatus
+	155, // 65: seriallink.v1.BridgePortsRequest.a:type_name -> seriallink.v1.BridgeEndpoint
+	155, // 66: seriallink.v1.BridgePortsRequest.b:type_name -> seriallink.v1.BridgeEndpoint
+	163, // 67: seriallink.v1.BridgePortsRequest.rules:type_name -> seriallink.v1.BridgeRule
+	163, // 68: seriallink.v1.Bridge.rules:type_name -> seriallink.v1.BridgeRule
+	157, // 69: seriallink.v1.BridgePortsResponse.bridge:type_name -> seriallink.v1.Bridge
+	157, // 70: seriallink.v1.ListBridgesResponse.bridges:type_name -> seriallink.v1.Bridge
+	157, // 71: seriallink.v1.StopBridgeResponse.bridge:type_name -> seriallink.v1.Bridge
+	10,  // 72: seriallink.v1.BridgeRule.direction:type_name -> seriallink.v1.BridgeDirection
+	11,  // 73: seriallink.v1.BridgeRule.action:type_name -> seriallink.v1.BridgeRuleAction
+	163, // 74: seriallink.v1.SetBridgeRulesRequest.rules:type_name -> seriallink.v1.BridgeRule
+	157, // 75: seriallink.v1.SetBridgeRulesResponse.bridge:type_name -> seriallink.v1.Bridge
+	12,  // 76: seriallink.v1.StreamAnnotatedRequest.decoder:type_name -> seriallink.v1.FrameDecoder
+	12,  // 77: seriallink.v1.AnnotatedFrame.decoder:type_name -> seriallink.v1.FrameDecoder
+	167, // 78: seriallink.v1.AnnotatedFrame.fields:type_name -> seriallink.v1.FrameField
+	168, // 79: seriallink.v1.StreamAnnotatedResponse.frame:type_name -> seriallink.v1.AnnotatedFrame
+	170, // 80: seriallink.v1.SetShapingRequest.shaping:type_name -> seriallink.v1.BandwidthShaping
+	170, // 81: seriallink.v1.SetShapingResponse.shaping:type_name -> seriallink.v1.BandwidthShaping
+	6,   // 82: seriallink.v1.StreamInfo.priority:type_name -> seriallink.v1.SessionPriority
+	174, // 83: seriallink.v1.ListStreamsResponse.streams:type_name -> seriallink.v1.StreamInfo
+	13,  // 84: seriallink.v1.GcodeJob.dialect:type_name -> seriallink.v1.GcodeDialect
+	14,  // 85: seriallink.v1.GcodeJob.state:type_name -> seriallink.v1.GcodeJobState
+	13,  // 86: seriallink.v1.StartGcodeJobRequest.dialect:type_name -> seriallink.v1.GcodeDialect
+	178, // 87: seriallink.v1.StartGcodeJobResponse.job:type_name -> seriallink.v1.GcodeJob
+	15,  // 88: seriallink.v1.ControlGcodeJobRequest.action:type_name -> seriallink.v1.GcodeJobAction
+	178, // 89: seriallink.v1.ControlGcodeJobResponse.job:type_name -> seriallink.v1.GcodeJob
+	178, // 90: seriallink.v1.GetGcodeJobResponse.job:type_name -> seriallink.v1.GcodeJob
+	178, // 91: seriallink.v1.StreamGcodeJobResponse.job:type_name -> seriallink.v1.GcodeJob
+	13,  // 92: seriallink.v1.MachineStatus.dialect:type_name -> seriallink.v1.GcodeDialect
+	227, // 93: seriallink.v1.MachineStatus.machine_position:type_name -> seriallink.v1.MachineStatus.MachinePositionEntry
+	228, // 94: seriallink.v1.MachineStatus.work_position:type_name -> seriallink.v1.MachineStatus.WorkPositionEntry
+	187, // 95: seriallink.v1.MachineStatus.temperatures:type_name -> seriallink.v1.MachineTemperature
+	178, // 96: seriallink.v1.MachineStatus.job:type_name -> seriallink.v1.GcodeJob
+	13,  // 97: seriallink.v1.ConnectMachineRequest.dialect:type_name -> seriallink.v1.GcodeDialect
+	188, // 98: seriallink.v1.ConnectMachineResponse.status:type_name -> seriallink.v1.MachineStatus
+	188, // 99: seriallink.v1.StreamMachineStatusResponse.status:type_name -> seriallink.v1.MachineStatus
+	188, // 100: seriallink.v1.JogMachineResponse.status:type_name -> seriallink.v1.MachineStatus
+	16,  // 101: seriallink.v1.SendMachineCommandRequest.command:type_name -> seriallink.v1.MachineCommand
+	188, // 102: seriallink.v1.SendMachineCommandResponse.status:type_name -> seriallink.v1.MachineStatus
+	200, // 103: seriallink.v1.ReadMeterResponse.values:type_name -> seriallink.v1.MeterValue
+	203, // 104: seriallink.v1.KeywordCount.windows:type_name -> seriallink.v1.KeywordWindow
+	204, // 105: seriallink.v1.PortKeywordStats.keywords:type_name -> seriallink.v1.KeywordCount
+	205, // 106: seriallink.v1.GetKeywordStatsResponse.ports:type_name -> seriallink.v1.PortKeywordStats
+	208, // 107: seriallink.v1.ListRecordingsResponse.recordings:type_name -> seriallink.v1.Recording
+	18,  // 108: seriallink.v1.UploadFirmwareRequest.protocol:type_name -> seriallink.v1.BootloaderProtocol
+	19,  // 109: seriallink.v1.UploadFirmwareRequest.reset_method:type_name -> seriallink.v1.BootloaderReset
+	20,  // 110: seriallink.v1.UploadFirmwareResponse.stage:type_name -> seriallink.v1.UploadStage
+	6,   // 111: seriallink.v1.SessionInfo.priority:type_name -> seriallink.v1.SessionPriority
+	8,   // 112: seriallink.v1.SessionInfo.power_state:type_name -> seriallink.v1.PowerState
+	229, // 113: seriallink.v1.SessionInfo.metadata:type_name -> seriallink.v1.SessionInfo.MetadataEntry
+	23,  // 114: seriallink.v1.SessionInfo.statistics:type_name -> seriallink.v1.PortStatistics
+	217, // 115: seriallink.v1.ListSessionsResponse.sessions:type_name -> seriallink.v1.SessionInfo
+	25,  // 116: seriallink.v1.SerialService.ListPorts:input_type -> seriallink.v1.ListPortsRequest
+	27,  // 117: seriallink.v1.SerialService.GetPortInfo:input_type -> seriallink.v1.GetPortInfoRequest
+	212, // 118: seriallink.v1.SerialService.GetPortCapabilities:input_type -> seriallink.v1.GetPortCapabilitiesRequest
+	29,  // 119: seriallink.v1.SerialService.OpenPort:input_type -> seriallink.v1.OpenPortRequest
+	32,  // 120: seriallink.v1.SerialService.ClosePort:input_type -> seriallink.v1.ClosePortRequest
+	34,  // 121: seriallink.v1.SerialService.GetPortStatus:input_type -> seriallink.v1.GetPortStatusRequest
+	36,  // 122: seriallink.v1.SerialService.Write:input_type -> seriallink.v1.WriteRequest
+	38,  // 123: seriallink.v1.SerialService.Read:input_type -> seriallink.v1.ReadRequest
+	41,  // 124: seriallink.v1.SerialService.StreamRead:input_type -> seriallink.v1.StreamReadRequest
+	44,  // 125: seriallink.v1.SerialService.StreamTimedRead:input_type -> seriallink.v1.StreamTimedReadRequest
+	47,  // 126: seriallink.v1.SerialService.StreamWrite:input_type -> seriallink.v1.StreamWriteRequest
+	49,  // 127: seriallink.v1.SerialService.BiDirectionalStream:input_type -> seriallink.v1.BiDirectionalStreamRequest
+	51,  // 128: seriallink.v1.SerialService.ConfigurePort:input_type -> seriallink.v1.ConfigurePortRequest
+	53,  // 129: seriallink.v1.SerialService.GetPortConfig:input_type -> seriallink.v1.GetPortConfigRequest
+	55,  // 130: seriallink.v1.SerialService.Ping:input_type -> seriallink.v1.PingRequest
+	57,  // 131: seriallink.v1.SerialService.GetAgentInfo:input_type -> seriallink.v1.GetAgentInfoRequest
+	61,  // 132: seriallink.v1.SerialService.GetMemoryStats:input_type -> seriallink.v1.GetMemoryStatsRequest
+	173, // 133: seriallink.v1.SerialService.ListStreams:input_type -> seriallink.v1.ListStreamsRequest
+	216, // 134: seriallink.v1.SerialService.ListSessions:input_type -> seriallink.v1.ListSessionsRequest
+	219, // 135: seriallink.v1.SerialService.CreateAccessToken:input_type -> seriallink.v1.CreateAccessTokenRequest
+	223, // 136: seriallink.v1.SerialService.CreateStreamLink:input_type -> seriallink.v1.CreateStreamLinkRequest
+	221, // 137: seriallink.v1.SerialService.ForceClose:input_type -> seriallink.v1.ForceCloseRequest
+	64,  // 138: seriallink.v1.SerialService.GetRecentOutput:input_type -> seriallink.v1.GetRecentOutputRequest
+	202, // 139: seriallink.v1.SerialService.GetKeywordStats:input_type -> seriallink.v1.GetKeywordStatsRequest
+	207, // 140: seriallink.v1.SerialService.ListRecordings:input_type -> seriallink.v1.ListRecordingsRequest
+	210, // 141: seriallink.v1.SerialService.FetchRecording:input_type -> seriallink.v1.FetchRecordingRequest
+	66,  // 142: seriallink.v1.SerialService.GetRecentErrors:input_type -> seriallink.v1.GetRecentErrorsRequest
+	69,  // 143: seriallink.v1.SerialService.DiagnoseLine:input_type -> seriallink.v1.DiagnoseLineRequest
+	72,  // 144: seriallink.v1.SerialService.Verify:input_type -> seriallink.v1.VerifyRequest
+	199, // 145: seriallink.v1.SerialService.ReadMeter:input_type -> seriallink.v1.ReadMeterRequest
+	131, // 146: seriallink.v1.SerialService.ListTestSuites:input_type -> seriallink.v1.ListTestSuitesRequest
+	133, // 147: seriallink.v1.SerialService.RunTestSuite:input_type -> seriallink.v1.RunTestSuiteRequest
+	75,  // 148: seriallink.v1.SerialService.SynchronizedWrite:input_type -> seriallink.v1.SynchronizedWriteRequest
+	78,  // 149: seriallink.v1.SerialService.ResetTarget:input_type -> seriallink.v1.ResetTargetRequest
+	80,  // 150: seriallink.v1.SerialService.ListBusDevices:input_type -> seriallink.v1.ListBusDevicesRequest
+	83,  // 151: seriallink.v1.SerialService.I2CTransfer:input_type -> seriallink.v1.I2CTransferRequest
+	85,  // 152: seriallink.v1.SerialService.SPITransfer:input_type -> seriallink.v1.SPITransferRequest
+	87,  // 153: seriallink.v1.SerialService.SendSMS:input_type -> seriallink.v1.SendSMSRequest
+	89,  // 154: seriallink.v1.SerialService.ReadSMS:input_type -> seriallink.v1.ReadSMSRequest
+	92,  // 155: seriallink.v1.SerialService.GetModemStatus:input_type -> seriallink.v1.GetModemStatusRequest
+	94,  // 156: seriallink.v1.SerialService.HandOffPPP:input_type -> seriallink.v1.HandOffPPPRequest
+	96,  // 157: seriallink.v1.SerialService.PrintText:input_type -> seriallink.v1.PrintTextRequest
+	102, // 158: seriallink.v1.SerialService.StreamScans:input_type -> seriallink.v1.StreamScansRequest
+	166, // 159: seriallink.v1.SerialService.StreamAnnotated:input_type -> seriallink.v1.StreamAnnotatedRequest
+	176, // 160: seriallink.v1.SerialService.Paste:input_type -> seriallink.v1.PasteRequest
+	105, // 161: seriallink.v1.SerialService.StreamPolledValues:input_type -> seriallink.v1.StreamPolledValuesRequest
+	109, // 162: seriallink.v1.SerialService.QueryHistory:input_type -> seriallink.v1.QueryHistoryRequest
+	114, // 163: seriallink.v1.SerialService.ListAlarms:input_type -> seriallink.v1.ListAlarmsRequest
+	116, // 164: seriallink.v1.SerialService.AcknowledgeAlarm:input_type -> seriallink.v1.AcknowledgeAlarmRequest
+	119, // 165: seriallink.v1.SerialService.ListDeviceStates:input_type -> seriallink.v1.ListDeviceStatesRequest
+	121, // 166: seriallink.v1.SerialService.StreamDeviceStates:input_type -> seriallink.v1.StreamDeviceStatesRequest
+	149, // 167: seriallink.v1.SerialService.StreamPortStatus:input_type -> seriallink.v1.StreamPortStatusRequest
+	124, // 168: seriallink.v1.SerialService.ListPendingWrites:input_type -> seriallink.v1.ListPendingWritesRequest
+	126, // 169: seriallink.v1.SerialService.ApproveWrite:input_type -> seriallink.v1.ApproveWriteRequest
+	128, // 170: seriallink.v1.SerialService.RejectWrite:input_type -> seriallink.v1.RejectWriteRequest
+	97,  // 171: seriallink.v1.SerialService.PrintRaster:input_type -> seriallink.v1.PrintRasterRequest
+	98,  // 172: seriallink.v1.SerialService.CutPaper:input_type -> seriallink.v1.CutPaperRequest
+	100, // 173: seriallink.v1.SerialService.GetPrinterStatus:input_type -> seriallink.v1.GetPrinterStatusRequest
+	138, // 174: seriallink.v1.SerialService.CreateReservation:input_type -> seriallink.v1.CreateReservationRequest
+	140, // 175: seriallink.v1.SerialService.ListReservations:input_type -> seriallink.v1.ListReservationsRequest
+	142, // 176: seriallink.v1.SerialService.CancelReservation:input_type -> seriallink.v1.CancelReservationRequest
+	145, // 177: seriallink.v1.SerialService.GetUsageReport:input_type -> seriallink.v1.GetUsageReportRequest
+	147, // 178: seriallink.v1.SerialService.SetPowerState:input_type -> seriallink.v1.SetPowerStateRequest
+	171, // 179: seriallink.v1.SerialService.SetShaping:input_type -> seriallink.v1.SetShapingRequest
+	151, // 180: seriallink.v1.SerialService.GetAgentStats:input_type -> seriallink.v1.GetAgentStatsRequest
+	153, // 181: seriallink.v1.SerialService.SetDebugEndpoints:input_type -> seriallink.v1.SetDebugEndpointsRequest
+	156, // 182: seriallink.v1.SerialService.BridgePorts:input_type -> seriallink.v1.BridgePortsRequest
+	159, // 183: seriallink.v1.SerialService.ListBridges:input_type -> seriallink.v1.ListBridgesRequest
+	161, // 184: seriallink.v1.SerialService.StopBridge:input_type -> seriallink.v1.StopBridgeRequest
+	164, // 185: seriallink.v1.SerialService.SetBridgeRules:input_type -> seriallink.v1.SetBridgeRulesRequest
+	179, // 186: seriallink.v1.SerialService.StartGcodeJob:input_type -> seriallink.v1.StartGcodeJobRequest
+	181, // 187: seriallink.v1.SerialService.ControlGcodeJob:input_type -> seriallink.v1.ControlGcodeJobRequest
+	183, // 188: seriallink.v1.SerialService.GetGcodeJob:input_type -> seriallink.v1.GetGcodeJobRequest
+	185, // 189: seriallink.v1.SerialService.StreamGcodeJob:input_type -> seriallink.v1.StreamGcodeJobRequest
+	189, // 190: seriallink.v1.SerialService.ConnectMachine:input_type -> seriallink.v1.ConnectMachineRequest
+	191, // 191: seriallink.v1.SerialService.DisconnectMachine:input_type -> seriallink.v1.DisconnectMachineRequest
+	193, // 192: seriallink.v1.SerialService.StreamMachineStatus:input_type -> seriallink.v1.StreamMachineStatusRequest
+	195, // 193: seriallink.v1.SerialService.JogMachine:input_type -> seriallink.v1.JogMachineRequest
+	197, // 194: seriallink.v1.SerialService.SendMachineCommand:input_type -> seriallink.v1.SendMachineCommandRequest
+	214, // 195: seriallink.v1.SerialService.UploadFirmware:input_type -> seriallink.v1.UploadFirmwareRequest
+	26,  // 196: seriallink.v1.SerialService.ListPorts:output_type -> seriallink.v1.ListPortsResponse
+	28,  // 197: seriallink.v1.SerialService.GetPortInfo:output_type -> seriallink.v1.GetPortInfoResponse
+	213, // 198: seriallink.v1.SerialService.GetPortCapabilities:output_type -> seriallink.v1.GetPortCapabilitiesResponse
+	31,  // 199: seriallink.v1.SerialService.OpenPort:output_type -> seriallink.v1.OpenPortResponse
+	33,  // 200: seriallink.v1.SerialService.ClosePort:output_type -> seriallink.v1.ClosePortResponse
+	35,  // 201: seriallink.v1.SerialService.GetPortStatus:output_type -> seriallink.v1.GetPortStatusResponse
+	37,  // 202: seriallink.v1.SerialService.Write:output_type -> seriallink.v1.WriteResponse
+	39,  // 203: seriallink.v1.SerialService.Read:output_type -> seriallink.v1.ReadResponse
+	43,  // 204: seriallink.v1.SerialService.StreamRead:output_type -> seriallink.v1.StreamReadResponse
+	46,  // 205: seriallink.v1.SerialService.StreamTimedRead:output_type -> seriallink.v1.StreamTimedReadResponse
+	48,  // 206: seriallink.v1.SerialService.StreamWrite:output_type -> seriallink.v1.StreamWriteResponse
+	50,  // 207: seriallink.v1.SerialService.BiDirectionalStream:output_type -> seriallink.v1.BiDirectionalStreamResponse
+	52,  // 208: seriallink.v1.SerialService.ConfigurePort:output_type -> seriallink.v1.ConfigurePortResponse
+	54,  // 209: seriallink.v1.SerialService.GetPortConfig:output_type -> seriallink.v1.GetPortConfigResponse
+	56,  // 210: seriallink.v1.SerialService.Ping:output_type -> seriallink.v1.PingResponse
+	60,  // 211: seriallink.v1.SerialService.GetAgentInfo:output_type -> seriallink.v1.GetAgentInfoResponse
+	63,  // 212: seriallink.v1.SerialService.GetMemoryStats:output_type -> seriallink.v1.GetMemoryStatsResponse
+	175, // 213: seriallink.v1.SerialService.ListStreams:output_type -> seriallink.v1.ListStreamsResponse
+	218, // 214: seriallink.v1.SerialService.ListSessions:output_type -> seriallink.v1.ListSessionsResponse
+	220, // 215: seriallink.v1.SerialService.CreateAccessToken:output_type -> seriallink.v1.CreateAccessTokenResponse
+	224, // 216: seriallink.v1.SerialService.CreateStreamLink:output_type -> seriallink.v1.CreateStreamLinkResponse
+	222, // 217: seriallink.v1.SerialService.ForceClose:output_type -> seriallink.v1.ForceCloseResponse
+	65,  // 218: seriallink.v1.SerialService.GetRecentOutput:output_type -> seriallink.v1.GetRecentOutputResponse
+	206, // 219: seriallink.v1.SerialService.GetKeywordStats:output_type -> seriallink.v1.GetKeywordStatsResponse
+	209, // 220: seriallink.v1.SerialService.ListRecordings:output_type -> seriallink.v1.ListRecordingsResponse
+	211, // 221: seriallink.v1.SerialService.FetchRecording:output_type -> seriallink.v1.FetchRecordingResponse
+	68,  // 222: seriallink.v1.SerialService.GetRecentErrors:output_type -> seriallink.v1.GetRecentErrorsResponse
+	71,  // 223: seriallink.v1.SerialService.DiagnoseLine:output_type -> seriallink.v1.DiagnoseLineResponse
+	73,  // 224: seriallink.v1.SerialService.Verify:output_type -> seriallink.v1.VerifyResponse
+	201, // 225: seriallink.v1.SerialService.ReadMeter:output_type -> seriallink.v1.ReadMeterResponse
+	132, // 226: seriallink.v1.SerialService.ListTestSuites:output_type -> seriallink.v1.ListTestSuitesResponse
+	136, // 227: seriallink.v1.SerialService.RunTestSuite:output_type -> seriallink.v1.RunTestSuiteResponse
+	77,  // 228: seriallink.v1.SerialService.SynchronizedWrite:output_type -> seriallink.v1.SynchronizedWriteResponse
+	79,  // 229: seriallink.v1.SerialService.ResetTarget:output_type -> seriallink.v1.ResetTargetResponse
+	82,  // 230: seriallink.v1.SerialService.ListBusDevices:output_type -> seriallink.v1.ListBusDevicesResponse
+	84,  // 231: seriallink.v1.SerialService.I2CTransfer:output_type -> seriallink.v1.I2CTransferResponse
+	86,  // 232: seriallink.v1.SerialService.SPITransfer:output_type -> seriallink.v1.SPITransferResponse
+	88,  // 233: seriallink.v1.SerialService.SendSMS:output_type -> seriallink.v1.SendSMSResponse
+	91,  // 234: seriallink.v1.SerialService.ReadSMS:output_type -> seriallink.v1.ReadSMSResponse
+	93,  // 235: seriallink.v1.SerialService.GetModemStatus:output_type -> seriallink.v1.GetModemStatusResponse
+	95,  // 236: seriallink.v1.SerialService.HandOffPPP:output_type -> seriallink.v1.HandOffPPPResponse
+	99,  // 237: seriallink.v1.SerialService.PrintText:output_type -> seriallink.v1.PrintResponse
+	104, // 238: seriallink.v1.SerialService.StreamScans:output_type -> seriallink.v1.StreamScansResponse
+	169, // 239: seriallink.v1.SerialService.StreamAnnotated:output_type -> seriallink.v1.StreamAnnotatedResponse
+	177, // 240: seriallink.v1.SerialService.Paste:output_type -> seriallink.v1.PasteResponse
+	108, // 241: seriallink.v1.SerialService.StreamPolledValues:output_type -> seriallink.v1.StreamPolledValuesResponse
+	112, // 242: seriallink.v1.SerialService.QueryHistory:output_type -> seriallink.v1.QueryHistoryResponse
+	115, // 243: seriallink.v1.SerialService.ListAlarms:output_type -> seriallink.v1.ListAlarmsResponse
+	117, // 244: seriallink.v1.SerialService.AcknowledgeAlarm:output_type -> seriallink.v1.AcknowledgeAlarmResponse
+	120, // 245: seriallink.v1.SerialService.ListDeviceStates:output_type -> seriallink.v1.ListDeviceStatesResponse
+	122, // 246: seriallink.v1.SerialService.StreamDeviceStates:output_type -> seriallink.v1.StreamDeviceStatesResponse
+	150, // 247: seriallink.v1.SerialService.StreamPortStatus:output_type -> seriallink.v1.StreamPortStatusResponse
+	125, // 248: seriallink.v1.SerialService.ListPendingWrites:output_type -> seriallink.v1.ListPendingWritesResponse
+	127, // 249: seriallink.v1.SerialService.ApproveWrite:output_type -> seriallink.v1.ApproveWriteResponse
+	129, // 250: seriallink.v1.SerialService.RejectWrite:output_type -> seriallink.v1.RejectWriteResponse
+	99,  // 251: seriallink.v1.SerialService.PrintRaster:output_type -> seriallink.v1.PrintResponse
+	99,  // 252: seriallink.v1.SerialService.CutPaper:output_type -> seriallink.v1.PrintResponse
+	101, // 253: seriallink.v1.SerialService.GetPrinterStatus:output_type -> seriallink.v1.GetPrinterStatusResponse
+	139, // 254: seriallink.v1.SerialService.CreateReservation:output_type -> seriallink.v1.CreateReservationResponse
+	141, // 255: seriallink.v1.SerialService.ListReservations:output_type -> seriallink.v1.ListReservationsResponse
+	143, // 256: seriallink.v1.SerialService.CancelReservation:output_type -> seriallink.v1.CancelReservationResponse
+	146, // 257: seriallink.v1.SerialService.GetUsageReport:output_type -> seriallink.v1.GetUsageReportResponse
+	148, // 258: seriallink.v1.SerialService.SetPowerState:output_type -> seriallink.v1.SetPowerStateResponse
+	172, // 259: seriallink.v1.SerialService.SetShaping:output_type -> seriallink.v1.SetShapingResponse
+	152, // 260: seriallink.v1.SerialService.GetAgentStats:output_type -> seriallink.v1.GetAgentStatsResponse
+	154, // 261: seriallink.v1.SerialService.SetDebugEndpoints:output_type -> seriallink.v1.SetDebugEndpointsResponse
+	158, // 262: seriallink.v1.SerialService.BridgePorts:output_type -> seriallink.v1.BridgePortsResponse
+	160, // 263: seriallink.v1.SerialService.ListBridges:output_type -> seriallink.v1.ListBridgesResponse
+	162, // 264: seriallink.v1.SerialService.StopBridge:output_type -> seriallink.v1.StopBridgeResponse
+	165, // 265: seriallink.v1.SerialService.SetBridgeRules:output_type -> seriallink.v1.SetBridgeRulesResponse
+	180, // 266: seriallink.v1.SerialService.StartGcodeJob:output_type -> seriallink.v1.StartGcodeJobResponse
+	182, // 267: seriallink.v1.SerialService.ControlGcodeJob:output_type -> seriallink.v1.ControlGcodeJobResponse
+	184, // 268: seriallink.v1.SerialService.GetGcodeJob:output_type -> seriallink.v1.GetGcodeJobResponse
+	186, // 269: seriallink.v1.SerialService.StreamGcodeJob:output_type -> seriallink.v1.StreamGcodeJobResponse
+	190, // 270: seriallink.v1.SerialService.ConnectMachine:output_type -> seriallink.v1.ConnectMachineResponse
+	192, // 271: seriallink.v1.SerialService.DisconnectMachine:output_type -> seriallink.v1.DisconnectMachineResponse
+	194, // 272: seriallink.v1.SerialService.StreamMachineStatus:output_type -> seriallink.v1.StreamMachineStatusResponse
+	196, // 273: seriallink.v1.SerialService.JogMachine:output_type -> seriallink.v1.JogMachineResponse
+	198, // 274: seriallink.v1.SerialService.SendMachineCommand:output_type -> seriallink.v1.SendMachineCommandResponse
+	215, // 275: seriallink.v1.SerialService.UploadFirmware:output_type -> seriallink.v1.UploadFirmwareResponse
+	196, // [196:276] is the sub-list for method output_type
+	116, // [116:196] is the sub-list for method input_type
+	116, // [116:116] is the sub-list for extension type_name
+	116, // [116:116] is the sub-list for extension extendee
+	0,   // [0:116] is the sub-list for field type_name
+}
+
+func init() { file_seriallink_v1_serial_proto_init() }
+func file_seriallink_v1_serial_proto_init() {
+	if File_seriallink_v1_serial_proto != nil {
+		return
+	}
+	file_seriallink_v1_serial_proto_msgTypes[113].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_seriallink_v1_serial_proto_rawDesc), len(file_seriallink_v1_serial_proto_rawDesc)),
+			NumEnums:      21,
+			NumMessages:   209,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_seriallink_v1_serial_proto_goTypes,
+		DependencyIndexes: file_seriallink_v1_serial_proto_depIdxs,
+		EnumInfos:         file_seriallink_v1_serial_proto_enumTypes,
+		MessageInfos:      file_seriallink_v1_serial_proto_msgTypes,
+	}.Build()
+	File_seriallink_v1_serial_proto = out.File
+	file_seriallink_v1_serial_proto_goTypes = nil
+	file_seriallink_v1_serial_proto_depIdxs = nil
+}
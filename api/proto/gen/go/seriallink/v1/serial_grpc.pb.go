@@ -0,0 +1,3420 @@
+// SerialLink agent API. Run "make proto" after changing this file to
+// regenerate the Go code in gen/go.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: seriallink/v1/serial.proto
+
+package seriallinkv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SerialService_ListPorts_FullMethodName           = "/seriallink.v1.SerialService/ListPorts"
+	SerialService_GetPortInfo_FullMethodName         = "/seriallink.v1.SerialService/GetPortInfo"
+	SerialService_GetPortCapabilities_FullMethodName = "/seriallink.v1.SerialService/GetPortCapabilities"
+	SerialService_OpenPort_FullMethodName            = "/seriallink.v1.SerialService/OpenPort"
+	SerialService_ClosePort_FullMethodName           = "/seriallink.v1.SerialService/ClosePort"
+	SerialService_GetPortStatus_FullMethodName       = "/seriallink.v1.SerialService/GetPortStatus"
+	SerialService_Write_FullMethodName               = "/seriallink.v1.SerialService/Write"
+	SerialService_Read_FullMethodName                = "/seriallink.v1.SerialService/Read"
+	SerialService_StreamRead_FullMethodName          = "/seriallink.v1.SerialService/StreamRead"
+	SerialService_StreamTimedRead_FullMethodName     = "/seriallink.v1.SerialService/StreamTimedRead"
+	SerialService_StreamWrite_FullMethodName         = "/seriallink.v1.SerialService/StreamWrite"
+	SerialService_BiDirectionalStream_FullMethodName = "/seriallink.v1.SerialService/BiDirectionalStream"
+	SerialService_ConfigurePort_FullMethodName       = "/seriallink.v1.SerialService/ConfigurePort"
+	SerialService_GetPortConfig_FullMethodName       = "/seriallink.v1.SerialService/GetPortConfig"
+	SerialService_Ping_FullMethodName                = "/seriallink.v1.SerialService/Ping"
+	SerialService_GetAgentInfo_FullMethodName        = "/seriallink.v1.SerialService/GetAgentInfo"
+	SerialService_GetMemoryStats_FullMethodName      = "/seriallink.v1.SerialService/GetMemoryStats"
+	SerialService_ListStreams_FullMethodName         = "/seriallink.v1.SerialService/ListStreams"
+	SerialService_ListSessions_FullMethodName        = "/seriallink.v1.SerialService/ListSessions"
+	SerialService_CreateAccessToken_FullMethodName   = "/seriallink.v1.SerialService/CreateAccessToken"
+	SerialService_CreateStreamLink_FullMethodName    = "/seriallink.v1.SerialService/CreateStreamLink"
+	SerialService_ForceClose_FullMethodName          = "/seriallink.v1.SerialService/ForceClose"
+	SerialService_GetRecentOutput_FullMethodName     = "/seriallink.v1.SerialService/GetRecentOutput"
+	SerialService_GetKeywordStats_FullMethodName     = "/seriallink.v1.SerialService/GetKeywordStats"
+	SerialService_ListRecordings_FullMethodName      = "/seriallink.v1.SerialService/ListRecordings"
+	SerialService_FetchRecording_FullMethodName      = "/seriallink.v1.SerialService/FetchRecording"
+	SerialService_GetRecentErrors_FullMethodName     = "/seriallink.v1.SerialService/GetRecentErrors"
+	SerialService_DiagnoseLine_FullMethodName        = "/seriallink.v1.SerialService/DiagnoseLine"
+	SerialService_Verify_FullMethodName              = "/seriallink.v1.SerialService/Verify"
+	SerialService_ReadMeter_FullMethodName           = "/seriallink.v1.SerialService/ReadMeter"
+	SerialService_ListTestSuites_FullMethodName      = "/seriallink.v1.SerialService/ListTestSuites"
+	SerialService_RunTestSuite_FullMethodName        = "/seriallink.v1.SerialService/RunTestSuite"
+	SerialService_SynchronizedWrite_FullMethodName   = "/seriallink.v1.SerialService/SynchronizedWrite"
+	SerialService_ResetTarget_FullMethodName         = "/seriallink.v1.SerialService/ResetTarget"
+	SerialService_ListBusDevices_FullMethodName      = "/seriallink.v1.SerialService/ListBusDevices"
+	SerialService_I2CTransfer_FullMethodName         = "/seriallink.v1.SerialService/I2CTransfer"
+	SerialService_SPITransfer_FullMethodName         = "/seriallink.v1.SerialService/SPITransfer"
+	SerialService_SendSMS_FullMethodName             = "/seriallink.v1.SerialService/SendSMS"
+	SerialService_ReadSMS_FullMethodName             = "/seriallink.v1.SerialService/ReadSMS"
+	SerialService_GetModemStatus_FullMethodName      = "/seriallink.v1.SerialService/GetModemStatus"
+	SerialService_HandOffPPP_FullMethodName          = "/seriallink.v1.SerialService/HandOffPPP"
+	SerialService_PrintText_FullMethodName           = "/seriallink.v1.SerialService/PrintText"
+	SerialService_StreamScans_FullMethodName         = "/seriallink.v1.SerialService/StreamScans"
+	SerialService_StreamAnnotated_FullMethodName     = "/seriallink.v1.SerialService/StreamAnnotated"
+	SerialService_Paste_FullMethodName               = "/seriallink.v1.SerialService/Paste"
+	SerialService_StreamPolledValues_FullMethodName  = "/seriallink.v1.SerialService/StreamPolledValues"
+	SerialService_QueryHistory_FullMethodName        = "/seriallink.v1.SerialService/QueryHistory"
+	SerialService_ListAlarms_FullMethodName          = "/seriallink.v1.SerialService/ListAlarms"
+	SerialService_AcknowledgeAlarm_FullMethodName    = "/seriallink.v1.SerialService/AcknowledgeAlarm"
+	SerialService_ListDeviceStates_FullMethodName    = "/seriallink.v1.SerialService/ListDeviceStates"
+	SerialService_StreamDeviceStates_FullMethodName  = "/seriallink.v1.SerialService/StreamDeviceStates"
+	SerialService_StreamPortStatus_FullMethodName    = "/seriallink.v1.SerialService/StreamPortStatus"
+	SerialService_ListPendingWrites_FullMethodName   = "/seriallink.v1.SerialService/ListPendingWrites"
+	SerialService_ApproveWrite_FullMethodName        = "/seriallink.v1.SerialService/ApproveWrite"
+	SerialService_RejectWrite_FullMethodName         = "/seriallink.v1.SerialService/RejectWrite"
+	SerialService_PrintRaster_FullMethodName         = "/seriallink.v1.SerialService/PrintRaster"
+	SerialService_CutPaper_FullMethodName            = "/seriallink.v1.SerialService/CutPaper"
+	SerialService_GetPrinterStatus_FullMethodName    = "/seriallink.v1.SerialService/GetPrinterStatus"
+	SerialService_CreateReservation_FullMethodName   = "/seriallink.v1.SerialService/CreateReservation"
+	SerialService_ListReservations_FullMethodName    = "/seriallink.v1.SerialService/ListReservations"
+	SerialService_CancelReservation_FullMethodName   = "/seriallink.v1.SerialService/CancelReservation"
+	SerialService_GetUsageReport_FullMethodName      = "/seriallink.v1.SerialService/GetUsageReport"
+	SerialService_SetPowerState_FullMethodName       = "/seriallink.v1.SerialService/SetPowerState"
+	SerialService_SetShaping_FullMethodName          = "/seriallink.v1.SerialService/SetShaping"
+	SerialService_GetAgentStats_FullMethodName       = "/seriallink.v1.SerialService/GetAgentStats"
+	SerialService_SetDebugEndpoints_FullMethodName   = "/seriallink.v1.SerialService/SetDebugEndpoints"
+	SerialService_BridgePorts_FullMethodName         = "/seriallink.v1.SerialService/BridgePorts"
+	SerialService_ListBridges_FullMethodName         = "/seriallink.v1.SerialService/ListBridges"
+	SerialService_StopBridge_FullMethodName          = "/seriallink.v1.SerialService/StopBridge"
+	SerialService_SetBridgeRules_FullMethodName      = "/seriallink.v1.SerialService/SetBridgeRules"
+	SerialService_StartGcodeJob_FullMethodName       = "/seriallink.v1.SerialService/StartGcodeJob"
+	SerialService_ControlGcodeJob_FullMethodName     = "/seriallink.v1.SerialService/ControlGcodeJob"
+	SerialService_GetGcodeJob_FullMethodName         = "/seriallink.v1.SerialService/GetGcodeJob"
+	SerialService_StreamGcodeJob_FullMethodName      = "/seriallink.v1.SerialService/StreamGcodeJob"
+	SerialService_ConnectMachine_FullMethodName      = "/seriallink.v1.SerialService/ConnectMachine"
+	SerialService_DisconnectMachine_FullMethodName   = "/seriallink.v1.SerialService/DisconnectMachine"
+	SerialService_StreamMachineStatus_FullMethodName = "/seriallink.v1.SerialService/StreamMachineStatus"
+	SerialService_JogMachine_FullMethodName          = "/seriallink.v1.SerialService/JogMachine"
+	SerialService_SendMachineCommand_FullMethodName  = "/seriallink.v1.SerialService/SendMachineCommand"
+	SerialService_UploadFirmware_FullMethodName      = "/seriallink.v1.SerialService/UploadFirmware"
+)
+
+// SerialServiceClient is the client API for SerialService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SerialServiceClient interface {
+	// ListPorts returns all available serial ports
+	ListPorts(ctx context.Context, in *ListPortsRequest, opts ...grpc.CallOption) (*ListPortsResponse, error)
+	// GetPortInfo returns information about a specific port
+	GetPortInfo(ctx context.Context, in *GetPortInfoRequest, opts ...grpc.CallOption) (*GetPortInfoResponse, error)
+	// GetPortCapabilities reports what a port and its driver support on the
+	// agent's platform
+	GetPortCapabilities(ctx context.Context, in *GetPortCapabilitiesRequest, opts ...grpc.CallOption) (*GetPortCapabilitiesResponse, error)
+	// OpenPort opens a serial port
+	OpenPort(ctx context.Context, in *OpenPortRequest, opts ...grpc.CallOption) (*OpenPortResponse, error)
+	// ClosePort closes a serial port. Given only a session ID the port is
+	// looked up; given only a port name the caller must be the session's
+	// opener.
+	ClosePort(ctx context.Context, in *ClosePortRequest, opts ...grpc.CallOption) (*ClosePortResponse, error)
+	// GetPortStatus returns the status of a port
+	GetPortStatus(ctx context.Context, in *GetPortStatusRequest, opts ...grpc.CallOption) (*GetPortStatusResponse, error)
+	// Write writes data to a port
+	Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*WriteResponse, error)
+	// Read reads data from a port
+	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
+	// StreamRead streams data from a port
+	StreamRead(ctx context.Context, in *StreamReadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamReadResponse], error)
+	// StreamTimedRead streams data with a timestamp per read system call, for
+	// protocol analysis where inter-byte gaps matter
+	StreamTimedRead(ctx context.Context, in *StreamTimedReadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamTimedReadResponse], error)
+	// StreamWrite writes streaming data to a port
+	StreamWrite(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StreamWriteRequest, StreamWriteResponse], error)
+	// BiDirectionalStream handles bidirectional streaming
+	BiDirectionalStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BiDirectionalStreamRequest, BiDirectionalStreamResponse], error)
+	// ConfigurePort configures a port
+	ConfigurePort(ctx context.Context, in *ConfigurePortRequest, opts ...grpc.CallOption) (*ConfigurePortResponse, error)
+	// GetPortConfig returns the current configuration of a port
+	GetPortConfig(ctx context.Context, in *GetPortConfigRequest, opts ...grpc.CallOption) (*GetPortConfigResponse, error)
+	// Ping checks if the server is alive
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	// GetAgentInfo returns information about the agent
+	GetAgentInfo(ctx context.Context, in *GetAgentInfoRequest, opts ...grpc.CallOption) (*GetAgentInfoResponse, error)
+	// GetMemoryStats reports the memory held in stream queues against the
+	// configured limits, with the Go runtime's heap figures
+	GetMemoryStats(ctx context.Context, in *GetMemoryStatsRequest, opts ...grpc.CallOption) (*GetMemoryStatsResponse, error)
+	// ListStreams reports, per read subscription, the data delivered and
+	// dropped and how far the consumer lags behind, to find slow consumers
+	ListStreams(ctx context.Context, in *ListStreamsRequest, opts ...grpc.CallOption) (*ListStreamsResponse, error)
+	// ListSessions returns the open sessions across all ports, optionally of
+	// one port or client. Under an access policy, only sessions on ports the
+	// caller may use are listed.
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// CreateAccessToken mints a short-lived token limited to one port,
+	// read-only unless asked otherwise, to embed in browser dashboards without
+	// handing out long-lived credentials
+	CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...grpc.CallOption) (*CreateAccessTokenResponse, error)
+	// CreateStreamLink mints URLs of the HTTP event stream and the WebSocket
+	// gateway that carry a token allowing nothing but following one port's
+	// data, so a console feed can be shared with someone without an account.
+	// A link made with once is accepted a single time.
+	CreateStreamLink(ctx context.Context, in *CreateStreamLinkRequest, opts ...grpc.CallOption) (*CreateStreamLinkResponse, error)
+	// ForceClose closes a port's session regardless of its session ID, for
+	// administrators taking over a stuck port. With notify, the reason is sent
+	// to the port's event stream first as a "notice" event, and the session
+	// gets the grace period to finish before it is closed.
+	ForceClose(ctx context.Context, in *ForceCloseRequest, opts ...grpc.CallOption) (*ForceCloseResponse, error)
+	// GetRecentOutput returns the recent output buffered for a console-logged port
+	GetRecentOutput(ctx context.Context, in *GetRecentOutputRequest, opts ...grpc.CallOption) (*GetRecentOutputResponse, error)
+	// GetKeywordStats returns how many console lines of each port held the
+	// configured keywords, in total and within sliding windows
+	GetKeywordStats(ctx context.Context, in *GetKeywordStatsRequest, opts ...grpc.CallOption) (*GetKeywordStatsResponse, error)
+	// ListRecordings lists the recorded interactive sessions, newest first
+	ListRecordings(ctx context.Context, in *ListRecordingsRequest, opts ...grpc.CallOption) (*ListRecordingsResponse, error)
+	// FetchRecording streams the asciicast file of a recording
+	FetchRecording(ctx context.Context, in *FetchRecordingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FetchRecordingResponse], error)
+	// GetRecentErrors returns the latest failed reads and writes of a session,
+	// oldest first, so clients can debug flaky behavior without the agent log
+	GetRecentErrors(ctx context.Context, in *GetRecentErrorsRequest, opts ...grpc.CallOption) (*GetRecentErrorsResponse, error)
+	// DiagnoseLine sweeps common line settings and ranks them by readability
+	DiagnoseLine(ctx context.Context, in *DiagnoseLineRequest, opts ...grpc.CallOption) (*DiagnoseLineResponse, error)
+	// Verify sends a command and compares the response with the expected bytes
+	// or pattern, for functional tests
+	Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyResponse, error)
+	// ReadMeter runs an IEC 62056-21 mode C data readout on a port: the
+	// exchange starts at 300 baud, 7E1, and switches to the speed the meter
+	// offers. The session's line settings are restored afterwards.
+	ReadMeter(ctx context.Context, in *ReadMeterRequest, opts ...grpc.CallOption) (*ReadMeterResponse, error)
+	// ListTestSuites returns the configured test suites
+	ListTestSuites(ctx context.Context, in *ListTestSuitesRequest, opts ...grpc.CallOption) (*ListTestSuitesResponse, error)
+	// RunTestSuite runs a test suite on an open session and returns its report
+	RunTestSuite(ctx context.Context, in *RunTestSuiteRequest, opts ...grpc.CallOption) (*RunTestSuiteResponse, error)
+	// SynchronizedWrite sends payloads to several ports at the same instant
+	SynchronizedWrite(ctx context.Context, in *SynchronizedWriteRequest, opts ...grpc.CallOption) (*SynchronizedWriteResponse, error)
+	// ResetTarget pulses the GPIO reset line wired to the device on a port
+	ResetTarget(ctx context.Context, in *ResetTargetRequest, opts ...grpc.CallOption) (*ResetTargetResponse, error)
+	// ListBusDevices lists the I2C and SPI devices exposed by bus providers
+	ListBusDevices(ctx context.Context, in *ListBusDevicesRequest, opts ...grpc.CallOption) (*ListBusDevicesResponse, error)
+	// I2CTransfer writes to and/or reads from a target on an I2C bus
+	I2CTransfer(ctx context.Context, in *I2CTransferRequest, opts ...grpc.CallOption) (*I2CTransferResponse, error)
+	// SPITransfer runs a full-duplex transfer on an SPI bus
+	SPITransfer(ctx context.Context, in *SPITransferRequest, opts ...grpc.CallOption) (*SPITransferResponse, error)
+	// SendSMS sends a text message through a cellular modem on a port
+	SendSMS(ctx context.Context, in *SendSMSRequest, opts ...grpc.CallOption) (*SendSMSResponse, error)
+	// ReadSMS lists the messages stored on a cellular modem
+	ReadSMS(ctx context.Context, in *ReadSMSRequest, opts ...grpc.CallOption) (*ReadSMSResponse, error)
+	// GetModemStatus returns the signal strength and network registration of a
+	// cellular modem
+	GetModemStatus(ctx context.Context, in *GetModemStatusRequest, opts ...grpc.CallOption) (*GetModemStatusResponse, error)
+	// HandOffPPP prepares a cellular modem for a PPP data call and releases the
+	// port so pppd can take it over
+	HandOffPPP(ctx context.Context, in *HandOffPPPRequest, opts ...grpc.CallOption) (*HandOffPPPResponse, error)
+	// PrintText prints text on an ESC/POS printer
+	PrintText(ctx context.Context, in *PrintTextRequest, opts ...grpc.CallOption) (*PrintResponse, error)
+	// StreamScans streams one event per barcode read from a scanner on a port
+	StreamScans(ctx context.Context, in *StreamScansRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamScansResponse], error)
+	// StreamAnnotated streams the frames a protocol decoder finds in the data
+	// of a port, each with a description next to its raw bytes
+	StreamAnnotated(ctx context.Context, in *StreamAnnotatedRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamAnnotatedResponse], error)
+	// Paste sends a text one line at a time, pacing the lines by a prompt, the
+	// line's echo or a delay, and streams the progress. Each line is its own
+	// transaction, so other operations on the port run in between.
+	Paste(ctx context.Context, in *PasteRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PasteResponse], error)
+	// StreamPolledValues streams the values parsed by configured pollers,
+	// starting with the latest sample of each
+	StreamPolledValues(ctx context.Context, in *StreamPolledValuesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamPolledValuesResponse], error)
+	// QueryHistory returns the recorded history of a poller's values
+	QueryHistory(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error)
+	// ListAlarms returns the alarms that are active or awaiting acknowledgment
+	ListAlarms(ctx context.Context, in *ListAlarmsRequest, opts ...grpc.CallOption) (*ListAlarmsResponse, error)
+	// AcknowledgeAlarm records that an operator has seen an alarm
+	AcknowledgeAlarm(ctx context.Context, in *AcknowledgeAlarmRequest, opts ...grpc.CallOption) (*AcknowledgeAlarmResponse, error)
+	// ListDeviceStates returns the current state of tracked devices
+	ListDeviceStates(ctx context.Context, in *ListDeviceStatesRequest, opts ...grpc.CallOption) (*ListDeviceStatesResponse, error)
+	// StreamDeviceStates sends the current state of tracked devices, then every
+	// transition
+	StreamDeviceStates(ctx context.Context, in *StreamDeviceStatesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamDeviceStatesResponse], error)
+	// StreamPortStatus sends the status of a port when the stream starts, on
+	// every event of the port and every interval; with on_change, interval
+	// snapshots are only sent when the status changed
+	StreamPortStatus(ctx context.Context, in *StreamPortStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamPortStatusResponse], error)
+	// ListPendingWrites returns the writes held for approval
+	ListPendingWrites(ctx context.Context, in *ListPendingWritesRequest, opts ...grpc.CallOption) (*ListPendingWritesResponse, error)
+	// ApproveWrite sends a held write on behalf of its requester. The approver
+	// must be a different client.
+	ApproveWrite(ctx context.Context, in *ApproveWriteRequest, opts ...grpc.CallOption) (*ApproveWriteResponse, error)
+	// RejectWrite discards a held write
+	RejectWrite(ctx context.Context, in *RejectWriteRequest, opts ...grpc.CallOption) (*RejectWriteResponse, error)
+	// PrintRaster prints an image on an ESC/POS printer
+	PrintRaster(ctx context.Context, in *PrintRasterRequest, opts ...grpc.CallOption) (*PrintResponse, error)
+	// CutPaper feeds and cuts the paper on an ESC/POS printer
+	CutPaper(ctx context.Context, in *CutPaperRequest, opts ...grpc.CallOption) (*PrintResponse, error)
+	// GetPrinterStatus polls the real-time status of an ESC/POS printer
+	GetPrinterStatus(ctx context.Context, in *GetPrinterStatusRequest, opts ...grpc.CallOption) (*GetPrinterStatusResponse, error)
+	// CreateReservation books a port for a time window. The holder defaults to
+	// the caller's identity.
+	CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error)
+	// ListReservations returns the current and upcoming reservations
+	ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error)
+	// CancelReservation removes a reservation on behalf of its holder or creator
+	CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*CancelReservationResponse, error)
+	// GetUsageReport returns the cumulative use of ports per client
+	GetUsageReport(ctx context.Context, in *GetUsageReportRequest, opts ...grpc.CallOption) (*GetUsageReportResponse, error)
+	// SetPowerState makes a session dormant or wakes it. A dormant session is
+	// not read or streamed until the client next uses it, it is woken, or data
+	// matching a wake pattern arrives.
+	SetPowerState(ctx context.Context, in *SetPowerStateRequest, opts ...grpc.CallOption) (*SetPowerStateResponse, error)
+	// SetShaping throttles a session's traffic to emulate a slow link. A
+	// missing or zero shaping lifts the limits.
+	SetShaping(ctx context.Context, in *SetShapingRequest, opts ...grpc.CallOption) (*SetShapingResponse, error)
+	// GetAgentStats returns totals across all sessions and the agent's resource
+	// use, for health dashboards
+	GetAgentStats(ctx context.Context, in *GetAgentStatsRequest, opts ...grpc.CallOption) (*GetAgentStatsResponse, error)
+	// SetDebugEndpoints starts or stops the debug listener serving pprof,
+	// expvar and runtime dumps
+	SetDebugEndpoints(ctx context.Context, in *SetDebugEndpointsRequest, opts ...grpc.CallOption) (*SetDebugEndpointsResponse, error)
+	// BridgePorts forwards the data of one open port to another, and back
+	// unless one_way is set. The caller must hold both sessions.
+	BridgePorts(ctx context.Context, in *BridgePortsRequest, opts ...grpc.CallOption) (*BridgePortsResponse, error)
+	// ListBridges returns the running bridges
+	ListBridges(ctx context.Context, in *ListBridgesRequest, opts ...grpc.CallOption) (*ListBridgesResponse, error)
+	// StopBridge stops a bridge on behalf of the holder of either of its
+	// sessions. The ports stay open.
+	StopBridge(ctx context.Context, in *StopBridgeRequest, opts ...grpc.CallOption) (*StopBridgeResponse, error)
+	// SetBridgeRules replaces the interception rules of a running bridge on
+	// behalf of the holder of either of its sessions; no rules forward the data
+	// unchanged again
+	SetBridgeRules(ctx context.Context, in *SetBridgeRulesRequest, opts ...grpc.CallOption) (*SetBridgeRulesResponse, error)
+	// StartGcodeJob starts sending a G-code program to a printer or CNC
+	// controller with the flow control of its firmware. The job runs on the
+	// agent until it ends, whether or not the client stays connected.
+	StartGcodeJob(ctx context.Context, in *StartGcodeJobRequest, opts ...grpc.CallOption) (*StartGcodeJobResponse, error)
+	// ControlGcodeJob pauses, resumes or cancels the job of a port on behalf of
+	// the holder of its session
+	ControlGcodeJob(ctx context.Context, in *ControlGcodeJobRequest, opts ...grpc.CallOption) (*ControlGcodeJobResponse, error)
+	// GetGcodeJob returns the progress of the job of a port, which may have
+	// finished
+	GetGcodeJob(ctx context.Context, in *GetGcodeJobRequest, opts ...grpc.CallOption) (*GetGcodeJobResponse, error)
+	// StreamGcodeJob sends the progress of the job of a port at an interval
+	// until it ends, the last message carrying its final state
+	StreamGcodeJob(ctx context.Context, in *StreamGcodeJobRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamGcodeJobResponse], error)
+	// ConnectMachine puts an open port in G-code mode, so the status of its
+	// printer or CNC controller is polled and it can be jogged between jobs,
+	// until DisconnectMachine
+	ConnectMachine(ctx context.Context, in *ConnectMachineRequest, opts ...grpc.CallOption) (*ConnectMachineResponse, error)
+	// DisconnectMachine takes a port out of G-code mode on behalf of the holder
+	// of its session; the port stays open
+	DisconnectMachine(ctx context.Context, in *DisconnectMachineRequest, opts ...grpc.CallOption) (*DisconnectMachineResponse, error)
+	// StreamMachineStatus sends the status of the machine of a port at an
+	// interval, or when it changes, until the machine is disconnected
+	StreamMachineStatus(ctx context.Context, in *StreamMachineStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamMachineStatusResponse], error)
+	// JogMachine moves the machine of a port by a distance on behalf of the
+	// holder of its session, once it has taken the move. Jogging is refused
+	// while a job runs.
+	JogMachine(ctx context.Context, in *JogMachineRequest, opts ...grpc.CallOption) (*JogMachineResponse, error)
+	// SendMachineCommand sends a feed hold, cycle start or reset to the machine
+	// of a port on behalf of the holder of its session
+	SendMachineCommand(ctx context.Context, in *SendMachineCommandRequest, opts ...grpc.CallOption) (*SendMachineCommandResponse, error)
+	// UploadFirmware resets an Arduino-style board into its bootloader and
+	// flashes a firmware image, streaming the progress. The port must not be
+	// open; the upload holds it until it ends.
+	UploadFirmware(ctx context.Context, in *UploadFirmwareRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UploadFirmwareResponse], error)
+}
+
+type serialServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSerialServiceClient(cc grpc.ClientConnInterface) SerialServiceClient {
+	return &serialServiceClient{cc}
+}
+
+func (c *serialServiceClient) ListPorts(ctx context.Context, in *ListPortsRequest, opts ...grpc.CallOption) (*ListPortsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPortsResponse)
+	err := c.cc.Invoke(ctx, SerialService_ListPorts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) GetPortInfo(ctx context.Context, in *GetPortInfoRequest, opts ...grpc.CallOption) (*GetPortInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortInfoResponse)
+	err := c.cc.Invoke(ctx, SerialService_GetPortInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) GetPortCapabilities(ctx context.Context, in *GetPortCapabilitiesRequest, opts ...grpc.CallOption) (*GetPortCapabilitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, SerialService_GetPortCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) OpenPort(ctx context.Context, in *OpenPortRequest, opts ...grpc.CallOption) (*OpenPortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenPortResponse)
+	err := c.cc.Invoke(ctx, SerialService_OpenPort_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ClosePort(ctx context.Context, in *ClosePortRequest, opts ...grpc.CallOption) (*ClosePortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClosePortResponse)
+	err := c.cc.Invoke(ctx, SerialService_ClosePort_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) GetPortStatus(ctx context.Context, in *GetPortStatusRequest, opts ...grpc.CallOption) (*GetPortStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortStatusResponse)
+	err := c.cc.Invoke(ctx, SerialService_GetPortStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*WriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteResponse)
+	err := c.cc.Invoke(ctx, SerialService_Write_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadResponse)
+	err := c.cc.Invoke(ctx, SerialService_Read_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) StreamRead(ctx context.Context, in *StreamReadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamReadResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SerialService_ServiceDesc.Streams[0], SerialService_StreamRead_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamReadRequest, StreamReadResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamReadClient = grpc.ServerStreamingClient[StreamReadResponse]
+
+func (c *serialServiceClient) StreamTimedRead(ctx context.Context, in *StreamTimedReadRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamTimedReadResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SerialService_ServiceDesc.Streams[1], SerialService_StreamTimedRead_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamTimedReadRequest, StreamTimedReadResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamTimedReadClient = grpc.ServerStreamingClient[StreamTimedReadResponse]
+
+func (c *serialServiceClient) StreamWrite(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StreamWriteRequest, StreamWriteResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SerialService_ServiceDesc.Streams[2], SerialService_StreamWrite_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamWriteRequest, StreamWriteResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamWriteClient = grpc.ClientStreamingClient[StreamWriteRequest, StreamWriteResponse]
+
+func (c *serialServiceClient) BiDirectionalStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BiDirectionalStreamRequest, BiDirectionalStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SerialService_ServiceDesc.Streams[3], SerialService_BiDirectionalStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BiDirectionalStreamRequest, BiDirectionalStreamResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_BiDirectionalStreamClient = grpc.BidiStreamingClient[BiDirectionalStreamRequest, BiDirectionalStreamResponse]
+
+func (c *serialServiceClient) ConfigurePort(ctx context.Context, in *ConfigurePortRequest, opts ...grpc.CallOption) (*ConfigurePortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigurePortResponse)
+	err := c.cc.Invoke(ctx, SerialService_ConfigurePort_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) GetPortConfig(ctx context.Context, in *GetPortConfigRequest, opts ...grpc.CallOption) (*GetPortConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPortConfigResponse)
+	err := c.cc.Invoke(ctx, SerialService_GetPortConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, SerialService_Ping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) GetAgentInfo(ctx context.Context, in *GetAgentInfoRequest, opts ...grpc.CallOption) (*GetAgentInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAgentInfoResponse)
+	err := c.cc.Invoke(ctx, SerialService_GetAgentInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) GetMemoryStats(ctx context.Context, in *GetMemoryStatsRequest, opts ...grpc.CallOption) (*GetMemoryStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMemoryStatsResponse)
+	err := c.cc.Invoke(ctx, SerialService_GetMemoryStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ListStreams(ctx context.Context, in *ListStreamsRequest, opts ...grpc.CallOption) (*ListStreamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStreamsResponse)
+	err := c.cc.Invoke(ctx, SerialService_ListStreams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, SerialService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) CreateAccessToken(ctx context.Context, in *CreateAccessTokenRequest, opts ...grpc.CallOption) (*CreateAccessTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAccessTokenResponse)
+	err := c.cc.Invoke(ctx, SerialService_CreateAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) CreateStreamLink(ctx context.Context, in *CreateStreamLinkRequest, opts ...grpc.CallOption) (*CreateStreamLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateStreamLinkResponse)
+	err := c.cc.Invoke(ctx, SerialService_CreateStreamLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ForceClose(ctx context.Context, in *ForceCloseRequest, opts ...grpc.CallOption) (*ForceCloseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForceCloseResponse)
+	err := c.cc.Invoke(ctx, SerialService_ForceClose_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) GetRecentOutput(ctx context.Context, in *GetRecentOutputRequest, opts ...grpc.CallOption) (*GetRecentOutputResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecentOutputResponse)
+	err := c.cc.Invoke(ctx, SerialService_GetRecentOutput_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) GetKeywordStats(ctx context.Context, in *GetKeywordStatsRequest, opts ...grpc.CallOption) (*GetKeywordStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKeywordStatsResponse)
+	err := c.cc.Invoke(ctx, SerialService_GetKeywordStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ListRecordings(ctx context.Context, in *ListRecordingsRequest, opts ...grpc.CallOption) (*ListRecordingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecordingsResponse)
+	err := c.cc.Invoke(ctx, SerialService_ListRecordings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) FetchRecording(ctx context.Context, in *FetchRecordingRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FetchRecordingResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SerialService_ServiceDesc.Streams[4], SerialService_FetchRecording_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[FetchRecordingRequest, FetchRecordingResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_FetchRecordingClient = grpc.ServerStreamingClient[FetchRecordingResponse]
+
+func (c *serialServiceClient) GetRecentErrors(ctx context.Context, in *GetRecentErrorsRequest, opts ...grpc.CallOption) (*GetRecentErrorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecentErrorsResponse)
+	err := c.cc.Invoke(ctx, SerialService_GetRecentErrors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) DiagnoseLine(ctx context.Context, in *DiagnoseLineRequest, opts ...grpc.CallOption) (*DiagnoseLineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiagnoseLineResponse)
+	err := c.cc.Invoke(ctx, SerialService_DiagnoseLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyResponse)
+	err := c.cc.Invoke(ctx, SerialService_Verify_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ReadMeter(ctx context.Context, in *ReadMeterRequest, opts ...grpc.CallOption) (*ReadMeterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadMeterResponse)
+	err := c.cc.Invoke(ctx, SerialService_ReadMeter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ListTestSuites(ctx context.Context, in *ListTestSuitesRequest, opts ...grpc.CallOption) (*ListTestSuitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTestSuitesResponse)
+	err := c.cc.Invoke(ctx, SerialService_ListTestSuites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) RunTestSuite(ctx context.Context, in *RunTestSuiteRequest, opts ...grpc.CallOption) (*RunTestSuiteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunTestSuiteResponse)
+	err := c.cc.Invoke(ctx, SerialService_RunTestSuite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) SynchronizedWrite(ctx context.Context, in *SynchronizedWriteRequest, opts ...grpc.CallOption) (*SynchronizedWriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SynchronizedWriteResponse)
+	err := c.cc.Invoke(ctx, SerialService_SynchronizedWrite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ResetTarget(ctx context.Context, in *ResetTargetRequest, opts ...grpc.CallOption) (*ResetTargetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetTargetResponse)
+	err := c.cc.Invoke(ctx, SerialService_ResetTarget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ListBusDevices(ctx context.Context, in *ListBusDevicesRequest, opts ...grpc.CallOption) (*ListBusDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBusDevicesResponse)
+	err := c.cc.Invoke(ctx, SerialService_ListBusDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) I2CTransfer(ctx context.Context, in *I2CTransferRequest, opts ...grpc.CallOption) (*I2CTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(I2CTransferResponse)
+	err := c.cc.Invoke(ctx, SerialService_I2CTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) SPITransfer(ctx context.Context, in *SPITransferRequest, opts ...grpc.CallOption) (*SPITransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SPITransferResponse)
+	err := c.cc.Invoke(ctx, SerialService_SPITransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) SendSMS(ctx context.Context, in *SendSMSRequest, opts ...grpc.CallOption) (*SendSMSResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendSMSResponse)
+	err := c.cc.Invoke(ctx, SerialService_SendSMS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ReadSMS(ctx context.Context, in *ReadSMSRequest, opts ...grpc.CallOption) (*ReadSMSResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadSMSResponse)
+	err := c.cc.Invoke(ctx, SerialService_ReadSMS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) GetModemStatus(ctx context.Context, in *GetModemStatusRequest, opts ...grpc.CallOption) (*GetModemStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModemStatusResponse)
+	err := c.cc.Invoke(ctx, SerialService_GetModemStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) HandOffPPP(ctx context.Context, in *HandOffPPPRequest, opts ...grpc.CallOption) (*HandOffPPPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HandOffPPPResponse)
+	err := c.cc.Invoke(ctx, SerialService_HandOffPPP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) PrintText(ctx context.Context, in *PrintTextRequest, opts ...grpc.CallOption) (*PrintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrintResponse)
+	err := c.cc.Invoke(ctx, SerialService_PrintText_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) StreamScans(ctx context.Context, in *StreamScansRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamScansResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SerialService_ServiceDesc.Streams[5], SerialService_StreamScans_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamScansRequest, StreamScansResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamScansClient = grpc.ServerStreamingClient[StreamScansResponse]
+
+func (c *serialServiceClient) StreamAnnotated(ctx context.Context, in *StreamAnnotatedRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamAnnotatedResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SerialService_ServiceDesc.Streams[6], SerialService_StreamAnnotated_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamAnnotatedRequest, StreamAnnotatedResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamAnnotatedClient = grpc.ServerStreamingClient[StreamAnnotatedResponse]
+
+func (c *serialServiceClient) Paste(ctx context.Context, in *PasteRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PasteResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SerialService_ServiceDesc.Streams[7], SerialService_Paste_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PasteRequest, PasteResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_PasteClient = grpc.ServerStreamingClient[PasteResponse]
+
+func (c *serialServiceClient) StreamPolledValues(ctx context.Context, in *StreamPolledValuesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamPolledValuesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SerialService_ServiceDesc.Streams[8], SerialService_StreamPolledValues_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamPolledValuesRequest, StreamPolledValuesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamPolledValuesClient = grpc.ServerStreamingClient[StreamPolledValuesResponse]
+
+func (c *serialServiceClient) QueryHistory(ctx context.Context, in *QueryHistoryRequest, opts ...grpc.CallOption) (*QueryHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryHistoryResponse)
+	err := c.cc.Invoke(ctx, SerialService_QueryHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ListAlarms(ctx context.Context, in *ListAlarmsRequest, opts ...grpc.CallOption) (*ListAlarmsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlarmsResponse)
+	err := c.cc.Invoke(ctx, SerialService_ListAlarms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) AcknowledgeAlarm(ctx context.Context, in *AcknowledgeAlarmRequest, opts ...grpc.CallOption) (*AcknowledgeAlarmResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcknowledgeAlarmResponse)
+	err := c.cc.Invoke(ctx, SerialService_AcknowledgeAlarm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ListDeviceStates(ctx context.Context, in *ListDeviceStatesRequest, opts ...grpc.CallOption) (*ListDeviceStatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeviceStatesResponse)
+	err := c.cc.Invoke(ctx, SerialService_ListDeviceStates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) StreamDeviceStates(ctx context.Context, in *StreamDeviceStatesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamDeviceStatesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SerialService_ServiceDesc.Streams[9], SerialService_StreamDeviceStates_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamDeviceStatesRequest, StreamDeviceStatesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamDeviceStatesClient = grpc.ServerStreamingClient[StreamDeviceStatesResponse]
+
+func (c *serialServiceClient) StreamPortStatus(ctx context.Context, in *StreamPortStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamPortStatusResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SerialService_ServiceDesc.Streams[10], SerialService_StreamPortStatus_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamPortStatusRequest, StreamPortStatusResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamPortStatusClient = grpc.ServerStreamingClient[StreamPortStatusResponse]
+
+func (c *serialServiceClient) ListPendingWrites(ctx context.Context, in *ListPendingWritesRequest, opts ...grpc.CallOption) (*ListPendingWritesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingWritesResponse)
+	err := c.cc.Invoke(ctx, SerialService_ListPendingWrites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ApproveWrite(ctx context.Context, in *ApproveWriteRequest, opts ...grpc.CallOption) (*ApproveWriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveWriteResponse)
+	err := c.cc.Invoke(ctx, SerialService_ApproveWrite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) RejectWrite(ctx context.Context, in *RejectWriteRequest, opts ...grpc.CallOption) (*RejectWriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectWriteResponse)
+	err := c.cc.Invoke(ctx, SerialService_RejectWrite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) PrintRaster(ctx context.Context, in *PrintRasterRequest, opts ...grpc.CallOption) (*PrintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrintResponse)
+	err := c.cc.Invoke(ctx, SerialService_PrintRaster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) CutPaper(ctx context.Context, in *CutPaperRequest, opts ...grpc.CallOption) (*PrintResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrintResponse)
+	err := c.cc.Invoke(ctx, SerialService_CutPaper_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) GetPrinterStatus(ctx context.Context, in *GetPrinterStatusRequest, opts ...grpc.CallOption) (*GetPrinterStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPrinterStatusResponse)
+	err := c.cc.Invoke(ctx, SerialService_GetPrinterStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) CreateReservation(ctx context.Context, in *CreateReservationRequest, opts ...grpc.CallOption) (*CreateReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReservationResponse)
+	err := c.cc.Invoke(ctx, SerialService_CreateReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ListReservations(ctx context.Context, in *ListReservationsRequest, opts ...grpc.CallOption) (*ListReservationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReservationsResponse)
+	err := c.cc.Invoke(ctx, SerialService_ListReservations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*CancelReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelReservationResponse)
+	err := c.cc.Invoke(ctx, SerialService_CancelReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) GetUsageReport(ctx context.Context, in *GetUsageReportRequest, opts ...grpc.CallOption) (*GetUsageReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageReportResponse)
+	err := c.cc.Invoke(ctx, SerialService_GetUsageReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) SetPowerState(ctx context.Context, in *SetPowerStateRequest, opts ...grpc.CallOption) (*SetPowerStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPowerStateResponse)
+	err := c.cc.Invoke(ctx, SerialService_SetPowerState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) SetShaping(ctx context.Context, in *SetShapingRequest, opts ...grpc.CallOption) (*SetShapingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetShapingResponse)
+	err := c.cc.Invoke(ctx, SerialService_SetShaping_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) GetAgentStats(ctx context.Context, in *GetAgentStatsRequest, opts ...grpc.CallOption) (*GetAgentStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAgentStatsResponse)
+	err := c.cc.Invoke(ctx, SerialService_GetAgentStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) SetDebugEndpoints(ctx context.Context, in *SetDebugEndpointsRequest, opts ...grpc.CallOption) (*SetDebugEndpointsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDebugEndpointsResponse)
+	err := c.cc.Invoke(ctx, SerialService_SetDebugEndpoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) BridgePorts(ctx context.Context, in *BridgePortsRequest, opts ...grpc.CallOption) (*BridgePortsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BridgePortsResponse)
+	err := c.cc.Invoke(ctx, SerialService_BridgePorts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ListBridges(ctx context.Context, in *ListBridgesRequest, opts ...grpc.CallOption) (*ListBridgesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBridgesResponse)
+	err := c.cc.Invoke(ctx, SerialService_ListBridges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) StopBridge(ctx context.Context, in *StopBridgeRequest, opts ...grpc.CallOption) (*StopBridgeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopBridgeResponse)
+	err := c.cc.Invoke(ctx, SerialService_StopBridge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) SetBridgeRules(ctx context.Context, in *SetBridgeRulesRequest, opts ...grpc.CallOption) (*SetBridgeRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBridgeRulesResponse)
+	err := c.cc.Invoke(ctx, SerialService_SetBridgeRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) StartGcodeJob(ctx context.Context, in *StartGcodeJobRequest, opts ...grpc.CallOption) (*StartGcodeJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartGcodeJobResponse)
+	err := c.cc.Invoke(ctx, SerialService_StartGcodeJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) ControlGcodeJob(ctx context.Context, in *ControlGcodeJobRequest, opts ...grpc.CallOption) (*ControlGcodeJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ControlGcodeJobResponse)
+	err := c.cc.Invoke(ctx, SerialService_ControlGcodeJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) GetGcodeJob(ctx context.Context, in *GetGcodeJobRequest, opts ...grpc.CallOption) (*GetGcodeJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGcodeJobResponse)
+	err := c.cc.Invoke(ctx, SerialService_GetGcodeJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) StreamGcodeJob(ctx context.Context, in *StreamGcodeJobRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamGcodeJobResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SerialService_ServiceDesc.Streams[11], SerialService_StreamGcodeJob_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamGcodeJobRequest, StreamGcodeJobResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamGcodeJobClient = grpc.ServerStreamingClient[StreamGcodeJobResponse]
+
+func (c *serialServiceClient) ConnectMachine(ctx context.Context, in *ConnectMachineRequest, opts ...grpc.CallOption) (*ConnectMachineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConnectMachineResponse)
+	err := c.cc.Invoke(ctx, SerialService_ConnectMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) DisconnectMachine(ctx context.Context, in *DisconnectMachineRequest, opts ...grpc.CallOption) (*DisconnectMachineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisconnectMachineResponse)
+	err := c.cc.Invoke(ctx, SerialService_DisconnectMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) StreamMachineStatus(ctx context.Context, in *StreamMachineStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamMachineStatusResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SerialService_ServiceDesc.Streams[12], SerialService_StreamMachineStatus_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamMachineStatusRequest, StreamMachineStatusResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamMachineStatusClient = grpc.ServerStreamingClient[StreamMachineStatusResponse]
+
+func (c *serialServiceClient) JogMachine(ctx context.Context, in *JogMachineRequest, opts ...grpc.CallOption) (*JogMachineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JogMachineResponse)
+	err := c.cc.Invoke(ctx, SerialService_JogMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) SendMachineCommand(ctx context.Context, in *SendMachineCommandRequest, opts ...grpc.CallOption) (*SendMachineCommandResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendMachineCommandResponse)
+	err := c.cc.Invoke(ctx, SerialService_SendMachineCommand_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serialServiceClient) UploadFirmware(ctx context.Context, in *UploadFirmwareRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[UploadFirmwareResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SerialService_ServiceDesc.Streams[13], SerialService_UploadFirmware_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadFirmwareRequest, UploadFirmwareResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_UploadFirmwareClient = grpc.ServerStreamingClient[UploadFirmwareResponse]
+
+// SerialServiceServer is the server API for SerialService service.
+// All implementations must embed UnimplementedSerialServiceServer
+// for forward compatibility.
+type SerialServiceServer interface {
+	// ListPorts returns all available serial ports
+	ListPorts(context.Context, *ListPortsRequest) (*ListPortsResponse, error)
+	// GetPortInfo returns information about a specific port
+	GetPortInfo(context.Context, *GetPortInfoRequest) (*GetPortInfoResponse, error)
+	// GetPortCapabilities reports what a port and its driver support on the
+	// agent's platform
+	GetPortCapabilities(context.Context, *GetPortCapabilitiesRequest) (*GetPortCapabilitiesResponse, error)
+	// OpenPort opens a serial port
+	OpenPort(context.Context, *OpenPortRequest) (*OpenPortResponse, error)
+	// ClosePort closes a serial port. Given only a session ID the port is
+	// looked up; given only a port name the caller must be the session's
+	// opener.
+	ClosePort(context.Context, *ClosePortRequest) (*ClosePortResponse, error)
+	// GetPortStatus returns the status of a port
+	GetPortStatus(context.Context, *GetPortStatusRequest) (*GetPortStatusResponse, error)
+	// Write writes data to a port
+	Write(context.Context, *WriteRequest) (*WriteResponse, error)
+	// Read reads data from a port
+	Read(context.Context, *ReadRequest) (*ReadResponse, error)
+	// StreamRead streams data from a port
+	StreamRead(*StreamReadRequest, grpc.ServerStreamingServer[StreamReadResponse]) error
+	// StreamTimedRead streams data with a timestamp per read system call, for
+	// protocol analysis where inter-byte gaps matter
+	StreamTimedRead(*StreamTimedReadRequest, grpc.ServerStreamingServer[StreamTimedReadResponse]) error
+	// StreamWrite writes streaming data to a port
+	StreamWrite(grpc.ClientStreamingServer[StreamWriteRequest, StreamWriteResponse]) error
+	// BiDirectionalStream handles bidirectional streaming
+	BiDirectionalStream(grpc.BidiStreamingServer[BiDirectionalStreamRequest, BiDirectionalStreamResponse]) error
+	// ConfigurePort configures a port
+	ConfigurePort(context.Context, *ConfigurePortRequest) (*ConfigurePortResponse, error)
+	// GetPortConfig returns the current configuration of a port
+	GetPortConfig(context.Context, *GetPortConfigRequest) (*GetPortConfigResponse, error)
+	// Ping checks if the server is alive
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	// GetAgentInfo returns information about the agent
+	GetAgentInfo(context.Context, *GetAgentInfoRequest) (*GetAgentInfoResponse, error)
+	// GetMemoryStats reports the memory held in stream queues against the
+	// configured limits, with the Go runtime's heap figures
+	GetMemoryStats(context.Context, *GetMemoryStatsRequest) (*GetMemoryStatsResponse, error)
+	// ListStreams reports, per read subscription, the data delivered and
+	// dropped and how far the consumer lags behind, to find slow consumers
+	ListStreams(context.Context, *ListStreamsRequest) (*ListStreamsResponse, error)
+	// ListSessions returns the open sessions across all ports, optionally of
+	// one port or client. Under an access policy, only sessions on ports the
+	// caller may use are listed.
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// CreateAccessToken mints a short-lived token limited to one port,
+	// read-only unless asked otherwise, to embed in browser dashboards without
+	// handing out long-lived credentials
+	CreateAccessToken(context.Context, *CreateAccessTokenRequest) (*CreateAccessTokenResponse, error)
+	// CreateStreamLink mints URLs of the HTTP event stream and the WebSocket
+	// gateway that carry a token allowing nothing but following one port's
+	// data, so a console feed can be shared with someone without an account.
+	// A link made with once is accepted a single time.
+	CreateStreamLink(context.Context, *CreateStreamLinkRequest) (*CreateStreamLinkResponse, error)
+	// ForceClose closes a port's session regardless of its session ID, for
+	// administrators taking over a stuck port. With notify, the reason is sent
+	// to the port's event stream first as a "notice" event, and the session
+	// gets the grace period to finish before it is closed.
+	ForceClose(context.Context, *ForceCloseRequest) (*ForceCloseResponse, error)
+	// GetRecentOutput returns the recent output buffered for a console-logged port
+	GetRecentOutput(context.Context, *GetRecentOutputRequest) (*GetRecentOutputResponse, error)
+	// GetKeywordStats returns how many console lines of each port held the
+	// configured keywords, in total and within sliding windows
+	GetKeywordStats(context.Context, *GetKeywordStatsRequest) (*GetKeywordStatsResponse, error)
+	// ListRecordings lists the recorded interactive sessions, newest first
+	ListRecordings(context.Context, *ListRecordingsRequest) (*ListRecordingsResponse, error)
+	// FetchRecording streams the asciicast file of a recording
+	FetchRecording(*FetchRecordingRequest, grpc.ServerStreamingServer[FetchRecordingResponse]) error
+	// GetRecentErrors returns the latest failed reads and writes of a session,
+	// oldest first, so clients can debug flaky behavior without the agent log
+	GetRecentErrors(context.Context, *GetRecentErrorsRequest) (*GetRecentErrorsResponse, error)
+	// DiagnoseLine sweeps common line settings and ranks them by readability
+	DiagnoseLine(context.Context, *DiagnoseLineRequest) (*DiagnoseLineResponse, error)
+	// Verify sends a command and compares the response with the expected bytes
+	// or pattern, for functional tests
+	Verify(context.Context, *VerifyRequest) (*VerifyResponse, error)
+	// ReadMeter runs an IEC 62056-21 mode C data readout on a port: the
+	// exchange starts at 300 baud, 7E1, and switches to the speed the meter
+	// offers. The session's line settings are restored afterwards.
+	ReadMeter(context.Context, *ReadMeterRequest) (*ReadMeterResponse, error)
+	// ListTestSuites returns the configured test suites
+	ListTestSuites(context.Context, *ListTestSuitesRequest) (*ListTestSuitesResponse, error)
+	// RunTestSuite runs a test suite on an open session and returns its report
+	RunTestSuite(context.Context, *RunTestSuiteRequest) (*RunTestSuiteResponse, error)
+	// SynchronizedWrite sends payloads to several ports at the same instant
+	SynchronizedWrite(context.Context, *SynchronizedWriteRequest) (*SynchronizedWriteResponse, error)
+	// ResetTarget pulses the GPIO reset line wired to the device on a port
+	ResetTarget(context.Context, *ResetTargetRequest) (*ResetTargetResponse, error)
+	// ListBusDevices lists the I2C and SPI devices exposed by bus providers
+	ListBusDevices(context.Context, *ListBusDevicesRequest) (*ListBusDevicesResponse, error)
+	// I2CTransfer writes to and/or reads from a target on an I2C bus
+	I2CTransfer(context.Context, *I2CTransferRequest) (*I2CTransferResponse, error)
+	// SPITransfer runs a full-duplex transfer on an SPI bus
+	SPITransfer(context.Context, *SPITransferRequest) (*SPITransferResponse, error)
+	// SendSMS sends a text message through a cellular modem on a port
+	SendSMS(context.Context, *SendSMSRequest) (*SendSMSResponse, error)
+	// ReadSMS lists the messages stored on a cellular modem
+	ReadSMS(context.Context, *ReadSMSRequest) (*ReadSMSResponse, error)
+	// GetModemStatus returns the signal strength and network registration of a
+	// cellular modem
+	GetModemStatus(context.Context, *GetModemStatusRequest) (*GetModemStatusResponse, error)
+	// HandOffPPP prepares a cellular modem for a PPP data call and releases the
+	// port so pppd can take it over
+	HandOffPPP(context.Context, *HandOffPPPRequest) (*HandOffPPPResponse, error)
+	// PrintText prints text on an ESC/POS printer
+	PrintText(context.Context, *PrintTextRequest) (*PrintResponse, error)
+	// StreamScans streams one event per barcode read from a scanner on a port
+	StreamScans(*StreamScansRequest, grpc.ServerStreamingServer[StreamScansResponse]) error
+	// StreamAnnotated streams the frames a protocol decoder finds in the data
+	// of a port, each with a description next to its raw bytes
+	StreamAnnotated(*StreamAnnotatedRequest, grpc.ServerStreamingServer[StreamAnnotatedResponse]) error
+	// Paste sends a text one line at a time, pacing the lines by a prompt, the
+	// line's echo or a delay, and streams the progress. Each line is its own
+	// transaction, so other operations on the port run in between.
+	Paste(*PasteRequest, grpc.ServerStreamingServer[PasteResponse]) error
+	// StreamPolledValues streams the values parsed by configured pollers,
+	// starting with the latest sample of each
+	StreamPolledValues(*StreamPolledValuesRequest, grpc.ServerStreamingServer[StreamPolledValuesResponse]) error
+	// QueryHistory returns the recorded history of a poller's values
+	QueryHistory(context.Context, *QueryHistoryRequest) (*QueryHistoryResponse, error)
+	// ListAlarms returns the alarms that are active or awaiting acknowledgment
+	ListAlarms(context.Context, *ListAlarmsRequest) (*ListAlarmsResponse, error)
+	// AcknowledgeAlarm records that an operator has seen an alarm
+	AcknowledgeAlarm(context.Context, *AcknowledgeAlarmRequest) (*AcknowledgeAlarmResponse, error)
+	// ListDeviceStates returns the current state of tracked devices
+	ListDeviceStates(context.Context, *ListDeviceStatesRequest) (*ListDeviceStatesResponse, error)
+	// StreamDeviceStates sends the current state of tracked devices, then every
+	// transition
+	StreamDeviceStates(*StreamDeviceStatesRequest, grpc.ServerStreamingServer[StreamDeviceStatesResponse]) error
+	// StreamPortStatus sends the status of a port when the stream starts, on
+	// every event of the port and every interval; with on_change, interval
+	// snapshots are only sent when the status changed
+	StreamPortStatus(*StreamPortStatusRequest, grpc.ServerStreamingServer[StreamPortStatusResponse]) error
+	// ListPendingWrites returns the writes held for approval
+	ListPendingWrites(context.Context, *ListPendingWritesRequest) (*ListPendingWritesResponse, error)
+	// ApproveWrite sends a held write on behalf of its requester. The approver
+	// must be a different client.
+	ApproveWrite(context.Context, *ApproveWriteRequest) (*ApproveWriteResponse, error)
+	// RejectWrite discards a held write
+	RejectWrite(context.Context, *RejectWriteRequest) (*RejectWriteResponse, error)
+	// PrintRaster prints an image on an ESC/POS printer
+	PrintRaster(context.Context, *PrintRasterRequest) (*PrintResponse, error)
+	// CutPaper feeds and cuts the paper on an ESC/POS printer
+	CutPaper(context.Context, *CutPaperRequest) (*PrintResponse, error)
+	// GetPrinterStatus polls the real-time status of an ESC/POS printer
+	GetPrinterStatus(context.Context, *GetPrinterStatusRequest) (*GetPrinterStatusResponse, error)
+	// CreateReservation books a port for a time window. The holder defaults to
+	// the caller's identity.
+	CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error)
+	// ListReservations returns the current and upcoming reservations
+	ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error)
+	// CancelReservation removes a reservation on behalf of its holder or creator
+	CancelReservation(context.Context, *CancelReservationRequest) (*CancelReservationResponse, error)
+	// GetUsageReport returns the cumulative use of ports per client
+	GetUsageReport(context.Context, *GetUsageReportRequest) (*GetUsageReportResponse, error)
+	// SetPowerState makes a session dormant or wakes it. A dormant session is
+	// not read or streamed until the client next uses it, it is woken, or data
+	// matching a wake pattern arrives.
+	SetPowerState(context.Context, *SetPowerStateRequest) (*SetPowerStateResponse, error)
+	// SetShaping throttles a session's traffic to emulate a slow link. A
+	// missing or zero shaping lifts the limits.
+	SetShaping(context.Context, *SetShapingRequest) (*SetShapingResponse, error)
+	// GetAgentStats returns totals across all sessions and the agent's resource
+	// use, for health dashboards
+	GetAgentStats(context.Context, *GetAgentStatsRequest) (*GetAgentStatsResponse, error)
+	// SetDebugEndpoints starts or stops the debug listener serving pprof,
+	// expvar and runtime dumps
+	SetDebugEndpoints(context.Context, *SetDebugEndpointsRequest) (*SetDebugEndpointsResponse, error)
+	// BridgePorts forwards the data of one open port to another, and back
+	// unless one_way is set. The caller must hold both sessions.
+	BridgePorts(context.Context, *BridgePortsRequest) (*BridgePortsResponse, error)
+	// ListBridges returns the running bridges
+	ListBridges(context.Context, *ListBridgesRequest) (*ListBridgesResponse, error)
+	// StopBridge stops a bridge on behalf of the holder of either of its
+	// sessions. The ports stay open.
+	StopBridge(context.Context, *StopBridgeRequest) (*StopBridgeResponse, error)
+	// SetBridgeRules replaces the interception rules of a running bridge on
+	// behalf of the holder of either of its sessions; no rules forward the data
+	// unchanged again
+	SetBridgeRules(context.Context, *SetBridgeRulesRequest) (*SetBridgeRulesResponse, error)
+	// StartGcodeJob starts sending a G-code program to a printer or CNC
+	// controller with the flow control of its firmware. The job runs on the
+	// agent until it ends, whether or not the client stays connected.
+	StartGcodeJob(context.Context, *StartGcodeJobRequest) (*StartGcodeJobResponse, error)
+	// ControlGcodeJob pauses, resumes or cancels the job of a port on behalf of
+	// the holder of its session
+	ControlGcodeJob(context.Context, *ControlGcodeJobRequest) (*ControlGcodeJobResponse, error)
+	// GetGcodeJob returns the progress of the job of a port, which may have
+	// finished
+	GetGcodeJob(context.Context, *GetGcodeJobRequest) (*GetGcodeJobResponse, error)
+	// StreamGcodeJob sends the progress of the job of a port at an interval
+	// until it ends, the last message carrying its final state
+	StreamGcodeJob(*StreamGcodeJobRequest, grpc.ServerStreamingServer[StreamGcodeJobResponse]) error
+	// ConnectMachine puts an open port in G-code mode, so the status of its
+	// printer or CNC controller is polled and it can be jogged between jobs,
+	// until DisconnectMachine
+	ConnectMachine(context.Context, *ConnectMachineRequest) (*ConnectMachineResponse, error)
+	// DisconnectMachine takes a port out of G-code mode on behalf of the holder
+	// of its session; the port stays open
+	DisconnectMachine(context.Context, *DisconnectMachineRequest) (*DisconnectMachineResponse, error)
+	// StreamMachineStatus sends the status of the machine of a port at an
+	// interval, or when it changes, until the machine is disconnected
+	StreamMachineStatus(*StreamMachineStatusRequest, grpc.ServerStreamingServer[StreamMachineStatusResponse]) error
+	// JogMachine moves the machine of a port by a distance on behalf of the
+	// holder of its session, once it has taken the move. Jogging is refused
+	// while a job runs.
+	JogMachine(context.Context, *JogMachineRequest) (*JogMachineResponse, error)
+	// SendMachineCommand sends a feed hold, cycle start or reset to the machine
+	// of a port on behalf of the holder of its session
+	SendMachineCommand(context.Context, *SendMachineCommandRequest) (*SendMachineCommandResponse, error)
+	// UploadFirmware resets an Arduino-style board into its bootloader and
+	// flashes a firmware image, streaming the progress. The port must not be
+	// open; the upload holds it until it ends.
+	UploadFirmware(*UploadFirmwareRequest, grpc.ServerStreamingServer[UploadFirmwareResponse]) error
+	mustEmbedUnimplementedSerialServiceServer()
+}
+
+// UnimplementedSerialServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSerialServiceServer struct{}
+
+func (UnimplementedSerialServiceServer) ListPorts(context.Context, *ListPortsRequest) (*ListPortsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPorts not implemented")
+}
+func (UnimplementedSerialServiceServer) GetPortInfo(context.Context, *GetPortInfoRequest) (*GetPortInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortInfo not implemented")
+}
+func (UnimplementedSerialServiceServer) GetPortCapabilities(context.Context, *GetPortCapabilitiesRequest) (*GetPortCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortCapabilities not implemented")
+}
+func (UnimplementedSerialServiceServer) OpenPort(context.Context, *OpenPortRequest) (*OpenPortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenPort not implemented")
+}
+func (UnimplementedSerialServiceServer) ClosePort(context.Context, *ClosePortRequest) (*ClosePortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePort not implemented")
+}
+func (UnimplementedSerialServiceServer) GetPortStatus(context.Context, *GetPortStatusRequest) (*GetPortStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortStatus not implemented")
+}
+func (UnimplementedSerialServiceServer) Write(context.Context, *WriteRequest) (*WriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Write not implemented")
+}
+func (UnimplementedSerialServiceServer) Read(context.Context, *ReadRequest) (*ReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedSerialServiceServer) StreamRead(*StreamReadRequest, grpc.ServerStreamingServer[StreamReadResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRead not implemented")
+}
+func (UnimplementedSerialServiceServer) StreamTimedRead(*StreamTimedReadRequest, grpc.ServerStreamingServer[StreamTimedReadResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTimedRead not implemented")
+}
+func (UnimplementedSerialServiceServer) StreamWrite(grpc.ClientStreamingServer[StreamWriteRequest, StreamWriteResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWrite not implemented")
+}
+func (UnimplementedSerialServiceServer) BiDirectionalStream(grpc.BidiStreamingServer[BiDirectionalStreamRequest, BiDirectionalStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method BiDirectionalStream not implemented")
+}
+func (UnimplementedSerialServiceServer) ConfigurePort(context.Context, *ConfigurePortRequest) (*ConfigurePortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigurePort not implemented")
+}
+func (UnimplementedSerialServiceServer) GetPortConfig(context.Context, *GetPortConfigRequest) (*GetPortConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortConfig not implemented")
+}
+func (UnimplementedSerialServiceServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedSerialServiceServer) GetAgentInfo(context.Context, *GetAgentInfoRequest) (*GetAgentInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentInfo not implemented")
+}
+func (UnimplementedSerialServiceServer) GetMemoryStats(context.Context, *GetMemoryStatsRequest) (*GetMemoryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoryStats not implemented")
+}
+func (UnimplementedSerialServiceServer) ListStreams(context.Context, *ListStreamsRequest) (*ListStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStreams not implemented")
+}
+func (UnimplementedSerialServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedSerialServiceServer) CreateAccessToken(context.Context, *CreateAccessTokenRequest) (*CreateAccessTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessToken not implemented")
+}
+func (UnimplementedSerialServiceServer) CreateStreamLink(context.Context, *CreateStreamLinkRequest) (*CreateStreamLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStreamLink not implemented")
+}
+func (UnimplementedSerialServiceServer) ForceClose(context.Context, *ForceCloseRequest) (*ForceCloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceClose not implemented")
+}
+func (UnimplementedSerialServiceServer) GetRecentOutput(context.Context, *GetRecentOutputRequest) (*GetRecentOutputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentOutput not implemented")
+}
+func (UnimplementedSerialServiceServer) GetKeywordStats(context.Context, *GetKeywordStatsRequest) (*GetKeywordStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeywordStats not implemented")
+}
+func (UnimplementedSerialServiceServer) ListRecordings(context.Context, *ListRecordingsRequest) (*ListRecordingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecordings not implemented")
+}
+func (UnimplementedSerialServiceServer) FetchRecording(*FetchRecordingRequest, grpc.ServerStreamingServer[FetchRecordingResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method FetchRecording not implemented")
+}
+func (UnimplementedSerialServiceServer) GetRecentErrors(context.Context, *GetRecentErrorsRequest) (*GetRecentErrorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentErrors not implemented")
+}
+func (UnimplementedSerialServiceServer) DiagnoseLine(context.Context, *DiagnoseLineRequest) (*DiagnoseLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiagnoseLine not implemented")
+}
+func (UnimplementedSerialServiceServer) Verify(context.Context, *VerifyRequest) (*VerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Verify not implemented")
+}
+func (UnimplementedSerialServiceServer) ReadMeter(context.Context, *ReadMeterRequest) (*ReadMeterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadMeter not implemented")
+}
+func (UnimplementedSerialServiceServer) ListTestSuites(context.Context, *ListTestSuitesRequest) (*ListTestSuitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTestSuites not implemented")
+}
+func (UnimplementedSerialServiceServer) RunTestSuite(context.Context, *RunTestSuiteRequest) (*RunTestSuiteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunTestSuite not implemented")
+}
+func (UnimplementedSerialServiceServer) SynchronizedWrite(context.Context, *SynchronizedWriteRequest) (*SynchronizedWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SynchronizedWrite not implemented")
+}
+func (UnimplementedSerialServiceServer) ResetTarget(context.Context, *ResetTargetRequest) (*ResetTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetTarget not implemented")
+}
+func (UnimplementedSerialServiceServer) ListBusDevices(context.Context, *ListBusDevicesRequest) (*ListBusDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBusDevices not implemented")
+}
+func (UnimplementedSerialServiceServer) I2CTransfer(context.Context, *I2CTransferRequest) (*I2CTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method I2CTransfer not implemented")
+}
+func (UnimplementedSerialServiceServer) SPITransfer(context.Context, *SPITransferRequest) (*SPITransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SPITransfer not implemented")
+}
+func (UnimplementedSerialServiceServer) SendSMS(context.Context, *SendSMSRequest) (*SendSMSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendSMS not implemented")
+}
+func (UnimplementedSerialServiceServer) ReadSMS(context.Context, *ReadSMSRequest) (*ReadSMSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadSMS not implemented")
+}
+func (UnimplementedSerialServiceServer) GetModemStatus(context.Context, *GetModemStatusRequest) (*GetModemStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModemStatus not implemented")
+}
+func (UnimplementedSerialServiceServer) HandOffPPP(context.Context, *HandOffPPPRequest) (*HandOffPPPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandOffPPP not implemented")
+}
+func (UnimplementedSerialServiceServer) PrintText(context.Context, *PrintTextRequest) (*PrintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrintText not implemented")
+}
+func (UnimplementedSerialServiceServer) StreamScans(*StreamScansRequest, grpc.ServerStreamingServer[StreamScansResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamScans not implemented")
+}
+func (UnimplementedSerialServiceServer) StreamAnnotated(*StreamAnnotatedRequest, grpc.ServerStreamingServer[StreamAnnotatedResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAnnotated not implemented")
+}
+func (UnimplementedSerialServiceServer) Paste(*PasteRequest, grpc.ServerStreamingServer[PasteResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Paste not implemented")
+}
+func (UnimplementedSerialServiceServer) StreamPolledValues(*StreamPolledValuesRequest, grpc.ServerStreamingServer[StreamPolledValuesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPolledValues not implemented")
+}
+func (UnimplementedSerialServiceServer) QueryHistory(context.Context, *QueryHistoryRequest) (*QueryHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryHistory not implemented")
+}
+func (UnimplementedSerialServiceServer) ListAlarms(context.Context, *ListAlarmsRequest) (*ListAlarmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlarms not implemented")
+}
+func (UnimplementedSerialServiceServer) AcknowledgeAlarm(context.Context, *AcknowledgeAlarmRequest) (*AcknowledgeAlarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeAlarm not implemented")
+}
+func (UnimplementedSerialServiceServer) ListDeviceStates(context.Context, *ListDeviceStatesRequest) (*ListDeviceStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeviceStates not implemented")
+}
+func (UnimplementedSerialServiceServer) StreamDeviceStates(*StreamDeviceStatesRequest, grpc.ServerStreamingServer[StreamDeviceStatesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamDeviceStates not implemented")
+}
+func (UnimplementedSerialServiceServer) StreamPortStatus(*StreamPortStatusRequest, grpc.ServerStreamingServer[StreamPortStatusResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPortStatus not implemented")
+}
+func (UnimplementedSerialServiceServer) ListPendingWrites(context.Context, *ListPendingWritesRequest) (*ListPendingWritesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingWrites not implemented")
+}
+func (UnimplementedSerialServiceServer) ApproveWrite(context.Context, *ApproveWriteRequest) (*ApproveWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveWrite not implemented")
+}
+func (UnimplementedSerialServiceServer) RejectWrite(context.Context, *RejectWriteRequest) (*RejectWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectWrite not implemented")
+}
+func (UnimplementedSerialServiceServer) PrintRaster(context.Context, *PrintRasterRequest) (*PrintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrintRaster not implemented")
+}
+func (UnimplementedSerialServiceServer) CutPaper(context.Context, *CutPaperRequest) (*PrintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CutPaper not implemented")
+}
+func (UnimplementedSerialServiceServer) GetPrinterStatus(context.Context, *GetPrinterStatusRequest) (*GetPrinterStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrinterStatus not implemented")
+}
+func (UnimplementedSerialServiceServer) CreateReservation(context.Context, *CreateReservationRequest) (*CreateReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReservation not implemented")
+}
+func (UnimplementedSerialServiceServer) ListReservations(context.Context, *ListReservationsRequest) (*ListReservationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReservations not implemented")
+}
+func (UnimplementedSerialServiceServer) CancelReservation(context.Context, *CancelReservationRequest) (*CancelReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReservation not implemented")
+}
+func (UnimplementedSerialServiceServer) GetUsageReport(context.Context, *GetUsageReportRequest) (*GetUsageReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageReport not implemented")
+}
+func (UnimplementedSerialServiceServer) SetPowerState(context.Context, *SetPowerStateRequest) (*SetPowerStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPowerState not implemented")
+}
+func (UnimplementedSerialServiceServer) SetShaping(context.Context, *SetShapingRequest) (*SetShapingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetShaping not implemented")
+}
+func (UnimplementedSerialServiceServer) GetAgentStats(context.Context, *GetAgentStatsRequest) (*GetAgentStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentStats not implemented")
+}
+func (UnimplementedSerialServiceServer) SetDebugEndpoints(context.Context, *SetDebugEndpointsRequest) (*SetDebugEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDebugEndpoints not implemented")
+}
+func (UnimplementedSerialServiceServer) BridgePorts(context.Context, *BridgePortsRequest) (*BridgePortsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgePorts not implemented")
+}
+func (UnimplementedSerialServiceServer) ListBridges(context.Context, *ListBridgesRequest) (*ListBridgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBridges not implemented")
+}
+func (UnimplementedSerialServiceServer) StopBridge(context.Context, *StopBridgeRequest) (*StopBridgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopBridge not implemented")
+}
+func (UnimplementedSerialServiceServer) SetBridgeRules(context.Context, *SetBridgeRulesRequest) (*SetBridgeRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBridgeRules not implemented")
+}
+func (UnimplementedSerialServiceServer) StartGcodeJob(context.Context, *StartGcodeJobRequest) (*StartGcodeJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGcodeJob not implemented")
+}
+func (UnimplementedSerialServiceServer) ControlGcodeJob(context.Context, *ControlGcodeJobRequest) (*ControlGcodeJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ControlGcodeJob not implemented")
+}
+func (UnimplementedSerialServiceServer) GetGcodeJob(context.Context, *GetGcodeJobRequest) (*GetGcodeJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGcodeJob not implemented")
+}
+func (UnimplementedSerialServiceServer) StreamGcodeJob(*StreamGcodeJobRequest, grpc.ServerStreamingServer[StreamGcodeJobResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamGcodeJob not implemented")
+}
+func (UnimplementedSerialServiceServer) ConnectMachine(context.Context, *ConnectMachineRequest) (*ConnectMachineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectMachine not implemented")
+}
+func (UnimplementedSerialServiceServer) DisconnectMachine(context.Context, *DisconnectMachineRequest) (*DisconnectMachineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectMachine not implemented")
+}
+func (UnimplementedSerialServiceServer) StreamMachineStatus(*StreamMachineStatusRequest, grpc.ServerStreamingServer[StreamMachineStatusResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMachineStatus not implemented")
+}
+func (UnimplementedSerialServiceServer) JogMachine(context.Context, *JogMachineRequest) (*JogMachineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JogMachine not implemented")
+}
+func (UnimplementedSerialServiceServer) SendMachineCommand(context.Context, *SendMachineCommandRequest) (*SendMachineCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMachineCommand not implemented")
+}
+func (UnimplementedSerialServiceServer) UploadFirmware(*UploadFirmwareRequest, grpc.ServerStreamingServer[UploadFirmwareResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadFirmware not implemented")
+}
+func (UnimplementedSerialServiceServer) mustEmbedUnimplementedSerialServiceServer() {}
+func (UnimplementedSerialServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeSerialServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SerialServiceServer will
+// result in compilation errors.
+type UnsafeSerialServiceServer interface {
+	mustEmbedUnimplementedSerialServiceServer()
+}
+
+func RegisterSerialServiceServer(s grpc.ServiceRegistrar, srv SerialServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSerialServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SerialService_ServiceDesc, srv)
+}
+
+func _SerialService_ListPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ListPorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ListPorts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ListPorts(ctx, req.(*ListPortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_GetPortInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).GetPortInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_GetPortInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).GetPortInfo(ctx, req.(*GetPortInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_GetPortCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).GetPortCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_GetPortCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).GetPortCapabilities(ctx, req.(*GetPortCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_OpenPort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenPortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).OpenPort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_OpenPort_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).OpenPort(ctx, req.(*OpenPortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ClosePort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClosePortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ClosePort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ClosePort_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ClosePort(ctx, req.(*ClosePortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_GetPortStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).GetPortStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_GetPortStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).GetPortStatus(ctx, req.(*GetPortStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_Write_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).Write(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_Write_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).Write(ctx, req.(*WriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).Read(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_Read_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).Read(ctx, req.(*ReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_StreamRead_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamReadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SerialServiceServer).StreamRead(m, &grpc.GenericServerStream[StreamReadRequest, StreamReadResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamReadServer = grpc.ServerStreamingServer[StreamReadResponse]
+
+func _SerialService_StreamTimedRead_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTimedReadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SerialServiceServer).StreamTimedRead(m, &grpc.GenericServerStream[StreamTimedReadRequest, StreamTimedReadResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamTimedReadServer = grpc.ServerStreamingServer[StreamTimedReadResponse]
+
+func _SerialService_StreamWrite_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SerialServiceServer).StreamWrite(&grpc.GenericServerStream[StreamWriteRequest, StreamWriteResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamWriteServer = grpc.ClientStreamingServer[StreamWriteRequest, StreamWriteResponse]
+
+func _SerialService_BiDirectionalStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SerialServiceServer).BiDirectionalStream(&grpc.GenericServerStream[BiDirectionalStreamRequest, BiDirectionalStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_BiDirectionalStreamServer = grpc.BidiStreamingServer[BiDirectionalStreamRequest, BiDirectionalStreamResponse]
+
+func _SerialService_ConfigurePort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigurePortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ConfigurePort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ConfigurePort_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ConfigurePort(ctx, req.(*ConfigurePortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_GetPortConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).GetPortConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_GetPortConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).GetPortConfig(ctx, req.(*GetPortConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_GetAgentInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).GetAgentInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_GetAgentInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).GetAgentInfo(ctx, req.(*GetAgentInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_GetMemoryStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).GetMemoryStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_GetMemoryStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).GetMemoryStats(ctx, req.(*GetMemoryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ListStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ListStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ListStreams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ListStreams(ctx, req.(*ListStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_CreateAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).CreateAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_CreateAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).CreateAccessToken(ctx, req.(*CreateAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_CreateStreamLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStreamLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).CreateStreamLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_CreateStreamLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).CreateStreamLink(ctx, req.(*CreateStreamLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ForceClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceCloseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ForceClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ForceClose_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ForceClose(ctx, req.(*ForceCloseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_GetRecentOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecentOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).GetRecentOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_GetRecentOutput_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).GetRecentOutput(ctx, req.(*GetRecentOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_GetKeywordStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeywordStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).GetKeywordStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_GetKeywordStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).GetKeywordStats(ctx, req.(*GetKeywordStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ListRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecordingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ListRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ListRecordings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ListRecordings(ctx, req.(*ListRecordingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_FetchRecording_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FetchRecordingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SerialServiceServer).FetchRecording(m, &grpc.GenericServerStream[FetchRecordingRequest, FetchRecordingResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_FetchRecordingServer = grpc.ServerStreamingServer[FetchRecordingResponse]
+
+func _SerialService_GetRecentErrors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecentErrorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).GetRecentErrors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_GetRecentErrors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).GetRecentErrors(ctx, req.(*GetRecentErrorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_DiagnoseLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiagnoseLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).DiagnoseLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_DiagnoseLine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).DiagnoseLine(ctx, req.(*DiagnoseLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_Verify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).Verify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_Verify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).Verify(ctx, req.(*VerifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ReadMeter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadMeterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ReadMeter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ReadMeter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ReadMeter(ctx, req.(*ReadMeterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ListTestSuites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTestSuitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ListTestSuites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ListTestSuites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ListTestSuites(ctx, req.(*ListTestSuitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_RunTestSuite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunTestSuiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).RunTestSuite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_RunTestSuite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).RunTestSuite(ctx, req.(*RunTestSuiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_SynchronizedWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SynchronizedWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).SynchronizedWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_SynchronizedWrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).SynchronizedWrite(ctx, req.(*SynchronizedWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ResetTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ResetTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ResetTarget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ResetTarget(ctx, req.(*ResetTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ListBusDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBusDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ListBusDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ListBusDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ListBusDevices(ctx, req.(*ListBusDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_I2CTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(I2CTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).I2CTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_I2CTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).I2CTransfer(ctx, req.(*I2CTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_SPITransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SPITransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).SPITransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_SPITransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).SPITransfer(ctx, req.(*SPITransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_SendSMS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendSMSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).SendSMS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_SendSMS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).SendSMS(ctx, req.(*SendSMSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ReadSMS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadSMSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ReadSMS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ReadSMS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ReadSMS(ctx, req.(*ReadSMSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_GetModemStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModemStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).GetModemStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_GetModemStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).GetModemStatus(ctx, req.(*GetModemStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_HandOffPPP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandOffPPPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).HandOffPPP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_HandOffPPP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).HandOffPPP(ctx, req.(*HandOffPPPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_PrintText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrintTextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).PrintText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_PrintText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).PrintText(ctx, req.(*PrintTextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_StreamScans_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamScansRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SerialServiceServer).StreamScans(m, &grpc.GenericServerStream[StreamScansRequest, StreamScansResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamScansServer = grpc.ServerStreamingServer[StreamScansResponse]
+
+func _SerialService_StreamAnnotated_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamAnnotatedRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SerialServiceServer).StreamAnnotated(m, &grpc.GenericServerStream[StreamAnnotatedRequest, StreamAnnotatedResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamAnnotatedServer = grpc.ServerStreamingServer[StreamAnnotatedResponse]
+
+func _SerialService_Paste_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PasteRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SerialServiceServer).Paste(m, &grpc.GenericServerStream[PasteRequest, PasteResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_PasteServer = grpc.ServerStreamingServer[PasteResponse]
+
+func _SerialService_StreamPolledValues_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPolledValuesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SerialServiceServer).StreamPolledValues(m, &grpc.GenericServerStream[StreamPolledValuesRequest, StreamPolledValuesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamPolledValuesServer = grpc.ServerStreamingServer[StreamPolledValuesResponse]
+
+func _SerialService_QueryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).QueryHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_QueryHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).QueryHistory(ctx, req.(*QueryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ListAlarms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlarmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ListAlarms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ListAlarms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ListAlarms(ctx, req.(*ListAlarmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_AcknowledgeAlarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeAlarmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).AcknowledgeAlarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_AcknowledgeAlarm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).AcknowledgeAlarm(ctx, req.(*AcknowledgeAlarmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ListDeviceStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeviceStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ListDeviceStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ListDeviceStates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ListDeviceStates(ctx, req.(*ListDeviceStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_StreamDeviceStates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamDeviceStatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SerialServiceServer).StreamDeviceStates(m, &grpc.GenericServerStream[StreamDeviceStatesRequest, StreamDeviceStatesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamDeviceStatesServer = grpc.ServerStreamingServer[StreamDeviceStatesResponse]
+
+func _SerialService_StreamPortStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPortStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SerialServiceServer).StreamPortStatus(m, &grpc.GenericServerStream[StreamPortStatusRequest, StreamPortStatusResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamPortStatusServer = grpc.ServerStreamingServer[StreamPortStatusResponse]
+
+func _SerialService_ListPendingWrites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingWritesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ListPendingWrites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ListPendingWrites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ListPendingWrites(ctx, req.(*ListPendingWritesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ApproveWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ApproveWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ApproveWrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ApproveWrite(ctx, req.(*ApproveWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_RejectWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).RejectWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_RejectWrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).RejectWrite(ctx, req.(*RejectWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_PrintRaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrintRasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).PrintRaster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_PrintRaster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).PrintRaster(ctx, req.(*PrintRasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_CutPaper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CutPaperRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).CutPaper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_CutPaper_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).CutPaper(ctx, req.(*CutPaperRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_GetPrinterStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrinterStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).GetPrinterStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_GetPrinterStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).GetPrinterStatus(ctx, req.(*GetPrinterStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_CreateReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).CreateReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_CreateReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).CreateReservation(ctx, req.(*CreateReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ListReservations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReservationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ListReservations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ListReservations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ListReservations(ctx, req.(*ListReservationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_CancelReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).CancelReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_CancelReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).CancelReservation(ctx, req.(*CancelReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_GetUsageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).GetUsageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_GetUsageReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).GetUsageReport(ctx, req.(*GetUsageReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_SetPowerState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPowerStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).SetPowerState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_SetPowerState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).SetPowerState(ctx, req.(*SetPowerStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_SetShaping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetShapingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).SetShaping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_SetShaping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).SetShaping(ctx, req.(*SetShapingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_GetAgentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).GetAgentStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_GetAgentStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).GetAgentStats(ctx, req.(*GetAgentStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_SetDebugEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDebugEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).SetDebugEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_SetDebugEndpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).SetDebugEndpoints(ctx, req.(*SetDebugEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_BridgePorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BridgePortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).BridgePorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_BridgePorts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).BridgePorts(ctx, req.(*BridgePortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ListBridges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBridgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ListBridges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ListBridges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ListBridges(ctx, req.(*ListBridgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_StopBridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopBridgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).StopBridge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_StopBridge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).StopBridge(ctx, req.(*StopBridgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_SetBridgeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBridgeRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).SetBridgeRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_SetBridgeRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).SetBridgeRules(ctx, req.(*SetBridgeRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_StartGcodeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGcodeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).StartGcodeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_StartGcodeJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).StartGcodeJob(ctx, req.(*StartGcodeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_ControlGcodeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlGcodeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ControlGcodeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ControlGcodeJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ControlGcodeJob(ctx, req.(*ControlGcodeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_GetGcodeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGcodeJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).GetGcodeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_GetGcodeJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).GetGcodeJob(ctx, req.(*GetGcodeJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_StreamGcodeJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamGcodeJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SerialServiceServer).StreamGcodeJob(m, &grpc.GenericServerStream[StreamGcodeJobRequest, StreamGcodeJobResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamGcodeJobServer = grpc.ServerStreamingServer[StreamGcodeJobResponse]
+
+func _SerialService_ConnectMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).ConnectMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_ConnectMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).ConnectMachine(ctx, req.(*ConnectMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_DisconnectMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).DisconnectMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_DisconnectMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).DisconnectMachine(ctx, req.(*DisconnectMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_StreamMachineStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamMachineStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SerialServiceServer).StreamMachineStatus(m, &grpc.GenericServerStream[StreamMachineStatusRequest, StreamMachineStatusResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_StreamMachineStatusServer = grpc.ServerStreamingServer[StreamMachineStatusResponse]
+
+func _SerialService_JogMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JogMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).JogMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_JogMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).JogMachine(ctx, req.(*JogMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_SendMachineCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMachineCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SerialServiceServer).SendMachineCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SerialService_SendMachineCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SerialServiceServer).SendMachineCommand(ctx, req.(*SendMachineCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SerialService_UploadFirmware_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UploadFirmwareRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SerialServiceServer).UploadFirmware(m, &grpc.GenericServerStream[UploadFirmwareRequest, UploadFirmwareResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SerialService_UploadFirmwareServer = grpc.ServerStreamingServer[UploadFirmwareResponse]
+
+// SerialService_ServiceDesc is the grpc.ServiceDesc for SerialService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SerialService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "seriallink.v1.SerialService",
+	HandlerType: (*SerialServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPorts",
+			Handler:    _SerialService_ListPorts_Handler,
+		},
+		{
+			MethodName: "GetPortInfo",
+			Handler:    _SerialService_GetPortInfo_Handler,
+		},
+		{
+			MethodName: "GetPortCapabilities",
+			Handler:    _SerialService_GetPortCapabilities_Handler,
+		},
+		{
+			MethodName: "OpenPort",
+			Handler:    _SerialService_OpenPort_Handler,
+		},
+		{
+			MethodName: "ClosePort",
+			Handler:    _SerialService_ClosePort_Handler,
+		},
+		{
+			MethodName: "GetPortStatus",
+			Handler:    _SerialService_GetPortStatus_Handler,
+		},
+		{
+			MethodName: "Write",
+			Handler:    _SerialService_Write_Handler,
+		},
+		{
+			MethodName: "Read",
+			Handler:    _SerialService_Read_Handler,
+		},
+		{
+			MethodName: "ConfigurePort",
+			Handler:    _SerialService_ConfigurePort_Handler,
+		},
+		{
+			MethodName: "GetPortConfig",
+			Handler:    _SerialService_GetPortConfig_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _SerialService_Ping_Handler,
+		},
+		{
+			MethodName: "GetAgentInfo",
+			Handler:    _SerialService_GetAgentInfo_Handler,
+		},
+		{
+			MethodName: "GetMemoryStats",
+			Handler:    _SerialService_GetMemoryStats_Handler,
+		},
+		{
+			MethodName: "ListStreams",
+			Handler:    _SerialService_ListStreams_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _SerialService_ListSessions_Handler,
+		},
+		{
+			MethodName: "CreateAccessToken",
+			Handler:    _SerialService_CreateAccessToken_Handler,
+		},
+		{
+			MethodName: "CreateStreamLink",
+			Handler:    _SerialService_CreateStreamLink_Handler,
+		},
+		{
+			MethodName: "ForceClose",
+			Handler:    _SerialService_ForceClose_Handler,
+		},
+		{
+			MethodName: "GetRecentOutput",
+			Handler:    _SerialService_GetRecentOutput_Handler,
+		},
+		{
+			MethodName: "GetKeywordStats",
+			Handler:    _SerialService_GetKeywordStats_Handler,
+		},
+		{
+			MethodName: "ListRecordings",
+			Handler:    _SerialService_ListRecordings_Handler,
+		},
+		{
+			MethodName: "GetRecentErrors",
+			Handler:    _SerialService_GetRecentErrors_Handler,
+		},
+		{
+			MethodName: "DiagnoseLine",
+			Handler:    _SerialService_DiagnoseLine_Handler,
+		},
+		{
+			MethodName: "Verify",
+			Handler:    _SerialService_Verify_Handler,
+		},
+		{
+			MethodName: "ReadMeter",
+			Handler:    _SerialService_ReadMeter_Handler,
+		},
+		{
+			MethodName: "ListTestSuites",
+			Handler:    _SerialService_ListTestSuites_Handler,
+		},
+		{
+			MethodName: "RunTestSuite",
+			Handler:    _SerialService_RunTestSuite_Handler,
+		},
+		{
+			MethodName: "SynchronizedWrite",
+			Handler:    _SerialService_SynchronizedWrite_Handler,
+		},
+		{
+			MethodName: "ResetTarget",
+			Handler:    _SerialService_ResetTarget_Handler,
+		},
+		{
+			MethodName: "ListBusDevices",
+			Handler:    _SerialService_ListBusDevices_Handler,
+		},
+		{
+			MethodName: "I2CTransfer",
+			Handler:    _SerialService_I2CTransfer_Handler,
+		},
+		{
+			MethodName: "SPITransfer",
+			Handler:    _SerialService_SPITransfer_Handler,
+		},
+		{
+			MethodName: "SendSMS",
+			Handler:    _SerialService_SendSMS_Handler,
+		},
+		{
+			MethodName: "ReadSMS",
+			Handler:    _SerialService_ReadSMS_Handler,
+		},
+		{
+			MethodName: "GetModemStatus",
+			Handler:    _SerialService_GetModemStatus_Handler,
+		},
+		{
+			MethodName: "HandOffPPP",
+			Handler:    _SerialService_HandOffPPP_Handler,
+		},
+		{
+			MethodName: "PrintText",
+			Handler:    _SerialService_PrintText_Handler,
+		},
+		{
+			MethodName: "QueryHistory",
+			Handler:    _SerialService_QueryHistory_Handler,
+		},
+		{
+			MethodName: "ListAlarms",
+			Handler:    _SerialService_ListAlarms_Handler,
+		},
+		{
+			MethodName: "AcknowledgeAlarm",
+			Handler:    _SerialService_AcknowledgeAlarm_Handler,
+		},
+		{
+			MethodName: "ListDeviceStates",
+			Handler:    _SerialService_ListDeviceStates_Handler,
+		},
+		{
+			MethodName: "ListPendingWrites",
+			Handler:    _SerialService_ListPendingWrites_Handler,
+		},
+		{
+			MethodName: "ApproveWrite",
+			Handler:    _SerialService_ApproveWrite_Handler,
+		},
+		{
+			MethodName: "RejectWrite",
+			Handler:    _SerialService_RejectWrite_Handler,
+		},
+		{
+			MethodName: "PrintRaster",
+			Handler:    _SerialService_PrintRaster_Handler,
+		},
+		{
+			MethodName: "CutPaper",
+			Handler:    _SerialService_CutPaper_Handler,
+		},
+		{
+			MethodName: "GetPrinterStatus",
+			Handler:    _SerialService_GetPrinterStatus_Handler,
+		},
+		{
+			MethodName: "CreateReservation",
+			Handler:    _SerialService_CreateReservation_Handler,
+		},
+		{
+			MethodName: "ListReservations",
+			Handler:    _SerialService_ListReservations_Handler,
+		},
+		{
+			MethodName: "CancelReservation",
+			Handler:    _SerialService_CancelReservation_Handler,
+		},
+		{
+			MethodName: "GetUsageReport",
+			Handler:    _SerialService_GetUsageReport_Handler,
+		},
+		{
+			MethodName: "SetPowerState",
+			Handler:    _SerialService_SetPowerState_Handler,
+		},
+		{
+			MethodName: "SetShaping",
+			Handler:    _SerialService_SetShaping_Handler,
+		},
+		{
+			MethodName: "GetAgentStats",
+			Handler:    _SerialService_GetAgentStats_Handler,
+		},
+		{
+			MethodName: "SetDebugEndpoints",
+			Handler:    _SerialService_SetDebugEndpoints_Handler,
+		},
+		{
+			MethodName: "BridgePorts",
+			Handler:    _SerialService_BridgePorts_Handler,
+		},
+		{
+			MethodName: "ListBridges",
+			Handler:    _SerialService_ListBridges_Handler,
+		},
+		{
+			MethodName: "StopBridge",
+			Handler:    _SerialService_StopBridge_Handler,
+		},
+		{
+			MethodName: "SetBridgeRules",
+			Handler:    _SerialService_SetBridgeRules_Handler,
+		},
+		{
+			MethodName: "StartGcodeJob",
+			Handler:    _SerialService_StartGcodeJob_Handler,
+		},
+		{
+			MethodName: "ControlGcodeJob",
+			Handler:    _SerialService_ControlGcodeJob_Handler,
+		},
+		{
+			MethodName: "GetGcodeJob",
+			Handler:    _SerialService_GetGcodeJob_Handler,
+		},
+		{
+			MethodName: "ConnectMachine",
+			Handler:    _SerialService_ConnectMachine_Handler,
+		},
+		{
+			MethodName: "DisconnectMachine",
+			Handler:    _SerialService_DisconnectMachine_Handler,
+		},
+		{
+			MethodName: "JogMachine",
+			Handler:    _SerialService_JogMachine_Handler,
+		},
+		{
+			MethodName: "SendMachineCommand",
+			Handler:    _SerialService_SendMachineCommand_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamRead",
+			Handler:       _SerialService_StreamRead_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamTimedRead",
+			Handler:       _SerialService_StreamTimedRead_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamWrite",
+			Handler:       _SerialService_StreamWrite_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BiDirectionalStream",
+			Handler:       _SerialService_BiDirectionalStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "FetchRecording",
+			Handler:       _SerialService_FetchRecording_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamScans",
+			Handler:       _SerialService_StreamScans_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAnnotated",
+			Handler:       _SerialService_StreamAnnotated_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Paste",
+			Handler:       _SerialService_Paste_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamPolledValues",
+			Handler:       _SerialService_StreamPolledValues_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamDeviceStates",
+			Handler:       _SerialService_StreamDeviceStates_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamPortStatus",
+			Handler:       _SerialService_StreamPortStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamGcodeJob",
+			Handler:       _SerialService_StreamGcodeJob_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamMachineStatus",
+			Handler:       _SerialService_StreamMachineStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadFirmware",
+			Handler:       _SerialService_UploadFirmware_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "seriallink/v1/serial.proto",
+}
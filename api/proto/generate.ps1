@@ -0,0 +1,16 @@
+# Regenerates the code of proto/seriallink/v1/serial.proto with buf and the
+# protoc-gen-go and protoc-gen-go-grpc plugins ("make install-tools")
+param(
+    [ValidateSet("go")]
+    [string]$Target = "go"
+)
+
+$ErrorActionPreference = "Stop"
+Push-Location $PSScriptRoot
+try {
+    switch ($Target) {
+        "go" { buf generate --template buf.gen.yaml }
+    }
+} finally {
+    Pop-Location
+}
@@ -0,0 +1,403 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/Shoaibashk/SerialLink/internal/overload"
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// restMaxBody bounds a request body
+const restMaxBody = 1 << 20
+
+// RESTServer maps the main port RPCs to JSON over HTTP for clients such as
+// curl that do not speak gRPC. Responses are the RPCs' responses as JSON;
+// sessions are shared with gRPC clients.
+type RESTServer struct {
+	service *SerialServer
+	guard   *overload.Guard
+	auth    *TokenAuth
+	logger  *log.Logger
+}
+
+// NewRESTServer creates a REST gateway to service
+func NewRESTServer(service *SerialServer, logger *log.Logger) *RESTServer {
+	return &RESTServer{service: service, logger: logger}
+}
+
+// SetOverloadGuard rejects opens while the agent is overloaded
+func (s *RESTServer) SetOverloadGuard(guard *overload.Guard) {
+	s.guard = guard
+}
+
+// SetTokenAuth requires an API token on every request
+func (s *RESTServer) SetTokenAuth(auth *TokenAuth) {
+	s.auth = auth
+}
+
+// Handler returns the HTTP handler with all routes registered. Port names
+// containing slashes must be URL-escaped (e.g. %2Fdev%2FttyUSB0).
+func (s *RESTServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/ports", scoped("ListPorts", s.handleListPorts))
+	mux.HandleFunc("GET /v1/ports/{name}", scoped("GetPortInfo", s.handlePortInfo))
+	mux.HandleFunc("GET /v1/ports/{name}/capabilities", scoped("GetPortCapabilities", s.handleCapabilities))
+	mux.HandleFunc("GET /v1/ports/{name}/status", scoped("GetPortStatus", s.handleStatus))
+	mux.HandleFunc("POST /v1/ports/{name}/open", scoped("OpenPort", s.handleOpen))
+	mux.HandleFunc("POST /v1/ports/{name}/close", scoped("ClosePort", s.handleClose))
+	mux.HandleFunc("POST /v1/ports/{name}/write", scoped("Write", s.handleWrite))
+	mux.HandleFunc("POST /v1/ports/{name}/read", scoped("Read", s.handleRead))
+	mux.HandleFunc("GET /v1/ports/{name}/config", scoped("GetPortConfig", s.handleGetConfig))
+	mux.HandleFunc("PUT /v1/ports/{name}/config", scoped("ConfigurePort", s.handleConfigure))
+	return s.logRequests(s.auth.protect(mux))
+}
+
+// scoped refuses requests of access tokens to a route outside their scope;
+// method is the RPC the route maps to
+func scoped(method string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := checkScope(r.Context(), method, r.PathValue("name")); err != nil {
+			writeError(w, err)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// logRequests logs each request once it completes
+func (s *RESTServer) logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		next.ServeHTTP(w, r)
+		s.logger.Debug("REST request completed",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"client", r.RemoteAddr,
+			"duration", time.Since(start))
+	})
+}
+
+// restError is the body of a failed request
+type restError struct {
+	Error string `json:"error"`
+	// Code is the gRPC status code
+	Code string `json:"code"`
+	// ApprovalID is set for writes held for approval
+	ApprovalID string `json:"approval_id,omitempty"`
+}
+
+// httpStatus maps a gRPC status code to an HTTP status, as gRPC-Gateway does
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+// writeJSON sends a response
+func writeJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError sends a failed call's error
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	writeJSON(w, httpStatus(st.Code()), restError{Error: st.Message(), Code: st.Code().String()})
+}
+
+// writeRefused sends a call the service refused, such as an open of a
+// locked port or a read that failed, as a conflict
+func writeRefused(w http.ResponseWriter, message string) {
+	writeJSON(w, http.StatusConflict, restError{Error: message, Code: codes.Aborted.String()})
+}
+
+// readBody decodes a JSON request body; an empty body leaves v unchanged
+func readBody(r *http.Request, v interface{}) error {
+	return decodeBody(r.Body, v)
+}
+
+// decodeBody decodes up to restMaxBody bytes of JSON into v; no input
+// leaves v unchanged
+func decodeBody(body io.Reader, v interface{}) error {
+	err := json.NewDecoder(io.LimitReader(body, restMaxBody)).Decode(v)
+	if err == nil || errors.Is(err, io.EOF) {
+		return nil
+	}
+	return status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+}
+
+// call runs a unary call and sends its response
+func (s *RESTServer) call(w http.ResponseWriter, run func() (interface{}, error)) {
+	resp, err := run()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *RESTServer) handleListPorts(w http.ResponseWriter, r *http.Request) {
+	s.call(w, func() (interface{}, error) {
+		return s.service.ListPorts(requestContext(r.Context(), r, ""), &pb.ListPortsRequest{})
+	})
+}
+
+func (s *RESTServer) handlePortInfo(w http.ResponseWriter, r *http.Request) {
+	s.call(w, func() (interface{}, error) {
+		return s.service.GetPortInfo(requestContext(r.Context(), r, ""), &pb.GetPortInfoRequest{PortName: r.PathValue("name")})
+	})
+}
+
+func (s *RESTServer) handleCapabilities(w http.ResponseWriter, r *http.Request) {
+	s.call(w, func() (interface{}, error) {
+		return s.service.GetPortCapabilities(requestContext(r.Context(), r, ""), &pb.GetPortCapabilitiesRequest{PortName: r.PathValue("name")})
+	})
+}
+
+func (s *RESTServer) handleStatus(w http.ResponseWriter, r *http.Request) {
+	s.call(w, func() (interface{}, error) {
+		return s.service.GetPortStatus(requestContext(r.Context(), r, ""), &pb.GetPortStatusRequest{PortName: r.PathValue("name")})
+	})
+}
+
+func (s *RESTServer) handleGetConfig(w http.ResponseWriter, r *http.Request) {
+	s.call(w, func() (interface{}, error) {
+		return s.service.GetPortConfig(requestContext(r.Context(), r, ""), &pb.GetPortConfigRequest{PortName: r.PathValue("name")})
+	})
+}
+
+// restOpenRequest is the body of an open; without line settings the port's
+// device profile or the agent's defaults apply
+type restOpenRequest struct {
+	lineSettings
+	lineStates
+	ClientID string `json:"client_id"`
+	// Exclusive defaults to true
+	Exclusive *bool             `json:"exclusive"`
+	Priority  string            `json:"priority"`
+	Metadata  map[string]string `json:"metadata"`
+}
+
+func (s *RESTServer) handleOpen(w http.ResponseWriter, r *http.Request) {
+	var body restOpenRequest
+	if err := readBody(r, &body); err != nil {
+		writeError(w, err)
+		return
+	}
+	if body.ClientID == "" {
+		body.ClientID = r.Header.Get(clientIDMetadataKey)
+	}
+	ctx := requestContext(r.Context(), r, body.ClientID)
+	if err := admitGateway(ctx, s.guard, body.ClientID); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	req := &pb.OpenPortRequest{
+		PortName:  r.PathValue("name"),
+		ClientId:  body.ClientID,
+		Exclusive: body.Exclusive == nil || *body.Exclusive,
+		Metadata:  body.Metadata,
+	}
+	if err := s.service.applyOpenOptions(req, body.lineSettings, body.lineStates, body.Priority); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	resp, err := s.service.OpenPort(ctx, req)
+	switch {
+	case err != nil:
+		writeError(w, err)
+	case !resp.Success:
+		writeRefused(w, resp.Message)
+	default:
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
+
+// restSessionRequest is the body of calls on a session
+type restSessionRequest struct {
+	SessionID string `json:"session_id"`
+}
+
+func (s *RESTServer) handleClose(w http.ResponseWriter, r *http.Request) {
+	var body restSessionRequest
+	if err := readBody(r, &body); err != nil {
+		writeError(w, err)
+		return
+	}
+	resp, err := s.service.ClosePort(requestContext(r.Context(), r, ""), &pb.ClosePortRequest{
+		PortName:  r.PathValue("name"),
+		SessionId: body.SessionID,
+	})
+	switch {
+	case err != nil:
+		writeError(w, err)
+	case !resp.Success:
+		writeRefused(w, resp.Message)
+	default:
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
+
+// restWriteRequest is the body of a write: data is base64, text is sent
+// as is
+type restWriteRequest struct {
+	SessionID string `json:"session_id"`
+	Data      []byte `json:"data"`
+	Text      string `json:"text"`
+	Flush     bool   `json:"flush"`
+}
+
+func (s *RESTServer) handleWrite(w http.ResponseWriter, r *http.Request) {
+	var body restWriteRequest
+	if err := readBody(r, &body); err != nil {
+		writeError(w, err)
+		return
+	}
+	if body.Text != "" {
+		body.Data = append(body.Data, body.Text...)
+	}
+	resp, err := s.service.Write(requestContext(r.Context(), r, ""), &pb.WriteRequest{
+		PortName:  r.PathValue("name"),
+		SessionId: body.SessionID,
+		Data:      body.Data,
+		Flush:     body.Flush,
+	})
+	switch {
+	case err != nil:
+		writeError(w, err)
+	case resp.ApprovalId != "":
+		// Held for approval: accepted, not yet written
+		writeJSON(w, http.StatusAccepted, restError{Error: resp.Message, Code: codes.Aborted.String(), ApprovalID: resp.ApprovalId})
+	case !resp.Success:
+		writeRefused(w, resp.Message)
+	default:
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
+
+// restReadRequest is the body of a read
+type restReadRequest struct {
+	SessionID string `json:"session_id"`
+	MaxBytes  uint32 `json:"max_bytes"`
+	TimeoutMs uint32 `json:"timeout_ms"`
+}
+
+func (s *RESTServer) handleRead(w http.ResponseWriter, r *http.Request) {
+	var body restReadRequest
+	if err := readBody(r, &body); err != nil {
+		writeError(w, err)
+		return
+	}
+	resp, err := s.service.Read(requestContext(r.Context(), r, ""), &pb.ReadRequest{
+		PortName:  r.PathValue("name"),
+		SessionId: body.SessionID,
+		MaxBytes:  body.MaxBytes,
+		TimeoutMs: body.TimeoutMs,
+	})
+	switch {
+	case err != nil:
+		writeError(w, err)
+	case !resp.Success:
+		writeRefused(w, resp.Message)
+	default:
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
+
+// restConfigureRequest is the body of a configure; unset settings keep
+// their current values
+type restConfigureRequest struct {
+	lineSettings
+	SessionID string `json:"session_id"`
+}
+
+func (s *RESTServer) handleConfigure(w http.ResponseWriter, r *http.Request) {
+	var body restConfigureRequest
+	if err := readBody(r, &body); err != nil {
+		writeError(w, err)
+		return
+	}
+	ctx := requestContext(r.Context(), r, "")
+	portName := r.PathValue("name")
+	if err := s.service.checkSessionAccess(ctx, portName); err != nil {
+		writeError(w, err)
+		return
+	}
+	session, err := s.service.manager.ValidateSession(portName, body.SessionID)
+	if err != nil {
+		code := codes.PermissionDenied
+		if errors.Is(err, serial.ErrPortNotOpen) {
+			code = codes.NotFound
+		}
+		writeError(w, status.Error(code, err.Error()))
+		return
+	}
+	config, err := s.service.portConfig(session.Config, body.lineSettings)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	resp, err := s.service.ConfigurePort(ctx, &pb.ConfigurePortRequest{
+		PortName:  portName,
+		SessionId: body.SessionID,
+		Config:    config,
+	})
+	switch {
+	case err != nil:
+		writeError(w, err)
+	case !resp.Success:
+		writeRefused(w, resp.Message)
+	default:
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
@@ -0,0 +1,835 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/Shoaibashk/SerialLink/internal/overload"
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/Shoaibashk/SerialLink/internal/wsframe"
+	"github.com/charmbracelet/log"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// wsPath is where the gateway accepts WebSocket connections
+	wsPath = "/v1/ws"
+
+	// wsMaxFrameSize bounds a frame received from a client
+	wsMaxFrameSize = 1 << 20
+
+	// wsPingInterval is how often idle connections are pinged; a client
+	// silent for twice as long is disconnected
+	wsPingInterval = 30 * time.Second
+
+	// wsWriteTimeout bounds sending one frame to a client
+	wsWriteTimeout = 10 * time.Second
+)
+
+// WebSocketServer is the WebSocket gateway for browser clients. Frames (see
+// package wsframe) carry open, close, read, write, stream and upload
+// operations, which run through the SerialServer with the same write
+// policies, input checks and session rules as gRPC calls.
+type WebSocketServer struct {
+	service   *SerialServer
+	encodings []string
+	origins   []string
+	guard     *overload.Guard
+	auth      *TokenAuth
+	logger    *log.Logger
+}
+
+// NewWebSocketServer creates a gateway to service. encodings lists the
+// frame encodings clients may negotiate besides JSON.
+func NewWebSocketServer(service *SerialServer, encodings []string, logger *log.Logger) *WebSocketServer {
+	return &WebSocketServer{
+		service:   service,
+		encodings: encodings,
+		logger:    logger,
+	}
+}
+
+// SetAllowedOrigins sets the browser origins (e.g. "https://dash.example.com")
+// allowed besides the agent's own host; "*" allows any
+func (s *WebSocketServer) SetAllowedOrigins(origins []string) {
+	s.origins = origins
+}
+
+// SetOverloadGuard rejects opens and streams while the agent is overloaded
+// and ends the streams the guard sheds
+func (s *WebSocketServer) SetOverloadGuard(guard *overload.Guard) {
+	s.guard = guard
+}
+
+// SetTokenAuth requires an API token to connect, in the Authorization
+// header or, from browsers, the access_token query parameter
+func (s *WebSocketServer) SetTokenAuth(auth *TokenAuth) {
+	s.auth = auth
+}
+
+// Handler returns the HTTP handler accepting WebSocket connections at
+// /v1/ws
+func (s *WebSocketServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+wsPath, s.handleConnection)
+	return s.auth.protect(mux)
+}
+
+// checkOrigin accepts requests without an Origin (non-browser clients), from
+// the agent's own host and from the allowed origins
+func (s *WebSocketServer) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+	for _, allowed := range s.origins {
+		if allowed == "*" || strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// handleConnection upgrades a request and serves the connection's frames
+func (s *WebSocketServer) handleConnection(w http.ResponseWriter, r *http.Request) {
+	requested := websocket.Subprotocols(r)
+	if encoding := r.URL.Query().Get("encoding"); encoding != "" {
+		requested = append([]string{encoding}, requested...)
+	}
+	codec := wsframe.Negotiate(requested, s.encodings)
+
+	// Echo the subprotocol the client asked for the chosen encoding with
+	header := http.Header{}
+	for _, protocol := range websocket.Subprotocols(r) {
+		if protocol == wsframe.SubprotocolPrefix+codec.Name() {
+			header.Set("Sec-WebSocket-Protocol", protocol)
+			break
+		}
+	}
+
+	upgrader := websocket.Upgrader{CheckOrigin: s.checkOrigin}
+	conn, err := upgrader.Upgrade(w, r, header)
+	if err != nil {
+		s.logger.Debug("WebSocket upgrade failed", "client", r.RemoteAddr, "error", err)
+		return
+	}
+	conn.SetReadLimit(wsMaxFrameSize)
+
+	ctx, cancel := context.WithCancel(requestContext(r.Context(), r, ""))
+
+	c := &wsConn{
+		server:   s,
+		conn:     conn,
+		codec:    codec,
+		ctx:      ctx,
+		cancel:   cancel,
+		sessions: make(map[string]string),
+		streams:  make(map[string]context.CancelFunc),
+	}
+	s.logger.Info("WebSocket client connected", "client", r.RemoteAddr, "encoding", codec.Name())
+	c.serve()
+	s.logger.Info("WebSocket client disconnected", "client", r.RemoteAddr)
+}
+
+// wsConn is one gateway connection. Requests are handled in the order they
+// arrive; streams run alongside.
+type wsConn struct {
+	server *WebSocketServer
+	conn   *websocket.Conn
+	codec  wsframe.Codec
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	writeMu sync.Mutex
+
+	mu sync.Mutex
+	// sessions are the sessions opened over the connection by port, closed
+	// when it ends
+	sessions map[string]string
+	// streams cancel the read streams by port
+	streams map[string]context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// serve reads and handles frames until the connection ends, then stops its
+// streams and closes the sessions it opened
+func (c *wsConn) serve() {
+	defer func() {
+		c.cancel()
+		c.wg.Wait()
+		c.closeSessions()
+		c.conn.Close()
+	}()
+
+	_ = c.conn.SetReadDeadline(time.Now().Add(2 * wsPingInterval))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(2 * wsPingInterval))
+	})
+	c.wg.Add(1)
+	go c.ping()
+
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		_ = c.conn.SetReadDeadline(time.Now().Add(2 * wsPingInterval))
+
+		var frame wsframe.Frame
+		if err := c.codec.Unmarshal(data, &frame); err != nil {
+			c.send(&wsframe.Frame{Type: wsframe.TypeError, Error: "invalid frame: " + err.Error()})
+			continue
+		}
+		c.handle(&frame)
+	}
+}
+
+// ping keeps the connection alive through proxies. When the connection's
+// context ends, e.g. on shutdown, it closes the connection, which the HTTP
+// server no longer tracks once upgraded.
+func (c *wsConn) ping() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(wsPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			c.writeMu.Lock()
+			_ = c.conn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseGoingAway, ""), time.Now().Add(time.Second))
+			c.writeMu.Unlock()
+			c.conn.Close()
+			return
+		case <-ticker.C:
+			c.writeMu.Lock()
+			err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout))
+			c.writeMu.Unlock()
+			if err != nil {
+				c.cancel()
+				return
+			}
+		}
+	}
+}
+
+// send writes a frame to the client
+func (c *wsConn) send(frame *wsframe.Frame) error {
+	data, err := c.codec.Marshal(frame)
+	if err != nil {
+		return err
+	}
+	messageType := websocket.TextMessage
+	if c.codec.Binary() {
+		messageType = websocket.BinaryMessage
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	if err := c.conn.WriteMessage(messageType, data); err != nil {
+		c.cancel()
+		return err
+	}
+	return nil
+}
+
+// wsMethods are the RPCs frames run, to hold access tokens to their scope
+var wsMethods = map[string]string{
+	wsframe.TypeOpen:    "OpenPort",
+	wsframe.TypeClose:   "ClosePort",
+	wsframe.TypeWrite:   "Write",
+	wsframe.TypeRead:    "Read",
+	wsframe.TypeStream:  "StreamRead",
+	wsframe.TypeMonitor: monitorMethod,
+	wsframe.TypeUpload:  "UploadFirmware",
+}
+
+// handle runs a request frame and answers it
+func (c *wsConn) handle(frame *wsframe.Frame) {
+	if method, ok := wsMethods[frame.Type]; ok {
+		if err := checkScope(c.ctx, method, frame.Port); err != nil {
+			c.sendError(frame, err)
+			return
+		}
+	}
+
+	var (
+		result *wsframe.Frame
+		err    error
+	)
+	switch frame.Type {
+	case wsframe.TypeOpen:
+		result, err = c.open(frame)
+	case wsframe.TypeClose:
+		result, err = c.close(frame)
+	case wsframe.TypeWrite:
+		result, err = c.write(frame)
+	case wsframe.TypeRead:
+		result, err = c.read(frame)
+	case wsframe.TypeStream:
+		result, err = c.stream(frame)
+	case wsframe.TypeMonitor:
+		result, err = c.monitor(frame)
+	case wsframe.TypeUpload:
+		result, err = c.upload(frame)
+	default:
+		c.send(&wsframe.Frame{Type: wsframe.TypeError, ID: frame.ID, Error: "unknown frame type " + strconv.Quote(frame.Type)})
+		return
+	}
+	if err != nil {
+		c.sendError(frame, err)
+		return
+	}
+	result.Type = wsframe.TypeResult
+	result.ID = frame.ID
+	if result.Port == "" {
+		result.Port = frame.Port
+	}
+	c.send(result)
+}
+
+// sendError answers a request with an error, with its gRPC status code
+func (c *wsConn) sendError(frame *wsframe.Frame, err error) {
+	reply := &wsframe.Frame{Type: wsframe.TypeError, ID: frame.ID, Port: frame.Port, Error: err.Error()}
+	var f failed
+	if errors.As(err, &f) {
+		reply.Options = f.options
+	} else if st, ok := status.FromError(err); ok {
+		reply.Error = st.Message()
+		reply.Options = map[string]string{"code": st.Code().String()}
+	}
+	c.send(reply)
+}
+
+// failed is a request the service answered without success
+type failed struct {
+	message string
+	options map[string]string
+}
+
+func (f failed) Error() string { return f.message }
+
+// callContext returns the context of a call, carrying the client ID the
+// frame names for overload priorities
+func (c *wsConn) callContext(frame *wsframe.Frame) context.Context {
+	if clientID := frame.Options["client_id"]; clientID != "" {
+		return metadata.NewIncomingContext(c.ctx, metadata.Pairs(clientIDMetadataKey, clientID))
+	}
+	return c.ctx
+}
+
+// open opens a port. Options: baud_rate, data_bits, stop_bits, parity,
+// flow_control, latency_profile, dtr, rts, priority, client_id and
+// exclusive; without line settings the agent's defaults or device profile
+// apply.
+func (c *wsConn) open(frame *wsframe.Frame) (*wsframe.Frame, error) {
+	ctx := c.callContext(frame)
+	opts := frame.Options
+	if err := admitGateway(ctx, c.server.guard, opts["client_id"]); err != nil {
+		return nil, err
+	}
+
+	req := &pb.OpenPortRequest{
+		PortName:  frame.Port,
+		ClientId:  opts["client_id"],
+		Exclusive: opts["exclusive"] != "false",
+	}
+	settings, err := lineSettingsOf(opts)
+	if err != nil {
+		return nil, err
+	}
+	states := lineStates{DTR: opts["dtr"], RTS: opts["rts"]}
+	if err := c.server.service.applyOpenOptions(req, settings, states, opts["priority"]); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.server.service.OpenPort(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, failed{message: resp.Message}
+	}
+
+	c.mu.Lock()
+	c.sessions[frame.Port] = resp.SessionId
+	c.mu.Unlock()
+	return &wsframe.Frame{SessionID: resp.SessionId}, nil
+}
+
+// lineSettingsOf parses the line settings of an open's options
+func lineSettingsOf(opts map[string]string) (lineSettings, error) {
+	var l lineSettings
+	for _, field := range []struct {
+		key    string
+		target *int
+	}{{"baud_rate", &l.BaudRate}, {"data_bits", &l.DataBits}, {"stop_bits", &l.StopBits}} {
+		if value := opts[field.key]; value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return l, status.Errorf(codes.InvalidArgument, "invalid %s %q", field.key, value)
+			}
+			*field.target = n
+		}
+	}
+	l.Parity = opts["parity"]
+	l.FlowControl = opts["flow_control"]
+	l.LatencyProfile = opts["latency_profile"]
+	return l, nil
+}
+
+// close closes a session and stops its stream
+func (c *wsConn) close(frame *wsframe.Frame) (*wsframe.Frame, error) {
+	c.stopStream(frame.Port)
+	resp, err := c.server.service.ClosePort(c.ctx, &pb.ClosePortRequest{PortName: frame.Port, SessionId: frame.SessionID})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, failed{message: resp.Message}
+	}
+
+	c.mu.Lock()
+	if c.sessions[frame.Port] == frame.SessionID {
+		delete(c.sessions, frame.Port)
+	}
+	c.mu.Unlock()
+	return &wsframe.Frame{SessionID: frame.SessionID}, nil
+}
+
+// write writes data to a port; the result holds bytes_written. Writes held
+// for approval fail with the approval_id.
+func (c *wsConn) write(frame *wsframe.Frame) (*wsframe.Frame, error) {
+	resp, err := c.server.service.Write(c.ctx, &pb.WriteRequest{
+		PortName:  frame.Port,
+		SessionId: frame.SessionID,
+		Data:      frame.Data,
+		Flush:     frame.Options["flush"] == "true",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		f := failed{message: resp.Message}
+		if resp.ApprovalId != "" {
+			f.options = map[string]string{"approval_id": resp.ApprovalId}
+		}
+		return nil, f
+	}
+	return &wsframe.Frame{
+		SessionID: frame.SessionID,
+		Options:   map[string]string{"bytes_written": strconv.FormatUint(uint64(resp.BytesWritten), 10)},
+	}, nil
+}
+
+// read reads from a port. Options: max_bytes and timeout_ms.
+func (c *wsConn) read(frame *wsframe.Frame) (*wsframe.Frame, error) {
+	maxBytes, _ := strconv.ParseUint(frame.Options["max_bytes"], 10, 32)
+	timeoutMs, _ := strconv.ParseUint(frame.Options["timeout_ms"], 10, 32)
+	resp, err := c.server.service.Read(c.ctx, &pb.ReadRequest{
+		PortName:  frame.Port,
+		SessionId: frame.SessionID,
+		MaxBytes:  uint32(maxBytes),
+		TimeoutMs: uint32(timeoutMs),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Success {
+		return nil, failed{message: resp.Message}
+	}
+	return &wsframe.Frame{SessionID: frame.SessionID, Data: resp.Data, Timestamp: time.Now().UnixNano()}, nil
+}
+
+// stream starts streaming a port's data as "data" frames carrying the
+// request's ID, or stops it with the option stop=true. Options: chunking
+// (adaptive, low_latency, throughput), pattern and dedup_lines.
+func (c *wsConn) stream(frame *wsframe.Frame) (*wsframe.Frame, error) {
+	if frame.Options["stop"] == "true" {
+		c.stopStream(frame.Port)
+		return &wsframe.Frame{SessionID: frame.SessionID}, nil
+	}
+
+	req := &pb.StreamReadRequest{
+		PortName:          frame.Port,
+		SessionId:         frame.SessionID,
+		IncludeTimestamps: true,
+		DedupLines:        frame.Options["dedup_lines"] == "true",
+	}
+	if pattern := frame.Options["pattern"]; pattern != "" {
+		req.Filter = &pb.StreamFilter{Pattern: pattern}
+	}
+	switch frame.Options["chunking"] {
+	case "":
+	case "adaptive":
+		req.Chunking = pb.StreamChunking_STREAM_CHUNKING_ADAPTIVE
+	case "low_latency":
+		req.Chunking = pb.StreamChunking_STREAM_CHUNKING_LOW_LATENCY
+	case "throughput":
+		req.Chunking = pb.StreamChunking_STREAM_CHUNKING_THROUGHPUT
+	default:
+		return nil, failed{message: "invalid chunking " + strconv.Quote(frame.Options["chunking"]) + " (adaptive, low_latency, throughput)"}
+	}
+
+	ctx := c.callContext(frame)
+	if err := admitGateway(ctx, c.server.guard, frame.Options["client_id"]); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	done := func() {}
+	if guard := c.server.guard; guard != nil {
+		identities := overloadIdentities(ctx, frame.Options["client_id"])
+		client := identities[1]
+		if identities[0] != "" {
+			client = identities[0]
+		}
+		ctx, done = guard.Track(ctx, client, "websocket stream", guard.Priority(identities...))
+	}
+
+	c.mu.Lock()
+	if _, running := c.streams[frame.Port]; running {
+		c.mu.Unlock()
+		cancel()
+		done()
+		return nil, failed{message: "a stream of " + frame.Port + " is already running on this connection"}
+	}
+	c.streams[frame.Port] = cancel
+	c.mu.Unlock()
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer done()
+		defer cancel()
+
+		stream := &wsReadStream{ctx: ctx, send: func(resp *pb.StreamReadResponse) error {
+			data := &wsframe.Frame{
+				Type:      wsframe.TypeData,
+				ID:        frame.ID,
+				Port:      frame.Port,
+				SessionID: frame.SessionID,
+				Data:      resp.Chunk.GetData(),
+				Timestamp: resp.Chunk.Timestamp,
+				Sequence:  resp.Chunk.Sequence,
+			}
+			if resp.RepeatCount > 0 {
+				data.Options = map[string]string{"repeat_count": strconv.FormatUint(uint64(resp.RepeatCount), 10)}
+			}
+			return c.send(data)
+		}}
+		err := c.server.service.StreamRead(req, stream)
+
+		c.mu.Lock()
+		delete(c.streams, frame.Port)
+		c.mu.Unlock()
+		switch {
+		case c.ctx.Err() != nil:
+		case errors.Is(context.Cause(ctx), overload.ErrShed):
+			c.sendError(frame, status.Error(codes.ResourceExhausted, overload.ErrShed.Error()))
+		case ctx.Err() != nil:
+			// Stopped by the client
+		case err != nil:
+			c.sendError(frame, err)
+		default:
+			// The port closed: a final result ends the stream
+			c.send(&wsframe.Frame{Type: wsframe.TypeResult, ID: frame.ID, Port: frame.Port, SessionID: frame.SessionID, Options: map[string]string{"ended": "true"}})
+		}
+	}()
+
+	return &wsframe.Frame{SessionID: frame.SessionID}, nil
+}
+
+// monitor follows the data read from a port by whichever session has it
+// open, as "data" frames carrying the request's ID and, for the session's
+// opener and administrators, the session's ID, or stops it with the option
+// stop=true. Unlike a stream it needs no
+// session and never consumes data; it survives the port closing and
+// follows the next session to open it, until stopped.
+func (c *wsConn) monitor(frame *wsframe.Frame) (*wsframe.Frame, error) {
+	if frame.Options["stop"] == "true" {
+		c.stopStream(frame.Port)
+		return &wsframe.Frame{}, nil
+	}
+	if frame.Port == "" {
+		return nil, status.Error(codes.InvalidArgument, "port is required")
+	}
+
+	ctx := c.callContext(frame)
+	service := c.server.service
+	if err := service.checkAccess(ctx, frame.Port, frame.Options["client_id"]); err != nil {
+		return nil, err
+	}
+	if err := admitGateway(ctx, c.server.guard, frame.Options["client_id"]); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	c.mu.Lock()
+	if _, running := c.streams[frame.Port]; running {
+		c.mu.Unlock()
+		cancel()
+		return nil, failed{message: "a stream of " + frame.Port + " is already running on this connection"}
+	}
+	c.streams[frame.Port] = cancel
+	c.mu.Unlock()
+
+	manager := service.manager
+	events := manager.SubscribeEvents()
+
+	var (
+		data      <-chan []byte
+		sessionID string
+		// shownID is the session ID as the caller may see it
+		shownID string
+	)
+	attach := func(session *serial.Session) {
+		ch, err := manager.SubscribeToReads(frame.Port, session.ID, "websocket monitor")
+		if err != nil {
+			return
+		}
+		data = ch
+		sessionID = session.ID
+		shownID = service.visibleSessionID(ctx, session.ID)
+	}
+	if session := manager.GetSession(frame.Port); session != nil {
+		attach(session)
+	}
+	result := &wsframe.Frame{SessionID: shownID}
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer cancel()
+		defer manager.UnsubscribeEvents(events)
+		defer func() {
+			if data != nil {
+				_ = manager.UnsubscribeFromReads(frame.Port, sessionID, data)
+			}
+			c.mu.Lock()
+			delete(c.streams, frame.Port)
+			c.mu.Unlock()
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case chunk, ok := <-data:
+				if !ok {
+					data = nil
+					sessionID, shownID = "", ""
+					continue
+				}
+				if c.send(&wsframe.Frame{
+					Type:      wsframe.TypeData,
+					ID:        frame.ID,
+					Port:      frame.Port,
+					SessionID: shownID,
+					Data:      chunk,
+					Timestamp: time.Now().UnixNano(),
+				}) != nil {
+					return
+				}
+
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				if event.PortName == frame.Port && event.Type == serial.PortEventOpened && data == nil {
+					if session := manager.GetSession(frame.Port); session != nil {
+						attach(session)
+					}
+				}
+			}
+		}
+	}()
+
+	return result, nil
+}
+
+// upload flashes the firmware in data to the board on a port, sending the
+// progress as "data" frames carrying the request's ID before the result.
+// Options: protocol (stk500v1, avr109), reset (auto, dtr, 1200bps, none),
+// baud_rate, skip_verify and client_id.
+func (c *wsConn) upload(frame *wsframe.Frame) (*wsframe.Frame, error) {
+	opts := frame.Options
+	req := &pb.UploadFirmwareRequest{
+		PortName:   frame.Port,
+		Firmware:   frame.Data,
+		SkipVerify: opts["skip_verify"] == "true",
+		ClientId:   opts["client_id"],
+	}
+	switch opts["protocol"] {
+	case "", "stk500v1":
+		req.Protocol = pb.BootloaderProtocol_BOOTLOADER_PROTOCOL_STK500V1
+	case "avr109":
+		req.Protocol = pb.BootloaderProtocol_BOOTLOADER_PROTOCOL_AVR109
+	default:
+		return nil, failed{message: "invalid protocol " + strconv.Quote(opts["protocol"]) + " (stk500v1, avr109)"}
+	}
+	switch opts["reset"] {
+	case "", "auto":
+	case "dtr":
+		req.ResetMethod = pb.BootloaderReset_BOOTLOADER_RESET_DTR
+	case "1200bps":
+		req.ResetMethod = pb.BootloaderReset_BOOTLOADER_RESET_TOUCH_1200
+	case "none":
+		req.ResetMethod = pb.BootloaderReset_BOOTLOADER_RESET_NONE
+	default:
+		return nil, failed{message: "invalid reset " + strconv.Quote(opts["reset"]) + " (auto, dtr, 1200bps, none)"}
+	}
+	if value := opts["baud_rate"]; value != "" {
+		baud, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid baud_rate %q", value)
+		}
+		req.BaudRate = uint32(baud)
+	}
+
+	var last *pb.UploadFirmwareResponse
+	stream := &wsUploadStream{ctx: c.callContext(frame), send: func(resp *pb.UploadFirmwareResponse) error {
+		last = resp
+		if resp.Stage == pb.UploadStage_UPLOAD_STAGE_DONE {
+			return nil
+		}
+		return c.send(&wsframe.Frame{
+			Type: wsframe.TypeData,
+			ID:   frame.ID,
+			Port: resp.PortName,
+			Options: map[string]string{
+				"stage":       uploadStageOption(resp.Stage),
+				"bytes_done":  strconv.FormatUint(uint64(resp.BytesDone), 10),
+				"bytes_total": strconv.FormatUint(uint64(resp.BytesTotal), 10),
+				"message":     resp.Message,
+			},
+		})
+	}}
+	if err := c.server.service.UploadFirmware(req, stream); err != nil {
+		return nil, err
+	}
+	return &wsframe.Frame{
+		Port: last.PortName,
+		Options: map[string]string{
+			"device":      last.Device,
+			"bytes":       strconv.FormatUint(uint64(last.BytesTotal), 10),
+			"verified":    strconv.FormatBool(last.Verified),
+			"duration_ms": strconv.FormatUint(last.DurationMs, 10),
+		},
+	}, nil
+}
+
+// uploadStageOption names an upload stage in "data" frames
+func uploadStageOption(stage pb.UploadStage) string {
+	switch stage {
+	case pb.UploadStage_UPLOAD_STAGE_RESETTING:
+		return "resetting"
+	case pb.UploadStage_UPLOAD_STAGE_CONNECTING:
+		return "connecting"
+	case pb.UploadStage_UPLOAD_STAGE_ERASING:
+		return "erasing"
+	case pb.UploadStage_UPLOAD_STAGE_WRITING:
+		return "writing"
+	case pb.UploadStage_UPLOAD_STAGE_VERIFYING:
+		return "verifying"
+	}
+	return "unknown"
+}
+
+// stopStream stops the stream of a port, if any
+func (c *wsConn) stopStream(portName string) {
+	c.mu.Lock()
+	cancel, ok := c.streams[portName]
+	c.mu.Unlock()
+	if ok {
+		cancel()
+	}
+}
+
+// closeSessions closes the sessions opened over the connection, so a
+// browser tab going away does not leave ports locked
+func (c *wsConn) closeSessions() {
+	c.mu.Lock()
+	sessions := c.sessions
+	c.sessions = nil
+	c.mu.Unlock()
+
+	// The connection's context is done by now; its peer still identifies
+	// the client
+	ctx := context.WithoutCancel(c.ctx)
+	for portName, sessionID := range sessions {
+		if _, err := c.server.service.ClosePort(ctx, &pb.ClosePortRequest{PortName: portName, SessionId: sessionID}); err != nil {
+			c.server.logger.Warn("failed to close WebSocket session", "port", portName, "session", sessionID, "error", err)
+		}
+	}
+}
+
+// wsReadStream runs StreamRead for a gateway connection. Only Context and
+// Send are used by the service.
+type wsReadStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	send func(*pb.StreamReadResponse) error
+}
+
+// Context returns the stream's context
+func (s *wsReadStream) Context() context.Context {
+	return s.ctx
+}
+
+// Send sends a response to the client
+func (s *wsReadStream) Send(resp *pb.StreamReadResponse) error {
+	return s.send(resp)
+}
+
+// wsUploadStream runs UploadFirmware for a gateway connection. Only Context
+// and Send are used by the service.
+type wsUploadStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	send func(*pb.UploadFirmwareResponse) error
+}
+
+// Context returns the stream's context
+func (s *wsUploadStream) Context() context.Context {
+	return s.ctx
+}
+
+// Send sends a response to the client
+func (s *wsUploadStream) Send(resp *pb.UploadFirmwareResponse) error {
+	return s.send(resp)
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package client provides a Go SDK for connecting to a SerialLink agent.
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/Shoaibashk/SerialLink/internal/tlsutil"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Options controls how the client reaches the agent
+type Options struct {
+	// SSH, when set, tunnels the gRPC connection through an SSH server.
+	// The agent address is then resolved from the SSH server's point of view,
+	// so "localhost:50051" reaches an agent bound to loopback on that host.
+	SSH *SSHOptions
+
+	// SPIFFE, when set, uses mutual TLS with the workload's SVID from the
+	// SPIFFE Workload API instead of a plaintext connection
+	SPIFFE *SPIFFEOptions
+
+	// Token is the API token sent with every call to an agent that has
+	// auth.enabled set
+	Token string
+
+	// Username and Password are sent instead of a token to agents
+	// authenticating against LDAP
+	Username string
+	Password string
+
+	// DialOptions are appended to the options used to create the connection
+	DialOptions []grpc.DialOption
+}
+
+// SPIFFEOptions selects the Workload API and the accepted agent identity
+type SPIFFEOptions struct {
+	// SocketPath is the Workload API address (default: $SPIFFE_ENDPOINT_SOCKET)
+	SocketPath string
+
+	// ServerIDs lists accepted agent SPIFFE IDs; when empty any agent in
+	// TrustDomain (or the client's own trust domain) is accepted
+	ServerIDs   []string
+	TrustDomain string
+}
+
+// Client is a connection to a SerialLink agent
+type Client struct {
+	pb.SerialServiceClient
+	conn      *grpc.ClientConn
+	sshClient *ssh.Client
+	svids     io.Closer
+	failover  *failoverConn
+}
+
+// Dial connects to the agent at address: host:port, or an SRV record as
+// "srv://_seriallink._tcp.example.com" to fail over among the agents it lists
+func Dial(address string, opts Options) (*Client, error) {
+	c := &Client{}
+
+	creds := insecure.NewCredentials()
+	if opts.SPIFFE != nil {
+		tlsConfig, source, err := tlsutil.SPIFFEClientConfig(context.Background(), tlsutil.SPIFFEOptions{
+			SocketPath:  opts.SPIFFE.SocketPath,
+			TrustDomain: opts.SPIFFE.TrustDomain,
+			AllowedIDs:  opts.SPIFFE.ServerIDs,
+		})
+		if err != nil {
+			return nil, err
+		}
+		c.svids = source
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if opts.Token != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(tokenCredentials(opts.Token)))
+	} else if opts.Username != "" {
+		basic := base64.StdEncoding.EncodeToString([]byte(opts.Username + ":" + opts.Password))
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(basicCredentials(basic)))
+	}
+	srv := IsSRVAddress(address)
+	if srv {
+		dialOpts = append(dialOpts, grpc.WithResolvers(srvBuilder{}))
+	}
+
+	if opts.SSH != nil {
+		sshClient, err := dialSSH(*opts.SSH)
+		if err != nil {
+			c.Close()
+			return nil, err
+		}
+		c.sshClient = sshClient
+
+		dialOpts = append(dialOpts,
+			grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+				return sshClient.DialContext(ctx, "tcp", addr)
+			}),
+		)
+		// The target is dialed on the remote side, so skip local DNS
+		// resolution; SRV records are still looked up locally
+		if !srv {
+			address = "passthrough:///" + address
+		}
+	}
+
+	dialOpts = append(dialOpts, opts.DialOptions...)
+
+	conn, err := grpc.NewClient(address, dialOpts...)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed to connect to service at %s: %w", address, err)
+	}
+	c.conn = conn
+	c.SerialServiceClient = pb.NewSerialServiceClient(conn)
+
+	return c, nil
+}
+
+// tokenCredentials sends an API token as a bearer token with every call
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity allows tokens over plaintext connections, which
+// are the default; use SPIFFE or an SSH tunnel to keep them private
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// basicCredentials sends an encoded username and password with every call
+type basicCredentials string
+
+func (b basicCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Basic " + string(b)}, nil
+}
+
+// RequireTransportSecurity allows passwords over plaintext connections,
+// as for tokens
+func (b basicCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// Conn returns the underlying gRPC connection; with failover, that of the
+// active agent
+func (c *Client) Conn() *grpc.ClientConn {
+	if c.failover != nil {
+		_, conn := c.failover.current()
+		return conn
+	}
+	return c.conn
+}
+
+// Close closes the gRPC connections and any SSH tunnel
+func (c *Client) Close() error {
+	var errs []error
+	if c.conn != nil {
+		errs = append(errs, c.conn.Close())
+	}
+	errs = append(errs, c.closeSSH())
+	if c.svids != nil {
+		errs = append(errs, c.svids.Close())
+		c.svids = nil
+	}
+	if c.failover != nil {
+		errs = append(errs, c.failover.close())
+		c.failover = nil
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Client) closeSSH() error {
+	if c.sshClient == nil {
+		return nil
+	}
+	err := c.sshClient.Close()
+	c.sshClient = nil
+	return err
+}
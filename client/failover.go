@@ -0,0 +1,397 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultHealthInterval = 5 * time.Second
+	defaultHealthTimeout  = 2 * time.Second
+)
+
+// FailoverOptions controls failover between redundant agents
+type FailoverOptions struct {
+	// HealthInterval between Ping health checks of every agent (default 5s)
+	HealthInterval time.Duration
+	// HealthTimeout bounds each check (default 2s)
+	HealthTimeout time.Duration
+
+	// ReopenSessions opens the ports of sessions held on a failed agent on
+	// the new one, for ports present on both (e.g. shared modem pools).
+	// Callers keep using their original session IDs.
+	ReopenSessions bool
+
+	// OnFailover, when set, is called after switching agents
+	OnFailover func(FailoverEvent)
+}
+
+// FailoverEvent describes a switch between agents
+type FailoverEvent struct {
+	From string
+	To   string
+	// Reopened lists the sessions re-established on the new agent
+	Reopened []string
+	// Lost lists the sessions that could not be re-established; calls
+	// using them fail until the port is opened again
+	Lost []string
+}
+
+// trackedSession is a session opened through a failover client
+type trackedSession struct {
+	request *pb.OpenPortRequest
+	// current is the session's ID on the active agent
+	current string
+}
+
+// failoverConn sends calls to the active agent, switching to the next
+// healthy agent in list order when it fails. It does not switch back while
+// the new agent stays healthy, so sessions are not moved needlessly.
+type failoverConn struct {
+	addresses []string
+	clients   []*Client
+	opts      FailoverOptions
+
+	mu       sync.Mutex
+	active   int
+	healthy  []bool
+	sessions map[string]*trackedSession // key: session ID returned to the caller
+
+	check  chan struct{}
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// DialFailover connects to redundant agents, listed in order of preference.
+// Calls go to the first healthy agent; when it fails a health check or a
+// call finds it unavailable, later calls go to the next healthy one. Calls
+// in flight on the failed agent are not retried.
+func DialFailover(addresses []string, opts Options, failover FailoverOptions) (*Client, error) {
+	if len(addresses) == 0 {
+		return nil, errors.New("no agent addresses")
+	}
+	if failover.HealthInterval <= 0 {
+		failover.HealthInterval = defaultHealthInterval
+	}
+	if failover.HealthTimeout <= 0 {
+		failover.HealthTimeout = defaultHealthTimeout
+	}
+
+	f := &failoverConn{
+		addresses: addresses,
+		opts:      failover,
+		healthy:   make([]bool, len(addresses)),
+		sessions:  make(map[string]*trackedSession),
+		check:     make(chan struct{}, 1),
+	}
+	for _, address := range addresses {
+		c, err := Dial(address, opts)
+		if err != nil {
+			f.close()
+			return nil, err
+		}
+		f.clients = append(f.clients, c)
+	}
+
+	// Start on the first agent that answers
+	f.checkHealth()
+	for i, ok := range f.healthy {
+		if ok {
+			f.active = i
+			break
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	f.cancel = cancel
+	f.wg.Add(1)
+	go f.monitor(ctx)
+
+	return &Client{SerialServiceClient: pb.NewSerialServiceClient(f), failover: f}, nil
+}
+
+// Agent returns the address of the agent calls currently go to
+func (c *Client) Agent() string {
+	if c.failover == nil {
+		return ""
+	}
+	c.failover.mu.Lock()
+	defer c.failover.mu.Unlock()
+	return c.failover.addresses[c.failover.active]
+}
+
+// current returns the active agent
+func (f *failoverConn) current() (int, *grpc.ClientConn) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.active, f.clients[f.active].conn
+}
+
+// Invoke performs a unary call on the active agent
+func (f *failoverConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	index, conn := f.current()
+
+	original, restore := f.rewriteSession(args)
+	err := conn.Invoke(ctx, method, args, reply, opts...)
+	restore()
+
+	if err != nil {
+		f.failed(index, err)
+		return err
+	}
+	f.track(index, original, args, reply)
+	return nil
+}
+
+// NewStream opens a stream on the active agent
+func (f *failoverConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	index, conn := f.current()
+	stream, err := conn.NewStream(ctx, desc, method, opts...)
+	if err != nil {
+		f.failed(index, err)
+		return nil, err
+	}
+	return &failoverStream{ClientStream: stream, conn: f}, nil
+}
+
+// failoverStream maps session IDs in messages sent on a stream
+type failoverStream struct {
+	grpc.ClientStream
+	conn *failoverConn
+}
+
+// SendMsg sends m with its session ID mapped to the active agent
+func (s *failoverStream) SendMsg(m any) error {
+	_, restore := s.conn.rewriteSession(m)
+	defer restore()
+	return s.ClientStream.SendMsg(m)
+}
+
+// rewriteSession replaces the session ID of a request with the session's ID
+// on the active agent until restore is called. It returns the ID the
+// caller used.
+func (f *failoverConn) rewriteSession(msg any) (string, func()) {
+	field := sessionIDField(msg)
+	if !field.IsValid() {
+		return "", func() {}
+	}
+	original := field.String()
+
+	f.mu.Lock()
+	session, ok := f.sessions[original]
+	f.mu.Unlock()
+	if !ok || session.current == original {
+		return original, func() {}
+	}
+
+	field.SetString(session.current)
+	return original, func() { field.SetString(original) }
+}
+
+// sessionIDField returns the settable SessionId string field of a message
+func sessionIDField(msg any) reflect.Value {
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	field := v.Elem().FieldByName("SessionId")
+	if !field.IsValid() || field.Kind() != reflect.String || !field.CanSet() {
+		return reflect.Value{}
+	}
+	return field
+}
+
+// track remembers opened sessions so they can be re-established
+func (f *failoverConn) track(index int, sessionID string, args, reply any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if index != f.active {
+		return
+	}
+
+	switch req := args.(type) {
+	case *pb.OpenPortRequest:
+		if resp, ok := reply.(*pb.OpenPortResponse); ok && resp.Success {
+			f.sessions[resp.SessionId] = &trackedSession{
+				request: &pb.OpenPortRequest{
+					PortName:  req.PortName,
+					Config:    req.Config,
+					ClientId:  req.ClientId,
+					Exclusive: req.Exclusive,
+					Priority:  req.Priority,
+				},
+				current: resp.SessionId,
+			}
+		}
+	case *pb.ClosePortRequest:
+		if resp, ok := reply.(*pb.ClosePortResponse); ok && resp.Success {
+			delete(f.sessions, sessionID)
+		}
+	}
+}
+
+// failed marks an agent down when a call finds it unavailable
+func (f *failoverConn) failed(index int, err error) {
+	if status.Code(err) != codes.Unavailable {
+		return
+	}
+	f.mu.Lock()
+	f.healthy[index] = false
+	f.mu.Unlock()
+
+	select {
+	case f.check <- struct{}{}:
+	default:
+	}
+}
+
+// monitor checks the agents periodically and fails over when needed
+func (f *failoverConn) monitor(ctx context.Context) {
+	defer f.wg.Done()
+
+	ticker := time.NewTicker(f.opts.HealthInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-f.check:
+		}
+		f.checkHealth()
+		f.failoverIfDown(ctx)
+	}
+}
+
+// checkHealth pings every agent
+func (f *failoverConn) checkHealth() {
+	results := make([]bool, len(f.clients))
+	var wg sync.WaitGroup
+	for i, c := range f.clients {
+		wg.Add(1)
+		go func(i int, c *Client) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), f.opts.HealthTimeout)
+			defer cancel()
+			_, err := c.Ping(ctx, &pb.PingRequest{Message: "health"})
+			results[i] = err == nil
+		}(i, c)
+	}
+	wg.Wait()
+
+	f.mu.Lock()
+	copy(f.healthy, results)
+	f.mu.Unlock()
+}
+
+// failoverIfDown switches to the first healthy agent when the active one
+// is down, re-establishing sessions if configured
+func (f *failoverConn) failoverIfDown(ctx context.Context) {
+	f.mu.Lock()
+	from := f.active
+	if f.healthy[from] {
+		f.mu.Unlock()
+		return
+	}
+	to := -1
+	for i, ok := range f.healthy {
+		if ok {
+			to = i
+			break
+		}
+	}
+	if to < 0 {
+		f.mu.Unlock()
+		return
+	}
+	sessions := make(map[string]*trackedSession, len(f.sessions))
+	for id, session := range f.sessions {
+		sessions[id] = session
+	}
+	f.mu.Unlock()
+
+	event := FailoverEvent{From: f.addresses[from], To: f.addresses[to]}
+	reopened := make(map[string]string)
+	for id, session := range sessions {
+		if !f.opts.ReopenSessions {
+			event.Lost = append(event.Lost, id)
+			continue
+		}
+		newID, err := f.reopen(ctx, to, session.request)
+		if err != nil {
+			event.Lost = append(event.Lost, id)
+			continue
+		}
+		reopened[id] = newID
+		event.Reopened = append(event.Reopened, id)
+	}
+
+	f.mu.Lock()
+	f.active = to
+	for _, id := range event.Lost {
+		delete(f.sessions, id)
+	}
+	for id, newID := range reopened {
+		if session, ok := f.sessions[id]; ok {
+			session.current = newID
+		}
+	}
+	f.mu.Unlock()
+
+	if f.opts.OnFailover != nil {
+		f.opts.OnFailover(event)
+	}
+}
+
+// reopen opens a session's port on another agent
+func (f *failoverConn) reopen(ctx context.Context, index int, req *pb.OpenPortRequest) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, f.opts.HealthTimeout)
+	defer cancel()
+
+	resp, err := f.clients[index].OpenPort(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	if !resp.Success {
+		return "", fmt.Errorf("failed to open %s: %s", req.PortName, resp.Message)
+	}
+	return resp.SessionId, nil
+}
+
+// close stops monitoring and closes every agent connection
+func (f *failoverConn) close() error {
+	if f.cancel != nil {
+		f.cancel()
+		f.wg.Wait()
+	}
+	var errs []error
+	for _, c := range f.clients {
+		errs = append(errs, c.Close())
+	}
+	return errors.Join(errs...)
+}
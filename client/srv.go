@@ -0,0 +1,157 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/resolver"
+)
+
+// SRVScheme selects DNS SRV discovery: "srv://_seriallink._tcp.example.com"
+// connects to the agents the record lists, failing over between them
+const SRVScheme = "srv"
+
+const (
+	// srvRefreshInterval re-resolves so agents added or moved in DNS are
+	// picked up without reconnecting
+	srvRefreshInterval = 5 * time.Minute
+	// srvMinInterval limits lookups when connections keep failing
+	srvMinInterval   = 10 * time.Second
+	srvLookupTimeout = 10 * time.Second
+)
+
+// IsSRVAddress reports whether address uses DNS SRV discovery
+func IsSRVAddress(address string) bool {
+	return strings.HasPrefix(address, SRVScheme+"://")
+}
+
+// srvBuilder creates resolvers for srv:// targets
+type srvBuilder struct{}
+
+// Scheme returns the URL scheme the builder handles
+func (srvBuilder) Scheme() string {
+	return SRVScheme
+}
+
+// Build starts resolving the SRV record named by the target
+func (srvBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+	name := target.URL.Host
+	if name == "" {
+		name = target.Endpoint()
+	}
+	if name == "" {
+		return nil, fmt.Errorf("srv address needs a record name, e.g. srv://_seriallink._tcp.example.com")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &srvResolver{
+		name:    name,
+		cc:      cc,
+		resolve: make(chan struct{}, 1),
+		cancel:  cancel,
+	}
+	r.wg.Add(1)
+	go r.run(ctx)
+	return r, nil
+}
+
+// srvResolver looks up an SRV record and hands its targets to gRPC in
+// priority order, randomized by weight within a priority (RFC 2782). The
+// pick_first balancer then tries them in turn, so the connection fails over
+// to the next agent when one is unreachable.
+type srvResolver struct {
+	name    string
+	cc      resolver.ClientConn
+	resolve chan struct{}
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// ResolveNow asks for a fresh lookup, e.g. after every target failed
+func (r *srvResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.resolve <- struct{}{}:
+	default:
+	}
+}
+
+// Close stops resolving
+func (r *srvResolver) Close() {
+	r.cancel()
+	r.wg.Wait()
+}
+
+// run resolves on start, on request and periodically
+func (r *srvResolver) run(ctx context.Context) {
+	defer r.wg.Done()
+
+	for {
+		addresses, err := r.lookup(ctx)
+		if err != nil {
+			r.cc.ReportError(err)
+		} else if err := r.cc.UpdateState(resolver.State{Addresses: addresses}); err != nil {
+			r.cc.ReportError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(srvMinInterval):
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.resolve:
+		case <-time.After(srvRefreshInterval - srvMinInterval):
+		}
+	}
+}
+
+// lookup returns the record's targets in the order to try them
+func (r *srvResolver) lookup(ctx context.Context) ([]resolver.Address, error) {
+	ctx, cancel := context.WithTimeout(ctx, srvLookupTimeout)
+	defer cancel()
+
+	_, records, err := net.DefaultResolver.LookupSRV(ctx, "", "", r.name)
+	if err != nil {
+		return nil, fmt.Errorf("SRV lookup of %s failed: %w", r.name, err)
+	}
+
+	addresses := make([]resolver.Address, 0, len(records))
+	for _, record := range records {
+		host := strings.TrimSuffix(record.Target, ".")
+		// A target of "." means the service is deliberately unavailable
+		if host == "" {
+			continue
+		}
+		addresses = append(addresses, resolver.Address{
+			Addr: net.JoinHostPort(host, strconv.Itoa(int(record.Port))),
+		})
+	}
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("SRV record %s lists no agents", r.name)
+	}
+	return addresses, nil
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// SSHOptions describes the SSH server used to tunnel to the agent
+type SSHOptions struct {
+	// Target is "[user@]host[:port]"; the user defaults to the local user
+	// and the port to 22
+	Target string
+
+	// KeyFile is a private key used for authentication. When empty, the
+	// ssh-agent (SSH_AUTH_SOCK) and the default ~/.ssh/id_* keys are tried.
+	KeyFile string
+
+	// KnownHostsFile defaults to ~/.ssh/known_hosts
+	KnownHostsFile string
+
+	// InsecureIgnoreHostKey disables host key verification
+	InsecureIgnoreHostKey bool
+
+	// Timeout bounds the SSH handshake (default 10s)
+	Timeout time.Duration
+}
+
+// dialSSH establishes the SSH connection used as the tunnel
+func dialSSH(opts SSHOptions) (*ssh.Client, error) {
+	username, host := splitSSHTarget(opts.Target)
+	if host == "" {
+		return nil, fmt.Errorf("invalid SSH target %q", opts.Target)
+	}
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		host = net.JoinHostPort(host, "22")
+	}
+
+	auth, agentConn, err := sshAuthMethods(opts.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	if agentConn != nil {
+		// The agent only signs during the handshake
+		defer agentConn.Close()
+	}
+
+	hostKeyCallback, err := sshHostKeyCallback(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	client, err := ssh.Dial("tcp", host, &ssh.ClientConfig{
+		User:            username,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         timeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to SSH server %s: %w", host, err)
+	}
+
+	return client, nil
+}
+
+// splitSSHTarget splits "[user@]host[:port]" into user and host parts
+func splitSSHTarget(target string) (string, string) {
+	username, host, found := strings.Cut(target, "@")
+	if !found {
+		host = username
+		username = ""
+	}
+
+	if username == "" {
+		if u, err := user.Current(); err == nil {
+			username = u.Username
+		}
+	}
+
+	return username, host
+}
+
+// sshAuthMethods collects key-based authentication methods. The connection
+// to the ssh-agent, when one is used, must be closed after the handshake.
+func sshAuthMethods(keyFile string) ([]ssh.AuthMethod, net.Conn, error) {
+	if keyFile != "" {
+		signer, err := loadSigner(keyFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		return []ssh.AuthMethod{ssh.PublicKeys(signer)}, nil, nil
+	}
+
+	var (
+		methods   []ssh.AuthMethod
+		agentConn net.Conn
+	)
+
+	if sock := os.Getenv("SSH_AUTH_SOCK"); sock != "" {
+		if conn, err := net.Dial("unix", sock); err == nil {
+			agentConn = conn
+			methods = append(methods, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+		}
+	}
+
+	home, _ := os.UserHomeDir()
+	var signers []ssh.Signer
+	for _, name := range []string{"id_ed25519", "id_ecdsa", "id_rsa"} {
+		signer, err := loadSigner(filepath.Join(home, ".ssh", name))
+		if err == nil {
+			signers = append(signers, signer)
+		}
+	}
+	if len(signers) > 0 {
+		methods = append(methods, ssh.PublicKeys(signers...))
+	}
+
+	if len(methods) == 0 {
+		return nil, nil, errors.New("no SSH keys available: start ssh-agent or pass a key file")
+	}
+
+	return methods, agentConn, nil
+}
+
+func loadSigner(path string) (ssh.Signer, error) {
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SSH key: %w", err)
+	}
+
+	signer, err := ssh.ParsePrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SSH key %s: %w", path, err)
+	}
+
+	return signer, nil
+}
+
+// sshHostKeyCallback verifies the server against known_hosts
+func sshHostKeyCallback(opts SSHOptions) (ssh.HostKeyCallback, error) {
+	if opts.InsecureIgnoreHostKey {
+		return ssh.InsecureIgnoreHostKey(), nil
+	}
+
+	path := opts.KnownHostsFile
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("cannot locate known_hosts: %w", err)
+		}
+		path = filepath.Join(home, ".ssh", "known_hosts")
+	}
+
+	callback, err := knownhosts.New(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load known_hosts: %w", err)
+	}
+
+	return callback, nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var alarmsCmd = &cobra.Command{
+	Use:   "alarms",
+	Short: "List threshold alarms raised on polled values",
+	Long: `List alarms that are active or waiting for acknowledgment.
+
+An alarm stays listed until it has cleared and been acknowledged.
+
+Example:
+  seriallink alarms
+  seriallink alarms --all          # Include finished alarms
+  seriallink alarms ack hot-3 --by alice`,
+	Args: cobra.NoArgs,
+	RunE: runAlarms,
+}
+
+var alarmsAckCmd = &cobra.Command{
+	Use:   "ack ALARM_ID",
+	Short: "Acknowledge an alarm",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runAlarmsAck,
+}
+
+func init() {
+	rootCmd.AddCommand(alarmsCmd)
+	alarmsCmd.AddCommand(alarmsAckCmd)
+
+	alarmsCmd.Flags().Bool("all", false, "include cleared and acknowledged alarms")
+	alarmsCmd.Flags().Bool("json", false, "output in JSON format")
+	alarmsAckCmd.Flags().String("by", "", "who acknowledges the alarm (default: client address)")
+}
+
+func runAlarms(cmd *cobra.Command, args []string) error {
+	all, _ := cmd.Flags().GetBool("all")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.ListAlarms(ctx, &pb.ListAlarmsRequest{IncludeHistory: all})
+	if err != nil {
+		return fmt.Errorf("failed to list alarms: %w", err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp.Alarms)
+	}
+
+	if len(resp.Alarms) == 0 {
+		fmt.Println("No alarms")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tSEVERITY\tSTATE\tACK\tRAISED\tVALUE\tMESSAGE")
+	fmt.Fprintln(w, "--\t--------\t-----\t---\t------\t-----\t-------")
+	for _, a := range resp.Alarms {
+		ack := "no"
+		if a.Acknowledged {
+			ack = a.AcknowledgedBy
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%g\t%s\n",
+			a.Id, a.Severity, a.State, ack,
+			time.Unix(0, a.RaisedAt).Format("2006-01-02 15:04:05"),
+			a.Value, a.Message)
+	}
+	return w.Flush()
+}
+
+func runAlarmsAck(cmd *cobra.Command, args []string) error {
+	by, _ := cmd.Flags().GetString("by")
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.AcknowledgeAlarm(ctx, &pb.AcknowledgeAlarmRequest{
+		AlarmId:        args[0],
+		AcknowledgedBy: by,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge alarm: %w", err)
+	}
+
+	fmt.Printf("Acknowledged %s (%s)\n", resp.Alarm.Id, resp.Alarm.State)
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var approvalsCmd = &cobra.Command{
+	Use:   "approvals",
+	Short: "List writes waiting for a second operator's approval",
+	Long: `List writes held by a port's write policy until another operator
+approves them (serial.write_policies[].require_approval).
+
+Example:
+  seriallink approvals
+  seriallink approvals approve 6f1c2a9e-...
+  seriallink approvals reject 6f1c2a9e-...`,
+	Args: cobra.NoArgs,
+	RunE: runApprovals,
+}
+
+var approvalsApproveCmd = &cobra.Command{
+	Use:   "approve APPROVAL_ID",
+	Short: "Approve a held write and send it to the port",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runApprovalsApprove,
+}
+
+var approvalsRejectCmd = &cobra.Command{
+	Use:   "reject APPROVAL_ID",
+	Short: "Discard a held write",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runApprovalsReject,
+}
+
+func init() {
+	rootCmd.AddCommand(approvalsCmd)
+	approvalsCmd.AddCommand(approvalsApproveCmd)
+	approvalsCmd.AddCommand(approvalsRejectCmd)
+
+	approvalsCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+func runApprovals(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.ListPendingWrites(ctx, &pb.ListPendingWritesRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list pending writes: %w", err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp.Writes)
+	}
+
+	if len(resp.Writes) == 0 {
+		fmt.Println("No writes waiting for approval")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tPORT\tREQUESTED BY\tREQUESTED\tEXPIRES\tDATA")
+	fmt.Fprintln(w, "--\t----\t------------\t---------\t-------\t----")
+	for _, p := range resp.Writes {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			p.ApprovalId, p.PortName, p.RequestedBy,
+			time.Unix(0, p.RequestedAt).Format("2006-01-02 15:04:05"),
+			time.Unix(0, p.ExpiresAt).Format("15:04:05"),
+			strconv.Quote(string(p.Data)))
+	}
+	return w.Flush()
+}
+
+func runApprovalsApprove(cmd *cobra.Command, args []string) error {
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.ApproveWrite(ctx, &pb.ApproveWriteRequest{ApprovalId: args[0]})
+	if err != nil {
+		return fmt.Errorf("failed to approve write: %w", err)
+	}
+	if !resp.Success {
+		return fmt.Errorf("approved write failed: %s", resp.Message)
+	}
+
+	fmt.Printf("Approved; wrote %d bytes\n", resp.BytesWritten)
+	return nil
+}
+
+func runApprovalsReject(cmd *cobra.Command, args []string) error {
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.RejectWrite(ctx, &pb.RejectWriteRequest{ApprovalId: args[0]})
+	if err != nil {
+		return fmt.Errorf("failed to reject write: %w", err)
+	}
+
+	fmt.Printf("Rejected write to %s requested by %s\n", resp.Write.PortName, resp.Write.RequestedBy)
+	return nil
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/config"
+	"github.com/Shoaibashk/SerialLink/internal/backup"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Export or import the agent's configuration and state",
+	Long: `Bundle the agent's configuration and state into one archive, to clone a
+configured gateway onto a replacement device. A bundle holds the config
+file (with its device and scanner profiles, write policies, input guards,
+pollers and port actions), the reservations, the usage totals and the API
+token file, with a manifest recording where each file came from. TLS
+credentials, the access token key and the compliance signing key are only
+included with --include-secrets.
+
+Bundles hold secrets: the config file may hold passwords and token hashes,
+and with --include-secrets the bundle holds private keys that let anyone
+impersonate the agent, mint access tokens or sign compliance logs. Keep
+bundles as private as the keys themselves. Restored tokens and keys are
+readable by their owner only.
+
+Run it on the gateway itself; the files are found through the config file
+(--config). Stop the agent before importing and start it afterwards.
+
+Example:
+  seriallink backup export -o gateway.tar.gz
+  seriallink backup export --include-secrets -o - | ssh new-gw 'cat > gw.tar.gz'
+  seriallink backup import gateway.tar.gz`,
+}
+
+var backupExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Write a backup bundle",
+	Args:  cobra.NoArgs,
+	RunE:  runBackupExport,
+}
+
+var backupImportCmd = &cobra.Command{
+	Use:   "import FILE",
+	Short: "Restore a backup bundle",
+	Long: `Restore a backup bundle ("-" reads standard input). The config file is
+written to --config (default: the config file in use, or the default
+location); reservations, usage totals and TLS credentials go where the
+imported config expects them. Existing files are only replaced with
+--force.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBackupImport,
+}
+
+func init() {
+	rootCmd.AddCommand(backupCmd)
+	backupCmd.AddCommand(backupExportCmd)
+	backupCmd.AddCommand(backupImportCmd)
+
+	backupExportCmd.Flags().StringP("output", "o", "", `bundle file (default: seriallink-backup-HOST-DATE.tar.gz; "-" for standard output)`)
+	backupExportCmd.Flags().Bool("include-secrets", false, "include the TLS certificate, key and CA files, the access token key and the compliance signing key")
+
+	backupImportCmd.Flags().Bool("force", false, "replace existing files")
+	backupImportCmd.Flags().Bool("dry-run", false, "list the files that would be written without writing them")
+}
+
+func runBackupExport(cmd *cobra.Command, args []string) error {
+	output, _ := cmd.Flags().GetString("output")
+	includeSecrets, _ := cmd.Flags().GetBool("include-secrets")
+
+	configFile := viper.ConfigFileUsed()
+	if configFile == "" {
+		return errors.New("no config file found; pass --config")
+	}
+	cfg, err := GetConfig()
+	if err != nil {
+		return err
+	}
+
+	sources := []backup.Source{
+		{Role: backup.RoleConfig, Path: configFile},
+		{Role: backup.RoleReservations, Path: configRelativeDir(cfg.Reservations.File, "reservations.json"), Optional: true},
+		{Role: backup.RoleUsage, Path: configRelativeDir(cfg.Usage.File, "usage.json"), Optional: true},
+		{Role: backup.RoleTokens, Path: tokenFilePath(cfg), Optional: true},
+	}
+	if includeSecrets {
+		sources = append(sources, backup.Source{Role: backup.RoleAccessKey, Path: accessKeyPath(cfg), Optional: true})
+		for _, source := range []backup.Source{
+			{Role: backup.RoleTLSCert, Path: cfg.TLS.CertFile},
+			{Role: backup.RoleTLSKey, Path: cfg.TLS.KeyFile},
+			{Role: backup.RoleTLSCA, Path: cfg.TLS.CAFile},
+			{Role: backup.RoleComplianceKey, Path: cfg.Console.Compliance.SigningKey},
+		} {
+			if source.Path != "" {
+				sources = append(sources, source)
+			}
+		}
+	}
+
+	hostname, _ := os.Hostname()
+	now := time.Now()
+	if output == "" {
+		output = fmt.Sprintf("seriallink-backup-%s-%s.tar.gz", hostname, now.Format("20060102-150405"))
+	}
+
+	var out io.Writer = os.Stdout
+	if output != "-" {
+		file, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0o600)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	manifest, err := backup.Export(out, backup.Manifest{CreatedAt: now, Hostname: hostname, Version: Version}, sources)
+	if err != nil {
+		if output != "-" {
+			os.Remove(output)
+		}
+		return fmt.Errorf("failed to export backup: %w", err)
+	}
+
+	if output != "-" {
+		fmt.Printf("Wrote %s\n", output)
+		for _, entry := range manifest.Files {
+			fmt.Printf("  %-16s %s (%s)\n", entry.Role, entry.Path, formatBytes(entry.Size))
+		}
+	}
+	return nil
+}
+
+// restoreTarget is where an imported file goes
+type restoreTarget struct {
+	role string
+	path string
+	data []byte
+	perm os.FileMode
+}
+
+func runBackupImport(cmd *cobra.Command, args []string) error {
+	force, _ := cmd.Flags().GetBool("force")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+	var in io.Reader = os.Stdin
+	if args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		in = file
+	}
+	bundle, err := backup.Read(in)
+	if err != nil {
+		return err
+	}
+
+	entry, configData, ok := bundle.File(backup.RoleConfig)
+	if !ok {
+		return fmt.Errorf("%w: no config file", backup.ErrInvalidBundle)
+	}
+	configFile := cfgFile
+	if configFile == "" {
+		configFile = viper.ConfigFileUsed()
+	}
+	if configFile == "" {
+		configFile = config.DefaultConfigPath()
+	}
+
+	// The imported config is validated from a copy next to where it will be
+	// written, so the state files it leaves at their defaults resolve next
+	// to it too
+	if err := os.MkdirAll(filepath.Dir(configFile), 0o755); err != nil {
+		return err
+	}
+	staged, err := os.CreateTemp(filepath.Dir(configFile), ".restore-*"+filepath.Ext(entry.Path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(staged.Name())
+	if _, err := staged.Write(configData); err != nil {
+		staged.Close()
+		return err
+	}
+	if err := staged.Close(); err != nil {
+		return err
+	}
+	cfg, err := config.LoadFromFile(staged.Name())
+	if err != nil {
+		return fmt.Errorf("imported config: %w", err)
+	}
+
+	targets := []restoreTarget{{role: backup.RoleConfig, path: configFile, data: configData, perm: 0o644}}
+	for _, t := range []restoreTarget{
+		{role: backup.RoleReservations, path: configRelativeDir(cfg.Reservations.File, "reservations.json"), perm: 0o644},
+		{role: backup.RoleUsage, path: configRelativeDir(cfg.Usage.File, "usage.json"), perm: 0o644},
+		{role: backup.RoleTLSCert, path: cfg.TLS.CertFile, perm: 0o644},
+		{role: backup.RoleTLSKey, path: cfg.TLS.KeyFile, perm: 0o600},
+		{role: backup.RoleTLSCA, path: cfg.TLS.CAFile, perm: 0o644},
+		{role: backup.RoleTokens, path: tokenFilePath(cfg), perm: 0o600},
+		{role: backup.RoleAccessKey, path: accessKeyPath(cfg), perm: 0o600},
+		{role: backup.RoleComplianceKey, path: cfg.Console.Compliance.SigningKey, perm: 0o600},
+	} {
+		_, data, ok := bundle.File(t.role)
+		if !ok {
+			continue
+		}
+		if t.path == "" {
+			fmt.Fprintf(os.Stderr, "Warning: skipping %s, the imported config names no file for it\n", t.role)
+			continue
+		}
+		t.data = data
+		targets = append(targets, t)
+	}
+
+	if !force {
+		var existing []string
+		for _, t := range targets {
+			if _, err := os.Stat(t.path); err == nil {
+				existing = append(existing, t.path)
+			}
+		}
+		if len(existing) > 0 && !dryRun {
+			return fmt.Errorf("refusing to replace existing files (use --force): %s", strings.Join(existing, ", "))
+		}
+	}
+
+	manifest := bundle.Manifest
+	fmt.Printf("Backup of %s (version %s) from %s\n", manifest.Hostname, manifest.Version, manifest.CreatedAt.Local().Format(time.RFC3339))
+	for _, t := range targets {
+		if dryRun {
+			fmt.Printf("  would write %-16s %s (%s)\n", t.role, t.path, formatBytes(int64(len(t.data))))
+			continue
+		}
+		if err := backup.WriteFile(t.path, t.data, t.perm); err != nil {
+			return fmt.Errorf("failed to restore %s: %w", t.role, err)
+		}
+		fmt.Printf("  restored %-16s %s (%s)\n", t.role, t.path, formatBytes(int64(len(t.data))))
+	}
+	if !dryRun {
+		fmt.Println("Start the agent to apply the restored configuration.")
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var bridgesCmd = &cobra.Command{
+	Use:   "bridges",
+	Short: "List port bridges",
+	Long: `List the bridges forwarding data between open ports. A bridge replaces a
+null-modem "man in the middle" rig: open both ports, bridge them and the
+devices talk through the agent.
+
+Example:
+  seriallink bridges
+  seriallink bridges create ecu-tap /dev/ttyUSB0 /dev/ttyUSB1 --session-a 550e8400-... --session-b 7c9e6679-... --log
+  seriallink bridges create tap COM3 COM4 --session-a ... --session-b ... --baud-b 9600 --one-way
+  seriallink bridges stop ecu-tap --session-id 550e8400-...
+
+Interception rules change the forwarded data, to test how a device copes
+with corrupted or late traffic from its peer. Each is
+"[a>b:|b>a:]ACTION:PATTERN[=>ARG]" with a regular expression PATTERN:
+  drop:PATTERN             remove the matched bytes
+  replace:PATTERN=>DATA    replace them with DATA ($1 expands a group)
+  delay:PATTERN=>MS        hold data containing a match for MS milliseconds
+  inject:PATTERN=>DATA     insert DATA after them
+Without a>b or b>a a rule applies to both directions; escapes such as \r
+are expanded in DATA. Rules of a running bridge are replaced with "rules":
+  seriallink bridges create tap COM3 COM4 --session-a ... --session-b ... --rule 'a>b:replace:OK\r=>ERROR\r'
+  seriallink bridges rules tap --session-id ... --rule 'delay:^\$GP=>500' --rule 'b>a:drop:\x06'
+  seriallink bridges rules tap --session-id ...   # forward unchanged again`,
+	Args: cobra.NoArgs,
+	RunE: runBridges,
+}
+
+var bridgesCreateCmd = &cobra.Command{
+	Use:   "create NAME PORT_A PORT_B",
+	Short: "Forward data between two open ports",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runBridgesCreate,
+}
+
+var bridgesRulesCmd = &cobra.Command{
+	Use:   "rules NAME",
+	Short: "Replace the interception rules of a bridge",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runBridgesRules,
+}
+
+var bridgesStopCmd = &cobra.Command{
+	Use:   "stop NAME",
+	Short: "Stop a bridge, leaving its ports open",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runBridgesStop,
+}
+
+func init() {
+	rootCmd.AddCommand(bridgesCmd)
+	bridgesCmd.AddCommand(bridgesCreateCmd)
+	bridgesCmd.AddCommand(bridgesRulesCmd)
+	bridgesCmd.AddCommand(bridgesStopCmd)
+
+	bridgesCmd.Flags().Bool("json", false, "output in JSON format")
+
+	bridgesCreateCmd.Flags().String("session-a", "", "session ID of PORT_A")
+	bridgesCreateCmd.Flags().String("session-b", "", "session ID of PORT_B")
+	bridgesCreateCmd.Flags().Uint32("baud-a", 0, "set PORT_A to this baud rate first (default: keep)")
+	bridgesCreateCmd.Flags().Uint32("baud-b", 0, "set PORT_B to this baud rate first (default: keep)")
+	bridgesCreateCmd.Flags().Bool("one-way", false, "only forward from PORT_A to PORT_B")
+	bridgesCreateCmd.Flags().Bool("log", false, "write the forwarded data to the agent log")
+	bridgesCreateCmd.Flags().StringArray("rule", nil, `interception rule, "[a>b:|b>a:]ACTION:PATTERN[=>ARG]" (repeatable)`)
+
+	bridgesRulesCmd.Flags().String("session-id", "", "session ID of either bridged port")
+	bridgesRulesCmd.Flags().StringArray("rule", nil, `interception rule, "[a>b:|b>a:]ACTION:PATTERN[=>ARG]" (repeatable; none clears)`)
+
+	bridgesStopCmd.Flags().String("session-id", "", "session ID of either bridged port")
+}
+
+func runBridges(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.ListBridges(ctx, &pb.ListBridgesRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list bridges: %w", err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp.Bridges)
+	}
+
+	if len(resp.Bridges) == 0 {
+		fmt.Println("No bridges")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tA\tB\tA->B\tB->A\tRULES\tSINCE")
+	fmt.Fprintln(w, "----\t-\t-\t----\t----\t-----\t-----")
+	for _, b := range resp.Bridges {
+		bToA := formatBytes(int64(b.BytesBToA))
+		if b.OneWay {
+			bToA = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%s\n",
+			b.Name, b.PortA, b.PortB, formatBytes(int64(b.BytesAToB)), bToA, len(b.Rules),
+			time.Unix(0, b.StartedAt).Format("2006-01-02 15:04"))
+	}
+	return w.Flush()
+}
+
+func runBridgesCreate(cmd *cobra.Command, args []string) error {
+	sessionA, _ := cmd.Flags().GetString("session-a")
+	sessionB, _ := cmd.Flags().GetString("session-b")
+	baudA, _ := cmd.Flags().GetUint32("baud-a")
+	baudB, _ := cmd.Flags().GetUint32("baud-b")
+	oneWay, _ := cmd.Flags().GetBool("one-way")
+	logData, _ := cmd.Flags().GetBool("log")
+	ruleValues, _ := cmd.Flags().GetStringArray("rule")
+
+	if sessionA == "" || sessionB == "" {
+		return fmt.Errorf("--session-a and --session-b are required")
+	}
+	rules, err := parseBridgeRules(ruleValues)
+	if err != nil {
+		return err
+	}
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.BridgePorts(ctx, &pb.BridgePortsRequest{
+		Name:    args[0],
+		A:       &pb.BridgeEndpoint{PortName: args[1], SessionId: sessionA, BaudRate: baudA},
+		B:       &pb.BridgeEndpoint{PortName: args[2], SessionId: sessionB, BaudRate: baudB},
+		OneWay:  oneWay,
+		LogData: logData,
+		Rules:   rules,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to bridge ports: %w", err)
+	}
+
+	direction := "<->"
+	if resp.Bridge.OneWay {
+		direction = "->"
+	}
+	fmt.Printf("Bridged %s %s %s as %s\n", resp.Bridge.PortA, direction, resp.Bridge.PortB, resp.Bridge.Name)
+	return nil
+}
+
+func runBridgesRules(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	ruleValues, _ := cmd.Flags().GetStringArray("rule")
+	if sessionID == "" {
+		return fmt.Errorf("--session-id is required")
+	}
+	rules, err := parseBridgeRules(ruleValues)
+	if err != nil {
+		return err
+	}
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.SetBridgeRules(ctx, &pb.SetBridgeRulesRequest{
+		Name:      args[0],
+		SessionId: sessionID,
+		Rules:     rules,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set bridge rules: %w", err)
+	}
+
+	fmt.Printf("Bridge %s now has %d rule(s)\n", resp.Bridge.Name, len(resp.Bridge.Rules))
+	return nil
+}
+
+func runBridgesStop(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	if sessionID == "" {
+		return fmt.Errorf("--session-id is required")
+	}
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.StopBridge(ctx, &pb.StopBridgeRequest{
+		Name:      args[0],
+		SessionId: sessionID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stop bridge: %w", err)
+	}
+
+	b := resp.Bridge
+	fmt.Printf("Stopped bridge %s (%s forwarded %s -> %s, %s %s -> %s)\n", b.Name,
+		formatBytes(int64(b.BytesAToB)), b.PortA, b.PortB,
+		formatBytes(int64(b.BytesBToA)), b.PortB, b.PortA)
+	return nil
+}
+
+// parseBridgeRules converts --rule values, "[a>b:|b>a:]ACTION:PATTERN[=>ARG]"
+func parseBridgeRules(values []string) ([]*pb.BridgeRule, error) {
+	rules := make([]*pb.BridgeRule, 0, len(values))
+	for _, value := range values {
+		rule := &pb.BridgeRule{}
+		spec := value
+		if rest, ok := strings.CutPrefix(spec, "a>b:"); ok {
+			rule.Direction, spec = pb.BridgeDirection_BRIDGE_DIRECTION_A_TO_B, rest
+		} else if rest, ok := strings.CutPrefix(spec, "b>a:"); ok {
+			rule.Direction, spec = pb.BridgeDirection_BRIDGE_DIRECTION_B_TO_A, rest
+		}
+
+		action, spec, ok := strings.Cut(spec, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid --rule %q: ACTION:PATTERN expected", value)
+		}
+		pattern, arg, hasArg := strings.Cut(spec, "=>")
+		rule.Pattern = pattern
+
+		switch action {
+		case "drop":
+			rule.Action = pb.BridgeRuleAction_BRIDGE_RULE_ACTION_DROP
+		case "replace":
+			rule.Action = pb.BridgeRuleAction_BRIDGE_RULE_ACTION_REPLACE
+		case "inject":
+			rule.Action = pb.BridgeRuleAction_BRIDGE_RULE_ACTION_INJECT
+		case "delay":
+			rule.Action = pb.BridgeRuleAction_BRIDGE_RULE_ACTION_DELAY
+			ms, err := strconv.ParseUint(arg, 10, 32)
+			if !hasArg || err != nil {
+				return nil, fmt.Errorf("invalid --rule %q: delay:PATTERN=>MS expected", value)
+			}
+			rule.DelayMs = uint32(ms)
+		default:
+			return nil, fmt.Errorf("invalid --rule %q: unknown action %q (drop, replace, delay, inject)", value, action)
+		}
+		if action == "replace" || action == "inject" {
+			data, err := unescapeArg(arg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --rule %q: %w", value, err)
+			}
+			rule.Data = []byte(data)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var busCmd = &cobra.Command{
+	Use:   "bus [flags]",
+	Short: "List and use I2C/SPI bus devices",
+	Long: `List the I2C and SPI devices exposed by the agent's bus providers, or run
+transfers on them with the i2c and spi subcommands.
+
+Providers are enabled under bus.providers in the agent configuration.
+
+Example:
+  seriallink bus                                     # List bus devices
+  seriallink bus --type i2c                          # List I2C buses only
+  seriallink bus i2c linux:i2c-1 0x50 00 --read 16   # Read 16 bytes from an EEPROM
+  seriallink bus spi linux:spidev0.0 9f000000        # Read a flash JEDEC ID`,
+	Args: cobra.NoArgs,
+	RunE: runBus,
+}
+
+var busI2CCmd = &cobra.Command{
+	Use:   "i2c DEVICE ADDRESS [HEX] [flags]",
+	Short: "Write to and/or read from an I2C target",
+	Long: `Write HEX to the target at ADDRESS, then read --read bytes in the same
+transaction (repeated start).`,
+	Args: cobra.RangeArgs(2, 3),
+	RunE: runBusI2C,
+}
+
+var busSPICmd = &cobra.Command{
+	Use:   "spi DEVICE HEX [flags]",
+	Short: "Run a full-duplex SPI transfer",
+	Long:  `Clock HEX out on the bus and print the bytes received at the same time.`,
+	Args:  cobra.ExactArgs(2),
+	RunE:  runBusSPI,
+}
+
+func init() {
+	rootCmd.AddCommand(busCmd)
+	busCmd.AddCommand(busI2CCmd, busSPICmd)
+
+	busCmd.Flags().String("type", "", "only list devices of this bus type (i2c, spi)")
+
+	busI2CCmd.Flags().Uint32("read", 0, "bytes to read after writing")
+
+	busSPICmd.Flags().Uint32("mode", 0, "SPI mode (0-3)")
+	busSPICmd.Flags().Uint32("speed", 0, "clock speed in Hz (0 for the device default)")
+	busSPICmd.Flags().Uint32("bits", 0, "bits per word (0 for the device default)")
+}
+
+func runBus(cmd *cobra.Command, args []string) error {
+	busType, _ := cmd.Flags().GetString("type")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.ListBusDevices(ctx, &pb.ListBusDevicesRequest{BusType: busType})
+	if err != nil {
+		return fmt.Errorf("failed to list bus devices: %w", err)
+	}
+
+	if len(resp.Devices) == 0 {
+		fmt.Println("No bus devices found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DEVICE\tTYPE\tDESCRIPTION")
+	fmt.Fprintln(w, "------\t----\t-----------")
+	for _, d := range resp.Devices {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", d.Name, d.BusType, d.Description)
+	}
+	return w.Flush()
+}
+
+func runBusI2C(cmd *cobra.Command, args []string) error {
+	readLen, _ := cmd.Flags().GetUint32("read")
+
+	addr, err := strconv.ParseUint(args[1], 0, 16)
+	if err != nil {
+		return fmt.Errorf("invalid I2C address %q: %w", args[1], err)
+	}
+
+	var write []byte
+	if len(args) == 3 {
+		write, err = hex.DecodeString(args[2])
+		if err != nil {
+			return fmt.Errorf("failed to parse hex data: %w", err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.I2CTransfer(ctx, &pb.I2CTransferRequest{
+		Device:     args[0],
+		Address:    uint32(addr),
+		Write:      write,
+		ReadLength: readLen,
+	})
+	if err != nil {
+		return fmt.Errorf("I2C transfer failed: %w", err)
+	}
+
+	printBusData(resp.Data)
+	if IsVerbose() {
+		fmt.Printf("Wrote %d bytes, read %d bytes\n", len(write), len(resp.Data))
+	}
+	return nil
+}
+
+func runBusSPI(cmd *cobra.Command, args []string) error {
+	mode, _ := cmd.Flags().GetUint32("mode")
+	speed, _ := cmd.Flags().GetUint32("speed")
+	bits, _ := cmd.Flags().GetUint32("bits")
+
+	data, err := hex.DecodeString(args[1])
+	if err != nil {
+		return fmt.Errorf("failed to parse hex data: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.SPITransfer(ctx, &pb.SPITransferRequest{
+		Device:      args[0],
+		Data:        data,
+		Mode:        mode,
+		SpeedHz:     speed,
+		BitsPerWord: bits,
+	})
+	if err != nil {
+		return fmt.Errorf("SPI transfer failed: %w", err)
+	}
+
+	printBusData(resp.Data)
+	return nil
+}
+
+// printBusData prints received bytes as hex, 16 per line
+func printBusData(data []byte) {
+	for i, b := range data {
+		if i > 0 && i%16 == 0 {
+			fmt.Println()
+		}
+		fmt.Printf("%02x ", b)
+	}
+	if len(data) > 0 {
+		fmt.Println()
+	}
+}
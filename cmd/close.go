@@ -23,18 +23,23 @@ import (
 
 	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 var closeCmd = &cobra.Command{
-	Use:   "close PORT [flags]",
+	Use:   "close [PORT] [flags]",
 	Short: "Close a serial port",
-	Long: `Close an open serial port.
+	Long: `Close an open serial port, by name, by session ID or both.
+
+Without a session ID only the client that opened the port may close it.
+With --force, an administrator (auth.admins) closes a port whatever
+session holds it; --notify first sends the reason to the port's event
+stream and --grace gives the session time to finish.
 
 Example:
-  seriallink close COM1                    # Close port by name`,
-	Args: cobra.ExactArgs(1),
+  seriallink close COM1                    # Close port by name
+  seriallink close --session-id 550e8400-e29b-41d4-a716-446655440000
+  seriallink close COM1 --force --notify --reason "rebooting the rig" --grace 10s`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: runClose,
 }
 
@@ -42,23 +47,34 @@ func init() {
 	rootCmd.AddCommand(closeCmd)
 
 	closeCmd.Flags().String("session-id", "", "session ID (required if not the opener)")
+	closeCmd.Flags().Bool("force", false, "close the port whatever session holds it (administrators only)")
+	closeCmd.Flags().Bool("notify", false, "with --force, send the reason to the session's client first")
+	closeCmd.Flags().String("reason", "", "with --force, why the port is closed")
+	closeCmd.Flags().Duration("grace", 0, "with --notify, time the session gets to finish before it is closed")
 }
 
 func runClose(cmd *cobra.Command, args []string) error {
-	portName := args[0]
+	var portName string
+	if len(args) > 0 {
+		portName = args[0]
+	}
 	sessionID, _ := cmd.Flags().GetString("session-id")
+	force, _ := cmd.Flags().GetBool("force")
+	if force {
+		return runForceClose(cmd, portName, sessionID)
+	}
+	if portName == "" && sessionID == "" {
+		return fmt.Errorf("a port name or --session-id is required")
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	addr := GetAddress()
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	client, err := dialService()
 	if err != nil {
-		return fmt.Errorf("failed to connect to service at %s: %w", addr, err)
+		return err
 	}
-	defer conn.Close()
-
-	client := pb.NewSerialServiceClient(conn)
+	defer client.Close()
 
 	resp, err := client.ClosePort(ctx, &pb.ClosePortRequest{
 		PortName:  portName,
@@ -72,11 +88,55 @@ func runClose(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to close port: %s", resp.Message)
 	}
 
+	closed := portName
+	if closed == "" {
+		closed = "session " + sessionID
+	}
 	if IsVerbose() {
-		fmt.Printf("Successfully closed %s\n", portName)
+		fmt.Printf("Successfully closed %s\n", closed)
 	} else {
-		fmt.Printf("Closed %s\n", portName)
+		fmt.Printf("Closed %s\n", closed)
+	}
+
+	return nil
+}
+
+func runForceClose(cmd *cobra.Command, portName, sessionID string) error {
+	notify, _ := cmd.Flags().GetBool("notify")
+	reason, _ := cmd.Flags().GetString("reason")
+	grace, _ := cmd.Flags().GetDuration("grace")
+	if portName == "" || sessionID != "" {
+		return fmt.Errorf("--force takes a port name and no --session-id")
+	}
+	if grace < 0 {
+		return fmt.Errorf("--grace must not be negative")
+	}
+	if grace > 0 && !notify {
+		return fmt.Errorf("--grace requires --notify")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second+grace)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.ForceClose(ctx, &pb.ForceCloseRequest{
+		PortName: portName,
+		Reason:   reason,
+		Notify:   notify,
+		GraceMs:  uint32(grace.Milliseconds()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to force-close port: %w", err)
+	}
+	if !resp.Success {
+		return fmt.Errorf("failed to force-close port: %s", resp.Message)
 	}
 
+	fmt.Printf("Closed %s (session %s of client %q): %s\n", portName, resp.SessionId, resp.ClientId, resp.Message)
 	return nil
 }
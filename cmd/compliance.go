@@ -0,0 +1,151 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/Shoaibashk/SerialLink/internal/chain"
+	"github.com/spf13/cobra"
+)
+
+var complianceCmd = &cobra.Command{
+	Use:   "compliance",
+	Short: "Manage compliance-mode console logs",
+	Long: `Create signing keys for, and verify, console logs written in compliance
+mode (console.compliance in the agent config). In compliance mode every
+logged line is chained to the one before it by a hash, and the chain is
+signed at regular checkpoints, so that a recorded device conversation can
+later be shown to be unaltered.
+
+Example:
+  seriallink compliance keygen --out /etc/seriallink/console.key
+  seriallink compliance verify --public-key console.key.pub console.log.2 console.log.1 console.log`,
+}
+
+var complianceKeygenCmd = &cobra.Command{
+	Use:   "keygen",
+	Short: "Create an Ed25519 key pair for signing checkpoints",
+	Long: `Create an Ed25519 key pair. The private key is written to --out, readable
+only by its owner, and the public key next to it with a .pub suffix.`,
+	Args: cobra.NoArgs,
+	RunE: runComplianceKeygen,
+}
+
+var complianceVerifyCmd = &cobra.Command{
+	Use:   "verify FILE...",
+	Short: "Verify compliance-mode console logs",
+	Long: `Verify the hash chain and checkpoint signatures of console logs. List
+rotated files oldest first, so that each is also checked to continue where
+the one before it ends.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runComplianceVerify,
+}
+
+func init() {
+	rootCmd.AddCommand(complianceCmd)
+	complianceCmd.AddCommand(complianceKeygenCmd)
+	complianceCmd.AddCommand(complianceVerifyCmd)
+
+	complianceKeygenCmd.Flags().String("out", "", "private key file to write")
+	complianceKeygenCmd.Flags().Bool("force", false, "overwrite existing key files")
+
+	complianceVerifyCmd.Flags().String("public-key", "", "public key file (a private key file works too)")
+}
+
+func runComplianceKeygen(cmd *cobra.Command, args []string) error {
+	out, _ := cmd.Flags().GetString("out")
+	force, _ := cmd.Flags().GetBool("force")
+	if out == "" {
+		return fmt.Errorf("--out is required")
+	}
+
+	private, public, err := chain.GenerateKey()
+	if err != nil {
+		return err
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	if force {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+	if err := writeKeyFile(out, private, flags, 0o600); err != nil {
+		return err
+	}
+	if err := writeKeyFile(out+".pub", public, flags, 0o644); err != nil {
+		return err
+	}
+
+	fmt.Printf("Private key: %s\n", out)
+	fmt.Printf("Public key:  %s.pub\n", out)
+	return nil
+}
+
+// writeKeyFile writes a key file with the given open flags and mode
+func writeKeyFile(path string, data []byte, flags int, mode os.FileMode) error {
+	file, err := os.OpenFile(path, flags, mode)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("%s already exists (use --force to overwrite)", path)
+		}
+		return fmt.Errorf("failed to write key: %w", err)
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write key: %w", err)
+	}
+	return file.Close()
+}
+
+func runComplianceVerify(cmd *cobra.Command, args []string) error {
+	keyPath, _ := cmd.Flags().GetString("public-key")
+	if keyPath == "" {
+		return fmt.Errorf("--public-key is required")
+	}
+	key, err := chain.LoadPublicKey(keyPath)
+	if err != nil {
+		return err
+	}
+
+	prev := ""
+	for _, path := range args {
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed to open log: %w", err)
+		}
+		result, err := chain.Verify(file, key, prev)
+		file.Close()
+		if err != nil {
+			fmt.Printf("%s: FAILED\n", path)
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		if result.Records == 0 {
+			fmt.Printf("%s: OK, empty\n", path)
+			continue
+		}
+		fmt.Printf("%s: OK, %d records (seq %d-%d), %d checkpoints\n",
+			path, result.Records, result.FirstSeq, result.LastSeq, result.Checkpoints)
+		if result.Unsigned > 0 {
+			fmt.Printf("  warning: the last %d records follow the last checkpoint and are not signed\n", result.Unsigned)
+		}
+		prev = result.Head
+	}
+	return nil
+}
@@ -24,8 +24,6 @@ import (
 
 	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 var configCmd = &cobra.Command{
@@ -38,7 +36,8 @@ This command allows you to view current configuration or apply new configuration
 Example:
   seriallink config COM1                              # View current configuration
   seriallink config COM1 --baud 115200                # Change baud rate
-  seriallink config COM1 --parity even --data-bits 7  # Change multiple settings`,
+  seriallink config COM1 --parity even --data-bits 7  # Change multiple settings
+  seriallink config COM1 --latency-profile low        # Tune for request/response loops`,
 	Args: cobra.ExactArgs(1),
 	RunE: runConfig,
 }
@@ -55,6 +54,7 @@ func init() {
 	configCmd.Flags().String("stop-bits", "", "stop bits (1, 1.5, 2)")
 	configCmd.Flags().String("parity", "", "parity (none, odd, even, mark, space)")
 	configCmd.Flags().String("flow-control", "", "flow control (none, hardware, software)")
+	configCmd.Flags().String("latency-profile", "", "latency profile (default, low)")
 }
 
 func runConfig(cmd *cobra.Command, args []string) error {
@@ -68,22 +68,20 @@ func runConfig(cmd *cobra.Command, args []string) error {
 	stopBits, _ := cmd.Flags().GetString("stop-bits")
 	parity, _ := cmd.Flags().GetString("parity")
 	flowControl, _ := cmd.Flags().GetString("flow-control")
+	latencyProfile, _ := cmd.Flags().GetString("latency-profile")
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	addr := GetAddress()
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	client, err := dialService()
 	if err != nil {
-		return fmt.Errorf("failed to connect to service at %s: %w", addr, err)
+		return err
 	}
-	defer conn.Close()
-
-	client := pb.NewSerialServiceClient(conn)
+	defer client.Close()
 
 	// If configuration flags are provided, apply them
-	if baud > 0 || dataBits != "" || stopBits != "" || parity != "" || flowControl != "" {
-		return applyConfig(client, ctx, portName, sessionID, baud, dataBits, stopBits, parity, flowControl)
+	if baud > 0 || dataBits != "" || stopBits != "" || parity != "" || flowControl != "" || latencyProfile != "" {
+		return applyConfig(client, ctx, portName, sessionID, baud, dataBits, stopBits, parity, flowControl, latencyProfile)
 	}
 
 	// Otherwise, just get the current configuration
@@ -101,7 +99,7 @@ func runConfig(cmd *cobra.Command, args []string) error {
 	return printConfigTable(resp.Config)
 }
 
-func applyConfig(client pb.SerialServiceClient, ctx context.Context, portName, sessionID string, baud uint32, dataBits, stopBits, parity, flowControl string) error {
+func applyConfig(client pb.SerialServiceClient, ctx context.Context, portName, sessionID string, baud uint32, dataBits, stopBits, parity, flowControl, latencyProfile string) error {
 	// Start with current config
 	currentResp, err := client.GetPortConfig(ctx, &pb.GetPortConfigRequest{
 		PortName: portName,
@@ -128,6 +126,9 @@ func applyConfig(client pb.SerialServiceClient, ctx context.Context, portName, s
 	if flowControl != "" {
 		config.FlowControl = parseFlowControl(flowControl)
 	}
+	if latencyProfile != "" {
+		config.LatencyProfile = parseLatencyProfile(latencyProfile)
+	}
 
 	// Apply configuration
 	resp, err := client.ConfigurePort(ctx, &pb.ConfigurePortRequest{
@@ -150,6 +151,7 @@ func applyConfig(client pb.SerialServiceClient, ctx context.Context, portName, s
 		fmt.Printf("  Stop Bits:      %s\n", getStopBitsString(config.StopBits))
 		fmt.Printf("  Parity:         %s\n", getParityString(config.Parity))
 		fmt.Printf("  Flow Control:   %s\n", getFlowControlString(config.FlowControl))
+		fmt.Printf("  Latency:        %s\n", getLatencyProfileString(config.LatencyProfile))
 	} else {
 		fmt.Printf("Configured %s\n", portName)
 	}
@@ -164,6 +166,8 @@ func printConfigTable(config *pb.PortConfig) error {
 	fmt.Printf("  Stop Bits:      %s\n", getStopBitsString(config.StopBits))
 	fmt.Printf("  Parity:         %s\n", getParityString(config.Parity))
 	fmt.Printf("  Flow Control:   %s\n", getFlowControlString(config.FlowControl))
+	fmt.Printf("  Latency:        %s\n", getLatencyProfileString(config.LatencyProfile))
+	fmt.Printf("  DTR/RTS:        %s/%s on open\n", getLineStateString(config.Dtr), getLineStateString(config.Rts))
 	if config.ReadTimeoutMs > 0 {
 		fmt.Printf("  Read Timeout:   %d ms\n", config.ReadTimeoutMs)
 	}
@@ -0,0 +1,75 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var debugCmd = &cobra.Command{
+	Use:   "debug on|off",
+	Short: "Start or stop the agent's debug endpoints",
+	Long: `Start or stop the debug listener serving pprof profiles, expvar variables
+and goroutine and session dumps. The agent must allow this with
+debug.allow_toggle and list you in auth.admins.
+
+Example:
+  seriallink debug on                       # Start the listener
+  go tool pprof http://127.0.0.1:6060/debug/pprof/heap
+  curl http://127.0.0.1:6060/debug/sessions
+  seriallink debug off                      # Stop it again`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: []string{"on", "off"},
+	RunE:      runDebug,
+}
+
+func init() {
+	rootCmd.AddCommand(debugCmd)
+}
+
+func runDebug(cmd *cobra.Command, args []string) error {
+	var enabled bool
+	switch args[0] {
+	case "on":
+		enabled = true
+	case "off":
+	default:
+		return fmt.Errorf("invalid argument %q (use on or off)", args[0])
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.SetDebugEndpoints(ctx, &pb.SetDebugEndpointsRequest{Enabled: enabled})
+	if err != nil {
+		return fmt.Errorf("failed to toggle debug endpoints: %w", err)
+	}
+
+	fmt.Println(resp.Message)
+	return nil
+}
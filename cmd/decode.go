@@ -0,0 +1,165 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+// frameDecoders maps the --decoder names to decoders
+var frameDecoders = map[string]pb.FrameDecoder{
+	"modbus_rtu": pb.FrameDecoder_FRAME_DECODER_MODBUS_RTU,
+	"modbus":     pb.FrameDecoder_FRAME_DECODER_MODBUS_RTU,
+	"nmea":       pb.FrameDecoder_FRAME_DECODER_NMEA,
+	"at":         pb.FrameDecoder_FRAME_DECODER_AT,
+	"custom":     pb.FrameDecoder_FRAME_DECODER_CUSTOM,
+	"megatec":    pb.FrameDecoder_FRAME_DECODER_MEGATEC,
+}
+
+var decodeCmd = &cobra.Command{
+	Use:   "decode PORT --decoder NAME [flags]",
+	Short: "Print the frames of a protocol received on a port",
+	Long: `Decode the data received on an open port and print one line per frame,
+with a description of its contents, until interrupted.
+
+Decoders:
+  modbus_rtu   Modbus RTU frames, ended by a silence (--gap, default 3.5
+               characters at the port's baud rate)
+  nmea         NMEA 0183 sentences from GPS receivers
+  at           AT commands and modem responses
+  megatec      Megatec (Q1) UPS commands and status responses
+  custom       frames ended by --terminator, decoded with the groups of
+               the regular expression --pattern
+
+Frames marked with ! are malformed, e.g. their checksum does not match.
+
+Example:
+  seriallink decode /dev/ttyUSB0 --decoder nmea --session-id ID
+  seriallink decode COM3 --decoder modbus_rtu --fields --raw --session-id ID
+  seriallink decode COM3 --decoder custom --pattern 'T=(?P<temp>[\d.]+)' --terminator '\r\n' --session-id ID`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDecode,
+}
+
+func init() {
+	rootCmd.AddCommand(decodeCmd)
+
+	decodeCmd.Flags().String("session-id", "", "session ID")
+	decodeCmd.Flags().String("decoder", "", "decoder: modbus_rtu, nmea, at, megatec or custom")
+	decodeCmd.Flags().String("pattern", "", "regular expression decoding custom frames")
+	decodeCmd.Flags().String("terminator", "", "bytes that end a custom frame, with escapes such as \\r\\n (default \\n)")
+	decodeCmd.Flags().Uint32("gap", 0, "end a frame after this many milliseconds without input")
+	decodeCmd.Flags().Bool("fields", false, "print the decoded fields below each frame")
+	decodeCmd.Flags().Bool("raw", false, "print the raw bytes of each frame in hex")
+	decodeCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+func runDecode(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	decoderName, _ := cmd.Flags().GetString("decoder")
+	pattern, _ := cmd.Flags().GetString("pattern")
+	terminator, _ := cmd.Flags().GetString("terminator")
+	gap, _ := cmd.Flags().GetUint32("gap")
+	showFields, _ := cmd.Flags().GetBool("fields")
+	showRaw, _ := cmd.Flags().GetBool("raw")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	decoder, ok := frameDecoders[strings.ToLower(decoderName)]
+	if !ok {
+		return fmt.Errorf("--decoder must be one of modbus_rtu, nmea, at or custom")
+	}
+	terminator, err := unescapeArg(terminator)
+	if err != nil {
+		return fmt.Errorf("invalid --terminator: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	stream, err := client.StreamAnnotated(ctx, &pb.StreamAnnotatedRequest{
+		PortName:   args[0],
+		SessionId:  sessionID,
+		Decoder:    decoder,
+		Pattern:    pattern,
+		Terminator: []byte(terminator),
+		GapMs:      gap,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stream frames: %w", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("frame stream failed: %w", err)
+		}
+
+		frame := resp.Frame
+		receivedAt := time.Unix(0, frame.Timestamp)
+		if jsonOutput {
+			fields := make(map[string]string, len(frame.Fields))
+			for _, field := range frame.Fields {
+				fields[field.Name] = field.Value
+			}
+			_ = encoder.Encode(map[string]interface{}{
+				"raw":       fmt.Sprintf("%x", frame.Raw),
+				"timestamp": receivedAt.Format(time.RFC3339Nano),
+				"sequence":  frame.Sequence,
+				"summary":   frame.Summary,
+				"fields":    fields,
+				"valid":     frame.Valid,
+				"error":     frame.Error,
+			})
+			continue
+		}
+
+		mark := " "
+		if !frame.Valid {
+			mark = "!"
+		}
+		fmt.Printf("%s %s %s\n", receivedAt.Format("15:04:05.000"), mark, frame.Summary)
+		if showRaw {
+			fmt.Printf("    % x\n", frame.Raw)
+		}
+		if showFields {
+			for _, field := range frame.Fields {
+				fmt.Printf("    %-12s %s\n", field.Name+":", field.Value)
+			}
+		}
+	}
+}
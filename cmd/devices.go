@@ -0,0 +1,138 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/Shoaibashk/SerialLink/client"
+	"github.com/spf13/cobra"
+)
+
+var devicesCmd = &cobra.Command{
+	Use:   "devices [DEVICE...]",
+	Short: "Show the state of the agent's tracked devices",
+	Long: `Show the state (UNKNOWN, INITIALIZING, READY, ERROR, UPDATING) of the
+devices configured under devices.
+
+Without arguments every device is shown.
+
+Example:
+  seriallink devices
+  seriallink devices plc-1
+  seriallink devices --watch          # Print every state change
+  seriallink devices --watch --json   # One JSON object per change`,
+	RunE: runDevices,
+}
+
+func init() {
+	rootCmd.AddCommand(devicesCmd)
+
+	devicesCmd.Flags().BoolP("watch", "w", false, "print state changes as they happen")
+	devicesCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+func runDevices(cmd *cobra.Command, args []string) error {
+	watch, _ := cmd.Flags().GetBool("watch")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if watch {
+		return watchDevices(client, args, jsonOutput)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.ListDeviceStates(ctx, &pb.ListDeviceStatesRequest{Devices: args})
+	if err != nil {
+		return fmt.Errorf("failed to list device states: %w", err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp.States)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DEVICE\tPORT\tSTATE\tSINCE\tREASON")
+	fmt.Fprintln(w, "------\t----\t-----\t-----\t------")
+	for _, st := range resp.States {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			st.Device, st.PortName, st.State,
+			time.Unix(0, st.Since).Format("2006-01-02 15:04:05"),
+			st.Reason)
+	}
+	return w.Flush()
+}
+
+// watchDevices prints the current states, then every change until interrupted
+func watchDevices(c *client.Client, devices []string, jsonOutput bool) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	stream, err := c.StreamDeviceStates(ctx, &pb.StreamDeviceStatesRequest{Devices: devices})
+	if err != nil {
+		return fmt.Errorf("failed to stream device states: %w", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("device state stream failed: %w", err)
+		}
+
+		st := resp.State
+		since := time.Unix(0, st.Since)
+		if jsonOutput {
+			_ = encoder.Encode(map[string]interface{}{
+				"device":         st.Device,
+				"port":           st.PortName,
+				"state":          st.State,
+				"previous_state": st.PreviousState,
+				"reason":         st.Reason,
+				"since":          since.Format(time.RFC3339Nano),
+			})
+			continue
+		}
+
+		if st.PreviousState == "" {
+			fmt.Printf("%s  %s  %s  (%s)\n", since.Format("15:04:05.000"), st.Device, st.State, st.Reason)
+		} else {
+			fmt.Printf("%s  %s  %s -> %s  (%s)\n", since.Format("15:04:05.000"), st.Device, st.PreviousState, st.State, st.Reason)
+		}
+	}
+}
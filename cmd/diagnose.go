@@ -0,0 +1,146 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var diagnoseCmd = &cobra.Command{
+	Use:   "diagnose PORT [flags]",
+	Short: "Find the line settings of an unknown device",
+	Long: `Cycle an open port through common baud rates, data bits and parity
+settings, score how readable the output is for each, and list the most
+likely settings first. The device must be printing while the sweep runs;
+use --probe to send something that makes it respond.
+
+The port's configuration is restored afterwards.
+
+Example:
+  seriallink diagnose COM3 --session-id <id>
+  seriallink diagnose /dev/ttyUSB0 --session-id <id> --probe "\r\n"
+  seriallink diagnose COM3 --session-id <id> --baud 9600,115200 --sample 1000`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDiagnose,
+}
+
+func init() {
+	rootCmd.AddCommand(diagnoseCmd)
+
+	diagnoseCmd.Flags().String("session-id", "", "session ID")
+	diagnoseCmd.Flags().UintSlice("baud", nil, "baud rates to try (default: common rates)")
+	diagnoseCmd.Flags().Uint32("sample", 500, "sampling time per setting in milliseconds")
+	diagnoseCmd.Flags().String("probe", "", "data to send after each change (escape sequences allowed)")
+	diagnoseCmd.Flags().Int("top", 5, "number of candidates to show (0 for all)")
+}
+
+func runDiagnose(cmd *cobra.Command, args []string) error {
+	portName := args[0]
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	bauds, _ := cmd.Flags().GetUintSlice("baud")
+	sampleMs, _ := cmd.Flags().GetUint32("sample")
+	probe, _ := cmd.Flags().GetString("probe")
+	top, _ := cmd.Flags().GetInt("top")
+
+	if probe != "" {
+		unquoted, err := strconv.Unquote(`"` + probe + `"`)
+		if err != nil {
+			return fmt.Errorf("invalid probe: %w", err)
+		}
+		probe = unquoted
+	}
+
+	req := &pb.DiagnoseLineRequest{
+		PortName:  portName,
+		SessionId: sessionID,
+		SampleMs:  sampleMs,
+		Probe:     []byte(probe),
+	}
+	for _, baud := range bauds {
+		req.BaudRates = append(req.BaudRates, uint32(baud))
+	}
+
+	// Every baud rate is sampled for five framings
+	rates := len(bauds)
+	if rates == 0 {
+		rates = 11
+	}
+	timeout := time.Duration(rates*5)*time.Duration(sampleMs)*time.Millisecond + 30*time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.DiagnoseLine(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to diagnose line: %w", err)
+	}
+
+	candidates := resp.Candidates
+	if top > 0 && len(candidates) > top {
+		candidates = candidates[:top]
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "RANK\tBAUD\tFRAMING\tSCORE\tBYTES\tSAMPLE")
+	fmt.Fprintln(w, "----\t----\t-------\t-----\t-----\t------")
+	for i, c := range candidates {
+		fmt.Fprintf(w, "%d\t%d\t%s\t%.0f%%\t%d\t%s\n",
+			i+1,
+			c.Config.BaudRate,
+			framing(c.Config),
+			c.Score*100,
+			c.BytesReceived,
+			truncate(strconv.QuoteToASCII(string(c.Sample)), 40),
+		)
+	}
+	return w.Flush()
+}
+
+// framing formats data bits, parity and stop bits, e.g. "8N1"
+func framing(cfg *pb.PortConfig) string {
+	parity := map[pb.Parity]string{
+		pb.Parity_PARITY_NONE:  "N",
+		pb.Parity_PARITY_ODD:   "O",
+		pb.Parity_PARITY_EVEN:  "E",
+		pb.Parity_PARITY_MARK:  "M",
+		pb.Parity_PARITY_SPACE: "S",
+	}[cfg.Parity]
+
+	stop := "1"
+	switch cfg.StopBits {
+	case pb.StopBits_STOP_BITS_1_5:
+		stop = "1.5"
+	case pb.StopBits_STOP_BITS_2:
+		stop = "2"
+	}
+
+	return fmt.Sprintf("%d%s%s", cfg.DataBits, parity, stop)
+}
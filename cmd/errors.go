@@ -0,0 +1,88 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var errorsCmd = &cobra.Command{
+	Use:   "errors PORT [flags]",
+	Short: "Show recent errors of a port session",
+	Long: `Show the latest failed reads and writes of a port's session, oldest
+first, with the operation and whether the error was temporary (outlasted
+the agent's retries) or fatal.
+
+Example:
+  seriallink errors COM1              # All kept errors of the current session
+  seriallink errors COM1 --limit 5    # The last 5
+  seriallink errors COM1 --json       # Output as JSON`,
+	Args: cobra.ExactArgs(1),
+	RunE: runErrors,
+}
+
+func init() {
+	rootCmd.AddCommand(errorsCmd)
+
+	errorsCmd.Flags().String("session-id", "", "session ID (default: the port's current session)")
+	errorsCmd.Flags().Uint32("limit", 0, "maximum errors to show (0 for all kept)")
+	errorsCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+func runErrors(cmd *cobra.Command, args []string) error {
+	portName := args[0]
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	limit, _ := cmd.Flags().GetUint32("limit")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.GetRecentErrors(ctx, &pb.GetRecentErrorsRequest{
+		PortName:  portName,
+		SessionId: sessionID,
+		Limit:     limit,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get recent errors: %w", err)
+	}
+
+	if jsonOutput {
+		return printInfoJSON(resp)
+	}
+
+	if len(resp.Errors) == 0 {
+		fmt.Printf("No errors on %s (session %s)\n", resp.PortName, resp.SessionId)
+		return nil
+	}
+	for _, record := range resp.Errors {
+		at := time.Unix(0, record.Timestamp).Format("2006-01-02 15:04:05.000")
+		fmt.Printf("%s  %-15s  %-9s  %s\n", at, record.Operation, record.ErrorClass, record.Message)
+	}
+	return nil
+}
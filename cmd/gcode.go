@@ -0,0 +1,647 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/Shoaibashk/SerialLink/client"
+	"github.com/spf13/cobra"
+)
+
+var gcodeCmd = &cobra.Command{
+	Use:   "gcode",
+	Short: "Run G-code jobs on 3D printers and CNC machines",
+	Long: `Send G-code programs to printers and CNC controllers running Marlin or
+GRBL firmware. The agent streams the program with the flow control the
+firmware expects and keeps going if the client disconnects:
+
+  marlin  lines are numbered and checksummed and sent one at a time, each
+          waiting for "ok"; lines the printer asks for again are resent
+  grbl    lines are sent as long as they fit the controller's receive
+          buffer and acknowledged with "ok" or "error:N"
+
+Comments, blank lines and existing line numbers are stripped before
+sending. A job fails on an error the firmware reports, a reset or when the
+machine stays silent for --timeout while lines await acknowledgement. No
+one else may read the port while a job runs.
+
+"connect" keeps a port in G-code mode between jobs, so the agent polls the
+machine's position, state and temperatures ("watch") and it can be jogged
+and held. "hold" stops GRBL at once and pauses the job; Marlin has no feed
+hold, so its job is paused and the moves it has buffered are carried out.
+"reset" soft-resets GRBL or stops Marlin with M112, cancelling the job.
+
+Example:
+  seriallink gcode send /dev/ttyACM0 benchy.gcode --session-id <id>
+  seriallink gcode send /dev/ttyUSB0 part.nc --session-id <id> --dialect grbl --detach
+  seriallink gcode status /dev/ttyUSB0 --follow
+  seriallink gcode pause /dev/ttyACM0 --session-id <id>
+  seriallink gcode resume /dev/ttyACM0 --session-id <id>
+  seriallink gcode cancel /dev/ttyACM0 --session-id <id>
+  seriallink gcode connect /dev/ttyUSB0 --session-id <id> --dialect grbl
+  seriallink gcode watch /dev/ttyUSB0
+  seriallink gcode jog /dev/ttyUSB0 --session-id <id> --x 10 --feed 1000
+  seriallink gcode hold /dev/ttyUSB0 --session-id <id>
+  seriallink gcode cycle-start /dev/ttyUSB0 --session-id <id>
+  seriallink gcode reset /dev/ttyUSB0 --session-id <id>
+  seriallink gcode disconnect /dev/ttyUSB0 --session-id <id>`,
+	Args: cobra.NoArgs,
+}
+
+var gcodeSendCmd = &cobra.Command{
+	Use:   "send PORT FILE",
+	Short: "Start a G-code job and follow its progress",
+	Long: `Start sending a G-code file to an open port and follow the progress until
+the job ends. Ctrl-C stops following; the job goes on (see "gcode cancel").
+FILE "-" reads standard input.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runGcodeSend,
+}
+
+var gcodeStatusCmd = &cobra.Command{
+	Use:   "status PORT",
+	Short: "Show the progress of the G-code job of a port",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGcodeStatus,
+}
+
+var gcodePauseCmd = &cobra.Command{
+	Use:   "pause PORT",
+	Short: "Stop sending lines; those already sent are still carried out",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGcodeControl(pb.GcodeJobAction_GCODE_JOB_ACTION_PAUSE),
+}
+
+var gcodeResumeCmd = &cobra.Command{
+	Use:   "resume PORT",
+	Short: "Continue a paused G-code job",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGcodeControl(pb.GcodeJobAction_GCODE_JOB_ACTION_RESUME),
+}
+
+var gcodeCancelCmd = &cobra.Command{
+	Use:   "cancel PORT",
+	Short: "Cancel a G-code job",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGcodeControl(pb.GcodeJobAction_GCODE_JOB_ACTION_CANCEL),
+}
+
+var gcodeConnectCmd = &cobra.Command{
+	Use:   "connect PORT",
+	Short: "Keep a port in G-code mode to poll, jog and hold its machine",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGcodeConnect,
+}
+
+var gcodeDisconnectCmd = &cobra.Command{
+	Use:   "disconnect PORT",
+	Short: "Take a port out of G-code mode, leaving it open",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGcodeDisconnect,
+}
+
+var gcodeWatchCmd = &cobra.Command{
+	Use:   "watch PORT",
+	Short: "Follow the position, state and temperatures of a machine",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGcodeWatch,
+}
+
+var gcodeJogCmd = &cobra.Command{
+	Use:   "jog PORT",
+	Short: "Move a machine by a distance (mm) on each axis",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGcodeJog,
+}
+
+var gcodeHoldCmd = &cobra.Command{
+	Use:   "hold PORT",
+	Short: "Feed hold: stop motion and pause the job",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGcodeCommand(pb.MachineCommand_MACHINE_COMMAND_FEED_HOLD),
+}
+
+var gcodeCycleStartCmd = &cobra.Command{
+	Use:   "cycle-start PORT",
+	Short: "Resume after a feed hold",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGcodeCommand(pb.MachineCommand_MACHINE_COMMAND_CYCLE_START),
+}
+
+var gcodeResetCmd = &cobra.Command{
+	Use:   "reset PORT",
+	Short: "Reset GRBL or stop Marlin at once, cancelling the job",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGcodeCommand(pb.MachineCommand_MACHINE_COMMAND_RESET),
+}
+
+func init() {
+	rootCmd.AddCommand(gcodeCmd)
+	gcodeCmd.AddCommand(gcodeSendCmd)
+	gcodeCmd.AddCommand(gcodeStatusCmd)
+	gcodeCmd.AddCommand(gcodePauseCmd)
+	gcodeCmd.AddCommand(gcodeResumeCmd)
+	gcodeCmd.AddCommand(gcodeCancelCmd)
+	gcodeCmd.AddCommand(gcodeConnectCmd)
+	gcodeCmd.AddCommand(gcodeDisconnectCmd)
+	gcodeCmd.AddCommand(gcodeWatchCmd)
+	gcodeCmd.AddCommand(gcodeJogCmd)
+	gcodeCmd.AddCommand(gcodeHoldCmd)
+	gcodeCmd.AddCommand(gcodeCycleStartCmd)
+	gcodeCmd.AddCommand(gcodeResetCmd)
+
+	gcodeSendCmd.Flags().String("session-id", "", "session ID")
+	gcodeSendCmd.Flags().String("dialect", "marlin", "firmware protocol: marlin, grbl")
+	gcodeSendCmd.Flags().String("name", "", "job name (default: the file name)")
+	gcodeSendCmd.Flags().Uint32("timeout", 120, "seconds the machine may stay silent while lines await acknowledgement")
+	gcodeSendCmd.Flags().Uint32("buffer-size", 0, "GRBL receive buffer in bytes (default 127)")
+	gcodeSendCmd.Flags().Bool("detach", false, "start the job without following it")
+	gcodeSendCmd.Flags().Uint32("interval", 2000, "milliseconds between progress updates")
+	gcodeSendCmd.Flags().Bool("json", false, "output in JSON format")
+
+	gcodeStatusCmd.Flags().Bool("follow", false, "follow the progress until the job ends")
+	gcodeStatusCmd.Flags().Uint32("interval", 2000, "milliseconds between progress updates with --follow")
+	gcodeStatusCmd.Flags().Bool("json", false, "output in JSON format")
+
+	for _, c := range []*cobra.Command{gcodePauseCmd, gcodeResumeCmd, gcodeCancelCmd} {
+		c.Flags().String("session-id", "", "session ID the job was started with")
+	}
+
+	gcodeConnectCmd.Flags().String("session-id", "", "session ID")
+	gcodeConnectCmd.Flags().String("dialect", "marlin", "firmware protocol: marlin, grbl")
+	gcodeConnectCmd.Flags().Uint32("status-interval", 1000, "milliseconds between status queries")
+	gcodeConnectCmd.Flags().Uint32("buffer-size", 0, "GRBL receive buffer in bytes (default 127)")
+
+	gcodeWatchCmd.Flags().Uint32("interval", 1000, "milliseconds between updates")
+	gcodeWatchCmd.Flags().Bool("on-change", false, "only print when the machine reports something new")
+	gcodeWatchCmd.Flags().Bool("json", false, "output in JSON format")
+
+	gcodeJogCmd.Flags().String("session-id", "", "session ID")
+	gcodeJogCmd.Flags().Float64("x", 0, "distance on X in mm")
+	gcodeJogCmd.Flags().Float64("y", 0, "distance on Y in mm")
+	gcodeJogCmd.Flags().Float64("z", 0, "distance on Z in mm")
+	gcodeJogCmd.Flags().Float64("feed", 0, "feed rate in mm/min")
+
+	for _, c := range []*cobra.Command{gcodeDisconnectCmd, gcodeHoldCmd, gcodeCycleStartCmd, gcodeResetCmd} {
+		c.Flags().String("session-id", "", "session ID the machine was connected with")
+	}
+}
+
+func runGcodeSend(cmd *cobra.Command, args []string) error {
+	portName, path := args[0], args[1]
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	dialectName, _ := cmd.Flags().GetString("dialect")
+	name, _ := cmd.Flags().GetString("name")
+	timeout, _ := cmd.Flags().GetUint32("timeout")
+	bufferSize, _ := cmd.Flags().GetUint32("buffer-size")
+	detach, _ := cmd.Flags().GetBool("detach")
+	interval, _ := cmd.Flags().GetUint32("interval")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	if sessionID == "" {
+		return errors.New("--session-id is required")
+	}
+	dialect, err := parseGcodeDialect(dialectName)
+	if err != nil {
+		return err
+	}
+
+	var program []byte
+	if path == "-" {
+		program, err = io.ReadAll(os.Stdin)
+	} else {
+		program, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if name == "" && path != "-" {
+		name = filepath.Base(path)
+	}
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.StartGcodeJob(ctx, &pb.StartGcodeJobRequest{
+		PortName:          portName,
+		SessionId:         sessionID,
+		Name:              name,
+		Dialect:           dialect,
+		Program:           program,
+		ResponseTimeoutMs: timeout * 1000,
+		BufferSize:        bufferSize,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start G-code job: %w", err)
+	}
+
+	if detach {
+		if jsonOutput {
+			return printGcodeJobJSON(resp.Job)
+		}
+		fmt.Printf("Started %s on %s (%d lines)\n", gcodeJobName(resp.Job), portName, resp.Job.TotalLines)
+		return nil
+	}
+	return followGcodeJob(client, portName, interval, jsonOutput)
+}
+
+func runGcodeStatus(cmd *cobra.Command, args []string) error {
+	follow, _ := cmd.Flags().GetBool("follow")
+	interval, _ := cmd.Flags().GetUint32("interval")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if follow {
+		return followGcodeJob(client, args[0], interval, jsonOutput)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.GetGcodeJob(ctx, &pb.GetGcodeJobRequest{PortName: args[0]})
+	if err != nil {
+		return fmt.Errorf("failed to get G-code job: %w", err)
+	}
+	if jsonOutput {
+		return printGcodeJobJSON(resp.Job)
+	}
+	printGcodeJob(resp.Job)
+	return nil
+}
+
+func runGcodeControl(action pb.GcodeJobAction) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		sessionID, _ := cmd.Flags().GetString("session-id")
+		if sessionID == "" {
+			return errors.New("--session-id is required")
+		}
+
+		client, err := dialService()
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		resp, err := client.ControlGcodeJob(ctx, &pb.ControlGcodeJobRequest{
+			PortName:  args[0],
+			SessionId: sessionID,
+			Action:    action,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to %s G-code job: %w", cmd.Name(), err)
+		}
+		printGcodeJob(resp.Job)
+		return nil
+	}
+}
+
+func runGcodeConnect(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	dialectName, _ := cmd.Flags().GetString("dialect")
+	statusInterval, _ := cmd.Flags().GetUint32("status-interval")
+	bufferSize, _ := cmd.Flags().GetUint32("buffer-size")
+
+	if sessionID == "" {
+		return errors.New("--session-id is required")
+	}
+	dialect, err := parseGcodeDialect(dialectName)
+	if err != nil {
+		return err
+	}
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err = client.ConnectMachine(ctx, &pb.ConnectMachineRequest{
+		PortName:         args[0],
+		SessionId:        sessionID,
+		Dialect:          dialect,
+		StatusIntervalMs: statusInterval,
+		BufferSize:       bufferSize,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to connect machine: %w", err)
+	}
+	fmt.Printf("Connected %s machine on %s\n", dialectName, args[0])
+	return nil
+}
+
+func runGcodeDisconnect(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	if sessionID == "" {
+		return errors.New("--session-id is required")
+	}
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.DisconnectMachine(ctx, &pb.DisconnectMachineRequest{
+		PortName:  args[0],
+		SessionId: sessionID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to disconnect machine: %w", err)
+	}
+	fmt.Println(resp.Message)
+	return nil
+}
+
+func runGcodeWatch(cmd *cobra.Command, args []string) error {
+	interval, _ := cmd.Flags().GetUint32("interval")
+	onChange, _ := cmd.Flags().GetBool("on-change")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	stream, err := client.StreamMachineStatus(ctx, &pb.StreamMachineStatusRequest{
+		PortName:   args[0],
+		IntervalMs: interval,
+		OnChange:   onChange,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to watch machine: %w", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to watch machine: %w", err)
+		}
+
+		if jsonOutput {
+			_ = encoder.Encode(resp.Status)
+			continue
+		}
+		printMachineStatus(resp.Status)
+	}
+}
+
+func runGcodeJog(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	x, _ := cmd.Flags().GetFloat64("x")
+	y, _ := cmd.Flags().GetFloat64("y")
+	z, _ := cmd.Flags().GetFloat64("z")
+	feed, _ := cmd.Flags().GetFloat64("feed")
+
+	if sessionID == "" {
+		return errors.New("--session-id is required")
+	}
+	if feed <= 0 {
+		return errors.New("--feed is required")
+	}
+	if x == 0 && y == 0 && z == 0 {
+		return errors.New("--x, --y or --z is required")
+	}
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.JogMachine(ctx, &pb.JogMachineRequest{
+		PortName:  args[0],
+		SessionId: sessionID,
+		X:         x,
+		Y:         y,
+		Z:         z,
+		FeedRate:  feed,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to jog: %w", err)
+	}
+	printMachineStatus(resp.Status)
+	return nil
+}
+
+func runGcodeCommand(command pb.MachineCommand) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		sessionID, _ := cmd.Flags().GetString("session-id")
+		if sessionID == "" {
+			return errors.New("--session-id is required")
+		}
+
+		client, err := dialService()
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		resp, err := client.SendMachineCommand(ctx, &pb.SendMachineCommandRequest{
+			PortName:  args[0],
+			SessionId: sessionID,
+			Command:   command,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to send %s: %w", cmd.Name(), err)
+		}
+		printMachineStatus(resp.Status)
+		return nil
+	}
+}
+
+// followGcodeJob prints the progress of a job until it ends or Ctrl-C, and
+// fails when the job does
+func followGcodeJob(c *client.Client, portName string, interval uint32, jsonOutput bool) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	stream, err := c.StreamGcodeJob(ctx, &pb.StreamGcodeJobRequest{
+		PortName:   portName,
+		IntervalMs: interval,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to follow G-code job: %w", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	var last *pb.GcodeJob
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			switch {
+			case errors.Is(err, io.EOF):
+				if last != nil && last.State == pb.GcodeJobState_GCODE_JOB_STATE_FAILED {
+					return fmt.Errorf("G-code job failed: %s", last.Error)
+				}
+				return nil
+			case ctx.Err() != nil:
+				if !jsonOutput {
+					fmt.Println("Stopped following; the job goes on")
+				}
+				return nil
+			}
+			return fmt.Errorf("failed to follow G-code job: %w", err)
+		}
+		last = resp.Job
+
+		if jsonOutput {
+			_ = encoder.Encode(resp.Job)
+			continue
+		}
+		printGcodeJob(resp.Job)
+	}
+}
+
+// printGcodeJob prints the progress of a job on one line
+func printGcodeJob(job *pb.GcodeJob) {
+	fmt.Printf("%s %s  %s  %d/%d lines (%.1f%%)  %s elapsed",
+		job.PortName, gcodeJobName(job), gcodeJobState(job.State),
+		job.AcknowledgedLines, job.TotalLines, job.Progress,
+		(time.Duration(job.ElapsedMs) * time.Millisecond).Round(time.Second))
+	if job.RemainingMs > 0 {
+		fmt.Printf(", ~%s left", (time.Duration(job.RemainingMs) * time.Millisecond).Round(time.Second))
+	}
+	if job.Error != "" {
+		fmt.Printf("  %s", job.Error)
+	}
+	fmt.Println()
+}
+
+// printMachineStatus prints the status of a machine on one line
+func printMachineStatus(status *pb.MachineStatus) {
+	state := status.State
+	if state == "" {
+		state = "unknown"
+	}
+	fmt.Printf("%s  %s", status.PortName, state)
+	if len(status.MachinePosition) > 0 {
+		fmt.Printf("  pos %s", formatAxes(status.MachinePosition))
+	}
+	if len(status.WorkPosition) > 0 {
+		fmt.Printf("  work %s", formatAxes(status.WorkPosition))
+	}
+	if status.FeedRate > 0 || status.SpindleSpeed > 0 {
+		fmt.Printf("  F%g S%g", status.FeedRate, status.SpindleSpeed)
+	}
+	for _, t := range status.Temperatures {
+		fmt.Printf("  %s %.1f/%.1f", t.Sensor, t.Current, t.Target)
+	}
+	if status.Error != "" {
+		fmt.Printf("  last error: %s", status.Error)
+	}
+	fmt.Println()
+}
+
+// formatAxes formats a position as "X1.000 Y2.000 Z3.000"
+func formatAxes(position map[string]float64) string {
+	axes := make([]string, 0, len(position))
+	for axis := range position {
+		axes = append(axes, axis)
+	}
+	slices.Sort(axes)
+	for i, axis := range axes {
+		axes[i] = fmt.Sprintf("%s%.3f", axis, position[axis])
+	}
+	return strings.Join(axes, " ")
+}
+
+func printGcodeJobJSON(job *pb.GcodeJob) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(job)
+}
+
+func gcodeJobName(job *pb.GcodeJob) string {
+	if job.Name == "" {
+		return "job"
+	}
+	return job.Name
+}
+
+// parseGcodeDialect converts a --dialect value
+func parseGcodeDialect(name string) (pb.GcodeDialect, error) {
+	switch name {
+	case "marlin":
+		return pb.GcodeDialect_GCODE_DIALECT_MARLIN, nil
+	case "grbl":
+		return pb.GcodeDialect_GCODE_DIALECT_GRBL, nil
+	}
+	return pb.GcodeDialect_GCODE_DIALECT_UNSPECIFIED, fmt.Errorf("invalid --dialect %q: marlin or grbl expected", name)
+}
+
+func gcodeJobState(state pb.GcodeJobState) string {
+	switch state {
+	case pb.GcodeJobState_GCODE_JOB_STATE_RUNNING:
+		return "running"
+	case pb.GcodeJobState_GCODE_JOB_STATE_PAUSED:
+		return "paused"
+	case pb.GcodeJobState_GCODE_JOB_STATE_COMPLETED:
+		return "completed"
+	case pb.GcodeJobState_GCODE_JOB_STATE_FAILED:
+		return "failed"
+	case pb.GcodeJobState_GCODE_JOB_STATE_CANCELLED:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
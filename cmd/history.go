@@ -0,0 +1,112 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history POLLER [flags]",
+	Short: "Show the recorded history of a poller's values",
+	Long: `Show values recorded by a poller (polling.history must be enabled).
+
+Values are aggregated into steps; each row shows the minimum, maximum and
+average of a step. Older ranges are served from minute or hour averages.
+
+Example:
+  seriallink history boiler
+  seriallink history boiler --field temperature --since 24h --step 15m
+  seriallink history boiler --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runHistory,
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+
+	historyCmd.Flags().String("field", "", "only show this value")
+	historyCmd.Flags().Duration("since", time.Hour, "how far back to look")
+	historyCmd.Flags().Duration("step", 0, "aggregate into steps of this length (default: finest available)")
+	historyCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+func runHistory(cmd *cobra.Command, args []string) error {
+	field, _ := cmd.Flags().GetString("field")
+	since, _ := cmd.Flags().GetDuration("since")
+	step, _ := cmd.Flags().GetDuration("step")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	resp, err := client.QueryHistory(ctx, &pb.QueryHistoryRequest{
+		Poller:      args[0],
+		Field:       field,
+		StartTime:   now.Add(-since).UnixNano(),
+		EndTime:     now.UnixNano(),
+		StepSeconds: uint32(step / time.Second),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to query history: %w", err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp)
+	}
+
+	if len(resp.Series) == 0 {
+		fmt.Println("No history recorded")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tFIELD\tMIN\tMAX\tAVG\tSAMPLES")
+	fmt.Fprintln(w, "----\t-----\t---\t---\t---\t-------")
+	for _, series := range resp.Series {
+		for _, p := range series.Points {
+			fmt.Fprintf(w, "%s\t%s\t%g\t%g\t%g\t%d\n",
+				time.Unix(0, p.Timestamp).Format("2006-01-02 15:04:05"),
+				series.Field, p.Min, p.Max, p.Avg, p.Count)
+		}
+	}
+	w.Flush()
+
+	if resp.StepSeconds == 0 {
+		fmt.Println("\nStep: as polled")
+	} else {
+		fmt.Printf("\nStep: %s\n", time.Duration(resp.StepSeconds)*time.Second)
+	}
+	return nil
+}
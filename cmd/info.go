@@ -24,8 +24,6 @@ import (
 
 	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 var infoCmd = &cobra.Command{
@@ -35,7 +33,9 @@ var infoCmd = &cobra.Command{
 
 Example:
   seriallink info                # Display service information
-  seriallink info --json         # Output as JSON`,
+  seriallink info --json         # Output as JSON
+  seriallink info --stats        # Totals across sessions and resource use
+  seriallink info --port /dev/ttyUSB0  # What the port supports on the agent`,
 	RunE: runInfo,
 }
 
@@ -43,6 +43,8 @@ func init() {
 	rootCmd.AddCommand(infoCmd)
 
 	infoCmd.Flags().Bool("json", false, "output in JSON format")
+	infoCmd.Flags().Bool("stats", false, "show agent statistics instead")
+	infoCmd.Flags().String("port", "", "show the capabilities of a port instead")
 }
 
 func runInfo(cmd *cobra.Command, args []string) error {
@@ -51,14 +53,33 @@ func runInfo(cmd *cobra.Command, args []string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	addr := GetAddress()
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	client, err := dialService()
 	if err != nil {
-		return fmt.Errorf("failed to connect to service at %s: %w", addr, err)
+		return err
 	}
-	defer conn.Close()
+	defer client.Close()
 
-	client := pb.NewSerialServiceClient(conn)
+	if stats, _ := cmd.Flags().GetBool("stats"); stats {
+		resp, err := client.GetAgentStats(ctx, &pb.GetAgentStatsRequest{})
+		if err != nil {
+			return fmt.Errorf("failed to get agent stats: %w", err)
+		}
+		if jsonOutput {
+			return printInfoJSON(resp)
+		}
+		return printStatsTable(resp)
+	}
+
+	if portName, _ := cmd.Flags().GetString("port"); portName != "" {
+		resp, err := client.GetPortCapabilities(ctx, &pb.GetPortCapabilitiesRequest{PortName: portName})
+		if err != nil {
+			return fmt.Errorf("failed to get port capabilities: %w", err)
+		}
+		if jsonOutput {
+			return printInfoJSON(resp)
+		}
+		return printCapabilitiesTable(resp)
+	}
 
 	resp, err := client.GetAgentInfo(ctx, &pb.GetAgentInfoRequest{})
 	if err != nil {
@@ -105,7 +126,61 @@ func printInfoTable(info *pb.AgentInfo) error {
 	return nil
 }
 
-func printInfoJSON(info *pb.AgentInfo) error {
+func printStatsTable(stats *pb.GetAgentStatsResponse) error {
+	fmt.Println("SerialLink Agent Statistics:")
+	fmt.Printf("\nSessions:\n")
+	fmt.Printf("  Open Ports:     %d\n", stats.OpenPorts)
+	fmt.Printf("  Open Sessions:  %d\n", stats.OpenSessions)
+	fmt.Printf("  Opened:         %d since start\n", stats.SessionsOpened)
+	fmt.Printf("  Streams:        %d\n", stats.Streams)
+
+	fmt.Printf("\nTraffic:\n")
+	fmt.Printf("  Bytes Sent:     %s\n", formatBytes(int64(stats.BytesSent)))
+	fmt.Printf("  Bytes Received: %s\n", formatBytes(int64(stats.BytesReceived)))
+	fmt.Printf("  Errors:         %d\n", stats.Errors)
+	fmt.Printf("  Buffered:       %s\n", formatBytes(stats.BufferedBytes))
+
+	fmt.Printf("\nResources:\n")
+	fmt.Printf("  CPU:            %.1f%% (%.1fs total)\n", stats.CpuPercent, stats.CpuSeconds)
+	fmt.Printf("  Heap:           %s\n", formatBytes(int64(stats.HeapAllocBytes)))
+	fmt.Printf("  Memory (OS):    %s\n", formatBytes(int64(stats.SysBytes)))
+	fmt.Printf("  Goroutines:     %d\n", stats.Goroutines)
+	if stats.UptimeSeconds > 0 {
+		fmt.Printf("  Uptime:         %s\n", formatUptime(stats.UptimeSeconds))
+	}
+
+	return nil
+}
+
+func printCapabilitiesTable(caps *pb.GetPortCapabilitiesResponse) error {
+	fmt.Printf("Capabilities of %s (%s):\n", caps.PortName, caps.Platform)
+	if caps.Driver != "" {
+		fmt.Printf("  %-18s %s\n", "Driver:", caps.Driver)
+	}
+	if caps.MaxBaudRate > 0 {
+		fmt.Printf("  %-18s %d\n", "Max baud:", caps.MaxBaudRate)
+	} else {
+		fmt.Printf("  %-18s %s\n", "Max baud:", "unknown")
+	}
+	for _, c := range []struct {
+		name      string
+		supported bool
+	}{
+		{"Low latency", caps.LowLatency},
+		{"Read polling", caps.ReadPolling},
+		{"Custom baud", caps.CustomBaud},
+		{"Mark/space parity", caps.MarkSpaceParity},
+		{"Modem status", caps.ModemStatus},
+		{"DTR/RTS", caps.ControlLines},
+		{"Break", caps.Break},
+		{"RS-485", caps.Rs485},
+	} {
+		fmt.Printf("  %-18s %v\n", c.name+":", c.supported)
+	}
+	return nil
+}
+
+func printInfoJSON(info interface{}) error {
 	data, err := json.MarshalIndent(info, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON: %w", err)
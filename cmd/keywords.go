@@ -0,0 +1,126 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var keywordsCmd = &cobra.Command{
+	Use:   "keywords [PORT] [flags]",
+	Short: "Show keyword counts of console-logged ports",
+	Long: `Show how many console lines held each configured keyword (e.g. "ERROR",
+"WARN" or "panic") since the agent started and within each sliding window.
+
+Keywords and windows are set under console.keywords in the agent
+configuration; the counts are also exported on /metrics.
+
+Example:
+  seriallink keywords                # All console-logged ports
+  seriallink keywords /dev/ttyUSB0   # One port
+  seriallink keywords --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runKeywords,
+}
+
+func init() {
+	rootCmd.AddCommand(keywordsCmd)
+
+	keywordsCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+func runKeywords(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	portName := ""
+	if len(args) == 1 {
+		portName = args[0]
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.GetKeywordStats(ctx, &pb.GetKeywordStatsRequest{PortName: portName})
+	if err != nil {
+		return fmt.Errorf("failed to get keyword stats: %w", err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp)
+	}
+
+	if len(resp.Ports) == 0 {
+		fmt.Println("No console-logged ports")
+		return nil
+	}
+
+	// Every port counts the same keywords over the same windows
+	var windows []*pb.KeywordWindow
+	if len(resp.Ports[0].Keywords) > 0 {
+		windows = resp.Ports[0].Keywords[0].Windows
+	}
+	header := []string{"PORT", "KEYWORD", "TOTAL"}
+	for _, win := range windows {
+		header = append(header, "LAST "+strings.ToUpper(formatWindow(win.WindowSeconds)))
+	}
+	underline := make([]string, len(header))
+	for i, h := range header {
+		underline[i] = strings.Repeat("-", len(h))
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	fmt.Fprintln(w, strings.Join(underline, "\t"))
+	for _, p := range resp.Ports {
+		for _, k := range p.Keywords {
+			row := []string{p.PortName, k.Keyword, fmt.Sprint(k.Total)}
+			for _, win := range k.Windows {
+				row = append(row, fmt.Sprint(win.Count))
+			}
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+	}
+	return w.Flush()
+}
+
+// formatWindow formats a window length, e.g. "5m" or "1h30m"
+func formatWindow(seconds uint32) string {
+	s := (time.Duration(seconds) * time.Second).String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
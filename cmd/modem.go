@@ -0,0 +1,224 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var modemCmd = &cobra.Command{
+	Use:   "modem PORT [flags]",
+	Short: "Manage a cellular modem on a port",
+	Long: `Show the signal strength and network registration of a cellular modem on
+an open port, or send and read SMS and hand the port to pppd with the
+subcommands.
+
+Example:
+  seriallink modem /dev/ttyUSB2 --session-id ID
+  seriallink modem send-sms /dev/ttyUSB2 +15551234567 "Door opened" --session-id ID
+  seriallink modem read-sms /dev/ttyUSB2 --unread --delete --session-id ID
+  seriallink modem ppp /dev/ttyUSB2 --apn internet --session-id ID`,
+	Args: cobra.ExactArgs(1),
+	RunE: runModem,
+}
+
+var modemSendSMSCmd = &cobra.Command{
+	Use:   "send-sms PORT NUMBER TEXT [flags]",
+	Short: "Send an SMS",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runModemSendSMS,
+}
+
+var modemReadSMSCmd = &cobra.Command{
+	Use:   "read-sms PORT [flags]",
+	Short: "List stored SMS",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runModemReadSMS,
+}
+
+var modemPPPCmd = &cobra.Command{
+	Use:   "ppp PORT [flags]",
+	Short: "Prepare a PPP data call and release the port for pppd",
+	Long: `Define the packet data context for the APN, check network registration
+and close the session so pppd can open the port and dial.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runModemPPP,
+}
+
+func init() {
+	rootCmd.AddCommand(modemCmd)
+	modemCmd.AddCommand(modemSendSMSCmd, modemReadSMSCmd, modemPPPCmd)
+
+	modemCmd.PersistentFlags().String("session-id", "", "session ID")
+
+	modemSendSMSCmd.Flags().Bool("pdu", false, "send in PDU mode (required for non-GSM characters)")
+
+	modemReadSMSCmd.Flags().Bool("pdu", false, "read in PDU mode")
+	modemReadSMSCmd.Flags().Bool("unread", false, "only list unread messages")
+	modemReadSMSCmd.Flags().Bool("delete", false, "delete messages after listing them")
+
+	modemPPPCmd.Flags().String("apn", "", "access point name")
+	_ = modemPPPCmd.MarkFlagRequired("apn")
+}
+
+func smsMode(cmd *cobra.Command) string {
+	if pdu, _ := cmd.Flags().GetBool("pdu"); pdu {
+		return "pdu"
+	}
+	return "text"
+}
+
+func runModem(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.GetModemStatus(ctx, &pb.GetModemStatusRequest{
+		PortName:  args[0],
+		SessionId: sessionID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to query modem: %w", err)
+	}
+
+	signal := "unknown"
+	if resp.SignalDbm != 0 {
+		signal = fmt.Sprintf("%d dBm (CSQ %d)", resp.SignalDbm, resp.SignalRssi)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Signal:\t%s\n", signal)
+	fmt.Fprintf(w, "Registration:\t%s\n", resp.Registration)
+	fmt.Fprintf(w, "Operator:\t%s\n", resp.Operator)
+	return w.Flush()
+}
+
+func runModemSendSMS(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+
+	// Submitting can take a while on a weak network
+	ctx, cancel := context.WithTimeout(context.Background(), 90*time.Second)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.SendSMS(ctx, &pb.SendSMSRequest{
+		PortName:  args[0],
+		SessionId: sessionID,
+		Number:    args[1],
+		Text:      args[2],
+		Mode:      smsMode(cmd),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send SMS: %w", err)
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("failed to send SMS: %s", resp.Message)
+	}
+
+	fmt.Printf("Sent to %s (reference %d)\n", args[1], resp.Reference)
+	return nil
+}
+
+func runModemReadSMS(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	unread, _ := cmd.Flags().GetBool("unread")
+	remove, _ := cmd.Flags().GetBool("delete")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 90*time.Second)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.ReadSMS(ctx, &pb.ReadSMSRequest{
+		PortName:   args[0],
+		SessionId:  sessionID,
+		Mode:       smsMode(cmd),
+		UnreadOnly: unread,
+		Delete:     remove,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read SMS: %w", err)
+	}
+
+	if len(resp.Messages) == 0 {
+		fmt.Println("No messages.")
+		return nil
+	}
+
+	for _, msg := range resp.Messages {
+		received := ""
+		if msg.Timestamp > 0 {
+			received = time.Unix(msg.Timestamp, 0).Format(time.RFC3339)
+		}
+		fmt.Printf("[%d] %s  %s  %s\n%s\n\n", msg.Index, msg.Sender, received, msg.Status, msg.Text)
+	}
+	return nil
+}
+
+func runModemPPP(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	apn, _ := cmd.Flags().GetString("apn")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.HandOffPPP(ctx, &pb.HandOffPPPRequest{
+		PortName:  args[0],
+		SessionId: sessionID,
+		Apn:       apn,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to prepare PPP: %w", err)
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("failed to prepare PPP: %s", resp.Message)
+	}
+
+	fmt.Printf("Port %s released; dial with %q in the pppd chat script\n", args[0], resp.DialString)
+	return nil
+}
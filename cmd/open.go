@@ -18,13 +18,16 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 	"time"
 
 	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/Shoaibashk/SerialLink/client"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 var openCmd = &cobra.Command{
@@ -35,7 +38,27 @@ var openCmd = &cobra.Command{
 Example:
   seriallink open COM1                           # Open with defaults (9600 baud)
   seriallink open COM1 --baud 115200             # Open with specific baud rate
-  seriallink open /dev/ttyUSB0 --baud 9600 --data-bits 8 --stop-bits 1 --parity none`,
+  seriallink open /dev/ttyUSB0 --baud 9600 --data-bits 8 --stop-bits 1 --parity none
+  seriallink open rfc2217://10.0.0.5:4001 --baud 115200  # Remote ser2net port
+  seriallink open /dev/ttyUSB0 --baud 115200 --latency-profile low  # Tight request/response loops
+  seriallink open /dev/ttyUSB0 --priority critical  # Writes and streams go ahead of bulk sessions
+  seriallink open COM1 --meta purpose=flashing --meta ticket=HW-123  # Tell others why the port is held
+  seriallink open /dev/ttyUSB0 --no-coalesce     # Send every write at once (latency-sensitive protocols)
+  seriallink open /dev/ttyACM0 --no-touch        # Leave DTR/RTS alone so an Arduino does not reset
+  seriallink open /dev/ttyUSB0 --dtr low --rts low  # Hold an ESP32 out of reset and bootloader mode
+
+Initialization commands given with --init run before the session is handed
+out, so no other traffic interleaves with them. Each is "COMMAND" or
+"COMMAND=>PATTERN" (escapes such as \r and \x06 are expanded); with a
+pattern the response must match it or the open fails:
+  seriallink open /dev/ttyUSB0 --init 'ATZ\r=>OK' --init 'ATE0\r=>OK'
+
+With --exec the port is only held for one exchange: the command is sent
+(after any --init commands), the response is printed as received and the
+port is closed again. "-" sends standard input instead:
+  seriallink open /dev/ttyUSB0 --baud 115200 --exec 'AT+CSQ\r' --terminator 'OK\r\n'
+  seriallink open COM3 --exec '*IDN?\n' --expect '^ACME'
+  printf 'VER\r' | seriallink open COM3 --exec -`,
 	Args: cobra.ExactArgs(1),
 	RunE: runOpen,
 }
@@ -48,7 +71,20 @@ func init() {
 	openCmd.Flags().String("stop-bits", "1", "stop bits (1, 1.5, 2)")
 	openCmd.Flags().String("parity", "none", "parity (none, odd, even, mark, space)")
 	openCmd.Flags().String("flow-control", "none", "flow control (none, hardware, software)")
+	openCmd.Flags().String("latency-profile", "", "latency profile (default, low; default: agent setting)")
+	openCmd.Flags().String("dtr", "", "DTR state on open (default, high, low, untouched; default: agent setting)")
+	openCmd.Flags().String("rts", "", "RTS state on open (default, high, low, untouched; default: agent setting)")
+	openCmd.Flags().Bool("no-touch", false, "leave DTR and RTS untouched on open and close (same as --dtr untouched --rts untouched)")
 	openCmd.Flags().String("client-id", "", "client ID for locking (auto-generated if not provided)")
+	openCmd.Flags().String("priority", "normal", "session priority (bulk, normal, critical)")
+	openCmd.Flags().StringArray("init", nil, `initialization command, "COMMAND" or "COMMAND=>PATTERN" (repeatable)`)
+	openCmd.Flags().Uint32("init-timeout", 2000, "timeout in milliseconds for each initialization response")
+	openCmd.Flags().StringToString("meta", nil, "session metadata as key=value, shown in status and events (repeatable)")
+	openCmd.Flags().Bool("no-coalesce", false, "send every write at once, without the agent's write coalescing window")
+	openCmd.Flags().String("exec", "", `send this command, print the response and close the port ("-" reads standard input)`)
+	openCmd.Flags().String("expect", "", "with --exec, regular expression the response must match")
+	openCmd.Flags().String("terminator", "", "with --exec, bytes ending the response (escapes expanded; default: 100ms of silence)")
+	openCmd.Flags().Uint32("exec-timeout", 2000, "with --exec, timeout in milliseconds for the response")
 }
 
 func runOpen(cmd *cobra.Command, args []string) error {
@@ -59,7 +95,20 @@ func runOpen(cmd *cobra.Command, args []string) error {
 	stopBits, _ := cmd.Flags().GetString("stop-bits")
 	parity, _ := cmd.Flags().GetString("parity")
 	flowControl, _ := cmd.Flags().GetString("flow-control")
+	latencyProfile, _ := cmd.Flags().GetString("latency-profile")
+	dtr, _ := cmd.Flags().GetString("dtr")
+	rts, _ := cmd.Flags().GetString("rts")
+	noTouch, _ := cmd.Flags().GetBool("no-touch")
 	clientID, _ := cmd.Flags().GetString("client-id")
+	priority, _ := cmd.Flags().GetString("priority")
+	initCommands, _ := cmd.Flags().GetStringArray("init")
+	initTimeout, _ := cmd.Flags().GetUint32("init-timeout")
+	metadata, _ := cmd.Flags().GetStringToString("meta")
+	noCoalesce, _ := cmd.Flags().GetBool("no-coalesce")
+	execCommand, _ := cmd.Flags().GetString("exec")
+	expect, _ := cmd.Flags().GetString("expect")
+	terminator, _ := cmd.Flags().GetString("terminator")
+	execTimeout, _ := cmd.Flags().GetUint32("exec-timeout")
 
 	if clientID == "" {
 		clientID = fmt.Sprintf("cli-%d", time.Now().UnixNano())
@@ -72,30 +121,78 @@ func runOpen(cmd *cobra.Command, args []string) error {
 	flowControlEnum := parseFlowControl(flowControl)
 
 	config := &pb.PortConfig{
-		BaudRate:    baud,
-		DataBits:    dataBitsEnum,
-		StopBits:    stopBitsEnum,
-		Parity:      parityEnum,
-		FlowControl: flowControlEnum,
+		BaudRate:       baud,
+		DataBits:       dataBitsEnum,
+		StopBits:       stopBitsEnum,
+		Parity:         parityEnum,
+		FlowControl:    flowControlEnum,
+		LatencyProfile: parseLatencyProfile(latencyProfile),
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	if noTouch {
+		if dtr != "" || rts != "" {
+			return errors.New("--no-touch cannot be combined with --dtr or --rts")
+		}
+		dtr, rts = "untouched", "untouched"
+	}
+	dtrState, err := parseLineState(dtr)
+	if err != nil {
+		return fmt.Errorf("invalid --dtr: %w", err)
+	}
+	rtsState, err := parseLineState(rts)
+	if err != nil {
+		return fmt.Errorf("invalid --rts: %w", err)
+	}
 
-	addr := GetAddress()
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	initSteps, err := parseInitSteps(initCommands, initTimeout)
 	if err != nil {
-		return fmt.Errorf("failed to connect to service at %s: %w", addr, err)
+		return err
 	}
-	defer conn.Close()
 
-	client := pb.NewSerialServiceClient(conn)
+	timeout := 10*time.Second + time.Duration(len(initSteps))*time.Duration(initTimeout)*time.Millisecond
+	execMode := cmd.Flags().Changed("exec")
+	if execMode {
+		step, err := parseExecStep(execCommand, expect, terminator, execTimeout)
+		if err != nil {
+			return err
+		}
+		initSteps = append(initSteps, step)
+		timeout += time.Duration(execTimeout) * time.Millisecond
+	} else if expect != "" || terminator != "" {
+		return errors.New("--expect and --terminator require --exec")
+	}
+
+	// Without explicit line settings the agent picks them, applying the
+	// device profile of known USB devices
+	explicit := false
+	for _, name := range []string{"baud", "data-bits", "stop-bits", "parity", "flow-control", "latency-profile"} {
+		explicit = explicit || cmd.Flags().Changed(name)
+	}
+	if !explicit {
+		config = nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
 
 	resp, err := client.OpenPort(ctx, &pb.OpenPortRequest{
 		PortName:  portName,
 		Config:    config,
 		ClientId:  clientID,
 		Exclusive: true,
+		Priority:  parsePriority(priority),
+		Init:      initSteps,
+		Metadata:  metadata,
+		Dtr:       dtrState,
+		Rts:       rtsState,
+
+		NoWriteCoalescing: noCoalesce,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to open port: %w", err)
@@ -105,14 +202,36 @@ func runOpen(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to open port: %s", resp.Message)
 	}
 
+	if execMode {
+		return finishExec(ctx, client, portName, resp)
+	}
+
 	if IsVerbose() {
 		fmt.Printf("Successfully opened %s\n", portName)
-		fmt.Printf("  Baud Rate:    %d\n", baud)
-		fmt.Printf("  Data Bits:    %s\n", dataBits)
-		fmt.Printf("  Stop Bits:    %s\n", stopBits)
-		fmt.Printf("  Parity:       %s\n", parity)
-		fmt.Printf("  Flow Control: %s\n", flowControl)
+		if config != nil {
+			fmt.Printf("  Baud Rate:    %d\n", baud)
+			fmt.Printf("  Data Bits:    %s\n", dataBits)
+			fmt.Printf("  Stop Bits:    %s\n", stopBits)
+			fmt.Printf("  Parity:       %s\n", parity)
+			fmt.Printf("  Flow Control: %s\n", flowControl)
+			if latencyProfile != "" {
+				fmt.Printf("  Latency:      %s\n", latencyProfile)
+			}
+		} else {
+			fmt.Printf("  Settings:     agent defaults or device profile\n")
+		}
+		fmt.Printf("  Priority:     %s\n", priority)
 		fmt.Printf("  Session ID:   %s\n", resp.SessionId)
+		if resp.ShortSessionId != "" {
+			fmt.Printf("  Short ID:     %s\n", resp.ShortSessionId)
+		}
+		for i, response := range resp.InitResponses {
+			if response != nil {
+				fmt.Printf("  Init step %d:  %q\n", i+1, response)
+			}
+		}
+	} else if resp.ShortSessionId != "" {
+		fmt.Printf("Opened %s (Session: %s, short: %s)\n", portName, resp.SessionId, resp.ShortSessionId)
 	} else {
 		fmt.Printf("Opened %s (Session: %s)\n", portName, resp.SessionId)
 	}
@@ -120,6 +239,85 @@ func runOpen(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// parseInitSteps converts --init values, "COMMAND" or "COMMAND=>PATTERN"
+func parseInitSteps(values []string, timeoutMs uint32) ([]*pb.InitStep, error) {
+	steps := make([]*pb.InitStep, 0, len(values))
+	for _, value := range values {
+		command, pattern, _ := strings.Cut(value, "=>")
+		unquoted, err := unescapeArg(command)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --init command %q: %w", command, err)
+		}
+		steps = append(steps, &pb.InitStep{
+			Data:            []byte(unquoted),
+			ExpectedPattern: pattern,
+			TimeoutMs:       timeoutMs,
+		})
+	}
+	return steps, nil
+}
+
+// parseExecStep converts --exec and its options into the last init step.
+// The response of a step without --expect is accepted as long as it is not
+// empty.
+func parseExecStep(command, expect, terminator string, timeoutMs uint32) (*pb.InitStep, error) {
+	var data []byte
+	if command == "-" {
+		input, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read command from stdin: %w", err)
+		}
+		data = input
+	} else {
+		unquoted, err := unescapeArg(command)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --exec command %q: %w", command, err)
+		}
+		data = []byte(unquoted)
+	}
+	if len(data) == 0 {
+		return nil, errors.New("--exec command is empty")
+	}
+
+	step := &pb.InitStep{
+		Data:            data,
+		ExpectedPattern: expect,
+		TimeoutMs:       timeoutMs,
+	}
+	if step.ExpectedPattern == "" {
+		step.ExpectedPattern = "(?s).*"
+	}
+	if terminator != "" {
+		unquoted, err := unescapeArg(terminator)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --terminator %q: %w", terminator, err)
+		}
+		step.Terminator = []byte(unquoted)
+	}
+	return step, nil
+}
+
+// finishExec prints the response of the --exec step and closes the port
+func finishExec(ctx context.Context, c *client.Client, portName string, resp *pb.OpenPortResponse) error {
+	if n := len(resp.InitResponses); n > 0 {
+		if _, err := os.Stdout.Write(resp.InitResponses[n-1]); err != nil {
+			return err
+		}
+	}
+
+	closeResp, err := c.ClosePort(ctx, &pb.ClosePortRequest{
+		PortName:  portName,
+		SessionId: resp.SessionId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to close port: %w", err)
+	}
+	if !closeResp.Success {
+		return fmt.Errorf("failed to close port: %s", closeResp.Message)
+	}
+	return nil
+}
+
 func parseDataBits(s string) pb.DataBits {
 	switch s {
 	case "5":
@@ -177,3 +375,45 @@ func parseFlowControl(s string) pb.FlowControl {
 		return pb.FlowControl_FLOW_CONTROL_NONE
 	}
 }
+
+func parseLatencyProfile(s string) pb.LatencyProfile {
+	switch s {
+	case "default":
+		return pb.LatencyProfile_LATENCY_PROFILE_DEFAULT
+	case "low":
+		return pb.LatencyProfile_LATENCY_PROFILE_LOW
+	default:
+		return pb.LatencyProfile_LATENCY_PROFILE_UNSPECIFIED
+	}
+}
+
+// parseLineState maps a line state flag; empty leaves it to the agent
+func parseLineState(s string) (pb.LineState, error) {
+	switch s {
+	case "":
+		return pb.LineState_LINE_STATE_UNSPECIFIED, nil
+	case "default":
+		return pb.LineState_LINE_STATE_DEFAULT, nil
+	case "high":
+		return pb.LineState_LINE_STATE_HIGH, nil
+	case "low":
+		return pb.LineState_LINE_STATE_LOW, nil
+	case "untouched":
+		return pb.LineState_LINE_STATE_UNTOUCHED, nil
+	default:
+		return pb.LineState_LINE_STATE_UNSPECIFIED, fmt.Errorf("%q is not default, high, low or untouched", s)
+	}
+}
+
+func parsePriority(s string) pb.SessionPriority {
+	switch s {
+	case "bulk":
+		return pb.SessionPriority_SESSION_PRIORITY_BULK
+	case "normal":
+		return pb.SessionPriority_SESSION_PRIORITY_NORMAL
+	case "critical":
+		return pb.SessionPriority_SESSION_PRIORITY_CRITICAL
+	default:
+		return pb.SessionPriority_SESSION_PRIORITY_UNSPECIFIED
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var pasteCmd = &cobra.Command{
+	Use:   "paste PORT FILE",
+	Short: "Send a text file line by line with pacing",
+	Long: `Send a text file to an open port one line at a time, waiting after each
+line for a prompt, the line's echo or a fixed delay, so a device that cannot
+take input faster than it processes it loses nothing. Typical uses are
+loading a configuration into network gear and streaming G-code to a CNC
+controller. FILE "-" reads standard input.
+
+After sending a line, paste waits for its echo (--echo), then for the
+--prompt regular expression, then for --delay. The paste stops at the first
+line whose echo or prompt does not arrive within --timeout. --line-ending
+accepts escape sequences such as \r and \n.
+
+Example:
+  seriallink paste /dev/ttyUSB0 switch.cfg --session-id <id> --prompt "#\s*$"
+  seriallink paste /dev/ttyACM0 part.gcode --session-id <id> --prompt "^ok" --line-ending "\n" --skip-blank
+  seriallink paste COM3 setup.txt --session-id <id> --echo --delay 100`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPaste,
+}
+
+func init() {
+	rootCmd.AddCommand(pasteCmd)
+
+	pasteCmd.Flags().String("session-id", "", "session ID")
+	pasteCmd.Flags().String("prompt", "", "regular expression to wait for after each line")
+	pasteCmd.Flags().Bool("echo", false, "wait for each line to be echoed")
+	pasteCmd.Flags().Uint32("delay", 0, "milliseconds to wait after each line")
+	pasteCmd.Flags().Uint32("timeout", 10000, "milliseconds to wait for a line's echo or prompt")
+	pasteCmd.Flags().String("line-ending", `\r`, "sent after each line (escape sequences allowed)")
+	pasteCmd.Flags().Bool("skip-blank", false, "do not send blank lines")
+	pasteCmd.Flags().Bool("show-response", false, "print what the device sent back after each line")
+	pasteCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+func runPaste(cmd *cobra.Command, args []string) error {
+	portName, path := args[0], args[1]
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	prompt, _ := cmd.Flags().GetString("prompt")
+	echo, _ := cmd.Flags().GetBool("echo")
+	delay, _ := cmd.Flags().GetUint32("delay")
+	timeout, _ := cmd.Flags().GetUint32("timeout")
+	lineEnding, _ := cmd.Flags().GetString("line-ending")
+	skipBlank, _ := cmd.Flags().GetBool("skip-blank")
+	showResponse, _ := cmd.Flags().GetBool("show-response")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	if sessionID == "" {
+		return errors.New("--session-id is required")
+	}
+	if prompt == "" && !echo && delay == 0 {
+		return errors.New("--prompt, --echo or --delay is required to pace the lines")
+	}
+	ending, err := unescapeArg(lineEnding)
+	if err != nil {
+		return fmt.Errorf("invalid --line-ending: %w", err)
+	}
+
+	var data []byte
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	stream, err := client.Paste(ctx, &pb.PasteRequest{
+		PortName:       portName,
+		SessionId:      sessionID,
+		Data:           data,
+		LineEnding:     ending,
+		PromptPattern:  prompt,
+		WaitEcho:       echo,
+		LineDelayMs:    delay,
+		LineTimeoutMs:  timeout,
+		SkipBlankLines: skipBlank,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to paste: %w", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	var last *pb.PasteResponse
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			switch {
+			case errors.Is(err, io.EOF):
+				if last != nil && !jsonOutput {
+					fmt.Printf("Sent %d lines in %s\n", last.TotalLines, time.Duration(last.ElapsedMs)*time.Millisecond)
+				}
+				return nil
+			case ctx.Err() != nil:
+				return fmt.Errorf("interrupted after line %d", pasteLinesSent(last))
+			}
+			return fmt.Errorf("paste stopped after line %d: %w", pasteLinesSent(last), err)
+		}
+		last = resp
+
+		if jsonOutput {
+			_ = encoder.Encode(resp)
+			continue
+		}
+		fmt.Printf("%*d/%d  %s\n", len(fmt.Sprint(resp.TotalLines)), resp.Line, resp.TotalLines, resp.Text)
+		if showResponse && len(resp.Response) > 0 {
+			for _, line := range strings.Split(strings.TrimRight(string(resp.Response), "\r\n"), "\n") {
+				fmt.Printf("    %s\n", strings.TrimRight(line, "\r"))
+			}
+		}
+	}
+}
+
+// pasteLinesSent returns the number of lines a paste has completed
+func pasteLinesSent(last *pb.PasteResponse) uint32 {
+	if last == nil {
+		return 0
+	}
+	return last.Line
+}
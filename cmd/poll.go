@@ -0,0 +1,112 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var pollCmd = &cobra.Command{
+	Use:   "poll [POLLER...]",
+	Short: "Print values read by the agent's configured pollers",
+	Long: `Stream the values parsed by the pollers configured under
+polling.pollers, starting with the latest reading of each.
+
+Without arguments every poller is shown.
+
+Example:
+  seriallink poll
+  seriallink poll boiler-temp
+  seriallink poll --json   # One JSON object per sample`,
+	RunE: runPoll,
+}
+
+func init() {
+	rootCmd.AddCommand(pollCmd)
+
+	pollCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+func runPoll(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	stream, err := client.StreamPolledValues(ctx, &pb.StreamPolledValuesRequest{Pollers: args})
+	if err != nil {
+		return fmt.Errorf("failed to stream polled values: %w", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("poll stream failed: %w", err)
+		}
+
+		sample := resp.Sample
+		polledAt := time.Unix(0, sample.Timestamp)
+		if jsonOutput {
+			values := make(map[string]float64, len(sample.Values))
+			for _, v := range sample.Values {
+				values[v.Name] = v.Value
+			}
+			out := map[string]interface{}{
+				"poller":    sample.Poller,
+				"port":      sample.PortName,
+				"timestamp": polledAt.Format(time.RFC3339Nano),
+				"values":    values,
+			}
+			if sample.Error != "" {
+				out["error"] = sample.Error
+			}
+			_ = encoder.Encode(out)
+			continue
+		}
+
+		if sample.Error != "" {
+			fmt.Printf("%s  %s  error: %s\n", polledAt.Format("15:04:05.000"), sample.Poller, sample.Error)
+			continue
+		}
+		parts := make([]string, 0, len(sample.Values))
+		for _, v := range sample.Values {
+			parts = append(parts, fmt.Sprintf("%s=%g%s", v.Name, v.Value, v.Unit))
+		}
+		fmt.Printf("%s  %s  %s\n", polledAt.Format("15:04:05.000"), sample.Poller, strings.Join(parts, " "))
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var powerCmd = &cobra.Command{
+	Use:   "power PORT STATE [flags]",
+	Short: "Make a session dormant or wake it",
+	Long: `Set the power state of an open session. A dormant session is not read
+or streamed and its buffered data is released; it wakes when the client
+next uses it, on "power PORT active", or when received data matches a wake
+pattern (a regular expression, e.g. "RING").
+
+Example:
+  seriallink power COM1 dormant --session-id 550e8400-...
+  seriallink power COM1 dormant --session-id 550e8400-... --wake "RING" --wake "^ALARM"
+  seriallink power COM1 active --session-id 550e8400-...`,
+	Args:      cobra.ExactArgs(2),
+	ValidArgs: []string{"active", "dormant"},
+	RunE:      runPower,
+}
+
+func init() {
+	rootCmd.AddCommand(powerCmd)
+
+	powerCmd.Flags().String("session-id", "", "session ID")
+	powerCmd.Flags().StringArray("wake", nil, "wake when received data matches this regular expression (repeatable)")
+}
+
+func runPower(cmd *cobra.Command, args []string) error {
+	portName := args[0]
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	wakePatterns, _ := cmd.Flags().GetStringArray("wake")
+
+	state, err := parsePowerState(args[1])
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.SetPowerState(ctx, &pb.SetPowerStateRequest{
+		PortName:     portName,
+		SessionId:    sessionID,
+		State:        state,
+		WakePatterns: wakePatterns,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set power state: %w", err)
+	}
+	if resp.State == pb.PowerState_POWER_STATE_UNSPECIFIED {
+		return fmt.Errorf("failed to set power state: %s", resp.Message)
+	}
+
+	fmt.Printf("%s is %s\n", portName, getPowerStateString(resp.State))
+	return nil
+}
+
+func parsePowerState(s string) (pb.PowerState, error) {
+	switch s {
+	case "active":
+		return pb.PowerState_POWER_STATE_ACTIVE, nil
+	case "dormant":
+		return pb.PowerState_POWER_STATE_DORMANT, nil
+	default:
+		return pb.PowerState_POWER_STATE_UNSPECIFIED, fmt.Errorf("invalid power state %q (use active or dormant)", s)
+	}
+}
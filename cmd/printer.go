@@ -0,0 +1,209 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/Shoaibashk/SerialLink/client"
+	"github.com/spf13/cobra"
+)
+
+var printerCmd = &cobra.Command{
+	Use:   "printer",
+	Short: "Print on an ESC/POS receipt printer",
+	Long: `Print text and images, cut paper and poll the status of an ESC/POS
+receipt printer on an open port.
+
+Example:
+  seriallink printer text COM3 "Thank you!" --align center --size 2 --cut --session-id ID
+  seriallink printer image COM3 logo.png --dither --width 384 --session-id ID
+  seriallink printer cut COM3 --partial --session-id ID
+  seriallink printer status COM3 --session-id ID`,
+}
+
+var printerTextCmd = &cobra.Command{
+	Use:   "text PORT TEXT [flags]",
+	Short: "Print text",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runPrinterText,
+}
+
+var printerImageCmd = &cobra.Command{
+	Use:   "image PORT FILE [flags]",
+	Short: "Print a PNG, JPEG or GIF image",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runPrinterImage,
+}
+
+var printerCutCmd = &cobra.Command{
+	Use:   "cut PORT [flags]",
+	Short: "Feed and cut the paper",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runPrinterCut,
+}
+
+var printerStatusCmd = &cobra.Command{
+	Use:   "status PORT [flags]",
+	Short: "Show the printer status",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runPrinterStatus,
+}
+
+func init() {
+	rootCmd.AddCommand(printerCmd)
+	printerCmd.AddCommand(printerTextCmd, printerImageCmd, printerCutCmd, printerStatusCmd)
+
+	printerCmd.PersistentFlags().String("session-id", "", "session ID")
+
+	printerTextCmd.Flags().Bool("bold", false, "bold text")
+	printerTextCmd.Flags().Bool("underline", false, "underlined text")
+	printerTextCmd.Flags().String("align", "left", "alignment (left, center, right)")
+	printerTextCmd.Flags().Uint32("size", 1, "character magnification (1-8)")
+	printerTextCmd.Flags().String("codepage", "cp437", "printer code page (cp437, cp850, cp858, windows-1252, ...)")
+	printerTextCmd.Flags().Uint32("feed", 0, "lines to feed after the text")
+	printerTextCmd.Flags().Bool("cut", false, "cut the paper afterwards")
+
+	printerImageCmd.Flags().Uint32("width", 576, "maximum width in dots (384 for 58 mm, 576 for 80 mm paper)")
+	printerImageCmd.Flags().Bool("dither", false, "dither instead of thresholding (photos, gradients)")
+	printerImageCmd.Flags().String("align", "left", "alignment (left, center, right)")
+	printerImageCmd.Flags().Bool("cut", false, "cut the paper afterwards")
+
+	printerCutCmd.Flags().Bool("partial", false, "partial cut (leave a tab)")
+	printerCutCmd.Flags().Uint32("feed", 0, "lines to feed before cutting")
+}
+
+func runPrinterText(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	bold, _ := cmd.Flags().GetBool("bold")
+	underline, _ := cmd.Flags().GetBool("underline")
+	align, _ := cmd.Flags().GetString("align")
+	size, _ := cmd.Flags().GetUint32("size")
+	codePage, _ := cmd.Flags().GetString("codepage")
+	feed, _ := cmd.Flags().GetUint32("feed")
+	cut, _ := cmd.Flags().GetBool("cut")
+
+	return sendPrintJob(func(ctx context.Context, c *client.Client) (*pb.PrintResponse, error) {
+		return c.PrintText(ctx, &pb.PrintTextRequest{
+			PortName:  args[0],
+			SessionId: sessionID,
+			Text:      args[1],
+			Bold:      bold,
+			Underline: underline,
+			Align:     align,
+			Size:      size,
+			CodePage:  codePage,
+			FeedLines: feed,
+			Cut:       cut,
+		})
+	})
+}
+
+func runPrinterImage(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	width, _ := cmd.Flags().GetUint32("width")
+	dither, _ := cmd.Flags().GetBool("dither")
+	align, _ := cmd.Flags().GetString("align")
+	cut, _ := cmd.Flags().GetBool("cut")
+
+	image, err := os.ReadFile(args[1])
+	if err != nil {
+		return fmt.Errorf("failed to read image: %w", err)
+	}
+
+	return sendPrintJob(func(ctx context.Context, c *client.Client) (*pb.PrintResponse, error) {
+		return c.PrintRaster(ctx, &pb.PrintRasterRequest{
+			PortName:  args[0],
+			SessionId: sessionID,
+			Image:     image,
+			Width:     width,
+			Dither:    dither,
+			Align:     align,
+			Cut:       cut,
+		})
+	})
+}
+
+func runPrinterCut(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	partial, _ := cmd.Flags().GetBool("partial")
+	feed, _ := cmd.Flags().GetUint32("feed")
+
+	return sendPrintJob(func(ctx context.Context, c *client.Client) (*pb.PrintResponse, error) {
+		return c.CutPaper(ctx, &pb.CutPaperRequest{
+			PortName:  args[0],
+			SessionId: sessionID,
+			Partial:   partial,
+			FeedLines: feed,
+		})
+	})
+}
+
+// sendPrintJob dials the service and runs one printer request
+func sendPrintJob(send func(ctx context.Context, c *client.Client) (*pb.PrintResponse, error)) error {
+	// Raster jobs can take a while at low baud rates
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := send(ctx, client)
+	if err != nil {
+		return fmt.Errorf("print failed: %w", err)
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("print failed: %s", resp.Message)
+	}
+
+	if IsVerbose() {
+		fmt.Printf("Sent %d bytes to the printer\n", resp.BytesWritten)
+	}
+	return nil
+}
+
+func runPrinterStatus(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.GetPrinterStatus(ctx, &pb.GetPrinterStatusRequest{
+		PortName:  args[0],
+		SessionId: sessionID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get printer status: %w", err)
+	}
+
+	fmt.Println(resp.Summary)
+	return nil
+}
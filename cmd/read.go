@@ -23,8 +23,6 @@ import (
 
 	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 var readCmd = &cobra.Command{
@@ -59,14 +57,11 @@ func runRead(cmd *cobra.Command, args []string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout+2000)*time.Millisecond)
 	defer cancel()
 
-	addr := GetAddress()
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	client, err := dialService()
 	if err != nil {
-		return fmt.Errorf("failed to connect to service at %s: %w", addr, err)
+		return err
 	}
-	defer conn.Close()
-
-	client := pb.NewSerialServiceClient(conn)
+	defer client.Close()
 
 	resp, err := client.Read(ctx, &pb.ReadRequest{
 		PortName:  portName,
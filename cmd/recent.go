@@ -0,0 +1,77 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var recentCmd = &cobra.Command{
+	Use:   "recent PORT [flags]",
+	Short: "Show recent console output of a port",
+	Long: `Show what a console-logged port printed recently, including output
+from before any client connected (e.g. a device boot log).
+
+The port must be listed under console.ports in the agent configuration.
+
+Example:
+  seriallink recent /dev/ttyUSB0                 # Everything buffered
+  seriallink recent /dev/ttyUSB0 --max-bytes 512 # Last 512 bytes`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRecent,
+}
+
+func init() {
+	rootCmd.AddCommand(recentCmd)
+
+	recentCmd.Flags().Uint32("max-bytes", 0, "maximum bytes to show (0 for all buffered output)")
+}
+
+func runRecent(cmd *cobra.Command, args []string) error {
+	portName := args[0]
+	maxBytes, _ := cmd.Flags().GetUint32("max-bytes")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.GetRecentOutput(ctx, &pb.GetRecentOutputRequest{
+		PortName: portName,
+		MaxBytes: maxBytes,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get recent output: %w", err)
+	}
+
+	fmt.Print(string(resp.Data))
+
+	if IsVerbose() {
+		fmt.Printf("\nShowing %d of %d bytes received\n", len(resp.Data), resp.TotalBytes)
+	}
+
+	return nil
+}
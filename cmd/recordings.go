@@ -0,0 +1,178 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var recordingsCmd = &cobra.Command{
+	Use:   "recordings",
+	Short: "List recorded interactive sessions",
+	Long: `List the interactive sessions recorded by the agent
+(console.recording.enabled), newest first, with the user who ran each one.
+Recordings are asciicast v2 files; fetch one and replay it with
+"asciinema play" or asciinema-player.
+
+Example:
+  seriallink recordings
+  seriallink recordings --user spiffe://example.org/ops/alice
+  seriallink recordings fetch alice/dev_ttyUSB0-20250101T120000-....cast`,
+	Args: cobra.NoArgs,
+	RunE: runRecordings,
+}
+
+var recordingsFetchCmd = &cobra.Command{
+	Use:   "fetch RECORDING_ID",
+	Short: "Download a session recording",
+	Long: `Download a session recording to a file, named after the recording
+unless --output is given ("-" for standard output).
+
+Example:
+  seriallink recordings fetch alice/dev_ttyUSB0-20250101T120000-....cast
+  seriallink recordings fetch alice/... -o - | asciinema play -`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRecordingsFetch,
+}
+
+func init() {
+	rootCmd.AddCommand(recordingsCmd)
+	recordingsCmd.AddCommand(recordingsFetchCmd)
+
+	recordingsCmd.Flags().String("user", "", "only show recordings of this user")
+	recordingsCmd.Flags().String("port", "", "only show recordings of this port")
+	recordingsCmd.Flags().Duration("since", 0, "only show recordings started within this long (e.g. 24h)")
+	recordingsCmd.Flags().Uint32("limit", 0, "maximum recordings to show (0 for all)")
+	recordingsCmd.Flags().Bool("json", false, "output in JSON format")
+
+	recordingsFetchCmd.Flags().StringP("output", "o", "", `output file ("-" for standard output)`)
+}
+
+func runRecordings(cmd *cobra.Command, args []string) error {
+	user, _ := cmd.Flags().GetString("user")
+	portName, _ := cmd.Flags().GetString("port")
+	since, _ := cmd.Flags().GetDuration("since")
+	limit, _ := cmd.Flags().GetUint32("limit")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := &pb.ListRecordingsRequest{User: user, PortName: portName, Limit: limit}
+	if since > 0 {
+		req.Since = time.Now().Add(-since).UnixNano()
+	}
+	resp, err := client.ListRecordings(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to list recordings: %w", err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp)
+	}
+
+	if len(resp.Recordings) == 0 {
+		fmt.Println("No recordings")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "STARTED\tUSER\tPORT\tDURATION\tSIZE\tID")
+	fmt.Fprintln(w, "-------\t----\t----\t--------\t----\t--")
+	for _, r := range resp.Recordings {
+		duration := (time.Duration(r.DurationMs) * time.Millisecond).Round(time.Second).String()
+		if r.Active {
+			duration += " (active)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			time.Unix(0, r.StartedAt).Format("2006-01-02 15:04:05"),
+			r.User, r.PortName, duration, formatBytes(int64(r.SizeBytes)), r.Id)
+	}
+	return w.Flush()
+}
+
+func runRecordingsFetch(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	output, _ := cmd.Flags().GetString("output")
+	if output == "" {
+		output = path.Base(id)
+	}
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	stream, err := client.FetchRecording(ctx, &pb.FetchRecordingRequest{Id: id})
+	if err != nil {
+		return fmt.Errorf("failed to fetch recording: %w", err)
+	}
+
+	var out io.Writer = os.Stdout
+	if output != "-" {
+		file, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0o644)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	var total int64
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to fetch recording: %w", err)
+		}
+		if _, err := out.Write(resp.Data); err != nil {
+			return err
+		}
+		total += int64(len(resp.Data))
+	}
+
+	if output != "-" {
+		fmt.Printf("Saved %s (%s)\n", output, formatBytes(total))
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var reservationsCmd = &cobra.Command{
+	Use:   "reservations",
+	Short: "List port reservations",
+	Long: `List current and upcoming port reservations. During a reservation only
+its holder may open the port (reservations.enabled in the agent config).
+
+Example:
+  seriallink reservations
+  seriallink reservations --port COM1
+  seriallink reservations create COM1 --start 2025-12-21T09:00:00Z --duration 2h --note "firmware soak"
+  seriallink reservations cancel 6f1c2a9e-...`,
+	Args: cobra.NoArgs,
+	RunE: runReservations,
+}
+
+var reservationsCreateCmd = &cobra.Command{
+	Use:   "create PORT",
+	Short: "Reserve a port for a time window",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runReservationsCreate,
+}
+
+var reservationsCancelCmd = &cobra.Command{
+	Use:   "cancel RESERVATION_ID",
+	Short: "Cancel a reservation you hold or created",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runReservationsCancel,
+}
+
+func init() {
+	rootCmd.AddCommand(reservationsCmd)
+	reservationsCmd.AddCommand(reservationsCreateCmd)
+	reservationsCmd.AddCommand(reservationsCancelCmd)
+
+	reservationsCmd.Flags().String("port", "", "only list reservations of this port")
+	reservationsCmd.Flags().Bool("json", false, "output in JSON format")
+
+	reservationsCreateCmd.Flags().String("start", "", "window start (RFC 3339; default now)")
+	reservationsCreateCmd.Flags().String("end", "", "window end (RFC 3339)")
+	reservationsCreateCmd.Flags().Duration("duration", 0, "window length, instead of --end (e.g. 2h)")
+	reservationsCreateCmd.Flags().String("holder", "", "identity or group:NAME that may open the port (default: your identity)")
+	reservationsCreateCmd.Flags().String("note", "", "note shown to other users")
+}
+
+func runReservations(cmd *cobra.Command, args []string) error {
+	portName, _ := cmd.Flags().GetString("port")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.ListReservations(ctx, &pb.ListReservationsRequest{PortName: portName})
+	if err != nil {
+		return fmt.Errorf("failed to list reservations: %w", err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp.Reservations)
+	}
+
+	if len(resp.Reservations) == 0 {
+		fmt.Println("No reservations")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tPORT\tHOLDER\tSTART\tEND\tACTIVE\tNOTE")
+	fmt.Fprintln(w, "--\t----\t------\t-----\t---\t------\t----")
+	for _, r := range resp.Reservations {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%v\t%s\n",
+			r.ReservationId, r.PortName, r.Holder,
+			time.Unix(0, r.StartTime).Format("2006-01-02 15:04"),
+			time.Unix(0, r.EndTime).Format("2006-01-02 15:04"),
+			r.Active, r.Note)
+	}
+	return w.Flush()
+}
+
+func runReservationsCreate(cmd *cobra.Command, args []string) error {
+	start, _ := cmd.Flags().GetString("start")
+	end, _ := cmd.Flags().GetString("end")
+	duration, _ := cmd.Flags().GetDuration("duration")
+	holder, _ := cmd.Flags().GetString("holder")
+	note, _ := cmd.Flags().GetString("note")
+
+	startTime := time.Now()
+	if start != "" {
+		parsed, err := time.Parse(time.RFC3339, start)
+		if err != nil {
+			return fmt.Errorf("invalid --start time: %w", err)
+		}
+		startTime = parsed
+	}
+
+	var endTime time.Time
+	switch {
+	case end != "" && duration > 0:
+		return fmt.Errorf("use either --end or --duration")
+	case end != "":
+		parsed, err := time.Parse(time.RFC3339, end)
+		if err != nil {
+			return fmt.Errorf("invalid --end time: %w", err)
+		}
+		endTime = parsed
+	case duration > 0:
+		endTime = startTime.Add(duration)
+	default:
+		return fmt.Errorf("--end or --duration is required")
+	}
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.CreateReservation(ctx, &pb.CreateReservationRequest{
+		PortName:  args[0],
+		Holder:    holder,
+		StartTime: startTime.UnixNano(),
+		EndTime:   endTime.UnixNano(),
+		Note:      note,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reserve port: %w", err)
+	}
+
+	r := resp.Reservation
+	fmt.Printf("Reserved %s for %s from %s to %s\n", r.PortName, r.Holder,
+		time.Unix(0, r.StartTime).Format(time.RFC3339),
+		time.Unix(0, r.EndTime).Format(time.RFC3339))
+	fmt.Printf("Reservation ID: %s\n", r.ReservationId)
+	return nil
+}
+
+func runReservationsCancel(cmd *cobra.Command, args []string) error {
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.CancelReservation(ctx, &pb.CancelReservationRequest{
+		ReservationId: args[0],
+	})
+	if err != nil {
+		return fmt.Errorf("failed to cancel reservation: %w", err)
+	}
+
+	fmt.Printf("Cancelled reservation of %s held by %s\n", resp.Reservation.PortName, resp.Reservation.Holder)
+	return nil
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var resetCmd = &cobra.Command{
+	Use:   "reset PORT [flags]",
+	Short: "Reset the device on a port via GPIO",
+	Long: `Reset the device attached to a port by pulsing the GPIO line wired to
+its reset pin.
+
+The line must be listed under gpio.reset_lines in the agent configuration.
+
+Example:
+  seriallink reset /dev/ttyUSB0              # Pulse for the configured time
+  seriallink reset /dev/ttyUSB0 --pulse 500  # Hold reset for 500ms`,
+	Args: cobra.ExactArgs(1),
+	RunE: runReset,
+}
+
+func init() {
+	rootCmd.AddCommand(resetCmd)
+
+	resetCmd.Flags().Uint32("pulse", 0, "pulse length in milliseconds (0 for the configured length)")
+}
+
+func runReset(cmd *cobra.Command, args []string) error {
+	portName := args[0]
+	pulseMs, _ := cmd.Flags().GetUint32("pulse")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second+time.Duration(pulseMs)*time.Millisecond)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.ResetTarget(ctx, &pb.ResetTargetRequest{
+		PortName: portName,
+		PulseMs:  pulseMs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reset target: %w", err)
+	}
+
+	if !resp.Success {
+		return fmt.Errorf("failed to reset target: %s", resp.Message)
+	}
+
+	fmt.Printf("Reset %s (%s)\n", portName, resp.Message)
+	return nil
+}
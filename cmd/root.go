@@ -21,10 +21,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/Shoaibashk/SerialLink/client"
 	"github.com/Shoaibashk/SerialLink/config"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"golang.org/x/term"
 )
 
 var (
@@ -45,6 +48,26 @@ var (
 
 	// address is the gRPC service address
 	address string
+
+	// sshTarget tunnels the gRPC connection through an SSH server
+	sshTarget string
+
+	// sshKeyFile is the private key used for the SSH tunnel
+	sshKeyFile string
+
+	// sshInsecure skips SSH host key verification
+	sshInsecure bool
+
+	// spiffeSocket enables mutual TLS using a SPIFFE Workload API
+	spiffeSocket string
+
+	// spiffeServerID is the expected SPIFFE ID of the agent
+	spiffeServerID string
+
+	// apiToken is sent to agents that require token authentication
+	apiToken string
+	// apiUser logs in to agents authenticating against LDAP
+	apiUser string
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -67,7 +90,8 @@ Features:
 Example usage:
   seriallink serve                    Start the gRPC server
   seriallink scan                     List available serial ports
-  seriallink version                  Show version information`,
+  seriallink version                  Show version information
+  seriallink scan --ssh pi@lab-gw     List ports on an agent reachable over SSH`,
 	SilenceUsage:  true,
 	SilenceErrors: true,
 }
@@ -88,14 +112,32 @@ func init() {
 	// Global flags
 	rootCmd.PersistentFlags().StringVarP(&cfgFile, "config", "c", "", "config file (default: $HOME/.seriallink/config.yaml)")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "enable verbose output")
-	rootCmd.PersistentFlags().StringVar(&address, "address", "localhost:50051", "gRPC service address (can also be set via SERIALLINK_ADDRESS env var)")
+	rootCmd.PersistentFlags().StringVar(&address, "address", "localhost:50051", "gRPC service address, comma-separated addresses of redundant agents, or srv://_seriallink._tcp.example.com to discover agents via DNS SRV (can also be set via SERIALLINK_ADDRESS env var)")
+	rootCmd.PersistentFlags().StringVar(&sshTarget, "ssh", "", "tunnel through SSH server [user@]host[:port]; --address is then resolved on that host")
+	rootCmd.PersistentFlags().StringVar(&sshKeyFile, "ssh-key", "", "SSH private key (default: ssh-agent, then ~/.ssh/id_*)")
+	rootCmd.PersistentFlags().BoolVar(&sshInsecure, "ssh-insecure", false, "skip SSH host key verification")
+	rootCmd.PersistentFlags().StringVar(&spiffeSocket, "spiffe-socket", "", "connect with a SPIFFE SVID from this Workload API socket (e.g. unix:///run/spire/agent.sock)")
+	rootCmd.PersistentFlags().StringVar(&spiffeServerID, "spiffe-server-id", "", "expected SPIFFE ID of the agent (default: any ID in the same trust domain)")
+	rootCmd.PersistentFlags().StringVar(&apiToken, "token", "", "API token for agents with auth enabled (can also be set via SERIALLINK_TOKEN env var)")
+	rootCmd.PersistentFlags().StringVar(&apiUser, "user", "", "username for agents authenticating against LDAP; the password is read from SERIALLINK_PASSWORD or prompted for (can also be set via SERIALLINK_USER env var)")
 
 	// Bind flags to viper
 	_ = viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose"))
 	_ = viper.BindPFlag("address", rootCmd.PersistentFlags().Lookup("address"))
+	_ = viper.BindPFlag("ssh", rootCmd.PersistentFlags().Lookup("ssh"))
+	_ = viper.BindPFlag("ssh_key", rootCmd.PersistentFlags().Lookup("ssh-key"))
+	_ = viper.BindPFlag("spiffe_socket", rootCmd.PersistentFlags().Lookup("spiffe-socket"))
+	_ = viper.BindPFlag("token", rootCmd.PersistentFlags().Lookup("token"))
+	_ = viper.BindPFlag("user", rootCmd.PersistentFlags().Lookup("user"))
 
 	// Bind environment variables
 	_ = viper.BindEnv("address", "SERIALLINK_ADDRESS")
+	_ = viper.BindEnv("ssh", "SERIALLINK_SSH")
+	_ = viper.BindEnv("ssh_key", "SERIALLINK_SSH_KEY")
+	_ = viper.BindEnv("spiffe_socket", "SERIALLINK_SPIFFE_SOCKET")
+	_ = viper.BindEnv("token", "SERIALLINK_TOKEN")
+	_ = viper.BindEnv("user", "SERIALLINK_USER")
+	_ = viper.BindEnv("password", "SERIALLINK_PASSWORD")
 }
 
 // initConfig reads in config file and ENV variables if set
@@ -119,6 +161,41 @@ func IsVerbose() bool {
 	return verbose || viper.GetBool("verbose")
 }
 
+// dialService connects to the agent, tunneling through SSH, using SPIFFE
+// mutual TLS and sending an API token or username and password when
+// requested
+func dialService() (*client.Client, error) {
+	opts := client.Options{Token: viper.GetString("token")}
+	if user := viper.GetString("user"); user != "" && opts.Token == "" {
+		password, err := apiPassword(user)
+		if err != nil {
+			return nil, err
+		}
+		opts.Username, opts.Password = user, password
+	}
+
+	if target := viper.GetString("ssh"); target != "" {
+		opts.SSH = &client.SSHOptions{
+			Target:                target,
+			KeyFile:               viper.GetString("ssh_key"),
+			InsecureIgnoreHostKey: sshInsecure,
+		}
+	}
+
+	if socket := viper.GetString("spiffe_socket"); socket != "" {
+		opts.SPIFFE = &client.SPIFFEOptions{SocketPath: socket}
+		if spiffeServerID != "" {
+			opts.SPIFFE.ServerIDs = []string{spiffeServerID}
+		}
+	}
+
+	// Comma-separated addresses fail over between redundant agents
+	if addresses := strings.Split(GetAddress(), ","); len(addresses) > 1 {
+		return client.DialFailover(addresses, opts, client.FailoverOptions{})
+	}
+	return client.Dial(GetAddress(), opts)
+}
+
 // GetAddress returns the gRPC service address
 func GetAddress() string {
 	addr := viper.GetString("address")
@@ -127,3 +204,22 @@ func GetAddress() string {
 	}
 	return addr
 }
+
+// apiPassword returns the password of the --user login from
+// SERIALLINK_PASSWORD, or prompts for it on a terminal
+func apiPassword(user string) (string, error) {
+	if password := viper.GetString("password"); password != "" {
+		return password, nil
+	}
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", fmt.Errorf("no password for %s: set SERIALLINK_PASSWORD", user)
+	}
+	fmt.Fprintf(os.Stderr, "Password for %s: ", user)
+	password, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("failed to read password: %w", err)
+	}
+	return string(password), nil
+}
@@ -21,13 +21,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 	"time"
 
 	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 var scanCmd = &cobra.Command{
@@ -63,20 +62,20 @@ func runScan(cmd *cobra.Command, args []string) error {
 	defer cancel()
 
 	// Connect to the gRPC service
-	addr := GetAddress()
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	client, err := dialService()
 	if err != nil {
-		return fmt.Errorf("failed to connect to service at %s: %w", addr, err)
+		return err
 	}
-	defer conn.Close()
-
-	client := pb.NewSerialServiceClient(conn)
+	defer client.Close()
 
 	// List ports
 	resp, err := client.ListPorts(ctx, &pb.ListPortsRequest{})
 	if err != nil {
 		return fmt.Errorf("failed to list ports: %w", err)
 	}
+	if resp.Partial {
+		fmt.Fprintf(os.Stderr, "Warning: scan incomplete, ports of %s may be missing (timed out or failed)\n", strings.Join(resp.IncompleteProviders, ", "))
+	}
 
 	if len(resp.Ports) == 0 {
 		if jsonOutput {
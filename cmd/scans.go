@@ -0,0 +1,117 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var scansCmd = &cobra.Command{
+	Use:   "scans PORT [flags]",
+	Short: "Print barcodes read by a scanner on a port",
+	Long: `Treat an open port as a barcode scanner and print one line per scan
+until interrupted.
+
+Flags override the port's entry in serial.scanner_profiles.
+
+Example:
+  seriallink scans /dev/ttyACM0 --session-id ID
+  seriallink scans COM4 --suffix "#" --debounce 500 --session-id ID
+  seriallink scans COM4 --json --session-id ID   # One JSON object per scan`,
+	Args: cobra.ExactArgs(1),
+	RunE: runScans,
+}
+
+func init() {
+	rootCmd.AddCommand(scansCmd)
+
+	scansCmd.Flags().String("session-id", "", "session ID")
+	scansCmd.Flags().String("terminators", "", "bytes that end a scan (default CR and LF)")
+	scansCmd.Flags().String("prefix", "", "prefix to strip from scans")
+	scansCmd.Flags().String("suffix", "", "suffix to strip from scans")
+	scansCmd.Flags().Uint32("debounce", 0, "drop repeats of the same code within this many milliseconds")
+	scansCmd.Flags().Uint32("idle", 0, "end a scan after this many milliseconds without input")
+	scansCmd.Flags().Uint32("min-length", 0, "drop scans shorter than this")
+	scansCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+func runScans(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	terminators, _ := cmd.Flags().GetString("terminators")
+	prefix, _ := cmd.Flags().GetString("prefix")
+	suffix, _ := cmd.Flags().GetString("suffix")
+	debounce, _ := cmd.Flags().GetUint32("debounce")
+	idle, _ := cmd.Flags().GetUint32("idle")
+	minLength, _ := cmd.Flags().GetUint32("min-length")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	stream, err := client.StreamScans(ctx, &pb.StreamScansRequest{
+		PortName:    args[0],
+		SessionId:   sessionID,
+		Terminators: terminators,
+		Prefix:      prefix,
+		Suffix:      suffix,
+		DebounceMs:  debounce,
+		IdleMs:      idle,
+		MinLength:   minLength,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stream scans: %w", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("scan stream failed: %w", err)
+		}
+
+		scan := resp.Scan
+		scannedAt := time.Unix(0, scan.Timestamp)
+		if jsonOutput {
+			_ = encoder.Encode(map[string]interface{}{
+				"data":      scan.Data,
+				"timestamp": scannedAt.Format(time.RFC3339Nano),
+				"sequence":  scan.Sequence,
+			})
+			continue
+		}
+		fmt.Printf("%s  %s\n", scannedAt.Format("15:04:05.000"), scan.Data)
+	}
+}
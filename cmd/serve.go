@@ -21,15 +21,42 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strings"
+	"sync"
 	"syscall"
+	"time"
 
 	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
 	"github.com/Shoaibashk/SerialLink/api"
 	"github.com/Shoaibashk/SerialLink/config"
+	"github.com/Shoaibashk/SerialLink/internal/acl"
+	"github.com/Shoaibashk/SerialLink/internal/actions"
+	"github.com/Shoaibashk/SerialLink/internal/alarm"
+	"github.com/Shoaibashk/SerialLink/internal/auth"
+	"github.com/Shoaibashk/SerialLink/internal/bus"
+	"github.com/Shoaibashk/SerialLink/internal/chain"
+	"github.com/Shoaibashk/SerialLink/internal/console"
+	"github.com/Shoaibashk/SerialLink/internal/debug"
+	"github.com/Shoaibashk/SerialLink/internal/devicestate"
+	"github.com/Shoaibashk/SerialLink/internal/history"
+	"github.com/Shoaibashk/SerialLink/internal/inputguard"
+	"github.com/Shoaibashk/SerialLink/internal/metrics"
+	"github.com/Shoaibashk/SerialLink/internal/mqtt"
+	"github.com/Shoaibashk/SerialLink/internal/overload"
+	"github.com/Shoaibashk/SerialLink/internal/poller"
+	"github.com/Shoaibashk/SerialLink/internal/reservation"
+	"github.com/Shoaibashk/SerialLink/internal/retention"
 	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/Shoaibashk/SerialLink/internal/sessionsummary"
+	"github.com/Shoaibashk/SerialLink/internal/storage"
+	"github.com/Shoaibashk/SerialLink/internal/testrunner"
+	"github.com/Shoaibashk/SerialLink/internal/tlsutil"
+	"github.com/Shoaibashk/SerialLink/internal/usage"
+	"github.com/Shoaibashk/SerialLink/internal/writepolicy"
 	"github.com/charmbracelet/log"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -66,6 +93,7 @@ func init() {
 	serveCmd.Flags().String("cert", "", "TLS certificate file")
 	serveCmd.Flags().String("key", "", "TLS key file")
 	serveCmd.Flags().Bool("reflection", true, "enable gRPC reflection")
+	serveCmd.Flags().String("rest-address", "", "serve the REST gateway on this address (e.g. 0.0.0.0:8082)")
 
 	// Bind flags to viper with error logging
 	if err := viper.BindPFlag("server.grpc_address", serveCmd.Flags().Lookup("address")); err != nil {
@@ -96,6 +124,13 @@ func runServe(cmd *cobra.Command, args []string) error {
 	if addr, _ := cmd.Flags().GetString("address"); addr != "" {
 		cfg.Server.GRPCAddress = addr
 	}
+	if addr, _ := cmd.Flags().GetString("rest-address"); addr != "" {
+		if err := config.ValidateListenAddress(cfg.Server.Network, addr); err != nil {
+			return fmt.Errorf("invalid --rest-address: %w", err)
+		}
+		cfg.Server.RESTEnabled = true
+		cfg.Server.RESTAddress = addr
+	}
 
 	logger.Info("Starting SerialLink server",
 		"version", Version,
@@ -103,7 +138,7 @@ func runServe(cmd *cobra.Command, args []string) error {
 		"tls", cfg.TLS.Enabled)
 
 	// Validate TLS certificates if TLS is enabled
-	if cfg.TLS.Enabled {
+	if cfg.TLS.Enabled && !cfg.TLS.ACME.Enabled && !cfg.TLS.SPIFFE.Enabled {
 		if err := validateTLSConfig(cfg.TLS, logger); err != nil {
 			return fmt.Errorf("TLS validation failed: %w", err)
 		}
@@ -115,7 +150,19 @@ func runServe(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to build serial defaults: %w", err)
 	}
 
+	// Secrets in traffic are masked wherever it is logged or stored
+	redactor, err := cfg.Redaction.ToRedactor()
+	if err != nil {
+		return fmt.Errorf("failed to build redaction rules: %w", err)
+	}
+
 	manager := serial.NewManager(cfg.Serial.AllowSharedAccess, defaultSerialConfig)
+	manager.SetLineQualityMonitoring(cfg.Serial.LineQualityMonitoring)
+	manager.SetMemoryLimits(cfg.Memory.ToLimits())
+	manager.SetRetryPolicy(cfg.Serial.Retry.ToPolicy())
+	if cfg.Serial.ShortSessionIDs {
+		manager.SetShortIDGenerator(serial.DefaultShortID)
+	}
 	defer manager.CloseAll()
 
 	// Create scanner
@@ -123,22 +170,398 @@ func runServe(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to create scanner: %w", err)
 	}
+	scanner.SetRemotePorts(cfg.Serial.RemotePorts)
+	scanner.SetScanTimeouts(cfg.Serial.ScanTimeouts())
+
+	var extraProfiles []serial.DeviceProfile
+	for _, profile := range cfg.Serial.DeviceProfiles {
+		extraProfiles = append(extraProfiles, profile.ToDeviceProfile())
+	}
+	scanner.SetDeviceDatabase(serial.NewDeviceDatabase(extraProfiles))
+
+	// Upload finished files (rotated console logs, recordings, session
+	// summaries) off the device
+	var shipper *storage.Shipper
+	if cfg.Storage.Type != "" {
+		backend, err := storage.New(cfg.Storage.ToOptions())
+		if err != nil {
+			return fmt.Errorf("failed to set up storage: %w", err)
+		}
+		prefix := cfg.Storage.Prefix
+		if prefix == "" {
+			prefix, _ = os.Hostname()
+		}
+		shipper = storage.NewShipper(backend, prefix, cfg.Storage.DeleteAfterUpload, logger)
+
+		shipperCtx, stopShipper := context.WithCancel(context.Background())
+		shipperDone := make(chan struct{})
+		go func() {
+			defer close(shipperDone)
+			shipper.Run(shipperCtx)
+		}()
+		defer func() {
+			stopShipper()
+			<-shipperDone
+		}()
+		logger.Info("uploading agent files", "destination", backend.Name(), "prefix", prefix)
+	}
+
+	// Summarize sessions when they close
+	if cfg.SessionSummary.Enabled {
+		manager.SetTrafficCapture(cfg.SessionSummary.Lines)
+
+		var skip []string
+		if !cfg.SessionSummary.IncludeAgentSessions {
+			skip = []string{console.ClientID, poller.ClientID, actions.ClientID, devicestate.ClientID}
+		}
+		summaries := sessionsummary.NewCollector(manager, skip, logger)
+		summaries.SetRedactor(redactor)
+		if cfg.SessionSummary.WebhookURL != "" {
+			summaries.AddNotifier(sessionsummary.WebhookNotifier{URL: cfg.SessionSummary.WebhookURL})
+		}
+		if cfg.SessionSummary.SaveFiles {
+			files := sessionsummary.FileNotifier{Dir: configRelativeDir(cfg.SessionSummary.Directory, "sessions")}
+			if shipper != nil && cfg.Storage.SessionSummaries {
+				files.Upload = func(path string) { shipper.Ship(path, "sessions") }
+			}
+			summaries.AddNotifier(files)
+		}
+
+		summariesCtx, stopSummaries := context.WithCancel(context.Background())
+		summariesDone := make(chan struct{})
+		go func() {
+			defer close(summariesDone)
+			summaries.Run(summariesCtx)
+		}()
+		defer func() {
+			stopSummaries()
+			<-summariesDone
+		}()
+	}
+
+	// Account port use per client
+	var ledger *usage.Ledger
+	if cfg.Usage.Enabled {
+		var skip []string
+		if !cfg.Usage.IncludeAgentSessions {
+			skip = []string{console.ClientID, poller.ClientID, actions.ClientID, devicestate.ClientID}
+		}
+		ledger, err = usage.Open(manager, configRelativeDir(cfg.Usage.File, "usage.json"), skip, logger)
+		if err != nil {
+			return err
+		}
+
+		usageCtx, stopUsage := context.WithCancel(context.Background())
+		usageDone := make(chan struct{})
+		go func() {
+			defer close(usageDone)
+			ledger.Run(usageCtx)
+		}()
+		defer func() {
+			stopUsage()
+			<-usageDone
+		}()
+		logger.Info("usage accounting enabled", "since", ledger.Since().Format(time.RFC3339))
+	}
+
+	// Start console loggers for ports configured for boot log capture
+	var compliance *console.ComplianceOptions
+	if cfg.Console.Compliance.Enabled {
+		key, err := chain.LoadPrivateKey(cfg.Console.Compliance.SigningKey)
+		if err != nil {
+			return fmt.Errorf("failed to load console compliance key: %w", err)
+		}
+		compliance = &console.ComplianceOptions{
+			Key:                key,
+			CheckpointInterval: time.Duration(cfg.Console.Compliance.CheckpointInterval) * time.Second,
+		}
+	}
+	crashDumps, err := cfg.Console.CrashDumps.ToOptions(configRelativeDir(cfg.Console.CrashDumps.Directory, "crashes"))
+	if err != nil {
+		return err
+	}
+	if crashDumps != nil {
+		crashDumps.Notify = func(dump console.CrashDump) {
+			if payload, err := dump.Marshal(); err == nil {
+				manager.EmitPortEvent(dump.PortName, serial.PortEventCrashDump, string(payload))
+			}
+			if shipper != nil && cfg.Storage.ConsoleLogs {
+				shipper.Ship(dump.Path, "crashes")
+			}
+			if url := cfg.Console.CrashDumps.WebhookURL; url != "" {
+				if err := console.PostCrashDump(context.Background(), url, dump); err != nil {
+					logger.Warn("failed to send crash dump notification", "port", dump.PortName, "error", err)
+				}
+			}
+		}
+	}
+
+	var collector *console.Collector
+	newConsoleOptions := func(portName string, config serial.PortConfig) console.Options {
+		opts := consolePortOptions(cfg, portName, config)
+		opts.Redactor = redactor
+		opts.Compliance = compliance
+		opts.CrashDumps = crashDumps
+		if shipper != nil && cfg.Storage.ConsoleLogs {
+			opts.Upload = func(path string) { shipper.Ship(path, "console") }
+		}
+		return opts
+	}
+	if len(cfg.Console.Ports) > 0 || portActionsLogConsole(cfg) {
+		consoleCtx, stopConsole := context.WithCancel(context.Background())
+		var opts []console.Options
+		for _, port := range cfg.Console.Ports {
+			portConfig := defaultSerialConfig
+			if port.BaudRate > 0 {
+				portConfig.BaudRate = port.BaudRate
+			}
+			opts = append(opts, newConsoleOptions(port.Name, portConfig))
+		}
+		collector = console.NewCollector(manager, opts, logger)
+		collector.Start(consoleCtx)
+		defer func() {
+			stopConsole()
+			collector.Wait()
+		}()
+	}
+
+	var publisher *mqtt.Publisher
+	if cfg.MQTT.Enabled {
+		publisher = mqtt.Connect(cfg.MQTT.ToOptions(), logger)
+		defer publisher.Close()
+	}
+
+	// Act on matching ports as they appear
+	if len(cfg.PortActions) > 0 {
+		portActions := make([]actions.Action, 0, len(cfg.PortActions))
+		for _, a := range cfg.PortActions {
+			action, err := a.ToAction()
+			if err != nil {
+				return fmt.Errorf("invalid port action %q: %w", a.Name, err)
+			}
+			portActions = append(portActions, action)
+		}
+		runner := actions.NewRunner(manager, scanner, portActions, defaultSerialConfig, logger)
+		if collector != nil {
+			runner.SetConsole(collector, newConsoleOptions)
+		}
+		if publisher != nil {
+			runner.SetPublisher(publisher, cfg.MQTT.TopicPrefix)
+		}
+
+		actionsCtx, stopActions := context.WithCancel(context.Background())
+		actionsDone := make(chan struct{})
+		go func() {
+			defer close(actionsDone)
+			runner.Run(actionsCtx, cfg.Serial.ScanInterval)
+		}()
+		defer func() {
+			stopActions()
+			<-actionsDone
+		}()
+		logger.Info("port actions enabled", "actions", len(portActions))
+	}
+
+	// Start declarative device polls
+	metricsRegistry := metrics.NewRegistry()
+	polling, err := startPolling(cfg, manager, publisher, logger)
+	if err != nil {
+		return err
+	}
+	defer polling.Stop()
+	if polling.engine != nil {
+		metricsRegistry.Register(polling.engine)
+	}
+	if collector != nil {
+		metricsRegistry.Register(collector)
+	}
+	metricsRegistry.Register(manager)
+	rpcMetrics := api.NewRPCMetrics()
+	metricsRegistry.Register(rpcMetrics)
+
+	// Keep agent files within their disk limits
+	if cfg.Retention.Enabled {
+		retentionManager := retention.NewManager(retentionTargets(cfg, collector, polling.history), int64(cfg.Retention.MaxTotalMB)*1024*1024,
+			time.Duration(cfg.Retention.CheckInterval)*time.Second, logger)
+		if publisher != nil {
+			retentionManager.AddNotifier(retention.MQTTNotifier{Publisher: publisher, Prefix: cfg.MQTT.TopicPrefix})
+		}
+		metricsRegistry.Register(retentionManager)
+
+		retentionCtx, stopRetention := context.WithCancel(context.Background())
+		retentionDone := make(chan struct{})
+		go func() {
+			defer close(retentionDone)
+			retentionManager.Run(retentionCtx)
+		}()
+		defer func() {
+			stopRetention()
+			<-retentionDone
+		}()
+	}
+
+	// Track device states from their output
+	var tracker *devicestate.Tracker
+	if len(cfg.Devices) > 0 {
+		defs := make([]devicestate.Definition, 0, len(cfg.Devices))
+		for _, d := range cfg.Devices {
+			def, err := d.ToDefinition(cfg.Serial.Defaults)
+			if err != nil {
+				return fmt.Errorf("invalid device %q: %w", d.Name, err)
+			}
+			defs = append(defs, def)
+		}
+		tracker = devicestate.NewTracker(manager, defs, logger)
+		if publisher != nil {
+			tracker.SetPublisher(publisher, cfg.MQTT.TopicPrefix)
+		}
+
+		trackerCtx, stopTracker := context.WithCancel(context.Background())
+		tracker.Start(trackerCtx)
+		defer func() {
+			stopTracker()
+			tracker.Wait()
+		}()
+		logger.Info("device state tracking enabled", "devices", len(defs))
+	}
 
-	// Create gRPC server options with logging interceptors
+	// Run end-of-line test suites on request and when ports are opened
+	var testRunner *testrunner.Runner
+	if len(cfg.Testing.Suites) > 0 {
+		suites := make([]testrunner.Suite, 0, len(cfg.Testing.Suites))
+		for _, t := range cfg.Testing.Suites {
+			suite, err := t.ToSuite()
+			if err != nil {
+				return fmt.Errorf("invalid test suite %q: %w", t.Name, err)
+			}
+			suites = append(suites, suite)
+		}
+		writer := &testrunner.ReportWriter{
+			Dir:     configRelativeDir(cfg.Testing.ReportDirectory, "test-reports"),
+			Formats: cfg.Testing.Formats(),
+		}
+		testRunner = testrunner.NewRunner(manager, suites, writer, logger)
+
+		testsCtx, stopTests := context.WithCancel(context.Background())
+		testRunner.Start(testsCtx)
+		defer func() {
+			stopTests()
+			testRunner.Wait()
+		}()
+		logger.Info("test suites enabled", "suites", len(suites), "reports", writer.Dir)
+	}
+
+	// Resolve real client addresses behind load balancers
+	addressResolver, err := api.NewClientAddressResolver(cfg.Server.TrustedProxies, cfg.Server.TrustForwardedFor)
+	if err != nil {
+		return fmt.Errorf("invalid trusted proxies: %w", err)
+	}
+	if (cfg.Server.ProxyProtocol || cfg.Server.TrustForwardedFor) && len(cfg.Server.TrustedProxies) == 0 {
+		logger.Warn("no trusted proxies configured; PROXY headers and forwarded addresses are ignored")
+	}
+
+	// Interceptors resolve the client address, log and time every call
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		addressResolver.UnaryInterceptor(),
+		api.UnaryLoggingInterceptor(logger),
+		rpcMetrics.UnaryInterceptor(),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		addressResolver.StreamInterceptor(),
+		api.StreamLoggingInterceptor(logger),
+		rpcMetrics.StreamInterceptor(),
+	}
+
+	// Reject calls without valid credentials before they reach the service
+	var (
+		tokenAuth    *api.TokenAuth
+		accessTokens *auth.AccessTokens
+	)
+	if cfg.Auth.Enabled {
+		keyFile := accessKeyPath(cfg)
+		key, err := auth.LoadAccessKey(keyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load access token key: %w", err)
+		}
+		if accessTokens, err = auth.NewAccessTokens(key); err != nil {
+			return fmt.Errorf("invalid access token key %s: %w", keyFile, err)
+		}
+		authenticator, err := newAuthenticator(cfg, accessTokens, logger)
+		if err != nil {
+			return err
+		}
+		tokenAuth = api.NewTokenAuth(authenticator, cfg.Auth.HasBackend(auth.BackendLDAP), logger)
+		unaryInterceptors = append(unaryInterceptors, tokenAuth.UnaryInterceptor())
+		streamInterceptors = append(streamInterceptors, tokenAuth.StreamInterceptor())
+		if !cfg.TLS.Enabled {
+			logger.Warn("credentials are sent in the clear; enable TLS on untrusted networks")
+		}
+	}
+
+	// Reject new work and shed streams while the agent is overloaded
+	var guard *overload.Guard
+	if cfg.Overload.Enabled {
+		guard = overload.NewGuard(cfg.Overload.ToOptions(), func() int64 {
+			return manager.MemoryStats().Buffered
+		}, logger)
+		guardCtx, stopGuard := context.WithCancel(context.Background())
+		defer stopGuard()
+		go guard.Run(guardCtx)
+
+		unaryInterceptors = append(unaryInterceptors, api.UnaryOverloadInterceptor(guard))
+		streamInterceptors = append(streamInterceptors, api.StreamOverloadInterceptor(guard))
+		logger.Info("overload protection enabled",
+			"max_cpu_percent", cfg.Overload.MaxCPUPercent,
+			"max_memory_mb", cfg.Overload.MaxMemoryMB,
+			"max_queued_mb", cfg.Overload.MaxQueuedMB)
+	}
+
+	// Create gRPC server options with the interceptors
 	var opts []grpc.ServerOption
 	opts = append(opts,
-		grpc.UnaryInterceptor(api.UnaryLoggingInterceptor(logger)),
-		grpc.StreamInterceptor(api.StreamLoggingInterceptor(logger)),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 	)
 
 	// Configure TLS if enabled
+	var tlsConfig *tls.Config
 	if cfg.TLS.Enabled {
-		tlsConfig, tlsErr := loadTLSConfig(cfg.TLS.CertFile, cfg.TLS.KeyFile)
-		if tlsErr != nil {
-			return fmt.Errorf("failed to load TLS config: %w", tlsErr)
+		if cfg.TLS.ACME.Enabled {
+			acmeConfig, stopChallenges, acmeErr := loadACMEConfig(cfg.TLS, logger)
+			if acmeErr != nil {
+				return fmt.Errorf("failed to set up ACME: %w", acmeErr)
+			}
+			defer stopChallenges()
+			tlsConfig = acmeConfig
+			logger.Info("TLS enabled with ACME certificates", "domains", cfg.TLS.ACME.Domains)
+		} else if cfg.TLS.SPIFFE.Enabled {
+			spiffeConfig, source, spiffeErr := tlsutil.SPIFFEServerConfig(context.Background(), tlsutil.SPIFFEOptions{
+				SocketPath:  cfg.TLS.SPIFFE.SocketPath,
+				TrustDomain: cfg.TLS.SPIFFE.TrustDomain,
+				AllowedIDs:  cfg.TLS.SPIFFE.AllowedIDs,
+			}, tlsutil.Options{
+				MinVersion:   cfg.TLS.MinVersion,
+				MaxVersion:   cfg.TLS.MaxVersion,
+				CipherSuites: cfg.TLS.CipherSuites,
+			})
+			if spiffeErr != nil {
+				return fmt.Errorf("failed to set up SPIFFE identity: %w", spiffeErr)
+			}
+			defer source.Close()
+			tlsConfig = spiffeConfig
+			logger.Info("TLS enabled with SPIFFE workload identity", "trust_domain", cfg.TLS.SPIFFE.TrustDomain)
+		} else {
+			fileConfig, stopReload, tlsErr := loadTLSConfig(cfg.TLS, logger)
+			if tlsErr != nil {
+				return fmt.Errorf("failed to load TLS config: %w", tlsErr)
+			}
+			if stopReload != nil {
+				defer close(stopReload)
+			}
+			tlsConfig = fileConfig
+			logger.Info("TLS enabled", "cert", cfg.TLS.CertFile, "client_auth", cfg.TLS.ClientAuth)
 		}
 		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
-		logger.Info("TLS enabled", "cert", cfg.TLS.CertFile)
 	}
 
 	// Add server options for connection limits
@@ -151,6 +574,127 @@ func runServe(cmd *cobra.Command, args []string) error {
 
 	// Create and register the serial service
 	serialServer := api.NewSerialServer(manager, scanner, cfg, logger)
+	serialServer.SetConsoleCollector(collector)
+	serialServer.SetRedactor(redactor)
+	if polling.engine != nil {
+		serialServer.SetPollingEngine(polling.engine)
+	}
+	if polling.history != nil {
+		serialServer.SetHistoryStore(polling.history)
+	}
+	if polling.alarms != nil {
+		serialServer.SetAlarmMonitor(polling.alarms)
+	}
+	if tracker != nil {
+		serialServer.SetDeviceTracker(tracker)
+	}
+	if testRunner != nil {
+		serialServer.SetTestRunner(testRunner)
+	}
+	if accessTokens != nil {
+		serialServer.SetAccessTokens(accessTokens)
+	}
+	var accessPolicy *acl.Policy
+	if cfg.ACL.Enabled {
+		rules := make([]acl.Rule, 0, len(cfg.ACL.Rules))
+		for i, r := range cfg.ACL.Rules {
+			rule, err := r.ToRule()
+			if err != nil {
+				return fmt.Errorf("invalid acl rule %d: %w", i+1, err)
+			}
+			rules = append(rules, rule)
+		}
+		accessPolicy = acl.New(rules)
+		serialServer.SetAccessPolicy(accessPolicy)
+		logger.Info("port access control enabled", "rules", len(rules))
+	}
+	if len(cfg.Serial.WritePolicies) > 0 {
+		policies := make([]writepolicy.Policy, 0, len(cfg.Serial.WritePolicies))
+		for _, p := range cfg.Serial.WritePolicies {
+			policy, err := p.ToPolicy()
+			if err != nil {
+				return fmt.Errorf("invalid write policy for %q: %w", p.Port, err)
+			}
+			policies = append(policies, policy)
+		}
+		serialServer.SetWriteGuard(writepolicy.NewGuard(policies, logger))
+		logger.Info("write policies enabled", "ports", len(policies))
+	}
+	if len(cfg.Serial.InputGuards) > 0 {
+		policies := make([]inputguard.Policy, 0, len(cfg.Serial.InputGuards))
+		for _, g := range cfg.Serial.InputGuards {
+			policy, err := g.ToPolicy()
+			if err != nil {
+				return fmt.Errorf("invalid input guard for %q: %w", g.Port, err)
+			}
+			policies = append(policies, policy)
+		}
+		serialServer.SetInputGuard(inputguard.New(policies))
+		logger.Info("input guards enabled", "ports", len(policies))
+	}
+	if ledger != nil {
+		serialServer.SetUsageLedger(ledger)
+	}
+	debugServer := debug.New(cfg.Debug.Address, manager, logger)
+	serialServer.SetDebugServer(debugServer)
+	if cfg.Debug.Enabled {
+		if err := debugServer.Start(); err != nil {
+			return err
+		}
+	}
+	defer func() { _ = debugServer.Stop() }()
+	var bookings *reservation.Book
+	if cfg.Reservations.Enabled {
+		book, err := reservation.Open(reservation.Options{
+			Path:        configRelativeDir(cfg.Reservations.File, "reservations.json"),
+			MaxDuration: time.Duration(cfg.Reservations.MaxHours * float64(time.Hour)),
+		}, logger)
+		if err != nil {
+			return err
+		}
+		if cfg.Reservations.WebhookURL != "" {
+			book.AddNotifier(reservation.WebhookNotifier{URL: cfg.Reservations.WebhookURL})
+		}
+		serialServer.SetReservationBook(book)
+		bookings = book
+
+		bookingsCtx, stopBookings := context.WithCancel(context.Background())
+		defer stopBookings()
+		go book.Run(bookingsCtx, serialServer.EnforceReservation)
+		logger.Info("port reservations enabled", "reservations", len(book.List("")))
+	}
+	if len(cfg.Bus.Providers) > 0 {
+		registry := bus.NewRegistry()
+		for _, name := range cfg.Bus.Providers {
+			provider, err := bus.NewProvider(name)
+			if err == nil {
+				err = registry.Add(provider)
+			}
+			if err != nil {
+				logger.Warn("bus provider unavailable", "provider", name, "error", err)
+				continue
+			}
+			logger.Info("bus provider enabled", "provider", name)
+		}
+		serialServer.SetBusRegistry(registry)
+	}
+	if cfg.Console.Recording.Enabled {
+		recording := console.RecordingOptions{
+			Directory: configRelativeDir(cfg.Console.Recording.Directory, "recordings"),
+			Always:    cfg.Console.Recording.Always,
+		}
+		if shipper != nil && cfg.Storage.Recordings {
+			recording.Upload = func(path string) { shipper.Ship(path, "recordings") }
+		}
+		serialServer.SetRecordingOptions(recording)
+	}
+	// Sessions of unplugged devices end rather than failing every read
+	stopRemovalWatch := scanner.WatchPorts(cfg.Serial.ScanInterval, func(_, removed, _ []serial.PortInfo) {
+		for _, info := range removed {
+			serialServer.CloseRemovedPort(info.Name)
+		}
+	})
+	defer scanner.StopWatch(stopRemovalWatch)
 	pb.RegisterSerialServiceServer(grpcServer, serialServer)
 
 	// Enable reflection for debugging
@@ -159,28 +703,84 @@ func runServe(cmd *cobra.Command, args []string) error {
 	}
 
 	// Start listening
-	listener, err := net.Listen("tcp", cfg.Server.GRPCAddress)
+	listener, err := net.Listen(cfg.Server.Network, cfg.Server.GRPCAddress)
 	if err != nil {
 		return fmt.Errorf("failed to listen on %s: %w", cfg.Server.GRPCAddress, err)
 	}
 
+	if cfg.Server.ProxyProtocol {
+		listener, err = api.NewProxyProtocolListener(listener, cfg.Server.TrustedProxies)
+		if err != nil {
+			return fmt.Errorf("failed to enable PROXY protocol: %w", err)
+		}
+		logger.Info("PROXY protocol enabled", "trusted_proxies", cfg.Server.TrustedProxies)
+	}
+
 	// Handle graceful shutdown
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
 	// Start server in goroutine
-	errChan := make(chan error, 1)
+	errChan := make(chan error, 5)
 	go func() {
-		logger.Info("SerialLink gRPC server listening", "address", cfg.Server.GRPCAddress)
+		logger.Info("SerialLink gRPC server listening", "address", listener.Addr(), "network", cfg.Server.Network)
 		if err := grpcServer.Serve(listener); err != nil {
 			errChan <- err
 		}
 	}()
 
+	// Start the HTTP server for SSE monitoring
+	var httpServer *http.Server
+	if cfg.Server.HTTPEnabled {
+		httpServer, err = startHTTPServer(ctx, cfg, manager, metricsRegistry, bookings, tokenAuth, accessPolicy, tlsConfig, logger, errChan)
+		if err != nil {
+			grpcServer.Stop()
+			return err
+		}
+	}
+
+	// Serve metrics for Prometheus on their own listener
+	var metricsServer *http.Server
+	if cfg.Metrics.Enabled {
+		metricsServer, err = startMetricsServer(cfg, metricsRegistry, logger, errChan)
+		if err != nil {
+			grpcServer.Stop()
+			return err
+		}
+	}
+
+	// Start the REST gateway for HTTP clients
+	var restServer *http.Server
+	if cfg.Server.RESTEnabled {
+		restServer, err = startRESTServer(ctx, cfg, serialServer, guard, tokenAuth, tlsConfig, logger, errChan)
+		if err != nil {
+			grpcServer.Stop()
+			return err
+		}
+	}
+
+	// Start the WebSocket gateway for browser clients
+	var wsServer *http.Server
+	if cfg.Server.WebSocketEnabled {
+		wsServer, err = startWebSocketServer(ctx, cfg, serialServer, guard, tokenAuth, tlsConfig, logger, errChan)
+		if err != nil {
+			grpcServer.Stop()
+			return err
+		}
+	}
+
 	// Wait for shutdown signal or error
 	select {
 	case <-ctx.Done():
 		logger.Info("Shutting down gracefully...")
+		for _, server := range []*http.Server{httpServer, wsServer, restServer, metricsServer} {
+			if server == nil {
+				continue
+			}
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			_ = server.Shutdown(shutdownCtx)
+			cancel()
+		}
 		grpcServer.GracefulStop()
 		return nil
 	case err := <-errChan:
@@ -188,6 +788,308 @@ func runServe(cmd *cobra.Command, args []string) error {
 	}
 }
 
+// newAuthenticator chains the access tokens minted by the agent and the
+// configured authentication backends
+func newAuthenticator(cfg *config.Config, accessTokens *auth.AccessTokens, logger *log.Logger) (auth.Authenticator, error) {
+	chain := auth.Chain{accessTokens}
+	for _, backend := range cfg.Auth.Backends {
+		switch strings.ToLower(backend) {
+		case auth.BackendStatic:
+			tokens := make([]auth.Token, 0, len(cfg.Auth.Tokens))
+			for _, t := range cfg.Auth.Tokens {
+				tokens = append(tokens, t.ToToken())
+			}
+			tokenFile := tokenFilePath(cfg)
+			verifier, err := auth.NewVerifier(tokens, tokenFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load API tokens: %w", err)
+			}
+			if verifier.Count() == 0 {
+				logger.Warn("API token authentication enabled without tokens; create one with \"seriallink token create\"", "token_file", tokenFile)
+			} else {
+				logger.Info("API token authentication enabled", "tokens", verifier.Count(), "token_file", tokenFile)
+			}
+			chain = append(chain, verifier)
+		case auth.BackendOIDC:
+			oidc, err := auth.NewOIDC(cfg.Auth.OIDC.ToOptions())
+			if err != nil {
+				return nil, err
+			}
+			logger.Info("OIDC authentication enabled", "issuer", cfg.Auth.OIDC.Issuer, "audience", cfg.Auth.OIDC.Audience)
+			chain = append(chain, oidc)
+		case auth.BackendLDAP:
+			ldap, err := auth.NewLDAP(cfg.Auth.LDAP.ToOptions())
+			if err != nil {
+				return nil, err
+			}
+			logger.Info("LDAP authentication enabled", "url", cfg.Auth.LDAP.URL)
+			chain = append(chain, ldap)
+		}
+	}
+	return chain, nil
+}
+
+// startHTTPServer starts the HTTP endpoints. Requests are cancelled when ctx
+// is, so long-lived event streams do not hold up shutdown.
+func startHTTPServer(ctx context.Context, cfg *config.Config, manager *serial.Manager, metricsRegistry *metrics.Registry, bookings *reservation.Book, tokenAuth *api.TokenAuth, accessPolicy *acl.Policy, tlsConfig *tls.Config, logger *log.Logger, errChan chan<- error) (*http.Server, error) {
+	handler := api.NewHTTPServer(manager, logger)
+	handler.SetMetrics(metricsRegistry)
+	handler.SetAdmins(cfg.Auth.Admins)
+	if bookings != nil {
+		handler.SetReservationBook(bookings)
+	}
+	if tokenAuth != nil {
+		handler.SetTokenAuth(tokenAuth)
+	}
+	if accessPolicy != nil {
+		handler.SetAccessPolicy(accessPolicy, cfg.TLS.Enabled && cfg.TLS.SPIFFE.Enabled)
+	}
+	return serveHTTP(ctx, cfg, cfg.Server.HTTPAddress, handler.Handler(), tlsConfig, "HTTP server", logger, errChan)
+}
+
+// startWebSocketServer starts the WebSocket gateway. Connections are closed
+// when ctx is cancelled, closing the sessions they opened.
+func startWebSocketServer(ctx context.Context, cfg *config.Config, serialServer *api.SerialServer, guard *overload.Guard, tokenAuth *api.TokenAuth, tlsConfig *tls.Config, logger *log.Logger, errChan chan<- error) (*http.Server, error) {
+	gateway := api.NewWebSocketServer(serialServer, cfg.Server.WebSocketEncodings, logger)
+	gateway.SetAllowedOrigins(cfg.Server.WebSocketOrigins)
+	if guard != nil {
+		gateway.SetOverloadGuard(guard)
+	}
+	if tokenAuth != nil {
+		gateway.SetTokenAuth(tokenAuth)
+	}
+	return serveHTTP(ctx, cfg, cfg.Server.WebSocketAddress, gateway.Handler(), tlsConfig, "WebSocket gateway", logger, errChan)
+}
+
+// startRESTServer starts the REST gateway
+func startRESTServer(ctx context.Context, cfg *config.Config, serialServer *api.SerialServer, guard *overload.Guard, tokenAuth *api.TokenAuth, tlsConfig *tls.Config, logger *log.Logger, errChan chan<- error) (*http.Server, error) {
+	gateway := api.NewRESTServer(serialServer, logger)
+	if guard != nil {
+		gateway.SetOverloadGuard(guard)
+	}
+	if tokenAuth != nil {
+		gateway.SetTokenAuth(tokenAuth)
+	}
+	return serveHTTP(ctx, cfg, cfg.Server.RESTAddress, gateway.Handler(), tlsConfig, "REST gateway", logger, errChan)
+}
+
+// serveHTTP serves handler on address with the gRPC server's PROXY protocol
+// and TLS settings. Requests are cancelled when ctx is.
+func serveHTTP(ctx context.Context, cfg *config.Config, address string, handler http.Handler, tlsConfig *tls.Config, name string, logger *log.Logger, errChan chan<- error) (*http.Server, error) {
+	listener, err := net.Listen(cfg.Server.Network, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+
+	if cfg.Server.ProxyProtocol {
+		listener, err = api.NewProxyProtocolListener(listener, cfg.Server.TrustedProxies)
+		if err != nil {
+			return nil, fmt.Errorf("failed to enable PROXY protocol: %w", err)
+		}
+	}
+
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
+	server := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+
+	go func() {
+		logger.Info("SerialLink "+name+" listening", "address", address)
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			errChan <- err
+		}
+	}()
+
+	return server, nil
+}
+
+// startMetricsServer serves the metrics at the configured address and path
+func startMetricsServer(cfg *config.Config, metricsRegistry *metrics.Registry, logger *log.Logger, errChan chan<- error) (*http.Server, error) {
+	listener, err := net.Listen(cfg.Server.Network, cfg.Metrics.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", cfg.Metrics.Address, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET "+cfg.Metrics.Path, metricsRegistry)
+	metricsServer := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		logger.Info("SerialLink metrics listening", "address", cfg.Metrics.Address, "path", cfg.Metrics.Path)
+		if err := metricsServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+			errChan <- err
+		}
+	}()
+
+	return metricsServer, nil
+}
+
+// configRelativeDir returns dir, or fallback next to the config file when
+// dir is empty
+func configRelativeDir(dir, fallback string) string {
+	if dir != "" {
+		return dir
+	}
+	configFile := viper.ConfigFileUsed()
+	if configFile == "" {
+		configFile = config.DefaultConfigPath()
+	}
+	return filepath.Join(filepath.Dir(configFile), fallback)
+}
+
+// pollingServices are the polling engine and the services fed by it
+type pollingServices struct {
+	engine  *poller.Engine
+	history *history.Store
+	alarms  *alarm.Monitor
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	logger *log.Logger
+}
+
+// startPolling starts the configured pollers and the history, alarm and MQTT
+// services that consume their samples. Without pollers nothing is started.
+// publisher is nil when MQTT is disabled.
+func startPolling(cfg *config.Config, manager *serial.Manager, publisher *mqtt.Publisher, logger *log.Logger) (*pollingServices, error) {
+	services := &pollingServices{logger: logger}
+	if len(cfg.Polling.Pollers) == 0 {
+		return services, nil
+	}
+
+	defs := make([]poller.Definition, 0, len(cfg.Polling.Pollers))
+	for _, p := range cfg.Polling.Pollers {
+		def, err := p.ToDefinition(cfg.Serial.Defaults)
+		if err != nil {
+			return nil, fmt.Errorf("invalid poller %q: %w", p.Name, err)
+		}
+		defs = append(defs, def)
+	}
+
+	if cfg.Polling.History.Enabled {
+		directory := configRelativeDir(cfg.Polling.History.Directory, "history")
+		store, err := history.Open(directory, cfg.Polling.History.ToRetention(), logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open polling history: %w", err)
+		}
+		services.history = store
+		logger.Info("polling history enabled", "directory", directory)
+	}
+
+	if len(cfg.Polling.Alarms.Rules) > 0 {
+		rules := make([]alarm.Rule, 0, len(cfg.Polling.Alarms.Rules))
+		for _, r := range cfg.Polling.Alarms.Rules {
+			rules = append(rules, r.ToRule())
+		}
+		services.alarms = alarm.NewMonitor(rules, logger)
+		services.alarms.AddNotifier(alarm.PortEventNotifier{Manager: manager})
+		if cfg.Polling.Alarms.WebhookURL != "" {
+			services.alarms.AddNotifier(alarm.WebhookNotifier{URL: cfg.Polling.Alarms.WebhookURL})
+		}
+		if publisher != nil {
+			services.alarms.AddNotifier(alarm.MQTTNotifier{Publisher: publisher, Prefix: cfg.MQTT.TopicPrefix})
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	services.cancel = cancel
+	services.engine = poller.NewEngine(manager, defs, logger)
+
+	run := func(fn func()) {
+		services.wg.Add(1)
+		go func() {
+			defer services.wg.Done()
+			fn()
+		}()
+	}
+	if publisher != nil {
+		run(func() { services.engine.Forward(ctx, publisher, cfg.MQTT.TopicPrefix) })
+	}
+	if services.history != nil {
+		run(func() { services.history.Follow(ctx, services.engine) })
+	}
+	if services.alarms != nil {
+		run(func() { services.alarms.Follow(ctx, services.engine) })
+	}
+	services.engine.Start(ctx)
+
+	return services, nil
+}
+
+// Stop stops polling and closes the services fed by it
+func (p *pollingServices) Stop() {
+	if p.cancel == nil {
+		return
+	}
+	p.cancel()
+	p.engine.Wait()
+	p.wg.Wait()
+
+	if p.history != nil {
+		if err := p.history.Close(); err != nil {
+			p.logger.Warn("failed to close polling history", "error", err)
+		}
+	}
+}
+
+// retentionTargets lists the directories of agent files with their limits.
+// Open console logs and history segments are never pruned.
+func retentionTargets(cfg *config.Config, collector *console.Collector, store *history.Store) []retention.Target {
+	consoleLogs := cfg.Retention.ConsoleLogs.ToTarget("console_logs", configRelativeDir(cfg.Console.Directory, "console"))
+	if collector != nil {
+		consoleLogs.InUse = collector.InUse
+	}
+
+	recordings := cfg.Retention.Recordings.ToTarget("recordings", configRelativeDir(cfg.Console.Recording.Directory, "recordings"))
+
+	historyFiles := cfg.Retention.History.ToTarget("history", configRelativeDir(cfg.Polling.History.Directory, "history"))
+	if store != nil {
+		historyFiles.InUse = store.InUse
+	}
+
+	testReports := cfg.Retention.TestReports.ToTarget("test_reports", configRelativeDir(cfg.Testing.ReportDirectory, "test-reports"))
+
+	sessionSummaries := cfg.Retention.SessionSummaries.ToTarget("session_summaries", configRelativeDir(cfg.SessionSummary.Directory, "sessions"))
+
+	return []retention.Target{consoleLogs, recordings, historyFiles, testReports, sessionSummaries}
+}
+
+// consolePortOptions builds console logger options for a port from the
+// configuration
+func consolePortOptions(cfg *config.Config, portName string, portConfig serial.PortConfig) console.Options {
+	return console.Options{
+		PortName:      portName,
+		Config:        portConfig,
+		Path:          filepath.Join(configRelativeDir(cfg.Console.Directory, "console"), console.FileName(portName)),
+		MaxSize:       int64(cfg.Console.MaxSize) * 1024 * 1024,
+		MaxBackups:    cfg.Console.MaxBackups,
+		Format:        cfg.Console.Format,
+		ShipURL:       cfg.Console.ShipURL,
+		BufferSize:    cfg.Console.BufferSize * 1024,
+		Dedup:         cfg.Console.Dedup.Enabled,
+		DedupInterval: time.Duration(cfg.Console.Dedup.ReportInterval) * time.Second,
+		Keywords:      cfg.Console.Keywords.ToOptions(),
+	}
+}
+
+// portActionsLogConsole reports whether a port action starts console logging
+func portActionsLogConsole(cfg *config.Config) bool {
+	for _, a := range cfg.PortActions {
+		if a.ConsoleLog {
+			return true
+		}
+	}
+	return false
+}
+
 // initLogger creates and configures a charmbracelet logger based on config
 func initLogger(cfg *config.Config) *log.Logger {
 	logger := log.NewWithOptions(os.Stderr, log.Options{
@@ -247,14 +1149,69 @@ func validateTLSConfig(tlsCfg config.TLSConfig, logger *log.Logger) error {
 	return nil
 }
 
-func loadTLSConfig(certFile, keyFile string) (*tls.Config, error) {
-	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+// loadTLSConfig builds the server TLS config and starts watching the
+// certificate files for rotation. The returned channel stops the watcher.
+func loadTLSConfig(tlsCfg config.TLSConfig, logger *log.Logger) (*tls.Config, chan struct{}, error) {
+	reloader, err := tlsutil.NewReloader(tlsCfg.CertFile, tlsCfg.KeyFile, tlsCfg.CAFile, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tlsConfig, err := tlsutil.ServerConfig(tlsutil.Options{
+		MinVersion:   tlsCfg.MinVersion,
+		MaxVersion:   tlsCfg.MaxVersion,
+		CipherSuites: tlsCfg.CipherSuites,
+		ClientAuth:   tlsCfg.ClientAuth,
+	}, reloader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var stop chan struct{}
+	if tlsCfg.ReloadInterval > 0 {
+		stop = reloader.Watch(time.Duration(tlsCfg.ReloadInterval) * time.Second)
+	}
+
+	return tlsConfig, stop, nil
+}
+
+// loadACMEConfig builds a TLS config backed by ACME certificates and starts
+// the HTTP-01 challenge listener. The returned function stops the listener.
+func loadACMEConfig(tlsCfg config.TLSConfig, logger *log.Logger) (*tls.Config, func(), error) {
+	cacheDir := configRelativeDir(tlsCfg.ACME.CacheDir, "acme")
+
+	source, err := tlsutil.NewACMESource(tlsutil.ACMEOptions{
+		Domains:      tlsCfg.ACME.Domains,
+		Email:        tlsCfg.ACME.Email,
+		CacheDir:     cacheDir,
+		DirectoryURL: tlsCfg.ACME.DirectoryURL,
+		CAFile:       tlsCfg.CAFile,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to load TLS certificates: %w", err)
+		return nil, nil, err
 	}
 
-	return &tls.Config{
-		Certificates: []tls.Certificate{cert},
-		MinVersion:   tls.VersionTLS12,
-	}, nil
+	tlsConfig, err := tlsutil.ServerConfig(tlsutil.Options{
+		MinVersion:   tlsCfg.MinVersion,
+		MaxVersion:   tlsCfg.MaxVersion,
+		CipherSuites: tlsCfg.CipherSuites,
+		ClientAuth:   tlsCfg.ClientAuth,
+	}, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	challengeServer := &http.Server{
+		Addr:              tlsCfg.ACME.HTTPAddress,
+		Handler:           source.HTTPHandler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		logger.Info("ACME HTTP-01 challenge listener started", "address", challengeServer.Addr, "cache", cacheDir)
+		if err := challengeServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("ACME challenge listener failed", "error", err)
+		}
+	}()
+
+	return tlsConfig, func() { _ = challengeServer.Close() }, nil
 }
@@ -0,0 +1,106 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var sessionsCmd = &cobra.Command{
+	Use:   "sessions",
+	Short: "List open sessions across all ports",
+	Long: `List the open sessions of the agent with their port, client ID, whether
+they hold the port exclusively, the data sent and received and how long
+they have been open.
+
+Example:
+  seriallink sessions
+  seriallink sessions --client dashboard
+  seriallink sessions --port /dev/ttyUSB0 --json`,
+	Args: cobra.NoArgs,
+	RunE: runSessions,
+}
+
+func init() {
+	rootCmd.AddCommand(sessionsCmd)
+
+	sessionsCmd.Flags().String("port", "", "only list the session of this port")
+	sessionsCmd.Flags().String("client", "", "only list sessions of this client ID")
+	sessionsCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+func runSessions(cmd *cobra.Command, args []string) error {
+	portName, _ := cmd.Flags().GetString("port")
+	clientID, _ := cmd.Flags().GetString("client")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.ListSessions(ctx, &pb.ListSessionsRequest{PortName: portName, ClientId: clientID})
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp)
+	}
+
+	if len(resp.Sessions) == 0 {
+		fmt.Println("No open sessions")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SESSION\tPORT\tCLIENT\tEXCLUSIVE\tSENT\tRECEIVED\tERRORS\tAGE")
+	fmt.Fprintln(w, "-------\t----\t------\t---------\t----\t--------\t------\t---")
+	for _, session := range resp.Sessions {
+		id := session.ShortSessionId
+		if id == "" {
+			id = session.SessionId
+		}
+		exclusive := "no"
+		if session.Exclusive {
+			exclusive = "yes"
+		}
+		var sent, received, errCount uint64
+		if stats := session.Statistics; stats != nil {
+			sent, received, errCount = stats.BytesSent, stats.BytesReceived, stats.Errors
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\n",
+			id, session.PortName, session.ClientId, exclusive,
+			formatBytes(int64(sent)), formatBytes(int64(received)), errCount,
+			(time.Duration(session.AgeMs) * time.Millisecond).Round(time.Second))
+	}
+	return w.Flush()
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var shapeCmd = &cobra.Command{
+	Use:   "shape PORT [flags]",
+	Short: "Throttle a session's traffic to emulate a slow link",
+	Long: `Limit how fast an open session receives and sends data, to test how an
+application copes with a slow or congested link. The port's line settings
+stay as they are; the agent paces the data instead. At 8N1 a link carries
+about a tenth of its baud rate in bytes per second, e.g. 960 at 9600 baud.
+
+Example:
+  seriallink shape COM3 --rx 960 --tx 960 --session-id ID
+  seriallink shape COM3 --rx 100 --rx-burst 1 --session-id ID   # Byte by byte
+  seriallink shape COM3 --off --session-id ID`,
+	Args: cobra.ExactArgs(1),
+	RunE: runShape,
+}
+
+func init() {
+	rootCmd.AddCommand(shapeCmd)
+
+	shapeCmd.Flags().String("session-id", "", "session ID")
+	shapeCmd.Flags().Uint32("rx", 0, "received bytes per second (0: unlimited)")
+	shapeCmd.Flags().Uint32("tx", 0, "sent bytes per second (0: unlimited)")
+	shapeCmd.Flags().Uint32("rx-burst", 0, "received bytes passed at once after a quiet spell (default a tenth of --rx)")
+	shapeCmd.Flags().Uint32("tx-burst", 0, "sent bytes passed at once after a quiet spell (default a tenth of --tx)")
+	shapeCmd.Flags().Bool("off", false, "lift the limits")
+}
+
+func runShape(cmd *cobra.Command, args []string) error {
+	portName := args[0]
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	rx, _ := cmd.Flags().GetUint32("rx")
+	tx, _ := cmd.Flags().GetUint32("tx")
+	rxBurst, _ := cmd.Flags().GetUint32("rx-burst")
+	txBurst, _ := cmd.Flags().GetUint32("tx-burst")
+	off, _ := cmd.Flags().GetBool("off")
+
+	if off && (rx > 0 || tx > 0 || rxBurst > 0 || txBurst > 0) {
+		return fmt.Errorf("--off cannot be combined with limits")
+	}
+	if !off && rx == 0 && tx == 0 {
+		return fmt.Errorf("set --rx or --tx, or --off to lift the limits")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.SetShaping(ctx, &pb.SetShapingRequest{
+		PortName:  portName,
+		SessionId: sessionID,
+		Shaping: &pb.BandwidthShaping{
+			RxBytesPerSec: rx,
+			TxBytesPerSec: tx,
+			RxBurst:       rxBurst,
+			TxBurst:       txBurst,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set shaping: %w", err)
+	}
+	if !resp.Success {
+		return fmt.Errorf("failed to set shaping: %s", resp.Message)
+	}
+
+	fmt.Printf("%s: %s\n", portName, formatShaping(resp.Shaping))
+	return nil
+}
+
+// formatShaping describes a session's shaping
+func formatShaping(s *pb.BandwidthShaping) string {
+	if s == nil {
+		return "unlimited"
+	}
+	limit := func(direction string, rate, burst uint32) string {
+		if rate == 0 {
+			return direction + " unlimited"
+		}
+		text := fmt.Sprintf("%s %d B/s", direction, rate)
+		if burst > 0 {
+			text += fmt.Sprintf(" (burst %d)", burst)
+		}
+		return text
+	}
+	return strings.Join([]string{
+		limit("rx", s.RxBytesPerSec, s.RxBurst),
+		limit("tx", s.TxBytesPerSec, s.TxBurst),
+	}, ", ")
+}
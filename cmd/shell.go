@@ -0,0 +1,714 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/Shoaibashk/SerialLink/client"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var shellCmd = &cobra.Command{
+	Use:   "shell [flags]",
+	Short: "Interactive shell over one agent connection",
+	Long: `Start an interactive shell that keeps one connection to the agent and
+one open session, for exploring a device without a separate invocation per
+step. Tab completes commands and port names; the up and down keys recall
+earlier commands, which are kept across shells.
+
+Commands read from a pipe run as a script that stops at the first failure.
+
+Example:
+  seriallink shell
+  seriallink> open COM3 115200
+  seriallink COM3> send "AT\r"
+  seriallink COM3> expect OK
+  seriallink COM3> hex on
+  seriallink COM3> read
+
+  printf 'open COM3\nsend "ATI\\r"\nexpect OK\n' | seriallink shell`,
+	Args: cobra.NoArgs,
+	RunE: runShell,
+}
+
+func init() {
+	rootCmd.AddCommand(shellCmd)
+
+	shellCmd.Flags().String("client-id", "", "client ID for locking (auto-generated if not provided)")
+	shellCmd.Flags().String("history-file", "", "command history file (default: $HOME/.seriallink/shell_history)")
+}
+
+// shellHistorySize is the number of commands loaded from the history file
+const shellHistorySize = 100
+
+// shellCommand is a command of the interactive shell
+type shellCommand struct {
+	name  string
+	usage string
+	help  string
+	run   func(sh *shell, ctx context.Context, args []string) error
+	// complete returns the candidates for argument number arg (from 0)
+	complete func(sh *shell, arg int) []string
+}
+
+var shellCommands = []shellCommand{
+	{name: "open", usage: "open PORT [BAUD]", help: "open a port, with the agent's defaults unless a baud rate is given",
+		run: (*shell).open, complete: (*shell).completeOpen},
+	{name: "close", usage: "close", help: "close the open port", run: (*shell).close},
+	{name: "send", usage: "send DATA...", help: `write data; "..." expands escapes such as \r and \x06`, run: (*shell).send},
+	{name: "expect", usage: "expect PATTERN [TIMEOUT_MS]", help: "read until the received data matches a regular expression", run: (*shell).expect},
+	{name: "read", usage: "read [TIMEOUT_MS]", help: "read and print the data available", run: (*shell).read},
+	{name: "hex", usage: "hex on|off", help: "show received data and take sent data as hex", run: (*shell).setHex,
+		complete: func(*shell, int) []string { return []string{"on", "off"} }},
+	{name: "eol", usage: "eol none|cr|lf|crlf", help: "line ending appended to sent text", run: (*shell).setEOL,
+		complete: func(*shell, int) []string { return []string{"none", "cr", "lf", "crlf"} }},
+	{name: "status", usage: "status", help: "show the status of the open port", run: (*shell).status},
+	{name: "ports", usage: "ports", help: "list the available ports", run: (*shell).listPorts},
+	{name: "help", usage: "help", help: "show this help"},
+	{name: "exit", usage: "exit", help: "close the open port and leave the shell (also quit, Ctrl-D)"},
+}
+
+// shellTimeout is the default timeout of expect and read
+const shellTimeout = 2 * time.Second
+
+// shell is the state of an interactive shell
+type shell struct {
+	client    *client.Client
+	clientID  string
+	portName  string
+	sessionID string
+	hex       bool
+	eol       []byte
+	// ports caches the port names for completion
+	ports []string
+}
+
+func runShell(cmd *cobra.Command, args []string) error {
+	clientID, _ := cmd.Flags().GetString("client-id")
+	historyFile, _ := cmd.Flags().GetString("history-file")
+
+	if clientID == "" {
+		clientID = fmt.Sprintf("cli-%d", time.Now().UnixNano())
+	}
+
+	c, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	sh := &shell{client: c, clientID: clientID}
+	defer sh.closeOnExit()
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return sh.runScript(os.Stdin)
+	}
+
+	if historyFile == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			historyFile = filepath.Join(home, ".seriallink", "shell_history")
+		}
+	}
+
+	terminal := term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{os.Stdin, os.Stdout}, "")
+	terminal.AutoCompleteCallback = sh.complete
+	if width, height, err := term.GetSize(fd); err == nil {
+		_ = terminal.SetSize(width, height)
+	}
+	for _, line := range loadShellHistory(historyFile) {
+		terminal.History.Add(line)
+	}
+
+	fmt.Println(`SerialLink shell. Type "help" for commands, "exit" or Ctrl-D to leave.`)
+	for {
+		terminal.SetPrompt(sh.prompt())
+		line, err := readShellLine(terminal, fd)
+		if errors.Is(err, io.EOF) {
+			fmt.Println()
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		appendShellHistory(historyFile, line)
+
+		if err := sh.execute(line); err != nil {
+			if errors.Is(err, errShellExit) {
+				return nil
+			}
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+	}
+}
+
+// errShellExit ends the shell
+var errShellExit = errors.New("exit")
+
+// readShellLine reads a line with the terminal in raw mode for editing,
+// leaving it in normal mode while commands run
+func readShellLine(terminal *term.Terminal, fd int) (string, error) {
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = term.Restore(fd, state) }()
+
+	return terminal.ReadLine()
+}
+
+// runScript executes the lines of r, stopping at the first failure
+func (sh *shell) runScript(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := sh.execute(line); err != nil {
+			if errors.Is(err, errShellExit) {
+				return nil
+			}
+			return fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// prompt shows the open port
+func (sh *shell) prompt() string {
+	if sh.portName != "" {
+		return fmt.Sprintf("seriallink %s> ", sh.portName)
+	}
+	return "seriallink> "
+}
+
+// execute runs one command line. Ctrl-C cancels the command, not the shell.
+func (sh *shell) execute(line string) error {
+	words, err := splitShellLine(line)
+	if err != nil {
+		return err
+	}
+	if len(words) == 0 {
+		return nil
+	}
+
+	name, args := words[0], words[1:]
+	switch name {
+	case "exit", "quit":
+		return errShellExit
+	case "help":
+		printShellHelp()
+		return nil
+	}
+
+	i := slices.IndexFunc(shellCommands, func(command shellCommand) bool { return command.name == name })
+	if i < 0 || shellCommands[i].run == nil {
+		return fmt.Errorf("unknown command %q, type \"help\" for commands", name)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	return shellCommands[i].run(sh, ctx, args)
+}
+
+func printShellHelp() {
+	for _, command := range shellCommands {
+		fmt.Printf("  %-28s %s\n", command.usage, command.help)
+	}
+}
+
+// splitShellLine splits a command line into words. Double-quoted text
+// expands escapes such as \r and \x06; single-quoted text is taken as is.
+func splitShellLine(line string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; c {
+		case ' ', '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		case '"':
+			end := i + 1
+			for end < len(line) && line[end] != '"' {
+				if line[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(line) {
+				return nil, errors.New(`unterminated "`)
+			}
+			unquoted, err := strconv.Unquote(line[i : end+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid quoted text %s: %w", line[i:end+1], err)
+			}
+			word.WriteString(unquoted)
+			inWord = true
+			i = end
+		case '\'':
+			end := strings.IndexByte(line[i+1:], '\'')
+			if end < 0 {
+				return nil, errors.New("unterminated '")
+			}
+			word.WriteString(line[i+1 : i+1+end])
+			inWord = true
+			i += end + 1
+		default:
+			word.WriteByte(c)
+			inWord = true
+		}
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// complete completes the word before the cursor when Tab is pressed
+func (sh *shell) complete(line string, pos int, key rune) (string, int, bool) {
+	if key != '\t' {
+		return "", 0, false
+	}
+
+	head := line[:pos]
+	words := strings.Fields(head)
+	if len(words) == 0 || strings.HasSuffix(head, " ") {
+		words = append(words, "")
+	}
+	prefix := words[len(words)-1]
+
+	var candidates []string
+	if len(words) == 1 {
+		for _, command := range shellCommands {
+			candidates = append(candidates, command.name)
+		}
+	} else if i := slices.IndexFunc(shellCommands, func(command shellCommand) bool { return command.name == words[0] }); i >= 0 && shellCommands[i].complete != nil {
+		candidates = shellCommands[i].complete(sh, len(words)-2)
+	}
+
+	var matches []string
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, prefix) {
+			matches = append(matches, candidate)
+		}
+	}
+	if len(matches) == 0 {
+		return "", 0, false
+	}
+
+	completion := matches[0]
+	for _, match := range matches[1:] {
+		for !strings.HasPrefix(match, completion) {
+			completion = completion[:len(completion)-1]
+		}
+	}
+	if len(matches) == 1 {
+		completion += " "
+	}
+	if completion == prefix {
+		return "", 0, false
+	}
+
+	newHead := head[:len(head)-len(prefix)] + completion
+	return newHead + line[pos:], len(newHead), true
+}
+
+// completeOpen offers the port names, then common baud rates
+func (sh *shell) completeOpen(arg int) []string {
+	switch arg {
+	case 0:
+		if sh.ports == nil {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+			_ = sh.refreshPorts(ctx)
+		}
+		return sh.ports
+	case 1:
+		return []string{"9600", "19200", "38400", "57600", "115200", "230400", "460800", "921600"}
+	}
+	return nil
+}
+
+// refreshPorts fetches the port names for completion
+func (sh *shell) refreshPorts(ctx context.Context) error {
+	resp, err := sh.client.ListPorts(ctx, &pb.ListPortsRequest{})
+	if err != nil {
+		return err
+	}
+	sh.ports = sh.ports[:0]
+	for _, port := range resp.Ports {
+		sh.ports = append(sh.ports, port.Name)
+	}
+	return nil
+}
+
+// requirePort fails unless a port is open
+func (sh *shell) requirePort() error {
+	if sh.sessionID == "" {
+		return errors.New(`no port is open, use "open PORT"`)
+	}
+	return nil
+}
+
+func (sh *shell) open(ctx context.Context, args []string) error {
+	if len(args) < 1 || len(args) > 2 {
+		return errors.New("usage: open PORT [BAUD]")
+	}
+	if sh.sessionID != "" {
+		return fmt.Errorf("%s is open, close it first", sh.portName)
+	}
+
+	req := &pb.OpenPortRequest{
+		PortName:  args[0],
+		ClientId:  sh.clientID,
+		Exclusive: true,
+	}
+	if len(args) == 2 {
+		baud, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid baud rate %q", args[1])
+		}
+		req.Config = &pb.PortConfig{
+			BaudRate:    uint32(baud),
+			DataBits:    pb.DataBits_DATA_BITS_8,
+			StopBits:    pb.StopBits_STOP_BITS_1,
+			Parity:      pb.Parity_PARITY_NONE,
+			FlowControl: pb.FlowControl_FLOW_CONTROL_NONE,
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := sh.client.OpenPort(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to open port: %w", err)
+	}
+	if !resp.Success {
+		return fmt.Errorf("failed to open port: %s", resp.Message)
+	}
+
+	sh.portName = args[0]
+	sh.sessionID = resp.SessionId
+	fmt.Printf("Opened %s (Session: %s)\n", sh.portName, sh.sessionID)
+	return nil
+}
+
+func (sh *shell) close(ctx context.Context, args []string) error {
+	if err := sh.requirePort(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := sh.client.ClosePort(ctx, &pb.ClosePortRequest{
+		PortName:  sh.portName,
+		SessionId: sh.sessionID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to close port: %w", err)
+	}
+	if !resp.Success {
+		return fmt.Errorf("failed to close port: %s", resp.Message)
+	}
+
+	fmt.Printf("Closed %s\n", sh.portName)
+	sh.portName, sh.sessionID = "", ""
+	return nil
+}
+
+// closeOnExit releases the port the shell still holds
+func (sh *shell) closeOnExit() {
+	if sh.sessionID == "" {
+		return
+	}
+	if err := sh.close(context.Background(), nil); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+}
+
+func (sh *shell) send(ctx context.Context, args []string) error {
+	if err := sh.requirePort(); err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return errors.New("usage: send DATA...")
+	}
+
+	var data []byte
+	if sh.hex {
+		decoded, err := hex.DecodeString(strings.Join(args, ""))
+		if err != nil {
+			return fmt.Errorf("invalid hex data: %w", err)
+		}
+		data = decoded
+	} else {
+		data = append([]byte(strings.Join(args, " ")), sh.eol...)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := sh.client.Write(ctx, &pb.WriteRequest{
+		PortName:  sh.portName,
+		SessionId: sh.sessionID,
+		Data:      data,
+		Flush:     true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write to port: %w", err)
+	}
+	if resp.ApprovalId != "" {
+		fmt.Printf("Write held for approval (%s)\n", resp.Message)
+		fmt.Printf("Another operator must run: seriallink approvals approve %s\n", resp.ApprovalId)
+		return nil
+	}
+	if !resp.Success {
+		return fmt.Errorf("write operation failed: %s", resp.Message)
+	}
+
+	if IsVerbose() {
+		fmt.Printf("Wrote %d bytes\n", resp.BytesWritten)
+	}
+	return nil
+}
+
+func (sh *shell) expect(ctx context.Context, args []string) error {
+	if err := sh.requirePort(); err != nil {
+		return err
+	}
+	if len(args) < 1 || len(args) > 2 {
+		return errors.New("usage: expect PATTERN [TIMEOUT_MS]")
+	}
+	pattern, err := regexp.Compile(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid pattern: %w", err)
+	}
+	timeout, err := shellTimeoutArg(args[1:])
+	if err != nil {
+		return err
+	}
+
+	var received []byte
+	deadline := time.Now().Add(timeout)
+	for !pattern.Match(received) {
+		remaining := time.Until(deadline)
+		if remaining <= 0 || ctx.Err() != nil {
+			sh.printData(received)
+			return fmt.Errorf("no match for %q within %s", pattern, timeout)
+		}
+
+		data, err := sh.readWithin(ctx, remaining)
+		if err != nil {
+			sh.printData(received)
+			return err
+		}
+		received = append(received, data...)
+	}
+
+	sh.printData(received)
+	return nil
+}
+
+func (sh *shell) read(ctx context.Context, args []string) error {
+	if err := sh.requirePort(); err != nil {
+		return err
+	}
+	if len(args) > 1 {
+		return errors.New("usage: read [TIMEOUT_MS]")
+	}
+	timeout, err := shellTimeoutArg(args)
+	if err != nil {
+		return err
+	}
+
+	data, err := sh.readWithin(ctx, timeout)
+	if err != nil {
+		return err
+	}
+	if len(data) == 0 && IsVerbose() {
+		fmt.Println("No data available")
+	}
+	sh.printData(data)
+	return nil
+}
+
+// readWithin reads the data arriving within timeout
+func (sh *shell) readWithin(ctx context.Context, timeout time.Duration) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout+2*time.Second)
+	defer cancel()
+
+	resp, err := sh.client.Read(ctx, &pb.ReadRequest{
+		PortName:  sh.portName,
+		SessionId: sh.sessionID,
+		MaxBytes:  4096,
+		TimeoutMs: uint32(max(timeout.Milliseconds(), 1)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read from port: %w", err)
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("read operation failed: %s", resp.Message)
+	}
+	return resp.Data, nil
+}
+
+// shellTimeoutArg parses an optional timeout in milliseconds
+func shellTimeoutArg(args []string) (time.Duration, error) {
+	if len(args) == 0 {
+		return shellTimeout, nil
+	}
+	ms, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout %q", args[0])
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+// printData prints received data as text or, in hex mode, as a hex dump
+func (sh *shell) printData(data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	if sh.hex {
+		fmt.Print(hex.Dump(data))
+		return
+	}
+	fmt.Print(string(data))
+	if data[len(data)-1] != '\n' {
+		fmt.Println()
+	}
+}
+
+func (sh *shell) setHex(ctx context.Context, args []string) error {
+	if len(args) != 1 || (args[0] != "on" && args[0] != "off") {
+		return errors.New("usage: hex on|off")
+	}
+	sh.hex = args[0] == "on"
+	return nil
+}
+
+func (sh *shell) setEOL(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: eol none|cr|lf|crlf")
+	}
+	switch args[0] {
+	case "none":
+		sh.eol = nil
+	case "cr":
+		sh.eol = []byte("\r")
+	case "lf":
+		sh.eol = []byte("\n")
+	case "crlf":
+		sh.eol = []byte("\r\n")
+	default:
+		return errors.New("usage: eol none|cr|lf|crlf")
+	}
+	return nil
+}
+
+func (sh *shell) status(ctx context.Context, args []string) error {
+	if err := sh.requirePort(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := sh.client.GetPortStatus(ctx, &pb.GetPortStatusRequest{PortName: sh.portName})
+	if err != nil {
+		return fmt.Errorf("failed to get port status: %w", err)
+	}
+	return printStatusTable(resp.Status)
+}
+
+func (sh *shell) listPorts(ctx context.Context, args []string) error {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	if err := sh.refreshPorts(ctx); err != nil {
+		return fmt.Errorf("failed to list ports: %w", err)
+	}
+	if len(sh.ports) == 0 {
+		fmt.Println("No serial ports found.")
+	}
+	for _, name := range sh.ports {
+		fmt.Println(name)
+	}
+	return nil
+}
+
+// loadShellHistory returns the latest commands of the history file
+func loadShellHistory(path string) []string {
+	if path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(lines) > shellHistorySize {
+		lines = lines[len(lines)-shellHistorySize:]
+	}
+	return slices.DeleteFunc(lines, func(line string) bool { return line == "" })
+}
+
+// appendShellHistory adds a command to the history file
+func appendShellHistory(path, line string) {
+	if path == "" {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+	_, _ = fmt.Fprintln(file, line)
+}
@@ -19,13 +19,16 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
 	"time"
 
 	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 var statusCmd = &cobra.Command{
@@ -35,7 +38,9 @@ var statusCmd = &cobra.Command{
 
 Example:
   seriallink status COM1                  # Get port status
-  seriallink status COM1 --json           # Output as JSON`,
+  seriallink status COM1 --json           # Output as JSON
+  seriallink status COM1 --watch          # Print a snapshot every second and on every change
+  seriallink status COM1 --watch --on-change --interval 250ms  # Only print changes`,
 	Args: cobra.ExactArgs(1),
 	RunE: runStatus,
 }
@@ -44,23 +49,30 @@ func init() {
 	rootCmd.AddCommand(statusCmd)
 
 	statusCmd.Flags().Bool("json", false, "output in JSON format")
+	statusCmd.Flags().BoolP("watch", "w", false, "stream status snapshots until interrupted")
+	statusCmd.Flags().Duration("interval", time.Second, "snapshot interval with --watch")
+	statusCmd.Flags().Bool("on-change", false, "with --watch, only print snapshots that changed")
 }
 
 func runStatus(cmd *cobra.Command, args []string) error {
 	portName := args[0]
 	jsonOutput, _ := cmd.Flags().GetBool("json")
+	watch, _ := cmd.Flags().GetBool("watch")
+
+	if watch {
+		interval, _ := cmd.Flags().GetDuration("interval")
+		onChange, _ := cmd.Flags().GetBool("on-change")
+		return watchStatus(portName, interval, onChange, jsonOutput)
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	addr := GetAddress()
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	client, err := dialService()
 	if err != nil {
-		return fmt.Errorf("failed to connect to service at %s: %w", addr, err)
+		return err
 	}
-	defer conn.Close()
-
-	client := pb.NewSerialServiceClient(conn)
+	defer client.Close()
 
 	resp, err := client.GetPortStatus(ctx, &pb.GetPortStatusRequest{
 		PortName: portName,
@@ -76,15 +88,85 @@ func runStatus(cmd *cobra.Command, args []string) error {
 	return printStatusTable(resp.Status)
 }
 
+// watchStatus prints a line per status snapshot until interrupted
+func watchStatus(portName string, interval time.Duration, onChange, jsonOutput bool) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	stream, err := client.StreamPortStatus(ctx, &pb.StreamPortStatusRequest{
+		PortName:   portName,
+		IntervalMs: uint32(interval.Milliseconds()),
+		OnChange:   onChange,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stream port status: %w", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("port status stream failed: %w", err)
+		}
+
+		if jsonOutput {
+			_ = encoder.Encode(resp)
+			continue
+		}
+
+		at := time.Unix(0, resp.Timestamp).Format("15:04:05.000")
+		st := resp.Status
+		if !st.IsOpen || st.Statistics == nil {
+			fmt.Printf("%s  %s  closed%s\n", at, st.PortName, closeReasonSuffix(st.CloseReason))
+			continue
+		}
+		fmt.Printf("%s  %s  open  tx %s  rx %s  errors %d  %s\n", at, st.PortName,
+			formatBytes(int64(st.Statistics.BytesSent)), formatBytes(int64(st.Statistics.BytesReceived)),
+			st.Statistics.Errors, getPowerStateString(st.PowerState))
+	}
+}
+
 func printStatusTable(status *pb.PortStatus) error {
 	fmt.Printf("Port: %s\n", status.PortName)
 	fmt.Printf("  Status:         %s\n", getStatusString(status.IsOpen))
+	if !status.IsOpen && status.ClosedAt > 0 {
+		fmt.Printf("  Closed:         %s (%s)\n", time.Unix(0, status.ClosedAt).Format(time.RFC3339), getCloseReasonString(status.CloseReason))
+	}
 	fmt.Printf("  Locked:         %v\n", status.IsLocked)
 	if status.LockedBy != "" {
 		fmt.Printf("  Locked By:      %s\n", status.LockedBy)
 	}
 	if status.SessionId != "" {
 		fmt.Printf("  Session ID:     %s\n", status.SessionId)
+		if status.ShortSessionId != "" {
+			fmt.Printf("  Short ID:       %s\n", status.ShortSessionId)
+		}
+		fmt.Printf("  Priority:       %s\n", getPriorityString(status.Priority))
+		fmt.Printf("  Power:          %s\n", getPowerStateString(status.PowerState))
+		if status.Shaping != nil {
+			fmt.Printf("  Shaping:        %s\n", formatShaping(status.Shaping))
+		}
+	}
+
+	if len(status.Metadata) > 0 {
+		fmt.Printf("\nMetadata:\n")
+		keys := make([]string, 0, len(status.Metadata))
+		for key := range status.Metadata {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Printf("  %s: %s\n", key, status.Metadata[key])
+		}
 	}
 
 	if status.CurrentConfig != nil {
@@ -94,6 +176,8 @@ func printStatusTable(status *pb.PortStatus) error {
 		fmt.Printf("  Stop Bits:      %s\n", getStopBitsString(status.CurrentConfig.StopBits))
 		fmt.Printf("  Parity:         %s\n", getParityString(status.CurrentConfig.Parity))
 		fmt.Printf("  Flow Control:   %s\n", getFlowControlString(status.CurrentConfig.FlowControl))
+		fmt.Printf("  Latency:        %s\n", getLatencyProfileString(status.CurrentConfig.LatencyProfile))
+		fmt.Printf("  DTR/RTS:        %s/%s on open\n", getLineStateString(status.CurrentConfig.Dtr), getLineStateString(status.CurrentConfig.Rts))
 	}
 
 	if status.Statistics != nil {
@@ -102,6 +186,11 @@ func printStatusTable(status *pb.PortStatus) error {
 		fmt.Printf("  Bytes Sent:     %d\n", stats.BytesSent)
 		fmt.Printf("  Bytes Received: %d\n", stats.BytesReceived)
 		fmt.Printf("  Errors:         %d\n", stats.Errors)
+		if stats.GarbageBytes > 0 || stats.BreakCount > 0 {
+			fmt.Printf("  Garbage Bytes:  %d\n", stats.GarbageBytes)
+			fmt.Printf("  Breaks:         %d\n", stats.BreakCount)
+			fmt.Printf("  Line Quality:   %.0f%%\n", stats.LineQuality*100)
+		}
 		if stats.OpenedAt > 0 {
 			openTime := time.Unix(0, stats.OpenedAt)
 			fmt.Printf("  Opened At:      %s\n", openTime.Format(time.RFC3339))
@@ -188,3 +277,66 @@ func getFlowControlString(fc pb.FlowControl) string {
 		return "unknown"
 	}
 }
+
+func getLatencyProfileString(lp pb.LatencyProfile) string {
+	switch lp {
+	case pb.LatencyProfile_LATENCY_PROFILE_LOW:
+		return "low"
+	default:
+		return "default"
+	}
+}
+
+func getLineStateString(ls pb.LineState) string {
+	switch ls {
+	case pb.LineState_LINE_STATE_HIGH:
+		return "high"
+	case pb.LineState_LINE_STATE_LOW:
+		return "low"
+	case pb.LineState_LINE_STATE_UNTOUCHED:
+		return "untouched"
+	default:
+		return "default"
+	}
+}
+
+func getPriorityString(p pb.SessionPriority) string {
+	switch p {
+	case pb.SessionPriority_SESSION_PRIORITY_BULK:
+		return "bulk"
+	case pb.SessionPriority_SESSION_PRIORITY_CRITICAL:
+		return "critical"
+	default:
+		return "normal"
+	}
+}
+
+func getCloseReasonString(r pb.CloseReason) string {
+	switch r {
+	case pb.CloseReason_CLOSE_REASON_CLIENT:
+		return "closed by client"
+	case pb.CloseReason_CLOSE_REASON_FORCED:
+		return "forced"
+	case pb.CloseReason_CLOSE_REASON_DEVICE_REMOVED:
+		return "device removed"
+	case pb.CloseReason_CLOSE_REASON_AGENT_SHUTDOWN:
+		return "agent shutdown"
+	default:
+		return "unknown"
+	}
+}
+
+// closeReasonSuffix describes why a port closed, if known
+func closeReasonSuffix(r pb.CloseReason) string {
+	if r == pb.CloseReason_CLOSE_REASON_UNSPECIFIED {
+		return ""
+	}
+	return " (" + getCloseReasonString(r) + ")"
+}
+
+func getPowerStateString(p pb.PowerState) string {
+	if p == pb.PowerState_POWER_STATE_DORMANT {
+		return "dormant"
+	}
+	return "active"
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var streamsCmd = &cobra.Command{
+	Use:   "streams",
+	Short: "List read subscriptions and how far they lag",
+	Long: `List every consumer of received data (read streams, SSE subscriptions,
+console loggers, device trackers, bridges) with the chunks delivered to it
+and dropped because it fell too far behind, and the data still waiting for
+it. A stream that keeps dropping or lagging is a slow consumer.
+
+Example:
+  seriallink streams
+  seriallink streams --port /dev/ttyUSB0 --json`,
+	Args: cobra.NoArgs,
+	RunE: runStreams,
+}
+
+func init() {
+	rootCmd.AddCommand(streamsCmd)
+
+	streamsCmd.Flags().String("port", "", "only list streams of this port")
+	streamsCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+func runStreams(cmd *cobra.Command, args []string) error {
+	portName, _ := cmd.Flags().GetString("port")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.ListStreams(ctx, &pb.ListStreamsRequest{PortName: portName})
+	if err != nil {
+		return fmt.Errorf("failed to list streams: %w", err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp)
+	}
+
+	if len(resp.Streams) == 0 {
+		fmt.Println("No streams")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tPORT\tCLIENT\tCONSUMER\tDELIVERED\tDROPPED\tQUEUED\tLAG")
+	fmt.Fprintln(w, "--\t----\t------\t--------\t---------\t-------\t------\t---")
+	for _, stream := range resp.Streams {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d (%s)\t%d (%s)\t%d (%s)\t%s\n",
+			stream.Id, stream.PortName, stream.ClientId, stream.Consumer,
+			stream.DeliveredChunks, formatBytes(int64(stream.DeliveredBytes)),
+			stream.DroppedChunks, formatBytes(int64(stream.DroppedBytes)),
+			stream.QueuedChunks, formatBytes(stream.QueuedBytes),
+			time.Duration(stream.LagMs)*time.Millisecond)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var testsCmd = &cobra.Command{
+	Use:   "tests",
+	Short: "List the configured test suites",
+	Long: `List the test suites configured under testing.suites. Suites send
+commands to a device and check the responses, e.g. for end-of-line tests;
+every run writes JUnit XML and JSON reports on the agent.
+
+Example:
+  seriallink tests
+  seriallink tests run board-eol COM3 --session-id <id>
+  seriallink tests run board-eol COM3 --session-id <id> --json`,
+	Args: cobra.NoArgs,
+	RunE: runTests,
+}
+
+var testsRunCmd = &cobra.Command{
+	Use:   "run SUITE PORT [flags]",
+	Short: "Run a test suite on an open port",
+	Long: `Run a test suite on an open port and print the result of every step.
+The command exits non-zero when a step fails.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runTestsRun,
+}
+
+func init() {
+	rootCmd.AddCommand(testsCmd)
+	testsCmd.AddCommand(testsRunCmd)
+
+	testsCmd.Flags().Bool("json", false, "output in JSON format")
+	testsRunCmd.Flags().String("session-id", "", "session ID")
+	testsRunCmd.Flags().Duration("timeout", 5*time.Minute, "time allowed for the whole suite")
+	testsRunCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+func runTests(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.ListTestSuites(ctx, &pb.ListTestSuitesRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list test suites: %w", err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp.Suites)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SUITE\tPORT\tON OPEN\tSTEPS")
+	fmt.Fprintln(w, "-----\t----\t-------\t-----")
+	for _, suite := range resp.Suites {
+		port := suite.Port
+		if port == "" {
+			port = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%t\t%s\n", suite.Name, port, suite.RunOnOpen, strings.Join(suite.Steps, ", "))
+	}
+	return w.Flush()
+}
+
+func runTestsRun(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	resp, err := client.RunTestSuite(ctx, &pb.RunTestSuiteRequest{
+		Suite:     args[0],
+		PortName:  args[1],
+		SessionId: sessionID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to run test suite: %w", err)
+	}
+	report := resp.Report
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return err
+		}
+	} else {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "STEP\tRESULT\tTIME\tMESSAGE")
+		fmt.Fprintln(w, "----\t------\t----\t-------")
+		for _, step := range report.Steps {
+			fmt.Fprintf(w, "%s\t%s\t%dms\t%s\n", step.Name, stepResult(step), step.DurationMs, step.Message)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		for _, step := range report.Steps {
+			if step.Diff != "" {
+				fmt.Printf("\n%s: received %s\n%s", step.Name, strconv.QuoteToASCII(string(step.Response)), step.Diff)
+			}
+		}
+		fmt.Println()
+		for _, path := range report.ReportFiles {
+			fmt.Printf("Report: %s\n", path)
+		}
+	}
+
+	if !report.Passed {
+		return fmt.Errorf("test suite %s failed", report.Suite)
+	}
+	if !jsonOutput {
+		fmt.Printf("Test suite %s passed in %dms\n", report.Suite, report.DurationMs)
+	}
+	return nil
+}
+
+// stepResult names the outcome of a test step
+func stepResult(step *pb.TestStepResult) string {
+	switch {
+	case step.Skipped:
+		return "SKIP"
+	case step.Passed:
+		return "PASS"
+	}
+	return "FAIL"
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var timingCmd = &cobra.Command{
+	Use:   "timing PORT",
+	Short: "Capture received data with per-read timestamps",
+	Long: `Read from an open port and print every chunk with nanosecond
+timestamps taken around the read system call, and the gap since the
+previous chunk in microseconds and in character times.
+
+With --per-byte every byte is read and timestamped on its own, for
+protocols where inter-byte gaps are meaningful. The capture consumes the
+received data and cannot run alongside a StreamRead on the same port.
+
+Example:
+  seriallink timing COM1 --session-id abc123
+  seriallink timing COM1 --session-id abc123 --per-byte --duration 10s
+  seriallink timing COM1 --session-id abc123 --csv > capture.csv`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTiming,
+}
+
+func init() {
+	rootCmd.AddCommand(timingCmd)
+
+	timingCmd.Flags().String("session-id", "", "session ID")
+	timingCmd.Flags().Bool("per-byte", false, "read and timestamp one byte at a time")
+	timingCmd.Flags().Duration("duration", 0, "stop after this long (default: until interrupted)")
+	timingCmd.Flags().Bool("csv", false, "output in CSV format")
+	timingCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+func runTiming(cmd *cobra.Command, args []string) error {
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	perByte, _ := cmd.Flags().GetBool("per-byte")
+	duration, _ := cmd.Flags().GetDuration("duration")
+	csvOutput, _ := cmd.Flags().GetBool("csv")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	if csvOutput && jsonOutput {
+		return fmt.Errorf("--csv and --json are mutually exclusive")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	stream, err := client.StreamTimedRead(ctx, &pb.StreamTimedReadRequest{
+		PortName:   args[0],
+		SessionId:  sessionID,
+		PerByte:    perByte,
+		DurationMs: uint32(duration.Milliseconds()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start timing capture: %w", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	writer := csv.NewWriter(os.Stdout)
+	defer writer.Flush()
+	if csvOutput {
+		_ = writer.Write([]string{"sequence", "started_ns", "completed_ns", "gap_ns", "gap_chars", "length", "hex"})
+	} else if !jsonOutput {
+		fmt.Printf("%-8s %-15s %12s %9s %5s  %s\n", "SEQ", "TIME", "GAP(us)", "GAP(chr)", "LEN", "DATA")
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("timing capture failed: %w", err)
+		}
+
+		chunk := resp.Chunk
+		gapChars := 0.0
+		if chunk.CharTimeNs > 0 {
+			gapChars = float64(chunk.GapNs) / float64(chunk.CharTimeNs)
+		}
+
+		switch {
+		case jsonOutput:
+			_ = encoder.Encode(map[string]interface{}{
+				"sequence":     chunk.Sequence,
+				"started_ns":   chunk.StartedAt,
+				"completed_ns": chunk.CompletedAt,
+				"gap_ns":       chunk.GapNs,
+				"gap_chars":    gapChars,
+				"char_time_ns": chunk.CharTimeNs,
+				"data":         fmt.Sprintf("%x", chunk.Data),
+			})
+		case csvOutput:
+			_ = writer.Write([]string{
+				strconv.FormatUint(uint64(chunk.Sequence), 10),
+				strconv.FormatInt(chunk.StartedAt, 10),
+				strconv.FormatInt(chunk.CompletedAt, 10),
+				strconv.FormatInt(chunk.GapNs, 10),
+				strconv.FormatFloat(gapChars, 'f', 2, 64),
+				strconv.Itoa(len(chunk.Data)),
+				fmt.Sprintf("%x", chunk.Data),
+			})
+			writer.Flush()
+		default:
+			fmt.Printf("%-8d %-15s %12.1f %9.2f %5d  %s  %s\n",
+				chunk.Sequence,
+				time.Unix(0, chunk.CompletedAt).Format("15:04:05.000000"),
+				float64(chunk.GapNs)/float64(time.Microsecond),
+				gapChars,
+				len(chunk.Data),
+				hexBytes(chunk.Data),
+				printableASCII(chunk.Data))
+		}
+	}
+}
+
+// hexBytes formats data as space-separated hex bytes
+func hexBytes(data []byte) string {
+	parts := make([]string, len(data))
+	for i, b := range data {
+		parts[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(parts, " ")
+}
+
+// printableASCII shows data as ASCII with non-printable bytes as dots
+func printableASCII(data []byte) string {
+	out := make([]byte, len(data))
+	for i, b := range data {
+		if b >= 0x20 && b < 0x7f {
+			out[i] = b
+		} else {
+			out[i] = '.'
+		}
+	}
+	return string(out)
+}
@@ -0,0 +1,361 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/Shoaibashk/SerialLink/config"
+	"github.com/Shoaibashk/SerialLink/internal/auth"
+	"github.com/spf13/cobra"
+)
+
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Manage the API tokens accepted by the agent",
+	Long: `Issue and revoke the API tokens clients present when auth.enabled is set.
+Tokens are kept in the token file (auth.token_file, default: tokens.json
+next to the config file) as SHA-256 hashes; the agent reads the file again
+when it changes, so new and revoked tokens take effect without a restart.
+
+Run it on the agent's host; the file is found through the config file
+(--config). Clients pass a token with --token or SERIALLINK_TOKEN.
+
+Example:
+  seriallink token create ci-runner
+  seriallink token create contractor --expires 720h
+  seriallink token create ci-runner --group ci
+  seriallink token list
+  seriallink token revoke contractor
+  seriallink token delegate /dev/ttyUSB0 --name lab-dashboard
+  seriallink token link /dev/ttyUSB0 --name vendor --once`,
+}
+
+var tokenCreateCmd = &cobra.Command{
+	Use:   "create NAME",
+	Short: "Issue a token",
+	Long: `Issue a token and print it. Only its hash is stored, so it cannot be
+shown again. NAME identifies the client: calls made with the token act as
+"token:NAME" for reservations, approvals, recordings and access rules,
+which can also grant ports to the token's groups ("group:NAME").`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTokenCreate,
+}
+
+var tokenListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List issued tokens",
+	Args:  cobra.NoArgs,
+	RunE:  runTokenList,
+}
+
+var tokenRevokeCmd = &cobra.Command{
+	Use:   "revoke NAME",
+	Short: "Revoke a token",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runTokenRevoke,
+}
+
+var tokenDelegateCmd = &cobra.Command{
+	Use:   "delegate PORT",
+	Short: "Mint a short-lived access token for one port",
+	Long: `Ask the agent for an access token limited to one port, to embed in a
+browser dashboard without handing out long-lived credentials. The token is
+read-only unless --allow-write is given and expires after --ttl (default
+30m, at most auth.access_tokens.max_seconds). Unlike the other token
+commands this one calls the agent, as one of auth.access_tokens.issuers.
+
+Access tokens cannot be revoked; keep them short-lived. Browsers pass them
+as the access_token query parameter of WebSocket and event stream URLs.
+
+Example:
+  seriallink token delegate /dev/ttyUSB0 --name lab-dashboard
+  seriallink token delegate COM3 --ttl 10m --allow-write`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTokenDelegate,
+}
+
+var tokenLinkCmd = &cobra.Command{
+	Use:   "link PORT",
+	Short: "Mint a signed link to follow one port's data",
+	Long: `Ask the agent for links to its event stream and WebSocket gateway that
+allow nothing but following the data read from one port, to share a
+console feed with someone without an account. The links carry a signed
+token, expire after --ttl (default 30m, at most
+auth.access_tokens.max_seconds) and, with --once, are accepted a single
+time. Like delegate this calls the agent, as one of
+auth.access_tokens.issuers.
+
+The event stream link works with curl -N or a browser's EventSource; over
+the WebSocket link, send a "monitor" frame for the port.
+
+Example:
+  seriallink token link /dev/ttyUSB0 --name vendor
+  seriallink token link COM3 --ttl 2h --once`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTokenLink,
+}
+
+func init() {
+	rootCmd.AddCommand(tokenCmd)
+	tokenCmd.AddCommand(tokenCreateCmd)
+	tokenCmd.AddCommand(tokenListCmd)
+	tokenCmd.AddCommand(tokenRevokeCmd)
+	tokenCmd.AddCommand(tokenDelegateCmd)
+	tokenCmd.AddCommand(tokenLinkCmd)
+
+	tokenCreateCmd.Flags().Duration("expires", 0, "lifetime of the token, e.g. 720h (default: no expiry)")
+	tokenCreateCmd.Flags().StringSlice("group", nil, "group of the token's client for access rules (repeatable)")
+	tokenListCmd.Flags().Bool("json", false, "output in JSON format")
+	tokenDelegateCmd.Flags().String("name", "", "name of the token's holder, who acts as access:NAME (default: your identity)")
+	tokenDelegateCmd.Flags().Duration("ttl", 0, "lifetime of the token (default: 30m)")
+	tokenDelegateCmd.Flags().Bool("allow-write", false, "also allow opening, writing, configuring and closing the port")
+	tokenDelegateCmd.Flags().Bool("json", false, "output in JSON format")
+	tokenLinkCmd.Flags().String("name", "", "name of the link's holder, who acts as access:NAME (default: your identity)")
+	tokenLinkCmd.Flags().Duration("ttl", 0, "lifetime of the link (default: 30m)")
+	tokenLinkCmd.Flags().Bool("once", false, "accept the link a single time")
+	tokenLinkCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+// tokenFilePath is the token file of the configuration
+func tokenFilePath(cfg *config.Config) string {
+	return configRelativeDir(cfg.Auth.TokenFile, "tokens.json")
+}
+
+// accessKeyPath is the access token key file of the configuration
+func accessKeyPath(cfg *config.Config) string {
+	return configRelativeDir(cfg.Auth.AccessTokens.KeyFile, "access_token.key")
+}
+
+func runTokenCreate(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	expires, _ := cmd.Flags().GetDuration("expires")
+	groups, _ := cmd.Flags().GetStringSlice("group")
+	if expires < 0 {
+		return fmt.Errorf("--expires must not be negative")
+	}
+
+	cfg, err := GetConfig()
+	if err != nil {
+		return err
+	}
+	for _, t := range cfg.Auth.Tokens {
+		if t.Name == name {
+			return fmt.Errorf("%w: %s (in auth.tokens)", auth.ErrDuplicateName, name)
+		}
+	}
+
+	secret, err := auth.Generate()
+	if err != nil {
+		return fmt.Errorf("failed to generate token: %w", err)
+	}
+	token := auth.Token{Name: name, SHA256: auth.Hash(secret), CreatedAt: time.Now().UTC(), Groups: groups}
+	if expires > 0 {
+		token.ExpiresAt = token.CreatedAt.Add(expires)
+	}
+
+	path := tokenFilePath(cfg)
+	if err := auth.AddToFile(path, token); err != nil {
+		return fmt.Errorf("failed to save token: %w", err)
+	}
+
+	fmt.Println(secret)
+	fmt.Fprintf(os.Stderr, "Token %q saved to %s; it is not shown again.\n", name, path)
+	if !token.ExpiresAt.IsZero() {
+		fmt.Fprintf(os.Stderr, "It expires %s.\n", token.ExpiresAt.Local().Format(time.RFC1123))
+	}
+	if !cfg.Auth.Enabled {
+		fmt.Fprintln(os.Stderr, "Tokens are only checked with auth.enabled set in the config file.")
+	}
+	return nil
+}
+
+func runTokenList(cmd *cobra.Command, args []string) error {
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	cfg, err := GetConfig()
+	if err != nil {
+		return err
+	}
+	tokens, err := auth.LoadFile(tokenFilePath(cfg))
+	if err != nil {
+		return err
+	}
+
+	type entry struct {
+		auth.Token
+		Source string `json:"source"`
+	}
+	entries := make([]entry, 0, len(cfg.Auth.Tokens)+len(tokens))
+	for _, t := range cfg.Auth.Tokens {
+		entries = append(entries, entry{Token: t.ToToken(), Source: "config"})
+	}
+	for _, t := range tokens {
+		entries = append(entries, entry{Token: t, Source: "token file"})
+	}
+
+	if jsonOutput {
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if len(entries) == 0 {
+		fmt.Println("No tokens issued")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSOURCE\tCREATED\tEXPIRES\tGROUPS")
+	now := time.Now()
+	for _, e := range entries {
+		created, expires := "-", "never"
+		if !e.CreatedAt.IsZero() {
+			created = e.CreatedAt.Local().Format("2006-01-02 15:04")
+		}
+		if !e.ExpiresAt.IsZero() {
+			expires = e.ExpiresAt.Local().Format("2006-01-02 15:04")
+			if e.Expired(now) {
+				expires += " (expired)"
+			}
+		}
+		groups := "-"
+		if len(e.Groups) > 0 {
+			groups = strings.Join(e.Groups, ",")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", e.Name, e.Source, created, expires, groups)
+	}
+	return w.Flush()
+}
+
+func runTokenRevoke(cmd *cobra.Command, args []string) error {
+	cfg, err := GetConfig()
+	if err != nil {
+		return err
+	}
+	path := tokenFilePath(cfg)
+	if err := auth.RemoveFromFile(path, args[0]); err != nil {
+		for _, t := range cfg.Auth.Tokens {
+			if t.Name == args[0] {
+				return fmt.Errorf("token %q is configured in auth.tokens; remove it from the config file", args[0])
+			}
+		}
+		return err
+	}
+	fmt.Printf("Revoked token %q\n", args[0])
+	return nil
+}
+
+func runTokenDelegate(cmd *cobra.Command, args []string) error {
+	name, _ := cmd.Flags().GetString("name")
+	ttl, _ := cmd.Flags().GetDuration("ttl")
+	allowWrite, _ := cmd.Flags().GetBool("allow-write")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	if ttl < 0 || (ttl > 0 && ttl < time.Second) {
+		return fmt.Errorf("--ttl must be at least 1s")
+	}
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.CreateAccessToken(ctx, &pb.CreateAccessTokenRequest{
+		Name:       name,
+		PortName:   args[0],
+		AllowWrite: allowWrite,
+		TtlSeconds: uint32(ttl / time.Second),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mint access token: %w", err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp)
+	}
+	fmt.Println(resp.Token)
+	access := "read-only"
+	if allowWrite {
+		access = "read-write"
+	}
+	fmt.Fprintf(os.Stderr, "Access token for %s (%s, acting as %s) expires %s.\n",
+		args[0], access, resp.Identity, time.Unix(0, resp.ExpiresAt).Local().Format(time.RFC1123))
+	return nil
+}
+
+func runTokenLink(cmd *cobra.Command, args []string) error {
+	name, _ := cmd.Flags().GetString("name")
+	ttl, _ := cmd.Flags().GetDuration("ttl")
+	once, _ := cmd.Flags().GetBool("once")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	if ttl < 0 || (ttl > 0 && ttl < time.Second) {
+		return fmt.Errorf("--ttl must be at least 1s")
+	}
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.CreateStreamLink(ctx, &pb.CreateStreamLinkRequest{
+		Name:       name,
+		PortName:   args[0],
+		TtlSeconds: uint32(ttl / time.Second),
+		Once:       once,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mint stream link: %w", err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp)
+	}
+	if resp.EventsUrl != "" {
+		fmt.Printf("Events:    %s\n", resp.EventsUrl)
+	}
+	if resp.WebsocketUrl != "" {
+		fmt.Printf("WebSocket: %s\n", resp.WebsocketUrl)
+	}
+	use := "many times"
+	if once {
+		use = "once"
+	}
+	fmt.Fprintf(os.Stderr, "Stream link for %s (usable %s, acting as %s) expires %s.\n",
+		args[0], use, resp.Identity, time.Unix(0, resp.ExpiresAt).Local().Format(time.RFC1123))
+	return nil
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var uploadCmd = &cobra.Command{
+	Use:   "upload PORT FILE",
+	Short: "Flash firmware to an Arduino-style board",
+	Long: `Reset the board on a port into its bootloader and flash a firmware image
+(Intel HEX as built by the Arduino IDE, or a raw binary), then read it back
+to verify it.
+
+  stk500v1  optiboot and the classic Arduino bootloaders (Uno, Nano, Pro
+            Mini); reset with a DTR pulse at 115200 baud
+  avr109    Caterina (Leonardo, Micro, Pro Micro); reset with the 1200 bps
+            touch, after which the bootloader may show up on another port
+
+Use --reset none when the bootloader is running already, e.g. after
+pressing the reset button. The port must not be open; close monitor
+sessions first. FILE "-" reads standard input.
+
+Example:
+  seriallink upload /dev/ttyUSB0 blink.ino.hex
+  seriallink upload /dev/ttyACM0 blink.ino.hex --protocol avr109
+  seriallink upload COM5 firmware.hex --baud 57600   # old Nano bootloader`,
+	Args: cobra.ExactArgs(2),
+	RunE: runUpload,
+}
+
+func init() {
+	rootCmd.AddCommand(uploadCmd)
+
+	uploadCmd.Flags().String("protocol", "stk500v1", "bootloader protocol: stk500v1, avr109")
+	uploadCmd.Flags().String("reset", "auto", "reset into the bootloader: auto, dtr, 1200bps, none")
+	uploadCmd.Flags().Uint32("baud", 0, "bootloader baud rate (default 115200 for stk500v1, 57600 for avr109)")
+	uploadCmd.Flags().Bool("no-verify", false, "skip reading the flash back")
+	uploadCmd.Flags().String("client-id", "", "client ID of the upload's sessions")
+	uploadCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+func runUpload(cmd *cobra.Command, args []string) error {
+	portName, path := args[0], args[1]
+	protocolName, _ := cmd.Flags().GetString("protocol")
+	resetName, _ := cmd.Flags().GetString("reset")
+	baud, _ := cmd.Flags().GetUint32("baud")
+	noVerify, _ := cmd.Flags().GetBool("no-verify")
+	clientID, _ := cmd.Flags().GetString("client-id")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	protocol, err := parseBootloaderProtocol(protocolName)
+	if err != nil {
+		return err
+	}
+	reset, err := parseBootloaderReset(resetName)
+	if err != nil {
+		return err
+	}
+
+	var firmware []byte
+	if path == "-" {
+		firmware, err = io.ReadAll(os.Stdin)
+	} else {
+		firmware, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	stream, err := client.UploadFirmware(ctx, &pb.UploadFirmwareRequest{
+		PortName:    portName,
+		Firmware:    firmware,
+		Protocol:    protocol,
+		ResetMethod: reset,
+		BaudRate:    baud,
+		SkipVerify:  noVerify,
+		ClientId:    clientID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload: %w", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	var last *pb.UploadFirmwareResponse
+	quarter := -1
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("upload failed: %w", err)
+		}
+
+		if jsonOutput {
+			_ = encoder.Encode(resp)
+			continue
+		}
+		// Print stage changes, and writing and verifying in quarters
+		if last == nil || resp.Stage != last.Stage {
+			quarter = -1
+		}
+		if resp.BytesTotal > 0 && resp.Stage != pb.UploadStage_UPLOAD_STAGE_DONE {
+			if q := int(4 * uint64(resp.BytesDone) / uint64(resp.BytesTotal)); q != quarter {
+				quarter = q
+				fmt.Printf("%-10s %d/%d bytes (%d%%)\n", uploadStageName(resp.Stage), resp.BytesDone, resp.BytesTotal, 100*uint64(resp.BytesDone)/uint64(resp.BytesTotal))
+			}
+		} else if last == nil || resp.Stage != last.Stage {
+			fmt.Printf("%-10s %s %s\n", uploadStageName(resp.Stage), resp.PortName, resp.Message)
+		}
+		last = resp
+	}
+
+	if last == nil || last.Stage != pb.UploadStage_UPLOAD_STAGE_DONE {
+		return errors.New("upload ended early")
+	}
+	if !jsonOutput {
+		verified := "not verified"
+		if last.Verified {
+			verified = "verified"
+		}
+		fmt.Printf("Flashed %d bytes to %s on %s in %s (%s)\n", last.BytesTotal, last.Device, last.PortName,
+			(time.Duration(last.DurationMs) * time.Millisecond).Round(100*time.Millisecond), verified)
+	}
+	return nil
+}
+
+func parseBootloaderProtocol(s string) (pb.BootloaderProtocol, error) {
+	switch s {
+	case "stk500v1", "stk500", "arduino":
+		return pb.BootloaderProtocol_BOOTLOADER_PROTOCOL_STK500V1, nil
+	case "avr109", "caterina":
+		return pb.BootloaderProtocol_BOOTLOADER_PROTOCOL_AVR109, nil
+	}
+	return 0, fmt.Errorf("invalid protocol %q: use stk500v1 or avr109", s)
+}
+
+func parseBootloaderReset(s string) (pb.BootloaderReset, error) {
+	switch s {
+	case "auto", "":
+		return pb.BootloaderReset_BOOTLOADER_RESET_UNSPECIFIED, nil
+	case "dtr":
+		return pb.BootloaderReset_BOOTLOADER_RESET_DTR, nil
+	case "1200bps", "1200":
+		return pb.BootloaderReset_BOOTLOADER_RESET_TOUCH_1200, nil
+	case "none":
+		return pb.BootloaderReset_BOOTLOADER_RESET_NONE, nil
+	}
+	return 0, fmt.Errorf("invalid reset %q: use auto, dtr, 1200bps or none", s)
+}
+
+func uploadStageName(stage pb.UploadStage) string {
+	switch stage {
+	case pb.UploadStage_UPLOAD_STAGE_RESETTING:
+		return "resetting"
+	case pb.UploadStage_UPLOAD_STAGE_CONNECTING:
+		return "connecting"
+	case pb.UploadStage_UPLOAD_STAGE_ERASING:
+		return "erasing"
+	case pb.UploadStage_UPLOAD_STAGE_WRITING:
+		return "writing"
+	case pb.UploadStage_UPLOAD_STAGE_VERIFYING:
+		return "verifying"
+	case pb.UploadStage_UPLOAD_STAGE_DONE:
+		return "done"
+	default:
+		return "unknown"
+	}
+}
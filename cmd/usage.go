@@ -0,0 +1,130 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var usageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "Show port usage per client",
+	Long: `Show the cumulative use of each port per client: sessions, open time
+and bytes transferred, counted since accounting was enabled
+(usage.enabled in the agent config). Use --csv to export it for chargeback.
+
+Example:
+  seriallink usage
+  seriallink usage --client lab-team-a
+  seriallink usage --port COM1 --csv > usage.csv`,
+	Args: cobra.NoArgs,
+	RunE: runUsage,
+}
+
+func init() {
+	rootCmd.AddCommand(usageCmd)
+
+	usageCmd.Flags().String("client", "", "only show this client ID")
+	usageCmd.Flags().String("port", "", "only show this port")
+	usageCmd.Flags().Bool("json", false, "output in JSON format")
+	usageCmd.Flags().Bool("csv", false, "output in CSV format")
+}
+
+func runUsage(cmd *cobra.Command, args []string) error {
+	clientID, _ := cmd.Flags().GetString("client")
+	portName, _ := cmd.Flags().GetString("port")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	csvOutput, _ := cmd.Flags().GetBool("csv")
+	if jsonOutput && csvOutput {
+		return fmt.Errorf("use either --json or --csv")
+	}
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.GetUsageReport(ctx, &pb.GetUsageReportRequest{
+		ClientId: clientID,
+		PortName: portName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get usage report: %w", err)
+	}
+
+	switch {
+	case jsonOutput:
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(resp)
+	case csvOutput:
+		return printUsageCSV(resp.Records)
+	}
+
+	since := time.Unix(0, resp.Since).Format("2006-01-02 15:04")
+	if len(resp.Records) == 0 {
+		fmt.Printf("No usage since %s\n", since)
+		return nil
+	}
+
+	fmt.Printf("Usage since %s\n\n", since)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CLIENT\tPORT\tSESSIONS\tOPEN TIME\tSENT\tRECEIVED\tLAST USED")
+	fmt.Fprintln(w, "------\t----\t--------\t---------\t----\t--------\t---------")
+	for _, r := range resp.Records {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\t%s\n",
+			r.ClientId, r.PortName, r.Sessions,
+			(time.Duration(r.OpenSeconds) * time.Second).String(),
+			formatBytes(int64(r.BytesSent)), formatBytes(int64(r.BytesReceived)),
+			time.Unix(0, r.LastUsed).Format("2006-01-02 15:04"))
+	}
+	return w.Flush()
+}
+
+// printUsageCSV writes usage records as CSV with a header row
+func printUsageCSV(records []*pb.UsageRecord) error {
+	w := csv.NewWriter(os.Stdout)
+	_ = w.Write([]string{"client_id", "port", "sessions", "open_seconds", "bytes_sent", "bytes_received", "first_used", "last_used"})
+	for _, r := range records {
+		_ = w.Write([]string{
+			r.ClientId,
+			r.PortName,
+			strconv.FormatUint(r.Sessions, 10),
+			strconv.FormatFloat(r.OpenSeconds, 'f', 0, 64),
+			strconv.FormatUint(r.BytesSent, 10),
+			strconv.FormatUint(r.BytesReceived, 10),
+			time.Unix(0, r.FirstUsed).UTC().Format(time.RFC3339),
+			time.Unix(0, r.LastUsed).UTC().Format(time.RFC3339),
+		})
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2024 SerialLink Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
+	"github.com/spf13/cobra"
+)
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify PORT COMMAND [flags]",
+	Short: "Send a command and check the response",
+	Long: `Send a command to an open port, capture the response and compare it
+with the expected bytes or a regular expression. Differences are shown as a
+hex diff, and the command exits non-zero when the check fails, so it can be
+used in production-line functional tests.
+
+The response ends at --terminator (included), after --length bytes, or when
+the device stops sending. The command, --expect and --terminator accept
+escape sequences such as \r, \n and \x06.
+
+Example:
+  seriallink verify COM3 "VER?\r\n" --session-id <id> --expect "OK v1.2.3\r\n"
+  seriallink verify COM3 "VER?\r\n" --session-id <id> --pattern "^OK v1\." --terminator "\r\n"
+  seriallink verify COM3 --hex 0203A1 --session-id <id> --expect-file golden.bin --length 16`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runVerify,
+}
+
+func init() {
+	rootCmd.AddCommand(verifyCmd)
+
+	verifyCmd.Flags().String("session-id", "", "session ID")
+	verifyCmd.Flags().String("hex", "", "command as a hex string instead of COMMAND")
+	verifyCmd.Flags().String("expect", "", "expected response (escape sequences allowed)")
+	verifyCmd.Flags().String("expect-hex", "", "expected response as a hex string")
+	verifyCmd.Flags().String("expect-file", "", "file holding the expected response")
+	verifyCmd.Flags().String("pattern", "", "regular expression the response must match")
+	verifyCmd.Flags().String("terminator", "", "end of the response (escape sequences allowed)")
+	verifyCmd.Flags().Uint32("length", 0, "length of the response in bytes")
+	verifyCmd.Flags().Uint32("timeout", 2000, "time to wait for the response in milliseconds")
+	verifyCmd.Flags().Bool("json", false, "output in JSON format")
+}
+
+func runVerify(cmd *cobra.Command, args []string) error {
+	portName := args[0]
+	sessionID, _ := cmd.Flags().GetString("session-id")
+	hexCommand, _ := cmd.Flags().GetString("hex")
+	expect, _ := cmd.Flags().GetString("expect")
+	expectHex, _ := cmd.Flags().GetString("expect-hex")
+	expectFile, _ := cmd.Flags().GetString("expect-file")
+	pattern, _ := cmd.Flags().GetString("pattern")
+	terminator, _ := cmd.Flags().GetString("terminator")
+	length, _ := cmd.Flags().GetUint32("length")
+	timeoutMs, _ := cmd.Flags().GetUint32("timeout")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+
+	var command []byte
+	switch {
+	case hexCommand != "" && len(args) == 2:
+		return errors.New("give either COMMAND or --hex, not both")
+	case hexCommand != "":
+		decoded, err := hex.DecodeString(strings.ReplaceAll(hexCommand, " ", ""))
+		if err != nil {
+			return fmt.Errorf("invalid --hex command: %w", err)
+		}
+		command = decoded
+	case len(args) == 2:
+		unquoted, err := unescapeArg(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid command: %w", err)
+		}
+		command = []byte(unquoted)
+	default:
+		return errors.New("a COMMAND or --hex is required")
+	}
+
+	req := &pb.VerifyRequest{
+		PortName:        portName,
+		SessionId:       sessionID,
+		Command:         command,
+		ExpectedPattern: pattern,
+		ResponseLength:  length,
+		TimeoutMs:       timeoutMs,
+	}
+
+	set := 0
+	for _, s := range []string{expect, expectHex, expectFile, pattern} {
+		if s != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return errors.New("exactly one of --expect, --expect-hex, --expect-file and --pattern is required")
+	}
+	switch {
+	case expect != "":
+		unquoted, err := unescapeArg(expect)
+		if err != nil {
+			return fmt.Errorf("invalid --expect: %w", err)
+		}
+		req.Expected = []byte(unquoted)
+	case expectHex != "":
+		decoded, err := hex.DecodeString(strings.ReplaceAll(expectHex, " ", ""))
+		if err != nil {
+			return fmt.Errorf("invalid --expect-hex: %w", err)
+		}
+		req.Expected = decoded
+	case expectFile != "":
+		data, err := os.ReadFile(expectFile)
+		if err != nil {
+			return fmt.Errorf("failed to read expected response: %w", err)
+		}
+		if len(data) == 0 {
+			return fmt.Errorf("expected response file %s is empty", expectFile)
+		}
+		req.Expected = data
+	}
+
+	if terminator != "" {
+		unquoted, err := unescapeArg(terminator)
+		if err != nil {
+			return fmt.Errorf("invalid --terminator: %w", err)
+		}
+		req.Terminator = []byte(unquoted)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeoutMs)*time.Millisecond+10*time.Second)
+	defer cancel()
+
+	client, err := dialService()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	resp, err := client.Verify(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to verify: %w", err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(resp); err != nil {
+			return err
+		}
+	} else if resp.Passed {
+		fmt.Printf("PASS  %s\n", strconv.QuoteToASCII(string(resp.Response)))
+	} else {
+		fmt.Printf("FAIL  %s\n", resp.Message)
+		if resp.Diff != "" {
+			fmt.Print(resp.Diff)
+		}
+	}
+
+	if !resp.Passed {
+		return fmt.Errorf("verification failed: %s", resp.Message)
+	}
+	return nil
+}
+
+// unescapeArg expands escape sequences such as \r, \n and \x06 in an argument
+func unescapeArg(s string) (string, error) {
+	return strconv.Unquote(`"` + s + `"`)
+}
@@ -18,13 +18,12 @@ package cmd
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"time"
 
 	pb "github.com/Shoaibashk/SerialLink-Proto/gen/go/seriallink/v1"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 var writeCmd = &cobra.Command{
@@ -35,7 +34,10 @@ var writeCmd = &cobra.Command{
 Example:
   seriallink write COM1 "Hello"            # Write text
   seriallink write COM1 "A\nB\nC"           # Write with newlines
-  seriallink write COM1 --hex "48656C6C6F" # Write hex data`,
+  seriallink write COM1 --hex "48656C6C6F" # Write hex data
+  seriallink write COM1 "GO" --at 2025-12-21T10:30:00Z  # Scheduled write
+  seriallink write COM1 "RESET" --dry-run  # Check and show the bytes only
+  seriallink write COM1 "AT" --timeout 500 # Give up after 500ms`,
 	Args: cobra.MinimumNArgs(2),
 	RunE: runWrite,
 }
@@ -46,6 +48,9 @@ func init() {
 	writeCmd.Flags().Bool("flush", true, "flush buffer after write")
 	writeCmd.Flags().String("session-id", "", "session ID")
 	writeCmd.Flags().Bool("hex", false, "interpret data as hex string")
+	writeCmd.Flags().String("at", "", "send at this time (RFC 3339, e.g. 2025-12-21T10:30:00.250Z)")
+	writeCmd.Flags().Bool("dry-run", false, "validate the write and show the bytes without sending them")
+	writeCmd.Flags().Uint32("timeout", 0, "write timeout in milliseconds for this write (0 waits for the port)")
 }
 
 func runWrite(cmd *cobra.Command, args []string) error {
@@ -55,6 +60,18 @@ func runWrite(cmd *cobra.Command, args []string) error {
 	flush, _ := cmd.Flags().GetBool("flush")
 	sessionID, _ := cmd.Flags().GetString("session-id")
 	hexMode, _ := cmd.Flags().GetBool("hex")
+	at, _ := cmd.Flags().GetString("at")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	writeTimeout, _ := cmd.Flags().GetUint32("timeout")
+
+	var executeAt time.Time
+	if at != "" {
+		parsed, err := time.Parse(time.RFC3339Nano, at)
+		if err != nil {
+			return fmt.Errorf("invalid --at time: %w", err)
+		}
+		executeAt = parsed
+	}
 
 	// Convert data
 	var dataBytes []byte
@@ -68,34 +85,61 @@ func runWrite(cmd *cobra.Command, args []string) error {
 		dataBytes = []byte(data)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	timeout := 10 * time.Second
+	if !executeAt.IsZero() {
+		timeout += time.Until(executeAt)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
-	addr := GetAddress()
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	client, err := dialService()
 	if err != nil {
-		return fmt.Errorf("failed to connect to service at %s: %w", addr, err)
+		return err
 	}
-	defer conn.Close()
-
-	client := pb.NewSerialServiceClient(conn)
+	defer client.Close()
 
-	resp, err := client.Write(ctx, &pb.WriteRequest{
+	req := &pb.WriteRequest{
 		PortName:  portName,
 		SessionId: sessionID,
 		Data:      dataBytes,
 		Flush:     flush,
-	})
+		DryRun:    dryRun,
+		TimeoutMs: writeTimeout,
+	}
+	if !executeAt.IsZero() {
+		req.ExecuteAt = executeAt.UnixNano()
+	}
+
+	resp, err := client.Write(ctx, req)
 	if err != nil {
 		return fmt.Errorf("failed to write to port: %w", err)
 	}
 
+	if dryRun {
+		if !resp.Success {
+			return fmt.Errorf("dry run failed: %s", resp.Message)
+		}
+		fmt.Println(resp.Message)
+		fmt.Print(hex.Dump(resp.WireData))
+		return nil
+	}
+	if resp.ApprovalId != "" {
+		fmt.Printf("Write held for approval (%s)\n", resp.Message)
+		fmt.Printf("Another operator must run: seriallink approvals approve %s\n", resp.ApprovalId)
+		return nil
+	}
 	if !resp.Success {
 		return fmt.Errorf("write operation failed: %s", resp.Message)
 	}
 
 	if IsVerbose() {
 		fmt.Printf("Wrote %d bytes to %s\n", resp.BytesWritten, portName)
+		if !executeAt.IsZero() {
+			fmt.Printf("Sent at %s (%s after schedule)\n",
+				time.Unix(0, resp.SentAt).Format(time.RFC3339Nano),
+				time.Unix(0, resp.SentAt).Sub(executeAt))
+		}
 	} else {
 		fmt.Printf("Wrote %d bytes\n", resp.BytesWritten)
 	}
@@ -18,38 +18,348 @@ limitations under the License.
 package config
 
 import (
+	"crypto/tls"
+	"encoding/hex"
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
 	"runtime"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/Shoaibashk/SerialLink/internal/acl"
+	"github.com/Shoaibashk/SerialLink/internal/actions"
+	"github.com/Shoaibashk/SerialLink/internal/alarm"
+	"github.com/Shoaibashk/SerialLink/internal/auth"
+	"github.com/Shoaibashk/SerialLink/internal/barcode"
+	"github.com/Shoaibashk/SerialLink/internal/bus"
+	"github.com/Shoaibashk/SerialLink/internal/console"
+	"github.com/Shoaibashk/SerialLink/internal/devicestate"
+	"github.com/Shoaibashk/SerialLink/internal/history"
+	"github.com/Shoaibashk/SerialLink/internal/inputguard"
+	"github.com/Shoaibashk/SerialLink/internal/mqtt"
+	"github.com/Shoaibashk/SerialLink/internal/overload"
+	"github.com/Shoaibashk/SerialLink/internal/poller"
+	"github.com/Shoaibashk/SerialLink/internal/redact"
+	"github.com/Shoaibashk/SerialLink/internal/retention"
 	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/Shoaibashk/SerialLink/internal/storage"
+	"github.com/Shoaibashk/SerialLink/internal/testrunner"
+	"github.com/Shoaibashk/SerialLink/internal/tlsutil"
+	"github.com/Shoaibashk/SerialLink/internal/ups"
+	"github.com/Shoaibashk/SerialLink/internal/verify"
+	"github.com/Shoaibashk/SerialLink/internal/writepolicy"
+	"github.com/Shoaibashk/SerialLink/internal/wsframe"
 	"github.com/spf13/viper"
 )
 
 // Config represents the complete agent configuration
 type Config struct {
-	Server  ServerConfig  `mapstructure:"server" yaml:"server"`
-	TLS     TLSConfig     `mapstructure:"tls" yaml:"tls"`
-	Serial  SerialConfig  `mapstructure:"serial" yaml:"serial"`
-	Logging LoggingConfig `mapstructure:"logging" yaml:"logging"`
+	Server    ServerConfig    `mapstructure:"server" yaml:"server"`
+	TLS       TLSConfig       `mapstructure:"tls" yaml:"tls"`
+	Auth      AuthConfig      `mapstructure:"auth" yaml:"auth"`
+	ACL       ACLConfig       `mapstructure:"acl" yaml:"acl"`
+	Serial    SerialConfig    `mapstructure:"serial" yaml:"serial"`
+	Logging   LoggingConfig   `mapstructure:"logging" yaml:"logging"`
+	Console   ConsoleConfig   `mapstructure:"console" yaml:"console"`
+	GPIO      GPIOConfig      `mapstructure:"gpio" yaml:"gpio"`
+	Bus       BusConfig       `mapstructure:"bus" yaml:"bus"`
+	Polling   PollingConfig   `mapstructure:"polling" yaml:"polling"`
+	MQTT      MQTTConfig      `mapstructure:"mqtt" yaml:"mqtt"`
+	Storage   StorageConfig   `mapstructure:"storage" yaml:"storage"`
+	Retention RetentionConfig `mapstructure:"retention" yaml:"retention"`
+	// PortActions run when matching ports appear
+	PortActions []PortActionConfig `mapstructure:"port_actions" yaml:"port_actions"`
+	// Devices are tracked through a state machine driven by their output
+	Devices []DeviceConfig `mapstructure:"devices" yaml:"devices"`
+	// Testing runs end-of-line test suites against devices
+	Testing TestingConfig `mapstructure:"testing" yaml:"testing"`
+	// SessionSummary reports on every session when it closes
+	SessionSummary SessionSummaryConfig `mapstructure:"session_summary" yaml:"session_summary"`
+	// Memory caps the data queued for slow stream consumers
+	Memory MemoryConfig `mapstructure:"memory" yaml:"memory"`
+	// Overload rejects new work and sheds streams when the agent is overloaded
+	Overload OverloadConfig `mapstructure:"overload" yaml:"overload"`
+	// Reservations book ports for scheduled exclusive use
+	Reservations ReservationsConfig `mapstructure:"reservations" yaml:"reservations"`
+	// Usage accounts port use per client for chargeback
+	Usage UsageConfig `mapstructure:"usage" yaml:"usage"`
+	// Redaction masks secrets in traffic before it is logged or stored
+	Redaction RedactionConfig `mapstructure:"redaction" yaml:"redaction"`
+	// Metrics serves Prometheus metrics on a separate listener
+	Metrics MetricsConfig `mapstructure:"metrics" yaml:"metrics"`
+	// Debug serves pprof, expvar and runtime dumps on a separate listener
+	Debug   DebugConfig   `mapstructure:"debug" yaml:"debug"`
 	Service ServiceConfig `mapstructure:"service" yaml:"service"`
 }
 
 // ServerConfig holds server-related settings
 type ServerConfig struct {
-	GRPCAddress       string `mapstructure:"grpc_address" yaml:"grpc_address"`
+	// Listener addresses are host:port. IPv6 literals are bracketed
+	// ("[::1]:50051") and may name an interface for link-local addresses
+	// ("[fe80::1%eth0]:50051"); an empty host or "[::]" listens on all
+	// addresses.
+	GRPCAddress string `mapstructure:"grpc_address" yaml:"grpc_address"`
+	// Network is tcp (IPv4 and IPv6, dual-stack on wildcard addresses),
+	// tcp4 or tcp6
+	Network           string `mapstructure:"network" yaml:"network"`
 	MaxConnections    int    `mapstructure:"max_connections" yaml:"max_connections"`
 	ConnectionTimeout int    `mapstructure:"connection_timeout" yaml:"connection_timeout"`
+
+	// HTTP endpoints (Server-Sent Events monitoring)
+	HTTPEnabled bool   `mapstructure:"http_enabled" yaml:"http_enabled"`
+	HTTPAddress string `mapstructure:"http_address" yaml:"http_address"`
+
+	// WebSocket gateway for browser clients
+	WebSocketEnabled bool   `mapstructure:"websocket_enabled" yaml:"websocket_enabled"`
+	WebSocketAddress string `mapstructure:"websocket_address" yaml:"websocket_address"`
+	// WebSocketEncodings lists frame encodings clients may negotiate in
+	// addition to JSON (cbor, msgpack)
+	WebSocketEncodings []string `mapstructure:"websocket_encodings" yaml:"websocket_encodings"`
+	// WebSocketOrigins lists the browser origins allowed to connect besides
+	// the agent's own host ("*" allows any)
+	WebSocketOrigins []string `mapstructure:"websocket_origins" yaml:"websocket_origins"`
+
+	// REST gateway mapping the port RPCs to JSON over HTTP
+	RESTEnabled bool   `mapstructure:"rest_enabled" yaml:"rest_enabled"`
+	RESTAddress string `mapstructure:"rest_address" yaml:"rest_address"`
+
+	// ProxyProtocol expects a HAProxy PROXY v1/v2 header on every connection
+	// from a trusted proxy (for agents behind a TCP load balancer)
+	ProxyProtocol bool `mapstructure:"proxy_protocol" yaml:"proxy_protocol"`
+	// TrustForwardedFor honours x-forwarded-for / x-real-ip metadata from trusted proxies
+	TrustForwardedFor bool `mapstructure:"trust_forwarded_for" yaml:"trust_forwarded_for"`
+	// TrustedProxies lists proxy CIDRs or IPs; empty trusts no peer
+	TrustedProxies []string `mapstructure:"trusted_proxies" yaml:"trusted_proxies"`
 }
 
 // TLSConfig holds TLS/SSL settings
 type TLSConfig struct {
-	Enabled  bool   `mapstructure:"enabled" yaml:"enabled"`
-	CertFile string `mapstructure:"cert_file" yaml:"cert_file"`
-	KeyFile  string `mapstructure:"key_file" yaml:"key_file"`
-	CAFile   string `mapstructure:"ca_file" yaml:"ca_file"`
+	Enabled      bool     `mapstructure:"enabled" yaml:"enabled"`
+	CertFile     string   `mapstructure:"cert_file" yaml:"cert_file"`
+	KeyFile      string   `mapstructure:"key_file" yaml:"key_file"`
+	CAFile       string   `mapstructure:"ca_file" yaml:"ca_file"`
+	MinVersion   string   `mapstructure:"min_version" yaml:"min_version"`
+	MaxVersion   string   `mapstructure:"max_version" yaml:"max_version"`
+	CipherSuites []string `mapstructure:"cipher_suites" yaml:"cipher_suites"`
+	// ClientAuth is none, request, require_any, verify_if_given or
+	// require_and_verify; only the last two verify the certificate chain
+	ClientAuth string `mapstructure:"client_auth" yaml:"client_auth"`
+	// ReloadInterval is how often (seconds) cert/key/CA files are checked for
+	// changes; 0 disables automatic reload
+	ReloadInterval int `mapstructure:"reload_interval" yaml:"reload_interval"`
+
+	ACME   ACMEConfig   `mapstructure:"acme" yaml:"acme"`
+	SPIFFE SPIFFEConfig `mapstructure:"spiffe" yaml:"spiffe"`
+}
+
+// AuthConfig requires credentials on every gRPC call and gateway request.
+// Tokens are sent as "authorization: Bearer TOKEN", LDAP usernames and
+// passwords as "authorization: Basic ..."; without TLS they cross the
+// network in the clear.
+type AuthConfig struct {
+	Enabled bool `mapstructure:"enabled" yaml:"enabled"`
+	// Backends check credentials in order: static (API tokens), oidc and
+	// ldap (default: static)
+	Backends []string `mapstructure:"backends" yaml:"backends"`
+	// Tokens are accepted besides those of the token file
+	Tokens []TokenConfig `mapstructure:"tokens" yaml:"tokens"`
+	// TokenFile holds the tokens issued by "seriallink token create" and is
+	// read again when it changes (default: tokens.json next to the config
+	// file)
+	TokenFile string `mapstructure:"token_file" yaml:"token_file"`
+
+	OIDC OIDCAuthConfig `mapstructure:"oidc" yaml:"oidc"`
+	LDAP LDAPAuthConfig `mapstructure:"ldap" yaml:"ldap"`
+	// Admins are the identities, groups ("group:NAME") and IP addresses
+	// allowed to make administrative calls, such as force-closing other
+	// clients' sessions or toggling the debug endpoints; empty allows nobody
+	Admins []string `mapstructure:"admins" yaml:"admins"`
+	// AccessTokens are short-lived tokens scoped to one port, minted with
+	// CreateAccessToken for browser dashboards
+	AccessTokens AccessTokenConfig `mapstructure:"access_tokens" yaml:"access_tokens"`
+}
+
+// HasBackend reports whether a backend is enabled
+func (a AuthConfig) HasBackend(name string) bool {
+	return slices.ContainsFunc(a.Backends, func(b string) bool { return strings.EqualFold(b, name) })
+}
+
+// OIDCAuthConfig accepts bearer tokens (JWTs) of an OpenID Connect
+// provider. Calls act as "oidc:USER".
+type OIDCAuthConfig struct {
+	// Issuer URL; its discovery document names the signing keys
+	Issuer string `mapstructure:"issuer" yaml:"issuer"`
+	// Audience is the client ID tokens must be issued for
+	Audience string `mapstructure:"audience" yaml:"audience"`
+	// JWKSURL overrides the key set URL of the discovery document
+	JWKSURL string `mapstructure:"jwks_url" yaml:"jwks_url"`
+	// UsernameClaim names the user (default: sub)
+	UsernameClaim string `mapstructure:"username_claim" yaml:"username_claim"`
+	// GroupsClaim lists the user's groups for access rules (default: groups)
+	GroupsClaim string `mapstructure:"groups_claim" yaml:"groups_claim"`
+}
+
+// ToOptions converts the settings into auth.OIDCOptions
+func (o OIDCAuthConfig) ToOptions() auth.OIDCOptions {
+	return auth.OIDCOptions{
+		Issuer:        o.Issuer,
+		Audience:      o.Audience,
+		JWKSURL:       o.JWKSURL,
+		UsernameClaim: o.UsernameClaim,
+		GroupsClaim:   o.GroupsClaim,
+	}
+}
+
+// LDAPAuthConfig accepts usernames and passwords checked by binding to a
+// directory. Calls act as "ldap:USER".
+type LDAPAuthConfig struct {
+	// URL is ldap://HOST[:PORT] or ldaps://HOST[:PORT]
+	URL      string `mapstructure:"url" yaml:"url"`
+	StartTLS bool   `mapstructure:"start_tls" yaml:"start_tls"`
+	// CAFile verifies the directory's certificate (default: system roots)
+	CAFile string `mapstructure:"ca_file" yaml:"ca_file"`
+	// BindDN and BindPassword are the service account searching users and
+	// groups; empty binds anonymously
+	BindDN       string `mapstructure:"bind_dn" yaml:"bind_dn"`
+	BindPassword string `mapstructure:"bind_password" yaml:"bind_password"`
+	// UserFilter finds the user below UserBaseDN; %s is the username
+	// (default: "(uid=%s)")
+	UserBaseDN string `mapstructure:"user_base_dn" yaml:"user_base_dn"`
+	UserFilter string `mapstructure:"user_filter" yaml:"user_filter"`
+	// GroupFilter finds the user's groups below GroupBaseDN; %s is the
+	// user's DN (default: "(member=%s)"). Empty GroupBaseDN skips groups.
+	GroupBaseDN string `mapstructure:"group_base_dn" yaml:"group_base_dn"`
+	GroupFilter string `mapstructure:"group_filter" yaml:"group_filter"`
+	// GroupAttribute names a group (default: cn)
+	GroupAttribute string `mapstructure:"group_attribute" yaml:"group_attribute"`
+	// CacheSeconds reuses a successful login, so not every call reaches
+	// the directory; a changed password keeps working this long (default: 60)
+	CacheSeconds int `mapstructure:"cache_seconds" yaml:"cache_seconds"`
+}
+
+// ToOptions converts the settings into auth.LDAPOptions
+func (l LDAPAuthConfig) ToOptions() auth.LDAPOptions {
+	return auth.LDAPOptions{
+		URL:            l.URL,
+		StartTLS:       l.StartTLS,
+		CAFile:         l.CAFile,
+		BindDN:         l.BindDN,
+		BindPassword:   l.BindPassword,
+		UserBaseDN:     l.UserBaseDN,
+		UserFilter:     l.UserFilter,
+		GroupBaseDN:    l.GroupBaseDN,
+		GroupFilter:    l.GroupFilter,
+		GroupAttribute: l.GroupAttribute,
+		CacheTTL:       time.Duration(l.CacheSeconds) * time.Second,
+	}
+}
+
+// AccessTokenConfig lets admins mint access tokens: tokens limited to one
+// port, read-only unless asked otherwise, that expire on their own. Calls
+// made with one act as "access:NAME".
+type AccessTokenConfig struct {
+	// Issuers are the identities and groups ("group:NAME") allowed to mint
+	// access tokens; empty allows nobody
+	Issuers []string `mapstructure:"issuers" yaml:"issuers"`
+	// KeyFile holds the key signing the tokens and is created when missing;
+	// agents sharing it accept each other's tokens, and replacing it
+	// invalidates all tokens (default: access_token.key next to the config
+	// file)
+	KeyFile string `mapstructure:"key_file" yaml:"key_file"`
+	// MaxSeconds bounds the lifetime of a token (default: 3600)
+	MaxSeconds int `mapstructure:"max_seconds" yaml:"max_seconds"`
+	// LinkHost is the host stream links point at, for agents known by
+	// another name than their clients use (default: the host the client
+	// reached the agent at)
+	LinkHost string `mapstructure:"link_host" yaml:"link_host"`
+}
+
+// TokenConfig is an API token accepted by the agent
+type TokenConfig struct {
+	// Name identifies the client; calls made with the token act as
+	// "token:NAME" for reservations, approvals and recordings
+	Name string `mapstructure:"name" yaml:"name"`
+	// SHA256 is the hex SHA-256 hash of the token, so the config file holds
+	// no secret (sha256sum of the token without a newline)
+	SHA256 string `mapstructure:"sha256" yaml:"sha256"`
+	// Token is the token itself, instead of sha256
+	Token string `mapstructure:"token" yaml:"token"`
+	// Groups the client belongs to, for access rules
+	Groups []string `mapstructure:"groups" yaml:"groups"`
+}
+
+// ToToken converts the entry into an auth.Token
+func (t TokenConfig) ToToken() auth.Token {
+	hash := strings.ToLower(t.SHA256)
+	if t.Token != "" {
+		hash = auth.Hash(t.Token)
+	}
+	return auth.Token{Name: t.Name, SHA256: hash, Groups: t.Groups}
+}
+
+// ACLConfig restricts which clients may use which ports. When enabled, a
+// client may open, read and write a port only if a rule names it and one
+// of the rule's patterns matches the port.
+type ACLConfig struct {
+	Enabled bool            `mapstructure:"enabled" yaml:"enabled"`
+	Rules   []ACLRuleConfig `mapstructure:"rules" yaml:"rules"`
+}
+
+// ACLRuleConfig grants clients the ports matching its patterns
+type ACLRuleConfig struct {
+	// Clients are SPIFFE IDs, certificate common names, authenticated
+	// identities ("token:NAME", "oidc:USER", "ldap:USER"), their groups
+	// ("group:NAME") or client IDs ("client:ID"); "*" matches any client.
+	// Client IDs are chosen by the clients themselves, so only
+	// authenticated identities keep out a client that lies about its ID.
+	Clients []string `mapstructure:"clients" yaml:"clients"`
+	// Ports are regular expressions matched against the port name
+	Ports []string `mapstructure:"ports" yaml:"ports"`
+}
+
+// ToRule converts the entry into an acl.Rule
+func (r ACLRuleConfig) ToRule() (acl.Rule, error) {
+	rule := acl.Rule{Clients: r.Clients}
+	for _, pattern := range r.Ports {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return acl.Rule{}, fmt.Errorf("invalid port pattern: %w", err)
+		}
+		rule.Ports = append(rule.Ports, re)
+	}
+	return rule, nil
+}
+
+// SPIFFEConfig holds workload identity settings. When enabled, the server
+// certificate and client trust bundle come from the SPIFFE Workload API and
+// clients must present an SVID (mutual TLS).
+type SPIFFEConfig struct {
+	Enabled bool `mapstructure:"enabled" yaml:"enabled"`
+	// SocketPath is the Workload API address (default: $SPIFFE_ENDPOINT_SOCKET)
+	SocketPath string `mapstructure:"socket_path" yaml:"socket_path"`
+	// TrustDomain of accepted clients (default: the agent's own trust domain)
+	TrustDomain string `mapstructure:"trust_domain" yaml:"trust_domain"`
+	// AllowedIDs restricts clients to specific SPIFFE IDs
+	AllowedIDs []string `mapstructure:"allowed_ids" yaml:"allowed_ids"`
+}
+
+// ACMEConfig holds automatic certificate provisioning settings
+type ACMEConfig struct {
+	Enabled bool     `mapstructure:"enabled" yaml:"enabled"`
+	Domains []string `mapstructure:"domains" yaml:"domains"`
+	Email   string   `mapstructure:"email" yaml:"email"`
+	// CacheDir stores issued certificates and the account key
+	// (default: "acme" next to the config file)
+	CacheDir     string `mapstructure:"cache_dir" yaml:"cache_dir"`
+	DirectoryURL string `mapstructure:"directory_url" yaml:"directory_url"`
+	// HTTPAddress serves HTTP-01 challenges; the CA connects on port 80
+	HTTPAddress string `mapstructure:"http_address" yaml:"http_address"`
 }
 
 // SerialConfig holds serial port settings
@@ -58,6 +368,227 @@ type SerialConfig struct {
 	ScanInterval      int            `mapstructure:"scan_interval" yaml:"scan_interval"`
 	ExcludePatterns   []string       `mapstructure:"exclude_patterns" yaml:"exclude_patterns"`
 	AllowSharedAccess bool           `mapstructure:"allow_shared_access" yaml:"allow_shared_access"`
+	RemotePorts       []string       `mapstructure:"remote_ports" yaml:"remote_ports"`
+	// ScanTimeoutMs bounds the listing of each scan provider, which run in
+	// parallel; ScanProviderTimeoutsMs overrides it by provider name
+	ScanTimeoutMs          int            `mapstructure:"scan_timeout_ms" yaml:"scan_timeout_ms"`
+	ScanProviderTimeoutsMs map[string]int `mapstructure:"scan_provider_timeouts_ms" yaml:"scan_provider_timeouts_ms"`
+	// LineQualityMonitoring scores received text and warns about likely
+	// baud/parity mismatches and BREAKs
+	LineQualityMonitoring bool `mapstructure:"line_quality_monitoring" yaml:"line_quality_monitoring"`
+	// AutoProfiles applies the settings of known devices (by USB VID/PID)
+	// when a port is opened without explicit settings
+	AutoProfiles   bool                  `mapstructure:"auto_profiles" yaml:"auto_profiles"`
+	DeviceProfiles []DeviceProfileConfig `mapstructure:"device_profiles" yaml:"device_profiles"`
+	// ScannerProfiles describe barcode scanners for StreamScans
+	ScannerProfiles []ScannerProfileConfig `mapstructure:"scanner_profiles" yaml:"scanner_profiles"`
+	// WritePolicies restrict what clients may write to a port
+	WritePolicies []WritePolicyConfig `mapstructure:"write_policies" yaml:"write_policies"`
+	// InputGuards screen what users type in interactive sessions
+	InputGuards []InputGuardConfig `mapstructure:"input_guards" yaml:"input_guards"`
+	// Retry retries reads and writes failing with temporary driver errors
+	Retry RetryConfig `mapstructure:"retry" yaml:"retry"`
+	// ShortSessionIDs gives sessions a short ID such as "COM3-7f3a", accepted
+	// in place of the UUID
+	ShortSessionIDs bool `mapstructure:"short_session_ids" yaml:"short_session_ids"`
+	// WriteCoalesceMs holds back writes of a session for up to this many
+	// milliseconds and sends them together (0 disables)
+	WriteCoalesceMs int `mapstructure:"write_coalesce_ms" yaml:"write_coalesce_ms"`
+}
+
+// ScanTimeouts returns the default scan provider timeout and the overrides
+// by provider name
+func (s SerialConfig) ScanTimeouts() (time.Duration, map[string]time.Duration) {
+	overrides := make(map[string]time.Duration, len(s.ScanProviderTimeoutsMs))
+	for name, ms := range s.ScanProviderTimeoutsMs {
+		overrides[name] = time.Duration(ms) * time.Millisecond
+	}
+	return time.Duration(s.ScanTimeoutMs) * time.Millisecond, overrides
+}
+
+// RetryConfig retries port reads and writes that fail with a temporary
+// error (EAGAIN, EINTR, ...) before reporting them; fatal errors are
+// reported at once
+type RetryConfig struct {
+	// Attempts is the number of retries (0 disables)
+	Attempts int `mapstructure:"attempts" yaml:"attempts"`
+	// BackoffMs is the wait before the first retry, doubled for each further
+	// one
+	BackoffMs int `mapstructure:"backoff_ms" yaml:"backoff_ms"`
+}
+
+// ToPolicy converts the settings into a serial.RetryPolicy
+func (r RetryConfig) ToPolicy() serial.RetryPolicy {
+	return serial.RetryPolicy{
+		Attempts: r.Attempts,
+		Backoff:  time.Duration(r.BackoffMs) * time.Millisecond,
+	}
+}
+
+// DeviceProfileConfig adds or overrides an entry in the device database
+type DeviceProfileConfig struct {
+	VID string `mapstructure:"vid" yaml:"vid"`
+	// PID may be empty to match every product of the vendor
+	PID         string `mapstructure:"pid" yaml:"pid"`
+	Family      string `mapstructure:"family" yaml:"family"`
+	BaudRate    int    `mapstructure:"baud_rate" yaml:"baud_rate"`
+	DataBits    int    `mapstructure:"data_bits" yaml:"data_bits"`
+	StopBits    int    `mapstructure:"stop_bits" yaml:"stop_bits"`
+	Parity      string `mapstructure:"parity" yaml:"parity"`
+	FlowControl string `mapstructure:"flow_control" yaml:"flow_control"`
+	// LatencyProfile is "default" or "low"
+	LatencyProfile string `mapstructure:"latency_profile" yaml:"latency_profile"`
+	// DTR and RTS are "default", "high", "low" or "untouched"
+	DTR string `mapstructure:"dtr" yaml:"dtr"`
+	RTS string `mapstructure:"rts" yaml:"rts"`
+}
+
+// ToDeviceProfile converts the entry into a serial.DeviceProfile
+func (p DeviceProfileConfig) ToDeviceProfile() serial.DeviceProfile {
+	return serial.DeviceProfile{
+		VID:            p.VID,
+		PID:            p.PID,
+		Family:         p.Family,
+		BaudRate:       p.BaudRate,
+		DataBits:       p.DataBits,
+		StopBits:       p.StopBits,
+		Parity:         p.Parity,
+		FlowControl:    p.FlowControl,
+		LatencyProfile: p.LatencyProfile,
+		DTR:            p.DTR,
+		RTS:            p.RTS,
+	}
+}
+
+// ScannerProfileConfig describes how the barcode scanner on a port frames scans
+type ScannerProfileConfig struct {
+	Port string `mapstructure:"port" yaml:"port"`
+	// Terminators are the bytes that end a scan (default: CR and LF)
+	Terminators string `mapstructure:"terminators" yaml:"terminators"`
+	Prefix      string `mapstructure:"prefix" yaml:"prefix"`
+	Suffix      string `mapstructure:"suffix" yaml:"suffix"`
+	DebounceMs  int    `mapstructure:"debounce_ms" yaml:"debounce_ms"`
+	IdleMs      int    `mapstructure:"idle_ms" yaml:"idle_ms"`
+	MinLength   int    `mapstructure:"min_length" yaml:"min_length"`
+}
+
+// ToProfile converts the entry into a barcode.Profile
+func (p ScannerProfileConfig) ToProfile() barcode.Profile {
+	return barcode.Profile{
+		Terminators: p.Terminators,
+		Prefix:      p.Prefix,
+		Suffix:      p.Suffix,
+		Debounce:    time.Duration(p.DebounceMs) * time.Millisecond,
+		IdleTimeout: time.Duration(p.IdleMs) * time.Millisecond,
+		MinLength:   p.MinLength,
+	}
+}
+
+// WritePolicyConfig restricts the payloads clients may write to a port.
+// Patterns are regular expressions matched against the payload without its
+// trailing CR and LF.
+type WritePolicyConfig struct {
+	Port string `mapstructure:"port" yaml:"port"`
+	// Allow, when set, rejects payloads matching none of its patterns
+	Allow []string `mapstructure:"allow" yaml:"allow"`
+	// Deny rejects payloads matching any of its patterns
+	Deny []string `mapstructure:"deny" yaml:"deny"`
+	// RequireApproval holds matching payloads until a second client approves them
+	RequireApproval []string `mapstructure:"require_approval" yaml:"require_approval"`
+	// Approvers are the client identities allowed to approve; required with
+	// require_approval
+	Approvers []string `mapstructure:"approvers" yaml:"approvers"`
+	// ApprovalTimeout in seconds discards writes not approved in time (default: 300)
+	ApprovalTimeout int `mapstructure:"approval_timeout" yaml:"approval_timeout"`
+}
+
+// ToPolicy converts the entry into a writepolicy.Policy
+func (p WritePolicyConfig) ToPolicy() (writepolicy.Policy, error) {
+	policy := writepolicy.Policy{
+		Port:            p.Port,
+		Approvers:       p.Approvers,
+		ApprovalTimeout: time.Duration(p.ApprovalTimeout) * time.Second,
+	}
+	for _, list := range []struct {
+		name     string
+		patterns []string
+		dest     *[]*regexp.Regexp
+	}{
+		{"allow", p.Allow, &policy.Allow},
+		{"deny", p.Deny, &policy.Deny},
+		{"require_approval", p.RequireApproval, &policy.RequireApproval},
+	} {
+		for _, pattern := range list.patterns {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return writepolicy.Policy{}, fmt.Errorf("invalid %s pattern: %w", list.name, err)
+			}
+			*list.dest = append(*list.dest, re)
+		}
+	}
+	return policy, nil
+}
+
+// InputGuardConfig screens the keystrokes of interactive sessions
+// (BiDirectionalStream) on a port, e.g. a console server port of a router.
+// Patterns are regular expressions matched against the typed line when
+// Enter is pressed; sequences are raw input such as "\x1d" or "~B".
+type InputGuardConfig struct {
+	Port string `mapstructure:"port" yaml:"port"`
+	// Block drops matching lines and erases them on the device
+	Block []string `mapstructure:"block" yaml:"block"`
+	// Confirm holds matching lines until the user answers "y"
+	Confirm []string `mapstructure:"confirm" yaml:"confirm"`
+	// BlockSequences and ConfirmSequences act on raw input as it arrives
+	BlockSequences   []string `mapstructure:"block_sequences" yaml:"block_sequences"`
+	ConfirmSequences []string `mapstructure:"confirm_sequences" yaml:"confirm_sequences"`
+	// Cancel erases a typed line on the device (default: "\x15", Ctrl-U)
+	Cancel string `mapstructure:"cancel" yaml:"cancel"`
+}
+
+// ToPolicy converts the entry into an inputguard.Policy
+func (g InputGuardConfig) ToPolicy() (inputguard.Policy, error) {
+	policy := inputguard.Policy{Port: g.Port}
+	for _, list := range []struct {
+		name     string
+		patterns []string
+		action   inputguard.Action
+	}{
+		{"block", g.Block, inputguard.Block},
+		{"confirm", g.Confirm, inputguard.Confirm},
+	} {
+		for _, pattern := range list.patterns {
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return inputguard.Policy{}, fmt.Errorf("invalid %s pattern: %w", list.name, err)
+			}
+			policy.Rules = append(policy.Rules, inputguard.Rule{Pattern: re, Action: list.action})
+		}
+	}
+	for _, list := range []struct {
+		name      string
+		sequences []string
+		action    inputguard.Action
+	}{
+		{"block_sequences", g.BlockSequences, inputguard.Block},
+		{"confirm_sequences", g.ConfirmSequences, inputguard.Confirm},
+	} {
+		for _, sequence := range list.sequences {
+			data, err := unescape(sequence)
+			if err != nil || data == "" {
+				return inputguard.Policy{}, fmt.Errorf("invalid %s entry %q", list.name, sequence)
+			}
+			policy.Sequences = append(policy.Sequences, inputguard.Sequence{Data: []byte(data), Action: list.action})
+		}
+	}
+	if g.Cancel != "" {
+		cancel, err := unescape(g.Cancel)
+		if err != nil {
+			return inputguard.Policy{}, fmt.Errorf("invalid cancel: %w", err)
+		}
+		policy.Cancel = []byte(cancel)
+	}
+	return policy, nil
 }
 
 // SerialDefaults holds default serial port parameters
@@ -69,6 +600,14 @@ type SerialDefaults struct {
 	FlowControl    string `mapstructure:"flow_control" yaml:"flow_control"`
 	ReadTimeoutMs  int    `mapstructure:"read_timeout_ms" yaml:"read_timeout_ms"`
 	WriteTimeoutMs int    `mapstructure:"write_timeout_ms" yaml:"write_timeout_ms"`
+	// LatencyProfile "low" lowers the FTDI latency timer and disables read
+	// batching for tight request/response loops (default: "default")
+	LatencyProfile string `mapstructure:"latency_profile" yaml:"latency_profile"`
+	// DTR and RTS are the control line states on open: "default"
+	// (asserted), "high", "low" or "untouched" to never change the line,
+	// so boards that reset on DTR are not reset
+	DTR string `mapstructure:"dtr" yaml:"dtr"`
+	RTS string `mapstructure:"rts" yaml:"rts"`
 }
 
 // LoggingConfig holds logging settings
@@ -82,6 +621,982 @@ type LoggingConfig struct {
 	Compress   bool   `mapstructure:"compress" yaml:"compress"`
 }
 
+// ConsoleConfig holds device console log collection settings
+type ConsoleConfig struct {
+	// Directory for console logs (default: "console" next to the config file)
+	Directory string `mapstructure:"directory" yaml:"directory"`
+	// MaxSize is the size in MB at which a log file is rotated
+	MaxSize    int    `mapstructure:"max_size" yaml:"max_size"`
+	MaxBackups int    `mapstructure:"max_backups" yaml:"max_backups"`
+	Format     string `mapstructure:"format" yaml:"format"`
+	// ShipURL receives every rotated file as an HTTP POST
+	ShipURL string `mapstructure:"ship_url" yaml:"ship_url"`
+	// BufferSize is the KB of recent output kept in memory per port
+	BufferSize int                 `mapstructure:"buffer_size" yaml:"buffer_size"`
+	Ports      []ConsolePortConfig `mapstructure:"ports" yaml:"ports"`
+
+	// Recording stores interactive sessions in asciicast v2 format
+	Recording RecordingConfig `mapstructure:"recording" yaml:"recording"`
+	// Compliance writes console logs as a verifiable hash chain
+	Compliance ComplianceConfig `mapstructure:"compliance" yaml:"compliance"`
+	// Dedup collapses runs of identical lines
+	Dedup DedupConfig `mapstructure:"dedup" yaml:"dedup"`
+	// Keywords counts the lines holding keywords over sliding windows
+	Keywords KeywordsConfig `mapstructure:"keywords" yaml:"keywords"`
+	// CrashDumps saves the output around panic patterns
+	CrashDumps CrashDumpsConfig `mapstructure:"crash_dumps" yaml:"crash_dumps"`
+}
+
+// CrashDumpsConfig saves a crash dump when a console line matches a panic
+// pattern: the recent output buffered for the port (buffer_size) plus the
+// output of the next seconds, so intermittent crashes are kept with context
+type CrashDumpsConfig struct {
+	// Patterns are regular expressions, e.g. "Kernel panic" or "Guru
+	// Meditation Error"; none disables crash dumps
+	Patterns []string `mapstructure:"patterns" yaml:"patterns"`
+	// CaptureAfter is how many seconds of output after the match are kept
+	CaptureAfter int `mapstructure:"capture_after" yaml:"capture_after"`
+	// Directory for dumps (default: "crashes" next to the config file)
+	Directory string `mapstructure:"directory" yaml:"directory"`
+	// WebhookURL receives every saved dump as JSON
+	WebhookURL string `mapstructure:"webhook_url" yaml:"webhook_url"`
+}
+
+// ToOptions converts the settings into console.CrashOptions, nil without
+// patterns. directory is the resolved dump directory.
+func (c CrashDumpsConfig) ToOptions(directory string) (*console.CrashOptions, error) {
+	if len(c.Patterns) == 0 {
+		return nil, nil
+	}
+	opts := &console.CrashOptions{
+		After:     time.Duration(c.CaptureAfter) * time.Second,
+		Directory: directory,
+	}
+	for _, pattern := range c.Patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid crash pattern %q: %w", pattern, err)
+		}
+		opts.Patterns = append(opts.Patterns, re)
+	}
+	return opts, nil
+}
+
+// KeywordsConfig counts the console lines of each port holding keywords,
+// such as "ERROR" or "panic", over sliding windows, for metrics and
+// GetKeywordStats
+type KeywordsConfig struct {
+	Words      []string `mapstructure:"words" yaml:"words"`
+	IgnoreCase bool     `mapstructure:"ignore_case" yaml:"ignore_case"`
+	// Windows are the spans, in seconds, counts are kept over
+	Windows []int `mapstructure:"windows" yaml:"windows"`
+}
+
+// ToOptions converts the settings into console.KeywordOptions
+func (k KeywordsConfig) ToOptions() console.KeywordOptions {
+	windows := make([]time.Duration, 0, len(k.Windows))
+	for _, w := range k.Windows {
+		windows = append(windows, time.Duration(w)*time.Second)
+	}
+	return console.KeywordOptions{Words: k.Words, IgnoreCase: k.IgnoreCase, Windows: windows}
+}
+
+// DedupConfig collapses runs of identical lines, such as heartbeats, in
+// console logs: the first line is logged and the repeats that follow as one
+// "last line repeated N times" entry
+type DedupConfig struct {
+	Enabled bool `mapstructure:"enabled" yaml:"enabled"`
+	// ReportInterval is how often, in seconds, a run still going on is
+	// logged (0: only when it ends)
+	ReportInterval int `mapstructure:"report_interval" yaml:"report_interval"`
+}
+
+// ComplianceConfig writes console logs append-only as a hash chain with
+// periodic checkpoints signed by an Ed25519 key, so a recorded device
+// conversation can later be shown to be unaltered with
+// "seriallink compliance verify"
+type ComplianceConfig struct {
+	Enabled bool `mapstructure:"enabled" yaml:"enabled"`
+	// SigningKey is a PEM Ed25519 private key ("seriallink compliance
+	// keygen" or "openssl genpkey -algorithm ed25519")
+	SigningKey string `mapstructure:"signing_key" yaml:"signing_key"`
+	// CheckpointInterval is how often, in seconds, new records are signed
+	CheckpointInterval int `mapstructure:"checkpoint_interval" yaml:"checkpoint_interval"`
+}
+
+// RecordingConfig holds interactive session recording settings
+type RecordingConfig struct {
+	Enabled bool `mapstructure:"enabled" yaml:"enabled"`
+	// Always records every session; otherwise clients opt in per session
+	Always bool `mapstructure:"always" yaml:"always"`
+	// Directory for .cast files (default: "recordings" next to the config file)
+	Directory string `mapstructure:"directory" yaml:"directory"`
+}
+
+// ConsolePortConfig selects a port for console logging
+type ConsolePortConfig struct {
+	Name string `mapstructure:"name" yaml:"name"`
+	// BaudRate overrides serial.defaults.baud_rate for this port
+	BaudRate int `mapstructure:"baud_rate" yaml:"baud_rate"`
+}
+
+// GPIOConfig holds GPIO lines tied to ports (Linux only)
+type GPIOConfig struct {
+	ResetLines []ResetLineConfig `mapstructure:"reset_lines" yaml:"reset_lines"`
+}
+
+// ResetLineConfig wires a GPIO line to the reset input of the device on a port
+type ResetLineConfig struct {
+	Port string `mapstructure:"port" yaml:"port"`
+	// Chip is the GPIO chip (default: gpiochip0)
+	Chip string `mapstructure:"chip" yaml:"chip"`
+	// Line is the line offset, the BCM GPIO number on a Raspberry Pi
+	Line int `mapstructure:"line" yaml:"line"`
+	// ActiveLow resets the device by pulling the line low (most resets)
+	ActiveLow bool `mapstructure:"active_low" yaml:"active_low"`
+	// PulseMs is how long reset is held (default: 100)
+	PulseMs int `mapstructure:"pulse_ms" yaml:"pulse_ms"`
+}
+
+// BusConfig holds I2C/SPI bridge settings
+type BusConfig struct {
+	// Providers lists the bus providers to enable, e.g. "linux"
+	Providers []string `mapstructure:"providers" yaml:"providers"`
+}
+
+// PollingConfig holds declarative device polls
+type PollingConfig struct {
+	Pollers []PollerConfig `mapstructure:"pollers" yaml:"pollers"`
+	// History keeps polled values on disk for QueryHistory
+	History HistoryConfig `mapstructure:"history" yaml:"history"`
+	// Alarms raise notifications when polled values cross thresholds
+	Alarms AlarmsConfig `mapstructure:"alarms" yaml:"alarms"`
+}
+
+// AlarmsConfig holds threshold alarm rules and where alarms are sent. Alarms
+// are also published over MQTT (when enabled) and as "alarm" port events.
+type AlarmsConfig struct {
+	// WebhookURL receives every alarm change as an HTTP POST
+	WebhookURL string            `mapstructure:"webhook_url" yaml:"webhook_url"`
+	Rules      []AlarmRuleConfig `mapstructure:"rules" yaml:"rules"`
+}
+
+// AlarmRuleConfig raises an alarm while a polled value meets a condition
+type AlarmRuleConfig struct {
+	Name   string `mapstructure:"name" yaml:"name"`
+	Poller string `mapstructure:"poller" yaml:"poller"`
+	Field  string `mapstructure:"field" yaml:"field"`
+	// Condition is >, >=, <, <=, == or !=
+	Condition string  `mapstructure:"condition" yaml:"condition"`
+	Threshold float64 `mapstructure:"threshold" yaml:"threshold"`
+	// ForSeconds is how long the condition must hold before raising
+	ForSeconds int `mapstructure:"for_seconds" yaml:"for_seconds"`
+	// Severity is free text (default: warning)
+	Severity string `mapstructure:"severity" yaml:"severity"`
+	Message  string `mapstructure:"message" yaml:"message"`
+}
+
+// ToRule converts the entry into an alarm.Rule
+func (r AlarmRuleConfig) ToRule() alarm.Rule {
+	return alarm.Rule{
+		Name:      r.Name,
+		Poller:    r.Poller,
+		Field:     r.Field,
+		Condition: r.Condition,
+		Threshold: r.Threshold,
+		For:       time.Duration(r.ForSeconds) * time.Second,
+		Severity:  r.Severity,
+		Message:   r.Message,
+	}
+}
+
+// HistoryConfig holds the on-disk history of polled values. Values are kept
+// as polled, then as minute and hour averages; a zero retention disables
+// that resolution.
+type HistoryConfig struct {
+	Enabled bool `mapstructure:"enabled" yaml:"enabled"`
+	// Directory for history files (default: "history" next to the config file)
+	Directory           string `mapstructure:"directory" yaml:"directory"`
+	RawRetentionHours   int    `mapstructure:"raw_retention_hours" yaml:"raw_retention_hours"`
+	MinuteRetentionDays int    `mapstructure:"minute_retention_days" yaml:"minute_retention_days"`
+	HourRetentionDays   int    `mapstructure:"hour_retention_days" yaml:"hour_retention_days"`
+}
+
+// ToRetention converts the retention settings into a history.Retention
+func (h HistoryConfig) ToRetention() history.Retention {
+	return history.Retention{
+		Raw:    time.Duration(h.RawRetentionHours) * time.Hour,
+		Minute: time.Duration(h.MinuteRetentionDays) * 24 * time.Hour,
+		Hour:   time.Duration(h.HourRetentionDays) * 24 * time.Hour,
+	}
+}
+
+// PollerConfig sends a request to a device at an interval and parses the
+// response into named values
+type PollerConfig struct {
+	Name string `mapstructure:"name" yaml:"name"`
+	Port string `mapstructure:"port" yaml:"port"`
+	// BaudRate overrides serial.defaults.baud_rate for this port
+	BaudRate   int `mapstructure:"baud_rate" yaml:"baud_rate"`
+	IntervalMs int `mapstructure:"interval_ms" yaml:"interval_ms"`
+	// TimeoutMs bounds waiting for the response (default: 1000)
+	TimeoutMs int `mapstructure:"timeout_ms" yaml:"timeout_ms"`
+	// Request is a Go template with .Name, .Seq and .Time
+	Request    string `mapstructure:"request" yaml:"request"`
+	RequestHex bool   `mapstructure:"request_hex" yaml:"request_hex"`
+	// Terminator ends the response (escapes such as \r\n are expanded)
+	Terminator     string             `mapstructure:"terminator" yaml:"terminator"`
+	ResponseLength int                `mapstructure:"response_length" yaml:"response_length"`
+	Parser         PollerParserConfig `mapstructure:"parser" yaml:"parser"`
+	// MQTTTopic overrides "<mqtt.topic_prefix>/<name>"
+	MQTTTopic string `mapstructure:"mqtt_topic" yaml:"mqtt_topic"`
+}
+
+// PollerParserConfig selects how a poll response is parsed
+type PollerParserConfig struct {
+	// Type is regex, bytes or megatec (a UPS answering the Megatec status
+	// query Q1, which is sent at 2400 baud unless request or baud_rate say
+	// otherwise)
+	Type    string              `mapstructure:"type" yaml:"type"`
+	Pattern string              `mapstructure:"pattern" yaml:"pattern"`
+	Fields  []PollerFieldConfig `mapstructure:"fields" yaml:"fields"`
+}
+
+// PollerFieldConfig maps a capture group or byte range to a value
+type PollerFieldConfig struct {
+	Name   string `mapstructure:"name" yaml:"name"`
+	Group  string `mapstructure:"group" yaml:"group"`
+	Offset int    `mapstructure:"offset" yaml:"offset"`
+	Type   string `mapstructure:"type" yaml:"type"`
+	// Endian is big (default) or little
+	Endian string  `mapstructure:"endian" yaml:"endian"`
+	Scale  float64 `mapstructure:"scale" yaml:"scale"`
+	Add    float64 `mapstructure:"add" yaml:"add"`
+	// Expression converts the value after scale and add, e.g. "value*0.1 - 40"
+	Expression string `mapstructure:"expression" yaml:"expression"`
+	Unit       string `mapstructure:"unit" yaml:"unit"`
+}
+
+// ToDefinition converts the entry into a poller.Definition
+func (p PollerConfig) ToDefinition(defaults SerialDefaults) (poller.Definition, error) {
+	// A Megatec UPS answers the status query at its own speed unless told
+	// otherwise
+	if p.Parser.Type == poller.ParserMegatec {
+		if p.Request == "" {
+			p.Request = ups.StatusQuery
+		}
+		if p.Terminator == "" && p.ResponseLength == 0 {
+			p.Terminator = ups.Terminator
+		}
+		defaults.BaudRate = ups.DefaultBaudRate
+	}
+	if p.BaudRate > 0 {
+		defaults.BaudRate = p.BaudRate
+	}
+	portConfig, err := defaults.ToPortConfig()
+	if err != nil {
+		return poller.Definition{}, err
+	}
+
+	fields := make([]poller.Field, 0, len(p.Parser.Fields))
+	for _, f := range p.Parser.Fields {
+		if f.Endian != "" && f.Endian != "big" && f.Endian != "little" {
+			return poller.Definition{}, fmt.Errorf("field %q: endian must be big or little", f.Name)
+		}
+		var expression *poller.Expression
+		if f.Expression != "" {
+			expression, err = poller.ParseExpression(f.Expression)
+			if err != nil {
+				return poller.Definition{}, fmt.Errorf("field %q: %w", f.Name, err)
+			}
+		}
+		fields = append(fields, poller.Field{
+			Name:         f.Name,
+			Group:        f.Group,
+			Offset:       f.Offset,
+			Type:         f.Type,
+			LittleEndian: f.Endian == "little",
+			Scale:        f.Scale,
+			Add:          f.Add,
+			Expression:   expression,
+			Unit:         f.Unit,
+		})
+	}
+	parser, err := poller.NewParser(p.Parser.Type, p.Parser.Pattern, fields)
+	if err != nil {
+		return poller.Definition{}, err
+	}
+
+	terminator, err := unescape(p.Terminator)
+	if err != nil {
+		return poller.Definition{}, fmt.Errorf("terminator: %w", err)
+	}
+
+	timeout := time.Duration(p.TimeoutMs) * time.Millisecond
+	if timeout == 0 {
+		timeout = time.Second
+	}
+
+	return poller.Definition{
+		Name:           p.Name,
+		PortName:       p.Port,
+		Config:         portConfig,
+		Interval:       time.Duration(p.IntervalMs) * time.Millisecond,
+		Request:        p.Request,
+		RequestHex:     p.RequestHex,
+		Timeout:        timeout,
+		Terminator:     terminator,
+		ResponseLength: p.ResponseLength,
+		Parser:         parser,
+		Topic:          p.MQTTTopic,
+	}, nil
+}
+
+// unescape expands Go escape sequences such as \r and \x03
+func unescape(s string) (string, error) {
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+	return strconv.Unquote(`"` + strings.ReplaceAll(s, `"`, `\"`) + `"`)
+}
+
+// MQTTConfig holds the MQTT broker connection used to publish values
+type MQTTConfig struct {
+	Enabled  bool   `mapstructure:"enabled" yaml:"enabled"`
+	Broker   string `mapstructure:"broker" yaml:"broker"`
+	ClientID string `mapstructure:"client_id" yaml:"client_id"`
+	Username string `mapstructure:"username" yaml:"username"`
+	Password string `mapstructure:"password" yaml:"password"`
+	// TopicPrefix is prepended to every published topic
+	TopicPrefix string `mapstructure:"topic_prefix" yaml:"topic_prefix"`
+	QoS         int    `mapstructure:"qos" yaml:"qos"`
+	Retain      bool   `mapstructure:"retain" yaml:"retain"`
+}
+
+// ToOptions converts the settings into mqtt.Options
+func (m MQTTConfig) ToOptions() mqtt.Options {
+	return mqtt.Options{
+		Broker:   m.Broker,
+		ClientID: m.ClientID,
+		Username: m.Username,
+		Password: m.Password,
+		QoS:      byte(m.QoS),
+		Retain:   m.Retain,
+	}
+}
+
+// StorageConfig selects where finished agent files are uploaded, to keep
+// them off devices with limited flash
+type StorageConfig struct {
+	// Type is local, s3 or sftp; empty disables uploads
+	Type string `mapstructure:"type" yaml:"type"`
+	// Prefix is prepended to every uploaded file (default: the host name)
+	Prefix string `mapstructure:"prefix" yaml:"prefix"`
+	// DeleteAfterUpload removes local files once they are uploaded
+	DeleteAfterUpload bool `mapstructure:"delete_after_upload" yaml:"delete_after_upload"`
+	// ConsoleLogs uploads rotated console log files
+	ConsoleLogs bool `mapstructure:"console_logs" yaml:"console_logs"`
+	// Recordings uploads finished session recordings
+	Recordings bool `mapstructure:"recordings" yaml:"recordings"`
+	// SessionSummaries uploads session summary files
+	SessionSummaries bool `mapstructure:"session_summaries" yaml:"session_summaries"`
+
+	Local LocalStorageConfig `mapstructure:"local" yaml:"local"`
+	S3    S3StorageConfig    `mapstructure:"s3" yaml:"s3"`
+	SFTP  SFTPStorageConfig  `mapstructure:"sftp" yaml:"sftp"`
+}
+
+// LocalStorageConfig copies files to a directory, e.g. a USB drive or NFS mount
+type LocalStorageConfig struct {
+	Directory string `mapstructure:"directory" yaml:"directory"`
+}
+
+// S3StorageConfig uploads to an S3-compatible bucket
+type S3StorageConfig struct {
+	// Endpoint of the service (default: AWS S3 in the region)
+	Endpoint  string `mapstructure:"endpoint" yaml:"endpoint"`
+	Region    string `mapstructure:"region" yaml:"region"`
+	Bucket    string `mapstructure:"bucket" yaml:"bucket"`
+	AccessKey string `mapstructure:"access_key" yaml:"access_key"`
+	SecretKey string `mapstructure:"secret_key" yaml:"secret_key"`
+}
+
+// SFTPStorageConfig uploads to an SFTP server
+type SFTPStorageConfig struct {
+	// Address is host or host:port
+	Address  string `mapstructure:"address" yaml:"address"`
+	User     string `mapstructure:"user" yaml:"user"`
+	Password string `mapstructure:"password" yaml:"password"`
+	KeyFile  string `mapstructure:"key_file" yaml:"key_file"`
+	// KnownHostsFile verifies the server's host key
+	KnownHostsFile string `mapstructure:"known_hosts_file" yaml:"known_hosts_file"`
+	Directory      string `mapstructure:"directory" yaml:"directory"`
+}
+
+// ToOptions converts the settings into storage.Options
+func (s StorageConfig) ToOptions() storage.Options {
+	opts := storage.Options{
+		Type:           s.Type,
+		Endpoint:       s.S3.Endpoint,
+		Region:         s.S3.Region,
+		Bucket:         s.S3.Bucket,
+		AccessKey:      s.S3.AccessKey,
+		SecretKey:      s.S3.SecretKey,
+		Address:        s.SFTP.Address,
+		User:           s.SFTP.User,
+		Password:       s.SFTP.Password,
+		KeyFile:        s.SFTP.KeyFile,
+		KnownHostsFile: s.SFTP.KnownHostsFile,
+	}
+	if s.Type == storage.TypeSFTP {
+		opts.Directory = s.SFTP.Directory
+	} else {
+		opts.Directory = s.Local.Directory
+	}
+	return opts
+}
+
+// RetentionConfig limits the disk space used by console logs, recordings,
+// polling history, test reports and session summaries, to protect SD-card
+// based deployments
+type RetentionConfig struct {
+	Enabled bool `mapstructure:"enabled" yaml:"enabled"`
+	// CheckInterval is how often limits are enforced, in seconds
+	CheckInterval int `mapstructure:"check_interval" yaml:"check_interval"`
+	// MaxTotalMB caps all files together; 0 is unlimited
+	MaxTotalMB int `mapstructure:"max_total_mb" yaml:"max_total_mb"`
+
+	ConsoleLogs RetentionLimits `mapstructure:"console_logs" yaml:"console_logs"`
+	Recordings  RetentionLimits `mapstructure:"recordings" yaml:"recordings"`
+	History     RetentionLimits `mapstructure:"history" yaml:"history"`
+	TestReports RetentionLimits `mapstructure:"test_reports" yaml:"test_reports"`
+	// SessionSummaries limits session summary files
+	SessionSummaries RetentionLimits `mapstructure:"session_summaries" yaml:"session_summaries"`
+}
+
+// RetentionLimits are the limits of one kind of file; 0 is unlimited
+type RetentionLimits struct {
+	MaxSizeMB  int `mapstructure:"max_size_mb" yaml:"max_size_mb"`
+	MaxAgeDays int `mapstructure:"max_age_days" yaml:"max_age_days"`
+}
+
+// ToTarget converts the limits into a retention.Target for a directory
+func (l RetentionLimits) ToTarget(name, dir string) retention.Target {
+	return retention.Target{
+		Name:    name,
+		Dir:     dir,
+		MaxAge:  time.Duration(l.MaxAgeDays) * 24 * time.Hour,
+		MaxSize: int64(l.MaxSizeMB) * 1024 * 1024,
+	}
+}
+
+// PortActionConfig describes what to do when a matching port appears
+type PortActionConfig struct {
+	Name  string          `mapstructure:"name" yaml:"name"`
+	Match PortMatchConfig `mapstructure:"match" yaml:"match"`
+
+	// Open keeps the port open; clients can join it with allow_shared_access
+	Open bool `mapstructure:"open" yaml:"open"`
+	// Line settings for open and console_log; unset fields come from the
+	// device's profile, then serial.defaults
+	BaudRate       int    `mapstructure:"baud_rate" yaml:"baud_rate"`
+	DataBits       int    `mapstructure:"data_bits" yaml:"data_bits"`
+	StopBits       int    `mapstructure:"stop_bits" yaml:"stop_bits"`
+	Parity         string `mapstructure:"parity" yaml:"parity"`
+	FlowControl    string `mapstructure:"flow_control" yaml:"flow_control"`
+	LatencyProfile string `mapstructure:"latency_profile" yaml:"latency_profile"`
+	DTR            string `mapstructure:"dtr" yaml:"dtr"`
+	RTS            string `mapstructure:"rts" yaml:"rts"`
+	// ConsoleLog records the port like an entry of console.ports
+	ConsoleLog bool `mapstructure:"console_log" yaml:"console_log"`
+	// Script runs first, with the port name as its argument
+	Script string `mapstructure:"script" yaml:"script"`
+	// ScriptTimeout in seconds (default: 30)
+	ScriptTimeout int `mapstructure:"script_timeout" yaml:"script_timeout"`
+	// Announce publishes the port's arrival and removal over MQTT
+	Announce bool `mapstructure:"announce" yaml:"announce"`
+
+	// Shutdown runs before the port is closed on removal or agent shutdown
+	Shutdown PortShutdownConfig `mapstructure:"shutdown" yaml:"shutdown"`
+}
+
+// PortShutdownConfig puts a device into a safe state before its port closes
+type PortShutdownConfig struct {
+	// Write is sent first, e.g. a safe-state command; supports escapes such as \r\n
+	Write string `mapstructure:"write" yaml:"write"`
+	// WriteHex is sent instead of write when set, e.g. "01 06 00 10 00 00"
+	WriteHex string `mapstructure:"write_hex" yaml:"write_hex"`
+	// DTR and RTS are "low", "high" or empty to leave the line alone
+	DTR string `mapstructure:"dtr" yaml:"dtr"`
+	RTS string `mapstructure:"rts" yaml:"rts"`
+	// DelayMs waits before the port is closed
+	DelayMs int `mapstructure:"delay_ms" yaml:"delay_ms"`
+	// StopConsole stops console logging of the port
+	StopConsole bool `mapstructure:"stop_console" yaml:"stop_console"`
+	// Script runs last, with the port name as its argument
+	Script string `mapstructure:"script" yaml:"script"`
+	// ScriptTimeout in seconds (default: 30)
+	ScriptTimeout int `mapstructure:"script_timeout" yaml:"script_timeout"`
+}
+
+// ToShutdown converts the hooks into actions.Shutdown
+func (s PortShutdownConfig) ToShutdown() (actions.Shutdown, error) {
+	shutdown := actions.Shutdown{
+		Delay:         time.Duration(s.DelayMs) * time.Millisecond,
+		StopConsole:   s.StopConsole,
+		Script:        s.Script,
+		ScriptTimeout: time.Duration(s.ScriptTimeout) * time.Second,
+	}
+
+	if s.WriteHex != "" {
+		data, err := hex.DecodeString(strings.ReplaceAll(s.WriteHex, " ", ""))
+		if err != nil {
+			return actions.Shutdown{}, fmt.Errorf("invalid shutdown.write_hex: %w", err)
+		}
+		shutdown.Write = data
+	} else if s.Write != "" {
+		data, err := unescape(s.Write)
+		if err != nil {
+			return actions.Shutdown{}, fmt.Errorf("invalid shutdown.write: %w", err)
+		}
+		shutdown.Write = []byte(data)
+	}
+
+	for _, line := range []struct {
+		name  string
+		value string
+		dest  **bool
+	}{
+		{"dtr", s.DTR, &shutdown.DTR},
+		{"rts", s.RTS, &shutdown.RTS},
+	} {
+		switch line.value {
+		case "":
+		case "low":
+			*line.dest = new(bool)
+		case "high":
+			high := true
+			*line.dest = &high
+		default:
+			return actions.Shutdown{}, fmt.Errorf("shutdown.%s must be low or high, got %q", line.name, line.value)
+		}
+	}
+	return shutdown, nil
+}
+
+// PortMatchConfig selects ports; every non-empty field must match
+type PortMatchConfig struct {
+	// Port is a regular expression matched against the port name
+	Port         string `mapstructure:"port" yaml:"port"`
+	VID          string `mapstructure:"vid" yaml:"vid"`
+	PID          string `mapstructure:"pid" yaml:"pid"`
+	SerialNumber string `mapstructure:"serial_number" yaml:"serial_number"`
+	// Family is a device family of the device database, e.g. "Arduino"
+	Family string `mapstructure:"family" yaml:"family"`
+}
+
+// ToAction converts the entry into an actions.Action
+func (a PortActionConfig) ToAction() (actions.Action, error) {
+	action := actions.Action{
+		Name: a.Name,
+		Match: actions.Match{
+			VID:          a.Match.VID,
+			PID:          a.Match.PID,
+			SerialNumber: a.Match.SerialNumber,
+			Family:       a.Match.Family,
+		},
+		Open: a.Open,
+		Settings: serial.DeviceProfile{
+			BaudRate:       a.BaudRate,
+			DataBits:       a.DataBits,
+			StopBits:       a.StopBits,
+			Parity:         a.Parity,
+			FlowControl:    a.FlowControl,
+			LatencyProfile: a.LatencyProfile,
+			DTR:            a.DTR,
+			RTS:            a.RTS,
+		},
+		ConsoleLog:    a.ConsoleLog,
+		Script:        a.Script,
+		ScriptTimeout: time.Duration(a.ScriptTimeout) * time.Second,
+		Announce:      a.Announce,
+	}
+	shutdown, err := a.Shutdown.ToShutdown()
+	if err != nil {
+		return actions.Action{}, err
+	}
+	action.Shutdown = shutdown
+	if a.Match.Port != "" {
+		re, err := regexp.Compile(a.Match.Port)
+		if err != nil {
+			return actions.Action{}, fmt.Errorf("invalid match.port: %w", err)
+		}
+		action.Match.Port = re
+	}
+	return action, nil
+}
+
+// DeviceConfig tracks the state of the device on a port
+type DeviceConfig struct {
+	Name string `mapstructure:"name" yaml:"name"`
+	Port string `mapstructure:"port" yaml:"port"`
+	// BaudRate overrides serial.defaults.baud_rate when the tracker opens the port
+	BaudRate int `mapstructure:"baud_rate" yaml:"baud_rate"`
+	// Open lets the tracker open the port while no client has it; required
+	// for probes
+	Open bool `mapstructure:"open" yaml:"open"`
+	// Rules are tried in order against each line of output
+	Rules []DeviceRuleConfig `mapstructure:"rules" yaml:"rules"`
+	Probe DeviceProbeConfig  `mapstructure:"probe" yaml:"probe"`
+}
+
+// DeviceRuleConfig moves a device to a state when its output matches
+type DeviceRuleConfig struct {
+	// Pattern is a regular expression matched against a line
+	Pattern string `mapstructure:"pattern" yaml:"pattern"`
+	// State is UNKNOWN, INITIALIZING, READY, ERROR or UPDATING
+	State string `mapstructure:"state" yaml:"state"`
+}
+
+// DeviceProbeConfig is a command sent periodically to check the device
+type DeviceProbeConfig struct {
+	// Command supports escapes such as \r\n; empty disables probing
+	Command    string `mapstructure:"command" yaml:"command"`
+	IntervalMs int    `mapstructure:"interval_ms" yaml:"interval_ms"`
+	// TimeoutMs without any response moves the device to ERROR (default: 1000)
+	TimeoutMs int `mapstructure:"timeout_ms" yaml:"timeout_ms"`
+}
+
+// ToDefinition converts the entry into a devicestate.Definition
+func (d DeviceConfig) ToDefinition(defaults SerialDefaults) (devicestate.Definition, error) {
+	if d.BaudRate > 0 {
+		defaults.BaudRate = d.BaudRate
+	}
+	portConfig, err := defaults.ToPortConfig()
+	if err != nil {
+		return devicestate.Definition{}, err
+	}
+
+	def := devicestate.Definition{
+		Name:     d.Name,
+		PortName: d.Port,
+		Config:   portConfig,
+		Open:     d.Open,
+	}
+	for i, r := range d.Rules {
+		state := strings.ToUpper(r.State)
+		if !devicestate.ValidState(state) {
+			return devicestate.Definition{}, fmt.Errorf("rule %d: unknown state %q", i+1, r.State)
+		}
+		re, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return devicestate.Definition{}, fmt.Errorf("rule %d: invalid pattern: %w", i+1, err)
+		}
+		def.Rules = append(def.Rules, devicestate.Rule{Pattern: re, State: state})
+	}
+
+	if d.Probe.Command != "" {
+		command, err := unescape(d.Probe.Command)
+		if err != nil {
+			return devicestate.Definition{}, fmt.Errorf("invalid probe.command: %w", err)
+		}
+		timeout := time.Duration(d.Probe.TimeoutMs) * time.Millisecond
+		if timeout == 0 {
+			timeout = time.Second
+		}
+		def.Probe = devicestate.Probe{
+			Command:  []byte(command),
+			Interval: time.Duration(d.Probe.IntervalMs) * time.Millisecond,
+			Timeout:  timeout,
+		}
+	}
+	return def, nil
+}
+
+// TestingConfig holds test suites run against devices, e.g. end-of-line
+// tests on a production line
+type TestingConfig struct {
+	// ReportDirectory receives the reports of every run (default:
+	// "test-reports" next to the config file)
+	ReportDirectory string `mapstructure:"report_directory" yaml:"report_directory"`
+	// ReportFormats are junit and json (default: both)
+	ReportFormats []string          `mapstructure:"report_formats" yaml:"report_formats"`
+	Suites        []TestSuiteConfig `mapstructure:"suites" yaml:"suites"`
+}
+
+// Formats returns the report formats, both when none are configured
+func (t TestingConfig) Formats() []string {
+	if len(t.ReportFormats) == 0 {
+		return []string{testrunner.FormatJUnit, testrunner.FormatJSON}
+	}
+	return t.ReportFormats
+}
+
+// TestSuiteConfig is a sequence of test steps
+type TestSuiteConfig struct {
+	Name string `mapstructure:"name" yaml:"name"`
+	// Port is a regular expression selecting the ports run_on_open applies to
+	Port string `mapstructure:"port" yaml:"port"`
+	// RunOnOpen runs the suite whenever a client opens a matching port
+	RunOnOpen bool `mapstructure:"run_on_open" yaml:"run_on_open"`
+	// StopOnFailure skips the remaining steps after a failed step
+	StopOnFailure bool             `mapstructure:"stop_on_failure" yaml:"stop_on_failure"`
+	Steps         []TestStepConfig `mapstructure:"steps" yaml:"steps"`
+}
+
+// TestStepConfig sends a command and checks the response. Send, expect and
+// terminator support escapes such as \r\n and \x06.
+type TestStepConfig struct {
+	Name string `mapstructure:"name" yaml:"name"`
+	Send string `mapstructure:"send" yaml:"send"`
+	// Expect is the exact response
+	Expect string `mapstructure:"expect" yaml:"expect"`
+	// Pattern is a regular expression the response must match
+	Pattern string `mapstructure:"pattern" yaml:"pattern"`
+	// Nominal and Tolerance check the number captured by the first group
+	// of Pattern
+	Nominal   *float64 `mapstructure:"nominal" yaml:"nominal"`
+	Tolerance float64  `mapstructure:"tolerance" yaml:"tolerance"`
+	// The response ends at Terminator, after Length bytes or after IdleMs
+	// without data (default: 100)
+	Terminator string `mapstructure:"terminator" yaml:"terminator"`
+	Length     int    `mapstructure:"length" yaml:"length"`
+	IdleMs     int    `mapstructure:"idle_ms" yaml:"idle_ms"`
+	// TimeoutMs bounds waiting for the response (default: 2000)
+	TimeoutMs int `mapstructure:"timeout_ms" yaml:"timeout_ms"`
+	// DelayMs waits before the step
+	DelayMs int `mapstructure:"delay_ms" yaml:"delay_ms"`
+}
+
+// ToSuite converts the entry into a testrunner.Suite
+func (t TestSuiteConfig) ToSuite() (testrunner.Suite, error) {
+	suite := testrunner.Suite{
+		Name:          t.Name,
+		RunOnOpen:     t.RunOnOpen,
+		StopOnFailure: t.StopOnFailure,
+	}
+	if t.Port != "" {
+		re, err := regexp.Compile(t.Port)
+		if err != nil {
+			return testrunner.Suite{}, fmt.Errorf("invalid port pattern: %w", err)
+		}
+		suite.Port = re
+	}
+
+	for i, st := range t.Steps {
+		step := testrunner.Step{
+			Name: st.Name,
+			Options: verify.Options{
+				Length:  st.Length,
+				Timeout: time.Duration(st.TimeoutMs) * time.Millisecond,
+				Idle:    time.Duration(st.IdleMs) * time.Millisecond,
+			},
+			Delay: time.Duration(st.DelayMs) * time.Millisecond,
+		}
+		if step.Name == "" {
+			step.Name = fmt.Sprintf("step %d", i+1)
+		}
+
+		fields := []struct {
+			name  string
+			value string
+			dest  *[]byte
+		}{
+			{"send", st.Send, &step.Send},
+			{"expect", st.Expect, &step.Expect},
+			{"terminator", st.Terminator, &step.Options.Terminator},
+		}
+		for _, f := range fields {
+			if f.value == "" {
+				continue
+			}
+			value, err := unescape(f.value)
+			if err != nil {
+				return testrunner.Suite{}, fmt.Errorf("%s: invalid %s: %w", step.Name, f.name, err)
+			}
+			*f.dest = []byte(value)
+		}
+
+		if st.Pattern != "" {
+			re, err := regexp.Compile(st.Pattern)
+			if err != nil {
+				return testrunner.Suite{}, fmt.Errorf("%s: invalid pattern: %w", step.Name, err)
+			}
+			step.Pattern = re
+		}
+		if st.Nominal != nil {
+			if step.Pattern == nil || step.Pattern.NumSubexp() < 1 {
+				return testrunner.Suite{}, fmt.Errorf("%s: nominal requires a pattern with a capture group", step.Name)
+			}
+			step.Tolerance = &testrunner.Tolerance{Nominal: *st.Nominal, Delta: st.Tolerance}
+		}
+		suite.Steps = append(suite.Steps, step)
+	}
+	return suite, nil
+}
+
+// SessionSummaryConfig produces a summary of every session when it closes:
+// duration, bytes each way, error counts, the first and last lines of
+// traffic and the events raised on the port
+type SessionSummaryConfig struct {
+	Enabled bool `mapstructure:"enabled" yaml:"enabled"`
+	// Lines of traffic kept from the start and from the end of a session
+	Lines int `mapstructure:"lines" yaml:"lines"`
+	// WebhookURL receives every summary as an HTTP POST
+	WebhookURL string `mapstructure:"webhook_url" yaml:"webhook_url"`
+	// SaveFiles writes every summary to Directory; files are uploaded when
+	// storage.session_summaries is set
+	SaveFiles bool `mapstructure:"save_files" yaml:"save_files"`
+	// Directory for summary files (default: "sessions" next to the config file)
+	Directory string `mapstructure:"directory" yaml:"directory"`
+	// IncludeAgentSessions also summarizes sessions opened by the agent
+	// itself, e.g. for console logging, polling or port actions
+	IncludeAgentSessions bool `mapstructure:"include_agent_sessions" yaml:"include_agent_sessions"`
+}
+
+// MemoryConfig caps the received data waiting for stream consumers (read
+// streams, console logging, device tracking). Data beyond a cap is dropped
+// so a fast device with a slow consumer cannot exhaust the gateway's
+// memory. Zero means unlimited.
+type MemoryConfig struct {
+	GlobalLimitMB  int `mapstructure:"global_limit_mb" yaml:"global_limit_mb"`
+	SessionLimitMB int `mapstructure:"session_limit_mb" yaml:"session_limit_mb"`
+	StreamLimitMB  int `mapstructure:"stream_limit_mb" yaml:"stream_limit_mb"`
+}
+
+// ToLimits converts the caps into serial.MemoryLimits
+func (m MemoryConfig) ToLimits() serial.MemoryLimits {
+	return serial.MemoryLimits{
+		Global:     int64(m.GlobalLimitMB) << 20,
+		PerSession: int64(m.SessionLimitMB) << 20,
+		PerStream:  int64(m.StreamLimitMB) << 20,
+	}
+}
+
+// OverloadConfig protects the agent when it is overloaded: new OpenPort
+// calls and streams are rejected with RESOURCE_EXHAUSTED and running streams
+// are shed, lowest priority first, until the load recovers. Zero disables a
+// threshold.
+type OverloadConfig struct {
+	Enabled bool `mapstructure:"enabled" yaml:"enabled"`
+	// CheckInterval between load samples in seconds
+	CheckInterval int `mapstructure:"check_interval" yaml:"check_interval"`
+	// MaxCPUPercent is the agent's CPU use as a share of all cores
+	MaxCPUPercent float64 `mapstructure:"max_cpu_percent" yaml:"max_cpu_percent"`
+	// MaxMemoryMB is the memory the agent holds from the OS
+	MaxMemoryMB int `mapstructure:"max_memory_mb" yaml:"max_memory_mb"`
+	// MaxQueuedMB is the data waiting for stream consumers
+	MaxQueuedMB int `mapstructure:"max_queued_mb" yaml:"max_queued_mb"`
+	// DefaultPriority applies to clients without an assigned priority
+	DefaultPriority int `mapstructure:"default_priority" yaml:"default_priority"`
+	// ExemptPriority and above are never rejected or shed
+	ExemptPriority int `mapstructure:"exempt_priority" yaml:"exempt_priority"`
+	// Priorities are assigned per client ID or IP address
+	Priorities []ClientPriorityConfig `mapstructure:"priorities" yaml:"priorities"`
+}
+
+// ClientPriorityConfig assigns a shedding priority to a client
+type ClientPriorityConfig struct {
+	// Client is a client ID (OpenPort client_id or x-seriallink-client-id
+	// metadata) or an IP address
+	Client   string `mapstructure:"client" yaml:"client"`
+	Priority int    `mapstructure:"priority" yaml:"priority"`
+}
+
+// ReservationsConfig lets clients book a port for a time window; during the
+// window only the holder may open it
+type ReservationsConfig struct {
+	Enabled bool `mapstructure:"enabled" yaml:"enabled"`
+	// File persists reservations across restarts (default: reservations.json
+	// next to the config file)
+	File string `mapstructure:"file" yaml:"file"`
+	// MaxHours caps the length of a reservation; 0 is unlimited
+	MaxHours float64 `mapstructure:"max_hours" yaml:"max_hours"`
+	// WebhookURL receives an HTTP POST when a reservation window starts
+	WebhookURL string `mapstructure:"webhook_url" yaml:"webhook_url"`
+}
+
+// UsageConfig accounts sessions, open time and bytes transferred per client
+// and port
+type UsageConfig struct {
+	Enabled bool `mapstructure:"enabled" yaml:"enabled"`
+	// File keeps the totals across restarts (default: usage.json next to the
+	// config file)
+	File string `mapstructure:"file" yaml:"file"`
+	// IncludeAgentSessions also accounts ports opened by the agent itself
+	// (console loggers, pollers, port actions, device tracking)
+	IncludeAgentSessions bool `mapstructure:"include_agent_sessions" yaml:"include_agent_sessions"`
+}
+
+// RedactionConfig masks secrets read from devices in console logs, session
+// recordings, session summaries and bridge data logs. Patterns match within
+// a line of a console log or summary and within a read chunk elsewhere.
+type RedactionConfig struct {
+	Rules []RedactionRuleConfig `mapstructure:"rules" yaml:"rules"`
+}
+
+// RedactionRuleConfig masks what a regular expression matches, or only
+// what its groups match when it has any, e.g. `PIN=(\d+)`
+type RedactionRuleConfig struct {
+	Pattern string `mapstructure:"pattern" yaml:"pattern"`
+	// Mask replaces the redacted data (default "***")
+	Mask string `mapstructure:"mask" yaml:"mask"`
+}
+
+// ToRedactor converts the rules into a redact.Redactor, nil without rules
+func (r RedactionConfig) ToRedactor() (*redact.Redactor, error) {
+	rules := make([]redact.Rule, 0, len(r.Rules))
+	for _, rule := range r.Rules {
+		pattern, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", rule.Pattern, err)
+		}
+		rules = append(rules, redact.Rule{Pattern: pattern, Mask: rule.Mask})
+	}
+	return redact.New(rules), nil
+}
+
+// MetricsConfig serves the agent's metrics in the Prometheus text format
+// on their own listener, for scraping without enabling the HTTP endpoints.
+// The listener has no authentication or TLS.
+type MetricsConfig struct {
+	Enabled bool   `mapstructure:"enabled" yaml:"enabled"`
+	Address string `mapstructure:"address" yaml:"address"`
+	// Path is the URL path of the metrics
+	Path string `mapstructure:"path" yaml:"path"`
+}
+
+// DebugConfig serves pprof, expvar and goroutine and session dumps for
+// diagnosing long-running deployments. The listener is unauthenticated, so
+// keep it on a loopback or management address.
+type DebugConfig struct {
+	// Enabled starts the listener with the agent
+	Enabled bool   `mapstructure:"enabled" yaml:"enabled"`
+	Address string `mapstructure:"address" yaml:"address"`
+	// AllowToggle lets clients start and stop the listener at runtime with
+	// SetDebugEndpoints; only auth.admins may
+	AllowToggle bool `mapstructure:"allow_toggle" yaml:"allow_toggle"`
+}
+
+// ToOptions converts the settings into overload.Options
+func (o OverloadConfig) ToOptions() overload.Options {
+	priorities := make(map[string]int, len(o.Priorities))
+	for _, p := range o.Priorities {
+		priorities[p.Client] = p.Priority
+	}
+	return overload.Options{
+		Thresholds: overload.Thresholds{
+			CPUPercent:  o.MaxCPUPercent,
+			MemoryBytes: uint64(o.MaxMemoryMB) << 20,
+			QueuedBytes: int64(o.MaxQueuedMB) << 20,
+		},
+		Interval:        time.Duration(o.CheckInterval) * time.Second,
+		Priorities:      priorities,
+		DefaultPriority: o.DefaultPriority,
+		ExemptPriority:  o.ExemptPriority,
+	}
+}
+
 // ServiceConfig holds system service settings
 type ServiceConfig struct {
 	Name          string `mapstructure:"name" yaml:"name"`
@@ -97,10 +1612,44 @@ func DefaultConfig() *Config {
 	return &Config{
 		Server: ServerConfig{
 			GRPCAddress:       "0.0.0.0:50051",
+			Network:           "tcp",
 			MaxConnections:    100,
 			ConnectionTimeout: 30,
+			HTTPEnabled:       false,
+			HTTPAddress:       "0.0.0.0:8080",
+			WebSocketEnabled:  false,
+			WebSocketAddress:  "0.0.0.0:8081",
+			WebSocketEncodings: []string{
+				wsframe.EncodingCBOR,
+				wsframe.EncodingMessagePack,
+			},
+			RESTEnabled: false,
+			RESTAddress: "0.0.0.0:8082",
 		},
 		TLS: TLSConfig{
+			Enabled:        false,
+			MinVersion:     "1.2",
+			ClientAuth:     "none",
+			ReloadInterval: 60,
+			ACME: ACMEConfig{
+				Enabled:     false,
+				HTTPAddress: ":80",
+			},
+			SPIFFE: SPIFFEConfig{
+				Enabled: false,
+			},
+		},
+		Auth: AuthConfig{
+			Enabled:  false,
+			Backends: []string{auth.BackendStatic},
+			LDAP: LDAPAuthConfig{
+				CacheSeconds: int(auth.DefaultLDAPCacheTTL.Seconds()),
+			},
+			AccessTokens: AccessTokenConfig{
+				MaxSeconds: int(auth.DefaultAccessTokenMaxTTL.Seconds()),
+			},
+		},
+		ACL: ACLConfig{
 			Enabled: false,
 		},
 		Serial: SerialConfig{
@@ -112,9 +1661,18 @@ func DefaultConfig() *Config {
 				FlowControl:    "none",
 				ReadTimeoutMs:  1000,
 				WriteTimeoutMs: 1000,
+				LatencyProfile: "default",
+				DTR:            "default",
+				RTS:            "default",
 			},
 			ScanInterval:      5,
+			ScanTimeoutMs:     5000,
 			AllowSharedAccess: false,
+			AutoProfiles:      true,
+			Retry: RetryConfig{
+				Attempts:  3,
+				BackoffMs: 10,
+			},
 		},
 		Logging: LoggingConfig{
 			Level:      "info",
@@ -124,6 +1682,78 @@ func DefaultConfig() *Config {
 			MaxAge:     30,
 			Compress:   true,
 		},
+		Console: ConsoleConfig{
+			MaxSize:    10,
+			MaxBackups: 5,
+			Format:     "text",
+			BufferSize: 64,
+			Compliance: ComplianceConfig{
+				CheckpointInterval: 60,
+			},
+			Dedup: DedupConfig{
+				ReportInterval: 60,
+			},
+			Keywords: KeywordsConfig{
+				Windows: []int{60, 300, 3600},
+			},
+			CrashDumps: CrashDumpsConfig{
+				CaptureAfter: 10,
+			},
+		},
+		Polling: PollingConfig{
+			History: HistoryConfig{
+				Enabled:             false,
+				RawRetentionHours:   24,
+				MinuteRetentionDays: 7,
+				HourRetentionDays:   365,
+			},
+		},
+		MQTT: MQTTConfig{
+			Enabled:     false,
+			Broker:      "tcp://localhost:1883",
+			ClientID:    "seriallink",
+			TopicPrefix: "seriallink",
+		},
+		Storage: StorageConfig{
+			ConsoleLogs:      true,
+			Recordings:       true,
+			SessionSummaries: true,
+		},
+		Retention: RetentionConfig{
+			Enabled:       false,
+			CheckInterval: 300,
+		},
+		SessionSummary: SessionSummaryConfig{
+			Enabled:   false,
+			Lines:     10,
+			SaveFiles: true,
+		},
+		Memory: MemoryConfig{
+			GlobalLimitMB:  64,
+			SessionLimitMB: 16,
+			StreamLimitMB:  4,
+		},
+		Overload: OverloadConfig{
+			Enabled:        false,
+			CheckInterval:  2,
+			MaxCPUPercent:  90,
+			ExemptPriority: 100,
+		},
+		Reservations: ReservationsConfig{
+			Enabled: false,
+		},
+		Usage: UsageConfig{
+			Enabled: false,
+		},
+		Metrics: MetricsConfig{
+			Enabled: false,
+			Address: "0.0.0.0:9090",
+			Path:    "/metrics",
+		},
+		Debug: DebugConfig{
+			Enabled: false,
+			Address: "127.0.0.1:6060",
+		},
 		Service: ServiceConfig{
 			Name:          "seriallink",
 			DisplayName:   "SerialLink Agent",
@@ -152,6 +1782,21 @@ func (d SerialDefaults) ToPortConfig() (serial.PortConfig, error) {
 		return serial.PortConfig{}, err
 	}
 
+	latencyProfile, err := serial.ParseLatencyProfile(d.LatencyProfile)
+	if err != nil {
+		return serial.PortConfig{}, err
+	}
+
+	dtr, err := serial.ParseLineState(d.DTR)
+	if err != nil {
+		return serial.PortConfig{}, fmt.Errorf("dtr: %w", err)
+	}
+
+	rts, err := serial.ParseLineState(d.RTS)
+	if err != nil {
+		return serial.PortConfig{}, fmt.Errorf("rts: %w", err)
+	}
+
 	return serial.PortConfig{
 		BaudRate:       d.BaudRate,
 		DataBits:       d.DataBits,
@@ -160,6 +1805,9 @@ func (d SerialDefaults) ToPortConfig() (serial.PortConfig, error) {
 		FlowControl:    flowControl,
 		ReadTimeoutMs:  d.ReadTimeoutMs,
 		WriteTimeoutMs: d.WriteTimeoutMs,
+		LatencyProfile: latencyProfile,
+		DTR:            dtr,
+		RTS:            rts,
 	}, nil
 }
 
@@ -169,11 +1817,36 @@ func SetDefaults() {
 
 	// Server defaults
 	viper.SetDefault("server.grpc_address", defaults.Server.GRPCAddress)
+	viper.SetDefault("server.network", defaults.Server.Network)
 	viper.SetDefault("server.max_connections", defaults.Server.MaxConnections)
 	viper.SetDefault("server.connection_timeout", defaults.Server.ConnectionTimeout)
+	viper.SetDefault("server.http_enabled", defaults.Server.HTTPEnabled)
+	viper.SetDefault("server.http_address", defaults.Server.HTTPAddress)
+	viper.SetDefault("server.websocket_enabled", defaults.Server.WebSocketEnabled)
+	viper.SetDefault("server.websocket_address", defaults.Server.WebSocketAddress)
+	viper.SetDefault("server.websocket_encodings", defaults.Server.WebSocketEncodings)
+	viper.SetDefault("server.websocket_origins", defaults.Server.WebSocketOrigins)
+	viper.SetDefault("server.rest_enabled", defaults.Server.RESTEnabled)
+	viper.SetDefault("server.rest_address", defaults.Server.RESTAddress)
+	viper.SetDefault("server.proxy_protocol", defaults.Server.ProxyProtocol)
+	viper.SetDefault("server.trust_forwarded_for", defaults.Server.TrustForwardedFor)
 
 	// TLS defaults
 	viper.SetDefault("tls.enabled", defaults.TLS.Enabled)
+	viper.SetDefault("tls.min_version", defaults.TLS.MinVersion)
+	viper.SetDefault("tls.client_auth", defaults.TLS.ClientAuth)
+	viper.SetDefault("tls.reload_interval", defaults.TLS.ReloadInterval)
+	viper.SetDefault("tls.acme.enabled", defaults.TLS.ACME.Enabled)
+	viper.SetDefault("tls.acme.http_address", defaults.TLS.ACME.HTTPAddress)
+	viper.SetDefault("tls.spiffe.enabled", defaults.TLS.SPIFFE.Enabled)
+
+	// Auth defaults
+	viper.SetDefault("auth.enabled", defaults.Auth.Enabled)
+	viper.SetDefault("auth.token_file", defaults.Auth.TokenFile)
+	viper.SetDefault("auth.backends", defaults.Auth.Backends)
+	viper.SetDefault("auth.ldap.cache_seconds", defaults.Auth.LDAP.CacheSeconds)
+	viper.SetDefault("auth.access_tokens.max_seconds", defaults.Auth.AccessTokens.MaxSeconds)
+	viper.SetDefault("acl.enabled", defaults.ACL.Enabled)
 
 	// Serial defaults
 	viper.SetDefault("serial.defaults.baud_rate", defaults.Serial.Defaults.BaudRate)
@@ -183,8 +1856,18 @@ func SetDefaults() {
 	viper.SetDefault("serial.defaults.flow_control", defaults.Serial.Defaults.FlowControl)
 	viper.SetDefault("serial.defaults.read_timeout_ms", defaults.Serial.Defaults.ReadTimeoutMs)
 	viper.SetDefault("serial.defaults.write_timeout_ms", defaults.Serial.Defaults.WriteTimeoutMs)
+	viper.SetDefault("serial.defaults.latency_profile", defaults.Serial.Defaults.LatencyProfile)
+	viper.SetDefault("serial.defaults.dtr", defaults.Serial.Defaults.DTR)
+	viper.SetDefault("serial.defaults.rts", defaults.Serial.Defaults.RTS)
 	viper.SetDefault("serial.scan_interval", defaults.Serial.ScanInterval)
+	viper.SetDefault("serial.scan_timeout_ms", defaults.Serial.ScanTimeoutMs)
 	viper.SetDefault("serial.allow_shared_access", defaults.Serial.AllowSharedAccess)
+	viper.SetDefault("serial.line_quality_monitoring", defaults.Serial.LineQualityMonitoring)
+	viper.SetDefault("serial.auto_profiles", defaults.Serial.AutoProfiles)
+	viper.SetDefault("serial.retry.attempts", defaults.Serial.Retry.Attempts)
+	viper.SetDefault("serial.retry.backoff_ms", defaults.Serial.Retry.BackoffMs)
+	viper.SetDefault("serial.short_session_ids", defaults.Serial.ShortSessionIDs)
+	viper.SetDefault("serial.write_coalesce_ms", defaults.Serial.WriteCoalesceMs)
 
 	// Logging defaults
 	viper.SetDefault("logging.level", defaults.Logging.Level)
@@ -194,6 +1877,94 @@ func SetDefaults() {
 	viper.SetDefault("logging.max_age", defaults.Logging.MaxAge)
 	viper.SetDefault("logging.compress", defaults.Logging.Compress)
 
+	// Console defaults
+	viper.SetDefault("console.directory", defaults.Console.Directory)
+	viper.SetDefault("console.max_size", defaults.Console.MaxSize)
+	viper.SetDefault("console.max_backups", defaults.Console.MaxBackups)
+	viper.SetDefault("console.format", defaults.Console.Format)
+	viper.SetDefault("console.ship_url", defaults.Console.ShipURL)
+	viper.SetDefault("console.buffer_size", defaults.Console.BufferSize)
+	viper.SetDefault("console.recording.enabled", defaults.Console.Recording.Enabled)
+	viper.SetDefault("console.recording.always", defaults.Console.Recording.Always)
+	viper.SetDefault("console.recording.directory", defaults.Console.Recording.Directory)
+	viper.SetDefault("console.compliance.enabled", defaults.Console.Compliance.Enabled)
+	viper.SetDefault("console.compliance.checkpoint_interval", defaults.Console.Compliance.CheckpointInterval)
+	viper.SetDefault("console.dedup.enabled", defaults.Console.Dedup.Enabled)
+	viper.SetDefault("console.dedup.report_interval", defaults.Console.Dedup.ReportInterval)
+	viper.SetDefault("console.keywords.ignore_case", defaults.Console.Keywords.IgnoreCase)
+	viper.SetDefault("console.keywords.windows", defaults.Console.Keywords.Windows)
+	viper.SetDefault("console.crash_dumps.capture_after", defaults.Console.CrashDumps.CaptureAfter)
+
+	// Polling defaults
+	viper.SetDefault("polling.history.enabled", defaults.Polling.History.Enabled)
+	viper.SetDefault("polling.history.directory", defaults.Polling.History.Directory)
+	viper.SetDefault("polling.history.raw_retention_hours", defaults.Polling.History.RawRetentionHours)
+	viper.SetDefault("polling.history.minute_retention_days", defaults.Polling.History.MinuteRetentionDays)
+	viper.SetDefault("polling.history.hour_retention_days", defaults.Polling.History.HourRetentionDays)
+
+	// MQTT defaults
+	viper.SetDefault("mqtt.enabled", defaults.MQTT.Enabled)
+	viper.SetDefault("mqtt.broker", defaults.MQTT.Broker)
+	viper.SetDefault("mqtt.client_id", defaults.MQTT.ClientID)
+	viper.SetDefault("mqtt.topic_prefix", defaults.MQTT.TopicPrefix)
+	viper.SetDefault("mqtt.qos", defaults.MQTT.QoS)
+	viper.SetDefault("mqtt.retain", defaults.MQTT.Retain)
+
+	// Storage defaults (credentials are listed so SERIALLINK_STORAGE_* environment variables apply)
+	viper.SetDefault("storage.type", defaults.Storage.Type)
+	viper.SetDefault("storage.delete_after_upload", defaults.Storage.DeleteAfterUpload)
+	viper.SetDefault("storage.console_logs", defaults.Storage.ConsoleLogs)
+	viper.SetDefault("storage.recordings", defaults.Storage.Recordings)
+	viper.SetDefault("storage.session_summaries", defaults.Storage.SessionSummaries)
+	viper.SetDefault("storage.s3.access_key", defaults.Storage.S3.AccessKey)
+	viper.SetDefault("storage.s3.secret_key", defaults.Storage.S3.SecretKey)
+	viper.SetDefault("storage.sftp.password", defaults.Storage.SFTP.Password)
+
+	// Retention defaults
+	viper.SetDefault("retention.enabled", defaults.Retention.Enabled)
+	viper.SetDefault("retention.check_interval", defaults.Retention.CheckInterval)
+	viper.SetDefault("retention.max_total_mb", defaults.Retention.MaxTotalMB)
+
+	// Session summary defaults
+	viper.SetDefault("session_summary.enabled", defaults.SessionSummary.Enabled)
+	viper.SetDefault("session_summary.lines", defaults.SessionSummary.Lines)
+	viper.SetDefault("session_summary.save_files", defaults.SessionSummary.SaveFiles)
+
+	// Memory defaults
+	viper.SetDefault("memory.global_limit_mb", defaults.Memory.GlobalLimitMB)
+	viper.SetDefault("memory.session_limit_mb", defaults.Memory.SessionLimitMB)
+	viper.SetDefault("memory.stream_limit_mb", defaults.Memory.StreamLimitMB)
+
+	// Overload defaults
+	viper.SetDefault("overload.enabled", defaults.Overload.Enabled)
+	viper.SetDefault("overload.check_interval", defaults.Overload.CheckInterval)
+	viper.SetDefault("overload.max_cpu_percent", defaults.Overload.MaxCPUPercent)
+	viper.SetDefault("overload.max_memory_mb", defaults.Overload.MaxMemoryMB)
+	viper.SetDefault("overload.max_queued_mb", defaults.Overload.MaxQueuedMB)
+	viper.SetDefault("overload.default_priority", defaults.Overload.DefaultPriority)
+	viper.SetDefault("overload.exempt_priority", defaults.Overload.ExemptPriority)
+
+	// Reservation defaults
+	viper.SetDefault("reservations.enabled", defaults.Reservations.Enabled)
+	viper.SetDefault("reservations.file", defaults.Reservations.File)
+	viper.SetDefault("reservations.max_hours", defaults.Reservations.MaxHours)
+	viper.SetDefault("reservations.webhook_url", defaults.Reservations.WebhookURL)
+
+	// Usage accounting defaults
+	viper.SetDefault("usage.enabled", defaults.Usage.Enabled)
+	viper.SetDefault("usage.file", defaults.Usage.File)
+	viper.SetDefault("usage.include_agent_sessions", defaults.Usage.IncludeAgentSessions)
+
+	// Metrics endpoint defaults
+	viper.SetDefault("metrics.enabled", defaults.Metrics.Enabled)
+	viper.SetDefault("metrics.address", defaults.Metrics.Address)
+	viper.SetDefault("metrics.path", defaults.Metrics.Path)
+
+	// Debug endpoint defaults
+	viper.SetDefault("debug.enabled", defaults.Debug.Enabled)
+	viper.SetDefault("debug.address", defaults.Debug.Address)
+	viper.SetDefault("debug.allow_toggle", defaults.Debug.AllowToggle)
+
 	// Service defaults
 	viper.SetDefault("service.name", defaults.Service.Name)
 	viper.SetDefault("service.display_name", defaults.Service.DisplayName)
@@ -259,11 +2030,31 @@ func (c *Config) Save(path string) error {
 // toMap converts config to a map for viper
 func (c *Config) toMap() map[string]interface{} {
 	return map[string]interface{}{
-		"server":  c.Server,
-		"tls":     c.TLS,
-		"serial":  c.Serial,
-		"logging": c.Logging,
-		"service": c.Service,
+		"server":          c.Server,
+		"tls":             c.TLS,
+		"auth":            c.Auth,
+		"acl":             c.ACL,
+		"serial":          c.Serial,
+		"logging":         c.Logging,
+		"console":         c.Console,
+		"gpio":            c.GPIO,
+		"bus":             c.Bus,
+		"polling":         c.Polling,
+		"mqtt":            c.MQTT,
+		"storage":         c.Storage,
+		"retention":       c.Retention,
+		"port_actions":    c.PortActions,
+		"devices":         c.Devices,
+		"testing":         c.Testing,
+		"session_summary": c.SessionSummary,
+		"memory":          c.Memory,
+		"overload":        c.Overload,
+		"reservations":    c.Reservations,
+		"usage":           c.Usage,
+		"redaction":       c.Redaction,
+		"metrics":         c.Metrics,
+		"debug":           c.Debug,
+		"service":         c.Service,
 	}
 }
 
@@ -272,15 +2063,132 @@ func (c *Config) Validate() error {
 	if c.Server.GRPCAddress == "" {
 		return fmt.Errorf("grpc_address is required")
 	}
+	if err := ValidateListenAddress(c.Server.Network, c.Server.GRPCAddress); err != nil {
+		return fmt.Errorf("server.grpc_address: %w", err)
+	}
 
 	if c.Server.MaxConnections < 1 {
 		return fmt.Errorf("max_connections must be at least 1")
 	}
 
+	if c.Server.HTTPEnabled {
+		if c.Server.HTTPAddress == "" {
+			return fmt.Errorf("http_address is required when http_enabled is set")
+		}
+		if err := ValidateListenAddress(c.Server.Network, c.Server.HTTPAddress); err != nil {
+			return fmt.Errorf("server.http_address: %w", err)
+		}
+	}
+
+	if c.Server.WebSocketEnabled {
+		if err := ValidateListenAddress(c.Server.Network, c.Server.WebSocketAddress); err != nil {
+			return fmt.Errorf("server.websocket_address: %w", err)
+		}
+	}
+
+	if c.Server.RESTEnabled {
+		if err := ValidateListenAddress(c.Server.Network, c.Server.RESTAddress); err != nil {
+			return fmt.Errorf("server.rest_address: %w", err)
+		}
+	}
+
+	for _, encoding := range c.Server.WebSocketEncodings {
+		if _, err := wsframe.Lookup(encoding); err != nil {
+			return fmt.Errorf("server.websocket_encodings: %w", err)
+		}
+	}
+
+	for _, origin := range c.Server.WebSocketOrigins {
+		if origin == "*" {
+			continue
+		}
+		if u, err := url.Parse(origin); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("server.websocket_origins: invalid origin %q (e.g. https://dash.example.com)", origin)
+		}
+	}
+
+	for _, proxy := range c.Server.TrustedProxies {
+		if net.ParseIP(proxy) == nil {
+			if _, _, err := net.ParseCIDR(proxy); err != nil {
+				return fmt.Errorf("invalid trusted_proxies entry %q", proxy)
+			}
+		}
+	}
+
 	if c.TLS.Enabled {
-		if c.TLS.CertFile == "" || c.TLS.KeyFile == "" {
+		if c.TLS.ACME.Enabled && c.TLS.SPIFFE.Enabled {
+			return fmt.Errorf("tls.acme and tls.spiffe cannot both be enabled")
+		}
+		if c.TLS.ACME.Enabled {
+			if len(c.TLS.ACME.Domains) == 0 {
+				return fmt.Errorf("tls.acme.domains is required when ACME is enabled")
+			}
+			if err := ValidateListenAddress("tcp", c.TLS.ACME.HTTPAddress); err != nil {
+				return fmt.Errorf("tls.acme.http_address: %w", err)
+			}
+		} else if c.TLS.SPIFFE.Enabled {
+			for _, id := range c.TLS.SPIFFE.AllowedIDs {
+				if !strings.HasPrefix(id, "spiffe://") {
+					return fmt.Errorf("tls.spiffe.allowed_ids entry %q must be a spiffe:// URI", id)
+				}
+			}
+		} else if c.TLS.CertFile == "" || c.TLS.KeyFile == "" {
 			return fmt.Errorf("TLS cert_file and key_file are required when TLS is enabled")
 		}
+		if err := c.TLS.validateOptions(); err != nil {
+			return err
+		}
+	}
+
+	tokenNames := make(map[string]bool, len(c.Auth.Tokens))
+	for _, t := range c.Auth.Tokens {
+		if t.Name == "" {
+			return fmt.Errorf("auth.tokens entries require a name")
+		}
+		if tokenNames[t.Name] {
+			return fmt.Errorf("auth token %q is listed twice", t.Name)
+		}
+		tokenNames[t.Name] = true
+		if (t.Token == "") == (t.SHA256 == "") {
+			return fmt.Errorf("auth token %q needs exactly one of token and sha256", t.Name)
+		}
+		if t.SHA256 != "" && !auth.ValidHash(t.SHA256) {
+			return fmt.Errorf("auth token %q: sha256 must be 64 hex digits", t.Name)
+		}
+	}
+	if c.Auth.Enabled && len(c.Auth.Backends) == 0 {
+		return fmt.Errorf("auth.backends must list at least one backend")
+	}
+	backends := make(map[string]bool, len(c.Auth.Backends))
+	for _, backend := range c.Auth.Backends {
+		if err := auth.ValidBackend(backend); err != nil {
+			return err
+		}
+		if backends[strings.ToLower(backend)] {
+			return fmt.Errorf("auth backend %q is listed twice", backend)
+		}
+		backends[strings.ToLower(backend)] = true
+	}
+	if c.Auth.Enabled && c.Auth.HasBackend(auth.BackendOIDC) && (c.Auth.OIDC.Issuer == "" || c.Auth.OIDC.Audience == "") {
+		return fmt.Errorf("auth.oidc requires an issuer and an audience")
+	}
+	if c.Auth.Enabled && c.Auth.HasBackend(auth.BackendLDAP) && (c.Auth.LDAP.URL == "" || c.Auth.LDAP.UserBaseDN == "") {
+		return fmt.Errorf("auth.ldap requires a url and a user_base_dn")
+	}
+	if c.Auth.LDAP.CacheSeconds < 0 {
+		return fmt.Errorf("auth.ldap.cache_seconds must not be negative")
+	}
+	if c.Auth.AccessTokens.MaxSeconds <= 0 {
+		return fmt.Errorf("auth.access_tokens.max_seconds must be positive")
+	}
+
+	for i, rule := range c.ACL.Rules {
+		if len(rule.Clients) == 0 || len(rule.Ports) == 0 {
+			return fmt.Errorf("acl rule %d requires clients and ports", i+1)
+		}
+		if _, err := rule.ToRule(); err != nil {
+			return fmt.Errorf("acl rule %d: %w", i+1, err)
+		}
 	}
 
 	if c.Serial.Defaults.BaudRate < 1 {
@@ -300,6 +2208,568 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid serial defaults: %w", err)
 	}
 
+	if c.Serial.Retry.Attempts < 0 || c.Serial.Retry.BackoffMs < 0 {
+		return fmt.Errorf("serial.retry values must not be negative")
+	}
+
+	if c.Serial.ScanTimeoutMs < 0 {
+		return fmt.Errorf("serial.scan_timeout_ms must not be negative")
+	}
+	for name, ms := range c.Serial.ScanProviderTimeoutsMs {
+		if ms <= 0 {
+			return fmt.Errorf("serial.scan_provider_timeouts_ms: timeout of %q must be positive", name)
+		}
+	}
+
+	if window := time.Duration(c.Serial.WriteCoalesceMs) * time.Millisecond; window < 0 || window > serial.MaxCoalesceWindow {
+		return fmt.Errorf("serial.write_coalesce_ms must be between 0 and %d", serial.MaxCoalesceWindow.Milliseconds())
+	}
+
+	for _, name := range c.Serial.RemotePorts {
+		if !serial.IsNetworkPort(name) {
+			return fmt.Errorf("remote port %q must start with tcp:// or rfc2217://", name)
+		}
+	}
+
+	for _, profile := range c.Serial.DeviceProfiles {
+		if profile.VID == "" {
+			return fmt.Errorf("serial.device_profiles entries require a vid")
+		}
+		if _, err := profile.ToDeviceProfile().Apply(serial.DefaultConfig()); err != nil {
+			return fmt.Errorf("device profile %s:%s: %w", profile.VID, profile.PID, err)
+		}
+	}
+
+	scannerPorts := make(map[string]bool, len(c.Serial.ScannerProfiles))
+	for _, profile := range c.Serial.ScannerProfiles {
+		if profile.Port == "" {
+			return fmt.Errorf("serial.scanner_profiles entries require a port")
+		}
+		if scannerPorts[profile.Port] {
+			return fmt.Errorf("scanner profile for %q is listed twice", profile.Port)
+		}
+		scannerPorts[profile.Port] = true
+		if profile.DebounceMs < 0 || profile.IdleMs < 0 || profile.MinLength < 0 {
+			return fmt.Errorf("scanner profile for %q: debounce_ms, idle_ms and min_length must not be negative", profile.Port)
+		}
+	}
+
+	policyPorts := make(map[string]bool, len(c.Serial.WritePolicies))
+	for _, policy := range c.Serial.WritePolicies {
+		if policy.Port == "" {
+			return fmt.Errorf("serial.write_policies entries require a port")
+		}
+		if policyPorts[policy.Port] {
+			return fmt.Errorf("write policy for %q is listed twice", policy.Port)
+		}
+		policyPorts[policy.Port] = true
+		if policy.ApprovalTimeout < 0 {
+			return fmt.Errorf("write policy for %q: approval_timeout must not be negative", policy.Port)
+		}
+		if len(policy.Approvers) > 0 && len(policy.RequireApproval) == 0 {
+			return fmt.Errorf("write policy for %q: approvers require require_approval patterns", policy.Port)
+		}
+		if len(policy.RequireApproval) > 0 && len(policy.Approvers) == 0 {
+			return fmt.Errorf("write policy for %q: require_approval needs approvers", policy.Port)
+		}
+		if _, err := policy.ToPolicy(); err != nil {
+			return fmt.Errorf("write policy for %q: %w", policy.Port, err)
+		}
+	}
+
+	guardPorts := make(map[string]bool, len(c.Serial.InputGuards))
+	for _, guard := range c.Serial.InputGuards {
+		if guard.Port == "" {
+			return fmt.Errorf("serial.input_guards entries require a port")
+		}
+		if guardPorts[guard.Port] {
+			return fmt.Errorf("input guard for %q is listed twice", guard.Port)
+		}
+		guardPorts[guard.Port] = true
+		if _, err := guard.ToPolicy(); err != nil {
+			return fmt.Errorf("input guard for %q: %w", guard.Port, err)
+		}
+	}
+
+	resetPorts := make(map[string]bool, len(c.GPIO.ResetLines))
+	for _, line := range c.GPIO.ResetLines {
+		if line.Port == "" {
+			return fmt.Errorf("gpio.reset_lines entries require a port")
+		}
+		if resetPorts[line.Port] {
+			return fmt.Errorf("gpio reset line for %q is listed twice", line.Port)
+		}
+		resetPorts[line.Port] = true
+		if line.Line < 0 || line.PulseMs < 0 {
+			return fmt.Errorf("gpio reset line for %q: line and pulse_ms must not be negative", line.Port)
+		}
+	}
+
+	for _, name := range c.Bus.Providers {
+		if !slices.Contains(bus.ProviderNames(), name) {
+			return fmt.Errorf("unknown bus provider %q (available: %s)", name, strings.Join(bus.ProviderNames(), ", "))
+		}
+	}
+
+	if err := c.Console.validate(); err != nil {
+		return err
+	}
+
+	pollers := make(map[string]bool, len(c.Polling.Pollers))
+	for _, p := range c.Polling.Pollers {
+		if p.Name == "" || p.Port == "" {
+			return fmt.Errorf("polling.pollers entries require a name and a port")
+		}
+		if pollers[p.Name] {
+			return fmt.Errorf("poller %q is listed twice", p.Name)
+		}
+		pollers[p.Name] = true
+		if p.IntervalMs < 1 {
+			return fmt.Errorf("poller %q: interval_ms must be positive", p.Name)
+		}
+		if p.TimeoutMs < 0 || p.ResponseLength < 0 || p.BaudRate < 0 {
+			return fmt.Errorf("poller %q: timeout_ms, response_length and baud_rate must not be negative", p.Name)
+		}
+		if _, err := p.ToDefinition(c.Serial.Defaults); err != nil {
+			return fmt.Errorf("poller %q: %w", p.Name, err)
+		}
+	}
+
+	rules := make(map[string]bool, len(c.Polling.Alarms.Rules))
+	for _, r := range c.Polling.Alarms.Rules {
+		if r.Name == "" || r.Poller == "" || r.Field == "" {
+			return fmt.Errorf("polling.alarms.rules entries require a name, poller and field")
+		}
+		if rules[r.Name] {
+			return fmt.Errorf("alarm rule %q is listed twice", r.Name)
+		}
+		rules[r.Name] = true
+		if !pollers[r.Poller] {
+			return fmt.Errorf("alarm rule %q: unknown poller %q", r.Name, r.Poller)
+		}
+		if !alarm.ValidCondition(r.Condition) {
+			return fmt.Errorf("alarm rule %q: condition must be one of >, >=, <, <=, ==, !=", r.Name)
+		}
+		if r.ForSeconds < 0 {
+			return fmt.Errorf("alarm rule %q: for_seconds must not be negative", r.Name)
+		}
+	}
+
+	if h := c.Polling.History; h.Enabled {
+		if h.RawRetentionHours < 0 || h.MinuteRetentionDays < 0 || h.HourRetentionDays < 0 {
+			return fmt.Errorf("polling.history retention must not be negative")
+		}
+		if h.RawRetentionHours == 0 && h.MinuteRetentionDays == 0 && h.HourRetentionDays == 0 {
+			return fmt.Errorf("polling.history needs at least one non-zero retention")
+		}
+	}
+
+	switch c.Storage.Type {
+	case "":
+	case storage.TypeLocal:
+		if c.Storage.Local.Directory == "" {
+			return fmt.Errorf("storage.local.directory is required for local storage")
+		}
+	case storage.TypeS3:
+		if c.Storage.S3.Bucket == "" {
+			return fmt.Errorf("storage.s3.bucket is required for s3 storage")
+		}
+	case storage.TypeSFTP:
+		if c.Storage.SFTP.Address == "" || c.Storage.SFTP.User == "" || c.Storage.SFTP.KnownHostsFile == "" {
+			return fmt.Errorf("storage.sftp.address, user and known_hosts_file are required for sftp storage")
+		}
+	default:
+		return fmt.Errorf("storage.type must be local, s3 or sftp, got %q", c.Storage.Type)
+	}
+
+	if r := c.Retention; r.Enabled {
+		if r.CheckInterval <= 0 {
+			return fmt.Errorf("retention.check_interval must be positive")
+		}
+		if r.MaxTotalMB < 0 {
+			return fmt.Errorf("retention.max_total_mb must not be negative")
+		}
+		for _, l := range []RetentionLimits{r.ConsoleLogs, r.Recordings, r.History} {
+			if l.MaxSizeMB < 0 || l.MaxAgeDays < 0 {
+				return fmt.Errorf("retention limits must not be negative")
+			}
+		}
+	}
+
+	actionNames := make(map[string]bool)
+	for _, a := range c.PortActions {
+		if a.Name == "" {
+			return fmt.Errorf("port action name is required")
+		}
+		if actionNames[a.Name] {
+			return fmt.Errorf("duplicate port action %q", a.Name)
+		}
+		actionNames[a.Name] = true
+		action, err := a.ToAction()
+		if err != nil {
+			return fmt.Errorf("port action %q: %w", a.Name, err)
+		}
+		if _, err := action.Settings.Apply(serial.DefaultConfig()); err != nil {
+			return fmt.Errorf("port action %q: %w", a.Name, err)
+		}
+		if !a.Open && !a.ConsoleLog && a.Script == "" && !a.Announce && !action.Shutdown.IsSet() {
+			return fmt.Errorf("port action %q does nothing; set open, console_log, script, announce or shutdown", a.Name)
+		}
+		if a.Shutdown.DelayMs < 0 || a.Shutdown.ScriptTimeout < 0 {
+			return fmt.Errorf("port action %q: shutdown delay_ms and script_timeout must not be negative", a.Name)
+		}
+		if a.Announce && !c.MQTT.Enabled {
+			return fmt.Errorf("port action %q: announce requires mqtt.enabled", a.Name)
+		}
+		if a.ScriptTimeout < 0 {
+			return fmt.Errorf("port action %q: script_timeout must not be negative", a.Name)
+		}
+	}
+
+	devices := make(map[string]bool, len(c.Devices))
+	devicePorts := make(map[string]bool, len(c.Devices))
+	for _, d := range c.Devices {
+		if d.Name == "" || d.Port == "" {
+			return fmt.Errorf("devices entries require a name and a port")
+		}
+		if devices[d.Name] {
+			return fmt.Errorf("device %q is listed twice", d.Name)
+		}
+		devices[d.Name] = true
+		if devicePorts[d.Port] {
+			return fmt.Errorf("device %q: port %s is tracked by another device", d.Name, d.Port)
+		}
+		devicePorts[d.Port] = true
+		if d.BaudRate < 0 || d.Probe.TimeoutMs < 0 {
+			return fmt.Errorf("device %q: baud_rate and probe.timeout_ms must not be negative", d.Name)
+		}
+		if d.Probe.Command != "" {
+			if !d.Open {
+				return fmt.Errorf("device %q: probe requires open", d.Name)
+			}
+			if d.Probe.IntervalMs < 1 {
+				return fmt.Errorf("device %q: probe.interval_ms must be positive", d.Name)
+			}
+		}
+		if len(d.Rules) == 0 && d.Probe.Command == "" {
+			return fmt.Errorf("device %q needs rules or a probe", d.Name)
+		}
+		if _, err := d.ToDefinition(c.Serial.Defaults); err != nil {
+			return fmt.Errorf("device %q: %w", d.Name, err)
+		}
+	}
+
+	for _, f := range c.Testing.ReportFormats {
+		if !testrunner.ValidFormat(f) {
+			return fmt.Errorf("testing.report_formats: unknown format %q, use junit or json", f)
+		}
+	}
+	suites := make(map[string]bool, len(c.Testing.Suites))
+	for _, t := range c.Testing.Suites {
+		if t.Name == "" {
+			return fmt.Errorf("testing.suites entries require a name")
+		}
+		if suites[t.Name] {
+			return fmt.Errorf("test suite %q is listed twice", t.Name)
+		}
+		suites[t.Name] = true
+		if len(t.Steps) == 0 {
+			return fmt.Errorf("test suite %q has no steps", t.Name)
+		}
+		if t.RunOnOpen && t.Port == "" {
+			return fmt.Errorf("test suite %q: run_on_open requires port", t.Name)
+		}
+		for i, st := range t.Steps {
+			if st.Expect != "" && st.Pattern != "" {
+				return fmt.Errorf("test suite %q step %d: set expect or pattern, not both", t.Name, i+1)
+			}
+			if st.Send == "" && st.Expect == "" && st.Pattern == "" && st.DelayMs == 0 {
+				return fmt.Errorf("test suite %q step %d does nothing; set send, expect, pattern or delay_ms", t.Name, i+1)
+			}
+			if st.Length < 0 || st.IdleMs < 0 || st.TimeoutMs < 0 || st.DelayMs < 0 || st.Tolerance < 0 {
+				return fmt.Errorf("test suite %q step %d: length, idle_ms, timeout_ms, delay_ms and tolerance must not be negative", t.Name, i+1)
+			}
+			if st.Tolerance > 0 && st.Nominal == nil {
+				return fmt.Errorf("test suite %q step %d: tolerance requires nominal", t.Name, i+1)
+			}
+		}
+		if _, err := t.ToSuite(); err != nil {
+			return fmt.Errorf("test suite %q: %w", t.Name, err)
+		}
+	}
+
+	if c.SessionSummary.Enabled {
+		if c.SessionSummary.Lines < 0 {
+			return fmt.Errorf("session_summary.lines must not be negative")
+		}
+		if c.SessionSummary.WebhookURL == "" && !c.SessionSummary.SaveFiles {
+			return fmt.Errorf("session_summary needs webhook_url or save_files")
+		}
+	}
+
+	for _, rule := range c.Redaction.Rules {
+		if rule.Pattern == "" {
+			return fmt.Errorf("redaction.rules entries require a pattern")
+		}
+	}
+	if _, err := c.Redaction.ToRedactor(); err != nil {
+		return fmt.Errorf("redaction: %w", err)
+	}
+
+	if c.Memory.GlobalLimitMB < 0 || c.Memory.SessionLimitMB < 0 || c.Memory.StreamLimitMB < 0 {
+		return fmt.Errorf("memory limits must not be negative")
+	}
+
+	if c.Overload.Enabled {
+		if c.Overload.CheckInterval <= 0 {
+			return fmt.Errorf("overload.check_interval must be positive")
+		}
+		if c.Overload.MaxCPUPercent < 0 || c.Overload.MaxMemoryMB < 0 || c.Overload.MaxQueuedMB < 0 {
+			return fmt.Errorf("overload thresholds must not be negative")
+		}
+		if c.Overload.MaxCPUPercent == 0 && c.Overload.MaxMemoryMB == 0 && c.Overload.MaxQueuedMB == 0 {
+			return fmt.Errorf("overload needs max_cpu_percent, max_memory_mb or max_queued_mb")
+		}
+		if c.Overload.DefaultPriority >= c.Overload.ExemptPriority {
+			return fmt.Errorf("overload.default_priority must be below exempt_priority")
+		}
+		for i, p := range c.Overload.Priorities {
+			if p.Client == "" {
+				return fmt.Errorf("overload.priorities[%d].client is required", i)
+			}
+		}
+	}
+
+	if c.Reservations.MaxHours < 0 {
+		return fmt.Errorf("reservations.max_hours must not be negative")
+	}
+
+	if c.Metrics.Enabled {
+		if err := ValidateListenAddress(c.Server.Network, c.Metrics.Address); err != nil {
+			return fmt.Errorf("metrics.address: %w", err)
+		}
+		if !strings.HasPrefix(c.Metrics.Path, "/") {
+			return fmt.Errorf("metrics.path must start with /")
+		}
+		if c.Server.HTTPEnabled && c.Metrics.Address == c.Server.HTTPAddress {
+			return fmt.Errorf("metrics.address must differ from server.http_address (the HTTP endpoints already serve /metrics)")
+		}
+	}
+
+	if c.Debug.Enabled || c.Debug.AllowToggle {
+		if err := ValidateListenAddress("tcp", c.Debug.Address); err != nil {
+			return fmt.Errorf("debug.address: %w", err)
+		}
+	}
+
+	if c.MQTT.Enabled {
+		if c.MQTT.Broker == "" {
+			return fmt.Errorf("mqtt.broker is required when mqtt is enabled")
+		}
+		if c.MQTT.QoS < 0 || c.MQTT.QoS > 2 {
+			return fmt.Errorf("mqtt.qos must be 0, 1 or 2")
+		}
+	}
+
+	return nil
+}
+
+// validate checks console logging settings
+func (c ConsoleConfig) validate() error {
+	if c.Format != "" && c.Format != "text" && c.Format != "json" {
+		return fmt.Errorf("console.format must be text or json, got %q", c.Format)
+	}
+
+	if c.MaxSize < 0 || c.MaxBackups < 0 || c.BufferSize < 0 {
+		return fmt.Errorf("console.max_size, max_backups and buffer_size must not be negative")
+	}
+
+	seen := make(map[string]bool, len(c.Ports))
+	for _, port := range c.Ports {
+		if port.Name == "" {
+			return fmt.Errorf("console.ports entries require a name")
+		}
+		if seen[port.Name] {
+			return fmt.Errorf("console port %q is listed twice", port.Name)
+		}
+		seen[port.Name] = true
+		if port.BaudRate < 0 {
+			return fmt.Errorf("console port %q: baud_rate must be positive", port.Name)
+		}
+	}
+
+	if c.Compliance.Enabled {
+		if c.Compliance.SigningKey == "" {
+			return fmt.Errorf("console.compliance needs a signing_key")
+		}
+		if c.Compliance.CheckpointInterval <= 0 {
+			return fmt.Errorf("console.compliance.checkpoint_interval must be positive")
+		}
+		if c.Dedup.Enabled {
+			return fmt.Errorf("console.dedup cannot be combined with console.compliance, which records every line")
+		}
+	}
+
+	if c.Dedup.ReportInterval < 0 {
+		return fmt.Errorf("console.dedup.report_interval must not be negative")
+	}
+
+	words := make(map[string]bool, len(c.Keywords.Words))
+	for _, word := range c.Keywords.Words {
+		if word == "" {
+			return fmt.Errorf("console.keywords.words must not be empty")
+		}
+		if words[word] {
+			return fmt.Errorf("console keyword %q is listed twice", word)
+		}
+		words[word] = true
+	}
+	if len(c.Keywords.Words) > 0 && len(c.Keywords.Windows) == 0 {
+		return fmt.Errorf("console.keywords needs windows")
+	}
+	for _, w := range c.Keywords.Windows {
+		if w <= 0 || w > 7*24*3600 {
+			return fmt.Errorf("console.keywords.windows must be between 1 second and 7 days")
+		}
+	}
+
+	if len(c.CrashDumps.Patterns) > 0 {
+		if _, err := c.CrashDumps.ToOptions(""); err != nil {
+			return fmt.Errorf("console.crash_dumps: %w", err)
+		}
+		if c.CrashDumps.CaptureAfter < 0 || c.CrashDumps.CaptureAfter > 3600 {
+			return fmt.Errorf("console.crash_dumps.capture_after must be between 0 and 3600 seconds")
+		}
+		if c.BufferSize == 0 && c.CrashDumps.CaptureAfter == 0 {
+			return fmt.Errorf("console.crash_dumps needs buffer_size or capture_after to capture any output")
+		}
+	}
+
+	return nil
+}
+
+// validateOptions checks TLS versions, cipher suites and client auth mode
+func (t TLSConfig) validateOptions() error {
+	minVersion, err := tlsutil.ParseVersion(t.MinVersion)
+	if err != nil {
+		return fmt.Errorf("tls.min_version: %w", err)
+	}
+	maxVersion, err := tlsutil.ParseVersion(t.MaxVersion)
+	if err != nil {
+		return fmt.Errorf("tls.max_version: %w", err)
+	}
+	if maxVersion != 0 && minVersion != 0 && maxVersion < minVersion {
+		return fmt.Errorf("tls.max_version must not be lower than tls.min_version")
+	}
+
+	if _, err := tlsutil.ParseCipherSuites(t.CipherSuites); err != nil {
+		return fmt.Errorf("tls.cipher_suites: %w", err)
+	}
+
+	clientAuth, err := tlsutil.ParseClientAuth(t.ClientAuth)
+	if err != nil {
+		return fmt.Errorf("tls.client_auth: %w", err)
+	}
+	if clientAuth >= tls.VerifyClientCertIfGiven && t.CAFile == "" {
+		return fmt.Errorf("tls.ca_file is required when client_auth verifies client certificates")
+	}
+
+	if t.ReloadInterval < 0 {
+		return fmt.Errorf("tls.reload_interval must not be negative")
+	}
+
+	return nil
+}
+
+// ValidateListenAddress checks a listener address for a network (tcp, tcp4
+// or tcp6), explaining the usual mistakes that net.Listen would only report
+// tersely: unbracketed IPv6 literals, missing ports, unknown interfaces and
+// addresses of the wrong IP version.
+func ValidateListenAddress(network, address string) error {
+	switch network {
+	case "tcp", "tcp4", "tcp6":
+	default:
+		return fmt.Errorf("network must be tcp (dual-stack), tcp4 or tcp6, not %q", network)
+	}
+	if address == "" {
+		return fmt.Errorf("address is empty")
+	}
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		bare := strings.Trim(address, "[]")
+		if ip := net.ParseIP(strings.SplitN(bare, "%", 2)[0]); ip != nil {
+			if ip.To4() != nil {
+				return fmt.Errorf("%q has no port, e.g. %s:50051", address, bare)
+			}
+			return fmt.Errorf("%q has no port, e.g. [%s]:50051", address, bare)
+		}
+		if strings.Count(address, ":") > 1 && !strings.HasPrefix(address, "[") {
+			return fmt.Errorf("IPv6 address in %q must be in brackets, e.g. [::1]:50051", address)
+		}
+		if !strings.Contains(address, ":") {
+			return fmt.Errorf("%q has no port, e.g. %s:50051", address, address)
+		}
+		return fmt.Errorf("%q is not host:port: %v", address, err)
+	}
+
+	if port == "" {
+		return fmt.Errorf("%q has no port", address)
+	}
+	if _, err := net.LookupPort("tcp", port); err != nil {
+		return fmt.Errorf("invalid port %q: must be 0-65535 or a service name", port)
+	}
+
+	// An empty host listens on every address, dual-stack with tcp
+	if host == "" {
+		return nil
+	}
+
+	literal, zone, scoped := strings.Cut(host, "%")
+	ip := net.ParseIP(literal)
+	if ip == nil {
+		if scoped {
+			return fmt.Errorf("interface zone %%%s needs an IPv6 address, not %q", zone, literal)
+		}
+		if strings.ContainsAny(host, " /[]") {
+			return fmt.Errorf("invalid host %q", host)
+		}
+		// Host names are resolved when listening
+		return nil
+	}
+
+	ipv4 := ip.To4() != nil
+	switch {
+	case ipv4 && scoped:
+		return fmt.Errorf("interface zone %%%s only applies to IPv6 addresses", zone)
+	case scoped:
+		if err := checkInterface(zone); err != nil {
+			return err
+		}
+	case !ipv4 && (ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast()):
+		return fmt.Errorf("link-local address %s needs an interface zone, e.g. [%s%%eth0]:%s", literal, literal, port)
+	}
+
+	if network == "tcp4" && !ipv4 {
+		return fmt.Errorf("%s is an IPv6 address but network is tcp4", literal)
+	}
+	if network == "tcp6" && ipv4 {
+		return fmt.Errorf("%s is an IPv4 address but network is tcp6; use [::]:%s for all IPv6 addresses", literal, port)
+	}
+	return nil
+}
+
+// checkInterface checks that an IPv6 zone names an interface by name or index
+func checkInterface(zone string) error {
+	if index, err := strconv.Atoi(zone); err == nil {
+		if _, err := net.InterfaceByIndex(index); err != nil {
+			return fmt.Errorf("no network interface with index %d", index)
+		}
+		return nil
+	}
+	if _, err := net.InterfaceByName(zone); err != nil {
+		return fmt.Errorf("no network interface named %q", zone)
+	}
 	return nil
 }
 
@@ -0,0 +1,54 @@
+// Package acl decides which clients may use which ports. A client is known
+// by its identities: the authenticated one (SPIFFE ID, certificate common
+// name, or the identity of an auth backend such as "token:NAME"), its
+// groups as "group:NAME" and the client ID it sends as "client:ID", which
+// only rules naming it that way match. Ports no rule grants to any of them
+// are refused.
+package acl
+
+import (
+	"regexp"
+	"slices"
+)
+
+// Everyone in a rule's clients matches any client
+const Everyone = "*"
+
+// Rule grants clients the ports matching any of its patterns
+type Rule struct {
+	// Clients are identities, groups or "client:ID", or Everyone
+	Clients []string
+	Ports   []*regexp.Regexp
+}
+
+// matches reports whether the rule names one of the identities
+func (r Rule) matches(identities []string) bool {
+	return slices.ContainsFunc(r.Clients, func(client string) bool {
+		return client == Everyone || slices.Contains(identities, client)
+	})
+}
+
+// grants reports whether the rule's patterns match a port
+func (r Rule) grants(portName string) bool {
+	return slices.ContainsFunc(r.Ports, func(re *regexp.Regexp) bool { return re.MatchString(portName) })
+}
+
+// Policy is a set of rules; a port is allowed when any rule grants it
+type Policy struct {
+	rules []Rule
+}
+
+// New creates a policy of rules
+func New(rules []Rule) *Policy {
+	return &Policy{rules: rules}
+}
+
+// Allowed reports whether a client known by identities may use a port
+func (p *Policy) Allowed(portName string, identities ...string) bool {
+	for _, rule := range p.rules {
+		if rule.matches(identities) && rule.grants(portName) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package acl
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestAllowed(t *testing.T) {
+	policy := New([]Rule{
+		{Clients: []string{"spiffe://lab/ci"}, Ports: []*regexp.Regexp{regexp.MustCompile(`^/dev/ttyUSB\d+$`)}},
+		{Clients: []string{"group:ops"}, Ports: []*regexp.Regexp{regexp.MustCompile(`^/dev/ttyS0$`)}},
+		{Clients: []string{"client:dashboard"}, Ports: []*regexp.Regexp{regexp.MustCompile(`^/dev/ttyACM0$`)}},
+		{Clients: []string{Everyone}, Ports: []*regexp.Regexp{regexp.MustCompile(`^tcp://`)}},
+	})
+
+	for _, tc := range []struct {
+		name       string
+		port       string
+		identities []string
+		allowed    bool
+	}{
+		{"identity", "/dev/ttyUSB3", []string{"spiffe://lab/ci"}, true},
+		{"identity outside its ports", "/dev/ttyS0", []string{"spiffe://lab/ci"}, false},
+		{"pattern anchored", "/dev/ttyUSB3x", []string{"spiffe://lab/ci"}, false},
+		{"other identity", "/dev/ttyUSB3", []string{"token:mallory"}, false},
+		{"group", "/dev/ttyS0", []string{"token:alice", "group:ops"}, true},
+		{"group name as identity", "/dev/ttyS0", []string{"ops"}, false},
+		{"client ID named by a rule", "/dev/ttyACM0", []string{"10.0.0.7", "client:dashboard"}, true},
+		{"client ID posing as an identity", "/dev/ttyUSB3", []string{"10.0.0.7", "client:spiffe://lab/ci"}, false},
+		{"bare client ID", "/dev/ttyACM0", []string{"dashboard"}, false},
+		{"everyone", "tcp://10.0.0.9:4001", []string{"token:mallory"}, true},
+		{"no rule grants the port", "/dev/ttyAMA0", []string{"spiffe://lab/ci", "group:ops"}, false},
+		{"no identities", "/dev/ttyUSB3", nil, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := policy.Allowed(tc.port, tc.identities...); got != tc.allowed {
+				t.Fatalf("Allowed(%q, %q) = %v, want %v", tc.port, tc.identities, got, tc.allowed)
+			}
+		})
+	}
+}
+
+func TestEmptyPolicyRefusesEveryone(t *testing.T) {
+	if New(nil).Allowed("/dev/ttyUSB0", "spiffe://lab/ci", "group:ops") {
+		t.Fatal("a policy without rules allowed a port")
+	}
+}
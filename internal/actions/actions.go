@@ -0,0 +1,387 @@
+// Package actions runs configured actions when matching ports appear, such
+// as opening the port, logging its console, running an init script or
+// announcing it over MQTT.
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/console"
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/charmbracelet/log"
+)
+
+// ClientID identifies sessions opened by actions
+const ClientID = "actions"
+
+// defaultScriptTimeout bounds a script without its own timeout
+const defaultScriptTimeout = 30 * time.Second
+
+// Port events, used in announcements and as SERIALLINK_EVENT for scripts
+const (
+	EventAdded    = "added"
+	EventRemoved  = "removed"
+	EventShutdown = "shutdown"
+)
+
+// Match selects ports; every non-empty criterion must match
+type Match struct {
+	// Port matches the port name
+	Port *regexp.Regexp
+	VID  string
+	PID  string
+	// SerialNumber is the USB serial number
+	SerialNumber string
+	// Family is the device family from the device database
+	Family string
+}
+
+// Matches reports whether a port meets every criterion
+func (m Match) Matches(info serial.PortInfo) bool {
+	if m.Port != nil && !m.Port.MatchString(info.Name) {
+		return false
+	}
+	for _, c := range []struct{ want, got string }{
+		{m.VID, info.VID},
+		{m.PID, info.PID},
+		{m.SerialNumber, info.SerialNumber},
+		{m.Family, info.DeviceFamily},
+	} {
+		if c.want != "" && !strings.EqualFold(c.want, c.got) {
+			return false
+		}
+	}
+	return true
+}
+
+// Action is what to do when a matching port appears. Steps run in order:
+// script, open, console log, announce.
+type Action struct {
+	Name  string
+	Match Match
+
+	// Open keeps the port open as the "actions" client
+	Open bool
+	// Settings override the line settings of the port's device profile
+	// for Open and ConsoleLog
+	Settings serial.DeviceProfile
+	// ConsoleLog records the port's output like a configured console port
+	ConsoleLog bool
+	// Script runs with the port name as argument and the port details in
+	// SERIALLINK_* environment variables
+	Script        string
+	ScriptTimeout time.Duration
+	// Announce publishes the port's arrival and removal
+	Announce bool
+
+	// Shutdown runs before the port is closed on removal or agent shutdown
+	Shutdown Shutdown
+}
+
+// Publisher sends messages to a broker, e.g. an MQTT publisher
+type Publisher interface {
+	Publish(topic string, payload []byte) error
+}
+
+// ConsoleOptions builds console logger options for a port
+type ConsoleOptions func(portName string, config serial.PortConfig) console.Options
+
+// active is what actions did for a port still present
+type active struct {
+	info      serial.PortInfo
+	matched   []Action
+	sessionID string
+	console   bool
+	announced []string
+}
+
+// Runner applies actions to ports as they appear and undoes them when the
+// ports go away
+type Runner struct {
+	manager  *serial.Manager
+	scanner  *serial.Scanner
+	actions  []Action
+	defaults serial.PortConfig
+	logger   *log.Logger
+
+	collector      *console.Collector
+	consoleOptions ConsoleOptions
+	publisher      Publisher
+	topicPrefix    string
+
+	mu     sync.Mutex
+	ports  map[string]*active
+	queues map[string]chan func()
+	wg     sync.WaitGroup
+}
+
+// NewRunner creates a runner. defaults are the line settings used for ports
+// without a device profile.
+func NewRunner(manager *serial.Manager, scanner *serial.Scanner, actions []Action, defaults serial.PortConfig, logger *log.Logger) *Runner {
+	return &Runner{
+		manager:  manager,
+		scanner:  scanner,
+		actions:  actions,
+		defaults: defaults,
+		logger:   logger,
+		ports:    make(map[string]*active),
+		queues:   make(map[string]chan func()),
+	}
+}
+
+// SetConsole enables console logging actions. Call before Run.
+func (r *Runner) SetConsole(collector *console.Collector, opts ConsoleOptions) {
+	r.collector = collector
+	r.consoleOptions = opts
+}
+
+// SetPublisher enables announcements to "<prefix>/ports/<port>". Call
+// before Run.
+func (r *Runner) SetPublisher(publisher Publisher, prefix string) {
+	r.publisher = publisher
+	r.topicPrefix = strings.TrimSuffix(prefix, "/")
+}
+
+// Run watches for ports every intervalSeconds until ctx is cancelled. Ports
+// present at startup are handled on the first scan. On return the actions
+// of every port are undone.
+func (r *Runner) Run(ctx context.Context, intervalSeconds int) {
+	stop := r.scanner.WatchPorts(intervalSeconds, func(added, removed, _ []serial.PortInfo) {
+		for _, info := range removed {
+			r.enqueue(ctx, info.Name, func() { r.undo(info.Name, EventRemoved) })
+		}
+		for _, info := range added {
+			r.enqueue(ctx, info.Name, func() { r.apply(ctx, info) })
+		}
+	})
+
+	<-ctx.Done()
+	r.scanner.StopWatch(stop)
+	r.wg.Wait()
+
+	r.mu.Lock()
+	names := make([]string, 0, len(r.ports))
+	for name := range r.ports {
+		names = append(names, name)
+	}
+	r.mu.Unlock()
+	for _, name := range names {
+		r.undo(name, EventShutdown)
+	}
+}
+
+// enqueue runs fn after earlier work for the same port, so a slow script
+// neither blocks other ports nor races with the port's removal
+func (r *Runner) enqueue(ctx context.Context, portName string, fn func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	queue, ok := r.queues[portName]
+	if !ok {
+		queue = make(chan func(), 16)
+		r.queues[portName] = queue
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case work := <-queue:
+					work()
+				}
+			}
+		}()
+	}
+
+	select {
+	case queue <- fn:
+	default:
+		r.logger.Warn("port action queue full, dropping change", "port", portName)
+	}
+}
+
+// apply runs every matching action for a new port
+func (r *Runner) apply(ctx context.Context, info serial.PortInfo) {
+	state := &active{info: info}
+	defer func() {
+		r.mu.Lock()
+		r.ports[info.Name] = state
+		r.mu.Unlock()
+	}()
+
+	for _, action := range r.actions {
+		if !action.Match.Matches(info) {
+			continue
+		}
+		r.logger.Info("running port action", "action", action.Name, "port", info.Name)
+		state.matched = append(state.matched, action)
+
+		if action.Script != "" {
+			if err := r.runScript(ctx, action.Name, action.Script, action.ScriptTimeout, info, EventAdded); err != nil {
+				// Later steps likely depend on the device being initialized
+				r.logger.Warn("port action script failed", "action", action.Name, "port", info.Name, "error", err)
+				continue
+			}
+		}
+
+		config, err := r.portConfig(action, info)
+		if err != nil {
+			r.logger.Warn("invalid port action settings", "action", action.Name, "port", info.Name, "error", err)
+			continue
+		}
+
+		if action.Open && state.sessionID == "" {
+			session, err := r.manager.OpenPort(info.Name, config, ClientID, false)
+			if err != nil {
+				r.logger.Warn("port action failed to open port", "action", action.Name, "port", info.Name, "error", err)
+			} else {
+				state.sessionID = session.ID
+			}
+		}
+
+		if action.ConsoleLog && !state.console {
+			if r.collector == nil {
+				r.logger.Warn("console logging unavailable for port action", "action", action.Name, "port", info.Name)
+			} else if r.collector.Add(ctx, r.consoleOptions(info.Name, config)) {
+				state.console = true
+			}
+		}
+
+		if action.Announce {
+			if err := r.announce(EventAdded, action.Name, info); err != nil {
+				r.logger.Warn("port action failed to announce port", "action", action.Name, "port", info.Name, "error", err)
+			} else {
+				state.announced = append(state.announced, action.Name)
+			}
+		}
+	}
+}
+
+// undo runs the shutdown hooks of a port and reverses its actions, when the
+// port was removed or the agent shuts down
+func (r *Runner) undo(portName, event string) {
+	r.mu.Lock()
+	state, ok := r.ports[portName]
+	delete(r.ports, portName)
+	r.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	for _, action := range state.matched {
+		if action.Shutdown.IsSet() {
+			r.shutdown(action, state, event)
+		}
+	}
+
+	if state.console {
+		r.collector.Remove(portName)
+	}
+	if state.sessionID != "" {
+		reason := serial.CloseReasonDeviceRemoved
+		if event == EventShutdown {
+			reason = serial.CloseReasonShutdown
+		}
+		if err := r.manager.ClosePortWithReason(portName, state.sessionID, reason); err != nil {
+			r.logger.Debug("port action failed to close port", "port", portName, "error", err)
+		}
+	}
+	for _, name := range state.announced {
+		if err := r.announce(EventRemoved, name, state.info); err != nil {
+			r.logger.Warn("port action failed to announce port removal", "action", name, "port", portName, "error", err)
+		}
+	}
+}
+
+// portConfig resolves the line settings for a port: the defaults, then the
+// device's profile, then the action's settings
+func (r *Runner) portConfig(action Action, info serial.PortInfo) (serial.PortConfig, error) {
+	config := r.defaults
+	if profile := r.scanner.DeviceProfile(info.Name); profile != nil {
+		if c, err := profile.Apply(config); err == nil {
+			config = c
+		}
+	}
+	return action.Settings.Apply(config)
+}
+
+// runScript runs a script of an action for a port event
+func (r *Runner) runScript(ctx context.Context, actionName, script string, timeout time.Duration, info serial.PortInfo, event string) error {
+	if timeout <= 0 {
+		timeout = defaultScriptTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, script, info.Name)
+	cmd.Env = append(os.Environ(),
+		"SERIALLINK_ACTION="+actionName,
+		"SERIALLINK_EVENT="+event,
+		"SERIALLINK_PORT="+info.Name,
+		"SERIALLINK_VID="+info.VID,
+		"SERIALLINK_PID="+info.PID,
+		"SERIALLINK_SERIAL_NUMBER="+info.SerialNumber,
+		"SERIALLINK_FAMILY="+info.DeviceFamily,
+	)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		if out := strings.TrimSpace(string(output)); out != "" {
+			return fmt.Errorf("%w: %s", err, out)
+		}
+		return err
+	}
+	r.logger.Debug("port action script finished", "action", actionName, "port", info.Name, "event", event, "output", strings.TrimSpace(string(output)))
+	return nil
+}
+
+// announcement is the JSON form of an announcement
+type announcement struct {
+	Event        string `json:"event"`
+	Action       string `json:"action"`
+	Timestamp    string `json:"timestamp"`
+	Port         string `json:"port"`
+	Description  string `json:"description,omitempty"`
+	Manufacturer string `json:"manufacturer,omitempty"`
+	Product      string `json:"product,omitempty"`
+	SerialNumber string `json:"serial_number,omitempty"`
+	VID          string `json:"vid,omitempty"`
+	PID          string `json:"pid,omitempty"`
+	Family       string `json:"family,omitempty"`
+	Host         string `json:"host,omitempty"`
+}
+
+// announce publishes a port event to "<prefix>/ports/<port>"
+func (r *Runner) announce(event, actionName string, info serial.PortInfo) error {
+	if r.publisher == nil {
+		return fmt.Errorf("mqtt is not enabled")
+	}
+
+	host, _ := os.Hostname()
+	payload, err := json.Marshal(announcement{
+		Event:        event,
+		Action:       actionName,
+		Timestamp:    time.Now().Format(time.RFC3339Nano),
+		Port:         info.Name,
+		Description:  info.Description,
+		Manufacturer: info.Manufacturer,
+		Product:      info.Product,
+		SerialNumber: info.SerialNumber,
+		VID:          info.VID,
+		PID:          info.PID,
+		Family:       info.DeviceFamily,
+		Host:         host,
+	})
+	if err != nil {
+		return err
+	}
+	return r.publisher.Publish(r.topicPrefix+"/ports/"+strings.TrimLeft(info.Name, `/\`), payload)
+}
@@ -0,0 +1,113 @@
+package actions
+
+import (
+	"context"
+	"time"
+)
+
+// Shutdown hooks put a device into a safe state before its port is closed.
+// Steps run in order: write, modem lines, stop console, script.
+type Shutdown struct {
+	// Write is sent to the port, e.g. a safe-state command
+	Write []byte
+	// DTR and RTS set the modem lines; nil leaves a line alone
+	DTR *bool
+	RTS *bool
+	// Delay gives the device time to act before the port is closed
+	Delay time.Duration
+	// StopConsole stops console logging of the port, including ports
+	// listed in the console configuration
+	StopConsole bool
+	// Script runs with SERIALLINK_EVENT set to "removed" or "shutdown"
+	Script        string
+	ScriptTimeout time.Duration
+}
+
+// IsSet reports whether any hook is configured
+func (s Shutdown) IsSet() bool {
+	return len(s.Write) > 0 || s.DTR != nil || s.RTS != nil || s.StopConsole || s.Script != ""
+}
+
+// touchesPort reports whether the hooks need an open port
+func (s Shutdown) touchesPort() bool {
+	return len(s.Write) > 0 || s.DTR != nil || s.RTS != nil
+}
+
+// shutdown runs the shutdown hooks of an action for a port
+func (r *Runner) shutdown(action Action, state *active, event string) {
+	hooks := action.Shutdown
+	portName := state.info.Name
+	r.logger.Info("running port shutdown hooks", "action", action.Name, "port", portName, "event", event)
+
+	if hooks.touchesPort() {
+		if err := r.safeState(action, state, event); err != nil {
+			if event == EventRemoved {
+				// The device is usually gone already
+				r.logger.Debug("port shutdown hook failed", "action", action.Name, "port", portName, "error", err)
+			} else {
+				r.logger.Warn("port shutdown hook failed", "action", action.Name, "port", portName, "error", err)
+			}
+		}
+	}
+
+	if hooks.StopConsole && r.collector != nil && r.collector.Remove(portName) {
+		state.console = false
+	}
+
+	if hooks.Script != "" {
+		// Runs after the runner's context is cancelled on agent shutdown
+		if err := r.runScript(context.Background(), action.Name, hooks.Script, hooks.ScriptTimeout, state.info, event); err != nil {
+			r.logger.Warn("port shutdown script failed", "action", action.Name, "port", portName, "error", err)
+		}
+	}
+}
+
+// safeState writes the shutdown command and sets the modem lines. It uses
+// the port's open session, whichever client owns it, or else opens the port
+// briefly; a removed device is not reopened.
+func (r *Runner) safeState(action Action, state *active, event string) error {
+	hooks := action.Shutdown
+	portName := state.info.Name
+
+	sessionID := state.sessionID
+	if sessionID == "" {
+		if session := r.manager.GetSession(portName); session != nil {
+			sessionID = session.ID
+		}
+	}
+	if sessionID == "" {
+		if event == EventRemoved {
+			return nil
+		}
+		config, err := r.portConfig(action, state.info)
+		if err != nil {
+			return err
+		}
+		session, err := r.manager.OpenPort(portName, config, ClientID, false)
+		if err != nil {
+			return err
+		}
+		sessionID = session.ID
+		defer func() {
+			_ = r.manager.ClosePort(portName, session.ID)
+		}()
+	}
+
+	if len(hooks.Write) > 0 {
+		if _, err := r.manager.Write(portName, sessionID, hooks.Write); err != nil {
+			return err
+		}
+	}
+	if hooks.DTR != nil {
+		if err := r.manager.SetDTR(portName, sessionID, *hooks.DTR); err != nil {
+			return err
+		}
+	}
+	if hooks.RTS != nil {
+		if err := r.manager.SetRTS(portName, sessionID, *hooks.RTS); err != nil {
+			return err
+		}
+	}
+	time.Sleep(hooks.Delay)
+	return nil
+}
@@ -0,0 +1,346 @@
+// Package alarm raises threshold alarms on polled values and tracks their
+// acknowledgment.
+package alarm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/poller"
+	"github.com/charmbracelet/log"
+)
+
+// Conditions compare a value with a rule's threshold
+const (
+	ConditionAbove        = ">"
+	ConditionAboveOrEqual = ">="
+	ConditionBelow        = "<"
+	ConditionBelowOrEqual = "<="
+	ConditionEqual        = "=="
+	ConditionNotEqual     = "!="
+)
+
+// Alarm states
+const (
+	StateActive  = "active"
+	StateCleared = "cleared"
+)
+
+// Event types
+const (
+	EventRaised       = "raised"
+	EventCleared      = "cleared"
+	EventAcknowledged = "acknowledged"
+)
+
+// historySize is the number of finished alarms kept for listing
+const historySize = 100
+
+var (
+	// ErrNotFound is returned when acknowledging an unknown alarm
+	ErrNotFound = errors.New("alarm not found")
+
+	// ErrAlreadyAcknowledged is returned when acknowledging an alarm twice
+	ErrAlreadyAcknowledged = errors.New("alarm already acknowledged")
+)
+
+// Rule raises an alarm while a polled value meets a condition for a while
+type Rule struct {
+	Name      string
+	Poller    string
+	Field     string
+	Condition string
+	Threshold float64
+	// For is how long the condition must hold before the alarm is raised
+	For      time.Duration
+	Severity string
+	Message  string
+}
+
+// ValidCondition reports whether c is a supported condition
+func ValidCondition(c string) bool {
+	switch c {
+	case ConditionAbove, ConditionAboveOrEqual, ConditionBelow, ConditionBelowOrEqual, ConditionEqual, ConditionNotEqual:
+		return true
+	}
+	return false
+}
+
+// matches reports whether value meets the rule's condition
+func (r Rule) matches(value float64) bool {
+	switch r.Condition {
+	case ConditionAbove:
+		return value > r.Threshold
+	case ConditionAboveOrEqual:
+		return value >= r.Threshold
+	case ConditionBelow:
+		return value < r.Threshold
+	case ConditionBelowOrEqual:
+		return value <= r.Threshold
+	case ConditionEqual:
+		return value == r.Threshold
+	case ConditionNotEqual:
+		return value != r.Threshold
+	}
+	return false
+}
+
+// Alarm is one occurrence of a rule's condition. It stays listed until it
+// has both cleared and been acknowledged.
+type Alarm struct {
+	ID       string
+	Rule     Rule
+	PortName string
+	State    string
+	// Value is the value that raised the alarm, or cleared it once cleared
+	Value     float64
+	RaisedAt  time.Time
+	ClearedAt time.Time
+
+	Acknowledged   bool
+	AcknowledgedAt time.Time
+	AcknowledgedBy string
+}
+
+// Description returns the rule's message, or a generated one
+func (a Alarm) Description() string {
+	if a.Rule.Message != "" {
+		return a.Rule.Message
+	}
+	return fmt.Sprintf("%s.%s %s %g", a.Rule.Poller, a.Rule.Field, a.Rule.Condition, a.Rule.Threshold)
+}
+
+// Event is a change to an alarm
+type Event struct {
+	Type      string
+	Alarm     Alarm
+	Timestamp time.Time
+}
+
+// Notifier delivers alarm events, e.g. to a webhook
+type Notifier interface {
+	Notify(ctx context.Context, event Event) error
+}
+
+// ruleState tracks one rule between samples
+type ruleState struct {
+	rule         Rule
+	pendingSince time.Time
+	current      *Alarm
+}
+
+// Monitor evaluates rules against polled values
+type Monitor struct {
+	logger    *log.Logger
+	notifiers []Notifier
+	events    chan Event
+
+	mu       sync.Mutex
+	rules    []*ruleState
+	open     []*Alarm
+	finished []Alarm
+	sequence uint64
+}
+
+// NewMonitor creates a monitor for the given rules
+func NewMonitor(rules []Rule, logger *log.Logger) *Monitor {
+	m := &Monitor{
+		logger: logger,
+		events: make(chan Event, 64),
+	}
+	for _, rule := range rules {
+		if rule.Severity == "" {
+			rule.Severity = "warning"
+		}
+		m.rules = append(m.rules, &ruleState{rule: rule})
+	}
+	return m
+}
+
+// AddNotifier delivers alarm events to n. Call before Follow.
+func (m *Monitor) AddNotifier(n Notifier) {
+	m.notifiers = append(m.notifiers, n)
+}
+
+// Follow evaluates every sample of the engine until ctx is cancelled
+func (m *Monitor) Follow(ctx context.Context, engine *poller.Engine) {
+	samples := engine.Subscribe()
+	defer engine.Unsubscribe(samples)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		m.dispatch(ctx)
+	}()
+	defer wg.Wait()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case sample := <-samples:
+			m.evaluate(sample)
+		}
+	}
+}
+
+// evaluate applies the rules of the sample's poller
+func (m *Monitor) evaluate(sample poller.Sample) {
+	if sample.Err != nil {
+		// Alarms keep their state while a device is not answering
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, state := range m.rules {
+		if state.rule.Poller != sample.Poller {
+			continue
+		}
+		for _, v := range sample.Values {
+			if v.Name == state.rule.Field {
+				m.apply(state, sample, v.Value)
+				break
+			}
+		}
+	}
+}
+
+// apply advances a rule with a new value (lock held)
+func (m *Monitor) apply(state *ruleState, sample poller.Sample, value float64) {
+	if !state.rule.matches(value) {
+		state.pendingSince = time.Time{}
+		if state.current != nil {
+			alarm := state.current
+			state.current = nil
+			alarm.State = StateCleared
+			alarm.Value = value
+			alarm.ClearedAt = sample.Timestamp
+			if alarm.Acknowledged {
+				m.finish(alarm)
+			}
+			m.emit(EventCleared, *alarm)
+		}
+		return
+	}
+
+	if state.current != nil {
+		return
+	}
+	if state.pendingSince.IsZero() {
+		state.pendingSince = sample.Timestamp
+	}
+	if sample.Timestamp.Sub(state.pendingSince) < state.rule.For {
+		return
+	}
+
+	m.sequence++
+	alarm := &Alarm{
+		ID:       fmt.Sprintf("%s-%d", state.rule.Name, m.sequence),
+		Rule:     state.rule,
+		PortName: sample.PortName,
+		State:    StateActive,
+		Value:    value,
+		RaisedAt: sample.Timestamp,
+	}
+	state.current = alarm
+	m.open = append(m.open, alarm)
+	m.emit(EventRaised, *alarm)
+}
+
+// Acknowledge marks an alarm as seen by an operator
+func (m *Monitor) Acknowledge(id, by string) (Alarm, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, alarm := range m.open {
+		if alarm.ID != id {
+			continue
+		}
+		if alarm.Acknowledged {
+			return *alarm, ErrAlreadyAcknowledged
+		}
+		alarm.Acknowledged = true
+		alarm.AcknowledgedAt = time.Now()
+		alarm.AcknowledgedBy = by
+		if alarm.State == StateCleared {
+			m.finish(alarm)
+		}
+		m.emit(EventAcknowledged, *alarm)
+		return *alarm, nil
+	}
+
+	for _, alarm := range m.finished {
+		if alarm.ID == id {
+			return alarm, ErrAlreadyAcknowledged
+		}
+	}
+	return Alarm{}, ErrNotFound
+}
+
+// List returns the alarms that are active or not yet acknowledged, newest
+// first, followed by finished alarms when includeFinished is set
+func (m *Monitor) List(includeFinished bool) []Alarm {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	alarms := make([]Alarm, 0, len(m.open))
+	for _, alarm := range m.open {
+		alarms = append(alarms, *alarm)
+	}
+	sort.Slice(alarms, func(i, j int) bool { return alarms[i].RaisedAt.After(alarms[j].RaisedAt) })
+
+	if includeFinished {
+		for i := len(m.finished) - 1; i >= 0; i-- {
+			alarms = append(alarms, m.finished[i])
+		}
+	}
+	return alarms
+}
+
+// finish moves a cleared and acknowledged alarm to the history (lock held)
+func (m *Monitor) finish(alarm *Alarm) {
+	for i, a := range m.open {
+		if a == alarm {
+			m.open = append(m.open[:i], m.open[i+1:]...)
+			break
+		}
+	}
+	m.finished = append(m.finished, *alarm)
+	if len(m.finished) > historySize {
+		m.finished = m.finished[len(m.finished)-historySize:]
+	}
+}
+
+// emit queues an event for the notifiers (lock held)
+func (m *Monitor) emit(eventType string, alarm Alarm) {
+	m.logger.Info("alarm "+eventType, "alarm", alarm.ID, "severity", alarm.Rule.Severity, "value", alarm.Value, "message", alarm.Description())
+
+	select {
+	case m.events <- Event{Type: eventType, Alarm: alarm, Timestamp: time.Now()}:
+	default:
+		m.logger.Warn("alarm notification queue full, dropping event", "alarm", alarm.ID, "event", eventType)
+	}
+}
+
+// dispatch delivers queued events to every notifier
+func (m *Monitor) dispatch(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-m.events:
+			for _, n := range m.notifiers {
+				if err := n.Notify(ctx, event); err != nil {
+					m.logger.Warn("failed to deliver alarm notification", "alarm", event.Alarm.ID, "error", err)
+				}
+			}
+		}
+	}
+}
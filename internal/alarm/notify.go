@@ -0,0 +1,162 @@
+package alarm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+)
+
+// webhookTimeout bounds a webhook delivery
+const webhookTimeout = 10 * time.Second
+
+// eventMessage is the JSON form of an event sent to webhooks and MQTT
+type eventMessage struct {
+	Event     string       `json:"event"`
+	Timestamp string       `json:"timestamp"`
+	Alarm     alarmMessage `json:"alarm"`
+}
+
+type alarmMessage struct {
+	ID             string  `json:"id"`
+	Rule           string  `json:"rule"`
+	Poller         string  `json:"poller"`
+	Field          string  `json:"field"`
+	Port           string  `json:"port"`
+	Severity       string  `json:"severity"`
+	Message        string  `json:"message"`
+	Condition      string  `json:"condition"`
+	Threshold      float64 `json:"threshold"`
+	Value          float64 `json:"value"`
+	State          string  `json:"state"`
+	RaisedAt       string  `json:"raised_at"`
+	ClearedAt      string  `json:"cleared_at,omitempty"`
+	Acknowledged   bool    `json:"acknowledged"`
+	AcknowledgedAt string  `json:"acknowledged_at,omitempty"`
+	AcknowledgedBy string  `json:"acknowledged_by,omitempty"`
+}
+
+// Marshal returns the JSON form of an event
+func (e Event) Marshal() ([]byte, error) {
+	a := e.Alarm
+	msg := eventMessage{
+		Event:     e.Type,
+		Timestamp: e.Timestamp.Format(time.RFC3339Nano),
+		Alarm: alarmMessage{
+			ID:             a.ID,
+			Rule:           a.Rule.Name,
+			Poller:         a.Rule.Poller,
+			Field:          a.Rule.Field,
+			Port:           a.PortName,
+			Severity:       a.Rule.Severity,
+			Message:        a.Description(),
+			Condition:      a.Rule.Condition,
+			Threshold:      a.Rule.Threshold,
+			Value:          a.Value,
+			State:          a.State,
+			RaisedAt:       a.RaisedAt.Format(time.RFC3339Nano),
+			ClearedAt:      formatTime(a.ClearedAt),
+			Acknowledged:   a.Acknowledged,
+			AcknowledgedAt: formatTime(a.AcknowledgedAt),
+			AcknowledgedBy: a.AcknowledgedBy,
+		},
+	}
+
+	// Conditions such as ">" are kept readable rather than HTML-escaped
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(msg); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
+
+// WebhookNotifier POSTs every event as JSON to a URL
+type WebhookNotifier struct {
+	URL    string
+	Client *http.Client
+}
+
+// Notify implements Notifier
+func (w WebhookNotifier) Notify(ctx context.Context, event Event) error {
+	body, err := event.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// Publisher sends messages to a broker, e.g. an MQTT publisher
+type Publisher interface {
+	Publish(topic string, payload []byte) error
+}
+
+// MQTTNotifier publishes every event to "<prefix>/alarms/<rule>"
+type MQTTNotifier struct {
+	Publisher Publisher
+	Prefix    string
+}
+
+// Notify implements Notifier
+func (n MQTTNotifier) Notify(_ context.Context, event Event) error {
+	payload, err := event.Marshal()
+	if err != nil {
+		return err
+	}
+	return n.Publisher.Publish(strings.TrimSuffix(n.Prefix, "/")+"/alarms/"+event.Alarm.Rule.Name, payload)
+}
+
+// PortEventNotifier sends every event as an "alarm" port event, so port
+// monitors (e.g. the HTTP event stream) see alarms of their port
+type PortEventNotifier struct {
+	Manager *serial.Manager
+}
+
+// Notify implements Notifier
+func (n PortEventNotifier) Notify(_ context.Context, event Event) error {
+	if event.Alarm.PortName == "" {
+		return nil
+	}
+	payload, err := event.Marshal()
+	if err != nil {
+		return err
+	}
+	n.Manager.EmitPortEvent(event.Alarm.PortName, serial.PortEventAlarm, string(payload))
+	return nil
+}
@@ -0,0 +1,181 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// accessTokenPrefix marks access tokens, so they are told apart from API
+// tokens without checking their signature
+const accessTokenPrefix = "slka_"
+
+const (
+	// DefaultAccessTokenTTL is the lifetime of access tokens minted
+	// without one
+	DefaultAccessTokenTTL = 30 * time.Minute
+
+	// DefaultAccessTokenMaxTTL bounds the lifetime of access tokens
+	DefaultAccessTokenMaxTTL = time.Hour
+
+	// accessKeySize is the size of the signing key in bytes
+	accessKeySize = 32
+)
+
+// AccessClaims are what an access token grants
+type AccessClaims struct {
+	// Name identifies the holder, who acts as "access:NAME"
+	Name string `json:"name"`
+	// Issuer is the identity that minted the token
+	Issuer    string `json:"iss"`
+	Port      string `json:"port"`
+	ReadOnly  bool   `json:"ro,omitempty"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+	// Stream limits the token to following the port's data (stream links)
+	Stream bool `json:"st,omitempty"`
+	// ID is set on single-use tokens, which are accepted once
+	ID string `json:"jti,omitempty"`
+}
+
+// AccessTokens mints and verifies access tokens: short-lived tokens scoped
+// to one port that can be embedded in browser dashboards. They are signed
+// with HMAC-SHA256 rather than stored, so any number can be handed out;
+// they cannot be revoked and stop working when they expire or the key
+// changes. Only single-use tokens are remembered, until they expire, and
+// only by the agent that accepted them. It is safe for concurrent use.
+type AccessTokens struct {
+	key []byte
+
+	mu   sync.Mutex
+	used map[string]time.Time
+}
+
+// NewAccessTokens creates access tokens signed with key
+func NewAccessTokens(key []byte) (*AccessTokens, error) {
+	if len(key) < accessKeySize {
+		return nil, fmt.Errorf("access token key must be at least %d bytes", accessKeySize)
+	}
+	return &AccessTokens{key: key, used: make(map[string]time.Time)}, nil
+}
+
+// NewTokenID returns a random ID for a single-use token
+func NewTokenID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b[:]), nil
+}
+
+// LoadAccessKey reads the hex signing key of a key file, creating the file
+// with a random key when it does not exist
+func LoadAccessKey(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		key, err := hex.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid access token key file %s: %w", path, err)
+		}
+		return key, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	key := make([]byte, accessKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, []byte(hex.EncodeToString(key)+"\n"), 0o600); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Issue mints a token granting the claims
+func (a *AccessTokens) Issue(claims AccessClaims) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	body := accessTokenPrefix + base64.RawURLEncoding.EncodeToString(payload)
+	return body + "." + a.sign(body), nil
+}
+
+// Authenticate makes access tokens a backend; the identity carries the
+// token's scope
+func (a *AccessTokens) Authenticate(ctx context.Context, creds Credentials) (Identity, error) {
+	if !strings.HasPrefix(creds.Token, accessTokenPrefix) {
+		return Identity{}, ErrUnsupported
+	}
+	claims, err := a.Verify(creds.Token)
+	if err != nil {
+		return Identity{}, err
+	}
+	if claims.ID != "" && !a.redeem(claims) {
+		return Identity{}, fmt.Errorf("%w: access:%s was already used", ErrInvalidToken, claims.Name)
+	}
+	return Identity{
+		Name:  "access:" + claims.Name,
+		Scope: &Scope{Port: claims.Port, ReadOnly: claims.ReadOnly, StreamOnly: claims.Stream},
+	}, nil
+}
+
+// redeem marks a single-use token used, reporting false if it already was
+func (a *AccessTokens) redeem(claims AccessClaims) bool {
+	now := time.Now()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for id, expires := range a.used {
+		if !now.Before(expires) {
+			delete(a.used, id)
+		}
+	}
+	if _, used := a.used[claims.ID]; used {
+		return false
+	}
+	a.used[claims.ID] = time.Unix(claims.ExpiresAt, 0)
+	return true
+}
+
+// Verify checks a token's signature and lifetime and returns its claims
+func (a *AccessTokens) Verify(token string) (AccessClaims, error) {
+	body, signature, ok := strings.Cut(token, ".")
+	if !ok || !strings.HasPrefix(body, accessTokenPrefix) || !hmac.Equal([]byte(signature), []byte(a.sign(body))) {
+		return AccessClaims{}, ErrInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(body, accessTokenPrefix))
+	if err != nil {
+		return AccessClaims{}, ErrInvalidToken
+	}
+	var claims AccessClaims
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Port == "" {
+		return AccessClaims{}, ErrInvalidToken
+	}
+	if !time.Now().Before(time.Unix(claims.ExpiresAt, 0)) {
+		return AccessClaims{}, fmt.Errorf("%w: access:%s", ErrExpiredToken, claims.Name)
+	}
+	return claims, nil
+}
+
+// sign returns the signature of a token body
+func (a *AccessTokens) sign(body string) string {
+	mac := hmac.New(sha256.New, a.key)
+	mac.Write([]byte(body))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
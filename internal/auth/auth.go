@@ -0,0 +1,235 @@
+// Package auth authenticates the clients of the agent against one or more
+// backends: API tokens issued by the agent, tokens of an OpenID Connect
+// provider, or an LDAP directory. For API tokens, only SHA-256 hashes are
+// kept, in the config file or in the token file written by "seriallink
+// token create", so neither holds a usable secret. Short-lived access
+// tokens scoped to one port are signed by the agent instead of stored.
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
+
+// tokenPrefix marks SerialLink tokens, so leaked ones are easy to spot
+const tokenPrefix = "slk_"
+
+// Errors returned by the verifier and the token file
+var (
+	ErrInvalidToken  = errors.New("invalid token")
+	ErrExpiredToken  = errors.New("token has expired")
+	ErrDuplicateName = errors.New("a token with this name already exists")
+	ErrNotFound      = errors.New("token not found")
+)
+
+// Token is an accepted API token
+type Token struct {
+	Name string `json:"name"`
+	// SHA256 is the hex SHA-256 hash of the token
+	SHA256    string    `json:"sha256"`
+	CreatedAt time.Time `json:"created_at"`
+	// ExpiresAt is zero for tokens that do not expire
+	ExpiresAt time.Time `json:"expires_at,omitzero"`
+	// Groups the token's client belongs to, for access rules
+	Groups []string `json:"groups,omitempty"`
+}
+
+// Expired reports whether the token has expired at now
+func (t Token) Expired(now time.Time) bool {
+	return !t.ExpiresAt.IsZero() && !now.Before(t.ExpiresAt)
+}
+
+// Generate returns a new random token
+func Generate() (string, error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return tokenPrefix + base64.RawURLEncoding.EncodeToString(b[:]), nil
+}
+
+// Hash returns the hex SHA-256 hash of a token
+func Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// ValidHash reports whether s is a hex SHA-256 hash
+func ValidHash(s string) bool {
+	b, err := hex.DecodeString(s)
+	return err == nil && len(b) == sha256.Size
+}
+
+// LoadFile reads a token file; a missing file holds no tokens
+func LoadFile(path string) ([]Token, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var tokens []Token
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		return nil, fmt.Errorf("invalid token file %s: %w", path, err)
+	}
+	return tokens, nil
+}
+
+// AddToFile adds a token to a token file, creating it
+func AddToFile(path string, token Token) error {
+	tokens, err := LoadFile(path)
+	if err != nil {
+		return err
+	}
+	if slices.ContainsFunc(tokens, func(t Token) bool { return t.Name == token.Name }) {
+		return fmt.Errorf("%w: %s", ErrDuplicateName, token.Name)
+	}
+	return writeFile(path, append(tokens, token))
+}
+
+// RemoveFromFile removes the token with a name from a token file
+func RemoveFromFile(path, name string) error {
+	tokens, err := LoadFile(path)
+	if err != nil {
+		return err
+	}
+	kept := slices.DeleteFunc(tokens, func(t Token) bool { return t.Name == name })
+	if len(kept) == len(tokens) {
+		return fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	return writeFile(path, kept)
+}
+
+// writeFile replaces a token file atomically, readable only by its owner
+func writeFile(path string, tokens []Token) error {
+	if tokens == nil {
+		tokens = []Token{}
+	}
+	data, err := json.MarshalIndent(tokens, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, ".tokens-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Verifier checks tokens against the configured ones and those of a token
+// file. The file is read again when it changes, so tokens created or
+// revoked while the agent runs take effect without a restart. It is safe
+// for concurrent use.
+type Verifier struct {
+	static []Token
+	path   string
+
+	mu      sync.Mutex
+	file    []Token
+	modTime time.Time
+	size    int64
+}
+
+// NewVerifier creates a verifier of the static tokens and, unless path is
+// empty, those of the token file at path
+func NewVerifier(static []Token, path string) (*Verifier, error) {
+	v := &Verifier{static: static, path: path}
+	if err := v.reload(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Count returns the number of accepted tokens
+func (v *Verifier) Count() int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return len(v.static) + len(v.file)
+}
+
+// lookup returns the accepted token matching token
+func (v *Verifier) lookup(token string) (Token, error) {
+	if token == "" {
+		return Token{}, ErrInvalidToken
+	}
+	// A token file that cannot be read keeps its previous tokens
+	_ = v.reload()
+
+	hash := []byte(Hash(token))
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for _, tokens := range [][]Token{v.static, v.file} {
+		for _, t := range tokens {
+			if subtle.ConstantTimeCompare(hash, []byte(t.SHA256)) != 1 {
+				continue
+			}
+			if t.Expired(time.Now()) {
+				return Token{}, fmt.Errorf("%w: %s", ErrExpiredToken, t.Name)
+			}
+			return t, nil
+		}
+	}
+	return Token{}, ErrInvalidToken
+}
+
+// reload reads the token file if it changed since it was last read
+func (v *Verifier) reload() error {
+	if v.path == "" {
+		return nil
+	}
+	info, err := os.Stat(v.path)
+	if errors.Is(err, os.ErrNotExist) {
+		v.mu.Lock()
+		v.file, v.modTime, v.size = nil, time.Time{}, 0
+		v.mu.Unlock()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	v.mu.Lock()
+	unchanged := info.ModTime().Equal(v.modTime) && info.Size() == v.size
+	v.mu.Unlock()
+	if unchanged {
+		return nil
+	}
+
+	tokens, err := LoadFile(v.path)
+	if err != nil {
+		return err
+	}
+	v.mu.Lock()
+	v.file, v.modTime, v.size = tokens, info.ModTime(), info.Size()
+	v.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Backend types
+const (
+	BackendStatic = "static"
+	BackendOIDC   = "oidc"
+	BackendLDAP   = "ldap"
+)
+
+// Errors returned by authenticators
+var (
+	// ErrUnsupported is returned for credentials of a kind a backend does
+	// not take, e.g. a password given to a token backend
+	ErrUnsupported        = errors.New("credentials not supported by this backend")
+	ErrInvalidCredentials = errors.New("invalid username or password")
+)
+
+// Credentials are what a client presents: a bearer token, or a username
+// and password
+type Credentials struct {
+	Token    string
+	Username string
+	Password string
+}
+
+// Identity is an authenticated client
+type Identity struct {
+	// Name identifies the client, prefixed with its backend ("token:ci",
+	// "oidc:alice@example.com", "ldap:alice")
+	Name string
+	// Groups the client belongs to, as the identity provider reports them
+	Groups []string
+	// Scope restricts a delegated access token to one port; nil for
+	// unrestricted identities
+	Scope *Scope
+}
+
+// Scope is what a delegated access token may do
+type Scope struct {
+	Port string
+	// ReadOnly allows reading the port and its status, but not opening,
+	// writing, configuring or closing it
+	ReadOnly bool
+	// StreamOnly allows nothing but following the data read from the port,
+	// for stream links
+	StreamOnly bool
+}
+
+// Authenticator checks credentials and returns whom they belong to
+type Authenticator interface {
+	Authenticate(ctx context.Context, creds Credentials) (Identity, error)
+}
+
+// Chain tries authenticators in order and returns the first identity one
+// of them accepts
+type Chain []Authenticator
+
+// Authenticate returns the identity of the first authenticator accepting
+// the credentials. When all reject them, the first error saying more than
+// that the token is unknown is returned, such as an expired token or an
+// unreachable provider.
+func (c Chain) Authenticate(ctx context.Context, creds Credentials) (Identity, error) {
+	var reason error
+	for _, a := range c {
+		identity, err := a.Authenticate(ctx, creds)
+		if err == nil {
+			return identity, nil
+		}
+		if ctx.Err() != nil {
+			return Identity{}, ctx.Err()
+		}
+		switch {
+		case errors.Is(err, ErrUnsupported):
+		case reason == nil, errors.Is(reason, ErrInvalidToken) && !errors.Is(err, ErrInvalidToken):
+			reason = err
+		}
+	}
+	if reason == nil {
+		return Identity{}, ErrUnsupported
+	}
+	return Identity{}, reason
+}
+
+// Authenticate makes the verifier the static token backend
+func (v *Verifier) Authenticate(ctx context.Context, creds Credentials) (Identity, error) {
+	if creds.Token == "" {
+		return Identity{}, ErrUnsupported
+	}
+	token, err := v.lookup(creds.Token)
+	if err != nil {
+		return Identity{}, err
+	}
+	return Identity{Name: "token:" + token.Name, Groups: token.Groups}, nil
+}
+
+// ValidBackend reports whether name is a backend type
+func ValidBackend(name string) error {
+	switch strings.ToLower(name) {
+	case BackendStatic, BackendOIDC, BackendLDAP:
+		return nil
+	}
+	return fmt.Errorf("unknown auth backend %q (static, oidc or ldap)", name)
+}
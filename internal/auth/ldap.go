@@ -0,0 +1,214 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+const (
+	// ldapTimeout bounds every LDAP operation
+	ldapTimeout = 10 * time.Second
+
+	// DefaultLDAPCacheTTL is how long a successful login is reused, so
+	// not every call goes to the directory
+	DefaultLDAPCacheTTL = time.Minute
+)
+
+// LDAPOptions configures an LDAP backend
+type LDAPOptions struct {
+	// URL of the directory, ldap:// or ldaps://
+	URL string
+	// StartTLS upgrades an ldap:// connection before binding
+	StartTLS bool
+	// CAFile verifies the directory's certificate (default: system roots)
+	CAFile string
+	// BindDN and BindPassword are the service account searching for users
+	// and groups; empty binds anonymously
+	BindDN       string
+	BindPassword string
+	// UserBaseDN and UserFilter find the user; %s in the filter is the
+	// escaped username (default: "(uid=%s)")
+	UserBaseDN string
+	UserFilter string
+	// GroupBaseDN and GroupFilter find the user's groups; %s in the filter
+	// is the escaped user DN (default: "(member=%s)"). Groups are not
+	// looked up when GroupBaseDN is empty.
+	GroupBaseDN string
+	GroupFilter string
+	// GroupAttribute names a group (default: cn)
+	GroupAttribute string
+	// CacheTTL reuses successful logins (default: DefaultLDAPCacheTTL)
+	CacheTTL time.Duration
+}
+
+// LDAP authenticates usernames and passwords by binding to a directory as
+// the user. It is safe for concurrent use.
+type LDAP struct {
+	opts      LDAPOptions
+	tlsConfig *tls.Config
+
+	mu    sync.Mutex
+	cache map[string]ldapLogin
+}
+
+// ldapLogin is a cached successful login
+type ldapLogin struct {
+	identity Identity
+	expires  time.Time
+}
+
+// NewLDAP creates an LDAP backend
+func NewLDAP(opts LDAPOptions) (*LDAP, error) {
+	u, err := url.Parse(opts.URL)
+	if err != nil || (u.Scheme != "ldap" && u.Scheme != "ldaps") || u.Host == "" {
+		return nil, fmt.Errorf("ldap url must be ldap://HOST or ldaps://HOST: %q", opts.URL)
+	}
+	if opts.UserBaseDN == "" {
+		return nil, errors.New("ldap requires a user_base_dn")
+	}
+	if opts.UserFilter == "" {
+		opts.UserFilter = "(uid=%s)"
+	}
+	if opts.GroupFilter == "" {
+		opts.GroupFilter = "(member=%s)"
+	}
+	if opts.GroupAttribute == "" {
+		opts.GroupAttribute = "cn"
+	}
+	if opts.CacheTTL == 0 {
+		opts.CacheTTL = DefaultLDAPCacheTTL
+	}
+
+	host := u.Hostname()
+	tlsConfig := &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}
+	if opts.CAFile != "" {
+		pem, err := os.ReadFile(opts.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ldap ca_file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("ldap ca_file %s holds no certificates", opts.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return &LDAP{opts: opts, tlsConfig: tlsConfig, cache: make(map[string]ldapLogin)}, nil
+}
+
+// Authenticate checks a username and password against the directory
+func (l *LDAP) Authenticate(ctx context.Context, creds Credentials) (Identity, error) {
+	if creds.Username == "" {
+		return Identity{}, ErrUnsupported
+	}
+	// An empty password would be an unauthenticated bind, which succeeds
+	if creds.Password == "" {
+		return Identity{}, ErrInvalidCredentials
+	}
+
+	key := Hash(creds.Username + "\x00" + creds.Password)
+	now := time.Now()
+	l.mu.Lock()
+	login, ok := l.cache[key]
+	l.mu.Unlock()
+	if ok && now.Before(login.expires) {
+		return login.identity, nil
+	}
+
+	identity, err := l.login(ctx, creds.Username, creds.Password)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	l.mu.Lock()
+	for k, cached := range l.cache {
+		if !now.Before(cached.expires) {
+			delete(l.cache, k)
+		}
+	}
+	l.cache[key] = ldapLogin{identity: identity, expires: now.Add(l.opts.CacheTTL)}
+	l.mu.Unlock()
+	return identity, nil
+}
+
+// login binds as the user and looks up its groups
+func (l *LDAP) login(ctx context.Context, username, password string) (Identity, error) {
+	conn, err := ldap.DialURL(l.opts.URL,
+		ldap.DialWithTLSConfig(l.tlsConfig),
+		ldap.DialWithDialer(&net.Dialer{Timeout: ldapTimeout}))
+	if err != nil {
+		return Identity{}, fmt.Errorf("failed to connect to LDAP: %w", err)
+	}
+	defer conn.Close()
+	conn.SetTimeout(ldapTimeout)
+
+	// Operations take no context; closing the connection aborts them
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	if l.opts.StartTLS {
+		if err := conn.StartTLS(l.tlsConfig); err != nil {
+			return Identity{}, fmt.Errorf("LDAP StartTLS failed: %w", err)
+		}
+	}
+	if err := l.bindService(conn); err != nil {
+		return Identity{}, err
+	}
+
+	users, err := conn.Search(ldap.NewSearchRequest(
+		l.opts.UserBaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, int(ldapTimeout.Seconds()), false,
+		fmt.Sprintf(l.opts.UserFilter, ldap.EscapeFilter(username)), []string{"dn"}, nil))
+	if err != nil {
+		return Identity{}, fmt.Errorf("LDAP user search failed: %w", err)
+	}
+	if len(users.Entries) != 1 {
+		return Identity{}, ErrInvalidCredentials
+	}
+	userDN := users.Entries[0].DN
+
+	if err := conn.Bind(userDN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return Identity{}, ErrInvalidCredentials
+		}
+		return Identity{}, fmt.Errorf("LDAP bind failed: %w", err)
+	}
+
+	identity := Identity{Name: "ldap:" + username}
+	if l.opts.GroupBaseDN == "" {
+		return identity, nil
+	}
+	// Users may not be allowed to search groups themselves
+	if err := l.bindService(conn); err != nil {
+		return Identity{}, err
+	}
+	groups, err := conn.Search(ldap.NewSearchRequest(
+		l.opts.GroupBaseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, int(ldapTimeout.Seconds()), false,
+		fmt.Sprintf(l.opts.GroupFilter, ldap.EscapeFilter(userDN)), []string{l.opts.GroupAttribute}, nil))
+	if err != nil {
+		return Identity{}, fmt.Errorf("LDAP group search failed: %w", err)
+	}
+	for _, entry := range groups.Entries {
+		identity.Groups = append(identity.Groups, entry.GetAttributeValues(l.opts.GroupAttribute)...)
+	}
+	return identity, nil
+}
+
+// bindService binds as the service account, if one is configured
+func (l *LDAP) bindService(conn *ldap.Conn) error {
+	if l.opts.BindDN == "" {
+		return nil
+	}
+	if err := conn.Bind(l.opts.BindDN, l.opts.BindPassword); err != nil {
+		return fmt.Errorf("LDAP service bind failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+const (
+	// oidcFetchTimeout bounds fetching the discovery document or key set
+	oidcFetchTimeout = 10 * time.Second
+
+	// oidcRefreshInterval is how often the key set may be fetched again
+	// for a token signed with an unknown key, so forged key IDs cannot
+	// flood the provider
+	oidcRefreshInterval = time.Minute
+
+	// oidcLeeway tolerates clock skew between the agent and the provider
+	oidcLeeway = time.Minute
+)
+
+// oidcAlgorithms are the signature algorithms accepted on tokens
+var oidcAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// OIDCOptions configures an OpenID Connect backend
+type OIDCOptions struct {
+	// Issuer is the provider's issuer URL, which tokens must name
+	Issuer string
+	// Audience is the client ID tokens must be issued for
+	Audience string
+	// JWKSURL is the provider's key set (default: from the discovery
+	// document of the issuer)
+	JWKSURL string
+	// UsernameClaim names the client (default: sub)
+	UsernameClaim string
+	// GroupsClaim lists the client's groups (default: groups)
+	GroupsClaim string
+	// Client fetches the discovery document and key set (default:
+	// http.DefaultClient)
+	Client *http.Client
+}
+
+// OIDC authenticates bearer tokens (JWTs) signed by an OpenID Connect
+// provider, such as ID tokens or JWT access tokens. It is safe for
+// concurrent use.
+type OIDC struct {
+	opts OIDCOptions
+
+	mu      sync.Mutex
+	jwksURL string
+	keys    jose.JSONWebKeySet
+	fetched time.Time
+}
+
+// NewOIDC creates an OpenID Connect backend. Keys are fetched when the
+// first token arrives, so the agent starts while the provider is down.
+func NewOIDC(opts OIDCOptions) (*OIDC, error) {
+	if opts.Issuer == "" || opts.Audience == "" {
+		return nil, errors.New("oidc requires an issuer and an audience")
+	}
+	if opts.UsernameClaim == "" {
+		opts.UsernameClaim = "sub"
+	}
+	if opts.GroupsClaim == "" {
+		opts.GroupsClaim = "groups"
+	}
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+	return &OIDC{opts: opts, jwksURL: opts.JWKSURL}, nil
+}
+
+// Authenticate verifies a token's signature, issuer, audience and
+// lifetime, and names the client by its username claim
+func (o *OIDC) Authenticate(ctx context.Context, creds Credentials) (Identity, error) {
+	// API tokens contain no dots, JWTs two
+	if strings.Count(creds.Token, ".") != 2 {
+		return Identity{}, ErrUnsupported
+	}
+	token, err := jwt.ParseSigned(creds.Token, oidcAlgorithms)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	key, err := o.key(ctx, token.Headers[0].KeyID)
+	if err != nil {
+		return Identity{}, err
+	}
+
+	var standard jwt.Claims
+	var claims map[string]any
+	if err := token.Claims(key, &standard, &claims); err != nil {
+		return Identity{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	err = standard.ValidateWithLeeway(jwt.Expected{
+		Issuer:      o.opts.Issuer,
+		AnyAudience: jwt.Audience{o.opts.Audience},
+		Time:        time.Now(),
+	}, oidcLeeway)
+	if errors.Is(err, jwt.ErrExpired) {
+		return Identity{}, fmt.Errorf("%w: %s", ErrExpiredToken, standard.Subject)
+	}
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	username, _ := claims[o.opts.UsernameClaim].(string)
+	if username == "" {
+		return Identity{}, fmt.Errorf("%w: no %s claim", ErrInvalidToken, o.opts.UsernameClaim)
+	}
+	return Identity{Name: "oidc:" + username, Groups: claimStrings(claims[o.opts.GroupsClaim])}, nil
+}
+
+// key returns the provider key with an ID, fetching the key set when it
+// is not known yet
+func (o *OIDC) key(ctx context.Context, id string) (jose.JSONWebKey, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if key, ok := findKey(o.keys, id); ok {
+		return key, nil
+	}
+	if !o.fetched.IsZero() && time.Since(o.fetched) < oidcRefreshInterval {
+		return jose.JSONWebKey{}, fmt.Errorf("%w: unknown signing key %q", ErrInvalidToken, id)
+	}
+	if err := o.fetchKeys(ctx); err != nil {
+		return jose.JSONWebKey{}, fmt.Errorf("failed to fetch OIDC keys: %w", err)
+	}
+	if key, ok := findKey(o.keys, id); ok {
+		return key, nil
+	}
+	return jose.JSONWebKey{}, fmt.Errorf("%w: unknown signing key %q", ErrInvalidToken, id)
+}
+
+// findKey returns the signing key with an ID, or the only one when the
+// token names none
+func findKey(keys jose.JSONWebKeySet, id string) (jose.JSONWebKey, bool) {
+	if id == "" {
+		if len(keys.Keys) == 1 {
+			return keys.Keys[0], true
+		}
+		return jose.JSONWebKey{}, false
+	}
+	for _, key := range keys.Key(id) {
+		if key.Use == "" || key.Use == "sig" {
+			return key, true
+		}
+	}
+	return jose.JSONWebKey{}, false
+}
+
+// fetchKeys fetches the key set, discovering its URL first if needed
+func (o *OIDC) fetchKeys(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, oidcFetchTimeout)
+	defer cancel()
+
+	if o.jwksURL == "" {
+		var discovery struct {
+			Issuer  string `json:"issuer"`
+			JWKSURI string `json:"jwks_uri"`
+		}
+		if err := o.getJSON(ctx, strings.TrimSuffix(o.opts.Issuer, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+			return err
+		}
+		if discovery.Issuer != o.opts.Issuer {
+			return fmt.Errorf("discovery document names issuer %q, expected %q", discovery.Issuer, o.opts.Issuer)
+		}
+		if discovery.JWKSURI == "" {
+			return errors.New("discovery document has no jwks_uri")
+		}
+		o.jwksURL = discovery.JWKSURI
+	}
+
+	var keys jose.JSONWebKeySet
+	if err := o.getJSON(ctx, o.jwksURL, &keys); err != nil {
+		return err
+	}
+	o.keys = keys
+	o.fetched = time.Now()
+	return nil
+}
+
+// getJSON fetches and decodes a JSON document
+func (o *OIDC) getJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := o.opts.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("GET %s: %w", url, err)
+	}
+	return nil
+}
+
+// claimStrings returns a claim holding a string or a list of strings
+func claimStrings(claim any) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+// Package backup bundles the agent's configuration and state files
+// (reservations, usage totals, API tokens, optionally TLS credentials and
+// signing keys) into one archive, so a configured gateway can be cloned
+// onto a replacement device. A bundle is a gzipped tar holding
+// manifest.json and the files.
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Format is the version of the bundle layout
+const Format = 1
+
+// manifestName is the name of the manifest in a bundle
+const manifestName = "manifest.json"
+
+// maxFileSize bounds a file read from a bundle
+const maxFileSize = 64 << 20
+
+// Roles of the files in a bundle
+const (
+	RoleConfig       = "config"
+	RoleReservations = "reservations"
+	RoleUsage        = "usage"
+	RoleTLSCert      = "tls_cert"
+	RoleTLSKey       = "tls_key"
+	RoleTLSCA        = "tls_ca"
+	// RoleTokens is the API token file (hashes of the tokens)
+	RoleTokens = "auth_tokens"
+	// RoleAccessKey is the key signing access tokens and stream links
+	RoleAccessKey = "access_token_key"
+	// RoleComplianceKey is the key signing compliance log checkpoints
+	RoleComplianceKey = "compliance_key"
+)
+
+// ErrInvalidBundle is returned for an archive that is not a readable bundle
+var ErrInvalidBundle = errors.New("invalid backup bundle")
+
+// Source is a file to bundle
+type Source struct {
+	Role string
+	Path string
+	// Optional sources are skipped when the file does not exist, e.g. the
+	// usage totals of an agent that has not saved any yet
+	Optional bool
+}
+
+// Manifest describes a bundle
+type Manifest struct {
+	Format    int       `json:"format"`
+	CreatedAt time.Time `json:"created_at"`
+	Hostname  string    `json:"hostname"`
+	Version   string    `json:"version"`
+	Files     []Entry   `json:"files"`
+}
+
+// Entry describes a file in a bundle
+type Entry struct {
+	Role string `json:"role"`
+	// Name is the file's name in the archive
+	Name string `json:"name"`
+	// Path is where the file was on the exporting device
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Bundle is a bundle read into memory
+type Bundle struct {
+	Manifest Manifest
+	data     map[string][]byte
+}
+
+// File returns the entry and contents of the file with a role
+func (b *Bundle) File(role string) (Entry, []byte, bool) {
+	for _, entry := range b.Manifest.Files {
+		if entry.Role == role {
+			return entry, b.data[entry.Name], true
+		}
+	}
+	return Entry{}, nil, false
+}
+
+// Export writes a bundle of the sources to w. manifest supplies the
+// description; its format and files are filled in.
+func Export(w io.Writer, manifest Manifest, sources []Source) (Manifest, error) {
+	manifest.Format = Format
+	manifest.Files = nil
+
+	type file struct {
+		entry Entry
+		data  []byte
+	}
+	var files []file
+	for _, source := range sources {
+		data, err := os.ReadFile(source.Path)
+		if errors.Is(err, os.ErrNotExist) && source.Optional {
+			continue
+		}
+		if err != nil {
+			return manifest, fmt.Errorf("%s: %w", source.Role, err)
+		}
+		sum := sha256.Sum256(data)
+		entry := Entry{
+			Role:   source.Role,
+			Name:   "files/" + source.Role + filepath.Ext(source.Path),
+			Path:   source.Path,
+			Size:   int64(len(data)),
+			SHA256: hex.EncodeToString(sum[:]),
+		}
+		manifest.Files = append(manifest.Files, entry)
+		files = append(files, file{entry, data})
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return manifest, err
+	}
+	if err := writeEntry(tw, manifestName, manifestData, manifest.CreatedAt); err != nil {
+		return manifest, err
+	}
+	for _, f := range files {
+		if err := writeEntry(tw, f.entry.Name, f.data, manifest.CreatedAt); err != nil {
+			return manifest, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return manifest, err
+	}
+	return manifest, gz.Close()
+}
+
+// writeEntry adds a file to the archive
+func writeEntry(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	// Bundles may hold credentials: files extracted by hand stay readable
+	// only by their owner
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0o600,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+// Read reads a bundle and verifies its files against the manifest
+func Read(r io.Reader) (*Bundle, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBundle, err)
+	}
+	defer gz.Close()
+
+	data := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidBundle, err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if header.Size > maxFileSize {
+			return nil, fmt.Errorf("%w: %s is too large", ErrInvalidBundle, header.Name)
+		}
+		content, err := io.ReadAll(io.LimitReader(tr, maxFileSize))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidBundle, err)
+		}
+		data[header.Name] = content
+	}
+
+	manifestData, ok := data[manifestName]
+	if !ok {
+		return nil, fmt.Errorf("%w: no %s", ErrInvalidBundle, manifestName)
+	}
+	bundle := &Bundle{data: data}
+	if err := json.Unmarshal(manifestData, &bundle.Manifest); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBundle, err)
+	}
+	if bundle.Manifest.Format != Format {
+		return nil, fmt.Errorf("%w: unsupported format %d", ErrInvalidBundle, bundle.Manifest.Format)
+	}
+	for _, entry := range bundle.Manifest.Files {
+		content, ok := data[entry.Name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s is missing", ErrInvalidBundle, entry.Name)
+		}
+		sum := sha256.Sum256(content)
+		if hex.EncodeToString(sum[:]) != entry.SHA256 {
+			return nil, fmt.Errorf("%w: checksum mismatch for %s", ErrInvalidBundle, entry.Name)
+		}
+	}
+	return bundle, nil
+}
+
+// WriteFile replaces path with data, creating its directory
+func WriteFile(path string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, ".restore-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,163 @@
+// Package barcode turns the byte stream of a serial barcode scanner into
+// discrete scans.
+package barcode
+
+import (
+	"strings"
+	"time"
+)
+
+// DefaultTerminators end a scan when a profile sets none
+const DefaultTerminators = "\r\n"
+
+// maxScanBytes bounds a pending scan; longer input is line noise or a
+// misconfigured terminator and is dropped up to the next terminator
+const maxScanBytes = 8192
+
+// Profile describes how a scanner frames its output
+type Profile struct {
+	// Terminators are the bytes that end a scan (default: CR and LF)
+	Terminators string
+	// Prefix and Suffix are stripped from scans that carry them
+	Prefix string
+	Suffix string
+	// Debounce drops a scan identical to the previous one within this window
+	Debounce time.Duration
+	// IdleTimeout ends a scan after a gap in input, for scanners that send
+	// no terminator (0 disables)
+	IdleTimeout time.Duration
+	// MinLength drops shorter scans, e.g. noise from a scanner powering up
+	MinLength int
+}
+
+// Scan is one barcode read
+type Scan struct {
+	Data string
+	// Timestamp is when the first byte of the scan arrived
+	Timestamp time.Time
+}
+
+// Framer splits scanner output into scans. It is not safe for concurrent use.
+type Framer struct {
+	profile  Profile
+	pending  []byte
+	started  time.Time
+	lastData string
+	lastTime time.Time
+	// overflow is set while dropping an oversized scan
+	overflow bool
+}
+
+// NewFramer creates a framer for a profile
+func NewFramer(profile Profile) *Framer {
+	if profile.Terminators == "" {
+		profile.Terminators = DefaultTerminators
+	}
+	return &Framer{profile: profile}
+}
+
+// Feed adds received bytes and returns the scans they complete
+func (f *Framer) Feed(data []byte, now time.Time) []Scan {
+	var scans []Scan
+	for len(data) > 0 {
+		if len(f.pending) == 0 {
+			f.started = now
+		}
+
+		i := f.indexTerminator(data)
+		if i < 0 {
+			f.buffer(data)
+			break
+		}
+
+		f.buffer(data[:i])
+		data = data[i+1:]
+		if f.overflow {
+			f.overflow = false
+			continue
+		}
+		if scan, ok := f.complete(); ok {
+			scans = append(scans, scan)
+		}
+	}
+	return scans
+}
+
+// indexTerminator returns the index of the first terminator byte in data,
+// or -1. Terminators are matched as bytes: bytes.IndexAny matches runes, so
+// a non-ASCII terminator would match depending on how reads split the
+// characters around it.
+func (f *Framer) indexTerminator(data []byte) int {
+	for i, b := range data {
+		if strings.IndexByte(f.profile.Terminators, b) >= 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// buffer appends to the pending scan, dropping it once it exceeds
+// maxScanBytes
+func (f *Framer) buffer(data []byte) {
+	if f.overflow {
+		return
+	}
+	if len(f.pending)+len(data) > maxScanBytes {
+		f.pending = f.pending[:0]
+		f.overflow = true
+		return
+	}
+	f.pending = append(f.pending, data...)
+}
+
+// Flush ends a pending unterminated scan; call it when no input arrived
+// for the idle timeout
+func (f *Framer) Flush() (Scan, bool) {
+	if f.profile.IdleTimeout <= 0 {
+		return Scan{}, false
+	}
+	if f.overflow {
+		// The gap ends the oversized scan
+		f.overflow = false
+		return Scan{}, false
+	}
+	if len(f.pending) == 0 {
+		return Scan{}, false
+	}
+	return f.complete()
+}
+
+// IdleTimeout returns the profile's idle timeout
+func (f *Framer) IdleTimeout() time.Duration {
+	return f.profile.IdleTimeout
+}
+
+// complete turns the pending bytes into a scan, applying stripping,
+// length filtering and debouncing
+func (f *Framer) complete() (Scan, bool) {
+	data := string(f.pending)
+	started := f.started
+	f.pending = f.pending[:0]
+
+	if f.profile.Prefix != "" {
+		data = strings.TrimPrefix(data, f.profile.Prefix)
+	}
+	if f.profile.Suffix != "" {
+		data = strings.TrimSuffix(data, f.profile.Suffix)
+	}
+	data = strings.TrimSpace(data)
+
+	if data == "" || len(data) < f.profile.MinLength {
+		return Scan{}, false
+	}
+
+	if f.profile.Debounce > 0 && data == f.lastData && started.Sub(f.lastTime) < f.profile.Debounce {
+		// Repeated scan of the same code; extend the window
+		f.lastTime = started
+		return Scan{}, false
+	}
+	f.lastData = data
+	f.lastTime = started
+
+	return Scan{Data: data, Timestamp: started}, true
+}
@@ -0,0 +1,62 @@
+package barcode
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func FuzzFramerFeed(f *testing.F) {
+	f.Add([]byte("4006381333931\r\n"), uint16(3), "", "")
+	f.Add([]byte("]C1ABC-123\r]C1ABC-123\r"), uint16(5), "\r", "]C1")
+	f.Add([]byte("\x02012345678905\x03\n"), uint16(1), "\n", "\x02")
+	f.Add([]byte("no terminator at all"), uint16(0), "", "")
+	f.Add(bytes.Repeat([]byte("A"), maxScanBytes+10), uint16(maxScanBytes), "\t", "")
+
+	f.Fuzz(func(t *testing.T, data []byte, split uint16, terminators, prefix string) {
+		profile := Profile{Terminators: terminators, Prefix: prefix, MinLength: 1, Debounce: time.Second}
+		now := time.Unix(0, 0)
+
+		whole := NewFramer(profile).Feed(data, now)
+
+		// Splitting the input must not change the scans
+		at := int(split) % (len(data) + 1)
+		parts := NewFramer(profile)
+		split1 := parts.Feed(data[:at], now)
+		split2 := parts.Feed(data[at:], now)
+		pieces := append(split1, split2...)
+		if len(whole) != len(pieces) || (len(whole) > 0 && !reflect.DeepEqual(whole, pieces)) {
+			t.Fatalf("split at %d gave %q, whole input %q", at, pieces, whole)
+		}
+
+		terms := profile.Terminators
+		if terms == "" {
+			terms = DefaultTerminators
+		}
+		for _, scan := range whole {
+			switch {
+			case scan.Data == "":
+				t.Fatal("empty scan")
+			case len(scan.Data) > maxScanBytes:
+				t.Fatalf("scan of %d bytes exceeds the limit", len(scan.Data))
+			case containsAnyByte(scan.Data, terms):
+				t.Fatalf("scan %q contains a terminator", scan.Data)
+			}
+		}
+		if len(parts.pending) > maxScanBytes {
+			t.Fatalf("pending scan grew to %d bytes", len(parts.pending))
+		}
+	})
+}
+
+// containsAnyByte reports whether s contains any byte of set
+func containsAnyByte(s, set string) bool {
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(set, s[i]) >= 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,391 @@
+// Package bridge forwards the data received on one open port to another,
+// replacing a null-modem "man in the middle" rig between two devices.
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/redact"
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/charmbracelet/log"
+)
+
+// Bridge errors
+var (
+	ErrInvalid     = errors.New("invalid bridge")
+	ErrExists      = errors.New("bridge already exists")
+	ErrPortBridged = errors.New("port is already bridged")
+	ErrNotFound    = errors.New("bridge not found")
+	ErrNotHolder   = errors.New("only the holder of a bridged session may change a bridge")
+)
+
+// readSize is the largest chunk forwarded at once
+const readSize = 4096
+
+// Endpoint is one side of a bridge: an open port and the session it was
+// opened with
+type Endpoint struct {
+	PortName  string
+	SessionID string
+	// BaudRate reconfigures the port before forwarding starts (0 keeps its
+	// settings). The sides may run at different rates; data for a slower
+	// side waits within the agent's memory limits.
+	BaudRate int
+}
+
+// Definition describes a bridge
+type Definition struct {
+	Name string
+	A, B Endpoint
+	// OneWay forwards from A to B only; B is not read
+	OneWay bool
+	// Log writes the forwarded data to the agent log
+	Log bool
+	// Rules intercept the forwarded data, in order
+	Rules []Rule
+}
+
+// Info is a running bridge and the bytes it forwarded
+type Info struct {
+	Definition
+	Started   time.Time
+	BytesAToB uint64
+	BytesBToA uint64
+	// RuleStats counts the matches of each of Rules
+	RuleStats []RuleStats
+}
+
+// bridge is a running bridge
+type bridge struct {
+	def     Definition
+	started time.Time
+	aToB    atomic.Uint64
+	bToA    atomic.Uint64
+	rules   atomic.Pointer[ruleSet]
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// reads reports whether the bridge reads a port
+func (b *bridge) reads(portName string) bool {
+	return b.def.A.PortName == portName || (!b.def.OneWay && b.def.B.PortName == portName)
+}
+
+// info returns the bridge's state
+func (b *bridge) info() Info {
+	rules := b.rules.Load()
+	def := b.def
+	def.Rules = rules.rules
+	return Info{
+		Definition: def,
+		Started:    b.started,
+		BytesAToB:  b.aToB.Load(),
+		BytesBToA:  b.bToA.Load(),
+		RuleStats:  rules.stats(),
+	}
+}
+
+// Set runs the bridges of an agent. A port belongs to at most one bridge.
+type Set struct {
+	manager *serial.Manager
+	logger  *log.Logger
+	// redactor masks secrets in logged data
+	redactor atomic.Pointer[redact.Redactor]
+
+	mu      sync.Mutex
+	bridges map[string]*bridge
+}
+
+// NewSet creates an empty set of bridges
+func NewSet(manager *serial.Manager, logger *log.Logger) *Set {
+	return &Set{
+		manager: manager,
+		logger:  logger,
+		bridges: make(map[string]*bridge),
+	}
+}
+
+// SetRedactor masks secrets in the data of bridges that log it
+func (s *Set) SetRedactor(redactor *redact.Redactor) {
+	s.redactor.Store(redactor)
+}
+
+// Start checks both sessions, applies the baud rates and starts
+// forwarding. The bridge stops by itself when either port closes.
+func (s *Set) Start(def Definition) (Info, error) {
+	switch {
+	case def.Name == "":
+		return Info{}, fmt.Errorf("%w: name is required", ErrInvalid)
+	case def.A.PortName == "" || def.B.PortName == "":
+		return Info{}, fmt.Errorf("%w: both ports are required", ErrInvalid)
+	case def.A.PortName == def.B.PortName:
+		return Info{}, fmt.Errorf("%w: a port cannot be bridged to itself", ErrInvalid)
+	}
+	rules, err := newRuleSet(def.Rules)
+	if err != nil {
+		return Info{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.bridges[def.Name]; exists {
+		return Info{}, fmt.Errorf("%w: %s", ErrExists, def.Name)
+	}
+	for _, other := range s.bridges {
+		for _, portName := range []string{def.A.PortName, def.B.PortName} {
+			if other.def.A.PortName == portName || other.def.B.PortName == portName {
+				return Info{}, fmt.Errorf("%w: %s is in bridge %s", ErrPortBridged, portName, other.def.Name)
+			}
+		}
+	}
+
+	// Subscribed before the sessions are checked, so no close slips past
+	portEvents := s.manager.SubscribeEvents()
+	started := false
+	defer func() {
+		if !started {
+			s.manager.UnsubscribeEvents(portEvents)
+		}
+	}()
+
+	for _, end := range []Endpoint{def.A, def.B} {
+		session, err := s.manager.ValidateSession(end.PortName, end.SessionID)
+		if err != nil {
+			return Info{}, fmt.Errorf("%s: %w", end.PortName, err)
+		}
+		if end.BaudRate == 0 || end.BaudRate == session.Config.BaudRate {
+			continue
+		}
+		config := session.Config
+		config.BaudRate = end.BaudRate
+		if err := s.manager.Configure(end.PortName, end.SessionID, config); err != nil {
+			return Info{}, fmt.Errorf("failed to set %s to %d baud: %w", end.PortName, end.BaudRate, err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	b := &bridge{
+		def:     def,
+		started: time.Now(),
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	b.rules.Store(rules)
+
+	// Subscribed before reading starts, so no data slips past
+	readers := []*serial.Reader{serial.NewReader(s.manager, def.A.PortName, def.A.SessionID, readSize)}
+	if !def.OneWay {
+		readers = append(readers, serial.NewReader(s.manager, def.B.PortName, def.B.SessionID, readSize))
+	}
+	subscriptions := make([]<-chan serial.DataEvent, len(readers))
+	for i, reader := range readers {
+		reader.SetConsumer("bridge " + def.Name)
+		subscriptions[i] = reader.Subscribe()
+	}
+	for _, reader := range readers {
+		if err := reader.Start(ctx); err != nil {
+			cancel()
+			for _, r := range readers {
+				r.Stop()
+			}
+			return Info{}, fmt.Errorf("failed to start reader: %w", err)
+		}
+	}
+
+	s.bridges[def.Name] = b
+	started = true
+	go s.run(ctx, b, portEvents, readers, subscriptions)
+
+	s.logger.Info("bridge started", "bridge", def.Name, "a", def.A.PortName, "b", def.B.PortName, "one_way", def.OneWay, "rules", len(def.Rules))
+	return b.info(), nil
+}
+
+// run forwards until the bridge is stopped or a port closes
+func (s *Set) run(ctx context.Context, b *bridge, portEvents <-chan serial.PortEvent, readers []*serial.Reader, subscriptions []<-chan serial.DataEvent) {
+	var wg sync.WaitGroup
+	wg.Add(len(readers) + 1)
+	go func() {
+		defer wg.Done()
+		s.watch(ctx, b, portEvents)
+	}()
+	go func() {
+		defer wg.Done()
+		s.forward(ctx, b, readers[0], subscriptions[0], DirectionAToB, b.def.A, b.def.B, &b.aToB)
+	}()
+	if len(readers) > 1 {
+		go func() {
+			defer wg.Done()
+			s.forward(ctx, b, readers[1], subscriptions[1], DirectionBToA, b.def.B, b.def.A, &b.bToA)
+		}()
+	}
+	wg.Wait()
+
+	s.mu.Lock()
+	if s.bridges[b.def.Name] == b {
+		delete(s.bridges, b.def.Name)
+	}
+	s.mu.Unlock()
+	close(b.done)
+
+	s.logger.Info("bridge stopped", "bridge", b.def.Name, "a_to_b", b.aToB.Load(), "b_to_a", b.bToA.Load())
+}
+
+// watch ends the bridge when the session of either side closes, also
+// while no data flows
+func (s *Set) watch(ctx context.Context, b *bridge, events <-chan serial.PortEvent) {
+	defer b.cancel()
+	defer s.manager.UnsubscribeEvents(events)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if event.Type == serial.PortEventClosed && (event.PortName == b.def.A.PortName || event.PortName == b.def.B.PortName) {
+				return
+			}
+		}
+	}
+}
+
+// forward writes what reader receives from one side to the other, as the
+// rules have it. Either direction ending ends the bridge.
+func (s *Set) forward(ctx context.Context, b *bridge, reader *serial.Reader, events <-chan serial.DataEvent, direction Direction, from, to Endpoint, forwarded *atomic.Uint64) {
+	defer b.cancel()
+	defer reader.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if event.Error != nil {
+				if portGone(event.Error) {
+					return
+				}
+				continue
+			}
+
+			data, delay := b.rules.Load().apply(direction, event.Data)
+			if delay > 0 {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(delay):
+				}
+			}
+			if len(data) == 0 {
+				continue
+			}
+
+			if _, err := s.manager.Write(to.PortName, to.SessionID, data); err != nil {
+				s.logger.Warn("bridge write failed", "bridge", b.def.Name, "port", to.PortName, "error", err)
+				if portGone(err) {
+					return
+				}
+				continue
+			}
+			forwarded.Add(uint64(len(data)))
+
+			if b.def.Log {
+				s.logger.Info("bridge data", "bridge", b.def.Name, "from", from.PortName, "to", to.PortName, "data", strconv.Quote(string(s.redactor.Load().Apply(data))))
+			}
+		}
+	}
+}
+
+// portGone reports whether an error means a side of the bridge is gone
+func portGone(err error) bool {
+	return errors.Is(err, serial.ErrPortClosed) || errors.Is(err, serial.ErrPortNotOpen) || errors.Is(err, serial.ErrInvalidSession)
+}
+
+// Stop stops a bridge on behalf of the holder of either of its sessions
+// and returns its final state
+func (s *Set) Stop(name, sessionID string) (Info, error) {
+	s.mu.Lock()
+	b, exists := s.bridges[name]
+	s.mu.Unlock()
+	if !exists {
+		return Info{}, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+
+	if !s.holds(b.def.A, sessionID) && !s.holds(b.def.B, sessionID) {
+		return Info{}, ErrNotHolder
+	}
+
+	b.cancel()
+	<-b.done
+	return b.info(), nil
+}
+
+// SetRules replaces the rules of a running bridge on behalf of the holder
+// of either of its sessions. The hit counts start again from zero.
+func (s *Set) SetRules(name, sessionID string, rules []Rule) (Info, error) {
+	s.mu.Lock()
+	b, exists := s.bridges[name]
+	s.mu.Unlock()
+	if !exists {
+		return Info{}, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+
+	if !s.holds(b.def.A, sessionID) && !s.holds(b.def.B, sessionID) {
+		return Info{}, ErrNotHolder
+	}
+
+	set, err := newRuleSet(rules)
+	if err != nil {
+		return Info{}, err
+	}
+	b.rules.Store(set)
+
+	s.logger.Info("bridge rules set", "bridge", name, "rules", len(rules))
+	return b.info(), nil
+}
+
+// holds reports whether sessionID is the session of a bridge's side
+func (s *Set) holds(end Endpoint, sessionID string) bool {
+	_, err := s.manager.ValidateSession(end.PortName, sessionID)
+	return sessionID != "" && err == nil
+}
+
+// List returns the running bridges by name
+func (s *Set) List() []Info {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	infos := make([]Info, 0, len(s.bridges))
+	for _, b := range s.bridges {
+		infos = append(infos, b.info())
+	}
+	slices.SortFunc(infos, func(a, b Info) int { return strings.Compare(a.Name, b.Name) })
+	return infos
+}
+
+// Reading returns the bridge reading a port, whose data no one else may
+// read without starving it
+func (s *Set) Reading(portName string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for name, b := range s.bridges {
+		if b.reads(portName) {
+			return name, true
+		}
+	}
+	return "", false
+}
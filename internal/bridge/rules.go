@@ -0,0 +1,144 @@
+package bridge
+
+import (
+	"fmt"
+	"regexp"
+	"sync/atomic"
+	"time"
+)
+
+// Action is what a rule does with the data it matches
+type Action string
+
+// Rule actions
+const (
+	// ActionDrop removes the matched bytes
+	ActionDrop Action = "drop"
+	// ActionReplace replaces the matched bytes with Data, expanding $1 and
+	// ${name} to the pattern's groups
+	ActionReplace Action = "replace"
+	// ActionDelay holds the data containing a match for Delay
+	ActionDelay Action = "delay"
+	// ActionInject inserts Data after the matched bytes
+	ActionInject Action = "inject"
+)
+
+// Direction selects the data a rule applies to
+type Direction string
+
+// Rule directions
+const (
+	// DirectionBoth applies a rule to the data of both sides
+	DirectionBoth Direction = ""
+	// DirectionAToB applies a rule to the data from A
+	DirectionAToB Direction = "a_to_b"
+	// DirectionBToA applies a rule to the data from B
+	DirectionBToA Direction = "b_to_a"
+)
+
+// maxRuleDelay bounds the delay of a rule
+const maxRuleDelay = time.Minute
+
+// Rule intercepts the forwarded data matching Pattern. Patterns are
+// matched within each chunk read from a port, so a match split across two
+// reads is missed.
+type Rule struct {
+	Direction Direction
+	Pattern   *regexp.Regexp
+	Action    Action
+	// Data is the replacement (replace) or the inserted bytes (inject)
+	Data []byte
+	// Delay is how long matching data is held (delay)
+	Delay time.Duration
+}
+
+// Validate checks that a rule is complete
+func (r Rule) Validate() error {
+	switch r.Direction {
+	case DirectionBoth, DirectionAToB, DirectionBToA:
+	default:
+		return fmt.Errorf("%w: unknown direction %q", ErrInvalid, r.Direction)
+	}
+	if r.Pattern == nil {
+		return fmt.Errorf("%w: rule pattern is required", ErrInvalid)
+	}
+	if r.Pattern.MatchString("") {
+		return fmt.Errorf("%w: rule pattern %q matches empty data", ErrInvalid, r.Pattern)
+	}
+
+	switch r.Action {
+	case ActionDrop, ActionReplace:
+	case ActionDelay:
+		if r.Delay <= 0 || r.Delay > maxRuleDelay {
+			return fmt.Errorf("%w: delay must be between 1ms and %s", ErrInvalid, maxRuleDelay)
+		}
+	case ActionInject:
+		if len(r.Data) == 0 {
+			return fmt.Errorf("%w: inject needs data", ErrInvalid)
+		}
+	default:
+		return fmt.Errorf("%w: unknown action %q", ErrInvalid, r.Action)
+	}
+	return nil
+}
+
+// RuleStats is a rule and the number of matches it intercepted
+type RuleStats struct {
+	Rule
+	Hits uint64
+}
+
+// ruleSet is the rules of a bridge, applied in order
+type ruleSet struct {
+	rules []Rule
+	hits  []atomic.Uint64
+}
+
+// newRuleSet validates rules
+func newRuleSet(rules []Rule) (*ruleSet, error) {
+	for i, rule := range rules {
+		if err := rule.Validate(); err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+	}
+	return &ruleSet{rules: rules, hits: make([]atomic.Uint64, len(rules))}, nil
+}
+
+// apply runs the rules of a direction over data. It returns the data to
+// forward, possibly empty, and how long to hold it first.
+func (rs *ruleSet) apply(direction Direction, data []byte) ([]byte, time.Duration) {
+	var delay time.Duration
+	for i, rule := range rs.rules {
+		if rule.Direction != DirectionBoth && rule.Direction != direction {
+			continue
+		}
+		matches := len(rule.Pattern.FindAllIndex(data, -1))
+		if matches == 0 {
+			continue
+		}
+		rs.hits[i].Add(uint64(matches))
+
+		switch rule.Action {
+		case ActionDrop:
+			data = rule.Pattern.ReplaceAllLiteral(data, nil)
+		case ActionReplace:
+			data = rule.Pattern.ReplaceAll(data, rule.Data)
+		case ActionDelay:
+			delay += rule.Delay
+		case ActionInject:
+			data = rule.Pattern.ReplaceAllFunc(data, func(match []byte) []byte {
+				return append(append([]byte(nil), match...), rule.Data...)
+			})
+		}
+	}
+	return data, delay
+}
+
+// stats returns the rules with their hit counts
+func (rs *ruleSet) stats() []RuleStats {
+	stats := make([]RuleStats, len(rs.rules))
+	for i, rule := range rs.rules {
+		stats[i] = RuleStats{Rule: rule, Hits: rs.hits[i].Load()}
+	}
+	return stats
+}
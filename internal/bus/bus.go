@@ -0,0 +1,107 @@
+// Package bus provides a provider model for non-UART buses such as I2C and
+// SPI, so that bridge adapters (USB-I2C, USB-SPI, host controllers) can be
+// exposed by the agent next to serial ports.
+package bus
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Type identifies a bus type
+type Type string
+
+// Supported bus types
+const (
+	TypeI2C Type = "i2c"
+	TypeSPI Type = "spi"
+)
+
+// Common errors for bus operations
+var (
+	// ErrDeviceNotFound is returned when no provider exposes a device
+	ErrDeviceNotFound = errors.New("bus device not found")
+
+	// ErrWrongBusType is returned when a transfer targets a device of another bus type
+	ErrWrongBusType = errors.New("device is not on the requested bus type")
+
+	// ErrInvalidTransfer is returned when transfer parameters are out of range
+	ErrInvalidTransfer = errors.New("invalid bus transfer")
+
+	// ErrUnsupported is returned when a provider cannot run on this platform
+	ErrUnsupported = errors.New("bus provider is not supported on this platform")
+)
+
+// DeviceInfo describes a bus device exposed by a provider
+type DeviceInfo struct {
+	// Name is unique within the provider, e.g. "i2c-1" or "spidev0.0"
+	Name        string
+	Type        Type
+	Description string
+}
+
+// SPIConfig holds per-transfer SPI settings; zero values keep the device defaults
+type SPIConfig struct {
+	Mode        uint8
+	SpeedHz     uint32
+	BitsPerWord uint8
+}
+
+// Provider exposes the devices of one bridge or host controller family.
+// Transfers on a single device are serialized by the Registry.
+type Provider interface {
+	// Name identifies the provider and prefixes its device names
+	Name() string
+
+	// Devices lists the devices currently available
+	Devices() ([]DeviceInfo, error)
+
+	// I2CTransfer writes to an I2C target and then reads readLen bytes
+	// in one combined transaction (repeated start)
+	I2CTransfer(device string, addr uint16, write []byte, readLen int) ([]byte, error)
+
+	// SPITransfer clocks tx out and returns the bytes received at the same time
+	SPITransfer(device string, cfg SPIConfig, tx []byte) ([]byte, error)
+}
+
+// Factory creates a provider
+type Factory func() (Provider, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = make(map[string]Factory)
+)
+
+// RegisterProvider makes a provider available by name for configuration
+func RegisterProvider(name string, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	factories[name] = factory
+}
+
+// ProviderNames returns the names of all registered providers
+func ProviderNames() []string {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewProvider creates a registered provider by name
+func NewProvider(name string) (Provider, error) {
+	factoriesMu.RLock()
+	factory, ok := factories[name]
+	factoriesMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown bus provider %q", name)
+	}
+	return factory()
+}
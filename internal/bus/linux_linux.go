@@ -0,0 +1,174 @@
+//go:build linux
+
+package bus
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// i2c-dev and spidev uAPI, see include/uapi/linux/i2c-dev.h and spi/spidev.h
+const (
+	i2cRdwr       = 0x0707
+	i2cMsgRead    = 0x0001
+	i2cMsgTenBit  = 0x0010
+	spiIocMessage = 0x40206b00 // SPI_IOC_MESSAGE(1)
+	spiIocWrMode  = 0x40016b01 // SPI_IOC_WR_MODE
+)
+
+// i2cMsg mirrors struct i2c_msg
+type i2cMsg struct {
+	Addr  uint16
+	Flags uint16
+	Len   uint16
+	Buf   unsafe.Pointer
+}
+
+// i2cRdwrData mirrors struct i2c_rdwr_ioctl_data
+type i2cRdwrData struct {
+	Msgs  unsafe.Pointer
+	Nmsgs uint32
+}
+
+// spiTransfer mirrors struct spi_ioc_transfer
+type spiTransfer struct {
+	TxBuf          uint64
+	RxBuf          uint64
+	Len            uint32
+	SpeedHz        uint32
+	DelayUsecs     uint16
+	BitsPerWord    uint8
+	CsChange       uint8
+	TxNbits        uint8
+	RxNbits        uint8
+	WordDelayUsecs uint8
+	Pad            uint8
+}
+
+type linuxProvider struct {
+	devDir string
+	sysDir string
+}
+
+func newLinuxProvider() (Provider, error) {
+	return &linuxProvider{devDir: "/dev", sysDir: "/sys/class"}, nil
+}
+
+func (p *linuxProvider) Name() string {
+	return linuxProviderName
+}
+
+func (p *linuxProvider) Devices() ([]DeviceInfo, error) {
+	var devices []DeviceInfo
+
+	i2c, err := filepath.Glob(filepath.Join(p.devDir, "i2c-*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range i2c {
+		name := filepath.Base(path)
+		devices = append(devices, DeviceInfo{
+			Name:        name,
+			Type:        TypeI2C,
+			Description: p.adapterName(name),
+		})
+	}
+
+	spi, err := filepath.Glob(filepath.Join(p.devDir, "spidev*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range spi {
+		name := filepath.Base(path)
+		devices = append(devices, DeviceInfo{
+			Name:        name,
+			Type:        TypeSPI,
+			Description: "SPI bus " + strings.TrimPrefix(name, "spidev"),
+		})
+	}
+
+	return devices, nil
+}
+
+// adapterName reads the I2C adapter name, which identifies USB bridges
+func (p *linuxProvider) adapterName(name string) string {
+	data, err := os.ReadFile(filepath.Join(p.sysDir, "i2c-dev", name, "name"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func (p *linuxProvider) I2CTransfer(device string, addr uint16, write []byte, readLen int) ([]byte, error) {
+	f, err := os.OpenFile(filepath.Join(p.devDir, device), os.O_RDWR, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", device, err)
+	}
+	defer f.Close()
+
+	var flags uint16
+	if addr > 0x7f {
+		flags |= i2cMsgTenBit
+	}
+
+	msgs := make([]i2cMsg, 0, 2)
+	if len(write) > 0 {
+		msgs = append(msgs, i2cMsg{Addr: addr, Flags: flags, Len: uint16(len(write)), Buf: unsafe.Pointer(&write[0])})
+	}
+	var read []byte
+	if readLen > 0 {
+		read = make([]byte, readLen)
+		msgs = append(msgs, i2cMsg{Addr: addr, Flags: flags | i2cMsgRead, Len: uint16(readLen), Buf: unsafe.Pointer(&read[0])})
+	}
+
+	data := i2cRdwrData{Msgs: unsafe.Pointer(&msgs[0]), Nmsgs: uint32(len(msgs))}
+	err = ioctl(f.Fd(), i2cRdwr, unsafe.Pointer(&data))
+	runtime.KeepAlive(msgs)
+	runtime.KeepAlive(write)
+	if err != nil {
+		return nil, fmt.Errorf("I2C transfer to 0x%02x on %s failed: %w", addr, device, err)
+	}
+	return read, nil
+}
+
+func (p *linuxProvider) SPITransfer(device string, cfg SPIConfig, tx []byte) ([]byte, error) {
+	f, err := os.OpenFile(filepath.Join(p.devDir, device), os.O_RDWR, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", device, err)
+	}
+	defer f.Close()
+
+	mode := cfg.Mode
+	if err := ioctl(f.Fd(), spiIocWrMode, unsafe.Pointer(&mode)); err != nil {
+		return nil, fmt.Errorf("failed to set SPI mode on %s: %w", device, err)
+	}
+
+	rx := make([]byte, len(tx))
+	xfer := spiTransfer{
+		TxBuf:       uint64(uintptr(unsafe.Pointer(&tx[0]))),
+		RxBuf:       uint64(uintptr(unsafe.Pointer(&rx[0]))),
+		Len:         uint32(len(tx)),
+		SpeedHz:     cfg.SpeedHz,
+		BitsPerWord: cfg.BitsPerWord,
+	}
+	err = ioctl(f.Fd(), spiIocMessage, unsafe.Pointer(&xfer))
+	runtime.KeepAlive(tx)
+	runtime.KeepAlive(rx)
+	if err != nil {
+		return nil, fmt.Errorf("SPI transfer on %s failed: %w", device, err)
+	}
+	return rx, nil
+}
+
+func ioctl(fd uintptr, request uintptr, arg unsafe.Pointer) error {
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, fd, request, uintptr(arg)); errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+//go:build !linux
+
+package bus
+
+func newLinuxProvider() (Provider, error) {
+	return nil, ErrUnsupported
+}
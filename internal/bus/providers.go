@@ -0,0 +1,10 @@
+package bus
+
+func init() {
+	RegisterProvider(linuxProviderName, newLinuxProvider)
+}
+
+// linuxProviderName is the reference provider backed by the Linux i2c-dev
+// and spidev interfaces, which also covers USB bridges with kernel drivers
+// (e.g. CH341, CP2112, MCP2221, FT260)
+const linuxProviderName = "linux"
@@ -0,0 +1,160 @@
+package bus
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// MaxTransferSize is the largest transfer accepted in either direction
+const MaxTransferSize = 4096
+
+// Device is a bus device qualified with its provider
+type Device struct {
+	DeviceInfo
+	// Provider is the name of the provider exposing the device
+	Provider string
+}
+
+// QualifiedName returns the name clients use, "<provider>:<device>"
+func (d Device) QualifiedName() string {
+	return d.Provider + ":" + d.Name
+}
+
+// Registry holds the configured providers and serializes access per device
+type Registry struct {
+	mu        sync.RWMutex
+	providers map[string]Provider
+	order     []string
+
+	locksMu sync.Mutex
+	locks   map[string]*sync.Mutex
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		providers: make(map[string]Provider),
+		locks:     make(map[string]*sync.Mutex),
+	}
+}
+
+// Add adds a provider to the registry
+func (r *Registry) Add(p Provider) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.providers[p.Name()]; exists {
+		return fmt.Errorf("bus provider %q is already registered", p.Name())
+	}
+	r.providers[p.Name()] = p
+	r.order = append(r.order, p.Name())
+	return nil
+}
+
+// Devices lists the devices of every provider, optionally filtered by type
+func (r *Registry) Devices(filter Type) ([]Device, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var devices []Device
+	for _, name := range r.order {
+		infos, err := r.providers[name].Devices()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s devices: %w", name, err)
+		}
+		for _, info := range infos {
+			if filter != "" && info.Type != filter {
+				continue
+			}
+			devices = append(devices, Device{DeviceInfo: info, Provider: name})
+		}
+	}
+	return devices, nil
+}
+
+// I2CTransfer runs a combined write/read transaction on an I2C device
+func (r *Registry) I2CTransfer(name string, addr uint16, write []byte, readLen int) ([]byte, error) {
+	if addr > 0x3ff {
+		return nil, fmt.Errorf("%w: address 0x%x out of range", ErrInvalidTransfer, addr)
+	}
+	if len(write) == 0 && readLen == 0 {
+		return nil, fmt.Errorf("%w: nothing to write or read", ErrInvalidTransfer)
+	}
+	if len(write) > MaxTransferSize || readLen < 0 || readLen > MaxTransferSize {
+		return nil, fmt.Errorf("%w: transfers are limited to %d bytes", ErrInvalidTransfer, MaxTransferSize)
+	}
+
+	provider, device, err := r.resolve(name, TypeI2C)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := r.lockDevice(name)
+	defer unlock()
+
+	return provider.I2CTransfer(device, addr, write, readLen)
+}
+
+// SPITransfer runs a full-duplex transfer on an SPI device
+func (r *Registry) SPITransfer(name string, cfg SPIConfig, tx []byte) ([]byte, error) {
+	if len(tx) == 0 || len(tx) > MaxTransferSize {
+		return nil, fmt.Errorf("%w: transfers must be 1 to %d bytes", ErrInvalidTransfer, MaxTransferSize)
+	}
+	if cfg.Mode > 3 {
+		return nil, fmt.Errorf("%w: SPI mode must be 0-3", ErrInvalidTransfer)
+	}
+
+	provider, device, err := r.resolve(name, TypeSPI)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := r.lockDevice(name)
+	defer unlock()
+
+	return provider.SPITransfer(device, cfg, tx)
+}
+
+// resolve finds the provider of a qualified device name and checks its type
+func (r *Registry) resolve(name string, want Type) (Provider, string, error) {
+	providerName, device, ok := strings.Cut(name, ":")
+	if !ok {
+		return nil, "", fmt.Errorf("%w: %s (expected provider:device)", ErrDeviceNotFound, name)
+	}
+
+	r.mu.RLock()
+	provider, ok := r.providers[providerName]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, "", fmt.Errorf("%w: %s", ErrDeviceNotFound, name)
+	}
+
+	infos, err := provider.Devices()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list %s devices: %w", providerName, err)
+	}
+	for _, info := range infos {
+		if info.Name != device {
+			continue
+		}
+		if info.Type != want {
+			return nil, "", fmt.Errorf("%w: %s is %s", ErrWrongBusType, name, info.Type)
+		}
+		return provider, device, nil
+	}
+	return nil, "", fmt.Errorf("%w: %s", ErrDeviceNotFound, name)
+}
+
+func (r *Registry) lockDevice(name string) func() {
+	r.locksMu.Lock()
+	lock, ok := r.locks[name]
+	if !ok {
+		lock = &sync.Mutex{}
+		r.locks[name] = lock
+	}
+	r.locksMu.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
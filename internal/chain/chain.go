@@ -0,0 +1,207 @@
+// Package chain writes log records into a hash chain with periodic signed
+// checkpoints, so that anyone holding the public key can later verify that
+// a recorded device conversation was not altered, reordered or cut short.
+//
+// Each record is one JSON line. Its hash is the hex SHA-256 of the
+// previous record's hash (64 zeros for the first record of a chain)
+// followed by seq, time, port, kind and line, each as a 4-byte big-endian
+// length and the field's bytes (seq in decimal). A checkpoint is a record
+// whose signature is the Ed25519 signature of its raw hash; as the hash
+// covers every record before it, one signature vouches for all of them.
+package chain
+
+import (
+	"bufio"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Genesis is the previous hash of the first record of a chain
+var Genesis = strings.Repeat("0", 64)
+
+// ErrBroken is returned when a file fails verification
+var ErrBroken = errors.New("hash chain broken")
+
+// Record kinds
+const (
+	KindLine       = "line"
+	KindCheckpoint = "checkpoint"
+)
+
+// maxRecordSize bounds a record line when reading
+const maxRecordSize = 1 << 20
+
+// Record is one line of a chained file
+type Record struct {
+	Seq  uint64 `json:"seq"`
+	Time string `json:"time"`
+	Port string `json:"port"`
+	Kind string `json:"kind"`
+	Line string `json:"line,omitempty"`
+	Prev string `json:"prev"`
+	Hash string `json:"hash"`
+	// Signature is set on checkpoints, base64-encoded
+	Signature string `json:"signature,omitempty"`
+}
+
+// digest computes the hash of a record from its fields
+func (r *Record) digest() []byte {
+	h := sha256.New()
+	h.Write([]byte(r.Prev))
+	for _, field := range []string{strconv.FormatUint(r.Seq, 10), r.Time, r.Port, r.Kind, r.Line} {
+		var size [4]byte
+		binary.BigEndian.PutUint32(size[:], uint32(len(field)))
+		h.Write(size[:])
+		h.Write([]byte(field))
+	}
+	return h.Sum(nil)
+}
+
+// Chain appends records to a chain. It is not safe for concurrent use.
+type Chain struct {
+	key  ed25519.PrivateKey
+	seq  uint64
+	head string
+	// unsigned counts the records since the last checkpoint
+	unsigned int
+}
+
+// New continues a chain after the record with seq and hash head; use 0 and
+// Genesis to start one
+func New(key ed25519.PrivateKey, seq uint64, head string) *Chain {
+	return &Chain{key: key, seq: seq, head: head}
+}
+
+// Line returns the encoded record of a line
+func (c *Chain) Line(t time.Time, port, line string) []byte {
+	c.unsigned++
+	return c.append(Record{Time: t.Format(time.RFC3339Nano), Port: port, Kind: KindLine, Line: line}, false)
+}
+
+// Checkpoint returns an encoded, signed checkpoint, or nil when every
+// record is signed already
+func (c *Chain) Checkpoint(t time.Time, port string) []byte {
+	if c.unsigned == 0 {
+		return nil
+	}
+	c.unsigned = 0
+	return c.append(Record{Time: t.Format(time.RFC3339Nano), Port: port, Kind: KindCheckpoint}, true)
+}
+
+// Unsigned returns the number of records since the last checkpoint
+func (c *Chain) Unsigned() int {
+	return c.unsigned
+}
+
+// append links a record to the chain and encodes it
+func (c *Chain) append(r Record, sign bool) []byte {
+	c.seq++
+	r.Seq = c.seq
+	r.Prev = c.head
+	digest := r.digest()
+	r.Hash = hex.EncodeToString(digest)
+	if sign {
+		r.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(c.key, digest))
+	}
+	c.head = r.Hash
+
+	line, _ := json.Marshal(r)
+	return append(line, '\n')
+}
+
+// Last returns the last complete record of a chained file, false when it
+// holds none. complete is false when the file ends in something other than
+// a record, e.g. a line cut short by a crash, so that appending to it
+// would break verification.
+func Last(r io.Reader) (last Record, found, complete bool, err error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxRecordSize)
+	complete = true
+	for scanner.Scan() {
+		var record Record
+		if json.Unmarshal(scanner.Bytes(), &record) != nil || record.Hash == "" {
+			complete = false
+			continue
+		}
+		last, found, complete = record, true, true
+	}
+	return last, found, complete, scanner.Err()
+}
+
+// Result summarizes a verified file
+type Result struct {
+	Records     int
+	Checkpoints int
+	// Unsigned counts the records after the last checkpoint, which no
+	// signature vouches for yet
+	Unsigned int
+	FirstSeq uint64
+	LastSeq  uint64
+	// Prev is the hash the file's first record continues from
+	Prev string
+	// Head is the hash of the file's last record
+	Head string
+}
+
+// Verify checks every record of a chained file and the signature of every
+// checkpoint. A non-empty prev requires the file to continue from that
+// hash, as when verifying rotated files in order. It fails with ErrBroken
+// at the first record that does not check out.
+func Verify(r io.Reader, key ed25519.PublicKey, prev string) (Result, error) {
+	var result Result
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxRecordSize)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return result, fmt.Errorf("%w: line %d is not a record: %v", ErrBroken, lineNo, err)
+		}
+
+		if result.Records == 0 {
+			result.FirstSeq = record.Seq
+			result.Prev = record.Prev
+		} else if record.Seq != result.LastSeq+1 {
+			return result, fmt.Errorf("%w: line %d has seq %d after %d", ErrBroken, lineNo, record.Seq, result.LastSeq)
+		}
+		if prev != "" && record.Prev != prev {
+			return result, fmt.Errorf("%w: line %d (seq %d) does not follow the previous record", ErrBroken, lineNo, record.Seq)
+		}
+		digest := record.digest()
+		if hex.EncodeToString(digest) != record.Hash {
+			return result, fmt.Errorf("%w: line %d (seq %d) was altered", ErrBroken, lineNo, record.Seq)
+		}
+
+		switch record.Kind {
+		case KindLine:
+			result.Unsigned++
+		case KindCheckpoint:
+			signature, err := base64.StdEncoding.DecodeString(record.Signature)
+			if err != nil || !ed25519.Verify(key, digest, signature) {
+				return result, fmt.Errorf("%w: line %d (seq %d) has an invalid signature", ErrBroken, lineNo, record.Seq)
+			}
+			result.Checkpoints++
+			result.Unsigned = 0
+		default:
+			return result, fmt.Errorf("%w: line %d has unknown kind %q", ErrBroken, lineNo, record.Kind)
+		}
+
+		result.Records++
+		result.LastSeq = record.Seq
+		result.Head = record.Hash
+		prev = record.Hash
+	}
+	if err := scanner.Err(); err != nil {
+		return result, fmt.Errorf("failed to read: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,80 @@
+// Package clock abstracts the passing of time, so write deadlines,
+// coalescing windows and reservation windows can be driven by a fake clock
+// in tests instead of waiting on the wall clock.
+package clock
+
+import "time"
+
+// Clock tells the time and schedules timers
+type Clock interface {
+	// Now returns the current time
+	Now() time.Time
+	// NewTimer returns a timer sending the time on its channel after d
+	NewTimer(d time.Duration) Timer
+	// AfterFunc calls f after d; the timer's channel is nil
+	AfterFunc(d time.Duration, f func()) Timer
+	// NewTicker returns a ticker sending the time on its channel every d
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is a single event of a Clock
+type Timer interface {
+	// C returns the channel the time is sent on, nil for AfterFunc timers
+	C() <-chan time.Time
+	// Stop prevents the timer from firing. It reports whether the timer
+	// was stopped before firing.
+	Stop() bool
+}
+
+// Ticker is a repeating event of a Clock
+type Ticker interface {
+	// C returns the channel the ticks are sent on
+	C() <-chan time.Time
+	// Stop turns the ticker off
+	Stop()
+}
+
+// System is the wall clock
+var System Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) Timer {
+	return systemTimer{time.AfterFunc(d, f)}
+}
+
+func (systemClock) NewTicker(d time.Duration) Ticker {
+	return systemTicker{time.NewTicker(d)}
+}
+
+type systemTimer struct {
+	t *time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.t.C
+}
+
+func (t systemTimer) Stop() bool {
+	return t.t.Stop()
+}
+
+type systemTicker struct {
+	t *time.Ticker
+}
+
+func (t systemTicker) C() <-chan time.Time {
+	return t.t.C
+}
+
+func (t systemTicker) Stop() {
+	t.t.Stop()
+}
@@ -0,0 +1,156 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Fake is a Clock that only moves when told to. Timers fire during
+// Advance, AfterFunc callbacks in the calling goroutine, so once Advance
+// returns every event up to the new time has happened.
+type Fake struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	now    time.Time
+	events []*fakeEvent
+}
+
+// fakeEvent is a pending timer or ticker of a Fake
+type fakeEvent struct {
+	clock  *Fake
+	at     time.Time
+	period time.Duration
+	c      chan time.Time
+	f      func()
+}
+
+// NewFake returns a fake clock set to now
+func NewFake(now time.Time) *Fake {
+	c := &Fake{now: now}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+// Now returns the fake time
+func (c *Fake) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTimer returns a timer firing once the clock reaches now+d
+func (c *Fake) NewTimer(d time.Duration) Timer {
+	return c.schedule(d, 0, nil)
+}
+
+// AfterFunc calls f once the clock reaches now+d
+func (c *Fake) AfterFunc(d time.Duration, f func()) Timer {
+	return c.schedule(d, 0, f)
+}
+
+// NewTicker returns a ticker firing every d of fake time
+func (c *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("clock: non-positive interval for NewTicker")
+	}
+	return fakeTicker{c.schedule(d, d, nil)}
+}
+
+// schedule adds an event at now+d, repeating every period unless zero
+func (c *Fake) schedule(d, period time.Duration, f func()) *fakeEvent {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := &fakeEvent{clock: c, at: c.now.Add(d), period: period, f: f}
+	if f == nil {
+		e.c = make(chan time.Time, 1)
+	}
+	c.events = append(c.events, e)
+	c.cond.Broadcast()
+	return e
+}
+
+// Advance moves the clock forward by d, firing the events due on the way
+// in time order
+func (c *Fake) Advance(d time.Duration) {
+	c.mu.Lock()
+	target := c.now.Add(d)
+	for {
+		e := c.nextLocked(target)
+		if e == nil {
+			break
+		}
+		c.now = e.at
+		if e.period > 0 {
+			e.at = e.at.Add(e.period)
+		} else {
+			c.removeLocked(e)
+		}
+
+		if e.f != nil {
+			c.mu.Unlock()
+			e.f()
+			c.mu.Lock()
+			continue
+		}
+		// Like time.Timer, a tick nobody received yet is dropped
+		select {
+		case e.c <- c.now:
+		default:
+		}
+	}
+	c.now = target
+	c.mu.Unlock()
+}
+
+// BlockUntil waits until n timers and tickers are pending, so a test can
+// advance the clock once the code under test has started waiting
+func (c *Fake) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.events) < n {
+		c.cond.Wait()
+	}
+}
+
+// nextLocked returns the earliest event due by target, or nil (lock held)
+func (c *Fake) nextLocked(target time.Time) *fakeEvent {
+	var next *fakeEvent
+	for _, e := range c.events {
+		if !e.at.After(target) && (next == nil || e.at.Before(next.at)) {
+			next = e
+		}
+	}
+	return next
+}
+
+// removeLocked drops a pending event, reporting whether it was pending
+// (lock held)
+func (c *Fake) removeLocked(e *fakeEvent) bool {
+	for i, other := range c.events {
+		if other == e {
+			c.events = append(c.events[:i], c.events[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (e *fakeEvent) C() <-chan time.Time {
+	return e.c
+}
+
+func (e *fakeEvent) Stop() bool {
+	e.clock.mu.Lock()
+	defer e.clock.mu.Unlock()
+	return e.clock.removeLocked(e)
+}
+
+// fakeTicker is a repeating fakeEvent
+type fakeTicker struct {
+	*fakeEvent
+}
+
+func (t fakeTicker) Stop() {
+	t.fakeEvent.Stop()
+}
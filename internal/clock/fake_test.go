@@ -0,0 +1,55 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeFiresEventsInOrder(t *testing.T) {
+	c := NewFake(time.Unix(0, 0))
+	var fired []string
+	c.AfterFunc(3*time.Second, func() { fired = append(fired, "late") })
+	c.AfterFunc(time.Second, func() { fired = append(fired, "early") })
+	stopped := c.AfterFunc(2*time.Second, func() { fired = append(fired, "stopped") })
+	if !stopped.Stop() {
+		t.Fatal("Stop of a pending timer reported false")
+	}
+
+	c.Advance(2 * time.Second)
+	if len(fired) != 1 || fired[0] != "early" {
+		t.Fatalf("fired %v after 2s, want [early]", fired)
+	}
+	c.Advance(time.Second)
+	if len(fired) != 2 || fired[1] != "late" {
+		t.Fatalf("fired %v after 3s, want [early late]", fired)
+	}
+}
+
+func TestFakeTimerAndTicker(t *testing.T) {
+	c := NewFake(time.Unix(0, 0))
+	timer := c.NewTimer(time.Second)
+	ticker := c.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	c.Advance(500 * time.Millisecond)
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired early")
+	default:
+	}
+
+	c.Advance(3 * time.Second)
+	if at := <-timer.C(); !at.Equal(time.Unix(1, 0)) {
+		t.Fatalf("timer fired at %v, want %v", at, time.Unix(1, 0))
+	}
+	// Ticks nobody received are dropped, as with time.Ticker
+	<-ticker.C()
+	select {
+	case <-ticker.C():
+		t.Fatal("ticker kept more than one tick")
+	default:
+	}
+	if timer.Stop() {
+		t.Fatal("Stop of a fired timer reported true")
+	}
+}
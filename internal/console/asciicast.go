@@ -0,0 +1,216 @@
+package console
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Shoaibashk/SerialLink/internal/redact"
+)
+
+// Asciicast event types
+const (
+	castOutput = "o"
+	castInput  = "i"
+)
+
+// RecordingOptions configures asciicast recording of interactive sessions
+type RecordingOptions struct {
+	Directory string
+	// Always records every interactive session; otherwise clients opt in
+	Always bool
+	// Upload is called with each finished recording when set
+	Upload func(path string)
+}
+
+// castHeader is the first line of an asciicast v2 file
+type castHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// Recorder writes a terminal session in asciinema's asciicast v2 format so
+// it can be replayed with asciinema-player. All methods are safe on a nil
+// Recorder, which records nothing.
+type Recorder struct {
+	mu      sync.Mutex
+	path    string
+	file    *os.File
+	w       *bufio.Writer
+	start   time.Time
+	pending map[string][]byte
+	// redactor masks secrets before they are recorded
+	redactor *redact.Redactor
+}
+
+// Header environment variables naming the session of a recording
+const (
+	castEnvUser    = "SERIALLINK_USER"
+	castEnvPort    = "SERIALLINK_PORT"
+	castEnvSession = "SERIALLINK_SESSION"
+)
+
+// NewRecorder creates a recording at path of a session on a port, by a
+// user, for a terminal of the given size
+func NewRecorder(path, user, portName, sessionID string, width, height int) (*Recorder, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create recordings directory: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create recording: %w", err)
+	}
+
+	r := &Recorder{
+		path:    path,
+		file:    file,
+		w:       bufio.NewWriter(file),
+		start:   time.Now(),
+		pending: make(map[string][]byte),
+	}
+
+	header, err := json.Marshal(castHeader{
+		Version:   2,
+		Width:     width,
+		Height:    height,
+		Timestamp: r.start.Unix(),
+		Title:     portName,
+		Env: map[string]string{
+			"TERM":         "xterm-256color",
+			castEnvUser:    user,
+			castEnvPort:    portName,
+			castEnvSession: sessionID,
+		},
+	})
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	// Written through at once so the recording is listed while it runs
+	r.w.Write(append(header, '\n'))
+	if err := r.w.Flush(); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// RecordingPath builds a unique recording file name for a port session in
+// the directory of the user
+func RecordingPath(dir, user, portName, sessionID string) string {
+	name := fmt.Sprintf("%s-%s-%s.cast",
+		unsafeFileChars.ReplaceAllString(trimPortPrefix(portName), "_"),
+		time.Now().Format("20060102T150405"),
+		sessionID)
+	return filepath.Join(dir, userDir(user), name)
+}
+
+// userDir derives a directory name from a user identity, e.g.
+// "spiffe://example.org/ops/alice" becomes "spiffe_example.org_ops_alice"
+func userDir(user string) string {
+	name := strings.Trim(unsafeFileChars.ReplaceAllString(user, "_"), "_.")
+	if name == "" {
+		return "anonymous"
+	}
+	return name
+}
+
+// Output records data received from the device
+func (r *Recorder) Output(data []byte) {
+	r.event(castOutput, data)
+}
+
+// Input records data typed by the user
+func (r *Recorder) Input(data []byte) {
+	r.event(castInput, data)
+}
+
+// event appends one timed event. Multi-byte UTF-8 characters split across
+// chunks are held back until complete so escape sequences replay intact.
+func (r *Recorder) event(kind string, data []byte) {
+	if r == nil || len(data) == 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	buf := append(r.pending[kind], r.redactor.Apply(data)...)
+	cut := completeUTF8(buf)
+	r.pending[kind] = append([]byte(nil), buf[cut:]...)
+	if cut == 0 {
+		return
+	}
+
+	line, err := json.Marshal([]interface{}{
+		time.Since(r.start).Seconds(),
+		kind,
+		string(buf[:cut]),
+	})
+	if err != nil {
+		return
+	}
+	r.w.Write(append(line, '\n'))
+}
+
+// SetRedactor masks secrets in the data recorded from now on
+func (r *Recorder) SetRedactor(redactor *redact.Redactor) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.redactor = redactor
+}
+
+// Path returns the recording file
+func (r *Recorder) Path() string {
+	if r == nil {
+		return ""
+	}
+	return r.path
+}
+
+// Close flushes and closes the recording
+func (r *Recorder) Close() error {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.w.Flush(); err != nil {
+		r.file.Close()
+		return err
+	}
+	return r.file.Close()
+}
+
+// completeUTF8 returns the length of buf without a trailing incomplete
+// UTF-8 sequence
+func completeUTF8(buf []byte) int {
+	// A UTF-8 sequence is at most 4 bytes, so only the tail needs checking
+	for i := len(buf) - 1; i >= 0 && i >= len(buf)-utf8.UTFMax; i-- {
+		if !utf8.RuneStart(buf[i]) {
+			continue
+		}
+		if utf8.FullRune(buf[i:]) {
+			return len(buf)
+		}
+		return i
+	}
+	return len(buf)
+}
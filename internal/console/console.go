@@ -0,0 +1,629 @@
+// Package console collects device console output into timestamped, rotated
+// log files, independent of any connected client.
+package console
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/chain"
+	"github.com/Shoaibashk/SerialLink/internal/dedup"
+	"github.com/Shoaibashk/SerialLink/internal/redact"
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/charmbracelet/log"
+)
+
+// ClientID identifies sessions opened by the console logger
+const ClientID = "console"
+
+const (
+	// retryInterval is the delay before reopening a port that went away
+	retryInterval = 5 * time.Second
+
+	// maxReadErrors is how many consecutive read errors end a session,
+	// e.g. when a USB adapter is unplugged
+	maxReadErrors = 10
+
+	// maxLineLength bounds a line before it is written without a newline
+	maxLineLength = 4096
+
+	// defaultCheckpointInterval applies when compliance sets no interval
+	defaultCheckpointInterval = time.Minute
+
+	// repeatCheckInterval is how often a run of repeated lines is checked
+	// against Options.DedupInterval
+	repeatCheckInterval = time.Second
+)
+
+// Log line formats
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Options configures console logging for one port
+type Options struct {
+	PortName string
+	Config   serial.PortConfig
+	// Path is the log file; rotated files get a .1, .2, ... suffix
+	Path       string
+	MaxSize    int64
+	MaxBackups int
+	Format     string
+	// ShipURL receives each rotated file as an HTTP POST when set
+	ShipURL string
+	// Upload is called with each rotated file when set
+	Upload func(path string)
+	// BufferSize is how many recent bytes are kept in memory for late joiners
+	BufferSize int
+	// Redactor masks secrets in the log lines and recent output
+	Redactor *redact.Redactor
+	// Compliance writes the log as a hash chain with signed checkpoints in
+	// place of Format
+	Compliance *ComplianceOptions
+	// Dedup logs the repeats of a line as one entry with a count; it does
+	// not apply with Compliance
+	Dedup bool
+	// DedupInterval is how often a run still going on is logged (0: only
+	// when it ends)
+	DedupInterval time.Duration
+	// Keywords counts the lines holding keywords
+	Keywords KeywordOptions
+	// CrashDumps saves the output around crash patterns when set
+	CrashDumps *CrashOptions
+}
+
+// ComplianceOptions make console logs verifiable (see package chain). A
+// file is rotated only after a checkpoint, so each ends signed, and
+// rotated files are made read-only.
+type ComplianceOptions struct {
+	Key ed25519.PrivateKey
+	// CheckpointInterval is how often new records are signed
+	CheckpointInterval time.Duration
+}
+
+// Logger continuously records the output of one port
+type Logger struct {
+	manager *serial.Manager
+	opts    Options
+	logger  *log.Logger
+
+	file   *rotatingFile
+	recent *ringBuffer
+	// mu guards file writes, chain and dedup, which checkpoints and repeat
+	// reports share with lines
+	mu        sync.Mutex
+	chain     *chain.Chain
+	dedup     *dedup.Filter
+	partial   []byte
+	lineStart time.Time
+
+	keywords *keywordCounter
+
+	crashMu sync.Mutex
+	crash   *crashCapture
+	crashes atomic.Uint64
+}
+
+// NewLogger creates a console logger for a port
+func NewLogger(manager *serial.Manager, opts Options, logger *log.Logger) *Logger {
+	if opts.Format == "" {
+		opts.Format = FormatText
+	}
+	l := &Logger{
+		manager:  manager,
+		opts:     opts,
+		logger:   logger,
+		recent:   newRingBuffer(opts.BufferSize),
+		keywords: newKeywordCounter(opts.Keywords),
+	}
+	if opts.Dedup && opts.Compliance == nil {
+		l.dedup = dedup.New(opts.DedupInterval)
+	}
+	return l
+}
+
+// PortName returns the port being logged
+func (l *Logger) PortName() string {
+	return l.opts.PortName
+}
+
+// Recent returns up to maxBytes of the most recent raw output (everything
+// buffered when maxBytes <= 0) and the total bytes seen since startup
+func (l *Logger) Recent(maxBytes int) ([]byte, uint64) {
+	return l.recent.Snapshot(maxBytes)
+}
+
+// Run records the port until ctx is cancelled, reopening it whenever the
+// device disappears
+func (l *Logger) Run(ctx context.Context) {
+	compliance := l.opts.Compliance
+	maxSize := l.opts.MaxSize
+	if compliance != nil {
+		// Rotated after a checkpoint instead
+		maxSize = 0
+	}
+	file, err := openRotatingFile(l.opts.Path, maxSize, l.opts.MaxBackups, func(path string) {
+		if compliance != nil {
+			if err := os.Chmod(path, 0o444); err != nil {
+				l.logger.Warn("failed to make console log read-only", "file", path, "error", err)
+			}
+		}
+		l.ship(ctx, path)
+	})
+	if err != nil {
+		l.logger.Error("console logger disabled", "port", l.opts.PortName, "error", err)
+		return
+	}
+	l.file = file
+	defer file.Close()
+
+	if compliance != nil {
+		if err := l.startChain(); err != nil {
+			l.logger.Error("console logger disabled", "port", l.opts.PortName, "error", err)
+			return
+		}
+		interval := compliance.CheckpointInterval
+		if interval <= 0 {
+			interval = defaultCheckpointInterval
+		}
+		checkpointsDone := make(chan struct{})
+		checkpointsCtx, stopCheckpoints := context.WithCancel(ctx)
+		go func() {
+			defer close(checkpointsDone)
+			l.checkpointEvery(checkpointsCtx, interval)
+		}()
+		defer func() {
+			stopCheckpoints()
+			<-checkpointsDone
+			l.checkpoint()
+		}()
+	}
+
+	if l.dedup != nil {
+		repeatsDone := make(chan struct{})
+		repeatsCtx, stopRepeats := context.WithCancel(ctx)
+		go func() {
+			defer close(repeatsDone)
+			l.reportRepeatsEvery(repeatsCtx)
+		}()
+		defer func() {
+			stopRepeats()
+			<-repeatsDone
+			l.reportRepeats(true)
+		}()
+	}
+
+	if l.opts.CrashDumps != nil {
+		// Save a capture still running
+		defer l.finishCrash()
+	}
+
+	l.logger.Info("console logger started", "port", l.opts.PortName, "file", l.opts.Path)
+
+	for {
+		if err := l.capture(ctx); err != nil && ctx.Err() == nil {
+			l.logger.Debug("console capture interrupted", "port", l.opts.PortName, "error", err)
+		}
+		l.flushPartial()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+// capture opens the port and logs it until the session ends. If a client
+// already holds the port exclusively, its reads are mirrored instead.
+func (l *Logger) capture(ctx context.Context) error {
+	session, err := l.manager.OpenPort(l.opts.PortName, l.opts.Config, ClientID, false)
+	if errors.Is(err, serial.ErrPortLocked) {
+		return l.mirror(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	// Logging yields to control sessions sharing the agent
+	session.SetPriority(serial.PriorityBulk)
+	defer func() {
+		_ = l.manager.ClosePort(l.opts.PortName, session.ID)
+	}()
+
+	reader := serial.NewReader(l.manager, l.opts.PortName, session.ID, 1024)
+	reader.SetConsumer("console")
+	subscription := reader.Subscribe()
+	if err := reader.Start(ctx); err != nil {
+		return err
+	}
+	defer reader.Stop()
+
+	readErrors := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-subscription:
+			if !ok {
+				return serial.ErrPortClosed
+			}
+			if event.Error != nil {
+				readErrors++
+				if readErrors >= maxReadErrors {
+					return event.Error
+				}
+				continue
+			}
+			readErrors = 0
+			l.writeData(event.Timestamp, event.Data)
+		}
+	}
+}
+
+// mirror logs data read by the client session that currently owns the port
+func (l *Logger) mirror(ctx context.Context) error {
+	session := l.manager.GetSession(l.opts.PortName)
+	if session == nil {
+		return serial.ErrPortLocked
+	}
+
+	data, err := l.manager.SubscribeToReads(l.opts.PortName, session.ID, "console")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = l.manager.UnsubscribeFromReads(l.opts.PortName, session.ID, data)
+	}()
+
+	l.logger.Debug("console logger mirroring client session", "port", l.opts.PortName, "client", session.ClientID)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case chunk, ok := <-data:
+			if !ok {
+				return nil
+			}
+			l.writeData(time.Now(), chunk)
+		}
+	}
+}
+
+// writeData buffers raw data and splits it into lines, each stamped with
+// the time its first byte arrived
+func (l *Logger) writeData(ts time.Time, data []byte) {
+	redacted := l.opts.Redactor.Apply(data)
+	l.recent.Write(redacted)
+	if l.opts.CrashDumps != nil {
+		l.crashWrite(redacted)
+	}
+
+	for len(data) > 0 {
+		if len(l.partial) == 0 {
+			l.lineStart = ts
+		}
+
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			l.partial = append(l.partial, data...)
+			if len(l.partial) >= maxLineLength {
+				l.flushPartial()
+			}
+			return
+		}
+
+		l.partial = append(l.partial, data[:i]...)
+		l.flushPartial()
+		data = data[i+1:]
+	}
+}
+
+// flushPartial writes the buffered line, if any
+func (l *Logger) flushPartial() {
+	if len(l.partial) == 0 {
+		return
+	}
+
+	raw := strings.TrimRight(string(l.partial), "\r")
+	l.partial = l.partial[:0]
+	if l.keywords != nil {
+		l.keywords.add(raw, l.lineStart)
+	}
+	line := l.opts.Redactor.String(raw)
+	l.crashMatch(raw, line, l.lineStart)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.chain == nil {
+		if l.dedup == nil {
+			l.write(l.formatLine(l.lineStart, line, 0))
+			return
+		}
+		for _, event := range l.dedup.Add(l.lineStart, []byte(line)) {
+			l.write(l.formatLine(event.Time, string(event.Line), event.Repeats))
+		}
+		return
+	}
+
+	l.write(l.chain.Line(l.lineStart, l.opts.PortName, line))
+	if l.opts.MaxSize > 0 && l.file.Size() >= l.opts.MaxSize {
+		l.checkpointLocked()
+		if err := l.file.Rotate(); err != nil {
+			l.logger.Warn("failed to rotate console log", "port", l.opts.PortName, "error", err)
+		}
+	}
+}
+
+// write appends to the log file (lock held)
+func (l *Logger) write(p []byte) {
+	if _, err := l.file.Write(p); err != nil {
+		l.logger.Warn("failed to write console log", "port", l.opts.PortName, "error", err)
+	}
+}
+
+// startChain continues the chain of the current file, or else of the last
+// rotated one. A current file that does not end in a record, such as a
+// plain log or one cut short by a crash, is rotated away first so the
+// chain goes on in a clean file.
+func (l *Logger) startChain() error {
+	seq, head := uint64(0), chain.Genesis
+	for _, path := range []string{l.opts.Path, l.opts.Path + ".1"} {
+		file, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		last, found, complete, err := chain.Last(file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if path == l.opts.Path && !complete {
+			if err := l.file.Rotate(); err != nil {
+				return err
+			}
+		}
+		if found {
+			seq, head = last.Seq, last.Hash
+			break
+		}
+	}
+	l.chain = chain.New(l.opts.Compliance.Key, seq, head)
+	return nil
+}
+
+// checkpointEvery signs new records at each interval until ctx is done
+func (l *Logger) checkpointEvery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.checkpoint()
+		}
+	}
+}
+
+// checkpoint signs the records written since the last checkpoint
+func (l *Logger) checkpoint() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.checkpointLocked()
+}
+
+// checkpointLocked is checkpoint with the lock held
+func (l *Logger) checkpointLocked() {
+	if record := l.chain.Checkpoint(time.Now(), l.opts.PortName); record != nil {
+		l.write(record)
+	}
+}
+
+// reportRepeatsEvery logs runs of repeated lines that span the dedup
+// interval until ctx is done
+func (l *Logger) reportRepeatsEvery(ctx context.Context) {
+	ticker := time.NewTicker(repeatCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.reportRepeats(false)
+		}
+	}
+}
+
+// reportRepeats logs the repeats held back by dedup, all of them when final
+func (l *Logger) reportRepeats(final bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	event, ok := l.dedup.Tick(time.Now())
+	if final {
+		event, ok = l.dedup.Flush()
+	}
+	if ok {
+		l.write(l.formatLine(event.Time, string(event.Line), event.Repeats))
+	}
+}
+
+// consoleLine is a single record in json format
+type consoleLine struct {
+	Time string `json:"time"`
+	Port string `json:"port"`
+	Line string `json:"line"`
+	// Repeats is set on an entry standing for this many repeats of Line
+	Repeats int `json:"repeats,omitempty"`
+}
+
+// formatLine formats a line, or with repeats > 0 the repeats of one
+func (l *Logger) formatLine(ts time.Time, line string, repeats int) []byte {
+	stamp := ts.Format(time.RFC3339Nano)
+
+	if l.opts.Format == FormatJSON {
+		record, err := json.Marshal(consoleLine{Time: stamp, Port: l.opts.PortName, Line: line, Repeats: repeats})
+		if err == nil {
+			return append(record, '\n')
+		}
+	}
+
+	switch {
+	case repeats == 1:
+		return []byte(fmt.Sprintf("%s last line repeated once\n", stamp))
+	case repeats > 1:
+		return []byte(fmt.Sprintf("%s last line repeated %d times\n", stamp, repeats))
+	}
+	return []byte(fmt.Sprintf("%s %s\n", stamp, line))
+}
+
+// ship uploads a rotated file in the background
+func (l *Logger) ship(ctx context.Context, path string) {
+	if l.opts.Upload != nil {
+		l.opts.Upload(path)
+	}
+	if l.opts.ShipURL == "" {
+		return
+	}
+
+	go func() {
+		if err := shipFile(ctx, l.opts.ShipURL, l.opts.PortName, path); err != nil {
+			l.logger.Warn("failed to ship console log", "port", l.opts.PortName, "file", path, "error", err)
+			return
+		}
+		l.logger.Debug("console log shipped", "port", l.opts.PortName, "file", path)
+	}()
+}
+
+// Collector runs console loggers for a set of ports. Loggers can also be
+// added and removed while running, e.g. as devices come and go.
+type Collector struct {
+	manager *serial.Manager
+	logger  *log.Logger
+	wg      sync.WaitGroup
+
+	mu      sync.RWMutex
+	loggers map[string]*Logger
+	stops   map[string]func()
+}
+
+// NewCollector creates loggers for the given ports
+func NewCollector(manager *serial.Manager, opts []Options, logger *log.Logger) *Collector {
+	c := &Collector{
+		manager: manager,
+		logger:  logger,
+		loggers: make(map[string]*Logger, len(opts)),
+		stops:   make(map[string]func()),
+	}
+	for _, o := range opts {
+		c.loggers[o.PortName] = NewLogger(manager, o, logger)
+	}
+	return c
+}
+
+// Start runs every logger until ctx is cancelled
+func (c *Collector) Start(ctx context.Context) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, l := range c.loggers {
+		c.run(ctx, l)
+	}
+}
+
+// Add starts logging another port until ctx is cancelled or the port is
+// removed. It returns false if the port is already logged.
+func (c *Collector) Add(ctx context.Context, opts Options) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, exists := c.loggers[opts.PortName]; exists {
+		return false
+	}
+
+	l := NewLogger(c.manager, opts, c.logger)
+	c.loggers[opts.PortName] = l
+	c.run(ctx, l)
+	return true
+}
+
+// run starts a logger that Remove can stop (lock held)
+func (c *Collector) run(ctx context.Context, l *Logger) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	c.stops[l.PortName()] = func() {
+		cancel()
+		<-done
+	}
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer close(done)
+		l.Run(ctx)
+	}()
+}
+
+// Remove stops logging a port. It returns false if the port is not logged.
+func (c *Collector) Remove(portName string) bool {
+	c.mu.Lock()
+	stop, exists := c.stops[portName]
+	delete(c.stops, portName)
+	if exists {
+		delete(c.loggers, portName)
+	}
+	c.mu.Unlock()
+
+	if exists {
+		stop()
+	}
+	return exists
+}
+
+// Wait blocks until all loggers have stopped
+func (c *Collector) Wait() {
+	c.wg.Wait()
+}
+
+// Logger returns the logger for a port, or nil if the port is not logged
+func (c *Collector) Logger(portName string) *Logger {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.loggers[portName]
+}
+
+// InUse reports whether path is the current log file of a logger
+func (c *Collector) InUse(path string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	path = filepath.Clean(path)
+	for _, l := range c.loggers {
+		if filepath.Clean(l.opts.Path) == path {
+			return true
+		}
+	}
+	return false
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// FileName derives a log file name from a port name,
+// e.g. "/dev/ttyUSB0" becomes "dev_ttyUSB0.log"
+func FileName(portName string) string {
+	return unsafeFileChars.ReplaceAllString(trimPortPrefix(portName), "_") + ".log"
+}
+
+// trimPortPrefix drops leading path separators from a port name
+func trimPortPrefix(portName string) string {
+	return strings.TrimLeft(portName, `/\`)
+}
@@ -0,0 +1,184 @@
+package console
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// crashWebhookTimeout bounds a crash dump notification
+const crashWebhookTimeout = 10 * time.Second
+
+// CrashOptions capture crash dumps: when a line matches one of Patterns,
+// such as "Kernel panic" or "Guru Meditation", the recent output and what
+// follows for After are saved to a file in Directory
+type CrashOptions struct {
+	Patterns  []*regexp.Regexp
+	After     time.Duration
+	Directory string
+	// Notify is called with each dump once it is saved
+	Notify func(CrashDump)
+}
+
+// CrashDump describes a saved crash dump
+type CrashDump struct {
+	PortName string
+	// Pattern is the pattern that matched Line
+	Pattern string
+	Line    string
+	Time    time.Time
+	Path    string
+	Size    int64
+}
+
+// crashCapture is a dump being captured
+type crashCapture struct {
+	dump  CrashDump
+	data  []byte
+	timer *time.Timer
+}
+
+// crashMatch starts a capture when a line matches a crash pattern. Lines
+// matching while a capture is running become part of it.
+func (l *Logger) crashMatch(raw, line string, ts time.Time) {
+	crash := l.opts.CrashDumps
+	if crash == nil {
+		return
+	}
+	var pattern *regexp.Regexp
+	for _, p := range crash.Patterns {
+		if p.MatchString(raw) {
+			pattern = p
+			break
+		}
+	}
+	if pattern == nil {
+		return
+	}
+
+	l.crashMu.Lock()
+	defer l.crashMu.Unlock()
+	if l.crash != nil {
+		return
+	}
+	// The recent output already holds the matching line
+	recent, _ := l.recent.Snapshot(0)
+	l.crash = &crashCapture{
+		dump: CrashDump{PortName: l.opts.PortName, Pattern: pattern.String(), Line: line, Time: ts},
+		data: recent,
+	}
+	l.crash.timer = time.AfterFunc(crash.After, l.finishCrash)
+	l.logger.Warn("crash pattern matched, capturing crash dump", "port", l.opts.PortName, "line", line)
+}
+
+// crashWrite adds output to a running capture
+func (l *Logger) crashWrite(data []byte) {
+	l.crashMu.Lock()
+	defer l.crashMu.Unlock()
+	if l.crash != nil {
+		l.crash.data = append(l.crash.data, data...)
+	}
+}
+
+// finishCrash saves a running capture and reports it
+func (l *Logger) finishCrash() {
+	l.crashMu.Lock()
+	capture := l.crash
+	l.crash = nil
+	l.crashMu.Unlock()
+	if capture == nil {
+		return
+	}
+	capture.timer.Stop()
+
+	dump := capture.dump
+	name := strings.TrimSuffix(FileName(l.opts.PortName), ".log") + "-" + dump.Time.UTC().Format("20060102T150405.000Z") + ".crash"
+	dump.Path = filepath.Join(l.opts.CrashDumps.Directory, name)
+	dump.Size = int64(len(capture.data))
+	if err := os.MkdirAll(l.opts.CrashDumps.Directory, 0o755); err != nil {
+		l.logger.Error("failed to save crash dump", "port", l.opts.PortName, "error", err)
+		return
+	}
+	if err := os.WriteFile(dump.Path, capture.data, 0o644); err != nil {
+		l.logger.Error("failed to save crash dump", "port", l.opts.PortName, "error", err)
+		return
+	}
+	l.crashes.Add(1)
+	l.logger.Info("crash dump saved", "port", l.opts.PortName, "file", dump.Path, "size", dump.Size)
+
+	if l.opts.CrashDumps.Notify != nil {
+		l.opts.CrashDumps.Notify(dump)
+	}
+}
+
+// CrashDumps returns the number of crash dumps saved for the port
+func (l *Logger) CrashDumps() uint64 {
+	return l.crashes.Load()
+}
+
+// crashDumpMessage is the JSON form of a crash dump
+type crashDumpMessage struct {
+	Event     string `json:"event"`
+	Port      string `json:"port"`
+	Pattern   string `json:"pattern"`
+	Line      string `json:"line"`
+	Timestamp string `json:"timestamp"`
+	File      string `json:"file"`
+	Size      int64  `json:"size"`
+}
+
+// Marshal returns the JSON form of a dump, as sent to webhooks and in
+// port events
+func (d CrashDump) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(crashDumpMessage{
+		Event:     "crash_dump",
+		Port:      d.PortName,
+		Pattern:   d.Pattern,
+		Line:      d.Line,
+		Timestamp: d.Time.Format(time.RFC3339Nano),
+		File:      d.Path,
+		Size:      d.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// PostCrashDump POSTs the JSON form of a dump to a webhook
+func PostCrashDump(ctx context.Context, url string, dump CrashDump) error {
+	body, err := dump.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, crashWebhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package console
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/metrics"
+)
+
+// maxKeywordSlots bounds the buckets kept per keyword; longer windows count
+// in coarser slots
+const maxKeywordSlots = 3600
+
+// KeywordOptions count the console lines holding keywords, such as "ERROR"
+// or "panic", over sliding windows
+type KeywordOptions struct {
+	Words      []string
+	IgnoreCase bool
+	// Windows are the spans counts are kept over, e.g. 1m, 5m and 1h
+	Windows []time.Duration
+}
+
+// KeywordStats counts the lines of a port holding a keyword
+type KeywordStats struct {
+	Keyword string
+	// Total counts lines since the logger started
+	Total   uint64
+	Windows []WindowCount
+}
+
+// WindowCount counts lines within the last Window
+type WindowCount struct {
+	Window time.Duration
+	Count  uint64
+}
+
+// keywordCounter counts lines per keyword in a ring of time slots spanning
+// the longest window
+type keywordCounter struct {
+	opts  KeywordOptions
+	words []string
+	slot  time.Duration
+
+	mu sync.Mutex
+	// buckets[k][i] counts the lines of keyword k in slot i of the ring;
+	// latest is the number of the slot of the newest bucket
+	buckets [][]uint32
+	latest  int64
+	totals  []uint64
+}
+
+// newKeywordCounter returns nil without words or windows
+func newKeywordCounter(opts KeywordOptions) *keywordCounter {
+	if len(opts.Words) == 0 || len(opts.Windows) == 0 {
+		return nil
+	}
+	longest := slices.Max(opts.Windows)
+	slot := max(time.Second, (longest+maxKeywordSlots-1)/maxKeywordSlots)
+	k := &keywordCounter{
+		opts:    opts,
+		words:   opts.Words,
+		slot:    slot,
+		buckets: make([][]uint32, len(opts.Words)),
+		totals:  make([]uint64, len(opts.Words)),
+	}
+	if opts.IgnoreCase {
+		k.words = make([]string, len(opts.Words))
+		for i, word := range opts.Words {
+			k.words[i] = strings.ToLower(word)
+		}
+	}
+	slots := int((longest + slot - 1) / slot)
+	for i := range k.buckets {
+		k.buckets[i] = make([]uint32, slots)
+	}
+	return k
+}
+
+// add counts a line once for each keyword it holds
+func (k *keywordCounter) add(line string, now time.Time) {
+	if k.opts.IgnoreCase {
+		line = strings.ToLower(line)
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.advance(now)
+	for i, word := range k.words {
+		if strings.Contains(line, word) {
+			k.totals[i]++
+			k.buckets[i][k.index(k.latest)]++
+		}
+	}
+}
+
+// stats returns the counts as of now
+func (k *keywordCounter) stats(now time.Time) []KeywordStats {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.advance(now)
+
+	stats := make([]KeywordStats, len(k.words))
+	for i, word := range k.opts.Words {
+		stats[i] = KeywordStats{Keyword: word, Total: k.totals[i]}
+		for _, window := range k.opts.Windows {
+			slots := int64((window + k.slot - 1) / k.slot)
+			var count uint64
+			for s := k.latest - slots + 1; s <= k.latest; s++ {
+				count += uint64(k.buckets[i][k.index(s)])
+			}
+			stats[i].Windows = append(stats[i].Windows, WindowCount{Window: window, Count: count})
+		}
+	}
+	return stats
+}
+
+// advance moves the ring to the slot of now, clearing the slots passed
+// (lock held)
+func (k *keywordCounter) advance(now time.Time) {
+	current := now.UnixNano() / int64(k.slot)
+	if current <= k.latest {
+		return
+	}
+	size := int64(len(k.buckets[0]))
+	passed := min(current-k.latest, size)
+	for s := current - passed + 1; s <= current; s++ {
+		for i := range k.buckets {
+			k.buckets[i][k.index(s)] = 0
+		}
+	}
+	k.latest = current
+}
+
+// index returns the ring index of a slot
+func (k *keywordCounter) index(slot int64) int {
+	size := int64(len(k.buckets[0]))
+	return int(((slot % size) + size) % size)
+}
+
+// KeywordStats returns the keyword counts of the port, nil when no
+// keywords are configured
+func (l *Logger) KeywordStats() []KeywordStats {
+	if l.keywords == nil {
+		return nil
+	}
+	return l.keywords.stats(time.Now())
+}
+
+// KeywordStats returns the keyword counts of every logged port
+func (c *Collector) KeywordStats() map[string][]KeywordStats {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	stats := make(map[string][]KeywordStats, len(c.loggers))
+	for port, l := range c.loggers {
+		if s := l.KeywordStats(); s != nil {
+			stats[port] = s
+		}
+	}
+	return stats
+}
+
+// Collect implements metrics.Collector with the keyword and crash dump
+// counts
+func (c *Collector) Collect() []metrics.Family {
+	totals := metrics.Family{
+		Name: "seriallink_console_keyword_lines_total",
+		Help: "Console lines holding a keyword.",
+		Type: metrics.TypeCounter,
+	}
+	windows := metrics.Family{
+		Name: "seriallink_console_keyword_lines",
+		Help: "Console lines holding a keyword within a sliding window.",
+		Type: metrics.TypeGauge,
+	}
+
+	all := c.KeywordStats()
+	ports := make([]string, 0, len(all))
+	for port := range all {
+		ports = append(ports, port)
+	}
+	slices.Sort(ports)
+	for _, port := range ports {
+		for _, s := range all[port] {
+			labels := []metrics.Label{{Name: "port", Value: port}, {Name: "keyword", Value: s.Keyword}}
+			totals.Samples = append(totals.Samples, metrics.Sample{Labels: labels, Value: float64(s.Total)})
+			for _, w := range s.Windows {
+				windows.Samples = append(windows.Samples, metrics.Sample{
+					Labels: append(labels[:2:2], metrics.Label{Name: "window_seconds", Value: strconv.FormatFloat(w.Window.Seconds(), 'f', -1, 64)}),
+					Value:  float64(w.Count),
+				})
+			}
+		}
+	}
+
+	crashes := metrics.Family{
+		Name: "seriallink_console_crash_dumps_total",
+		Help: "Crash dumps saved from console output.",
+		Type: metrics.TypeCounter,
+	}
+	c.mu.RLock()
+	ports = ports[:0]
+	for port, l := range c.loggers {
+		if l.opts.CrashDumps != nil {
+			ports = append(ports, port)
+		}
+	}
+	slices.Sort(ports)
+	for _, port := range ports {
+		crashes.Samples = append(crashes.Samples, metrics.Sample{
+			Labels: []metrics.Label{{Name: "port", Value: port}},
+			Value:  float64(c.loggers[port].CrashDumps()),
+		})
+	}
+	c.mu.RUnlock()
+	return []metrics.Family{totals, windows, crashes}
+}
@@ -0,0 +1,141 @@
+package console
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrRecordingNotFound is returned for an unknown recording ID
+var ErrRecordingNotFound = errors.New("recording not found")
+
+// RecordingInfo describes a recording in the recordings directory
+type RecordingInfo struct {
+	// ID is the path of the recording within the directory, e.g.
+	// "alice/dev_ttyUSB0-20250101T120000-<session>.cast"
+	ID        string
+	User      string
+	PortName  string
+	SessionID string
+	Started   time.Time
+	// Duration is the time from the start to the last write
+	Duration time.Duration
+	Size     int64
+}
+
+// ListRecordings returns the recordings in dir, newest first. Files
+// without an asciicast header are skipped.
+func ListRecordings(dir string) ([]RecordingInfo, error) {
+	var recordings []RecordingInfo
+	err := filepath.WalkDir(dir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !entry.Type().IsRegular() || filepath.Ext(p) != ".cast" {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			// Removed while walking
+			return nil
+		}
+		id, err := filepath.Rel(dir, p)
+		if err != nil {
+			return nil
+		}
+		if recording, ok := recordingInfo(p, filepath.ToSlash(id), info); ok {
+			recordings = append(recordings, recording)
+		}
+		return nil
+	})
+	slices.SortFunc(recordings, func(a, b RecordingInfo) int { return b.Started.Compare(a.Started) })
+	return recordings, err
+}
+
+// StatRecording describes a recording of dir by ID
+func StatRecording(dir, id string) (RecordingInfo, error) {
+	if !validRecordingID(id) {
+		return RecordingInfo{}, ErrRecordingNotFound
+	}
+	p := filepath.Join(dir, filepath.FromSlash(id))
+	info, err := os.Stat(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return RecordingInfo{}, ErrRecordingNotFound
+	}
+	if err != nil {
+		return RecordingInfo{}, err
+	}
+	if !info.Mode().IsRegular() {
+		return RecordingInfo{}, ErrRecordingNotFound
+	}
+	recording, ok := recordingInfo(p, id, info)
+	if !ok {
+		return RecordingInfo{}, ErrRecordingNotFound
+	}
+	return recording, nil
+}
+
+// recordingInfo describes the recording at p from its asciicast header
+func recordingInfo(p, id string, info fs.FileInfo) (RecordingInfo, bool) {
+	header, ok := readCastHeader(p)
+	if !ok {
+		return RecordingInfo{}, false
+	}
+	started := time.Unix(header.Timestamp, 0)
+	return RecordingInfo{
+		ID:        id,
+		User:      header.Env[castEnvUser],
+		PortName:  header.Env[castEnvPort],
+		SessionID: header.Env[castEnvSession],
+		Started:   started,
+		Duration:  max(0, info.ModTime().Sub(started)),
+		Size:      info.Size(),
+	}, true
+}
+
+// validRecordingID reports whether id names a recording inside the
+// directory
+func validRecordingID(id string) bool {
+	return fs.ValidPath(id) && path.Ext(id) == ".cast" && !strings.Contains(id, `\`)
+}
+
+// OpenRecording opens a recording of dir by ID
+func OpenRecording(dir, id string) (*os.File, error) {
+	if !validRecordingID(id) {
+		return nil, ErrRecordingNotFound
+	}
+	file, err := os.Open(filepath.Join(dir, filepath.FromSlash(id)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrRecordingNotFound
+	}
+	return file, err
+}
+
+// readCastHeader reads the header line of an asciicast file
+func readCastHeader(p string) (castHeader, bool) {
+	file, err := os.Open(p)
+	if err != nil {
+		return castHeader{}, false
+	}
+	defer file.Close()
+
+	line, err := bufio.NewReader(file).ReadBytes('\n')
+	if err != nil {
+		return castHeader{}, false
+	}
+	var header castHeader
+	if err := json.Unmarshal(line, &header); err != nil || header.Version != 2 {
+		return castHeader{}, false
+	}
+	return header, true
+}
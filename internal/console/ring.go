@@ -0,0 +1,70 @@
+package console
+
+import "sync"
+
+// ringBuffer keeps the most recent bytes written to it
+type ringBuffer struct {
+	mu    sync.Mutex
+	buf   []byte
+	start int
+	size  int
+	total uint64
+}
+
+func newRingBuffer(capacity int) *ringBuffer {
+	return &ringBuffer{buf: make([]byte, capacity)}
+}
+
+// Write appends data, overwriting the oldest bytes once full
+func (r *ringBuffer) Write(data []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.total += uint64(len(data))
+	capacity := len(r.buf)
+	if capacity == 0 {
+		return
+	}
+
+	// Only the tail of an oversized write can be kept
+	if len(data) >= capacity {
+		copy(r.buf, data[len(data)-capacity:])
+		r.start = 0
+		r.size = capacity
+		return
+	}
+
+	end := (r.start + r.size) % capacity
+	n := copy(r.buf[end:], data)
+	copy(r.buf, data[n:])
+
+	r.size += len(data)
+	if r.size > capacity {
+		r.start = (r.start + r.size - capacity) % capacity
+		r.size = capacity
+	}
+}
+
+// Snapshot returns up to maxBytes of the most recent data (all buffered data
+// when maxBytes <= 0) and the total number of bytes ever written
+func (r *ringBuffer) Snapshot(maxBytes int) ([]byte, uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	n := r.size
+	if maxBytes > 0 && maxBytes < n {
+		n = maxBytes
+	}
+
+	out := make([]byte, n)
+	capacity := len(r.buf)
+	if capacity == 0 {
+		return out, r.total
+	}
+
+	from := (r.start + r.size - n) % capacity
+	copied := copy(out, r.buf[from:min(from+n, capacity)])
+	copy(out[copied:], r.buf[:n-copied])
+
+	return out, r.total
+}
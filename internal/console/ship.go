@@ -0,0 +1,45 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// shipTimeout bounds a single upload of a rotated log file
+const shipTimeout = 60 * time.Second
+
+// shipFile uploads a rotated log file to url with an HTTP POST. The body is
+// the raw file; the port and file name are sent as headers.
+func shipFile(ctx context.Context, url, portName, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, shipTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, file)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	req.Header.Set("X-SerialLink-Port", portName)
+	req.Header.Set("X-SerialLink-File", filepath.Base(path))
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("upload rejected: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// Package debug serves pprof profiles, expvar variables and goroutine and
+// session dumps on a listener of their own, to diagnose leaks in
+// long-running deployments. The listener can be started and stopped at
+// runtime.
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	runtimepprof "runtime/pprof"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/charmbracelet/log"
+)
+
+// shutdownTimeout bounds waiting for requests in flight, e.g. a CPU profile,
+// when the listener stops
+const shutdownTimeout = 5 * time.Second
+
+// Server is the debug listener
+type Server struct {
+	address string
+	manager *serial.Manager
+	logger  *log.Logger
+
+	mu     sync.Mutex
+	server *http.Server
+	// bound is the address actually listened on
+	bound string
+}
+
+// New creates a stopped debug server for address
+func New(address string, manager *serial.Manager, logger *log.Logger) *Server {
+	publishTotals(manager)
+	return &Server{
+		address: address,
+		manager: manager,
+		logger:  logger,
+	}
+}
+
+// publishTotals exposes the manager's totals in expvar. Variables cannot be
+// unpublished, so only the first manager is published.
+func publishTotals(manager *serial.Manager) {
+	if expvar.Get("seriallink") != nil {
+		return
+	}
+	expvar.Publish("seriallink", expvar.Func(func() any {
+		return manager.Totals()
+	}))
+}
+
+// Start listens on the configured address; starting a running server does
+// nothing
+func (s *Server) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.server != nil {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.address, err)
+	}
+	server := &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Warn("debug listener failed", "error", err)
+		}
+	}()
+
+	s.server = server
+	s.bound = listener.Addr().String()
+	s.logger.Warn("debug endpoints enabled", "address", s.bound)
+	return nil
+}
+
+// Stop closes the listener, waiting briefly for requests in flight
+func (s *Server) Stop() error {
+	s.mu.Lock()
+	server := s.server
+	s.server = nil
+	s.bound = ""
+	s.mu.Unlock()
+	if server == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	err := server.Shutdown(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = server.Close()
+	}
+	s.logger.Info("debug endpoints disabled")
+	return err
+}
+
+// Running reports whether the listener is up and the address it is bound to
+func (s *Server) Running() (bool, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.server == nil {
+		return false, s.address
+	}
+	return true, s.bound
+}
+
+// Handler returns the debug routes
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.HandleFunc("/debug/goroutines", handleGoroutines)
+	mux.HandleFunc("/debug/sessions", s.handleSessions)
+	return mux
+}
+
+// handleGoroutines writes the stack of every goroutine
+func handleGoroutines(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintf(w, "%d goroutines\n\n", runtime.NumGoroutine())
+	_ = runtimepprof.Lookup("goroutine").WriteTo(w, 2)
+}
+
+// sessionDump describes an open session
+type sessionDump struct {
+	ID            string    `json:"id"`
+	Port          string    `json:"port"`
+	ClientID      string    `json:"client_id"`
+	Exclusive     bool      `json:"exclusive"`
+	Priority      string    `json:"priority"`
+	PowerState    string    `json:"power_state"`
+	OpenedAt      time.Time `json:"opened_at"`
+	LastActivity  time.Time `json:"last_activity"`
+	BytesSent     uint64    `json:"bytes_sent"`
+	BytesReceived uint64    `json:"bytes_received"`
+	Errors        uint64    `json:"errors"`
+	Streams       int       `json:"streams"`
+	BufferedBytes int64     `json:"buffered_bytes"`
+	DroppedBytes  uint64    `json:"dropped_bytes"`
+	// Metadata is what the client said about the session at open
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// handleSessions writes every open session with its streams and buffers as
+// JSON
+func (s *Server) handleSessions(w http.ResponseWriter, r *http.Request) {
+	memory := make(map[string]serial.SessionMemory)
+	for _, m := range s.manager.MemoryStats().Sessions {
+		memory[m.SessionID] = m
+	}
+
+	sessions := s.manager.Sessions()
+	dump := make([]sessionDump, 0, len(sessions))
+	for _, session := range sessions {
+		m := memory[session.ID]
+		dump = append(dump, sessionDump{
+			ID:            session.ID,
+			Port:          session.PortName,
+			ClientID:      session.ClientID,
+			Exclusive:     session.Exclusive,
+			Priority:      session.Priority().String(),
+			PowerState:    session.PowerState().String(),
+			OpenedAt:      session.Statistics.OpenedAt,
+			LastActivity:  session.Statistics.LastActivity,
+			BytesSent:     atomic.LoadUint64(&session.Statistics.BytesSent),
+			BytesReceived: atomic.LoadUint64(&session.Statistics.BytesReceived),
+			Errors:        atomic.LoadUint64(&session.Statistics.Errors),
+			Streams:       m.Streams,
+			BufferedBytes: m.Buffered,
+			DroppedBytes:  m.Dropped,
+			Metadata:      session.Metadata,
+		})
+	}
+	sort.Slice(dump, func(i, j int) bool { return dump[i].Port < dump[j].Port })
+
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(dump)
+}
@@ -0,0 +1,113 @@
+package decode
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+)
+
+// atFinalResults names the final result codes that end a command
+var atFinalResults = map[string]string{
+	"OK":          "success",
+	"ERROR":       "error",
+	"NO CARRIER":  "no carrier",
+	"BUSY":        "busy",
+	"NO ANSWER":   "no answer",
+	"NO DIALTONE": "no dial tone",
+}
+
+// atUnsolicited names the common unsolicited result codes
+var atUnsolicited = map[string]string{
+	"RING":         "incoming call",
+	"RDY":          "module ready",
+	"POWERED DOWN": "module powered down",
+}
+
+// decodeAT decodes one line of an AT command exchange, from either side
+func decodeAT(raw []byte) (Frame, bool) {
+	line := strings.TrimSpace(string(bytes.TrimRight(raw, "\r\n")))
+	if line == "" {
+		return Frame{}, false
+	}
+	frame := Frame{Raw: raw}
+	upper := strings.ToUpper(line)
+
+	switch {
+	case strings.HasPrefix(upper, "AT"):
+		kind, name, args := atCommand(line[2:])
+		frame.Fields = []Field{{Name: "type", Value: kind}, {Name: "command", Value: "AT" + name}}
+		frame.Summary = kind + " AT" + name
+		if args != "" {
+			frame.Fields = append(frame.Fields, Field{Name: "arguments", Value: args})
+			frame.Summary += " = " + args
+		}
+	case atFinalResults[upper] != "":
+		frame.Fields = []Field{{Name: "type", Value: "final result"}, {Name: "result", Value: atFinalResults[upper]}}
+		frame.Summary = "final result: " + atFinalResults[upper]
+	case strings.HasPrefix(upper, "CONNECT"):
+		frame.Fields = []Field{{Name: "type", Value: "final result"}, {Name: "result", Value: "connected"}}
+		frame.Summary = "final result: " + strings.ToLower(line)
+	case strings.HasPrefix(upper, "+CME ERROR:"), strings.HasPrefix(upper, "+CMS ERROR:"):
+		kind := "equipment error"
+		if upper[3] == 'S' {
+			kind = "message service error"
+		}
+		code := strings.TrimSpace(line[len("+CME ERROR:"):])
+		frame.Fields = []Field{{Name: "type", Value: "final result"}, {Name: "result", Value: kind}, {Name: "code", Value: code}}
+		frame.Summary = "final result: " + kind + " " + code
+	case atUnsolicited[upper] != "":
+		frame.Fields = []Field{{Name: "type", Value: "unsolicited"}, {Name: "event", Value: atUnsolicited[upper]}}
+		frame.Summary = "unsolicited: " + atUnsolicited[upper]
+	case strings.HasPrefix(line, "+") || strings.HasPrefix(line, "^"):
+		name, values, _ := strings.Cut(line, ":")
+		values = strings.TrimSpace(values)
+		frame.Fields = []Field{{Name: "type", Value: "information response"}, {Name: "command", Value: name}}
+		for i, value := range atValues(values) {
+			frame.Fields = append(frame.Fields, Field{Name: strconv.Itoa(i + 1), Value: value})
+		}
+		frame.Summary = "information response " + name
+		if values != "" {
+			frame.Summary += ": " + values
+		}
+	default:
+		frame.Fields = []Field{{Name: "type", Value: "text"}}
+		frame.Summary = "text: " + line
+	}
+	return frame, true
+}
+
+// atCommand classifies the part of a command after "AT"
+func atCommand(rest string) (kind, name, args string) {
+	switch {
+	case strings.HasSuffix(rest, "=?"):
+		return "test command", rest[:len(rest)-2], ""
+	case strings.HasSuffix(rest, "?"):
+		return "read command", rest[:len(rest)-1], ""
+	}
+	if name, args, ok := strings.Cut(rest, "="); ok {
+		return "set command", name, args
+	}
+	return "execute command", rest, ""
+}
+
+// atValues splits the values of a response at commas outside quotes
+func atValues(values string) []string {
+	if values == "" {
+		return nil
+	}
+	var split []string
+	quoted := false
+	start := 0
+	for i := 0; i < len(values); i++ {
+		switch values[i] {
+		case '"':
+			quoted = !quoted
+		case ',':
+			if !quoted {
+				split = append(split, strings.TrimSpace(values[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(split, strings.TrimSpace(values[start:]))
+}
@@ -0,0 +1,46 @@
+package decode
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// customDecoder decodes frames with a pattern; the groups that match
+// become fields, named by the group's name or number
+func customDecoder(pattern *regexp.Regexp, terminator []byte) func([]byte) (Frame, bool) {
+	names := pattern.SubexpNames()
+	return func(raw []byte) (Frame, bool) {
+		content := bytes.TrimSuffix(raw, terminator)
+		if len(bytes.TrimSpace(content)) == 0 {
+			return Frame{}, false
+		}
+		frame := Frame{Raw: raw}
+
+		match := pattern.FindSubmatch(content)
+		if match == nil {
+			frame.Summary = strconv.Quote(string(content))
+			frame.Error = "no match"
+			return frame, true
+		}
+
+		var summary []string
+		for i := 1; i < len(match); i++ {
+			if match[i] == nil {
+				continue
+			}
+			name := names[i]
+			if name == "" {
+				name = strconv.Itoa(i)
+			}
+			frame.Fields = append(frame.Fields, Field{Name: name, Value: string(match[i])})
+			summary = append(summary, name+"="+string(match[i]))
+		}
+		frame.Summary = strings.Join(summary, " ")
+		if frame.Summary == "" {
+			frame.Summary = strconv.Quote(string(match[0]))
+		}
+		return frame, true
+	}
+}
@@ -0,0 +1,214 @@
+// Package decode splits the byte stream of a port into the frames of a
+// protocol and describes each in human-readable form.
+package decode
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// ErrInvalid is returned for an unknown decoder or unusable options
+var ErrInvalid = errors.New("invalid decoder")
+
+// Decoder names
+const (
+	ModbusRTU = "modbus_rtu"
+	NMEA      = "nmea"
+	AT        = "at"
+	Megatec   = "megatec"
+	Custom    = "custom"
+)
+
+// maxFrameBytes bounds a pending frame; longer input is line noise or a
+// misconfigured decoder and is emitted as it is
+const maxFrameBytes = 4096
+
+// Field is one named value of a frame
+type Field struct {
+	Name  string
+	Value string
+}
+
+// Frame is one decoded frame
+type Frame struct {
+	// Raw is the frame as received, terminator included
+	Raw []byte
+	// Timestamp is when the first byte of the frame arrived
+	Timestamp time.Time
+	// Summary describes the frame in one line
+	Summary string
+	Fields  []Field
+	// Error says why a frame is malformed, e.g. a checksum mismatch
+	Error string
+}
+
+// Decoder turns received bytes into frames. It is not safe for concurrent
+// use.
+type Decoder interface {
+	// Feed adds received bytes and returns the frames they complete
+	Feed(data []byte, now time.Time) []Frame
+	// Flush completes the pending frame after Gap without input
+	Flush() []Frame
+	// Gap is the silence that ends a frame (0: frames end at terminators
+	// only)
+	Gap() time.Duration
+}
+
+// Options tune a decoder
+type Options struct {
+	// Pattern matches the frames of the custom decoder; its named groups
+	// become the frame's fields
+	Pattern string
+	// Terminator ends the frames of the custom decoder (default LF)
+	Terminator []byte
+	// Gap ends a frame after this long without input. Modbus RTU frames
+	// always end this way and need it; other decoders use it for a device
+	// that leaves its last line unterminated.
+	Gap time.Duration
+}
+
+// New creates a decoder by name
+func New(name string, opts Options) (Decoder, error) {
+	switch name {
+	case ModbusRTU:
+		if opts.Gap <= 0 {
+			return nil, fmt.Errorf("%w: modbus_rtu needs a frame gap", ErrInvalid)
+		}
+		return &framer{gap: opts.Gap, decode: decodeModbusRTU}, nil
+	case NMEA:
+		return &framer{split: splitAt([]byte("\n")), gap: opts.Gap, decode: decodeNMEA}, nil
+	case AT:
+		return &framer{split: splitAtAny("\r\n"), gap: opts.Gap, decode: decodeAT}, nil
+	case Megatec:
+		return &framer{split: splitAt([]byte("\r")), gap: opts.Gap, decode: decodeMegatec}, nil
+	case Custom:
+		if opts.Pattern == "" {
+			return nil, fmt.Errorf("%w: custom needs a pattern", ErrInvalid)
+		}
+		pattern, err := regexp.Compile(opts.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+		}
+		terminator := opts.Terminator
+		if len(terminator) == 0 {
+			terminator = []byte("\n")
+		}
+		return &framer{
+			split:  splitAt(terminator),
+			gap:    opts.Gap,
+			decode: customDecoder(pattern, terminator),
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown decoder %q", ErrInvalid, name)
+	}
+}
+
+// framer splits a stream into frames at terminators and after gaps, and
+// decodes each
+type framer struct {
+	// split returns the length of the first frame in data including its
+	// terminator, or -1 (nil: frames end at gaps only)
+	split func(data []byte) int
+	gap   time.Duration
+	// decode describes a frame; false skips it, e.g. a blank line
+	decode func(raw []byte) (Frame, bool)
+
+	pending []byte
+	started time.Time
+	last    time.Time
+}
+
+// Feed adds received bytes and returns the frames they complete
+func (f *framer) Feed(data []byte, now time.Time) []Frame {
+	var frames []Frame
+	// A gap the caller's timer has not flushed yet still ends the frame
+	if f.gap > 0 && len(f.pending) > 0 && now.Sub(f.last) >= f.gap {
+		frames = f.Flush()
+	}
+	f.last = now
+
+	if len(f.pending) == 0 {
+		f.started = now
+	}
+	f.pending = append(f.pending, data...)
+
+	for f.split != nil && len(f.pending) > 0 {
+		end := f.split(f.pending)
+		if end < 0 {
+			break
+		}
+		frames = f.emit(frames, f.pending[:end])
+		f.pending = append(f.pending[:0], f.pending[end:]...)
+		f.started = now
+	}
+	if len(f.pending) > maxFrameBytes {
+		frames = f.emit(frames, f.pending)
+		f.pending = f.pending[:0]
+	}
+	return frames
+}
+
+// Flush completes the pending frame after Gap without input
+func (f *framer) Flush() []Frame {
+	if len(f.pending) == 0 {
+		return nil
+	}
+	frames := f.emit(nil, f.pending)
+	f.pending = f.pending[:0]
+	return frames
+}
+
+// Gap is the silence that ends a frame
+func (f *framer) Gap() time.Duration {
+	return f.gap
+}
+
+// emit decodes a copy of raw and appends it to frames
+func (f *framer) emit(frames []Frame, raw []byte) []Frame {
+	frame, ok := f.decode(bytes.Clone(raw))
+	if !ok {
+		return frames
+	}
+	frame.Timestamp = f.started
+	return append(frames, frame)
+}
+
+// splitAt splits after each occurrence of a terminator
+func splitAt(terminator []byte) func([]byte) int {
+	return func(data []byte) int {
+		i := bytes.Index(data, terminator)
+		if i < 0 {
+			return -1
+		}
+		return i + len(terminator)
+	}
+}
+
+// splitAtAny splits after any one of the terminator bytes
+func splitAtAny(terminators string) func([]byte) int {
+	return func(data []byte) int {
+		i := bytes.IndexAny(data, terminators)
+		if i < 0 {
+			return -1
+		}
+		return i + 1
+	}
+}
+
+// ModbusGap returns the silence of 3.5 characters that ends a Modbus RTU
+// frame at a baud rate, fixed at 1.75ms above 19200 baud as the
+// specification has it. Serial reads are not timed that precisely, so
+// the gap is at least minGap.
+func ModbusGap(baudRate int) time.Duration {
+	const minGap = 5 * time.Millisecond
+
+	gap := 1750 * time.Microsecond
+	if baudRate > 0 && baudRate <= 19200 {
+		// 11 bits per character: start, 8 data, parity or stop, stop
+		gap = time.Duration(3.5 * 11 * float64(time.Second) / float64(baudRate))
+	}
+	return max(gap, minGap)
+}
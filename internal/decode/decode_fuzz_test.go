@@ -0,0 +1,98 @@
+package decode
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// fuzzDecoder feeds input to a decoder whole and split in two, and checks
+// both give the same frames
+func fuzzDecoder(f *testing.F, name string, opts Options, seeds ...string) {
+	for _, seed := range seeds {
+		f.Add([]byte(seed), uint16(len(seed)/2))
+	}
+	if _, err := New(name, opts); err != nil {
+		f.Fatalf("decoder %s: %v", name, err)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte, split uint16) {
+		if len(data) > maxFrameBytes {
+			// Oversized input is cut where the reads end
+			return
+		}
+		now := time.Unix(0, 0)
+		decode := func(parts ...[]byte) []Frame {
+			d, _ := New(name, opts)
+			var frames []Frame
+			for _, part := range parts {
+				frames = append(frames, d.Feed(part, now)...)
+			}
+			return append(frames, d.Flush()...)
+		}
+
+		whole := decode(data)
+		at := int(split) % (len(data) + 1)
+		pieces := decode(data[:at], data[at:])
+		if !reflect.DeepEqual(whole, pieces) {
+			t.Fatalf("split at %d gave %+v, whole input %+v", at, pieces, whole)
+		}
+
+		total := 0
+		for _, frame := range whole {
+			if len(frame.Raw) == 0 {
+				t.Fatalf("frame without data: %+v", frame)
+			}
+			total += len(frame.Raw)
+		}
+		if total > len(data) {
+			t.Fatalf("frames hold %d bytes of %d received", total, len(data))
+		}
+	})
+}
+
+func FuzzNMEA(f *testing.F) {
+	fuzzDecoder(f, NMEA, Options{},
+		"$GPGGA,123519,4807.038,N,01131.000,E,1,08,0.9,545.4,M,46.9,M,,*47\r\n",
+		"$GPRMC,123519,A,4807.038,N,01131.000,E,022.4,084.4,230394,003.1,W*6A\r\n",
+		"$GPGLL,4916.45,N,12311.12,W,225444,A,*1D\r\n$GPGSV,bad*00\r\n",
+		"$GPGGA,,,,,,,,,,,,,,*ZZ\r\n",
+		"no dollar sign\n\n\n",
+	)
+}
+
+func FuzzModbusRTU(f *testing.F) {
+	fuzzDecoder(f, ModbusRTU, Options{Gap: ModbusGap(9600)},
+		"\x01\x03\x00\x00\x00\x02\xc4\x0b",
+		"\x01\x03\x04\x00\xfa\x01\x2c\x1b\xc7",
+		"\x11\x83\x02\xc1\x34",
+		"\x01",
+		"\x01\x10\x00\x01\x00\x02\x04\x00\x0a\x01\x02\x00\x00",
+	)
+}
+
+func FuzzAT(f *testing.F) {
+	fuzzDecoder(f, AT, Options{},
+		"AT+CSQ\r\r\n+CSQ: 21,99\r\n\r\nOK\r\n",
+		"AT+COPS=1,2,\"26201\"\r\nERROR\r\n",
+		"+CME ERROR: 10\r\n",
+		"ATE0\rRING\r\n",
+	)
+}
+
+func FuzzMegatec(f *testing.F) {
+	fuzzDecoder(f, Megatec, Options{},
+		"Q1\r(208.4 140.0 208.4 034 59.9 2.05 35.0 00110000\r",
+		"F\r#220.0 000 024.0 50.0\r",
+		"T03\rS.5R0030\rQ\r",
+		"(\r#\r",
+	)
+}
+
+func FuzzCustom(f *testing.F) {
+	fuzzDecoder(f, Custom, Options{Pattern: `^(?P<id>\d+):(?P<value>-?\d+(\.\d+)?)`, Terminator: []byte(";")},
+		"1:23.5;2:-4;",
+		"x:y;;;",
+		"99999999999999999999:1",
+	)
+}
@@ -0,0 +1,132 @@
+package decode
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/Shoaibashk/SerialLink/internal/ups"
+)
+
+// megatecCommands names the commands without arguments
+var megatecCommands = map[string]string{
+	"Q1": "status query",
+	"Q":  "toggle beeper",
+	"F":  "rating query",
+	"I":  "identification query",
+	"T":  "10 second battery test",
+	"TL": "battery test until low",
+	"CT": "cancel test",
+	"C":  "cancel shutdown",
+}
+
+// decodeMegatec decodes one line of the Megatec UPS protocol, from either
+// side
+func decodeMegatec(raw []byte) (Frame, bool) {
+	line := strings.TrimSpace(string(bytes.TrimRight(raw, "\r\n")))
+	if line == "" {
+		return Frame{}, false
+	}
+	frame := Frame{Raw: raw}
+
+	switch {
+	case strings.HasPrefix(line, "("):
+		status, err := ups.ParseStatus([]byte(line))
+		if err != nil {
+			frame.Summary = "malformed status"
+			frame.Error = err.Error()
+			return frame, true
+		}
+		tokens := status.Flags.Status()
+		frame.Fields = []Field{{Name: "type", Value: "status"}, {Name: "ups.status", Value: tokens}}
+		for _, r := range status.Readings() {
+			if !strings.HasPrefix(r.Name, "ups.status.") {
+				frame.Fields = append(frame.Fields, Field{Name: r.Name, Value: formatReading(r.Value)})
+			}
+		}
+		frame.Summary = fmt.Sprintf("status %s: input %sV, output %sV, load %s%%, battery %sV",
+			tokens, formatReading(status.InputVoltage), formatReading(status.OutputVoltage),
+			formatReading(status.Load), formatReading(status.BatteryVoltage))
+	case strings.HasPrefix(line, "#"):
+		if rating, err := ups.ParseRating([]byte(line)); err == nil {
+			frame.Fields = []Field{
+				{Name: "type", Value: "rating"},
+				{Name: "input.voltage.nominal", Value: formatReading(rating.Voltage)},
+				{Name: "input.current.nominal", Value: formatReading(rating.Current)},
+				{Name: "battery.voltage.nominal", Value: formatReading(rating.BatteryVoltage)},
+				{Name: "input.frequency.nominal", Value: formatReading(rating.Frequency)},
+			}
+			frame.Summary = fmt.Sprintf("rating: %sV %sA, battery %sV, %sHz", formatReading(rating.Voltage),
+				formatReading(rating.Current), formatReading(rating.BatteryVoltage), formatReading(rating.Frequency))
+			return frame, true
+		}
+		info, _ := ups.ParseInfo([]byte(line))
+		frame.Fields = []Field{
+			{Name: "type", Value: "identification"},
+			{Name: "ups.mfr", Value: info.Manufacturer},
+			{Name: "ups.model", Value: info.Model},
+			{Name: "ups.firmware", Value: info.Version},
+		}
+		frame.Summary = strings.TrimSpace(fmt.Sprintf("identification: %s %s %s", info.Manufacturer, info.Model, info.Version))
+	default:
+		command, arguments := megatecCommand(line)
+		if command == "" {
+			frame.Fields = []Field{{Name: "type", Value: "text"}}
+			frame.Summary = "text: " + line
+			return frame, true
+		}
+		frame.Fields = append([]Field{{Name: "type", Value: "command"}, {Name: "command", Value: line}}, arguments...)
+		frame.Summary = command
+	}
+	return frame, true
+}
+
+// megatecCommand describes a command, "" for other text
+func megatecCommand(line string) (string, []Field) {
+	if name := megatecCommands[line]; name != "" {
+		return name, nil
+	}
+	switch {
+	case strings.HasPrefix(line, "T"):
+		if minutes, ok := megatecMinutes(line[1:]); ok {
+			return "battery test for " + minutes + " min", []Field{{Name: "minutes", Value: minutes}}
+		}
+	case strings.HasPrefix(line, "S"):
+		shutdown, restore, hasRestore := strings.Cut(line[1:], "R")
+		minutes, ok := megatecMinutes(shutdown)
+		if !ok {
+			return "", nil
+		}
+		fields := []Field{{Name: "shutdown_minutes", Value: minutes}}
+		summary := "shutdown in " + minutes + " min"
+		if hasRestore {
+			restoreMinutes, ok := megatecMinutes(restore)
+			if !ok {
+				return "", nil
+			}
+			fields = append(fields, Field{Name: "restore_minutes", Value: restoreMinutes})
+			summary += ", restore after " + restoreMinutes + " min"
+		}
+		return summary, fields
+	}
+	return "", nil
+}
+
+// megatecMinutes parses the minutes of a command, "05" or ".5"
+func megatecMinutes(s string) (string, bool) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || v < 0 || s == "" || strings.ContainsAny(s, "+-eE") {
+		return "", false
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64), true
+}
+
+// formatReading formats a UPS reading, "n/a" for one not reported
+func formatReading(v float64) string {
+	if math.IsNaN(v) {
+		return "n/a"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
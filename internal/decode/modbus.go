@@ -0,0 +1,159 @@
+package decode
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// modbusFunctions names the Modbus function codes
+var modbusFunctions = map[byte]string{
+	1:  "read coils",
+	2:  "read discrete inputs",
+	3:  "read holding registers",
+	4:  "read input registers",
+	5:  "write single coil",
+	6:  "write single register",
+	7:  "read exception status",
+	8:  "diagnostics",
+	15: "write multiple coils",
+	16: "write multiple registers",
+	17: "report server ID",
+	23: "read/write multiple registers",
+	43: "encapsulated interface transport",
+}
+
+// modbusExceptions names the Modbus exception codes
+var modbusExceptions = map[byte]string{
+	1:  "illegal function",
+	2:  "illegal data address",
+	3:  "illegal data value",
+	4:  "server device failure",
+	5:  "acknowledge",
+	6:  "server device busy",
+	8:  "memory parity error",
+	10: "gateway path unavailable",
+	11: "gateway target device failed to respond",
+}
+
+// decodeModbusRTU decodes one Modbus RTU frame. Requests and responses
+// share function codes, so which one a frame is follows from its length.
+func decodeModbusRTU(raw []byte) (Frame, bool) {
+	frame := Frame{Raw: raw}
+	if len(raw) < 4 {
+		frame.Summary = "runt frame"
+		frame.Error = fmt.Sprintf("%d bytes is too short for a frame", len(raw))
+		return frame, true
+	}
+
+	pdu := raw[:len(raw)-2]
+	given := binary.LittleEndian.Uint16(raw[len(raw)-2:])
+	if sum := modbusCRC(pdu); sum != given {
+		frame.Error = fmt.Sprintf("CRC mismatch: got %04X, computed %04X", given, sum)
+	}
+
+	unit, function, data := pdu[0], pdu[1], pdu[2:]
+	name := modbusFunctions[function&0x7F]
+	if name == "" {
+		name = fmt.Sprintf("function %d", function&0x7F)
+	}
+	frame.Fields = []Field{
+		{Name: "unit", Value: strconv.Itoa(int(unit))},
+		{Name: "function", Value: fmt.Sprintf("%d (%s)", function&0x7F, name)},
+	}
+	frame.Summary = fmt.Sprintf("unit %d %s", unit, name)
+
+	switch {
+	case function&0x80 != 0:
+		frame.Summary = fmt.Sprintf("unit %d exception for %s", unit, name)
+		if len(data) == 1 {
+			exception := modbusExceptions[data[0]]
+			if exception == "" {
+				exception = "unknown"
+			}
+			frame.Fields = append(frame.Fields, Field{Name: "exception", Value: fmt.Sprintf("%d (%s)", data[0], exception)})
+			frame.Summary += ": " + exception
+		}
+	case function >= 1 && function <= 4 && len(data) == 4:
+		address, quantity := binary.BigEndian.Uint16(data), binary.BigEndian.Uint16(data[2:])
+		frame.Fields = append(frame.Fields,
+			Field{Name: "address", Value: strconv.Itoa(int(address))},
+			Field{Name: "quantity", Value: strconv.Itoa(int(quantity))},
+		)
+		frame.Summary += fmt.Sprintf(" request: %d from %d", quantity, address)
+	case function >= 1 && function <= 4 && len(data) >= 1 && int(data[0]) == len(data)-1:
+		values := modbusValues(function, data[1:])
+		frame.Fields = append(frame.Fields,
+			Field{Name: "byte count", Value: strconv.Itoa(int(data[0]))},
+			Field{Name: "values", Value: values},
+		)
+		frame.Summary += " response: " + values
+	case (function == 5 || function == 6) && len(data) == 4:
+		address, value := binary.BigEndian.Uint16(data), binary.BigEndian.Uint16(data[2:])
+		shown := strconv.Itoa(int(value))
+		if function == 5 {
+			shown = "off"
+			if value == 0xFF00 {
+				shown = "on"
+			}
+		}
+		frame.Fields = append(frame.Fields,
+			Field{Name: "address", Value: strconv.Itoa(int(address))},
+			Field{Name: "value", Value: shown},
+		)
+		frame.Summary += fmt.Sprintf(" %d = %s", address, shown)
+	case (function == 15 || function == 16) && len(data) == 4:
+		address, quantity := binary.BigEndian.Uint16(data), binary.BigEndian.Uint16(data[2:])
+		frame.Fields = append(frame.Fields,
+			Field{Name: "address", Value: strconv.Itoa(int(address))},
+			Field{Name: "quantity", Value: strconv.Itoa(int(quantity))},
+		)
+		frame.Summary += fmt.Sprintf(" response: %d from %d", quantity, address)
+	case (function == 15 || function == 16) && len(data) >= 5 && int(data[4]) == len(data)-5:
+		address, quantity := binary.BigEndian.Uint16(data), binary.BigEndian.Uint16(data[2:])
+		values := modbusValues(function, data[5:])
+		frame.Fields = append(frame.Fields,
+			Field{Name: "address", Value: strconv.Itoa(int(address))},
+			Field{Name: "quantity", Value: strconv.Itoa(int(quantity))},
+			Field{Name: "values", Value: values},
+		)
+		frame.Summary += fmt.Sprintf(" request: %d from %d: %s", quantity, address, values)
+	case len(data) > 0:
+		frame.Fields = append(frame.Fields, Field{Name: "data", Value: hex.EncodeToString(data)})
+	}
+
+	if frame.Error != "" {
+		frame.Summary += " (" + frame.Error + ")"
+	}
+	return frame, true
+}
+
+// modbusValues formats register values, or coil and input bits as hex
+func modbusValues(function byte, data []byte) string {
+	if function == 1 || function == 2 || function == 15 || len(data)%2 != 0 {
+		return hex.EncodeToString(data)
+	}
+	registers := make([]string, 0, len(data)/2)
+	for i := 0; i < len(data); i += 2 {
+		registers = append(registers, strconv.Itoa(int(binary.BigEndian.Uint16(data[i:]))))
+	}
+	return strings.Join(registers, " ")
+}
+
+// modbusCRC computes the CRC-16/MODBUS of data
+func modbusCRC(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		crc ^= uint16(b)
+		for range 8 {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ 0xA001
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,225 @@
+package decode
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// nmeaSentences names the common sentence types
+var nmeaSentences = map[string]string{
+	"GGA": "fix data",
+	"GLL": "position",
+	"GSA": "DOP and active satellites",
+	"GSV": "satellites in view",
+	"RMC": "recommended minimum data",
+	"TXT": "text",
+	"VTG": "course and speed",
+	"ZDA": "time and date",
+}
+
+// nmeaFixQualities names the fix qualities of GGA
+var nmeaFixQualities = map[string]string{
+	"0": "no fix",
+	"1": "GPS fix",
+	"2": "DGPS fix",
+	"4": "RTK fixed",
+	"5": "RTK float",
+	"6": "estimated",
+}
+
+// decodeNMEA decodes one NMEA 0183 sentence
+func decodeNMEA(raw []byte) (Frame, bool) {
+	line := string(bytes.TrimRight(raw, "\r\n"))
+	if strings.TrimSpace(line) == "" {
+		return Frame{}, false
+	}
+	frame := Frame{Raw: raw}
+
+	start := strings.IndexAny(line, "$!")
+	if start < 0 {
+		frame.Summary = "not an NMEA sentence"
+		frame.Error = "missing $ or ! start"
+		return frame, true
+	}
+	body := line[start+1:]
+
+	if star := strings.LastIndexByte(body, '*'); star >= 0 {
+		given := body[star+1:]
+		body = body[:star]
+		var sum byte
+		for i := 0; i < len(body); i++ {
+			sum ^= body[i]
+		}
+		want, err := strconv.ParseUint(given, 16, 8)
+		switch {
+		case err != nil:
+			frame.Error = fmt.Sprintf("bad checksum %q", given)
+		case byte(want) != sum:
+			frame.Error = fmt.Sprintf("checksum mismatch: got %02X, computed %02X", want, sum)
+		}
+	}
+
+	fields := strings.Split(body, ",")
+	address := fields[0]
+	values := fields[1:]
+	sentence := address
+	if len(address) == 5 {
+		frame.Fields = append(frame.Fields, Field{Name: "talker", Value: address[:2]})
+		sentence = address[2:]
+	}
+	frame.Fields = append(frame.Fields, Field{Name: "sentence", Value: sentence})
+
+	name := nmeaSentences[sentence]
+	if name == "" {
+		name = "sentence"
+	}
+	frame.Summary = address + " " + name
+
+	var details []string
+	switch sentence {
+	case "GGA":
+		details = decodeGGA(&frame, values)
+	case "RMC":
+		details = decodeRMC(&frame, values)
+	case "GLL":
+		details = decodeGLL(&frame, values)
+	default:
+		for i, value := range values {
+			frame.Fields = append(frame.Fields, Field{Name: strconv.Itoa(i + 1), Value: value})
+		}
+	}
+	if len(details) > 0 {
+		frame.Summary += ": " + strings.Join(details, ", ")
+	}
+	if frame.Error != "" {
+		frame.Summary += " (" + frame.Error + ")"
+	}
+	return frame, true
+}
+
+// decodeGGA adds the fields of a GGA sentence and returns its summary
+func decodeGGA(frame *Frame, values []string) []string {
+	values = pad(values, 14)
+	position := nmeaPosition(values[1], values[2], values[3], values[4])
+	quality := nmeaFixQualities[values[5]]
+	if quality == "" {
+		quality = values[5]
+	}
+	frame.Fields = append(frame.Fields,
+		Field{Name: "time", Value: nmeaTime(values[0])},
+		Field{Name: "position", Value: position},
+		Field{Name: "quality", Value: quality},
+		Field{Name: "satellites", Value: values[6]},
+		Field{Name: "hdop", Value: values[7]},
+		Field{Name: "altitude", Value: strings.TrimSpace(values[8] + " " + strings.ToLower(values[9]))},
+	)
+	return nonEmpty(position, quality, suffixed(values[6], " satellites"))
+}
+
+// decodeRMC adds the fields of an RMC sentence and returns its summary
+func decodeRMC(frame *Frame, values []string) []string {
+	values = pad(values, 11)
+	position := nmeaPosition(values[2], values[3], values[4], values[5])
+	status := nmeaStatus(values[1])
+	frame.Fields = append(frame.Fields,
+		Field{Name: "time", Value: nmeaTime(values[0])},
+		Field{Name: "status", Value: status},
+		Field{Name: "position", Value: position},
+		Field{Name: "speed", Value: suffixed(values[6], " kn")},
+		Field{Name: "course", Value: values[7]},
+		Field{Name: "date", Value: nmeaDate(values[8])},
+	)
+	return nonEmpty(position, suffixed(values[6], " kn"), status)
+}
+
+// decodeGLL adds the fields of a GLL sentence and returns its summary
+func decodeGLL(frame *Frame, values []string) []string {
+	values = pad(values, 6)
+	position := nmeaPosition(values[0], values[1], values[2], values[3])
+	status := nmeaStatus(values[5])
+	frame.Fields = append(frame.Fields,
+		Field{Name: "position", Value: position},
+		Field{Name: "time", Value: nmeaTime(values[4])},
+		Field{Name: "status", Value: status},
+	)
+	return nonEmpty(position, status)
+}
+
+// nmeaPosition converts ddmm.mmmm and dddmm.mmmm coordinates to degrees
+func nmeaPosition(lat, latHemisphere, lon, lonHemisphere string) string {
+	latitude, ok1 := nmeaDegrees(lat, 2)
+	longitude, ok2 := nmeaDegrees(lon, 3)
+	if !ok1 || !ok2 {
+		return ""
+	}
+	return fmt.Sprintf("%.5f°%s %.5f°%s", latitude, latHemisphere, longitude, lonHemisphere)
+}
+
+// nmeaDegrees converts a coordinate with degDigits degree digits
+func nmeaDegrees(value string, degDigits int) (float64, bool) {
+	if len(value) < degDigits+2 {
+		return 0, false
+	}
+	degrees, err1 := strconv.ParseFloat(value[:degDigits], 64)
+	minutes, err2 := strconv.ParseFloat(value[degDigits:], 64)
+	if err1 != nil || err2 != nil {
+		return 0, false
+	}
+	return degrees + minutes/60, true
+}
+
+// nmeaTime formats hhmmss.ss as hh:mm:ss.ss
+func nmeaTime(value string) string {
+	if len(value) < 6 {
+		return value
+	}
+	return value[0:2] + ":" + value[2:4] + ":" + value[4:]
+}
+
+// nmeaDate formats ddmmyy as dd.mm.yy
+func nmeaDate(value string) string {
+	if len(value) != 6 {
+		return value
+	}
+	return value[0:2] + "." + value[2:4] + "." + value[4:6]
+}
+
+// nmeaStatus names the A/V status of a sentence
+func nmeaStatus(value string) string {
+	switch value {
+	case "A":
+		return "valid"
+	case "V":
+		return "warning"
+	}
+	return value
+}
+
+// pad extends values to at least n entries
+func pad(values []string, n int) []string {
+	for len(values) < n {
+		values = append(values, "")
+	}
+	return values
+}
+
+// suffixed appends a unit to a value that is set
+func suffixed(value, unit string) string {
+	if value == "" {
+		return ""
+	}
+	return value + unit
+}
+
+// nonEmpty returns the values that are set
+func nonEmpty(values ...string) []string {
+	var set []string
+	for _, value := range values {
+		if value != "" {
+			set = append(set, value)
+		}
+	}
+	return set
+}
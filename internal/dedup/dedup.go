@@ -0,0 +1,88 @@
+// Package dedup collapses runs of identical lines, such as a device's
+// heartbeat, into a single event with a repeat count.
+//
+// The first line of a run passes as it is, so output is not delayed; the
+// repeats that follow are held back and reported as one event when the run
+// ends, and at an interval while it goes on, in the manner of syslog's
+// "last message repeated N times". Stream applies this to raw data, split
+// into lines, and can also keep only the lines that match.
+package dedup
+
+import (
+	"bytes"
+	"time"
+)
+
+// Event is a line to pass on
+type Event struct {
+	Line []byte
+	// Time is when the line arrived, or for repeats when the last one did
+	Time time.Time
+	// Repeats is 0 for a line as it arrived; otherwise the event stands for
+	// this many further copies of Line
+	Repeats int
+}
+
+// Filter collapses repeated lines. It is not safe for concurrent use.
+type Filter struct {
+	interval time.Duration
+
+	last []byte
+	// repeats of last held back since the last event, the first at since
+	repeats    int
+	since      time.Time
+	lastRepeat time.Time
+}
+
+// New creates a filter that reports a run still going on at every interval
+// (0: only when it ends)
+func New(interval time.Duration) *Filter {
+	return &Filter{interval: interval}
+}
+
+// Add filters a line and returns the events to pass on: none for a repeat,
+// otherwise the held repeats of the previous line, if any, and the line
+func (f *Filter) Add(t time.Time, line []byte) []Event {
+	if f.last != nil && bytes.Equal(line, f.last) {
+		if f.repeats == 0 {
+			f.since = t
+		}
+		f.repeats++
+		f.lastRepeat = t
+		return nil
+	}
+
+	var events []Event
+	if event, ok := f.release(); ok {
+		events = append(events, event)
+	}
+	f.last = append(f.last[:0], line...)
+	return append(events, Event{Line: line, Time: t})
+}
+
+// Tick reports the repeats held back since the last event once they span
+// the interval
+func (f *Filter) Tick(now time.Time) (Event, bool) {
+	if f.interval <= 0 || f.repeats == 0 || now.Sub(f.since) < f.interval {
+		return Event{}, false
+	}
+	return f.release()
+}
+
+// Flush ends the current run, reporting the repeats held back, if any; the
+// next line passes even if it repeats the last
+func (f *Filter) Flush() (Event, bool) {
+	event, ok := f.release()
+	f.last = nil
+	return event, ok
+}
+
+// release reports and resets the held repeats
+func (f *Filter) release() (Event, bool) {
+	if f.repeats == 0 {
+		return Event{}, false
+	}
+	event := Event{Line: bytes.Clone(f.last), Time: f.lastRepeat, Repeats: f.repeats}
+	f.repeats = 0
+	return event, true
+}
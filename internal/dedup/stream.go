@@ -0,0 +1,121 @@
+package dedup
+
+import (
+	"bytes"
+	"time"
+)
+
+// maxLineLength bounds a line before it passes without a newline
+const maxLineLength = 4096
+
+// Stream splits a stream of data chunks into lines, optionally keeping only
+// those that match and collapsing repeats. Lines keep their newline. It is
+// not safe for concurrent use.
+type Stream struct {
+	// hold is how long the start of a line waits for the rest
+	hold time.Duration
+	// match keeps a line when set
+	match func(line []byte) bool
+	// filter collapses repeats when set
+	filter *Filter
+
+	partial   []byte
+	partialAt time.Time
+}
+
+// NewStream creates a stream that passes a line still unfinished after hold
+// as it is, e.g. a prompt
+func NewStream(hold time.Duration) *Stream {
+	return &Stream{hold: hold}
+}
+
+// Match keeps only the lines for which match returns true. It is given a
+// line without its line ending.
+func (s *Stream) Match(match func(line []byte) bool) {
+	s.match = match
+}
+
+// Collapse collapses runs of identical lines, reporting a run at every
+// interval (0: only when it ends)
+func (s *Stream) Collapse(interval time.Duration) {
+	s.filter = New(interval)
+}
+
+// Write returns the lines completed by data
+func (s *Stream) Write(t time.Time, data []byte) []Event {
+	var events []Event
+	for len(data) > 0 {
+		if len(s.partial) == 0 {
+			s.partialAt = t
+		}
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			s.partial = append(s.partial, data...)
+			if len(s.partial) >= maxLineLength {
+				events = s.passPartial(events)
+			}
+			break
+		}
+		s.partial = append(s.partial, data[:i+1]...)
+		data = data[i+1:]
+		events = s.pass(events, s.partialAt, s.partial)
+		s.partial = nil
+	}
+	return events
+}
+
+// Tick returns collapsed runs that span the interval and a line unfinished
+// for longer than hold
+func (s *Stream) Tick(now time.Time) []Event {
+	var events []Event
+	if s.filter != nil {
+		if event, ok := s.filter.Tick(now); ok {
+			events = append(events, event)
+		}
+	}
+	if len(s.partial) > 0 && now.Sub(s.partialAt) >= s.hold {
+		events = s.passPartial(events)
+	}
+	return events
+}
+
+// Flush returns everything held back
+func (s *Stream) Flush() []Event {
+	var events []Event
+	if len(s.partial) > 0 {
+		return s.passPartial(events)
+	}
+	if s.filter != nil {
+		if event, ok := s.filter.Flush(); ok {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// pass adds a complete line to events if it matches
+func (s *Stream) pass(events []Event, t time.Time, line []byte) []Event {
+	if s.match != nil && !s.match(bytes.TrimRight(line, "\r\n")) {
+		return events
+	}
+	if s.filter == nil {
+		return append(events, Event{Line: line, Time: t})
+	}
+	return append(events, s.filter.Add(t, line)...)
+}
+
+// passPartial passes the unfinished line as it is if it matches, ending
+// the current run as its rest will not be compared
+func (s *Stream) passPartial(events []Event) []Event {
+	line := s.partial
+	s.partial = nil
+	if s.match != nil && !s.match(bytes.TrimRight(line, "\r\n")) {
+		return events
+	}
+	if s.filter != nil {
+		if event, ok := s.filter.Flush(); ok {
+			events = append(events, event)
+		}
+	}
+	return append(events, Event{Line: line, Time: s.partialAt})
+}
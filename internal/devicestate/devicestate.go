@@ -0,0 +1,453 @@
+// Package devicestate tracks the state of serial devices (initializing,
+// ready, failed, updating) from their output and probe commands, so
+// orchestration can treat devices like services.
+package devicestate
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/charmbracelet/log"
+)
+
+// ClientID identifies sessions opened by the tracker
+const ClientID = "devicestate"
+
+// Device states
+const (
+	StateUnknown      = "UNKNOWN"
+	StateInitializing = "INITIALIZING"
+	StateReady        = "READY"
+	StateError        = "ERROR"
+	StateUpdating     = "UPDATING"
+)
+
+const (
+	// retryInterval is the delay before looking at a port again
+	retryInterval = 5 * time.Second
+
+	// maxLineLength bounds a line before it is matched without a newline
+	maxLineLength = 1024
+
+	// maxReadErrors is how many consecutive read errors end a session
+	maxReadErrors = 10
+)
+
+// ValidState reports whether s is a device state
+func ValidState(s string) bool {
+	switch s {
+	case StateUnknown, StateInitializing, StateReady, StateError, StateUpdating:
+		return true
+	}
+	return false
+}
+
+// Rule moves a device to State when a line of its output matches Pattern
+type Rule struct {
+	Pattern *regexp.Regexp
+	State   string
+}
+
+// Probe is a command sent periodically while the tracker owns the port.
+// The response is matched against the rules; no response at all within
+// Timeout moves the device to ERROR.
+type Probe struct {
+	Command  []byte
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+// Definition describes a tracked device
+type Definition struct {
+	Name     string
+	PortName string
+	// Config is used when the tracker opens the port
+	Config serial.PortConfig
+	// Open lets the tracker open the port while no client has it. Probes
+	// only run on the tracker's own session.
+	Open  bool
+	Rules []Rule
+	Probe Probe
+}
+
+// Status is the current state of a device
+type Status struct {
+	Device   string
+	PortName string
+	State    string
+	Previous string
+	// Reason explains the last transition, e.g. the line that matched
+	Reason string
+	Since  time.Time
+}
+
+// Publisher sends messages to a broker, e.g. an MQTT publisher
+type Publisher interface {
+	Publish(topic string, payload []byte) error
+}
+
+// Tracker follows the state of devices
+type Tracker struct {
+	manager *serial.Manager
+	defs    []Definition
+	logger  *log.Logger
+	wg      sync.WaitGroup
+
+	publisher   Publisher
+	topicPrefix string
+
+	mu     sync.RWMutex
+	states map[string]Status
+	subs   []chan Status
+}
+
+// NewTracker creates a tracker for the given devices, all UNKNOWN
+func NewTracker(manager *serial.Manager, defs []Definition, logger *log.Logger) *Tracker {
+	t := &Tracker{
+		manager: manager,
+		defs:    defs,
+		logger:  logger,
+		states:  make(map[string]Status, len(defs)),
+	}
+	now := time.Now()
+	for _, def := range defs {
+		t.states[def.Name] = Status{
+			Device:   def.Name,
+			PortName: def.PortName,
+			State:    StateUnknown,
+			Reason:   "not observed yet",
+			Since:    now,
+		}
+	}
+	return t
+}
+
+// SetPublisher publishes every transition to "<prefix>/devices/<name>".
+// Call before Start.
+func (t *Tracker) SetPublisher(publisher Publisher, prefix string) {
+	t.publisher = publisher
+	t.topicPrefix = strings.TrimSuffix(prefix, "/")
+}
+
+// Start tracks every device until ctx is cancelled
+func (t *Tracker) Start(ctx context.Context) {
+	for _, def := range t.defs {
+		t.wg.Add(1)
+		go func(def Definition) {
+			defer t.wg.Done()
+			t.run(ctx, def)
+		}(def)
+	}
+}
+
+// Wait blocks until tracking has stopped
+func (t *Tracker) Wait() {
+	t.wg.Wait()
+}
+
+// Names returns the tracked devices in configuration order
+func (t *Tracker) Names() []string {
+	names := make([]string, 0, len(t.defs))
+	for _, def := range t.defs {
+		names = append(names, def.Name)
+	}
+	return names
+}
+
+// List returns the status of every device in configuration order
+func (t *Tracker) List() []Status {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	result := make([]Status, 0, len(t.defs))
+	for _, def := range t.defs {
+		result = append(result, t.states[def.Name])
+	}
+	return result
+}
+
+// Subscribe returns a channel receiving every transition
+func (t *Tracker) Subscribe() <-chan Status {
+	ch := make(chan Status, 32)
+	t.mu.Lock()
+	t.subs = append(t.subs, ch)
+	t.mu.Unlock()
+	return ch
+}
+
+// Unsubscribe removes a subscription
+func (t *Tracker) Unsubscribe(ch <-chan Status) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for i, sub := range t.subs {
+		if sub == ch {
+			close(sub)
+			t.subs = append(t.subs[:i], t.subs[i+1:]...)
+			return
+		}
+	}
+}
+
+// run follows one device: it mirrors the session of the client that has
+// the port, or opens the port itself when allowed
+func (t *Tracker) run(ctx context.Context, def Definition) {
+	events := t.manager.SubscribeEvents()
+	defer t.manager.UnsubscribeEvents(events)
+
+	for ctx.Err() == nil {
+		session := t.manager.GetSession(def.PortName)
+		switch {
+		case session != nil && session.ClientID != ClientID:
+			if t.mirror(ctx, def, session) {
+				t.set(def, StateUnknown, "port closed")
+			}
+		case def.Open:
+			if err := t.own(ctx, def); err != nil {
+				t.set(def, StateUnknown, err.Error())
+			}
+		default:
+			t.set(def, StateUnknown, "port is not open")
+		}
+
+		// Look again when a client opens the port, or after a while
+		timer := time.NewTimer(retryInterval)
+	wait:
+		for {
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+				break wait
+			case event := <-events:
+				if event.PortName == def.PortName && event.Type == serial.PortEventOpened && event.ClientID != ClientID {
+					timer.Stop()
+					break wait
+				}
+			}
+		}
+	}
+}
+
+// mirror follows the output read by another client's session. It returns
+// true if the session ended while ctx is live.
+func (t *Tracker) mirror(ctx context.Context, def Definition, session *serial.Session) bool {
+	data, err := t.manager.SubscribeToReads(def.PortName, session.ID, "devicestate "+def.Name)
+	if err != nil {
+		return false
+	}
+	defer func() {
+		_ = t.manager.UnsubscribeFromReads(def.PortName, session.ID, data)
+	}()
+
+	var lines lineBuffer
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case chunk, ok := <-data:
+			if !ok {
+				return true
+			}
+			t.feed(def, &lines, chunk)
+		}
+	}
+}
+
+// own opens the port and follows its output, sending probes. It returns
+// nil when the session ends or ctx is cancelled.
+func (t *Tracker) own(ctx context.Context, def Definition) error {
+	session, err := t.manager.OpenPort(def.PortName, def.Config, ClientID, false)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = t.manager.ClosePort(def.PortName, session.ID)
+		if ctx.Err() == nil {
+			t.set(def, StateUnknown, "port closed")
+		}
+	}()
+
+	reader := serial.NewReader(t.manager, def.PortName, session.ID, 1024)
+	reader.SetConsumer("devicestate " + def.Name)
+	subscription := reader.Subscribe()
+	if err := reader.Start(ctx); err != nil {
+		return err
+	}
+	defer reader.Stop()
+
+	var probes <-chan time.Time
+	var unanswered <-chan time.Time
+	probe := func() error {
+		if _, err := t.manager.Write(def.PortName, session.ID, def.Probe.Command); err != nil {
+			return err
+		}
+		// A probe still unanswered keeps its deadline, so probes delayed
+		// behind a blocked read cannot postpone it
+		if unanswered == nil {
+			unanswered = time.After(def.Probe.Timeout)
+		}
+		return nil
+	}
+	if len(def.Probe.Command) > 0 {
+		ticker := time.NewTicker(def.Probe.Interval)
+		defer ticker.Stop()
+		probes = ticker.C
+		if err := probe(); err != nil {
+			return err
+		}
+	}
+
+	var lines lineBuffer
+	readErrors := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-subscription:
+			if !ok {
+				return nil
+			}
+			if event.Error != nil {
+				readErrors++
+				if readErrors >= maxReadErrors {
+					return nil
+				}
+				continue
+			}
+			readErrors = 0
+			unanswered = nil
+			t.feed(def, &lines, event.Data)
+		case <-probes:
+			if err := probe(); err != nil {
+				return nil
+			}
+		case <-unanswered:
+			unanswered = nil
+			t.set(def, StateError, "no response to probe")
+		}
+	}
+}
+
+// feed matches the rules against complete lines and the line in progress,
+// so prompts without a newline match too
+func (t *Tracker) feed(def Definition, lines *lineBuffer, data []byte) {
+	for _, line := range lines.write(data) {
+		t.match(def, line)
+	}
+	if partial := lines.partial(); partial != "" {
+		t.match(def, partial)
+	}
+}
+
+// match applies the first rule matching a line
+func (t *Tracker) match(def Definition, line string) {
+	for _, rule := range def.Rules {
+		if rule.Pattern.MatchString(line) {
+			t.set(def, rule.State, "matched "+truncate(line))
+			return
+		}
+	}
+}
+
+// set moves a device to a state and notifies subscribers
+func (t *Tracker) set(def Definition, state, reason string) {
+	t.mu.Lock()
+	current := t.states[def.Name]
+	if current.State == state {
+		t.mu.Unlock()
+		return
+	}
+	status := Status{
+		Device:   def.Name,
+		PortName: def.PortName,
+		State:    state,
+		Previous: current.State,
+		Reason:   reason,
+		Since:    time.Now(),
+	}
+	t.states[def.Name] = status
+	for _, ch := range t.subs {
+		select {
+		case ch <- status:
+		default:
+			// Subscriber too slow, drop the transition
+		}
+	}
+	t.mu.Unlock()
+
+	t.logger.Info("device state changed", "device", def.Name, "port", def.PortName, "from", status.Previous, "to", state, "reason", reason)
+
+	payload, err := status.Marshal()
+	if err != nil {
+		return
+	}
+	t.manager.EmitPortEvent(def.PortName, serial.PortEventDeviceState, string(payload))
+	if t.publisher != nil {
+		if err := t.publisher.Publish(t.topicPrefix+"/devices/"+def.Name, payload); err != nil {
+			t.logger.Warn("failed to publish device state", "device", def.Name, "error", err)
+		}
+	}
+}
+
+// statusMessage is the JSON form of a status
+type statusMessage struct {
+	Device   string `json:"device"`
+	Port     string `json:"port"`
+	State    string `json:"state"`
+	Previous string `json:"previous_state"`
+	Reason   string `json:"reason"`
+	Since    string `json:"since"`
+}
+
+// Marshal returns the JSON form of a status
+func (s Status) Marshal() ([]byte, error) {
+	return json.Marshal(statusMessage{
+		Device:   s.Device,
+		Port:     s.PortName,
+		State:    s.State,
+		Previous: s.Previous,
+		Reason:   s.Reason,
+		Since:    s.Since.Format(time.RFC3339Nano),
+	})
+}
+
+// lineBuffer splits output into lines
+type lineBuffer struct {
+	buf []byte
+}
+
+// write adds data and returns the lines it completed
+func (b *lineBuffer) write(data []byte) []string {
+	var lines []string
+	for _, c := range data {
+		if c == '\n' || len(b.buf) >= maxLineLength {
+			lines = append(lines, strings.TrimRight(string(b.buf), "\r"))
+			b.buf = b.buf[:0]
+			if c == '\n' {
+				continue
+			}
+		}
+		b.buf = append(b.buf, c)
+	}
+	return lines
+}
+
+// partial returns the line in progress
+func (b *lineBuffer) partial() string {
+	return strings.TrimRight(string(b.buf), "\r")
+}
+
+// truncate shortens a line for a transition reason
+func truncate(line string) string {
+	const max = 80
+	if len(line) > max {
+		return line[:max] + "..."
+	}
+	return line
+}
@@ -0,0 +1,154 @@
+// Package escpos generates ESC/POS command sequences for serial receipt
+// printers and decodes their real-time status responses.
+package escpos
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+)
+
+// Control codes
+const (
+	esc = 0x1b
+	gs  = 0x1d
+	dle = 0x10
+	eot = 0x04
+)
+
+// Alignment values for ESC a
+const (
+	AlignLeft   = "left"
+	AlignCenter = "center"
+	AlignRight  = "right"
+)
+
+// codePages maps code page names to their ESC t table number and encoder
+var codePages = map[string]struct {
+	table    byte
+	encoding encoding.Encoding
+}{
+	"cp437":        {0, charmap.CodePage437},
+	"cp850":        {2, charmap.CodePage850},
+	"cp860":        {3, charmap.CodePage860},
+	"cp863":        {4, charmap.CodePage863},
+	"cp865":        {5, charmap.CodePage865},
+	"windows-1252": {16, charmap.Windows1252},
+	"cp866":        {17, charmap.CodePage866},
+	"cp858":        {19, charmap.CodePage858},
+}
+
+// TextOptions controls how text is printed
+type TextOptions struct {
+	Bold      bool
+	Underline bool
+	// Align is left (default), center or right
+	Align string
+	// Size magnifies characters, 1 (normal) to 8
+	Size int
+	// CodePage selects the character table, cp437 by default
+	CodePage string
+	// FeedLines are fed after the text
+	FeedLines int
+}
+
+// Initialize resets the printer to its power-on settings (ESC @)
+func Initialize() []byte {
+	return []byte{esc, '@'}
+}
+
+// Text builds the commands to print text with the given options. Formatting
+// is reset afterwards.
+func Text(text string, opts TextOptions) ([]byte, error) {
+	page := opts.CodePage
+	if page == "" {
+		page = "cp437"
+	}
+	codePage, ok := codePages[strings.ToLower(page)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported code page %q", opts.CodePage)
+	}
+
+	align, err := alignment(opts.Align)
+	if err != nil {
+		return nil, err
+	}
+
+	size := opts.Size
+	if size == 0 {
+		size = 1
+	}
+	if size < 1 || size > 8 {
+		return nil, fmt.Errorf("text size must be 1-8, got %d", size)
+	}
+
+	// Characters missing from the code page print as '?'
+	encoded, err := encoding.ReplaceUnsupported(codePage.encoding.NewEncoder()).String(normalizeNewlines(text))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode text: %w", err)
+	}
+
+	var buf bytes.Buffer
+	buf.Write([]byte{esc, 't', codePage.table})
+	buf.Write([]byte{esc, 'a', align})
+	if opts.Bold {
+		buf.Write([]byte{esc, 'E', 1})
+	}
+	if opts.Underline {
+		buf.Write([]byte{esc, '-', 1})
+	}
+	if size > 1 {
+		// Same magnification in width (high nibble) and height (low nibble)
+		n := byte(size - 1)
+		buf.Write([]byte{gs, '!', n<<4 | n})
+	}
+
+	buf.WriteString(encoded)
+	if !strings.HasSuffix(encoded, "\n") {
+		buf.WriteByte('\n')
+	}
+
+	// Reset formatting so later jobs start clean
+	buf.Write([]byte{gs, '!', 0, esc, '-', 0, esc, 'E', 0, esc, 'a', 0})
+	buf.Write(Feed(opts.FeedLines))
+	return buf.Bytes(), nil
+}
+
+// Feed prints the buffer and feeds n lines (ESC d)
+func Feed(lines int) []byte {
+	if lines <= 0 {
+		return nil
+	}
+	return []byte{esc, 'd', byte(min(lines, 255))}
+}
+
+// Cut feeds the paper to the cutter plus feedLines and cuts it (GS V)
+func Cut(partial bool, feedLines int) []byte {
+	mode := byte(65) // full cut after feeding
+	if partial {
+		mode = 66
+	}
+	return append(Feed(feedLines), gs, 'V', mode, 0)
+}
+
+func alignment(align string) (byte, error) {
+	switch strings.ToLower(align) {
+	case "", AlignLeft:
+		return 0, nil
+	case AlignCenter:
+		return 1, nil
+	case AlignRight:
+		return 2, nil
+	}
+	return 0, fmt.Errorf("alignment must be left, center or right, got %q", align)
+}
+
+// normalizeNewlines converts CRLF and CR line endings to LF, which ESC/POS
+// printers treat as print-and-feed
+func normalizeNewlines(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.ReplaceAll(text, "\r", "\n")
+}
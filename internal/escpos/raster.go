@@ -0,0 +1,157 @@
+package escpos
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	_ "image/gif"  // register GIF decoding
+	_ "image/jpeg" // register JPEG decoding
+	_ "image/png"  // register PNG decoding
+)
+
+// Common print widths in dots
+const (
+	Width58mm = 384
+	Width80mm = 576
+)
+
+// rasterBand is the number of lines sent per GS v 0 command; small bands
+// keep within the receive buffer of low-end printers
+const rasterBand = 256
+
+// RasterOptions controls how an image is printed
+type RasterOptions struct {
+	// Width is the maximum print width in dots; wider images are scaled
+	// down (default: 576, an 80 mm printer)
+	Width int
+	// Dither uses Floyd-Steinberg dithering instead of a plain threshold,
+	// which suits photos and logos with gradients
+	Dither bool
+	// Align is left (default), center or right
+	Align string
+}
+
+// Raster decodes a PNG, JPEG or GIF image and builds the GS v 0 raster
+// commands to print it
+func Raster(data []byte, opts RasterOptions) ([]byte, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	width := opts.Width
+	if width <= 0 {
+		width = Width80mm
+	}
+	align, err := alignment(opts.Align)
+	if err != nil {
+		return nil, err
+	}
+
+	gray := grayscale(img, width)
+	bits := monochrome(gray, opts.Dither)
+
+	var buf bytes.Buffer
+	buf.Write([]byte{esc, 'a', align})
+	for top := 0; top < bits.height; top += rasterBand {
+		lines := min(rasterBand, bits.height-top)
+		buf.Write([]byte{gs, 'v', '0', 0,
+			byte(bits.stride), byte(bits.stride >> 8),
+			byte(lines), byte(lines >> 8)})
+		buf.Write(bits.data[top*bits.stride : (top+lines)*bits.stride])
+	}
+	buf.Write([]byte{esc, 'a', 0})
+	return buf.Bytes(), nil
+}
+
+// grayImage is an 8-bit luminance image, 0 black to 255 white
+type grayImage struct {
+	width, height int
+	pix           []float64
+}
+
+// grayscale converts img to luminance, scaling it down to at most maxWidth
+// dots by averaging the source pixels covered by each dot
+func grayscale(img image.Image, maxWidth int) grayImage {
+	bounds := img.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+
+	width, height := srcW, srcH
+	if srcW > maxWidth {
+		width = maxWidth
+		height = max(1, srcH*maxWidth/srcW)
+	}
+
+	g := grayImage{width: width, height: height, pix: make([]float64, width*height)}
+	for y := 0; y < height; y++ {
+		y0, y1 := y*srcH/height, max((y+1)*srcH/height, y*srcH/height+1)
+		for x := 0; x < width; x++ {
+			x0, x1 := x*srcW/width, max((x+1)*srcW/width, x*srcW/width+1)
+
+			var sum float64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					sum += luminance(img, bounds.Min.X+sx, bounds.Min.Y+sy)
+				}
+			}
+			g.pix[y*width+x] = sum / float64((y1-y0)*(x1-x0))
+		}
+	}
+	return g
+}
+
+// luminance returns the brightness of a pixel, treating transparency as
+// white paper
+func luminance(img image.Image, x, y int) float64 {
+	r, g, b, a := img.At(x, y).RGBA()
+	l := (0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)) / 257
+	return l + (255 - float64(a)/257)
+}
+
+// bitmap is a 1-bit image, one bit per dot with 1 printing black
+type bitmap struct {
+	height int
+	stride int
+	data   []byte
+}
+
+// monochrome converts luminance to printable dots
+func monochrome(g grayImage, dither bool) bitmap {
+	b := bitmap{height: g.height, stride: (g.width + 7) / 8}
+	b.data = make([]byte, b.stride*g.height)
+
+	pix := g.pix
+	if dither {
+		pix = append([]float64(nil), g.pix...)
+	}
+	for y := 0; y < g.height; y++ {
+		for x := 0; x < g.width; x++ {
+			old := pix[y*g.width+x]
+			black := old < 128
+			if black {
+				b.data[y*b.stride+x/8] |= 0x80 >> (x % 8)
+			}
+			if !dither {
+				continue
+			}
+
+			// Floyd-Steinberg error diffusion
+			newValue := 255.0
+			if black {
+				newValue = 0
+			}
+			diff := old - newValue
+			spread := func(dx, dy int, weight float64) {
+				nx, ny := x+dx, y+dy
+				if nx >= 0 && nx < g.width && ny < g.height {
+					pix[ny*g.width+nx] += diff * weight
+				}
+			}
+			spread(1, 0, 7.0/16)
+			spread(-1, 1, 3.0/16)
+			spread(0, 1, 5.0/16)
+			spread(1, 1, 1.0/16)
+		}
+	}
+	return b
+}
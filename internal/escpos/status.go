@@ -0,0 +1,99 @@
+package escpos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ErrNoStatus is returned when the printer does not answer a status request
+var ErrNoStatus = errors.New("printer did not answer the status request")
+
+// Status is the real-time status of a printer
+type Status struct {
+	Online       bool
+	CoverOpen    bool
+	PaperNearEnd bool
+	PaperOut     bool
+	CutterError  bool
+	// Error is set for unrecoverable or auto-recoverable errors
+	Error bool
+}
+
+// String summarizes the status, e.g. "online" or "offline, paper out"
+func (s Status) String() string {
+	var parts []string
+	if s.Online {
+		parts = append(parts, "online")
+	} else {
+		parts = append(parts, "offline")
+	}
+	for _, flag := range []struct {
+		set  bool
+		name string
+	}{
+		{s.CoverOpen, "cover open"},
+		{s.PaperOut, "paper out"},
+		{s.PaperNearEnd && !s.PaperOut, "paper low"},
+		{s.CutterError, "cutter error"},
+		{s.Error && !s.CutterError, "error"},
+	} {
+		if flag.set {
+			parts = append(parts, flag.name)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// QueryStatus polls the printer with DLE EOT 1-4. Reads on rw must time
+// out regularly (returning 0 bytes) so timeout is honoured.
+func QueryStatus(ctx context.Context, rw io.ReadWriter, timeout time.Duration) (Status, error) {
+	var responses [4]byte
+	for i := range responses {
+		value, err := transmitStatus(ctx, rw, byte(i+1), timeout)
+		if err != nil {
+			return Status{}, err
+		}
+		responses[i] = value
+	}
+
+	printer, offline, errorStatus, paper := responses[0], responses[1], responses[2], responses[3]
+	return Status{
+		Online:       printer&0x08 == 0,
+		CoverOpen:    offline&0x04 != 0,
+		PaperOut:     offline&0x20 != 0 || paper&0x60 != 0,
+		PaperNearEnd: paper&0x0c != 0,
+		CutterError:  errorStatus&0x08 != 0,
+		Error:        offline&0x40 != 0 || errorStatus&0x60 != 0,
+	}, nil
+}
+
+// transmitStatus sends DLE EOT n and waits for the status byte
+func transmitStatus(ctx context.Context, rw io.ReadWriter, n byte, timeout time.Duration) (byte, error) {
+	if _, err := rw.Write([]byte{dle, eot, n}); err != nil {
+		return 0, fmt.Errorf("failed to request status: %w", err)
+	}
+
+	deadline := time.Now().Add(timeout)
+	buffer := make([]byte, 16)
+	for time.Now().Before(deadline) {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		count, err := rw.Read(buffer)
+		if err != nil {
+			return 0, err
+		}
+		// Status bytes have bits 1 and 4 set and bits 0 and 7 clear;
+		// anything else is unrelated output
+		for _, b := range buffer[:count] {
+			if b&0x93 == 0x12 {
+				return b, nil
+			}
+		}
+	}
+	return 0, ErrNoStatus
+}
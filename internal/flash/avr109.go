@@ -0,0 +1,126 @@
+package flash
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+// AVR109 commands and responses
+const (
+	avrSoftwareID    = 'S'
+	avrBlockSupport  = 'b'
+	avrEnterProgmode = 'P'
+	avrLeaveProgmode = 'L'
+	avrChipErase     = 'e'
+	avrSetAddress    = 'A'
+	avrWriteBlock    = 'B'
+	avrReadBlock     = 'g'
+	avrReadSignature = 's'
+	avrExit          = 'E'
+	avrAck           = '\r'
+	avrYes           = 'Y'
+
+	// avrMemoryFlash selects flash in block commands
+	avrMemoryFlash = 'F'
+
+	// avrEraseTimeout bounds a chip erase
+	avrEraseTimeout = 5 * time.Second
+)
+
+// avr109 speaks AVR109 with Caterina, which erases the whole chip before
+// writing and takes blocks of the size it reports
+type avr109 struct {
+	rw        io.ReadWriter
+	blockSize int
+}
+
+func (a *avr109) connect(ctx context.Context) (string, error) {
+	var id []byte
+	var err error
+	for attempt := 0; attempt < syncAttempts; attempt++ {
+		if err = drain(a.rw); err != nil {
+			return "", err
+		}
+		if id, err = exchange(ctx, a.rw, []byte{avrSoftwareID}, 7, responseTimeout/5); err == nil || ctx.Err() != nil {
+			break
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w: no AVR109 software ID (wrong protocol, or the board did not enter its bootloader?)", ErrNoBootloader)
+	}
+
+	support, err := exchange(ctx, a.rw, []byte{avrBlockSupport}, 3, responseTimeout)
+	if err != nil {
+		return "", fmt.Errorf("failed to query block support: %w", err)
+	}
+	if support[0] != avrYes {
+		return "", fmt.Errorf("%w: bootloader %q does not support block transfers", ErrProtocol, id)
+	}
+	a.blockSize = int(support[1])<<8 | int(support[2])
+	if a.blockSize == 0 {
+		return "", fmt.Errorf("%w: block size 0", ErrProtocol)
+	}
+
+	// Caterina reports the signature bytes in reverse order
+	signature, err := exchange(ctx, a.rw, []byte{avrReadSignature}, 3, responseTimeout)
+	if err != nil {
+		return "", fmt.Errorf("failed to read signature: %w", err)
+	}
+	if err := a.command(ctx, []byte{avrEnterProgmode}, responseTimeout); err != nil {
+		return "", fmt.Errorf("failed to enter programming mode: %w", err)
+	}
+	return fmt.Sprintf("%s (AVR109), signature %02X%02X%02X", id, signature[2], signature[1], signature[0]), nil
+}
+
+func (a *avr109) erase(ctx context.Context) error {
+	if err := a.command(ctx, []byte{avrChipErase}, avrEraseTimeout); err != nil {
+		return fmt.Errorf("failed to erase: %w", err)
+	}
+	return nil
+}
+
+func (a *avr109) pageSize() int {
+	return a.blockSize
+}
+
+func (a *avr109) writePage(ctx context.Context, address int, data []byte) error {
+	if err := a.setAddress(ctx, address); err != nil {
+		return err
+	}
+	command := append([]byte{avrWriteBlock, byte(len(data) >> 8), byte(len(data)), avrMemoryFlash}, data...)
+	return a.command(ctx, command, responseTimeout)
+}
+
+func (a *avr109) readPage(ctx context.Context, address int, size int) ([]byte, error) {
+	if err := a.setAddress(ctx, address); err != nil {
+		return nil, err
+	}
+	return exchange(ctx, a.rw, []byte{avrReadBlock, byte(size >> 8), byte(size), avrMemoryFlash}, size, responseTimeout)
+}
+
+func (a *avr109) finish(ctx context.Context) error {
+	if err := a.command(ctx, []byte{avrLeaveProgmode}, responseTimeout); err != nil {
+		return err
+	}
+	return a.command(ctx, []byte{avrExit}, responseTimeout)
+}
+
+// setAddress sets the word address of the next block command
+func (a *avr109) setAddress(ctx context.Context, address int) error {
+	word := address / 2
+	return a.command(ctx, []byte{avrSetAddress, byte(word >> 8), byte(word)}, responseTimeout)
+}
+
+// command sends a command acknowledged with a carriage return
+func (a *avr109) command(ctx context.Context, command []byte, timeout time.Duration) error {
+	response, err := exchange(ctx, a.rw, command, 1, timeout)
+	if err != nil {
+		return err
+	}
+	if response[0] != avrAck {
+		return fmt.Errorf("%w to %q: 0x%02X", ErrProtocol, command[0], response[0])
+	}
+	return nil
+}
@@ -0,0 +1,451 @@
+// Package flash uploads firmware to Arduino-style boards through their
+// serial bootloader, so web IDEs and build servers can flash boards
+// attached to the agent.
+//
+// The board is first reset into its bootloader: boards with a USB-serial
+// chip (Uno, Nano) reset on a DTR pulse and run optiboot, which speaks
+// STK500v1; boards with native USB (Leonardo, Micro) start the Caterina
+// bootloader, which speaks AVR109, when their port is opened at 1200 baud
+// and closed again (the "1200 bps touch"). The bootloader may come up on a
+// different port, which is looked for after the touch.
+package flash
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+)
+
+// Protocol is the bootloader protocol of a board
+type Protocol string
+
+// Protocols
+const (
+	// ProtocolSTK500v1 is spoken by optiboot and the classic Arduino
+	// bootloaders (Uno, Nano, Pro Mini)
+	ProtocolSTK500v1 Protocol = "stk500v1"
+	// ProtocolAVR109 is spoken by Caterina (Leonardo, Micro, Pro Micro)
+	ProtocolAVR109 Protocol = "avr109"
+)
+
+// Reset is how a board is put into its bootloader
+type Reset string
+
+// Resets
+const (
+	// ResetAuto picks the reset of the protocol: a DTR pulse for STK500v1,
+	// the 1200 bps touch for AVR109
+	ResetAuto Reset = ""
+	// ResetDTR pulses DTR and RTS low
+	ResetDTR Reset = "dtr"
+	// ResetTouch1200 opens the port at 1200 baud and closes it
+	ResetTouch1200 Reset = "1200bps"
+	// ResetNone expects the bootloader to be running already
+	ResetNone Reset = "none"
+)
+
+// Stage is the step an upload is at
+type Stage string
+
+// Stages
+const (
+	StageResetting  Stage = "resetting"
+	StageConnecting Stage = "connecting"
+	StageErasing    Stage = "erasing"
+	StageWriting    Stage = "writing"
+	StageVerifying  Stage = "verifying"
+	StageDone       Stage = "done"
+)
+
+// Upload errors
+var (
+	ErrInvalidImage = errors.New("invalid firmware image")
+	ErrNoBootloader = errors.New("no response from the bootloader")
+	ErrProtocol     = errors.New("unexpected bootloader response")
+	ErrVerifyFailed = errors.New("verification failed")
+	ErrPortNotFound = errors.New("bootloader port did not appear")
+)
+
+const (
+	// readTimeout is the read timeout of the port during an upload
+	readTimeout = 20 * time.Millisecond
+
+	// responseTimeout bounds waiting for a bootloader response
+	responseTimeout = time.Second
+
+	// syncAttempts is how often the bootloader is asked to respond before
+	// giving up; optiboot listens for about a second after a reset
+	syncAttempts = 10
+
+	// portWait bounds waiting for the bootloader port after a 1200 bps
+	// touch; Caterina stays in its bootloader for 8 seconds
+	portWait = 8 * time.Second
+
+	// portKeptWait is how long the port may stay listed after a touch
+	// before it is assumed to keep its name (some platforms never drop it)
+	portKeptWait = 2 * time.Second
+
+	// openRetry bounds retrying to open a port that just appeared, until
+	// its device node is ready
+	openRetry = 2 * time.Second
+
+	// pollInterval is how often ports are listed while waiting
+	pollInterval = 100 * time.Millisecond
+)
+
+// Request describes an upload
+type Request struct {
+	PortName string
+	// Image is the flash contents from address 0 (see ParseImage)
+	Image    []byte
+	Protocol Protocol
+	Reset    Reset
+	// BaudRate of the bootloader (default 115200 for STK500v1, 57600 for
+	// AVR109, where native USB ignores it)
+	BaudRate int
+	// Verify reads the flash back and compares it with the image
+	Verify bool
+	// ClientID names the sessions the upload opens
+	ClientID string
+}
+
+// Progress reports the stage of an upload and, while writing or
+// verifying, how many bytes are done
+type Progress struct {
+	Stage Stage
+	// PortName is the port the bootloader is reached on
+	PortName string
+	Done     int
+	Total    int
+	Message  string
+}
+
+// Result describes a finished upload
+type Result struct {
+	// PortName is the port the bootloader was reached on
+	PortName string
+	// Device identifies the bootloader and the chip's signature
+	Device   string
+	Bytes    int
+	Verified bool
+	Duration time.Duration
+}
+
+// Uploader flashes boards on the ports of a manager
+type Uploader struct {
+	manager *serial.Manager
+	ports   func() ([]string, error)
+}
+
+// NewUploader creates an uploader; ports lists the port names present, to
+// find the bootloader port after a 1200 bps touch
+func NewUploader(manager *serial.Manager, ports func() ([]string, error)) *Uploader {
+	return &Uploader{manager: manager, ports: ports}
+}
+
+// Upload resets the board on a port into its bootloader and writes the
+// image, reporting progress as it goes until it is done. The port must not
+// be open; the upload holds it exclusively until it ends.
+func (u *Uploader) Upload(ctx context.Context, req Request, progress func(Progress)) (Result, error) {
+	if len(req.Image) == 0 {
+		return Result{}, fmt.Errorf("%w: empty image", ErrInvalidImage)
+	}
+	if req.Protocol != ProtocolSTK500v1 && req.Protocol != ProtocolAVR109 {
+		return Result{}, fmt.Errorf("unknown bootloader protocol %q", req.Protocol)
+	}
+	reset := req.Reset
+	if reset == ResetAuto {
+		reset = ResetDTR
+		if req.Protocol == ProtocolAVR109 {
+			reset = ResetTouch1200
+		}
+	}
+	if req.BaudRate == 0 {
+		req.BaudRate = 115200
+		if req.Protocol == ProtocolAVR109 {
+			req.BaudRate = 57600
+		}
+	}
+	if req.ClientID == "" {
+		req.ClientID = "upload"
+	}
+	if progress == nil {
+		progress = func(Progress) {}
+	}
+
+	started := time.Now()
+	portName := req.PortName
+	if reset == ResetTouch1200 {
+		progress(Progress{Stage: StageResetting, PortName: portName, Message: "1200 bps touch"})
+		var err error
+		if portName, err = u.touch1200(ctx, portName, req.ClientID); err != nil {
+			return Result{}, err
+		}
+	}
+
+	session, err := u.open(ctx, portName, req, reset == ResetTouch1200)
+	if err != nil {
+		return Result{}, err
+	}
+	defer u.manager.ClosePort(portName, session.ID)
+
+	result := Result{PortName: portName, Bytes: len(req.Image)}
+	err = u.manager.Transact(portName, session.ID, readTimeout, func(rw io.ReadWriter) error {
+		if reset == ResetDTR {
+			progress(Progress{Stage: StageResetting, PortName: portName, Message: "DTR pulse"})
+			if err := pulseReset(ctx, rw); err != nil {
+				return err
+			}
+		}
+
+		var p programmer = &stk500{rw: rw}
+		if req.Protocol == ProtocolAVR109 {
+			p = &avr109{rw: rw}
+		}
+
+		progress(Progress{Stage: StageConnecting, PortName: portName})
+		device, err := p.connect(ctx)
+		if err != nil {
+			return err
+		}
+		result.Device = device
+
+		progress(Progress{Stage: StageErasing, PortName: portName, Message: device})
+		if err := p.erase(ctx); err != nil {
+			return err
+		}
+
+		if err := writeImage(ctx, p, req.Image, func(done int) {
+			progress(Progress{Stage: StageWriting, PortName: portName, Done: done, Total: len(req.Image)})
+		}); err != nil {
+			return err
+		}
+		if req.Verify {
+			if err := verifyImage(ctx, p, req.Image, func(done int) {
+				progress(Progress{Stage: StageVerifying, PortName: portName, Done: done, Total: len(req.Image)})
+			}); err != nil {
+				return err
+			}
+			result.Verified = true
+		}
+		return p.finish(ctx)
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	result.Duration = time.Since(started)
+	return result, nil
+}
+
+// lineConfig is the port configuration of an upload at a baud rate. The
+// control lines are left to the driver, which asserts them on open.
+func lineConfig(baudRate int) serial.PortConfig {
+	config := serial.DefaultConfig()
+	config.BaudRate = baudRate
+	return config
+}
+
+// open opens the bootloader port exclusively, retrying while a port that
+// just appeared is not ready
+func (u *Uploader) open(ctx context.Context, portName string, req Request, appeared bool) (*serial.Session, error) {
+	deadline := time.Now().Add(openRetry)
+	for {
+		session, err := u.manager.OpenPort(portName, lineConfig(req.BaudRate), req.ClientID, true)
+		if err == nil || !appeared || errors.Is(err, serial.ErrPortLocked) || time.Now().After(deadline) {
+			return session, err
+		}
+		if err := sleep(ctx, pollInterval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// touch1200 opens a port at 1200 baud and drops DTR, which makes boards
+// with native USB start their bootloader, and returns the port the
+// bootloader comes up on
+func (u *Uploader) touch1200(ctx context.Context, portName, clientID string) (string, error) {
+	before, err := u.ports()
+	if err != nil {
+		return "", fmt.Errorf("failed to list ports: %w", err)
+	}
+
+	session, err := u.manager.OpenPort(portName, lineConfig(1200), clientID, true)
+	if err != nil {
+		return "", err
+	}
+	dropErr := u.manager.SetDTR(portName, session.ID, false)
+	// Closing drops DTR as well where setting it failed
+	if err := u.manager.ClosePort(portName, session.ID); err != nil && dropErr != nil {
+		return "", err
+	}
+	return u.waitForBootloader(ctx, portName, before)
+}
+
+// waitForBootloader waits for the port of a board that re-enumerates into
+// its bootloader: a port missing from before, or the board's port coming
+// back after it vanished. A port that stays listed is assumed to keep its
+// name.
+func (u *Uploader) waitForBootloader(ctx context.Context, portName string, before []string) (string, error) {
+	started := time.Now()
+	vanished := false
+	for time.Since(started) < portWait {
+		if err := sleep(ctx, pollInterval); err != nil {
+			return "", err
+		}
+		ports, err := u.ports()
+		if err != nil {
+			continue
+		}
+		for _, name := range ports {
+			if name != portName && !slices.Contains(before, name) {
+				return name, nil
+			}
+		}
+		switch present := slices.Contains(ports, portName); {
+		case !present:
+			vanished = true
+		case vanished:
+			return portName, nil
+		case time.Since(started) >= portKeptWait:
+			return portName, nil
+		}
+	}
+	return "", fmt.Errorf("%w within %s of the 1200 bps touch on %s", ErrPortNotFound, portWait, portName)
+}
+
+// pulseReset resets a board with an auto-reset circuit into its
+// bootloader, as avrdude does: DTR and RTS low for 250ms, then high
+func pulseReset(ctx context.Context, rw io.ReadWriter) error {
+	lines, ok := rw.(serial.ControlLines)
+	if !ok {
+		return errors.New("the port has no control lines")
+	}
+	if err := setLines(lines, false); err != nil {
+		return err
+	}
+	if err := sleep(ctx, 250*time.Millisecond); err != nil {
+		return err
+	}
+	if err := setLines(lines, true); err != nil {
+		return err
+	}
+	return sleep(ctx, 50*time.Millisecond)
+}
+
+// setLines sets DTR and RTS together
+func setLines(lines serial.ControlLines, level bool) error {
+	if err := lines.SetDTR(level); err != nil {
+		return err
+	}
+	return lines.SetRTS(level)
+}
+
+// programmer speaks the protocol of a bootloader
+type programmer interface {
+	// connect synchronizes with the bootloader, enters programming mode
+	// and describes the device
+	connect(ctx context.Context) (string, error)
+	// erase erases the flash, where the bootloader does not erase each
+	// page as it is written
+	erase(ctx context.Context) error
+	// pageSize is the size of the blocks written and read
+	pageSize() int
+	writePage(ctx context.Context, address int, data []byte) error
+	readPage(ctx context.Context, address int, size int) ([]byte, error)
+	// finish leaves programming mode and starts the new firmware
+	finish(ctx context.Context) error
+}
+
+// writeImage writes an image page by page, the last one padded with the
+// value of erased flash
+func writeImage(ctx context.Context, p programmer, image []byte, progress func(done int)) error {
+	size := p.pageSize()
+	for address := 0; address < len(image); address += size {
+		page := image[address:min(address+size, len(image))]
+		padded := append(slices.Clone(page), bytes.Repeat([]byte{0xFF}, size-len(page))...)
+		if err := p.writePage(ctx, address, padded); err != nil {
+			return fmt.Errorf("failed to write page at 0x%04X: %w", address, err)
+		}
+		progress(address + len(page))
+	}
+	return nil
+}
+
+// verifyImage reads the flash back and compares it with an image
+func verifyImage(ctx context.Context, p programmer, image []byte, progress func(done int)) error {
+	size := p.pageSize()
+	for address := 0; address < len(image); address += size {
+		page := image[address:min(address+size, len(image))]
+		read, err := p.readPage(ctx, address, len(page))
+		if err != nil {
+			return fmt.Errorf("failed to read page at 0x%04X: %w", address, err)
+		}
+		for i := range page {
+			if read[i] != page[i] {
+				return fmt.Errorf("%w: 0x%02X at 0x%04X, expected 0x%02X", ErrVerifyFailed, read[i], address+i, page[i])
+			}
+		}
+		progress(address + len(page))
+	}
+	return nil
+}
+
+// exchange sends a command and reads a response of n bytes
+func exchange(ctx context.Context, rw io.ReadWriter, command []byte, n int, timeout time.Duration) ([]byte, error) {
+	if _, err := rw.Write(command); err != nil {
+		return nil, err
+	}
+	return readFull(ctx, rw, n, timeout)
+}
+
+// readFull reads n bytes within timeout. Reads on r return (0, nil) after
+// a short timeout without data.
+func readFull(ctx context.Context, r io.Reader, n int, timeout time.Duration) ([]byte, error) {
+	buffer := make([]byte, n)
+	read := 0
+	deadline := time.Now().Add(timeout)
+	for read < n {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("%w (%d of %d bytes)", ErrNoBootloader, read, n)
+		}
+		k, err := r.Read(buffer[read:])
+		if err != nil {
+			return nil, err
+		}
+		read += k
+	}
+	return buffer, nil
+}
+
+// drain discards pending input
+func drain(r io.Reader) error {
+	buffer := make([]byte, 256)
+	for {
+		n, err := r.Read(buffer)
+		if err != nil || n == 0 {
+			return err
+		}
+	}
+}
+
+// sleep waits for d unless ctx is cancelled first
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,99 @@
+package flash
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// maxImageSize bounds a firmware image; STK500v1 and AVR109 address flash
+// in 16-bit words, so nothing past 128 KiB can be written anyway
+const maxImageSize = 128 * 1024
+
+// Intel HEX record types
+const (
+	recordData           = 0x00
+	recordEOF            = 0x01
+	recordSegmentAddress = 0x02
+	recordLinearAddress  = 0x04
+)
+
+// ParseImage returns the flash contents of a firmware file in Intel HEX
+// format (as built by the Arduino IDE) or a raw binary image starting at
+// address 0. Gaps between HEX records are filled with 0xFF, the value of
+// erased flash.
+func ParseImage(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: empty image", ErrInvalidImage)
+	}
+	if trimmed := bytes.TrimLeft(data, " \t\r\n"); len(trimmed) > 0 && trimmed[0] == ':' {
+		return parseIntelHex(trimmed)
+	}
+	if len(data) > maxImageSize {
+		return nil, fmt.Errorf("%w: %d bytes exceeds %d", ErrInvalidImage, len(data), maxImageSize)
+	}
+	return data, nil
+}
+
+// parseIntelHex decodes an Intel HEX file
+func parseIntelHex(data []byte) ([]byte, error) {
+	var image []byte
+	var base uint32
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if line[0] != ':' {
+			return nil, fmt.Errorf("%w: line %d does not start with ':'", ErrInvalidImage, lineNumber)
+		}
+		record, err := hex.DecodeString(line[1:])
+		if err != nil || len(record) < 5 || len(record) != int(record[0])+5 {
+			return nil, fmt.Errorf("%w: malformed record on line %d", ErrInvalidImage, lineNumber)
+		}
+		var sum byte
+		for _, b := range record {
+			sum += b
+		}
+		if sum != 0 {
+			return nil, fmt.Errorf("%w: checksum mismatch on line %d", ErrInvalidImage, lineNumber)
+		}
+
+		payload := record[4 : 4+record[0]]
+		switch record[3] {
+		case recordData:
+			address := base + (uint32(record[1])<<8 | uint32(record[2]))
+			end := address + uint32(len(payload))
+			if end > maxImageSize {
+				return nil, fmt.Errorf("%w: data at 0x%X on line %d is past %d bytes", ErrInvalidImage, address, lineNumber, maxImageSize)
+			}
+			for uint32(len(image)) < end {
+				image = append(image, 0xFF)
+			}
+			copy(image[address:], payload)
+		case recordEOF:
+			if len(image) == 0 {
+				return nil, fmt.Errorf("%w: no data", ErrInvalidImage)
+			}
+			return image, nil
+		case recordSegmentAddress, recordLinearAddress:
+			if len(payload) != 2 {
+				return nil, fmt.Errorf("%w: malformed address record on line %d", ErrInvalidImage, lineNumber)
+			}
+			base = uint32(payload[0])<<8 | uint32(payload[1])
+			if record[3] == recordSegmentAddress {
+				base <<= 4
+			} else {
+				base <<= 16
+			}
+		}
+		// Start address records (03, 05) do not affect the image
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	return nil, fmt.Errorf("%w: missing end-of-file record", ErrInvalidImage)
+}
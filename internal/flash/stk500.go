@@ -0,0 +1,112 @@
+package flash
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// STK500v1 commands and responses
+const (
+	stkGetSync       = 0x30
+	stkEnterProgmode = 0x50
+	stkLeaveProgmode = 0x51
+	stkLoadAddress   = 0x55
+	stkProgPage      = 0x64
+	stkReadPage      = 0x74
+	stkReadSign      = 0x75
+	stkCRCEOP        = 0x20
+	stkInSync        = 0x14
+	stkOK            = 0x10
+
+	// stkMemoryFlash selects flash in page commands
+	stkMemoryFlash = 'F'
+
+	// stkPageSize is the page size of the ATmega328P, the chip of most
+	// optiboot boards; larger chips accept it as well
+	stkPageSize = 128
+)
+
+// stk500 speaks STK500v1 with optiboot, which erases each page as it is
+// written
+type stk500 struct {
+	rw io.ReadWriter
+}
+
+func (s *stk500) connect(ctx context.Context) (string, error) {
+	synced := false
+	for attempt := 0; attempt < syncAttempts && !synced; attempt++ {
+		if err := drain(s.rw); err != nil {
+			return "", err
+		}
+		response, err := exchange(ctx, s.rw, []byte{stkGetSync, stkCRCEOP}, 2, responseTimeout/5)
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		synced = err == nil && response[0] == stkInSync && response[1] == stkOK
+	}
+	if !synced {
+		return "", fmt.Errorf("%w: no STK500 sync (wrong protocol or baud rate, or no auto-reset?)", ErrNoBootloader)
+	}
+	if err := drain(s.rw); err != nil {
+		return "", err
+	}
+
+	signature, err := s.command(ctx, []byte{stkReadSign}, 3)
+	if err != nil {
+		return "", fmt.Errorf("failed to read signature: %w", err)
+	}
+	if _, err := s.command(ctx, []byte{stkEnterProgmode}, 0); err != nil {
+		return "", fmt.Errorf("failed to enter programming mode: %w", err)
+	}
+	return fmt.Sprintf("STK500v1, signature %02X%02X%02X", signature[0], signature[1], signature[2]), nil
+}
+
+func (s *stk500) erase(ctx context.Context) error {
+	return nil
+}
+
+func (s *stk500) pageSize() int {
+	return stkPageSize
+}
+
+func (s *stk500) writePage(ctx context.Context, address int, data []byte) error {
+	if err := s.loadAddress(ctx, address); err != nil {
+		return err
+	}
+	command := append([]byte{stkProgPage, byte(len(data) >> 8), byte(len(data)), stkMemoryFlash}, data...)
+	_, err := s.command(ctx, command, 0)
+	return err
+}
+
+func (s *stk500) readPage(ctx context.Context, address int, size int) ([]byte, error) {
+	if err := s.loadAddress(ctx, address); err != nil {
+		return nil, err
+	}
+	return s.command(ctx, []byte{stkReadPage, byte(size >> 8), byte(size), stkMemoryFlash}, size)
+}
+
+func (s *stk500) finish(ctx context.Context) error {
+	_, err := s.command(ctx, []byte{stkLeaveProgmode}, 0)
+	return err
+}
+
+// loadAddress sets the word address of the next page command
+func (s *stk500) loadAddress(ctx context.Context, address int) error {
+	word := address / 2
+	_, err := s.command(ctx, []byte{stkLoadAddress, byte(word), byte(word >> 8)}, 0)
+	return err
+}
+
+// command sends a command framed with CRC_EOP and returns the n bytes of
+// the response between INSYNC and OK
+func (s *stk500) command(ctx context.Context, command []byte, n int) ([]byte, error) {
+	response, err := exchange(ctx, s.rw, append(command, stkCRCEOP), n+2, responseTimeout)
+	if err != nil {
+		return nil, err
+	}
+	if response[0] != stkInSync || response[n+1] != stkOK {
+		return nil, fmt.Errorf("%w to 0x%02X: % X", ErrProtocol, command[0], response)
+	}
+	return response[1 : n+1], nil
+}
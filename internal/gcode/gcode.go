@@ -0,0 +1,93 @@
+// Package gcode streams G-code programs to 3D printers and CNC controllers
+// running Marlin or GRBL firmware, with the flow control each expects, so a
+// job runs on the agent next to the machine rather than depending on a
+// remote client's connection.
+//
+// Marlin lines are numbered and checksummed; the printer acknowledges each
+// with "ok", reports long-running commands with "busy:" and asks for lines
+// it received corrupted to be sent again with "Resend:". GRBL lines are
+// sent as long as they fit the controller's receive buffer (character
+// counting) and acknowledged in order with "ok" or "error:N".
+package gcode
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// Dialect is the firmware protocol of a machine
+type Dialect string
+
+// Dialects
+const (
+	DialectMarlin Dialect = "marlin"
+	DialectGRBL   Dialect = "grbl"
+)
+
+// G-code errors
+var (
+	ErrInvalid      = errors.New("invalid G-code job")
+	ErrBusy         = errors.New("a G-code job is running on the port")
+	ErrNotFound     = errors.New("no G-code job on the port")
+	ErrNotHolder    = errors.New("only the holder of the machine's session may control it")
+	ErrFinished     = errors.New("the G-code job has finished")
+	ErrConnected    = errors.New("a machine is already connected on the port")
+	ErrNotConnected = errors.New("no machine is connected on the port")
+	ErrNotReady     = errors.New("the machine is not ready")
+)
+
+// lineNumber and checksum match what a program may carry already
+var (
+	lineNumber = regexp.MustCompile(`^[Nn]\d+\s*`)
+	checksum   = regexp.MustCompile(`\*\d+$`)
+)
+
+// Parse returns the commands of a program without comments ("; ..." and
+// "(...)"), blank lines, "%" delimiters, line numbers and checksums, which
+// the sender adds itself for Marlin
+func Parse(program []byte) [][]byte {
+	var commands [][]byte
+	for _, line := range bytes.Split(program, []byte("\n")) {
+		line = stripComments(line)
+		line = lineNumber.ReplaceAll(line, nil)
+		line = bytes.TrimSpace(checksum.ReplaceAll(line, nil))
+		if len(line) == 0 || bytes.Equal(line, []byte("%")) {
+			continue
+		}
+		commands = append(commands, line)
+	}
+	return commands
+}
+
+// stripComments removes "(...)" and everything after ";"
+func stripComments(line []byte) []byte {
+	var out []byte
+	depth := 0
+	for _, c := range line {
+		switch {
+		case c == ';' && depth == 0:
+			return bytes.TrimSpace(out)
+		case c == '(':
+			depth++
+		case c == ')' && depth > 0:
+			depth--
+		case depth == 0:
+			out = append(out, c)
+		}
+	}
+	return bytes.TrimSpace(out)
+}
+
+// marlinFrame numbers and checksums a command as Marlin expects:
+// "N<n> <command>*<XOR of the bytes before the asterisk>"
+func marlinFrame(n int, command []byte) []byte {
+	frame := append([]byte("N"+strconv.Itoa(n)+" "), command...)
+	var sum byte
+	for _, c := range frame {
+		sum ^= c
+	}
+	return fmt.Appendf(frame, "*%d\n", sum)
+}
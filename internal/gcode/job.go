@@ -0,0 +1,304 @@
+package gcode
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultResponseTimeout fails a job when the machine sends nothing for
+// this long while lines await acknowledgement. Marlin reports long
+// commands such as homing with "busy:" every few seconds; GRBL
+// acknowledges motion as soon as it is planned.
+const DefaultResponseTimeout = 2 * time.Minute
+
+// State is the state of a job
+type State string
+
+// Job states
+const (
+	StateRunning   State = "running"
+	StatePaused    State = "paused"
+	StateCompleted State = "completed"
+	StateFailed    State = "failed"
+	StateCancelled State = "cancelled"
+)
+
+// Finished reports whether a job in this state has ended
+func (s State) Finished() bool {
+	return s == StateCompleted || s == StateFailed || s == StateCancelled
+}
+
+// Definition describes a job
+type Definition struct {
+	PortName  string
+	SessionID string
+	Dialect   Dialect
+	// Name identifies the program, e.g. its file name
+	Name    string
+	Program []byte
+	// ResponseTimeout overrides DefaultResponseTimeout
+	ResponseTimeout time.Duration
+	// BufferSize is GRBL's receive buffer (default DefaultGRBLBufferSize)
+	// unless the machine is connected already
+	BufferSize int
+}
+
+// Info is the progress of a job
+type Info struct {
+	PortName string
+	Name     string
+	Dialect  Dialect
+	State    State
+	// Error tells why a job failed
+	Error string
+	// Lines of the program, sent and acknowledged by the machine
+	Lines        int
+	Sent         int
+	Acknowledged int
+	Started      time.Time
+	Finished     time.Time
+}
+
+// Elapsed returns how long a job has been running
+func (i Info) Elapsed() time.Duration {
+	if i.Finished.IsZero() {
+		return time.Since(i.Started)
+	}
+	return i.Finished.Sub(i.Started)
+}
+
+// Remaining estimates how long a running job will take from the pace of
+// the lines acknowledged so far, 0 when unknown
+func (i Info) Remaining() time.Duration {
+	if i.State.Finished() || i.Acknowledged == 0 {
+		return 0
+	}
+	return i.Elapsed() / time.Duration(i.Acknowledged) * time.Duration(i.Lines-i.Acknowledged)
+}
+
+// job is a program run by a machine. Its machine updates it; it is kept for
+// its progress until the next job of the port starts.
+type job struct {
+	def     Definition
+	started time.Time
+
+	mu       sync.Mutex
+	state    State
+	err      string
+	finished time.Time
+	lines    int
+	sent     int
+	acked    int
+}
+
+// info returns the job's progress
+func (j *job) info() Info {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return Info{
+		PortName:     j.def.PortName,
+		Name:         j.def.Name,
+		Dialect:      j.def.Dialect,
+		State:        j.state,
+		Error:        j.err,
+		Lines:        j.lines,
+		Sent:         j.sent,
+		Acknowledged: j.acked,
+		Started:      j.started,
+		Finished:     j.finished,
+	}
+}
+
+// progress records the lines sent and acknowledged
+func (j *job) progress(p *protocol) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.lines, j.sent, j.acked = p.lines()
+}
+
+// running reports whether the job sends lines
+func (j *job) running() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.state == StateRunning
+}
+
+// setState pauses or resumes the job unless it has ended
+func (j *job) setState(state State) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if !j.state.Finished() {
+		j.state = state
+	}
+}
+
+// finish ends the job in a state unless it has ended already
+func (j *job) finish(state State, err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.state.Finished() {
+		return
+	}
+	j.state = state
+	if err != nil {
+		j.err = err.Error()
+	}
+	j.finished = time.Now()
+}
+
+// Start checks the session and program and starts sending. A port without
+// a connected machine is connected for the job and disconnected when it
+// ends. The machine reads the port meanwhile; no one else may.
+func (s *Set) Start(def Definition) (Info, error) {
+	if def.PortName == "" {
+		return Info{}, fmt.Errorf("%w: port is required", ErrInvalid)
+	}
+	commands := Parse(def.Program)
+	if len(commands) == 0 {
+		return Info{}, fmt.Errorf("%w: the program holds no commands", ErrInvalid)
+	}
+	if def.ResponseTimeout <= 0 {
+		def.ResponseTimeout = DefaultResponseTimeout
+	}
+	if _, err := s.manager.ValidateSession(def.PortName, def.SessionID); err != nil {
+		return Info{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j := &job{def: def, started: time.Now(), state: StateRunning}
+	// The program is not needed once framed
+	j.def.Program = nil
+
+	if m, connected := s.machines[def.PortName]; connected {
+		switch {
+		case m.def.SessionID != def.SessionID:
+			return Info{}, ErrNotHolder
+		case m.def.Dialect != def.Dialect:
+			return Info{}, fmt.Errorf("%w: the machine is connected as %s", ErrInvalid, m.def.Dialect)
+		}
+		m.mu.Lock()
+		err := m.load(j, commands)
+		m.mu.Unlock()
+		if err != nil {
+			return Info{}, err
+		}
+		m.poke()
+	} else {
+		m, err := newMachine(MachineDefinition{
+			PortName:   def.PortName,
+			SessionID:  def.SessionID,
+			Dialect:    def.Dialect,
+			BufferSize: def.BufferSize,
+		}, false)
+		if err != nil {
+			return Info{}, err
+		}
+		if err := m.load(j, commands); err != nil {
+			return Info{}, err
+		}
+		if err := s.start(m); err != nil {
+			return Info{}, err
+		}
+	}
+
+	s.jobs[def.PortName] = j
+	info := j.info()
+	s.logger.Info("G-code job started", "port", def.PortName, "name", def.Name, "dialect", def.Dialect, "lines", info.Lines)
+	return info, nil
+}
+
+// Pause stops sending further lines; lines already sent are still carried
+// out by the machine
+func (s *Set) Pause(portName, sessionID string) (Info, error) {
+	return s.control(portName, sessionID, func(m *machine, j *job) {
+		j.setState(StatePaused)
+	})
+}
+
+// Resume continues a paused job
+func (s *Set) Resume(portName, sessionID string) (Info, error) {
+	return s.control(portName, sessionID, func(m *machine, j *job) {
+		j.setState(StateRunning)
+		m.poke()
+	})
+}
+
+// Cancel stops a job and returns its final state. Lines the machine has
+// buffered already are still carried out.
+func (s *Set) Cancel(portName, sessionID string) (Info, error) {
+	info, err := s.control(portName, sessionID, func(m *machine, j *job) {
+		m.mu.Lock()
+		s.finish(m, StateCancelled, nil)
+		m.mu.Unlock()
+		m.poke()
+	})
+	if err != nil {
+		return info, err
+	}
+
+	// A machine connected for the job is disconnected by now
+	s.mu.Lock()
+	m := s.machines[portName]
+	s.mu.Unlock()
+	if m != nil && !m.persistent {
+		<-m.done
+	}
+	return s.Get(portName)
+}
+
+// control changes the running job of a port on behalf of the holder of its
+// session
+func (s *Set) control(portName, sessionID string, change func(m *machine, j *job)) (Info, error) {
+	s.mu.Lock()
+	j, exists := s.jobs[portName]
+	m := s.machines[portName]
+	s.mu.Unlock()
+	if !exists {
+		return Info{}, fmt.Errorf("%w: %s", ErrNotFound, portName)
+	}
+	if _, err := s.manager.ValidateSession(portName, sessionID); sessionID != j.def.SessionID || err != nil {
+		return Info{}, ErrNotHolder
+	}
+
+	if m == nil || j.info().State.Finished() {
+		return j.info(), ErrFinished
+	}
+	change(m, j)
+
+	info := j.info()
+	s.logger.Info("G-code job changed", "port", portName, "name", info.Name, "state", info.State)
+	return info, nil
+}
+
+// Get returns the job of a port, which may have finished
+func (s *Set) Get(portName string) (Info, error) {
+	s.mu.Lock()
+	j, exists := s.jobs[portName]
+	s.mu.Unlock()
+	if !exists {
+		return Info{}, fmt.Errorf("%w: %s", ErrNotFound, portName)
+	}
+	return j.info(), nil
+}
+
+// List returns the jobs by port
+func (s *Set) List() []Info {
+	s.mu.Lock()
+	jobs := make([]*job, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		jobs = append(jobs, j)
+	}
+	s.mu.Unlock()
+
+	infos := make([]Info, 0, len(jobs))
+	for _, j := range jobs {
+		infos = append(infos, j.info())
+	}
+	slices.SortFunc(infos, func(a, b Info) int { return strings.Compare(a.PortName, b.PortName) })
+	return infos
+}
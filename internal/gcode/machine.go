@@ -0,0 +1,586 @@
+package gcode
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/charmbracelet/log"
+)
+
+const (
+	// DefaultStatusInterval is how often a machine is asked for its status
+	DefaultStatusInterval = time.Second
+
+	// MinStatusInterval bounds the load status queries put on a machine
+	MinStatusInterval = 200 * time.Millisecond
+
+	// checkInterval is how often a machine checks for a timeout
+	checkInterval = 100 * time.Millisecond
+)
+
+// GRBL real-time commands, acted on at once rather than buffered
+const (
+	grblFeedHold   = "!"
+	grblCycleStart = "~"
+	grblReset      = "\x18"
+)
+
+// marlinEmergencyStop halts a Marlin printer; it acts at once when the
+// firmware has the emergency parser
+const marlinEmergencyStop = "M112\n"
+
+// Command is a machine control command
+type Command string
+
+// Commands
+const (
+	// CommandFeedHold stops motion on GRBL, which keeps its position, and
+	// pauses the job. Marlin has no feed hold: its job is paused and the
+	// moves it has buffered are carried out.
+	CommandFeedHold Command = "feed-hold"
+	// CommandCycleStart resumes after a feed hold
+	CommandCycleStart Command = "cycle-start"
+	// CommandReset soft-resets GRBL or stops Marlin at once (M112), which
+	// then needs a restart, and cancels the job
+	CommandReset Command = "reset"
+)
+
+// Jog moves a machine by a distance in mm on each axis
+type Jog struct {
+	X, Y, Z float64
+	// FeedRate is in mm/min
+	FeedRate float64
+}
+
+// Lines returns the commands of a relative jog: GRBL's jog command, which
+// checks soft limits and is cancelled by a feed hold, or for Marlin a
+// relative move between G91 and G90
+func (j Jog) Lines(dialect Dialect) ([][]byte, error) {
+	if j.FeedRate <= 0 {
+		return nil, fmt.Errorf("%w: the feed rate must be positive", ErrInvalid)
+	}
+	var move []string
+	for _, axis := range []struct {
+		name     string
+		distance float64
+	}{{"X", j.X}, {"Y", j.Y}, {"Z", j.Z}} {
+		if axis.distance != 0 {
+			move = append(move, axis.name+strconv.FormatFloat(axis.distance, 'f', -1, 64))
+		}
+	}
+	if len(move) == 0 {
+		return nil, fmt.Errorf("%w: a distance is required", ErrInvalid)
+	}
+	feed := "F" + strconv.FormatFloat(j.FeedRate, 'f', -1, 64)
+
+	if dialect == DialectGRBL {
+		return [][]byte{[]byte("$J=G91 G21 " + strings.Join(move, " ") + " " + feed)}, nil
+	}
+	return [][]byte{
+		[]byte("G91"),
+		[]byte("G1 " + strings.Join(move, " ") + " " + feed),
+		[]byte("G90"),
+	}, nil
+}
+
+// MachineDefinition describes a connected machine
+type MachineDefinition struct {
+	PortName  string
+	SessionID string
+	Dialect   Dialect
+	// StatusInterval overrides DefaultStatusInterval
+	StatusInterval time.Duration
+	// BufferSize is GRBL's receive buffer (default DefaultGRBLBufferSize)
+	BufferSize int
+}
+
+// machine reads a port, sends the lines of its job and commands with flow
+// control and keeps the status the machine reports
+type machine struct {
+	def MachineDefinition
+	// persistent machines were connected on request and stay after a job
+	persistent bool
+	cancel     context.CancelFunc
+	done       chan struct{}
+	// wake has the machine send what was queued
+	wake chan struct{}
+
+	mu       sync.Mutex
+	protocol *protocol
+	parser   statusParser
+	job      *job
+}
+
+// poke has the machine send what was queued
+func (m *machine) poke() {
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+}
+
+// load makes a job the machine's (lock held)
+func (m *machine) load(j *job, commands [][]byte) error {
+	if m.job != nil {
+		return ErrBusy
+	}
+	if err := m.protocol.load(commands); err != nil {
+		return err
+	}
+	m.job = j
+	j.progress(m.protocol)
+	return nil
+}
+
+// end ends the job, if any (lock held)
+func (m *machine) end(state State, err error) {
+	if m.job == nil {
+		return
+	}
+	m.job.progress(m.protocol)
+	m.job.finish(state, err)
+	m.protocol.unload()
+	m.job = nil
+}
+
+// responseTimeout is how long the machine may stay silent (lock held)
+func (m *machine) responseTimeout() time.Duration {
+	if m.job != nil {
+		return m.job.def.ResponseTimeout
+	}
+	return DefaultResponseTimeout
+}
+
+// Set runs the machines and G-code jobs of an agent, one per port
+type Set struct {
+	manager *serial.Manager
+	logger  *log.Logger
+
+	mu       sync.Mutex
+	machines map[string]*machine
+	jobs     map[string]*job
+}
+
+// NewSet creates an empty set of machines
+func NewSet(manager *serial.Manager, logger *log.Logger) *Set {
+	return &Set{
+		manager:  manager,
+		logger:   logger,
+		machines: make(map[string]*machine),
+		jobs:     make(map[string]*job),
+	}
+}
+
+// Connect puts an open port in G-code mode until Disconnect, so the status
+// of its machine is polled and it can be jogged between jobs. The machine
+// reads the port meanwhile; no one else may.
+func (s *Set) Connect(def MachineDefinition) (Status, error) {
+	if def.PortName == "" {
+		return Status{}, fmt.Errorf("%w: port is required", ErrInvalid)
+	}
+	if _, err := s.manager.ValidateSession(def.PortName, def.SessionID); err != nil {
+		return Status{}, err
+	}
+
+	s.mu.Lock()
+	if _, exists := s.machines[def.PortName]; exists {
+		s.mu.Unlock()
+		return Status{}, ErrConnected
+	}
+	m, err := newMachine(def, true)
+	if err == nil {
+		err = s.start(m)
+	}
+	s.mu.Unlock()
+	if err != nil {
+		return Status{}, err
+	}
+
+	s.logger.Info("G-code machine connected", "port", def.PortName, "dialect", def.Dialect)
+	return m.status(), nil
+}
+
+// newMachine creates a machine to start
+func newMachine(def MachineDefinition, persistent bool) (*machine, error) {
+	p, err := newProtocol(def.Dialect, def.BufferSize)
+	if err != nil {
+		return nil, err
+	}
+	if def.StatusInterval <= 0 {
+		def.StatusInterval = DefaultStatusInterval
+	}
+	def.StatusInterval = max(def.StatusInterval, MinStatusInterval)
+
+	return &machine{
+		def:        def,
+		persistent: persistent,
+		done:       make(chan struct{}),
+		wake:       make(chan struct{}, 1),
+		protocol:   p,
+		parser:     statusParser{status: Status{PortName: def.PortName, Dialect: def.Dialect}},
+	}, nil
+}
+
+// start has a machine read its port and run (lock held)
+func (s *Set) start(m *machine) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	reader := serial.NewReader(s.manager, m.def.PortName, m.def.SessionID, 1024)
+	reader.SetConsumer("gcode " + string(m.def.Dialect))
+	subscription := reader.Subscribe()
+	if err := reader.Start(ctx); err != nil {
+		cancel()
+		return fmt.Errorf("failed to start reader: %w", err)
+	}
+
+	s.machines[m.def.PortName] = m
+	go s.run(ctx, m, reader, subscription)
+	return nil
+}
+
+// Disconnect takes a port out of G-code mode on behalf of the holder of
+// its session. A running job must be cancelled first.
+func (s *Set) Disconnect(portName, sessionID string) error {
+	m, err := s.holder(portName, sessionID)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	running := m.job != nil
+	m.mu.Unlock()
+	if running {
+		return ErrBusy
+	}
+
+	m.cancel()
+	<-m.done
+	s.logger.Info("G-code machine disconnected", "port", portName)
+	return nil
+}
+
+// holder returns the machine of a port for the holder of its session
+func (s *Set) holder(portName, sessionID string) (*machine, error) {
+	s.mu.Lock()
+	m, exists := s.machines[portName]
+	s.mu.Unlock()
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrNotConnected, portName)
+	}
+	if _, err := s.manager.ValidateSession(portName, sessionID); sessionID != m.def.SessionID || err != nil {
+		return nil, ErrNotHolder
+	}
+	return m, nil
+}
+
+// Status returns what the machine of a port last reported
+func (s *Set) Status(portName string) (Status, error) {
+	s.mu.Lock()
+	m, exists := s.machines[portName]
+	s.mu.Unlock()
+	if !exists {
+		return Status{}, fmt.Errorf("%w: %s", ErrNotConnected, portName)
+	}
+	return m.status(), nil
+}
+
+// status returns a copy of the machine's status
+func (m *machine) status() Status {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.parser.status.clone()
+}
+
+// Jog moves the machine of a port on behalf of the holder of its session
+// and waits until the machine takes the move. Jogging is refused while a
+// job runs, even paused, and while the machine is in alarm or halted.
+func (s *Set) Jog(ctx context.Context, portName, sessionID string, jog Jog) error {
+	m, err := s.holder(portName, sessionID)
+	if err != nil {
+		return err
+	}
+	lines, err := jog.Lines(m.def.Dialect)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	switch {
+	case m.job != nil:
+		m.mu.Unlock()
+		return ErrBusy
+	case m.parser.status.State == "alarm" || m.parser.status.State == "halted":
+		state := m.parser.status.State
+		m.mu.Unlock()
+		return fmt.Errorf("%w: the machine is %s", ErrNotReady, state)
+	}
+	reply := make(chan error, len(lines))
+	for _, line := range lines {
+		m.protocol.queue(line, reply)
+	}
+	m.mu.Unlock()
+	m.poke()
+
+	for range lines {
+		select {
+		case err := <-reply:
+			switch {
+			case errors.Is(err, errReset):
+				return fmt.Errorf("%w: %v", ErrNotReady, err)
+			case err != nil:
+				return fmt.Errorf("%w: %v", ErrInvalid, err)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-m.done:
+			return serial.ErrPortClosed
+		}
+	}
+	s.logger.Info("G-code machine jogged", "port", portName, "x", jog.X, "y", jog.Y, "z", jog.Z, "feed", jog.FeedRate)
+	return nil
+}
+
+// Command sends a control command to the machine of a port on behalf of the
+// holder of its session
+func (s *Set) Command(portName, sessionID string, command Command) error {
+	m, err := s.holder(portName, sessionID)
+	if err != nil {
+		return err
+	}
+	grbl := m.def.Dialect == DialectGRBL
+
+	var data string
+	m.mu.Lock()
+	switch command {
+	case CommandFeedHold:
+		if grbl {
+			data = grblFeedHold
+		}
+		if m.job != nil {
+			m.job.setState(StatePaused)
+		}
+	case CommandCycleStart:
+		if grbl {
+			data = grblCycleStart
+		}
+		if m.job != nil {
+			m.job.setState(StateRunning)
+		}
+	case CommandReset:
+		data = marlinEmergencyStop
+		if grbl {
+			data = grblReset
+		}
+		s.finish(m, StateCancelled, nil)
+		m.protocol.restart()
+	default:
+		m.mu.Unlock()
+		return fmt.Errorf("%w: unknown command %q", ErrInvalid, command)
+	}
+	m.mu.Unlock()
+	m.poke()
+
+	if data != "" {
+		if _, err := s.manager.Write(portName, sessionID, []byte(data)); err != nil {
+			return err
+		}
+	}
+	s.logger.Info("G-code machine command", "port", portName, "command", command)
+	return nil
+}
+
+// Reading reports whether a machine reads a port, whose data no one else
+// may read without starving it
+func (s *Set) Reading(portName string) bool {
+	s.mu.Lock()
+	_, exists := s.machines[portName]
+	s.mu.Unlock()
+	return exists
+}
+
+// run sends the lines of a machine and handles its responses until it is
+// disconnected, or for a machine connected for a job until the job ends
+func (s *Set) run(ctx context.Context, m *machine, reader *serial.Reader, subscription <-chan serial.DataEvent) {
+	defer close(m.done)
+	defer s.remove(m)
+	defer reader.Stop()
+	defer m.cancel()
+
+	check := time.NewTicker(checkInterval)
+	defer check.Stop()
+	poll := time.NewTicker(m.def.StatusInterval)
+	defer poll.Stop()
+	s.poll(m)
+
+	var partial []byte
+	lastResponse := time.Now()
+	for {
+		if err := s.sendReady(m); err != nil {
+			s.stop(m, err)
+			return
+		}
+
+		m.mu.Lock()
+		if m.job != nil {
+			m.job.progress(m.protocol)
+			if m.protocol.done() {
+				s.finish(m, StateCompleted, nil)
+			}
+		}
+		idle := m.job == nil && !m.persistent
+		waiting := m.protocol.waiting()
+		timeout := m.responseTimeout()
+		m.mu.Unlock()
+		if idle {
+			return
+		}
+		// Silence counts from the first line awaiting acknowledgement
+		if !waiting {
+			lastResponse = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			s.stop(m, nil)
+			return
+		case <-m.wake:
+		case <-poll.C:
+			s.poll(m)
+		case now := <-check.C:
+			if waiting && now.Sub(lastResponse) > timeout {
+				err := fmt.Errorf("no response within %s", timeout)
+				m.mu.Lock()
+				m.protocol.drop(err)
+				s.fail(m, err)
+				m.mu.Unlock()
+			}
+		case event, ok := <-subscription:
+			if !ok {
+				s.stop(m, serial.ErrPortClosed)
+				return
+			}
+			if event.Error != nil {
+				if portGone(event.Error) {
+					s.stop(m, event.Error)
+					return
+				}
+				continue
+			}
+			lastResponse = time.Now()
+
+			partial = append(partial, event.Data...)
+			for {
+				i := bytes.IndexByte(partial, '\n')
+				if i < 0 {
+					break
+				}
+				line := strings.TrimRight(string(partial[:i]), "\r")
+				partial = partial[i+1:]
+
+				m.mu.Lock()
+				m.parser.parse(line, time.Now())
+				if err := m.protocol.response(line); err != nil {
+					s.fail(m, err)
+				}
+				m.mu.Unlock()
+			}
+		}
+	}
+}
+
+// poll asks the machine for its status: GRBL at once, Marlin after the
+// lines sent before
+func (s *Set) poll(m *machine) {
+	if m.def.Dialect == DialectGRBL {
+		_, _ = s.manager.Write(m.def.PortName, m.def.SessionID, []byte(grblStatusQuery))
+		return
+	}
+
+	m.mu.Lock()
+	for _, query := range []string{marlinPosition, marlinTemperatures} {
+		if !m.protocol.queued(query) {
+			m.protocol.queue([]byte(query), nil)
+		}
+	}
+	m.mu.Unlock()
+	m.poke()
+}
+
+// sendReady writes the lines the machine can take now: commands, then the
+// lines of its job unless paused
+func (s *Set) sendReady(m *machine) error {
+	m.mu.Lock()
+	lines := m.protocol.send(m.job != nil && m.job.running())
+	m.mu.Unlock()
+
+	for _, line := range lines {
+		if _, err := s.manager.Write(m.def.PortName, m.def.SessionID, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fail fails the machine's job, if any, on an error the machine reported
+// (lock held)
+func (s *Set) fail(m *machine, err error) {
+	if m.job == nil {
+		s.logger.Debug("G-code machine reported an error", "port", m.def.PortName, "error", err)
+		return
+	}
+	s.finish(m, StateFailed, err)
+}
+
+// finish ends the machine's job, if any, and logs how (lock held)
+func (s *Set) finish(m *machine, state State, err error) {
+	j := m.job
+	if j == nil {
+		return
+	}
+	m.end(state, err)
+
+	info := j.info()
+	if info.State == StateFailed {
+		s.logger.Warn("G-code job failed", "port", info.PortName, "name", info.Name, "error", info.Error)
+		return
+	}
+	s.logger.Info("G-code job ended", "port", info.PortName, "name", info.Name, "state", info.State, "lines", info.Acknowledged, "elapsed", info.Elapsed().Round(time.Second))
+}
+
+// stop ends a machine, failing its job on err or cancelling it
+func (s *Set) stop(m *machine, err error) {
+	m.mu.Lock()
+	if err != nil {
+		s.fail(m, err)
+	} else {
+		s.finish(m, StateCancelled, nil)
+	}
+	m.protocol.restart()
+	m.mu.Unlock()
+
+	if err != nil && m.persistent {
+		s.logger.Warn("G-code machine disconnected", "port", m.def.PortName, "error", err)
+	}
+}
+
+// remove forgets a machine that has stopped
+func (s *Set) remove(m *machine) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.machines[m.def.PortName] == m {
+		delete(s.machines, m.def.PortName)
+	}
+}
+
+// portGone reports whether an error means the port is gone
+func portGone(err error) bool {
+	return errors.Is(err, serial.ErrPortClosed) || errors.Is(err, serial.ErrPortNotOpen) || errors.Is(err, serial.ErrInvalidSession)
+}
@@ -0,0 +1,291 @@
+package gcode
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultGRBLBufferSize is the receive buffer of GRBL on an ATmega328p,
+// less one byte as GRBL's streaming guide advises
+const DefaultGRBLBufferSize = 127
+
+// errReset is returned to commands lost when the machine restarts
+var errReset = errors.New("the machine restarted")
+
+// flight is a line sent but not acknowledged
+type flight struct {
+	line []byte
+	// job is set for the frames of a job, reply for commands
+	job   bool
+	reply chan error
+}
+
+// command is a line queued outside of a job, as a status query or a jog
+type command struct {
+	line  []byte
+	reply chan error
+}
+
+// protocol tracks which lines of a machine may be sent and which the
+// machine has acknowledged. Commands go before the frames of a job. It is
+// not safe for concurrent use.
+type protocol struct {
+	dialect Dialect
+	// bufferSize bounds the bytes in flight (GRBL)
+	bufferSize int
+
+	// frames are the lines of the current job as sent; for Marlin frames[0]
+	// resets the line numbering and frames[n] is numbered n
+	frames   [][]byte
+	preamble int
+	// next is the index of the next frame to send, acked the number of
+	// frames acknowledged
+	next  int
+	acked int
+
+	commands []command
+	inflight []flight
+	// ignoreOK counts acknowledgements that refer to no line, as the "ok"
+	// Marlin sends after asking for a resend
+	ignoreOK int
+}
+
+// newProtocol creates the flow control of a machine
+func newProtocol(dialect Dialect, bufferSize int) (*protocol, error) {
+	switch dialect {
+	case DialectMarlin:
+	case DialectGRBL:
+		if bufferSize <= 0 {
+			bufferSize = DefaultGRBLBufferSize
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown dialect %q", ErrInvalid, dialect)
+	}
+	return &protocol{dialect: dialect, bufferSize: bufferSize}, nil
+}
+
+// frame frames the commands of a job
+func (p *protocol) frame(commands [][]byte) ([][]byte, int, error) {
+	var frames [][]byte
+	if p.dialect == DialectMarlin {
+		frames = append(frames, marlinFrame(0, []byte("M110 N0")))
+		for i, command := range commands {
+			frames = append(frames, marlinFrame(i+1, command))
+		}
+		return frames, 1, nil
+	}
+
+	for i, command := range commands {
+		frame := append(append([]byte(nil), command...), '\n')
+		if len(frame) > p.bufferSize {
+			return nil, 0, fmt.Errorf("%w: line %d is longer than the receive buffer", ErrInvalid, i+1)
+		}
+		frames = append(frames, frame)
+	}
+	return frames, 0, nil
+}
+
+// load makes the commands the current job
+func (p *protocol) load(commands [][]byte) error {
+	frames, preamble, err := p.frame(commands)
+	if err != nil {
+		return err
+	}
+	p.frames, p.preamble = frames, preamble
+	p.next, p.acked = 0, 0
+	// Frames of the previous job still in flight are not counted
+	for i := range p.inflight {
+		p.inflight[i].job = false
+	}
+	return nil
+}
+
+// queue adds a command, whose reply receives nil when it is acknowledged
+// or the error the machine reported
+func (p *protocol) queue(line []byte, reply chan error) {
+	p.commands = append(p.commands, command{line: append(append([]byte(nil), line...), '\n'), reply: reply})
+}
+
+// queued reports whether a command is queued or in flight
+func (p *protocol) queued(line string) bool {
+	for _, c := range p.commands {
+		if string(c.line) == line+"\n" {
+			return true
+		}
+	}
+	for _, f := range p.inflight {
+		if !f.job && string(f.line) == line+"\n" {
+			return true
+		}
+	}
+	return false
+}
+
+// send returns the lines that may be sent now and counts them in flight:
+// queued commands, then the job's frames when job is set
+func (p *protocol) send(job bool) [][]byte {
+	var lines [][]byte
+	for len(p.commands) > 0 && p.fits(len(p.commands[0].line)) {
+		c := p.commands[0]
+		p.commands = p.commands[1:]
+		lines = append(lines, c.line)
+		p.inflight = append(p.inflight, flight{line: c.line, reply: c.reply})
+	}
+	for job && len(p.commands) == 0 && p.next < len(p.frames) && p.fits(len(p.frames[p.next])) {
+		frame := p.frames[p.next]
+		lines = append(lines, frame)
+		p.inflight = append(p.inflight, flight{line: frame, job: true})
+		p.next++
+	}
+	return lines
+}
+
+// fits reports whether a line of size may be sent now: Marlin takes one
+// line at a time, GRBL as many as its buffer holds
+func (p *protocol) fits(size int) bool {
+	if p.dialect == DialectMarlin {
+		return len(p.inflight) == 0
+	}
+	used := 0
+	for _, f := range p.inflight {
+		used += len(f.line)
+	}
+	return used+size <= p.bufferSize
+}
+
+// response handles a line the machine sent. It returns an error when the
+// job cannot go on; errors of commands go to their replies.
+func (p *protocol) response(line string) error {
+	line = strings.TrimSpace(line)
+	lower := strings.ToLower(line)
+	switch p.dialect {
+	case DialectMarlin:
+		switch {
+		case strings.HasPrefix(lower, "ok"):
+			p.ack(nil)
+		case strings.HasPrefix(lower, "resend:"), strings.HasPrefix(lower, "rs "):
+			return p.resend(line)
+		case strings.HasPrefix(lower, "error:"):
+			// Transmission errors are followed by a resend request
+			if strings.Contains(lower, "checksum") || strings.Contains(lower, "line number") {
+				return nil
+			}
+			return fmt.Errorf("printer reported %q at line %d", line, p.current())
+		case strings.HasPrefix(lower, "!!"):
+			return fmt.Errorf("printer reported %q at line %d", line, p.current())
+		case lower == "start":
+			n := p.current()
+			p.restart()
+			return fmt.Errorf("printer restarted at line %d", n)
+		}
+	case DialectGRBL:
+		switch {
+		case lower == "ok":
+			p.ack(nil)
+		case strings.HasPrefix(lower, "error:"):
+			n := p.current()
+			if job := p.ack(fmt.Errorf("controller rejected the command with %s", line)); job {
+				return fmt.Errorf("controller rejected line %d with %s", n, line)
+			}
+		case strings.HasPrefix(lower, "alarm:"):
+			return fmt.Errorf("controller raised %s at line %d", line, p.current())
+		case strings.HasPrefix(lower, "grbl "):
+			n := p.current()
+			p.restart()
+			return fmt.Errorf("controller reset at line %d", n)
+		}
+	}
+	return nil
+}
+
+// ack acknowledges the oldest line in flight, rejecting it with err if
+// set. It reports whether the line was a job's; a rejected frame does not
+// count as acknowledged.
+func (p *protocol) ack(err error) bool {
+	if p.ignoreOK > 0 && err == nil {
+		p.ignoreOK--
+		return false
+	}
+	if len(p.inflight) == 0 {
+		return false
+	}
+	f := p.inflight[0]
+	p.inflight = p.inflight[1:]
+	if f.reply != nil {
+		f.reply <- err
+	}
+	if f.job && err == nil {
+		p.acked++
+	}
+	return f.job
+}
+
+// resend goes back to the line a Marlin printer asks for
+func (p *protocol) resend(line string) error {
+	_, number, _ := strings.Cut(line, ":")
+	if strings.HasPrefix(strings.ToLower(line), "rs ") {
+		number = line[len("rs "):]
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(number), "N")))
+	if err != nil || n < 0 || n > p.next {
+		return fmt.Errorf("printer asked to resend unknown line %q", line)
+	}
+	p.next = n
+	p.acked = min(p.acked, n)
+	p.drop(errReset)
+	p.ignoreOK = 1
+	return nil
+}
+
+// restart forgets the lines in flight and queued, which a machine loses
+// when it restarts
+func (p *protocol) restart() {
+	p.drop(errReset)
+	for _, c := range p.commands {
+		if c.reply != nil {
+			c.reply <- errReset
+		}
+	}
+	p.commands = nil
+	p.ignoreOK = 0
+}
+
+// drop forgets the lines in flight, rejecting commands with err
+func (p *protocol) drop(err error) {
+	for _, f := range p.inflight {
+		if f.reply != nil {
+			f.reply <- err
+		}
+	}
+	p.inflight = nil
+}
+
+// unload ends the current job; its frames in flight are still awaited
+func (p *protocol) unload() {
+	p.frames = nil
+	p.next, p.acked, p.preamble = 0, 0, 0
+}
+
+// current returns the number of the program line awaiting acknowledgement,
+// counting from 1
+func (p *protocol) current() int {
+	return max(1, p.acked-p.preamble+1)
+}
+
+// waiting reports whether lines await acknowledgement
+func (p *protocol) waiting() bool {
+	return len(p.inflight) > 0
+}
+
+// done reports whether every frame of the job was acknowledged
+func (p *protocol) done() bool {
+	return len(p.frames) > 0 && p.acked == len(p.frames)
+}
+
+// lines returns the program lines of the job in total, sent and
+// acknowledged
+func (p *protocol) lines() (total, sent, acked int) {
+	return len(p.frames) - p.preamble, max(0, p.next-p.preamble), max(0, p.acked-p.preamble)
+}
@@ -0,0 +1,236 @@
+package gcode
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Temperature is a heater of a printer
+type Temperature struct {
+	// Sensor is T (the active hotend), T0, T1, ..., B (bed) or C (chamber)
+	Sensor  string
+	Current float64
+	Target  float64
+}
+
+// Status is what a machine last reported about itself
+type Status struct {
+	PortName string
+	Dialect  Dialect
+	// State is GRBL's lower-cased state (idle, run, hold, jog, alarm, door,
+	// check, home, sleep) or for Marlin ready, busy or halted
+	State string
+	// Position is the machine position by axis, WorkPosition the position
+	// in work coordinates (GRBL)
+	Position     map[string]float64
+	WorkPosition map[string]float64
+	Temperatures []Temperature
+	// FeedRate and SpindleSpeed are the current rates (GRBL)
+	FeedRate     float64
+	SpindleSpeed float64
+	// Error is the last error or alarm the machine reported
+	Error   string
+	Updated time.Time
+}
+
+// clone returns a copy of s that shares nothing with it
+func (s Status) clone() Status {
+	c := s
+	c.Position = cloneAxes(s.Position)
+	c.WorkPosition = cloneAxes(s.WorkPosition)
+	c.Temperatures = append([]Temperature(nil), s.Temperatures...)
+	return c
+}
+
+func cloneAxes(axes map[string]float64) map[string]float64 {
+	if axes == nil {
+		return nil
+	}
+	c := make(map[string]float64, len(axes))
+	for axis, v := range axes {
+		c[axis] = v
+	}
+	return c
+}
+
+// Status queries
+const (
+	// grblStatusQuery asks GRBL for a report at once, outside the buffer
+	grblStatusQuery = "?"
+	// marlinPosition and marlinTemperatures are answered after the lines
+	// sent before
+	marlinPosition     = "M114"
+	marlinTemperatures = "M105"
+)
+
+// marlinTemperature matches "T:210.0 /210.0", "B:60.1 /60.0" and the like
+var marlinTemperature = regexp.MustCompile(`\b([TBC]\d*):\s*(-?[\d.]+)\s*/\s*(-?[\d.]+)`)
+
+// statusParser updates a status from the lines a machine sends
+type statusParser struct {
+	status Status
+	// wco is GRBL's work coordinate offset, reported now and then
+	wco []float64
+}
+
+// parse updates the status from a line and reports whether it changed
+func (p *statusParser) parse(line string, now time.Time) bool {
+	line = strings.TrimSpace(line)
+	var changed bool
+	if p.status.Dialect == DialectGRBL {
+		changed = p.parseGRBL(line)
+	} else {
+		changed = p.parseMarlin(line)
+	}
+	if changed {
+		p.status.Updated = now
+	}
+	return changed
+}
+
+// parseGRBL reads GRBL 1.1 reports such as
+// "<Idle|MPos:0.000,0.000,0.000|FS:0,0|WCO:0.000,0.000,0.000>"
+func (p *statusParser) parseGRBL(line string) bool {
+	lower := strings.ToLower(line)
+	switch {
+	case strings.HasPrefix(lower, "error:"), strings.HasPrefix(lower, "alarm:"):
+		p.status.Error = line
+		return true
+	case !strings.HasPrefix(line, "<") || !strings.HasSuffix(line, ">"):
+		return false
+	}
+
+	fields := strings.Split(line[1:len(line)-1], "|")
+	state, _, _ := strings.Cut(fields[0], ":")
+	p.status.State = strings.ToLower(state)
+
+	var mpos, wpos []float64
+	for _, field := range fields[1:] {
+		name, value, _ := strings.Cut(field, ":")
+		switch name {
+		case "MPos":
+			mpos = parseFloats(value)
+		case "WPos":
+			wpos = parseFloats(value)
+		case "WCO":
+			p.wco = parseFloats(value)
+		case "FS", "F":
+			rates := parseFloats(value)
+			if len(rates) > 0 {
+				p.status.FeedRate = rates[0]
+			}
+			if len(rates) > 1 {
+				p.status.SpindleSpeed = rates[1]
+			}
+		}
+	}
+
+	// Only one of the positions is reported; WCO relates them
+	if mpos == nil && wpos != nil && len(p.wco) == len(wpos) {
+		mpos = offset(wpos, p.wco, 1)
+	}
+	if wpos == nil && mpos != nil && len(p.wco) == len(mpos) {
+		wpos = offset(mpos, p.wco, -1)
+	}
+	if mpos != nil {
+		p.status.Position = axes(mpos)
+	}
+	if wpos != nil {
+		p.status.WorkPosition = axes(wpos)
+	}
+	return true
+}
+
+// parseMarlin reads the answers to M114 ("X:10.00 Y:0.00 Z:0.00 E:0.00
+// Count X:800 ...") and M105 ("ok T:210.0 /210.0 B:60.0 /60.0 @:127")
+func (p *statusParser) parseMarlin(line string) bool {
+	lower := strings.ToLower(line)
+	switch {
+	case strings.HasPrefix(lower, "echo:busy:"), strings.HasPrefix(lower, "busy:"):
+		p.status.State = "busy"
+		return true
+	case strings.HasPrefix(lower, "error:printer halted"), strings.HasPrefix(lower, "!!"):
+		p.status.State = "halted"
+		p.status.Error = line
+		return true
+	case strings.HasPrefix(lower, "error:"):
+		if strings.Contains(lower, "checksum") || strings.Contains(lower, "line number") {
+			return false
+		}
+		p.status.Error = line
+		return true
+	case lower == "start":
+		p.status.State = "ready"
+		return true
+	case strings.HasPrefix(line, "X:"):
+		position, _, _ := strings.Cut(line, " Count ")
+		axes := make(map[string]float64)
+		for _, field := range strings.Fields(position) {
+			axis, value, ok := strings.Cut(field, ":")
+			if v, err := strconv.ParseFloat(value, 64); ok && err == nil {
+				axes[axis] = v
+			}
+		}
+		p.status.Position = axes
+		return true
+	}
+
+	changed := false
+	if strings.HasPrefix(lower, "ok") && p.status.State != "halted" {
+		changed = p.status.State != "ready"
+		p.status.State = "ready"
+	}
+	matches := marlinTemperature.FindAllStringSubmatch(line, -1)
+	if len(matches) == 0 {
+		return changed
+	}
+	// "T" repeats the active hotend when each is reported as T0, T1, ...
+	numbered := strings.Contains(line, "T0:")
+	var temperatures []Temperature
+	for _, m := range matches {
+		if m[1] == "T" && numbered {
+			continue
+		}
+		current, _ := strconv.ParseFloat(m[2], 64)
+		target, _ := strconv.ParseFloat(m[3], 64)
+		temperatures = append(temperatures, Temperature{Sensor: m[1], Current: current, Target: target})
+	}
+	p.status.Temperatures = temperatures
+	return true
+}
+
+// parseFloats parses "1.000,2.000,3.000"
+func parseFloats(s string) []float64 {
+	var values []float64
+	for _, field := range strings.Split(s, ",") {
+		v, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+// offset returns a + sign*b by element
+func offset(a, b []float64, sign float64) []float64 {
+	values := make([]float64, len(a))
+	for i := range a {
+		values[i] = a[i] + sign*b[i]
+	}
+	return values
+}
+
+// axes names GRBL's positions X, Y, Z, A, B, C
+func axes(values []float64) map[string]float64 {
+	names := []string{"X", "Y", "Z", "A", "B", "C"}
+	m := make(map[string]float64, len(values))
+	for i, v := range values {
+		if i < len(names) {
+			m[names[i]] = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,26 @@
+// Package gpio drives GPIO lines through the Linux GPIO character device,
+// e.g. to pulse the reset line of a device wired to a Raspberry Pi header.
+package gpio
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrUnsupported is returned on platforms without GPIO character devices
+var ErrUnsupported = errors.New("GPIO is only supported on Linux")
+
+// Line identifies a GPIO line
+type Line struct {
+	// Chip is the GPIO chip device, e.g. "gpiochip0" or "/dev/gpiochip0"
+	Chip string
+	// Offset is the line number on the chip (the BCM GPIO number on a Pi)
+	Offset int
+	// ActiveLow asserts the line by driving it low
+	ActiveLow bool
+}
+
+// Pulse asserts the line for d, then releases it to the inactive level
+func Pulse(line Line, d time.Duration) error {
+	return pulse(line, d)
+}
@@ -0,0 +1,81 @@
+//go:build linux
+
+package gpio
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// GPIO character device uAPI (v1), see include/uapi/linux/gpio.h
+const (
+	gpioHandlesMax         = 64
+	gpioHandleRequestOut   = 1 << 1
+	gpioHandleRequestLow   = 1 << 2
+	gpioGetLineHandleIoctl = 0xc16cb403 // _IOWR(0xB4, 0x03, struct gpiohandle_request)
+	gpioSetLineValuesIoctl = 0xc040b409 // _IOWR(0xB4, 0x09, struct gpiohandle_data)
+)
+
+// gpioHandleRequest mirrors struct gpiohandle_request
+type gpioHandleRequest struct {
+	LineOffsets   [gpioHandlesMax]uint32
+	Flags         uint32
+	DefaultValues [gpioHandlesMax]uint8
+	ConsumerLabel [32]byte
+	Lines         uint32
+	Fd            int32
+}
+
+// gpioHandleData mirrors struct gpiohandle_data
+type gpioHandleData struct {
+	Values [gpioHandlesMax]uint8
+}
+
+func pulse(line Line, d time.Duration) error {
+	path := line.Chip
+	if filepath.Base(path) == path {
+		path = filepath.Join("/dev", path)
+	}
+
+	chip, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open GPIO chip: %w", err)
+	}
+	defer chip.Close()
+
+	// Request the line as an output that starts asserted; the kernel
+	// handles active-low inversion
+	req := gpioHandleRequest{Flags: gpioHandleRequestOut, Lines: 1}
+	if line.ActiveLow {
+		req.Flags |= gpioHandleRequestLow
+	}
+	req.LineOffsets[0] = uint32(line.Offset)
+	req.DefaultValues[0] = 1
+	copy(req.ConsumerLabel[:], "seriallink-reset")
+
+	if err := ioctl(chip.Fd(), gpioGetLineHandleIoctl, unsafe.Pointer(&req)); err != nil {
+		return fmt.Errorf("failed to request GPIO line %d: %w", line.Offset, err)
+	}
+	handle := os.NewFile(uintptr(req.Fd), fmt.Sprintf("%s line %d", path, line.Offset))
+	defer handle.Close()
+
+	time.Sleep(d)
+
+	var data gpioHandleData
+	if err := ioctl(handle.Fd(), gpioSetLineValuesIoctl, unsafe.Pointer(&data)); err != nil {
+		return fmt.Errorf("failed to release GPIO line %d: %w", line.Offset, err)
+	}
+	return nil
+}
+
+func ioctl(fd uintptr, request uintptr, arg unsafe.Pointer) error {
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, fd, request, uintptr(arg)); errno != 0 {
+		return errno
+	}
+	return nil
+}
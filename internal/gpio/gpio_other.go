@@ -0,0 +1,9 @@
+//go:build !linux
+
+package gpio
+
+import "time"
+
+func pulse(line Line, d time.Duration) error {
+	return ErrUnsupported
+}
@@ -0,0 +1,436 @@
+// Package history keeps a small on-disk time series of polled values,
+// downsampled into minute and hour averages for longer retention.
+package history
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/poller"
+	"github.com/charmbracelet/log"
+)
+
+// dayLayout names the daily segment files of a tier
+const dayLayout = "2006-01-02"
+
+// pruneInterval is how often expired segments are removed
+const pruneInterval = time.Hour
+
+// Retention is how long each tier keeps data; zero disables a tier
+type Retention struct {
+	Raw    time.Duration
+	Minute time.Duration
+	Hour   time.Duration
+}
+
+// Point is a value, or the aggregate of the values in one step
+type Point struct {
+	Time  time.Time
+	Min   float64
+	Max   float64
+	Avg   float64
+	Count int
+}
+
+// Series is the history of one value of a poller
+type Series struct {
+	Poller string
+	Field  string
+	Points []Point
+}
+
+// record is one line of a segment file
+type record struct {
+	Time   int64   `json:"t"`
+	Poller string  `json:"p"`
+	Field  string  `json:"f"`
+	Min    float64 `json:"min"`
+	Max    float64 `json:"max"`
+	Avg    float64 `json:"avg"`
+	Count  int     `json:"n"`
+}
+
+// tier is one resolution of the store. Raw points go straight to disk;
+// coarser tiers aggregate in memory until their step ends.
+type tier struct {
+	name      string
+	step      time.Duration
+	retention time.Duration
+
+	day     string
+	file    *os.File
+	writer  *bufio.Writer
+	pending map[seriesKey]*record
+}
+
+type seriesKey struct {
+	poller string
+	field  string
+}
+
+// Store is an on-disk history of polled values
+type Store struct {
+	dir    string
+	logger *log.Logger
+
+	mu    sync.Mutex
+	tiers []*tier
+}
+
+// Open opens (or creates) a store in dir
+func Open(dir string, retention Retention, logger *log.Logger) (*Store, error) {
+	s := &Store{dir: dir, logger: logger}
+	for _, t := range []*tier{
+		{name: "raw", retention: retention.Raw},
+		{name: "1m", step: time.Minute, retention: retention.Minute},
+		{name: "1h", step: time.Hour, retention: retention.Hour},
+	} {
+		if t.retention <= 0 {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Join(dir, t.name), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create history directory: %w", err)
+		}
+		t.pending = make(map[seriesKey]*record)
+		s.tiers = append(s.tiers, t)
+	}
+	if len(s.tiers) == 0 {
+		return nil, errors.New("every history tier is disabled")
+	}
+
+	s.Prune(time.Now())
+	return s, nil
+}
+
+// Follow records every successful sample of the engine until ctx is
+// cancelled
+func (s *Store) Follow(ctx context.Context, engine *poller.Engine) {
+	samples := engine.Subscribe()
+	defer engine.Unsubscribe(samples)
+
+	prune := time.NewTicker(pruneInterval)
+	defer prune.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-prune.C:
+			s.Prune(now)
+		case sample := <-samples:
+			if sample.Err != nil {
+				continue
+			}
+			for _, v := range sample.Values {
+				if err := s.Record(sample.Poller, v.Name, sample.Timestamp, v.Value); err != nil {
+					s.logger.Warn("failed to record history", "poller", sample.Poller, "error", err)
+					break
+				}
+			}
+		}
+	}
+}
+
+// Record adds a value to every tier
+func (s *Store) Record(pollerName, field string, at time.Time, value float64) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := seriesKey{pollerName, field}
+	for _, t := range s.tiers {
+		if t.step == 0 {
+			if err := s.write(t, record{
+				Time: at.UnixNano(), Poller: pollerName, Field: field,
+				Min: value, Max: value, Avg: value, Count: 1,
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		bucket := at.Truncate(t.step).UnixNano()
+		agg := t.pending[key]
+		if agg != nil && agg.Time != bucket {
+			// The step ended; persist it and start the next one
+			if err := s.write(t, *agg); err != nil {
+				return err
+			}
+			agg = nil
+		}
+		if agg == nil {
+			t.pending[key] = &record{
+				Time: bucket, Poller: pollerName, Field: field,
+				Min: value, Max: value, Avg: value, Count: 1,
+			}
+			continue
+		}
+		agg.merge(record{Min: value, Max: value, Avg: value, Count: 1})
+	}
+
+	for _, t := range s.tiers {
+		if t.writer != nil {
+			if err := t.writer.Flush(); err != nil {
+				return fmt.Errorf("failed to write history: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// write appends a record to the tier's segment for the record's day (lock held)
+func (s *Store) write(t *tier, rec record) error {
+	day := time.Unix(0, rec.Time).UTC().Format(dayLayout)
+	if t.day != day {
+		if err := t.close(); err != nil {
+			return err
+		}
+		file, err := os.OpenFile(filepath.Join(s.dir, t.name, day+".jsonl"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to open history segment: %w", err)
+		}
+		t.day = day
+		t.file = file
+		t.writer = bufio.NewWriter(file)
+	}
+
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	_, _ = t.writer.Write(line)
+	return t.writer.WriteByte('\n')
+}
+
+// Query returns the history of a poller's values between start and end.
+// An empty field selects every value of the poller. Points are aggregated
+// into steps of at least step, read from the tier chosen by selectTier.
+// The step used is returned.
+func (s *Store) Query(pollerName, field string, start, end time.Time, step time.Duration) ([]Series, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := s.selectTier(start, step)
+	if step < t.step {
+		step = t.step
+	}
+	if t.writer != nil {
+		if err := t.writer.Flush(); err != nil {
+			return nil, 0, fmt.Errorf("failed to write history: %w", err)
+		}
+	}
+
+	type bucketKey struct {
+		series seriesKey
+		time   int64
+	}
+	buckets := make(map[bucketKey]*record)
+	add := func(rec record) {
+		if rec.Poller != pollerName || (field != "" && rec.Field != field) {
+			return
+		}
+		at := time.Unix(0, rec.Time)
+		if at.Before(start) || at.After(end) {
+			return
+		}
+		key := bucketKey{seriesKey{rec.Poller, rec.Field}, rec.Time}
+		if step > 0 {
+			key.time = at.Truncate(step).UnixNano()
+		}
+		if agg, ok := buckets[key]; ok {
+			agg.merge(rec)
+			return
+		}
+		rec.Time = key.time
+		buckets[key] = &rec
+	}
+
+	for day := start.UTC().Truncate(24 * time.Hour); !day.After(end); day = day.Add(24 * time.Hour) {
+		path := filepath.Join(s.dir, t.name, day.Format(dayLayout)+".jsonl")
+		if err := readSegment(path, add); err != nil {
+			return nil, 0, err
+		}
+	}
+	// Include the step still being aggregated
+	for _, rec := range t.pending {
+		add(*rec)
+	}
+
+	bySeries := make(map[seriesKey]*Series)
+	var result []*Series
+	for key, rec := range buckets {
+		series, ok := bySeries[key.series]
+		if !ok {
+			series = &Series{Poller: key.series.poller, Field: key.series.field}
+			bySeries[key.series] = series
+			result = append(result, series)
+		}
+		series.Points = append(series.Points, Point{
+			Time:  time.Unix(0, rec.Time),
+			Min:   rec.Min,
+			Max:   rec.Max,
+			Avg:   rec.Avg,
+			Count: rec.Count,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Field < result[j].Field })
+	series := make([]Series, 0, len(result))
+	for _, r := range result {
+		sort.Slice(r.Points, func(i, j int) bool { return r.Points[i].Time.Before(r.Points[j].Time) })
+		series = append(series, *r)
+	}
+	return series, step, nil
+}
+
+// selectTier picks the coarsest tier no coarser than step whose retention
+// reaches back to start. Without one it picks the finest tier that does,
+// or else the longest-lived tier (lock held).
+func (s *Store) selectTier(start time.Time, step time.Duration) *tier {
+	age := time.Since(start)
+
+	var chosen *tier
+	for _, t := range s.tiers {
+		if age > t.retention {
+			continue
+		}
+		if chosen == nil || (t.step <= step && t.step > chosen.step) {
+			chosen = t
+		}
+	}
+	if chosen != nil {
+		return chosen
+	}
+
+	longest := s.tiers[0]
+	for _, t := range s.tiers {
+		if t.retention > longest.retention {
+			longest = t
+		}
+	}
+	return longest
+}
+
+// readSegment calls fn for every record in a segment file
+func readSegment(path string, fn func(record)) error {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read history: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var rec record
+		if json.Unmarshal(scanner.Bytes(), &rec) != nil {
+			// A torn line from a power loss; skip it
+			continue
+		}
+		fn(rec)
+	}
+	return scanner.Err()
+}
+
+// Prune removes segments older than their tier's retention
+func (s *Store) Prune(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range s.tiers {
+		entries, err := os.ReadDir(filepath.Join(s.dir, t.name))
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			day, err := time.Parse(dayLayout, strings.TrimSuffix(entry.Name(), ".jsonl"))
+			if err != nil || day.Format(dayLayout) == t.day {
+				continue
+			}
+			// A segment expires once its last point is older than retention
+			if now.Sub(day.Add(24*time.Hour)) > t.retention {
+				path := filepath.Join(s.dir, t.name, entry.Name())
+				if err := os.Remove(path); err != nil {
+					s.logger.Warn("failed to prune history", "path", path, "error", err)
+				}
+			}
+		}
+	}
+}
+
+// InUse reports whether path is a segment the store still writes to: the
+// open segment or today's segment of any tier
+func (s *Store) InUse(path string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path = filepath.Clean(path)
+	today := time.Now().UTC().Format(dayLayout)
+	for _, t := range s.tiers {
+		for _, day := range []string{t.day, today} {
+			if day != "" && path == filepath.Join(s.dir, t.name, day+".jsonl") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Close writes the steps still being aggregated and closes the store
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var firstErr error
+	for _, t := range s.tiers {
+		for _, rec := range t.pending {
+			if err := s.write(t, *rec); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+		if err := t.close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// close flushes and closes the current segment
+func (t *tier) close() error {
+	if t.file == nil {
+		return nil
+	}
+	err := t.writer.Flush()
+	if closeErr := t.file.Close(); err == nil {
+		err = closeErr
+	}
+	t.file, t.writer, t.day = nil, nil, ""
+	if err != nil {
+		return fmt.Errorf("failed to write history: %w", err)
+	}
+	return nil
+}
+
+// merge folds other into r
+func (r *record) merge(other record) {
+	total := r.Avg*float64(r.Count) + other.Avg*float64(other.Count)
+	r.Count += other.Count
+	r.Avg = total / float64(r.Count)
+	r.Min = math.Min(r.Min, other.Min)
+	r.Max = math.Max(r.Max, other.Max)
+}
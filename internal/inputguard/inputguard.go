@@ -0,0 +1,226 @@
+// Package inputguard screens the keystrokes of interactive console sessions
+// for dangerous input, such as "erase startup-config" or the sequence that
+// breaks a router into ROMMON. Typed lines are followed as they are edited;
+// when Enter completes a line matching a rule, the Enter is blocked or held
+// until the user confirms it, so the command never runs by accident.
+package inputguard
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// Action is what happens to matching input
+type Action string
+
+// Actions
+const (
+	// Block drops the input and erases the typed line on the device
+	Block Action = "block"
+	// Confirm holds the input until the user answers "y"
+	Confirm Action = "confirm"
+)
+
+// DefaultCancel erases the typed line on most device CLIs (Ctrl-U)
+var DefaultCancel = []byte{0x15}
+
+// Rule matches a typed line, without its CR or LF, when Enter is pressed
+type Rule struct {
+	Pattern *regexp.Regexp
+	Action  Action
+}
+
+// Sequence matches raw input as it arrives, e.g. "\x1d" or "~B". The bytes
+// of a sequence are held back until it is complete or cannot be.
+type Sequence struct {
+	Data   []byte
+	Action Action
+}
+
+// Policy is the guard of one port
+type Policy struct {
+	Port      string
+	Rules     []Rule
+	Sequences []Sequence
+	// Cancel is written to the device when a typed line is blocked or not
+	// confirmed (default DefaultCancel)
+	Cancel []byte
+}
+
+// Guard holds the policies of all guarded ports
+type Guard struct {
+	policies map[string]*Policy
+}
+
+// New returns a guard for the policies
+func New(policies []Policy) *Guard {
+	g := &Guard{policies: make(map[string]*Policy, len(policies))}
+	for i := range policies {
+		if policies[i].Cancel == nil {
+			policies[i].Cancel = DefaultCancel
+		}
+		g.policies[policies[i].Port] = &policies[i]
+	}
+	return g
+}
+
+// Session returns a screen for an interactive session on a port, nil when
+// the port is not guarded
+func (g *Guard) Session(portName string) *Session {
+	if g == nil {
+		return nil
+	}
+	policy, ok := g.policies[portName]
+	if !ok {
+		return nil
+	}
+	return &Session{policy: policy}
+}
+
+// Session screens the input of one interactive session. It is not safe
+// for concurrent use.
+type Session struct {
+	policy *Policy
+	// line is the line typed so far
+	line []byte
+	// prefix holds input that begins a sequence
+	prefix []byte
+	// held is input waiting for confirmation, heldLine whether it ends a
+	// typed line
+	held     []byte
+	heldLine bool
+}
+
+// Result is what screened input turns into
+type Result struct {
+	// Write goes to the device
+	Write []byte
+	// Notice goes to the user, e.g. a confirmation prompt
+	Notice []byte
+	// Blocked describes blocked input, for logs and audit; empty if none
+	Blocked []string
+}
+
+// Input screens keystrokes sent to the device
+func (s *Session) Input(data []byte) Result {
+	var r Result
+	for _, b := range data {
+		s.input(b, &r)
+	}
+	return r
+}
+
+// input screens one byte
+func (s *Session) input(b byte, r *Result) {
+	if s.held != nil {
+		s.answer(b, r)
+		return
+	}
+
+	if len(s.policy.Sequences) > 0 {
+		s.prefix = append(s.prefix, b)
+		for len(s.prefix) > 0 {
+			if seq, ok := s.sequence(); ok {
+				data := s.prefix
+				s.prefix = nil
+				s.act(seq.Action, data, false, fmt.Sprintf("sequence %s", strconv.Quote(string(seq.Data))), r)
+				return
+			}
+			if s.isPrefix() {
+				return
+			}
+			// The first byte starts no sequence
+			first := s.prefix[0]
+			s.prefix = s.prefix[1:]
+			s.typed(first, r)
+		}
+		s.prefix = nil
+		return
+	}
+	s.typed(b, r)
+}
+
+// sequence returns the sequence the held prefix completes
+func (s *Session) sequence() (Sequence, bool) {
+	for _, seq := range s.policy.Sequences {
+		if bytes.Equal(s.prefix, seq.Data) {
+			return seq, true
+		}
+	}
+	return Sequence{}, false
+}
+
+// isPrefix reports whether the held prefix may still become a sequence
+func (s *Session) isPrefix() bool {
+	for _, seq := range s.policy.Sequences {
+		if len(seq.Data) > len(s.prefix) && bytes.HasPrefix(seq.Data, s.prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// typed follows the edited line and screens it when Enter is pressed
+func (s *Session) typed(b byte, r *Result) {
+	switch {
+	case b == '\r' || b == '\n':
+		if len(s.line) == 0 {
+			r.Write = append(r.Write, b)
+			return
+		}
+		line := string(s.line)
+		s.line = s.line[:0]
+		for _, rule := range s.policy.Rules {
+			if rule.Pattern.MatchString(line) {
+				s.act(rule.Action, []byte{b}, true, strconv.Quote(line), r)
+				return
+			}
+		}
+	case b == 0x08 || b == 0x7f:
+		if len(s.line) > 0 {
+			s.line = s.line[:len(s.line)-1]
+		}
+	case b == 0x15 || b == 0x03:
+		// Ctrl-U and Ctrl-C drop the line
+		s.line = s.line[:0]
+	case b >= 0x20:
+		s.line = append(s.line, b)
+	}
+	r.Write = append(r.Write, b)
+}
+
+// act blocks input or holds it for confirmation
+func (s *Session) act(action Action, data []byte, line bool, what string, r *Result) {
+	if action == Confirm {
+		s.held, s.heldLine = data, line
+		r.Notice = append(r.Notice, fmt.Sprintf("\r\n%% SerialLink: %s is guarded. Send it? [y/N] ", what)...)
+		return
+	}
+	if line {
+		r.Write = append(r.Write, s.policy.Cancel...)
+	}
+	r.Notice = append(r.Notice, fmt.Sprintf("\r\n%% SerialLink: %s is blocked on this port\r\n", what)...)
+	r.Blocked = append(r.Blocked, what)
+}
+
+// answer completes a confirmation with the next keystroke
+func (s *Session) answer(b byte, r *Result) {
+	held, line := s.held, s.heldLine
+	if line && b == '\n' && bytes.Equal(held, []byte{'\r'}) {
+		// The LF of a CR LF Enter
+		s.held = append(held, b)
+		return
+	}
+	s.held, s.heldLine = nil, false
+	if b == 'y' || b == 'Y' {
+		r.Notice = append(r.Notice, "y\r\n"...)
+		r.Write = append(r.Write, held...)
+		return
+	}
+	if line {
+		r.Write = append(r.Write, s.policy.Cancel...)
+	}
+	r.Notice = append(r.Notice, "n\r\n% SerialLink: cancelled\r\n"...)
+}
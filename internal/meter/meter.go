@@ -0,0 +1,313 @@
+// Package meter reads electricity, gas, water and heat meters over the
+// optical or wired interface of IEC 62056-21 in mode C, switching to the
+// speed the meter offers for the readout, and parses its OBIS coded values.
+package meter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+)
+
+// Errors of a readout
+var (
+	ErrInvalid    = errors.New("invalid meter request")
+	ErrNoResponse = errors.New("the meter did not respond")
+	ErrProtocol   = errors.New("meter protocol error")
+)
+
+// InitialBaudRate is the speed every exchange starts at, 7E1
+const InitialBaudRate = 300
+
+// DefaultTimeout is how long the meter may stay silent, per the 1.5s
+// response time the standard allows
+const DefaultTimeout = 1500 * time.Millisecond
+
+// Control characters of the protocol
+const (
+	stx = 0x02
+	etx = 0x03
+	ack = 0x06
+)
+
+// baudRates are the speeds of mode C by the character in the meter's
+// identification
+var baudRates = []int{300, 600, 1200, 2400, 4800, 9600, 19200}
+
+// dataSet matches "1.8.0(001234.567*kWh)"; an empty address continues the
+// previous one, as in "0.9.1(12:00:00)(13:00:00)"
+var dataSet = regexp.MustCompile(`([^()\r\n]*)\(([^()\r\n]*)\)`)
+
+// Options tune a readout
+type Options struct {
+	// Address selects a meter on a shared bus; empty for any
+	Address string
+	// MaxBaudRate caps the speed of the readout (0: as offered)
+	MaxBaudRate int
+	// Timeout overrides DefaultTimeout
+	Timeout time.Duration
+}
+
+// Value is one data set of a readout
+type Value struct {
+	// Code is the OBIS code as the meter sends it, e.g. "1.8.0" or
+	// "1-0:1.8.0*255"
+	Code  string
+	Value string
+	Unit  string
+	// Number is the value when it is numeric
+	Number  float64
+	Numeric bool
+}
+
+// Readout is what a meter sent
+type Readout struct {
+	// Manufacturer is the three letter FLAG ID, e.g. "ISK"
+	Manufacturer string
+	// Identification is the rest of the identification line
+	Identification string
+	// BaudRate is the speed of the readout
+	BaudRate int
+	Values   []Value
+	// Data is the data block as received
+	Data []byte
+}
+
+// Request returns the request message for a meter address
+func Request(address string) []byte {
+	return []byte("/?" + address + "!\r\n")
+}
+
+// Read runs a mode C data readout on rw, which must be a
+// serial.LineSwitcher: the exchange starts at 300 baud, 7E1, and switches
+// to the agreed speed for the readout. Reads on rw must time out regularly.
+func Read(ctx context.Context, rw io.ReadWriter, opts Options) (Readout, error) {
+	if strings.ContainsAny(opts.Address, "/?!\r\n") || len(opts.Address) > 32 {
+		return Readout{}, fmt.Errorf("%w: address %q", ErrInvalid, opts.Address)
+	}
+	if opts.MaxBaudRate != 0 && opts.MaxBaudRate < InitialBaudRate {
+		return Readout{}, fmt.Errorf("%w: max baud rate %d is below %d", ErrInvalid, opts.MaxBaudRate, InitialBaudRate)
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	switcher, ok := rw.(serial.LineSwitcher)
+	if !ok {
+		return Readout{}, fmt.Errorf("%w: the line cannot switch speed", ErrInvalid)
+	}
+
+	line := switcher.Line()
+	line.BaudRate, line.DataBits, line.Parity, line.StopBits = InitialBaudRate, 7, serial.ParityEven, serial.StopBits1
+	if err := switcher.SetLine(line); err != nil {
+		return Readout{}, err
+	}
+
+	r := &reader{rw: rw, timeout: opts.Timeout}
+	if err := r.discard(); err != nil {
+		return Readout{}, err
+	}
+	request := Request(opts.Address)
+	if _, err := rw.Write(request); err != nil {
+		return Readout{}, err
+	}
+
+	// A half-duplex line echoes the request
+	identification, err := r.line(ctx)
+	if err == nil && bytes.Equal(identification, bytes.TrimRight(request, "\r\n")) {
+		identification, err = r.line(ctx)
+	}
+	if err != nil {
+		return Readout{}, err
+	}
+	readout, speed, err := parseIdentification(identification)
+	if err != nil {
+		return Readout{}, err
+	}
+
+	// The meter offers its top speed; any lower one of mode C may be chosen
+	for speed > 0 && opts.MaxBaudRate > 0 && baudRates[speed] > opts.MaxBaudRate {
+		speed--
+	}
+	acknowledgement := []byte{ack, '0', byte('0' + speed), '0', '\r', '\n'}
+	if _, err := rw.Write(acknowledgement); err != nil {
+		return Readout{}, err
+	}
+	readout.BaudRate = baudRates[speed]
+	if speed > 0 {
+		// The meter answers at the new speed no sooner than 200ms after the
+		// acknowledgement, which takes 10 bits a character at 300 baud
+		if err := sleep(ctx, time.Duration(len(acknowledgement))*10*time.Second/InitialBaudRate); err != nil {
+			return Readout{}, err
+		}
+		line.BaudRate = readout.BaudRate
+		if err := switcher.SetLine(line); err != nil {
+			return Readout{}, err
+		}
+	}
+
+	data, err := r.message(ctx)
+	if err != nil {
+		return Readout{}, err
+	}
+	readout.Data = data
+	readout.Values = ParseData(data)
+	return readout, nil
+}
+
+// parseIdentification parses "/ISk5MT174-0001": manufacturer, the
+// character of the top speed and the identification
+func parseIdentification(line []byte) (Readout, int, error) {
+	if len(line) < 5 || line[0] != '/' {
+		return Readout{}, 0, fmt.Errorf("%w: unexpected identification %q", ErrProtocol, line)
+	}
+	readout := Readout{Manufacturer: string(line[1:4]), Identification: string(line[5:])}
+	speed := int(line[4] - '0')
+	if line[4] < '0' || speed >= len(baudRates) {
+		return Readout{}, 0, fmt.Errorf("%w: the meter offers mode A or B (speed %q), only mode C is supported", ErrProtocol, line[4])
+	}
+	return readout, speed, nil
+}
+
+// ParseData parses the data sets of a data block, ending at "!"
+func ParseData(data []byte) []Value {
+	var values []Value
+	code := ""
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "!" {
+			break
+		}
+		for _, m := range dataSet.FindAllStringSubmatch(line, -1) {
+			if c := strings.TrimSpace(m[1]); c != "" {
+				code = c
+			}
+			value := Value{Code: code, Value: m[2]}
+			if v, unit, ok := strings.Cut(m[2], "*"); ok {
+				value.Value, value.Unit = v, unit
+			}
+			if n, err := strconv.ParseFloat(value.Value, 64); err == nil {
+				value.Number, value.Numeric = n, true
+			}
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// reader reads the messages of a meter, failing when it stays silent for
+// timeout
+type reader struct {
+	rw      io.ReadWriter
+	timeout time.Duration
+	pending []byte
+}
+
+// discard drops stale input
+func (r *reader) discard() error {
+	buffer := make([]byte, 256)
+	for {
+		n, err := r.rw.Read(buffer)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+	}
+}
+
+// line returns the next line without CR LF
+func (r *reader) line(ctx context.Context) ([]byte, error) {
+	end, err := r.until(ctx, func(data []byte) int {
+		if i := bytes.Index(data, []byte("\r\n")); i >= 0 {
+			return i + 2
+		}
+		return -1
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(end, "\r\n"), nil
+}
+
+// message returns the data block of "STX data ETX BCC", checking the block
+// check character, the XOR of the bytes after STX through ETX
+func (r *reader) message(ctx context.Context) ([]byte, error) {
+	msg, err := r.until(ctx, func(data []byte) int {
+		if i := bytes.IndexByte(data, etx); i >= 0 && i+1 < len(data) {
+			return i + 2
+		}
+		return -1
+	})
+	if err != nil {
+		return nil, err
+	}
+	start := bytes.IndexByte(msg, stx)
+	if start < 0 {
+		return nil, fmt.Errorf("%w: the readout does not start with STX", ErrProtocol)
+	}
+	var bcc byte
+	for _, b := range msg[start+1 : len(msg)-1] {
+		bcc ^= b
+	}
+	if bcc != msg[len(msg)-1] {
+		return nil, fmt.Errorf("%w: block check character mismatch: got %02X, computed %02X", ErrProtocol, msg[len(msg)-1], bcc)
+	}
+	return msg[start+1 : len(msg)-2], nil
+}
+
+// until reads until end returns the length of a complete message
+func (r *reader) until(ctx context.Context, end func(data []byte) int) ([]byte, error) {
+	buffer := make([]byte, 512)
+	last := time.Now()
+	for {
+		if n := end(r.pending); n >= 0 {
+			msg := r.pending[:n:n]
+			r.pending = r.pending[n:]
+			return msg, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if time.Since(last) > r.timeout {
+			if len(r.pending) > 0 {
+				return nil, fmt.Errorf("%w: incomplete message %q", ErrNoResponse, truncate(r.pending))
+			}
+			return nil, ErrNoResponse
+		}
+
+		n, err := r.rw.Read(buffer)
+		if err != nil {
+			return nil, err
+		}
+		if n > 0 {
+			r.pending = append(r.pending, buffer[:n]...)
+			last = time.Now()
+		}
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// truncate shortens data for error messages
+func truncate(b []byte) string {
+	if len(b) > 64 {
+		return string(b[:64]) + "..."
+	}
+	return string(b)
+}
@@ -0,0 +1,107 @@
+package metrics
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// TypeHistogram is the type of histogram families
+const TypeHistogram = "histogram"
+
+// DefaultBuckets are upper bounds, in seconds, suited to request latencies
+var DefaultBuckets = []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Histogram counts observations into buckets, one series per combination
+// of label values. It is safe for concurrent use.
+type Histogram struct {
+	name       string
+	help       string
+	labelNames []string
+	buckets    []float64
+
+	mu     sync.Mutex
+	series map[string]*histogramSeries
+}
+
+// histogramSeries is the histogram of one combination of label values
+type histogramSeries struct {
+	labels []Label
+	// counts are per bucket, not cumulative; the last is +Inf
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// NewHistogram creates a histogram with the given bucket upper bounds and
+// label names
+func NewHistogram(name, help string, buckets []float64, labelNames ...string) *Histogram {
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	return &Histogram{
+		name:       name,
+		help:       help,
+		labelNames: labelNames,
+		buckets:    buckets,
+		series:     make(map[string]*histogramSeries),
+	}
+}
+
+// Observe records a value; labelValues are in the order of the label names
+func (h *Histogram) Observe(value float64, labelValues ...string) {
+	key := strings.Join(labelValues, "\xff")
+	bucket := sort.SearchFloat64s(h.buckets, value)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.series[key]
+	if !ok {
+		s = &histogramSeries{counts: make([]uint64, len(h.buckets)+1)}
+		for i, name := range h.labelNames {
+			value := ""
+			if i < len(labelValues) {
+				value = labelValues[i]
+			}
+			s.labels = append(s.labels, Label{Name: name, Value: value})
+		}
+		h.series[key] = s
+	}
+	s.counts[bucket]++
+	s.sum += value
+	s.count++
+}
+
+// Collect implements Collector
+func (h *Histogram) Collect() []Family {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	keys := make([]string, 0, len(h.series))
+	for key := range h.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	family := Family{Name: h.name, Help: h.help, Type: TypeHistogram}
+	for _, key := range keys {
+		s := h.series[key]
+		var cumulative uint64
+		for i, count := range s.counts {
+			cumulative += count
+			le := "+Inf"
+			if i < len(h.buckets) {
+				le = strconv.FormatFloat(h.buckets[i], 'g', -1, 64)
+			}
+			family.Samples = append(family.Samples, Sample{
+				Suffix: "_bucket",
+				Labels: append(append([]Label(nil), s.labels...), Label{Name: "le", Value: le}),
+				Value:  float64(cumulative),
+			})
+		}
+		family.Samples = append(family.Samples,
+			Sample{Suffix: "_sum", Labels: s.labels, Value: s.sum},
+			Sample{Suffix: "_count", Labels: s.labels, Value: float64(s.count)})
+	}
+	return []Family{family}
+}
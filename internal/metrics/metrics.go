@@ -0,0 +1,146 @@
+// Package metrics exposes agent metrics in the Prometheus text format
+// without pulling in a client library.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Metric types
+const (
+	TypeGauge   = "gauge"
+	TypeCounter = "counter"
+)
+
+// Label is a metric label
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample is one value of a metric family
+type Sample struct {
+	// Suffix is appended to the family name, e.g. "_bucket" (usually empty)
+	Suffix string
+	Labels []Label
+	Value  float64
+}
+
+// Family is a named metric with its samples
+type Family struct {
+	Name    string
+	Help    string
+	Type    string
+	Samples []Sample
+}
+
+// Collector produces metric families at scrape time
+type Collector interface {
+	Collect() []Family
+}
+
+// Registry holds collectors and serves their metrics over HTTP
+type Registry struct {
+	mu         sync.RWMutex
+	collectors []Collector
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds a collector
+func (r *Registry) Register(c Collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.collectors = append(r.collectors, c)
+}
+
+// Gather collects all metric families, sorted by name
+func (r *Registry) Gather() []Family {
+	r.mu.RLock()
+	collectors := append([]Collector(nil), r.collectors...)
+	r.mu.RUnlock()
+
+	var families []Family
+	for _, c := range collectors {
+		families = append(families, c.Collect()...)
+	}
+	sort.SliceStable(families, func(i, j int) bool {
+		return families[i].Name < families[j].Name
+	})
+	return families
+}
+
+// ServeHTTP writes all metrics in the Prometheus text exposition format
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	out := bufio.NewWriter(w)
+	for _, family := range r.Gather() {
+		if family.Help != "" {
+			fmt.Fprintf(out, "# HELP %s %s\n", family.Name, escapeHelp(family.Help))
+		}
+		if family.Type != "" {
+			fmt.Fprintf(out, "# TYPE %s %s\n", family.Name, family.Type)
+		}
+		for _, sample := range family.Samples {
+			out.WriteString(family.Name + sample.Suffix)
+			writeLabels(out, sample.Labels)
+			out.WriteByte(' ')
+			out.WriteString(formatValue(sample.Value))
+			out.WriteByte('\n')
+		}
+	}
+	_ = out.Flush()
+}
+
+func writeLabels(out *bufio.Writer, labels []Label) {
+	if len(labels) == 0 {
+		return
+	}
+	out.WriteByte('{')
+	for i, label := range labels {
+		if i > 0 {
+			out.WriteByte(',')
+		}
+		out.WriteString(label.Name)
+		out.WriteString(`="`)
+		out.WriteString(escapeLabel(label.Value))
+		out.WriteByte('"')
+	}
+	out.WriteByte('}')
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string {
+	return helpEscaper.Replace(s)
+}
+
+func escapeLabel(s string) string {
+	return labelEscaper.Replace(s)
+}
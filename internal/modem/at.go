@@ -0,0 +1,192 @@
+// Package modem implements an AT command layer and common cellular modem
+// workflows (SMS, signal and registration queries, PPP hand-off) on top of
+// a serial line.
+package modem
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// DefaultTimeout bounds a single AT command
+const DefaultTimeout = 5 * time.Second
+
+// ErrTimeout is returned when a modem does not answer in time
+var ErrTimeout = errors.New("modem did not respond in time")
+
+// CommandError is a final ERROR result from the modem
+type CommandError struct {
+	Command string
+	// Result is the final result line, e.g. "ERROR" or "+CMS ERROR: 500"
+	Result string
+}
+
+func (e *CommandError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Command, e.Result)
+}
+
+// AT runs AT commands over a line. Reads on the line must time out
+// regularly (returning 0 bytes) so deadlines are honoured.
+type AT struct {
+	rw      io.ReadWriter
+	pending []byte
+}
+
+// NewAT creates an AT command runner on rw
+func NewAT(rw io.ReadWriter) *AT {
+	return &AT{rw: rw}
+}
+
+// Command sends an AT command and returns the information lines of the
+// response, without echo and final result. A CONNECT result is returned as
+// the last line.
+func (a *AT) Command(ctx context.Context, cmd string, timeout time.Duration) ([]string, error) {
+	if err := a.send(cmd + "\r"); err != nil {
+		return nil, err
+	}
+	return a.response(ctx, cmd, timeout)
+}
+
+// CommandWithBody sends a command that prompts for a body with "> " (e.g.
+// AT+CMGS), then sends the body terminated by Ctrl-Z
+func (a *AT) CommandWithBody(ctx context.Context, cmd string, body []byte, timeout time.Duration) ([]string, error) {
+	if err := a.send(cmd + "\r"); err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		if i := bytes.Index(a.pending, []byte("> ")); i >= 0 {
+			a.pending = a.pending[i+2:]
+			break
+		}
+		if line, ok := a.takeLine(); ok {
+			if result, final := finalResult(line); final {
+				return nil, &CommandError{Command: cmd, Result: result}
+			}
+			continue
+		}
+		if err := a.fill(ctx, deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := a.send(string(body) + "\x1a"); err != nil {
+		return nil, err
+	}
+	return a.response(ctx, cmd, timeout)
+}
+
+func (a *AT) send(s string) error {
+	if _, err := a.rw.Write([]byte(s)); err != nil {
+		return fmt.Errorf("failed to send AT command: %w", err)
+	}
+	return nil
+}
+
+// response collects lines until a final result code
+func (a *AT) response(ctx context.Context, cmd string, timeout time.Duration) ([]string, error) {
+	deadline := time.Now().Add(timeout)
+	var lines []string
+	for {
+		line, ok := a.takeLine()
+		if !ok {
+			if err := a.fill(ctx, deadline); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if line == "" || line == cmd {
+			continue
+		}
+		if result, final := finalResult(line); final {
+			switch {
+			case result == "OK":
+				return lines, nil
+			case strings.HasPrefix(result, "CONNECT"):
+				return append(lines, result), nil
+			default:
+				return nil, &CommandError{Command: cmd, Result: result}
+			}
+		}
+		lines = append(lines, line)
+	}
+}
+
+// takeLine removes the next complete line from the pending input
+func (a *AT) takeLine() (string, bool) {
+	i := bytes.IndexAny(a.pending, "\r\n")
+	if i < 0 {
+		return "", false
+	}
+	line := string(a.pending[:i])
+	a.pending = a.pending[i+1:]
+	return strings.TrimSpace(line), true
+}
+
+// fill reads more input, failing once the deadline has passed
+func (a *AT) fill(ctx context.Context, deadline time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if time.Now().After(deadline) {
+		return ErrTimeout
+	}
+
+	buffer := make([]byte, 256)
+	n, err := a.rw.Read(buffer)
+	if err != nil {
+		return err
+	}
+	a.pending = append(a.pending, buffer[:n]...)
+	return nil
+}
+
+// finalResult reports whether a line is a final result code
+func finalResult(line string) (string, bool) {
+	switch {
+	case line == "OK", line == "ERROR", line == "NO CARRIER", line == "BUSY",
+		line == "NO DIALTONE", line == "NO ANSWER",
+		strings.HasPrefix(line, "CONNECT"),
+		strings.HasPrefix(line, "+CME ERROR:"), strings.HasPrefix(line, "+CMS ERROR:"):
+		return line, true
+	}
+	return "", false
+}
+
+// field returns the value of an information line with the given prefix,
+// e.g. field(lines, "+CSQ:") returns "17,99" for "+CSQ: 17,99"
+func field(lines []string, prefix string) (string, bool) {
+	for _, line := range lines {
+		if strings.HasPrefix(line, prefix) {
+			return strings.TrimSpace(strings.TrimPrefix(line, prefix)), true
+		}
+	}
+	return "", false
+}
+
+// splitParams splits comma separated parameters, honouring quotes and
+// removing them
+func splitParams(s string) []string {
+	var params []string
+	var current strings.Builder
+	quoted := false
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ',' && !quoted:
+			params = append(params, strings.TrimSpace(current.String()))
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	return append(params, strings.TrimSpace(current.String()))
+}
@@ -0,0 +1,107 @@
+package modem
+
+import "strings"
+
+// gsm7Basic is the GSM 03.38 default alphabet, indexed by septet value
+const gsm7Basic = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞ\x1bÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+	"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+
+// gsm7Escape introduces a character from the extension table
+const gsm7Escape = 0x1b
+
+// gsm7Extension maps extension table septets (after an escape) to runes
+var gsm7Extension = map[byte]rune{
+	0x0a: '\f',
+	0x14: '^',
+	0x28: '{',
+	0x29: '}',
+	0x2f: '\\',
+	0x3c: '[',
+	0x3d: '~',
+	0x3e: ']',
+	0x40: '|',
+	0x65: '€',
+}
+
+var (
+	gsm7Runes    = []rune(gsm7Basic)
+	gsm7Septets  = make(map[rune]byte, len(gsm7Runes))
+	gsm7Extended = make(map[rune]byte, len(gsm7Extension))
+)
+
+func init() {
+	for i, r := range gsm7Runes {
+		if i != gsm7Escape {
+			gsm7Septets[r] = byte(i)
+		}
+	}
+	for septet, r := range gsm7Extension {
+		gsm7Extended[r] = septet
+	}
+}
+
+// encodeGSM7 converts text to unpacked septets; ok is false when text
+// contains characters outside the GSM alphabet
+func encodeGSM7(text string) (septets []byte, ok bool) {
+	for _, r := range text {
+		if s, found := gsm7Septets[r]; found {
+			septets = append(septets, s)
+		} else if s, found := gsm7Extended[r]; found {
+			septets = append(septets, gsm7Escape, s)
+		} else {
+			return nil, false
+		}
+	}
+	return septets, true
+}
+
+// decodeGSM7 converts unpacked septets to text
+func decodeGSM7(septets []byte) string {
+	var b strings.Builder
+	for i := 0; i < len(septets); i++ {
+		s := septets[i] & 0x7f
+		if s == gsm7Escape && i+1 < len(septets) {
+			i++
+			if r, found := gsm7Extension[septets[i]&0x7f]; found {
+				b.WriteRune(r)
+			} else {
+				// Unknown extensions fall back to the basic table
+				b.WriteRune(gsm7Runes[septets[i]&0x7f])
+			}
+			continue
+		}
+		b.WriteRune(gsm7Runes[s])
+	}
+	return b.String()
+}
+
+// packSeptets packs septets into octets, least significant bit first
+func packSeptets(septets []byte) []byte {
+	packed := make([]byte, (len(septets)*7+7)/8)
+	for i, s := range septets {
+		bit := i * 7
+		packed[bit/8] |= (s & 0x7f) << (bit % 8)
+		if bit%8 > 1 {
+			packed[bit/8+1] |= (s & 0x7f) >> (8 - bit%8)
+		}
+	}
+	return packed
+}
+
+// unpackSeptets extracts count septets from packed octets, skipping
+// fillBits leading bits (used after a user data header)
+func unpackSeptets(packed []byte, count, fillBits int) []byte {
+	septets := make([]byte, 0, count)
+	for i := 0; i < count; i++ {
+		bit := fillBits + i*7
+		if (bit+6)/8 >= len(packed) {
+			break
+		}
+		value := uint16(packed[bit/8])
+		if bit/8+1 < len(packed) {
+			value |= uint16(packed[bit/8+1]) << 8
+		}
+		septets = append(septets, byte(value>>(bit%8))&0x7f)
+	}
+	return septets
+}
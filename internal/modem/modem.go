@@ -0,0 +1,302 @@
+package modem
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SMS modes
+const (
+	ModeText = "text"
+	ModePDU  = "pdu"
+)
+
+// sendTimeout allows for network round trips when submitting an SMS
+const sendTimeout = 60 * time.Second
+
+// Message is a received SMS
+type Message struct {
+	// Index is the storage index, used to delete the message
+	Index int
+	// Status is e.g. "REC UNREAD" or "REC READ"
+	Status    string
+	Sender    string
+	Timestamp time.Time
+	Text      string
+}
+
+// Status is the network state of a modem
+type Status struct {
+	// SignalRSSI is the raw AT+CSQ value (0-31, 99 when unknown)
+	SignalRSSI int
+	// SignalDBm is the received signal strength, 0 when unknown
+	SignalDBm int
+	// Registration is e.g. "home", "roaming", "searching" or "denied"
+	Registration string
+	Operator     string
+}
+
+// pduStatus maps AT+CMGL PDU mode status values to text mode names
+var pduStatus = []string{"REC UNREAD", "REC READ", "STO UNSENT", "STO SENT"}
+
+// registrationStatus maps +CREG/+CEREG status values to names
+var registrationStatus = map[string]string{
+	"0": "not registered",
+	"1": "home",
+	"2": "searching",
+	"3": "denied",
+	"4": "unknown",
+	"5": "roaming",
+}
+
+// SendSMS sends a single-part SMS and returns the message reference
+func SendSMS(ctx context.Context, at *AT, number, text, mode string) (int, error) {
+	var lines []string
+	switch mode {
+	case ModeText, "":
+		if _, err := at.Command(ctx, "AT+CMGF=1", DefaultTimeout); err != nil {
+			return 0, err
+		}
+		if _, err := at.Command(ctx, `AT+CSCS="GSM"`, DefaultTimeout); err != nil {
+			return 0, err
+		}
+		if septets, ok := encodeGSM7(text); !ok {
+			return 0, fmt.Errorf("text mode only supports the GSM alphabet, use PDU mode")
+		} else if len(septets) > maxGSM7Septets {
+			return 0, ErrMessageTooLong
+		}
+
+		var err error
+		lines, err = at.CommandWithBody(ctx, fmt.Sprintf("AT+CMGS=%q", number), []byte(text), sendTimeout)
+		if err != nil {
+			return 0, err
+		}
+	case ModePDU:
+		pdu, length, err := encodeSubmit(number, text)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := at.Command(ctx, "AT+CMGF=0", DefaultTimeout); err != nil {
+			return 0, err
+		}
+		lines, err = at.CommandWithBody(ctx, fmt.Sprintf("AT+CMGS=%d", length), []byte(pdu), sendTimeout)
+		if err != nil {
+			return 0, err
+		}
+	default:
+		return 0, fmt.Errorf("unknown SMS mode %q (text or pdu)", mode)
+	}
+
+	value, ok := field(lines, "+CMGS:")
+	if !ok {
+		return 0, nil
+	}
+	ref, _ := strconv.Atoi(value)
+	return ref, nil
+}
+
+// ReadSMS lists stored messages, optionally only unread ones, and deletes
+// them afterwards when requested
+func ReadSMS(ctx context.Context, at *AT, mode string, unreadOnly, remove bool) ([]Message, error) {
+	var messages []Message
+	var err error
+	switch mode {
+	case ModeText, "":
+		messages, err = readText(ctx, at, unreadOnly)
+	case ModePDU:
+		messages, err = readPDU(ctx, at, unreadOnly)
+	default:
+		return nil, fmt.Errorf("unknown SMS mode %q (text or pdu)", mode)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if remove {
+		for _, msg := range messages {
+			if _, err := at.Command(ctx, fmt.Sprintf("AT+CMGD=%d", msg.Index), DefaultTimeout); err != nil {
+				return messages, fmt.Errorf("failed to delete message %d: %w", msg.Index, err)
+			}
+		}
+	}
+	return messages, nil
+}
+
+func readText(ctx context.Context, at *AT, unreadOnly bool) ([]Message, error) {
+	if _, err := at.Command(ctx, "AT+CMGF=1", DefaultTimeout); err != nil {
+		return nil, err
+	}
+	if _, err := at.Command(ctx, `AT+CSCS="GSM"`, DefaultTimeout); err != nil {
+		return nil, err
+	}
+
+	filter := "ALL"
+	if unreadOnly {
+		filter = "REC UNREAD"
+	}
+	lines, err := at.Command(ctx, fmt.Sprintf("AT+CMGL=%q", filter), sendTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// +CMGL: <index>,<stat>,<oa>,[<alpha>],<scts> followed by the text
+	var messages []Message
+	for i := 0; i < len(lines); i++ {
+		value, ok := strings.CutPrefix(lines[i], "+CMGL:")
+		if !ok {
+			continue
+		}
+		params := splitParams(value)
+		if len(params) < 3 {
+			continue
+		}
+
+		msg := Message{Status: params[1], Sender: params[2]}
+		msg.Index, _ = strconv.Atoi(params[0])
+		if len(params) >= 5 {
+			msg.Timestamp = parseTextTimestamp(params[4])
+		}
+
+		var text []string
+		for i+1 < len(lines) && !strings.HasPrefix(lines[i+1], "+CMGL:") {
+			i++
+			text = append(text, lines[i])
+		}
+		msg.Text = strings.Join(text, "\n")
+		messages = append(messages, msg)
+	}
+	return messages, nil
+}
+
+func readPDU(ctx context.Context, at *AT, unreadOnly bool) ([]Message, error) {
+	if _, err := at.Command(ctx, "AT+CMGF=0", DefaultTimeout); err != nil {
+		return nil, err
+	}
+
+	filter := 4
+	if unreadOnly {
+		filter = 0
+	}
+	lines, err := at.Command(ctx, fmt.Sprintf("AT+CMGL=%d", filter), sendTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// +CMGL: <index>,<stat>,[<alpha>],<length> followed by the PDU
+	var messages []Message
+	for i := 0; i+1 < len(lines); i++ {
+		value, ok := strings.CutPrefix(lines[i], "+CMGL:")
+		if !ok {
+			continue
+		}
+		params := splitParams(value)
+		i++
+
+		msg, err := decodeDeliver(lines[i])
+		if err != nil {
+			// Skip stored outgoing messages and anything undecodable
+			continue
+		}
+		msg.Index, _ = strconv.Atoi(params[0])
+		if len(params) > 1 {
+			if stat, err := strconv.Atoi(params[1]); err == nil && stat >= 0 && stat < len(pduStatus) {
+				msg.Status = pduStatus[stat]
+			}
+		}
+		messages = append(messages, msg)
+	}
+	return messages, nil
+}
+
+// parseTextTimestamp parses a text mode time stamp, "yy/MM/dd,hh:mm:ss±zz"
+// with the zone in quarter hours
+func parseTextTimestamp(s string) time.Time {
+	if len(s) < 17 {
+		return time.Time{}
+	}
+	t, err := time.Parse("06/01/02,15:04:05", s[:17])
+	if err != nil {
+		return time.Time{}
+	}
+	if len(s) > 18 {
+		if quarters, err := strconv.Atoi(s[17:]); err == nil {
+			zone := time.FixedZone("", quarters*15*60)
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, zone)
+		}
+	}
+	return t
+}
+
+// QueryStatus reads signal strength, network registration and operator
+func QueryStatus(ctx context.Context, at *AT) (Status, error) {
+	status := Status{SignalRSSI: 99, Registration: "unknown"}
+
+	lines, err := at.Command(ctx, "AT+CSQ", DefaultTimeout)
+	if err != nil {
+		return status, err
+	}
+	if value, ok := field(lines, "+CSQ:"); ok {
+		if rssi, err := strconv.Atoi(splitParams(value)[0]); err == nil {
+			status.SignalRSSI = rssi
+			if rssi >= 0 && rssi <= 31 {
+				status.SignalDBm = -113 + 2*rssi
+			}
+		}
+	}
+
+	// Prefer LTE (EPS) registration, falling back to circuit switched
+	for _, cmd := range []string{"AT+CEREG?", "AT+CREG?"} {
+		lines, err := at.Command(ctx, cmd, DefaultTimeout)
+		if err != nil {
+			continue
+		}
+		value, ok := field(lines, strings.TrimSuffix(strings.TrimPrefix(cmd, "AT"), "?")+":")
+		if !ok {
+			continue
+		}
+		params := splitParams(value)
+		if len(params) < 2 {
+			continue
+		}
+		if name, ok := registrationStatus[params[1]]; ok {
+			status.Registration = name
+			if name == "home" || name == "roaming" {
+				break
+			}
+		}
+	}
+
+	lines, err = at.Command(ctx, "AT+COPS?", DefaultTimeout)
+	if err == nil {
+		if value, ok := field(lines, "+COPS:"); ok {
+			if params := splitParams(value); len(params) >= 3 {
+				status.Operator = params[2]
+			}
+		}
+	}
+
+	return status, nil
+}
+
+// PreparePPP defines the packet data context with the given APN and checks
+// that the modem is registered, so pppd can dial with DialString
+func PreparePPP(ctx context.Context, at *AT, apn string) error {
+	status, err := QueryStatus(ctx, at)
+	if err != nil {
+		return err
+	}
+	if status.Registration != "home" && status.Registration != "roaming" {
+		return fmt.Errorf("modem is not registered on a network (%s)", status.Registration)
+	}
+
+	if _, err := at.Command(ctx, fmt.Sprintf(`AT+CGDCONT=1,"IP",%q`, apn), DefaultTimeout); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DialString is the dial command for the context defined by PreparePPP
+const DialString = "ATD*99***1#"
@@ -0,0 +1,245 @@
+package modem
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// Data coding schemes
+const (
+	dcsGSM7 = 0x00
+	dcsUCS2 = 0x08
+)
+
+// Single-part message limits
+const (
+	maxGSM7Septets = 160
+	maxUserData    = 140
+)
+
+// ErrMessageTooLong is returned for text that does not fit one SMS
+var ErrMessageTooLong = errors.New("message does not fit in a single SMS")
+
+// ErrInvalidPDU is returned when a received PDU cannot be decoded
+var ErrInvalidPDU = errors.New("invalid SMS PDU")
+
+// encodeSubmit builds an SMS-SUBMIT PDU for AT+CMGS in PDU mode, using the
+// SMSC stored on the SIM. It returns the hex PDU and the TPDU length.
+func encodeSubmit(number, text string) (string, int, error) {
+	digits, numberType, err := encodeNumber(number)
+	if err != nil {
+		return "", 0, err
+	}
+
+	dcs := byte(dcsGSM7)
+	var userData []byte
+	var userDataLen int
+	if septets, ok := encodeGSM7(text); ok {
+		if len(septets) > maxGSM7Septets {
+			return "", 0, ErrMessageTooLong
+		}
+		userData = packSeptets(septets)
+		userDataLen = len(septets)
+	} else {
+		dcs = dcsUCS2
+		for _, unit := range utf16.Encode([]rune(text)) {
+			userData = append(userData, byte(unit>>8), byte(unit))
+		}
+		if len(userData) > maxUserData {
+			return "", 0, ErrMessageTooLong
+		}
+		userDataLen = len(userData)
+	}
+
+	tpdu := []byte{
+		0x01, // SMS-SUBMIT, no validity period
+		0x00, // message reference assigned by the modem
+		byte(len(strings.TrimPrefix(number, "+"))),
+		numberType,
+	}
+	tpdu = append(tpdu, digits...)
+	tpdu = append(tpdu, 0x00, dcs, byte(userDataLen))
+	tpdu = append(tpdu, userData...)
+
+	// Leading 00: no SMSC address, use the one stored on the SIM
+	return "00" + strings.ToUpper(hex.EncodeToString(tpdu)), len(tpdu), nil
+}
+
+// encodeNumber encodes a phone number as swapped semi-octets
+func encodeNumber(number string) ([]byte, byte, error) {
+	numberType := byte(0x81) // unknown/national
+	if strings.HasPrefix(number, "+") {
+		numberType = 0x91 // international
+		number = number[1:]
+	}
+	if number == "" {
+		return nil, 0, fmt.Errorf("phone number is required")
+	}
+
+	digits := make([]byte, 0, (len(number)+1)/2)
+	for i := 0; i < len(number); i += 2 {
+		lo, ok := semiOctet(number[i])
+		if !ok {
+			return nil, 0, fmt.Errorf("invalid phone number %q", number)
+		}
+		hi := byte(0x0f)
+		if i+1 < len(number) {
+			if hi, ok = semiOctet(number[i+1]); !ok {
+				return nil, 0, fmt.Errorf("invalid phone number %q", number)
+			}
+		}
+		digits = append(digits, hi<<4|lo)
+	}
+	return digits, numberType, nil
+}
+
+func semiOctet(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c == '*':
+		return 0x0a, true
+	case c == '#':
+		return 0x0b, true
+	}
+	return 0, false
+}
+
+// decodeDeliver decodes an SMS-DELIVER PDU as listed by AT+CMGL in PDU mode
+func decodeDeliver(pduHex string) (Message, error) {
+	var msg Message
+
+	pdu, err := hex.DecodeString(strings.TrimSpace(pduHex))
+	if err != nil {
+		return msg, fmt.Errorf("%w: %v", ErrInvalidPDU, err)
+	}
+	r := &pduReader{data: pdu}
+
+	// Skip the SMSC address
+	r.skip(int(r.byte()))
+
+	firstOctet := r.byte()
+	if firstOctet&0x03 != 0x00 {
+		return msg, fmt.Errorf("%w: not an SMS-DELIVER (type %d)", ErrInvalidPDU, firstOctet&0x03)
+	}
+	hasHeader := firstOctet&0x40 != 0
+
+	addrLen := int(r.byte())
+	addrType := r.byte()
+	addr := r.bytes((addrLen + 1) / 2)
+	msg.Sender = decodeAddress(addr, addrLen, addrType)
+
+	r.byte() // protocol identifier
+	dcs := r.byte()
+	msg.Timestamp = decodeTimestamp(r.bytes(7))
+	userDataLen := int(r.byte())
+	userData := r.rest()
+	if r.err != nil {
+		return msg, r.err
+	}
+
+	headerLen := 0
+	if hasHeader && len(userData) > 0 {
+		headerLen = int(userData[0]) + 1
+	}
+
+	switch dcs & 0x0c {
+	case dcsUCS2:
+		if headerLen > len(userData) {
+			return msg, fmt.Errorf("%w: truncated user data header", ErrInvalidPDU)
+		}
+		body := userData[headerLen:min(userDataLen, len(userData))]
+		units := make([]uint16, 0, len(body)/2)
+		for i := 0; i+1 < len(body); i += 2 {
+			units = append(units, uint16(body[i])<<8|uint16(body[i+1]))
+		}
+		msg.Text = string(utf16.Decode(units))
+	case 0x04: // 8-bit data
+		msg.Text = string(userData[min(headerLen, len(userData)):min(userDataLen, len(userData))])
+	default:
+		// Septets after the header start on a septet boundary
+		headerSeptets := (headerLen*8 + 6) / 7
+		septets := unpackSeptets(userData, userDataLen, headerSeptets*7)
+		if len(septets) > userDataLen-headerSeptets {
+			septets = septets[:max(userDataLen-headerSeptets, 0)]
+		}
+		msg.Text = decodeGSM7(septets)
+	}
+
+	return msg, nil
+}
+
+// decodeAddress decodes a sender address of addrLen semi-octets
+func decodeAddress(addr []byte, addrLen int, addrType byte) string {
+	if (addrType>>4)&0x07 == 0x05 {
+		// Alphanumeric sender, GSM 7-bit packed
+		return decodeGSM7(unpackSeptets(addr, addrLen*4/7, 0))
+	}
+
+	var b strings.Builder
+	if (addrType>>4)&0x07 == 0x01 {
+		b.WriteByte('+')
+	}
+	for i := 0; i < addrLen && i/2 < len(addr); i++ {
+		nibble := addr[i/2] >> (4 * (i % 2)) & 0x0f
+		b.WriteByte("0123456789*#abc"[min(int(nibble), 14)])
+	}
+	return b.String()
+}
+
+// decodeTimestamp decodes a service centre time stamp
+func decodeTimestamp(ts []byte) time.Time {
+	if len(ts) < 7 {
+		return time.Time{}
+	}
+	field := func(b byte) int {
+		return int(b&0x0f)*10 + int(b>>4)
+	}
+
+	quarters := int(ts[6]&0x07)*10 + int(ts[6]>>4)
+	if ts[6]&0x08 != 0 {
+		quarters = -quarters
+	}
+	zone := time.FixedZone("", quarters*15*60)
+
+	return time.Date(2000+field(ts[0]), time.Month(field(ts[1])), field(ts[2]),
+		field(ts[3]), field(ts[4]), field(ts[5]), 0, zone)
+}
+
+// pduReader reads PDU fields, recording the first overrun
+type pduReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *pduReader) bytes(n int) []byte {
+	if r.err != nil || r.pos+n > len(r.data) {
+		r.err = fmt.Errorf("%w: truncated", ErrInvalidPDU)
+		return make([]byte, n)
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+func (r *pduReader) byte() byte {
+	return r.bytes(1)[0]
+}
+
+func (r *pduReader) skip(n int) {
+	r.bytes(n)
+}
+
+func (r *pduReader) rest() []byte {
+	if r.err != nil {
+		return nil
+	}
+	b := r.data[r.pos:]
+	r.pos = len(r.data)
+	return b
+}
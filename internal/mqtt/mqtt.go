@@ -0,0 +1,73 @@
+// Package mqtt publishes agent data to an MQTT broker.
+package mqtt
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/log"
+	paho "github.com/eclipse/paho.mqtt.golang"
+)
+
+// publishTimeout bounds how long a publish waits for the broker
+const publishTimeout = 5 * time.Second
+
+// Options configures the broker connection
+type Options struct {
+	// Broker is the broker URL, e.g. tcp://localhost:1883 or ssl://host:8883
+	Broker   string
+	ClientID string
+	Username string
+	Password string
+	QoS      byte
+	Retain   bool
+}
+
+// Publisher publishes messages, reconnecting in the background when the
+// broker goes away
+type Publisher struct {
+	client paho.Client
+	opts   Options
+}
+
+// Connect creates a publisher. The first connection attempt runs in the
+// background, so the agent starts even when the broker is unreachable.
+func Connect(opts Options, logger *log.Logger) *Publisher {
+	clientOpts := paho.NewClientOptions().
+		AddBroker(opts.Broker).
+		SetClientID(opts.ClientID).
+		SetUsername(opts.Username).
+		SetPassword(opts.Password).
+		SetAutoReconnect(true).
+		SetConnectRetry(true).
+		SetConnectRetryInterval(10 * time.Second).
+		SetOnConnectHandler(func(paho.Client) {
+			logger.Info("connected to MQTT broker", "broker", opts.Broker)
+		}).
+		SetConnectionLostHandler(func(_ paho.Client, err error) {
+			logger.Warn("lost connection to MQTT broker", "broker", opts.Broker, "error", err)
+		})
+
+	client := paho.NewClient(clientOpts)
+	client.Connect()
+
+	return &Publisher{client: client, opts: opts}
+}
+
+// Publish sends a message to topic
+func (p *Publisher) Publish(topic string, payload []byte) error {
+	if !p.client.IsConnectionOpen() {
+		return fmt.Errorf("not connected to MQTT broker %s", p.opts.Broker)
+	}
+
+	token := p.client.Publish(topic, p.opts.QoS, p.opts.Retain, payload)
+	if !token.WaitTimeout(publishTimeout) {
+		return fmt.Errorf("publish to %s timed out", topic)
+	}
+	return token.Error()
+}
+
+// Close disconnects from the broker
+func (p *Publisher) Close() {
+	p.client.Disconnect(250)
+}
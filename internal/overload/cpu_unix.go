@@ -0,0 +1,17 @@
+//go:build unix
+
+package overload
+
+import (
+	"syscall"
+	"time"
+)
+
+// ProcessCPUTime returns the CPU time used by the agent so far
+func ProcessCPUTime() (time.Duration, bool) {
+	var usage syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, &usage); err != nil {
+		return 0, false
+	}
+	return time.Duration(usage.Utime.Nano() + usage.Stime.Nano()), true
+}
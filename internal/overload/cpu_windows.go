@@ -0,0 +1,21 @@
+//go:build windows
+
+package overload
+
+import (
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+// ProcessCPUTime returns the CPU time used by the agent so far
+func ProcessCPUTime() (time.Duration, bool) {
+	var creation, exit, kernel, user windows.Filetime
+	if err := windows.GetProcessTimes(windows.CurrentProcess(), &creation, &exit, &kernel, &user); err != nil {
+		return 0, false
+	}
+	// Filetime counts 100 ns intervals
+	ticks := (int64(kernel.HighDateTime)<<32 | int64(kernel.LowDateTime)) +
+		(int64(user.HighDateTime)<<32 | int64(user.LowDateTime))
+	return time.Duration(ticks * 100), true
+}
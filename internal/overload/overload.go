@@ -0,0 +1,271 @@
+// Package overload protects the agent when it runs short of CPU time or
+// memory: it rejects new work and sheds the lowest-priority streams until
+// the load is back under the configured thresholds.
+package overload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+// recoverRatio is the share of every threshold the load must fall below
+// before the agent accepts new work again, so it does not flap around a
+// threshold
+const recoverRatio = 0.9
+
+// Errors reported for rejected and shed work
+var (
+	ErrOverloaded = errors.New("agent overloaded")
+	ErrShed       = errors.New("stream shed: agent overloaded")
+)
+
+// Thresholds above which the agent is overloaded. Zero disables a check.
+type Thresholds struct {
+	// CPUPercent is the agent's CPU use as a share of all cores
+	CPUPercent float64
+	// MemoryBytes is memory obtained from the OS and not returned
+	MemoryBytes uint64
+	// QueuedBytes is data waiting for stream consumers
+	QueuedBytes int64
+}
+
+// Options configure a Guard
+type Options struct {
+	Thresholds Thresholds
+	// Interval between load samples; one stream is shed per interval while
+	// overloaded
+	Interval time.Duration
+	// Priorities by client ID or IP address; higher values are shed last
+	Priorities      map[string]int
+	DefaultPriority int
+	// ExemptPriority and above are never rejected or shed
+	ExemptPriority int
+}
+
+// Sample is a load measurement
+type Sample struct {
+	Time        time.Time
+	CPUPercent  float64
+	MemoryBytes uint64
+	QueuedBytes int64
+}
+
+// Status is the guard's current state
+type Status struct {
+	Overloaded bool
+	// Reasons lists the thresholds exceeded
+	Reasons  []string
+	Last     Sample
+	Streams  int
+	Rejected uint64
+	Shed     uint64
+}
+
+// stream is a tracked stream that can be shed
+type stream struct {
+	id       uint64
+	client   string
+	method   string
+	priority int
+	cancel   context.CancelCauseFunc
+}
+
+// Guard samples the agent's load, admits or rejects new work and sheds
+// streams while overloaded
+type Guard struct {
+	opts   Options
+	queued func() int64
+	logger *log.Logger
+
+	mu         sync.Mutex
+	overloaded bool
+	reasons    []string
+	last       Sample
+	streams    map[uint64]*stream
+	nextID     uint64
+	rejected   uint64
+	shed       uint64
+
+	// CPU accounting between samples
+	lastCPU  time.Duration
+	lastTime time.Time
+}
+
+// NewGuard creates a guard. queued reports the bytes waiting for stream
+// consumers and may be nil.
+func NewGuard(opts Options, queued func() int64, logger *log.Logger) *Guard {
+	if opts.Interval <= 0 {
+		opts.Interval = 2 * time.Second
+	}
+	return &Guard{
+		opts:    opts,
+		queued:  queued,
+		logger:  logger,
+		streams: make(map[uint64]*stream),
+	}
+}
+
+// Run samples the load until ctx is cancelled
+func (g *Guard) Run(ctx context.Context) {
+	g.lastCPU, _ = ProcessCPUTime()
+	g.lastTime = time.Now()
+
+	ticker := time.NewTicker(g.opts.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			g.update(g.sample())
+		}
+	}
+}
+
+// sample measures the current load
+func (g *Guard) sample() Sample {
+	now := time.Now()
+	s := Sample{Time: now}
+
+	if cpu, ok := ProcessCPUTime(); ok {
+		if elapsed := now.Sub(g.lastTime); elapsed > 0 {
+			s.CPUPercent = 100 * float64(cpu-g.lastCPU) / (float64(elapsed) * float64(runtime.NumCPU()))
+		}
+		g.lastCPU = cpu
+	}
+	g.lastTime = now
+
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	s.MemoryBytes = mem.Sys - mem.HeapReleased
+
+	if g.queued != nil {
+		s.QueuedBytes = g.queued()
+	}
+	return s
+}
+
+// exceeded lists the thresholds a sample is over, scaled by ratio
+func (t Thresholds) exceeded(s Sample, ratio float64) []string {
+	var reasons []string
+	if t.CPUPercent > 0 && s.CPUPercent > t.CPUPercent*ratio {
+		reasons = append(reasons, fmt.Sprintf("cpu %.0f%% > %.0f%%", s.CPUPercent, t.CPUPercent*ratio))
+	}
+	if t.MemoryBytes > 0 && float64(s.MemoryBytes) > float64(t.MemoryBytes)*ratio {
+		reasons = append(reasons, fmt.Sprintf("memory %d MB > %.0f MB", s.MemoryBytes>>20, float64(t.MemoryBytes)*ratio/(1<<20)))
+	}
+	if t.QueuedBytes > 0 && float64(s.QueuedBytes) > float64(t.QueuedBytes)*ratio {
+		reasons = append(reasons, fmt.Sprintf("queued %d KB > %.0f KB", s.QueuedBytes>>10, float64(t.QueuedBytes)*ratio/(1<<10)))
+	}
+	return reasons
+}
+
+// update applies a sample, entering or leaving the overloaded state and
+// shedding one stream while overloaded
+func (g *Guard) update(s Sample) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.last = s
+
+	if reasons := g.opts.Thresholds.exceeded(s, 1); len(reasons) > 0 {
+		if !g.overloaded {
+			g.logger.Warn("agent overloaded, rejecting new work", "reasons", strings.Join(reasons, ", "))
+		}
+		g.overloaded = true
+		g.reasons = reasons
+	} else if g.overloaded && len(g.opts.Thresholds.exceeded(s, recoverRatio)) == 0 {
+		g.logger.Info("agent load recovered, accepting new work")
+		g.overloaded = false
+		g.reasons = nil
+	}
+
+	if g.overloaded {
+		g.shedOne()
+	}
+}
+
+// shedOne cancels the lowest-priority stream, newest first among equals
+// (lock held)
+func (g *Guard) shedOne() {
+	var victim *stream
+	for _, st := range g.streams {
+		if st.priority >= g.opts.ExemptPriority {
+			continue
+		}
+		if victim == nil || st.priority < victim.priority || (st.priority == victim.priority && st.id > victim.id) {
+			victim = st
+		}
+	}
+	if victim == nil {
+		return
+	}
+
+	delete(g.streams, victim.id)
+	g.shed++
+	g.logger.Warn("shedding stream", "client", victim.client, "method", victim.method, "priority", victim.priority)
+	victim.cancel(ErrShed)
+}
+
+// Priority returns the priority of the first of the client's identities
+// (client ID, IP address) that has one assigned
+func (g *Guard) Priority(identities ...string) int {
+	for _, id := range identities {
+		if p, ok := g.opts.Priorities[id]; ok && id != "" {
+			return p
+		}
+	}
+	return g.opts.DefaultPriority
+}
+
+// Admit reports whether new work at a priority may start, returning an
+// error wrapping ErrOverloaded with the reasons when it may not
+func (g *Guard) Admit(priority int) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.overloaded || priority >= g.opts.ExemptPriority {
+		return nil
+	}
+	g.rejected++
+	return fmt.Errorf("%w: %s", ErrOverloaded, strings.Join(g.reasons, ", "))
+}
+
+// Track registers a stream for shedding. The returned context is cancelled
+// with ErrShed as its cause when the stream is shed; call done when the
+// stream ends.
+func (g *Guard) Track(ctx context.Context, client, method string, priority int) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	g.mu.Lock()
+	g.nextID++
+	id := g.nextID
+	g.streams[id] = &stream{id: id, client: client, method: method, priority: priority, cancel: cancel}
+	g.mu.Unlock()
+
+	return ctx, func() {
+		g.mu.Lock()
+		delete(g.streams, id)
+		g.mu.Unlock()
+		cancel(nil)
+	}
+}
+
+// Status returns the guard's current state
+func (g *Guard) Status() Status {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return Status{
+		Overloaded: g.overloaded,
+		Reasons:    append([]string(nil), g.reasons...),
+		Last:       g.last,
+		Streams:    len(g.streams),
+		Rejected:   g.rejected,
+		Shed:       g.shed,
+	}
+}
@@ -0,0 +1,158 @@
+// Package paste sends text to a device one line at a time, pacing the
+// lines by waiting for a prompt, the line's echo or a fixed delay, as when
+// loading a configuration into network gear or G-code into a CNC
+// controller that would lose input sent faster than it is processed.
+package paste
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"time"
+)
+
+const (
+	// DefaultLineEnding ends each line sent, as the Enter key does
+	DefaultLineEnding = "\r"
+
+	// DefaultTimeout bounds waiting for a line's prompt or echo
+	DefaultTimeout = 10 * time.Second
+
+	// maxResponseSize caps the response captured for a line
+	maxResponseSize = 64 * 1024
+)
+
+// ErrTimeout is returned when a line's prompt or echo does not arrive
+var ErrTimeout = errors.New("timed out")
+
+// Options control the pacing. After a line is sent, Line waits for its
+// echo when Echo is set, then for Prompt when set, then for Delay.
+type Options struct {
+	// LineEnding replaces the line endings of the text (default
+	// DefaultLineEnding)
+	LineEnding []byte
+	Prompt     *regexp.Regexp
+	Echo       bool
+	Delay      time.Duration
+	// Timeout bounds waiting for the echo and prompt (default
+	// DefaultTimeout)
+	Timeout time.Duration
+}
+
+// Validate checks that the options pace the lines
+func (o Options) Validate() error {
+	if o.Prompt == nil && !o.Echo && o.Delay <= 0 {
+		return errors.New("a prompt, echo or delay is required to pace the lines")
+	}
+	return nil
+}
+
+// Lines splits text at LF, CRLF or CR into lines without their endings,
+// dropping blank lines when skipBlank is set
+func Lines(text []byte, skipBlank bool) [][]byte {
+	text = bytes.ReplaceAll(text, []byte("\r\n"), []byte("\n"))
+	text = bytes.ReplaceAll(text, []byte("\r"), []byte("\n"))
+	text = bytes.TrimSuffix(text, []byte("\n"))
+	if len(text) == 0 {
+		return nil
+	}
+
+	var lines [][]byte
+	for _, line := range bytes.Split(text, []byte("\n")) {
+		if skipBlank && len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Discard drops input waiting on rw, so a prompt left over from before is
+// not taken for the first line's. Reads on rw must return (0, nil) after a
+// short timeout without data.
+func Discard(rw io.ReadWriter) error {
+	buffer := make([]byte, 512)
+	for {
+		n, err := rw.Read(buffer)
+		if err != nil || n == 0 {
+			return err
+		}
+	}
+}
+
+// Line sends one line and waits as opts ask, returning what the device sent
+// back meanwhile. Reads on rw must return (0, nil) after a short timeout
+// without data.
+func Line(ctx context.Context, rw io.ReadWriter, line []byte, opts Options) ([]byte, error) {
+	ending := opts.LineEnding
+	if ending == nil {
+		ending = []byte(DefaultLineEnding)
+	}
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	if _, err := rw.Write(append(append([]byte(nil), line...), ending...)); err != nil {
+		return nil, err
+	}
+
+	var response []byte
+	buffer := make([]byte, 512)
+	read := func() error {
+		n, err := rw.Read(buffer)
+		if len(response) < maxResponseSize {
+			response = append(response, buffer[:n]...)
+		}
+		return err
+	}
+
+	deadline := time.Now().Add(timeout)
+	waitFor := func(what string, done func() bool) error {
+		for !done() {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("%w: no %s within %s", ErrTimeout, what, timeout)
+			}
+			if err := read(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// The prompt is looked for after the echo
+	promptFrom := 0
+	if opts.Echo && len(line) > 0 {
+		err := waitFor("echo", func() bool {
+			i := bytes.Index(response, line)
+			if i >= 0 {
+				promptFrom = i + len(line)
+			}
+			return i >= 0
+		})
+		if err != nil {
+			return response, err
+		}
+	}
+	if opts.Prompt != nil {
+		if err := waitFor("prompt", func() bool { return opts.Prompt.Match(response[promptFrom:]) }); err != nil {
+			return response, err
+		}
+	}
+
+	for end := time.Now().Add(opts.Delay); time.Now().Before(end); {
+		if err := ctx.Err(); err != nil {
+			return response, err
+		}
+		if err := read(); err != nil {
+			return response, err
+		}
+	}
+	return response, nil
+}
@@ -0,0 +1,275 @@
+package poller
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expression is a compiled arithmetic expression over a parsed value, such
+// as "value*0.1 - 40". It supports + - * / % ^, parentheses, the variable
+// value, the constants pi and e, and the functions abs, sqrt, exp, ln, log10,
+// floor, ceil, round, min, max and pow.
+type Expression struct {
+	source string
+	eval   func(value float64) float64
+}
+
+// exprFuncs are the functions available to expressions, by argument count
+var exprFuncs = map[string]struct {
+	args int
+	fn   func(args []float64) float64
+}{
+	"abs":   {1, func(a []float64) float64 { return math.Abs(a[0]) }},
+	"sqrt":  {1, func(a []float64) float64 { return math.Sqrt(a[0]) }},
+	"exp":   {1, func(a []float64) float64 { return math.Exp(a[0]) }},
+	"ln":    {1, func(a []float64) float64 { return math.Log(a[0]) }},
+	"log10": {1, func(a []float64) float64 { return math.Log10(a[0]) }},
+	"floor": {1, func(a []float64) float64 { return math.Floor(a[0]) }},
+	"ceil":  {1, func(a []float64) float64 { return math.Ceil(a[0]) }},
+	"round": {1, func(a []float64) float64 { return math.Round(a[0]) }},
+	"min":   {2, func(a []float64) float64 { return math.Min(a[0], a[1]) }},
+	"max":   {2, func(a []float64) float64 { return math.Max(a[0], a[1]) }},
+	"pow":   {2, func(a []float64) float64 { return math.Pow(a[0], a[1]) }},
+}
+
+// ParseExpression compiles an expression
+func ParseExpression(source string) (*Expression, error) {
+	p := &exprParser{src: source}
+	p.next()
+	eval, err := p.parseSum()
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", source, err)
+	}
+	if p.tok != "" {
+		return nil, fmt.Errorf("invalid expression %q: unexpected %q", source, p.tok)
+	}
+	return &Expression{source: source, eval: eval}, nil
+}
+
+// Eval evaluates the expression for a value
+func (e *Expression) Eval(value float64) float64 {
+	return e.eval(value)
+}
+
+// String returns the expression source
+func (e *Expression) String() string {
+	return e.source
+}
+
+type evalFunc = func(value float64) float64
+
+// exprParser is a recursive descent parser over single-token lookahead
+type exprParser struct {
+	src string
+	pos int
+	tok string
+}
+
+// next advances to the next token; tok is "" at the end of input
+func (p *exprParser) next() {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+	if p.pos >= len(p.src) {
+		p.tok = ""
+		return
+	}
+
+	start := p.pos
+	c := p.src[p.pos]
+	switch {
+	case c >= '0' && c <= '9' || c == '.':
+		for p.pos < len(p.src) && (isDigit(p.src[p.pos]) || p.src[p.pos] == '.') {
+			p.pos++
+		}
+		// Exponent, e.g. 1.5e-3
+		if p.pos < len(p.src) && (p.src[p.pos] == 'e' || p.src[p.pos] == 'E') {
+			end := p.pos + 1
+			if end < len(p.src) && (p.src[end] == '+' || p.src[end] == '-') {
+				end++
+			}
+			if end < len(p.src) && isDigit(p.src[end]) {
+				for end < len(p.src) && isDigit(p.src[end]) {
+					end++
+				}
+				p.pos = end
+			}
+		}
+	case c == '_' || unicode.IsLetter(rune(c)):
+		for p.pos < len(p.src) && (p.src[p.pos] == '_' || isDigit(p.src[p.pos]) || unicode.IsLetter(rune(p.src[p.pos]))) {
+			p.pos++
+		}
+	default:
+		p.pos++
+	}
+	p.tok = p.src[start:p.pos]
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// parseSum parses + and -
+func (p *exprParser) parseSum() (evalFunc, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok == "+" || p.tok == "-" {
+		op := p.tok
+		p.next()
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		if op == "+" {
+			left = func(v float64) float64 { return l(v) + right(v) }
+		} else {
+			left = func(v float64) float64 { return l(v) - right(v) }
+		}
+	}
+	return left, nil
+}
+
+// parseProduct parses *, / and %
+func (p *exprParser) parseProduct() (evalFunc, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok == "*" || p.tok == "/" || p.tok == "%" {
+		op := p.tok
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		switch op {
+		case "*":
+			left = func(v float64) float64 { return l(v) * right(v) }
+		case "/":
+			left = func(v float64) float64 { return l(v) / right(v) }
+		default:
+			left = func(v float64) float64 { return math.Mod(l(v), right(v)) }
+		}
+	}
+	return left, nil
+}
+
+// parseUnary parses a leading minus or plus
+func (p *exprParser) parseUnary() (evalFunc, error) {
+	switch p.tok {
+	case "-":
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return func(v float64) float64 { return -operand(v) }, nil
+	case "+":
+		p.next()
+		return p.parseUnary()
+	}
+	return p.parsePower()
+}
+
+// parsePower parses the right-associative ^
+func (p *exprParser) parsePower() (evalFunc, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok != "^" {
+		return base, nil
+	}
+	p.next()
+	exponent, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return func(v float64) float64 { return math.Pow(base(v), exponent(v)) }, nil
+}
+
+// parsePrimary parses numbers, names, calls and parentheses
+func (p *exprParser) parsePrimary() (evalFunc, error) {
+	tok := p.tok
+	switch {
+	case tok == "":
+		return nil, fmt.Errorf("unexpected end of expression")
+
+	case tok == "(":
+		p.next()
+		inner, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok != ")" {
+			return nil, fmt.Errorf("missing )")
+		}
+		p.next()
+		return inner, nil
+
+	case isDigit(tok[0]) || tok[0] == '.':
+		n, err := strconv.ParseFloat(tok, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", tok)
+		}
+		p.next()
+		return func(float64) float64 { return n }, nil
+
+	case tok[0] != '_' && !unicode.IsLetter(rune(tok[0])):
+		return nil, fmt.Errorf("unexpected %q", tok)
+	}
+
+	name := strings.ToLower(tok)
+	p.next()
+	switch name {
+	case "value":
+		return func(v float64) float64 { return v }, nil
+	case "pi":
+		return func(float64) float64 { return math.Pi }, nil
+	case "e":
+		return func(float64) float64 { return math.E }, nil
+	}
+
+	f, ok := exprFuncs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown name %q", tok)
+	}
+	if p.tok != "(" {
+		return nil, fmt.Errorf("%s needs arguments", name)
+	}
+	p.next()
+
+	var args []evalFunc
+	for p.tok != ")" {
+		if len(args) > 0 {
+			if p.tok != "," {
+				return nil, fmt.Errorf("expected , or ) in call to %s", name)
+			}
+			p.next()
+		}
+		arg, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	p.next()
+
+	if len(args) != f.args {
+		return nil, fmt.Errorf("%s takes %d argument(s), got %d", name, f.args, len(args))
+	}
+	return func(v float64) float64 {
+		values := make([]float64, len(args))
+		for i, arg := range args {
+			values[i] = arg(v)
+		}
+		return f.fn(values)
+	}, nil
+}
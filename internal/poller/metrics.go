@@ -0,0 +1,59 @@
+package poller
+
+import "github.com/Shoaibashk/SerialLink/internal/metrics"
+
+// Collect implements metrics.Collector
+func (e *Engine) Collect() []metrics.Family {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	values := metrics.Family{
+		Name: "seriallink_poll_value",
+		Help: "Most recent value parsed by a poller.",
+		Type: metrics.TypeGauge,
+	}
+	polls := metrics.Family{
+		Name: "seriallink_polls_total",
+		Help: "Polls run.",
+		Type: metrics.TypeCounter,
+	}
+	failures := metrics.Family{
+		Name: "seriallink_poll_errors_total",
+		Help: "Polls that failed or returned an unparseable response.",
+		Type: metrics.TypeCounter,
+	}
+	lastOK := metrics.Family{
+		Name: "seriallink_poll_last_success_timestamp_seconds",
+		Help: "Unix time of the last successful poll.",
+		Type: metrics.TypeGauge,
+	}
+
+	for _, def := range e.defs {
+		labels := []metrics.Label{{Name: "poller", Value: def.Name}, {Name: "port", Value: def.PortName}}
+		stats := e.stats[def.Name]
+
+		polls.Samples = append(polls.Samples, metrics.Sample{Labels: labels, Value: float64(stats.polls)})
+		failures.Samples = append(failures.Samples, metrics.Sample{Labels: labels, Value: float64(stats.failures)})
+		if !stats.lastOK.IsZero() {
+			lastOK.Samples = append(lastOK.Samples, metrics.Sample{
+				Labels: labels,
+				Value:  float64(stats.lastOK.UnixNano()) / 1e9,
+			})
+		}
+
+		// Values stay at their last good reading while polls fail; the
+		// error counter and timestamp show staleness
+		if sample, ok := e.lastGood[def.Name]; ok {
+			for _, v := range sample.Values {
+				values.Samples = append(values.Samples, metrics.Sample{
+					Labels: append(labels[:2:2],
+						metrics.Label{Name: "field", Value: v.Name},
+						metrics.Label{Name: "unit", Value: v.Unit}),
+					Value: v.Value,
+				})
+			}
+		}
+	}
+
+	return []metrics.Family{values, polls, failures, lastOK}
+}
@@ -0,0 +1,256 @@
+package poller
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Shoaibashk/SerialLink/internal/ups"
+)
+
+// Parser types
+const (
+	ParserRegex = "regex"
+	ParserBytes = "bytes"
+	// ParserMegatec reads the answer of a UPS to the Megatec status query
+	// Q1, with values named as in NUT (input.voltage, ups.status.OB, ...)
+	ParserMegatec = "megatec"
+)
+
+// Value is one parsed value of a poll
+type Value struct {
+	Name  string
+	Value float64
+	Unit  string
+}
+
+// Field maps part of a response to a named value
+type Field struct {
+	Name string
+	// Group is the regex capture group, by name or number (regex parser)
+	Group string
+	// Offset is the byte offset of the field (bytes parser)
+	Offset int
+	// Type is the binary type: u8, i8, u16, i16, u32, i32, u64, i64, f32 or
+	// f64 (bytes parser)
+	Type string
+	// LittleEndian reads multi-byte fields least significant byte first
+	LittleEndian bool
+	// Scale and Add convert the raw value: value = raw*Scale + Add
+	// (a zero Scale means 1)
+	Scale float64
+	Add   float64
+	// Expression, when set, converts the value further after Scale and Add,
+	// e.g. to engineering units
+	Expression *Expression
+	Unit       string
+}
+
+// Parser extracts values from a poll response
+type Parser interface {
+	Parse(response []byte) ([]Value, error)
+}
+
+// fieldSizes are the byte sizes of the binary field types
+var fieldSizes = map[string]int{
+	"u8": 1, "i8": 1,
+	"u16": 2, "i16": 2,
+	"u32": 4, "i32": 4, "f32": 4,
+	"u64": 8, "i64": 8, "f64": 8,
+}
+
+// NewParser creates a parser of the given type
+func NewParser(parserType, pattern string, fields []Field) (Parser, error) {
+	switch parserType {
+	case ParserRegex, "":
+		return newRegexParser(pattern, fields)
+	case ParserBytes:
+		return newBytesParser(fields)
+	case ParserMegatec:
+		if pattern != "" || len(fields) > 0 {
+			return nil, fmt.Errorf("megatec parser takes no pattern or fields")
+		}
+		return megatecParser{}, nil
+	}
+	return nil, fmt.Errorf("unknown parser type %q (regex, bytes or megatec)", parserType)
+}
+
+type regexParser struct {
+	re     *regexp.Regexp
+	fields []Field
+}
+
+func newRegexParser(pattern string, fields []Field) (*regexParser, error) {
+	if pattern == "" {
+		return nil, fmt.Errorf("regex parser requires a pattern")
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+
+	// Without explicit fields every named group becomes a value
+	if len(fields) == 0 {
+		for _, name := range re.SubexpNames() {
+			if name != "" {
+				fields = append(fields, Field{Name: name, Group: name})
+			}
+		}
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("pattern has no named groups and no fields are defined")
+		}
+	}
+
+	for i, field := range fields {
+		if field.Name == "" {
+			return nil, fmt.Errorf("field %d requires a name", i+1)
+		}
+		if groupIndex(re, field.Group) < 0 {
+			return nil, fmt.Errorf("field %s: pattern has no group %q", field.Name, field.Group)
+		}
+	}
+	return &regexParser{re: re, fields: fields}, nil
+}
+
+// groupIndex resolves a group name or number, -1 when missing
+func groupIndex(re *regexp.Regexp, group string) int {
+	if group == "" {
+		return -1
+	}
+	if n, err := strconv.Atoi(group); err == nil {
+		if n > 0 && n <= re.NumSubexp() {
+			return n
+		}
+		return -1
+	}
+	return re.SubexpIndex(group)
+}
+
+func (p *regexParser) Parse(response []byte) ([]Value, error) {
+	match := p.re.FindSubmatch(response)
+	if match == nil {
+		return nil, fmt.Errorf("response does not match pattern: %q", truncate(response))
+	}
+
+	values := make([]Value, 0, len(p.fields))
+	for _, field := range p.fields {
+		text := strings.TrimSpace(string(match[groupIndex(p.re, field.Group)]))
+		raw, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %q is not a number", field.Name, text)
+		}
+		values = append(values, field.value(raw))
+	}
+	return values, nil
+}
+
+type bytesParser struct {
+	fields []Field
+}
+
+func newBytesParser(fields []Field) (*bytesParser, error) {
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("bytes parser requires fields")
+	}
+	for i, field := range fields {
+		if field.Name == "" {
+			return nil, fmt.Errorf("field %d requires a name", i+1)
+		}
+		if _, ok := fieldSizes[field.Type]; !ok {
+			return nil, fmt.Errorf("field %s: unknown type %q", field.Name, field.Type)
+		}
+		if field.Offset < 0 {
+			return nil, fmt.Errorf("field %s: offset must not be negative", field.Name)
+		}
+	}
+	return &bytesParser{fields: fields}, nil
+}
+
+func (p *bytesParser) Parse(response []byte) ([]Value, error) {
+	values := make([]Value, 0, len(p.fields))
+	for _, field := range p.fields {
+		size := fieldSizes[field.Type]
+		if field.Offset+size > len(response) {
+			return nil, fmt.Errorf("field %s: response too short (%d bytes)", field.Name, len(response))
+		}
+		values = append(values, field.value(decodeField(response[field.Offset:field.Offset+size], field)))
+	}
+	return values, nil
+}
+
+// MinLength returns the response length needed by all fields
+func (p *bytesParser) MinLength() int {
+	length := 0
+	for _, field := range p.fields {
+		length = max(length, field.Offset+fieldSizes[field.Type])
+	}
+	return length
+}
+
+type megatecParser struct{}
+
+func (megatecParser) Parse(response []byte) ([]Value, error) {
+	status, err := ups.ParseStatus(response)
+	if err != nil {
+		return nil, err
+	}
+	readings := status.Readings()
+	values := make([]Value, 0, len(readings))
+	for _, r := range readings {
+		values = append(values, Value{Name: r.Name, Value: r.Value, Unit: r.Unit})
+	}
+	return values, nil
+}
+
+func decodeField(b []byte, field Field) float64 {
+	var order binary.ByteOrder = binary.BigEndian
+	if field.LittleEndian {
+		order = binary.LittleEndian
+	}
+
+	switch field.Type {
+	case "u8":
+		return float64(b[0])
+	case "i8":
+		return float64(int8(b[0]))
+	case "u16":
+		return float64(order.Uint16(b))
+	case "i16":
+		return float64(int16(order.Uint16(b)))
+	case "u32":
+		return float64(order.Uint32(b))
+	case "i32":
+		return float64(int32(order.Uint32(b)))
+	case "f32":
+		return float64(math.Float32frombits(order.Uint32(b)))
+	case "u64":
+		return float64(order.Uint64(b))
+	case "i64":
+		return float64(int64(order.Uint64(b)))
+	default: // f64
+		return math.Float64frombits(order.Uint64(b))
+	}
+}
+
+func (f Field) value(raw float64) Value {
+	scale := f.Scale
+	if scale == 0 {
+		scale = 1
+	}
+	value := raw*scale + f.Add
+	if f.Expression != nil {
+		value = f.Expression.Eval(value)
+	}
+	return Value{Name: f.Name, Value: value, Unit: f.Unit}
+}
+
+// truncate shortens a response for error messages
+func truncate(b []byte) string {
+	if len(b) > 64 {
+		return string(b[:64]) + "..."
+	}
+	return string(b)
+}
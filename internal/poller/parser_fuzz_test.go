@@ -0,0 +1,110 @@
+package poller
+
+import (
+	"testing"
+)
+
+func FuzzRegexParser(f *testing.F) {
+	f.Add([]byte("T=23.5 H=41\r\n"))
+	f.Add([]byte("T=-40 H=1e3"))
+	f.Add([]byte("T= H=NaN"))
+	f.Add([]byte("T=1.7976931348623157e309 H=0x1p-2"))
+	f.Add([]byte("garbage\x00\xff"))
+
+	expr, err := ParseExpression("value*1.8 + 32")
+	if err != nil {
+		f.Fatal(err)
+	}
+	parser, err := NewParser(ParserRegex, `T=(?P<temp>\S*)\s+H=(\S+)`, []Field{
+		{Name: "temperature", Group: "temp", Expression: expr, Unit: "°F"},
+		{Name: "humidity", Group: "2", Scale: 0.1},
+	})
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	f.Fuzz(func(t *testing.T, response []byte) {
+		values, err := parser.Parse(response)
+		if err == nil && len(values) != 2 {
+			t.Fatalf("parsed %d values, want 2", len(values))
+		}
+	})
+}
+
+func FuzzBytesParser(f *testing.F) {
+	f.Add([]byte{0x01, 0x03, 0x04, 0x00, 0xfa, 0x01, 0x2c}, false)
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, true)
+	f.Add([]byte{}, false)
+
+	var fields []Field
+	offset := 0
+	for _, typ := range []string{"u8", "i8", "u16", "i16", "u32", "i32", "f32", "u64", "i64", "f64"} {
+		fields = append(fields, Field{Name: typ, Offset: offset % 7, Type: typ})
+		offset += 3
+	}
+	build := func(littleEndian bool) *bytesParser {
+		withOrder := make([]Field, len(fields))
+		for i, field := range fields {
+			field.LittleEndian = littleEndian
+			withOrder[i] = field
+		}
+		parser, err := NewParser(ParserBytes, "", withOrder)
+		if err != nil {
+			f.Fatal(err)
+		}
+		return parser.(*bytesParser)
+	}
+	parsers := map[bool]*bytesParser{false: build(false), true: build(true)}
+
+	f.Fuzz(func(t *testing.T, response []byte, littleEndian bool) {
+		parser := parsers[littleEndian]
+		values, err := parser.Parse(response)
+		if (err == nil) != (len(response) >= parser.MinLength()) {
+			t.Fatalf("%d byte response: error %v, minimum length %d", len(response), err, parser.MinLength())
+		}
+		if err == nil && len(values) != len(fields) {
+			t.Fatalf("parsed %d values, want %d", len(values), len(fields))
+		}
+	})
+}
+
+func FuzzMegatecParser(f *testing.F) {
+	f.Add([]byte("(208.4 140.0 208.4 034 59.9 2.05 35.0 00110000\r"))
+	f.Add([]byte("(000.0 000.0 000.0 000 00.0 0.00 00.0 10000001"))
+	f.Add([]byte("(208.4 140.0 208.4 034 59.9 2.05 35.0 0011000"))
+	f.Add([]byte("((((((((\r"))
+	f.Add([]byte("#220.0 000 024.0 50.0\r"))
+
+	parser, err := NewParser(ParserMegatec, "", nil)
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	f.Fuzz(func(t *testing.T, response []byte) {
+		values, err := parser.Parse(response)
+		if err == nil && len(values) == 0 {
+			t.Fatal("status parsed without readings")
+		}
+	})
+}
+
+func FuzzParseExpression(f *testing.F) {
+	f.Add("value*0.1 - 40", 1234.0)
+	f.Add("-(value ^ 2) % 7 + pow(value, 0.5)", -3.0)
+	f.Add("max(min(value, 100), sqrt(abs(value))) / 0", 0.0)
+	f.Add("round(ln(value) * log10(e) + pi", 2.0)
+	f.Add("((((((((((value))))))))))", 1e308)
+	f.Add("value value", 1.0)
+	f.Add("unknown(value)", 1.0)
+
+	f.Fuzz(func(t *testing.T, source string, value float64) {
+		expr, err := ParseExpression(source)
+		if err != nil {
+			return
+		}
+		if expr.String() != source {
+			t.Fatalf("String() = %q, want %q", expr.String(), source)
+		}
+		_ = expr.Eval(value)
+	})
+}
@@ -0,0 +1,417 @@
+// Package poller runs declaratively defined request/response polls against
+// serial devices and publishes the parsed values.
+package poller
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/charmbracelet/log"
+)
+
+// ClientID identifies sessions opened by the polling engine
+const ClientID = "poller"
+
+const (
+	// retryInterval is the delay before reopening a port that failed
+	retryInterval = 5 * time.Second
+
+	// pollReadTimeout is the read granularity while waiting for a response
+	pollReadTimeout = 20 * time.Millisecond
+
+	// maxResponseSize bounds a response when no terminator arrives
+	maxResponseSize = 4096
+)
+
+// Definition describes one poll
+type Definition struct {
+	Name     string
+	PortName string
+	// Config is used when the engine opens the port
+	Config   serial.PortConfig
+	Interval time.Duration
+	// Request is a text/template rendered before each poll with .Name,
+	// .Seq (poll counter) and .Time
+	Request string
+	// RequestHex decodes the rendered request from hex, for binary protocols
+	RequestHex bool
+	// Timeout bounds waiting for the response
+	Timeout time.Duration
+	// Terminator ends the response; without one the response ends after
+	// ResponseLength bytes or at the timeout
+	Terminator     string
+	ResponseLength int
+	Parser         Parser
+	// Topic overrides the MQTT topic of the poll's samples
+	Topic string
+}
+
+// Sample is the result of one poll
+type Sample struct {
+	Poller    string
+	PortName  string
+	Timestamp time.Time
+	Values    []Value
+	// Err is set when the poll failed; Values is then empty
+	Err error
+}
+
+// Publisher sends messages to a broker, e.g. an MQTT publisher
+type Publisher interface {
+	Publish(topic string, payload []byte) error
+}
+
+// Engine runs polls. Polls on the same port run one after another on a
+// session the engine opens.
+type Engine struct {
+	manager *serial.Manager
+	defs    []Definition
+	logger  *log.Logger
+	wg      sync.WaitGroup
+
+	mu       sync.RWMutex
+	latest   map[string]Sample
+	lastGood map[string]Sample
+	stats    map[string]*pollStats
+	subs     []chan Sample
+}
+
+// pollStats counts poll outcomes for metrics
+type pollStats struct {
+	polls    uint64
+	failures uint64
+	lastOK   time.Time
+}
+
+// NewEngine creates an engine for the given polls
+func NewEngine(manager *serial.Manager, defs []Definition, logger *log.Logger) *Engine {
+	e := &Engine{
+		manager:  manager,
+		defs:     defs,
+		logger:   logger,
+		latest:   make(map[string]Sample),
+		lastGood: make(map[string]Sample),
+		stats:    make(map[string]*pollStats),
+	}
+	for _, def := range defs {
+		e.stats[def.Name] = &pollStats{}
+	}
+	return e
+}
+
+// Start runs the polls until ctx is cancelled
+func (e *Engine) Start(ctx context.Context) {
+	byPort := make(map[string][]Definition)
+	var ports []string
+	for _, def := range e.defs {
+		if _, ok := byPort[def.PortName]; !ok {
+			ports = append(ports, def.PortName)
+		}
+		byPort[def.PortName] = append(byPort[def.PortName], def)
+	}
+
+	for _, port := range ports {
+		e.wg.Add(1)
+		go func(defs []Definition) {
+			defer e.wg.Done()
+			e.runPort(ctx, defs)
+		}(byPort[port])
+	}
+}
+
+// Wait blocks until all polls have stopped
+func (e *Engine) Wait() {
+	e.wg.Wait()
+}
+
+// Names returns the names of all polls
+func (e *Engine) Names() []string {
+	names := make([]string, 0, len(e.defs))
+	for _, def := range e.defs {
+		names = append(names, def.Name)
+	}
+	return names
+}
+
+// Latest returns the most recent sample of every poll that has run
+func (e *Engine) Latest() []Sample {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	samples := make([]Sample, 0, len(e.latest))
+	for _, def := range e.defs {
+		if sample, ok := e.latest[def.Name]; ok {
+			samples = append(samples, sample)
+		}
+	}
+	return samples
+}
+
+// Subscribe returns a channel receiving every sample
+func (e *Engine) Subscribe() <-chan Sample {
+	ch := make(chan Sample, 64)
+	e.mu.Lock()
+	e.subs = append(e.subs, ch)
+	e.mu.Unlock()
+	return ch
+}
+
+// Unsubscribe stops delivery to a channel returned by Subscribe
+func (e *Engine) Unsubscribe(ch <-chan Sample) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for i, sub := range e.subs {
+		if sub == ch {
+			e.subs = append(e.subs[:i], e.subs[i+1:]...)
+			close(sub)
+			return
+		}
+	}
+}
+
+// Forward publishes every successful sample as JSON to
+// "<prefix>/<poll name>", or the poll's own topic, until ctx is cancelled
+func (e *Engine) Forward(ctx context.Context, publisher Publisher, prefix string) {
+	topics := make(map[string]string, len(e.defs))
+	for _, def := range e.defs {
+		topic := def.Topic
+		if topic == "" {
+			topic = strings.TrimSuffix(prefix, "/") + "/" + def.Name
+		}
+		topics[def.Name] = topic
+	}
+
+	samples := e.Subscribe()
+	defer e.Unsubscribe(samples)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case sample := <-samples:
+			if sample.Err != nil {
+				continue
+			}
+			payload, err := json.Marshal(sampleMessage(sample))
+			if err != nil {
+				continue
+			}
+			if err := publisher.Publish(topics[sample.Poller], payload); err != nil {
+				e.logger.Debug("failed to publish poll sample", "poller", sample.Poller, "error", err)
+			}
+		}
+	}
+}
+
+// sampleMessage is the JSON form of a sample published to MQTT
+func sampleMessage(sample Sample) map[string]interface{} {
+	values := make(map[string]interface{}, len(sample.Values))
+	for _, v := range sample.Values {
+		values[v.Name] = v.Value
+	}
+	return map[string]interface{}{
+		"poller":    sample.Poller,
+		"port":      sample.PortName,
+		"timestamp": sample.Timestamp.Format(time.RFC3339Nano),
+		"values":    values,
+	}
+}
+
+// runPort polls the definitions of one port, earliest due first
+func (e *Engine) runPort(ctx context.Context, defs []Definition) {
+	portName := defs[0].PortName
+	next := make([]time.Time, len(defs))
+	seq := make([]uint64, len(defs))
+	var session *serial.Session
+
+	defer func() {
+		if session != nil {
+			_ = e.manager.ClosePort(portName, session.ID)
+		}
+	}()
+
+	for {
+		if session == nil {
+			var err error
+			session, err = e.manager.OpenPort(portName, defs[0].Config, ClientID, true)
+			if err != nil {
+				session = nil
+				e.logger.Warn("poller failed to open port", "port", portName, "error", err)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(retryInterval):
+				}
+				continue
+			}
+			e.logger.Info("poller started", "port", portName, "polls", len(defs))
+		}
+
+		due := 0
+		for i := range next {
+			if next[i].Before(next[due]) {
+				due = i
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(next[due])):
+		}
+
+		def := defs[due]
+		seq[due]++
+		sample := e.poll(ctx, session, def, seq[due])
+		e.record(sample)
+
+		next[due] = next[due].Add(def.Interval)
+		if now := time.Now(); next[due].Before(now) {
+			next[due] = now.Add(def.Interval)
+		}
+
+		if errors.Is(sample.Err, serial.ErrPortNotOpen) || errors.Is(sample.Err, serial.ErrInvalidSession) || session.IsClosed() {
+			// The session went away (e.g. adapter unplugged); reopen
+			session = nil
+		}
+	}
+}
+
+// poll runs one request/response exchange
+func (e *Engine) poll(ctx context.Context, session *serial.Session, def Definition, seq uint64) Sample {
+	sample := Sample{Poller: def.Name, PortName: def.PortName, Timestamp: time.Now()}
+
+	request, err := renderRequest(def, seq, sample.Timestamp)
+	if err != nil {
+		sample.Err = err
+		return sample
+	}
+
+	var response []byte
+	err = e.manager.Transact(def.PortName, session.ID, pollReadTimeout, func(rw io.ReadWriter) error {
+		var err error
+		response, err = exchange(ctx, rw, request, def)
+		return err
+	})
+	if err != nil {
+		sample.Err = err
+		return sample
+	}
+
+	sample.Values, sample.Err = def.Parser.Parse(response)
+	return sample
+}
+
+// renderRequest executes the request template
+func renderRequest(def Definition, seq uint64, now time.Time) ([]byte, error) {
+	tmpl, err := template.New(def.Name).Parse(def.Request)
+	if err != nil {
+		return nil, fmt.Errorf("invalid request template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	data := struct {
+		Name string
+		Seq  uint64
+		Time time.Time
+	}{def.Name, seq, now}
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to render request: %w", err)
+	}
+
+	if def.RequestHex {
+		request, err := hex.DecodeString(strings.Join(strings.Fields(buf.String()), ""))
+		if err != nil {
+			return nil, fmt.Errorf("request is not valid hex: %w", err)
+		}
+		return request, nil
+	}
+	return buf.Bytes(), nil
+}
+
+// exchange discards stale input, sends the request and collects the response
+func exchange(ctx context.Context, rw io.ReadWriter, request []byte, def Definition) ([]byte, error) {
+	buffer := make([]byte, 512)
+	for {
+		n, err := rw.Read(buffer)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			break
+		}
+	}
+
+	if len(request) > 0 {
+		if _, err := rw.Write(request); err != nil {
+			return nil, err
+		}
+	}
+
+	var response []byte
+	deadline := time.Now().Add(def.Timeout)
+	for time.Now().Before(deadline) && ctx.Err() == nil {
+		n, err := rw.Read(buffer)
+		if err != nil {
+			return nil, err
+		}
+		response = append(response, buffer[:n]...)
+
+		switch {
+		case def.Terminator != "":
+			if i := bytes.Index(response, []byte(def.Terminator)); i >= 0 {
+				return response[:i], nil
+			}
+		case def.ResponseLength > 0:
+			if len(response) >= def.ResponseLength {
+				return response[:def.ResponseLength], nil
+			}
+		}
+		if len(response) >= maxResponseSize {
+			break
+		}
+	}
+
+	if len(response) == 0 {
+		return nil, fmt.Errorf("no response within %s", def.Timeout)
+	}
+	if def.Terminator != "" || def.ResponseLength > 0 {
+		return nil, fmt.Errorf("incomplete response within %s: %q", def.Timeout, truncate(response))
+	}
+	return response, nil
+}
+
+// record stores a sample and delivers it to subscribers
+func (e *Engine) record(sample Sample) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	stats := e.stats[sample.Poller]
+	stats.polls++
+	if sample.Err != nil {
+		stats.failures++
+		e.logger.Debug("poll failed", "poller", sample.Poller, "error", sample.Err)
+	} else {
+		stats.lastOK = sample.Timestamp
+		e.lastGood[sample.Poller] = sample
+	}
+	e.latest[sample.Poller] = sample
+
+	for _, ch := range e.subs {
+		select {
+		case ch <- sample:
+		default:
+			// Subscriber too slow, drop the sample
+		}
+	}
+}
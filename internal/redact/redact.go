@@ -0,0 +1,95 @@
+// Package redact masks secrets in device traffic, such as a PIN a device
+// echoes, before the traffic is written anywhere it persists.
+package redact
+
+import (
+	"bytes"
+	"regexp"
+)
+
+// DefaultMask replaces redacted data when a rule sets no mask
+const DefaultMask = "***"
+
+// Rule masks what Pattern matches. When the pattern has groups, only what
+// the groups match is masked, e.g. `PIN=(\d+)` keeps "PIN=" and masks the
+// digits; otherwise the whole match is.
+type Rule struct {
+	Pattern *regexp.Regexp
+	// Mask replaces each masked span (default DefaultMask)
+	Mask string
+}
+
+// Redactor applies rules in order. All methods are safe on a nil Redactor,
+// which redacts nothing.
+type Redactor struct {
+	rules []Rule
+}
+
+// New creates a redactor, nil when there are no rules
+func New(rules []Rule) *Redactor {
+	if len(rules) == 0 {
+		return nil
+	}
+	return &Redactor{rules: rules}
+}
+
+// Apply returns data with every match masked. It never modifies data and
+// returns it as it is when nothing matches.
+func (r *Redactor) Apply(data []byte) []byte {
+	if r == nil {
+		return data
+	}
+	for _, rule := range r.rules {
+		data = rule.apply(data)
+	}
+	return data
+}
+
+// String is Apply for text
+func (r *Redactor) String(s string) string {
+	if r == nil {
+		return s
+	}
+	return string(r.Apply([]byte(s)))
+}
+
+// apply masks the matches of one rule
+func (rule Rule) apply(data []byte) []byte {
+	matches := rule.Pattern.FindAllSubmatchIndex(data, -1)
+	if matches == nil {
+		return data
+	}
+	mask := rule.Mask
+	if mask == "" {
+		mask = DefaultMask
+	}
+
+	var out bytes.Buffer
+	copied := 0
+	for _, match := range matches {
+		spans := [][2]int{{match[0], match[1]}}
+		if len(match) > 2 {
+			spans = spans[:0]
+			for i := 2; i < len(match); i += 2 {
+				if match[i] >= 0 {
+					spans = append(spans, [2]int{match[i], match[i+1]})
+				}
+			}
+		}
+		// Groups come in order of their start; a nested group lies within
+		// one already masked
+		for _, span := range spans {
+			if span[0] < copied || span[0] == span[1] {
+				continue
+			}
+			out.Write(data[copied:span[0]])
+			out.WriteString(mask)
+			copied = span[1]
+		}
+	}
+	if copied == 0 && out.Len() == 0 {
+		return data
+	}
+	out.Write(data[copied:])
+	return out.Bytes()
+}
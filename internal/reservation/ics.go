@@ -0,0 +1,80 @@
+package reservation
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// icsTimeFormat is the UTC date-time form of RFC 5545
+const icsTimeFormat = "20060102T150405Z"
+
+// icsLineLength is the longest content line in octets before folding
+const icsLineLength = 75
+
+// WriteICS writes reservations as an iCalendar (RFC 5545) feed, one event
+// per reservation, so bookings show up in calendar applications
+func WriteICS(w io.Writer, reservations []Reservation, now time.Time) error {
+	bw := bufio.NewWriter(w)
+	line := func(name, value string) {
+		writeICSLine(bw, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//SerialLink//Port Reservations//EN")
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	line("X-WR-CALNAME", "SerialLink port reservations")
+	for _, r := range reservations {
+		line("BEGIN", "VEVENT")
+		line("UID", r.ID+"@seriallink")
+		line("DTSTAMP", now.UTC().Format(icsTimeFormat))
+		line("CREATED", r.CreatedAt.UTC().Format(icsTimeFormat))
+		line("DTSTART", r.Start.UTC().Format(icsTimeFormat))
+		line("DTEND", r.End.UTC().Format(icsTimeFormat))
+		line("SUMMARY", escapeICSText(r.PortName+" reserved by "+r.Holder))
+		line("LOCATION", escapeICSText(r.PortName))
+		description := "Holder: " + r.Holder + "\nBooked by: " + r.CreatedBy
+		if r.Note != "" {
+			description = r.Note + "\n\n" + description
+		}
+		line("DESCRIPTION", escapeICSText(description))
+		line("END", "VEVENT")
+	}
+	line("END", "VCALENDAR")
+
+	return bw.Flush()
+}
+
+// escapeICSText escapes a TEXT property value
+func escapeICSText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// writeICSLine writes a content line, folding it at 75 octets without
+// splitting UTF-8 sequences
+func writeICSLine(w *bufio.Writer, line string) {
+	limit := icsLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.WriteString(line[:cut])
+		w.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space
+		limit = icsLineLength - 1
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
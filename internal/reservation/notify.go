@@ -0,0 +1,82 @@
+package reservation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// webhookTimeout bounds a webhook delivery
+const webhookTimeout = 10 * time.Second
+
+// EventStarted is sent when a reservation window starts
+const EventStarted = "reservation_started"
+
+// Event is a change in a reservation delivered to notifiers
+type Event struct {
+	Type        string
+	Reservation Reservation
+	Timestamp   time.Time
+}
+
+// Notifier delivers reservation events, e.g. to a webhook
+type Notifier interface {
+	Notify(ctx context.Context, event Event) error
+}
+
+// eventMessage is the JSON form of an event sent to webhooks
+type eventMessage struct {
+	Event       string      `json:"event"`
+	Timestamp   string      `json:"timestamp"`
+	Reservation Reservation `json:"reservation"`
+}
+
+// Marshal returns the JSON form of an event
+func (e Event) Marshal() ([]byte, error) {
+	return json.Marshal(eventMessage{
+		Event:       e.Type,
+		Timestamp:   e.Timestamp.Format(time.RFC3339Nano),
+		Reservation: e.Reservation,
+	})
+}
+
+// WebhookNotifier POSTs every event as JSON to a URL
+type WebhookNotifier struct {
+	URL    string
+	Client *http.Client
+}
+
+// Notify implements Notifier
+func (w WebhookNotifier) Notify(ctx context.Context, event Event) error {
+	body, err := event.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+// Package reservation books ports for time windows, so shared lab hardware
+// can be scheduled between teams: during a window only the holder may open
+// the port, and overlapping bookings are rejected.
+package reservation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/clock"
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+)
+
+// checkInterval is how often window starts and ends are checked
+const checkInterval = time.Second
+
+// Errors returned by the book
+var (
+	ErrInvalid   = errors.New("invalid reservation")
+	ErrConflict  = errors.New("reservation conflicts with another")
+	ErrNotFound  = errors.New("reservation not found")
+	ErrNotHolder = errors.New("only the holder or creator may cancel a reservation")
+)
+
+// Reservation books a port for [Start, End)
+type Reservation struct {
+	ID       string    `json:"id"`
+	PortName string    `json:"port_name"`
+	Holder   string    `json:"holder"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Note     string    `json:"note,omitempty"`
+	// CreatedBy is the identity of the client that made the booking
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ActiveAt reports whether the window includes t
+func (r Reservation) ActiveAt(t time.Time) bool {
+	return !t.Before(r.Start) && t.Before(r.End)
+}
+
+// HeldBy reports whether any of the identities holds the reservation
+func (r Reservation) HeldBy(identities ...string) bool {
+	return slices.Contains(identities, r.Holder)
+}
+
+// overlaps reports whether two windows on the same port intersect
+func (r Reservation) overlaps(other Reservation) bool {
+	return r.PortName == other.PortName && r.Start.Before(other.End) && other.Start.Before(r.End)
+}
+
+// Options configure a Book
+type Options struct {
+	// Path persists reservations across restarts; empty keeps them in memory
+	Path string
+	// MaxDuration caps a window; zero is unlimited
+	MaxDuration time.Duration
+	// Clock times the windows; nil is the wall clock
+	Clock clock.Clock
+}
+
+// Book holds the reservations of every port
+type Book struct {
+	opts      Options
+	logger    *log.Logger
+	notifiers []Notifier
+
+	mu           sync.Mutex
+	reservations map[string]Reservation
+	// started are reservations whose window start has been announced
+	started map[string]bool
+}
+
+// Open loads the reservations saved at opts.Path, dropping finished ones
+func Open(opts Options, logger *log.Logger) (*Book, error) {
+	if opts.Clock == nil {
+		opts.Clock = clock.System
+	}
+	b := &Book{
+		opts:         opts,
+		logger:       logger,
+		reservations: make(map[string]Reservation),
+		started:      make(map[string]bool),
+	}
+	if opts.Path == "" {
+		return b, nil
+	}
+
+	data, err := os.ReadFile(opts.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return b, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reservations: %w", err)
+	}
+	var saved []Reservation
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", opts.Path, err)
+	}
+	now := b.opts.Clock.Now()
+	for _, r := range saved {
+		if r.End.After(now) {
+			b.reservations[r.ID] = r
+		}
+	}
+	return b, nil
+}
+
+// AddNotifier delivers reservation events to n. Call before Run.
+func (b *Book) AddNotifier(n Notifier) {
+	b.notifiers = append(b.notifiers, n)
+}
+
+// Create books a window, rejecting it with ErrConflict when it overlaps a
+// reservation of the same port
+func (b *Book) Create(r Reservation) (Reservation, error) {
+	now := b.opts.Clock.Now()
+	switch {
+	case r.PortName == "":
+		return Reservation{}, fmt.Errorf("%w: port is required", ErrInvalid)
+	case r.Holder == "":
+		return Reservation{}, fmt.Errorf("%w: holder is required", ErrInvalid)
+	case !r.End.After(r.Start):
+		return Reservation{}, fmt.Errorf("%w: end must be after start", ErrInvalid)
+	case !r.End.After(now):
+		return Reservation{}, fmt.Errorf("%w: window already ended", ErrInvalid)
+	case b.opts.MaxDuration > 0 && r.End.Sub(r.Start) > b.opts.MaxDuration:
+		return Reservation{}, fmt.Errorf("%w: window longer than %s", ErrInvalid, b.opts.MaxDuration)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, other := range b.reservations {
+		if r.overlaps(other) {
+			return Reservation{}, fmt.Errorf("%w: %s is reserved by %s from %s to %s", ErrConflict,
+				other.PortName, other.Holder, other.Start.Format(time.RFC3339), other.End.Format(time.RFC3339))
+		}
+	}
+
+	r.ID = uuid.New().String()
+	r.CreatedAt = now
+	b.reservations[r.ID] = r
+	if err := b.saveLocked(); err != nil {
+		delete(b.reservations, r.ID)
+		return Reservation{}, err
+	}
+
+	b.logger.Info("port reserved", "port", r.PortName, "holder", r.Holder, "start", r.Start, "end", r.End, "id", r.ID)
+	return r, nil
+}
+
+// Cancel removes a reservation on behalf of its holder or creator
+func (b *Book) Cancel(id string, identities ...string) (Reservation, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	r, ok := b.reservations[id]
+	if !ok {
+		return Reservation{}, ErrNotFound
+	}
+	if !r.HeldBy(identities...) && !slices.Contains(identities, r.CreatedBy) {
+		return Reservation{}, ErrNotHolder
+	}
+
+	delete(b.reservations, id)
+	if err := b.saveLocked(); err != nil {
+		b.reservations[id] = r
+		return Reservation{}, err
+	}
+	delete(b.started, id)
+
+	b.logger.Info("reservation cancelled", "port", r.PortName, "holder", r.Holder, "id", id)
+	return r, nil
+}
+
+// List returns the current and future reservations, of one port when
+// portName is set, by start time
+func (b *Book) List(portName string) []Reservation {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.opts.Clock.Now()
+	result := make([]Reservation, 0, len(b.reservations))
+	for _, r := range b.reservations {
+		if r.End.After(now) && (portName == "" || r.PortName == portName) {
+			result = append(result, r)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Start.Before(result[j].Start) })
+	return result
+}
+
+// Active returns the reservation of a port in force at t
+func (b *Book) Active(portName string, t time.Time) (Reservation, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, r := range b.reservations {
+		if r.PortName == portName && r.ActiveAt(t) {
+			return r, true
+		}
+	}
+	return Reservation{}, false
+}
+
+// Run calls onStart and notifies as each window starts, and drops finished
+// reservations, until ctx is cancelled
+func (b *Book) Run(ctx context.Context, onStart func(Reservation)) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	ticker := b.opts.Clock.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		for _, r := range b.check(b.opts.Clock.Now(), onStart) {
+			// Slow webhooks must not hold up the next window
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				b.notify(ctx, Event{Type: EventStarted, Reservation: r, Timestamp: b.opts.Clock.Now()})
+			}()
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+		}
+	}
+}
+
+// notify delivers an event to every notifier
+func (b *Book) notify(ctx context.Context, event Event) {
+	for _, n := range b.notifiers {
+		if err := n.Notify(ctx, event); err != nil {
+			b.logger.Warn("failed to deliver reservation notification", "id", event.Reservation.ID, "event", event.Type, "error", err)
+		}
+	}
+}
+
+// check announces started windows and prunes finished ones. It returns the
+// reservations whose window started.
+func (b *Book) check(now time.Time, onStart func(Reservation)) []Reservation {
+	var starting []Reservation
+
+	b.mu.Lock()
+	pruned := false
+	for id, r := range b.reservations {
+		if !r.End.After(now) {
+			delete(b.reservations, id)
+			delete(b.started, id)
+			pruned = true
+			continue
+		}
+		if r.ActiveAt(now) && !b.started[id] {
+			b.started[id] = true
+			starting = append(starting, r)
+		}
+	}
+	if pruned {
+		if err := b.saveLocked(); err != nil {
+			b.logger.Warn("failed to save reservations", "error", err)
+		}
+	}
+	b.mu.Unlock()
+
+	for _, r := range starting {
+		b.logger.Info("reservation window started", "port", r.PortName, "holder", r.Holder, "end", r.End)
+		if onStart != nil {
+			onStart(r)
+		}
+	}
+	return starting
+}
+
+// saveLocked writes the reservations to disk atomically (lock held)
+func (b *Book) saveLocked() error {
+	if b.opts.Path == "" {
+		return nil
+	}
+
+	list := make([]Reservation, 0, len(b.reservations))
+	for _, r := range b.reservations {
+		list = append(list, r)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Start.Before(list[j].Start) })
+	data, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(b.opts.Path), 0o755); err != nil {
+		return fmt.Errorf("failed to save reservations: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(b.opts.Path), ".reservations-*")
+	if err != nil {
+		return fmt.Errorf("failed to save reservations: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to save reservations: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to save reservations: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), b.opts.Path); err != nil {
+		return fmt.Errorf("failed to save reservations: %w", err)
+	}
+	return nil
+}
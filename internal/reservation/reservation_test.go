@@ -0,0 +1,82 @@
+package reservation
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/clock"
+	"github.com/charmbracelet/log"
+)
+
+func TestWindowStartsAndExpiresOnClock(t *testing.T) {
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	clk := clock.NewFake(start.Add(-time.Minute))
+	book, err := Open(Options{Clock: clk}, log.New(io.Discard))
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+
+	r, err := book.Create(Reservation{
+		PortName: "/dev/ttyUSB0",
+		Holder:   "group:soak-rigs",
+		Start:    start,
+		End:      start.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	started := make(chan Reservation, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		book.Run(ctx, func(r Reservation) { started <- r })
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	// Run checks once at once, then on each tick
+	clk.BlockUntil(1)
+	if _, ok := book.Active(r.PortName, clk.Now()); ok {
+		t.Fatal("reservation active before its window")
+	}
+
+	clk.Advance(time.Minute)
+	if got := <-started; got.ID != r.ID {
+		t.Fatalf("started %s, want %s", got.ID, r.ID)
+	}
+	if active, ok := book.Active(r.PortName, clk.Now()); !ok || !active.HeldBy("group:soak-rigs") {
+		t.Fatalf("active = %+v, %v during the window", active, ok)
+	}
+
+	clk.Advance(time.Hour)
+	if list := book.List(""); len(list) != 0 {
+		t.Fatalf("listed %d reservations after the window ended", len(list))
+	}
+	if _, ok := book.Active(r.PortName, clk.Now()); ok {
+		t.Fatal("reservation active after its window")
+	}
+}
+
+func TestCreateRejectsEndedWindow(t *testing.T) {
+	now := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	book, err := Open(Options{Clock: clock.NewFake(now)}, log.New(io.Discard))
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+
+	_, err = book.Create(Reservation{
+		PortName: "/dev/ttyUSB0",
+		Holder:   "token:alice",
+		Start:    now.Add(-2 * time.Hour),
+		End:      now.Add(-time.Hour),
+	})
+	if err == nil {
+		t.Fatal("created a window that already ended")
+	}
+}
@@ -0,0 +1,83 @@
+package retention
+
+import (
+	"sort"
+
+	"github.com/Shoaibashk/SerialLink/internal/metrics"
+)
+
+// Collect implements metrics.Collector
+func (m *Manager) Collect() []metrics.Family {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	bytes := metrics.Family{
+		Name: "seriallink_retention_bytes",
+		Help: "Disk space used by agent files.",
+		Type: metrics.TypeGauge,
+	}
+	files := metrics.Family{
+		Name: "seriallink_retention_files",
+		Help: "Agent files on disk.",
+		Type: metrics.TypeGauge,
+	}
+	limits := metrics.Family{
+		Name: "seriallink_retention_limit_bytes",
+		Help: "Size limit of agent files; target \"total\" covers every target.",
+		Type: metrics.TypeGauge,
+	}
+	prunedFiles := metrics.Family{
+		Name: "seriallink_retention_pruned_files_total",
+		Help: "Agent files removed to stay within retention limits.",
+		Type: metrics.TypeCounter,
+	}
+	prunedBytes := metrics.Family{
+		Name: "seriallink_retention_pruned_bytes_total",
+		Help: "Bytes of agent files removed to stay within retention limits.",
+		Type: metrics.TypeCounter,
+	}
+	lastRun := metrics.Family{
+		Name: "seriallink_retention_last_run_timestamp_seconds",
+		Help: "Unix time retention limits were last enforced.",
+		Type: metrics.TypeGauge,
+	}
+
+	for _, t := range m.targets {
+		labels := []metrics.Label{{Name: "target", Value: t.Name}}
+		u := m.usage[t.Name]
+		bytes.Samples = append(bytes.Samples, metrics.Sample{Labels: labels, Value: float64(u.bytes)})
+		files.Samples = append(files.Samples, metrics.Sample{Labels: labels, Value: float64(u.files)})
+		if t.MaxSize > 0 {
+			limits.Samples = append(limits.Samples, metrics.Sample{Labels: labels, Value: float64(t.MaxSize)})
+		}
+	}
+	if m.maxTotal > 0 {
+		limits.Samples = append(limits.Samples, metrics.Sample{
+			Labels: []metrics.Label{{Name: "target", Value: "total"}},
+			Value:  float64(m.maxTotal),
+		})
+	}
+
+	keys := make([]prunedKey, 0, len(m.pruned))
+	for key := range m.pruned {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].target != keys[j].target {
+			return keys[i].target < keys[j].target
+		}
+		return keys[i].reason < keys[j].reason
+	})
+	for _, key := range keys {
+		labels := []metrics.Label{{Name: "target", Value: key.target}, {Name: "reason", Value: key.reason}}
+		p := m.pruned[key]
+		prunedFiles.Samples = append(prunedFiles.Samples, metrics.Sample{Labels: labels, Value: float64(p.files)})
+		prunedBytes.Samples = append(prunedBytes.Samples, metrics.Sample{Labels: labels, Value: float64(p.bytes)})
+	}
+
+	if !m.lastRun.IsZero() {
+		lastRun.Samples = append(lastRun.Samples, metrics.Sample{Value: float64(m.lastRun.UnixNano()) / 1e9})
+	}
+
+	return []metrics.Family{bytes, files, limits, prunedFiles, prunedBytes, lastRun}
+}
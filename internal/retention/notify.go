@@ -0,0 +1,50 @@
+package retention
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// eventMessage is the JSON form of an event
+type eventMessage struct {
+	Event     string   `json:"event"`
+	Timestamp string   `json:"timestamp"`
+	Target    string   `json:"target"`
+	Reason    string   `json:"reason"`
+	Files     []string `json:"files"`
+	Bytes     int64    `json:"bytes"`
+}
+
+// Marshal returns the JSON form of an event
+func (e Event) Marshal() ([]byte, error) {
+	return json.Marshal(eventMessage{
+		Event:     "pruned",
+		Timestamp: e.Timestamp.Format(time.RFC3339Nano),
+		Target:    e.Target,
+		Reason:    e.Reason,
+		Files:     e.Files,
+		Bytes:     e.Bytes,
+	})
+}
+
+// Publisher sends messages to a broker, e.g. an MQTT publisher
+type Publisher interface {
+	Publish(topic string, payload []byte) error
+}
+
+// MQTTNotifier publishes every event to "<prefix>/retention/<target>"
+type MQTTNotifier struct {
+	Publisher Publisher
+	Prefix    string
+}
+
+// Notify implements Notifier
+func (n MQTTNotifier) Notify(_ context.Context, event Event) error {
+	payload, err := event.Marshal()
+	if err != nil {
+		return err
+	}
+	return n.Publisher.Publish(strings.TrimSuffix(n.Prefix, "/")+"/retention/"+event.Target, payload)
+}
@@ -0,0 +1,258 @@
+// Package retention keeps the files written by the agent within size and
+// age limits, so logs and recordings cannot fill small flash storage.
+package retention
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+// Reasons a file was pruned
+const (
+	ReasonAge   = "age"
+	ReasonSize  = "size"
+	ReasonTotal = "total"
+)
+
+// activeWindow protects recently modified files, which may still be written
+const activeWindow = time.Minute
+
+// Target is a directory of agent files with its own limits
+type Target struct {
+	Name string
+	Dir  string
+	// MaxAge removes files not modified for longer; zero keeps them
+	MaxAge time.Duration
+	// MaxSize caps the bytes of the directory; zero is unlimited
+	MaxSize int64
+	// InUse reports files still open for writing, which are never removed
+	InUse func(path string) bool
+}
+
+// Event reports the files pruned from a target for one reason
+type Event struct {
+	Target    string
+	Reason    string
+	Files     []string
+	Bytes     int64
+	Timestamp time.Time
+}
+
+// Notifier delivers prune events, e.g. to an MQTT broker
+type Notifier interface {
+	Notify(ctx context.Context, event Event) error
+}
+
+// usage counts files and bytes
+type usage struct {
+	files int
+	bytes int64
+}
+
+// file is a file found in a target
+type file struct {
+	target    int
+	path      string
+	size      int64
+	modTime   time.Time
+	protected bool
+}
+
+type prunedKey struct {
+	target string
+	reason string
+}
+
+// Manager enforces the limits of its targets periodically
+type Manager struct {
+	targets   []Target
+	maxTotal  int64
+	interval  time.Duration
+	logger    *log.Logger
+	notifiers []Notifier
+
+	mu      sync.Mutex
+	usage   map[string]usage
+	pruned  map[prunedKey]usage
+	lastRun time.Time
+}
+
+// NewManager creates a manager. maxTotal caps all targets together; zero
+// is unlimited.
+func NewManager(targets []Target, maxTotal int64, interval time.Duration, logger *log.Logger) *Manager {
+	return &Manager{
+		targets:  targets,
+		maxTotal: maxTotal,
+		interval: interval,
+		logger:   logger,
+		usage:    make(map[string]usage),
+		pruned:   make(map[prunedKey]usage),
+	}
+}
+
+// AddNotifier delivers prune events to n. Call before Run.
+func (m *Manager) AddNotifier(n Notifier) {
+	m.notifiers = append(m.notifiers, n)
+}
+
+// Run enforces the limits now and then every interval until ctx is cancelled
+func (m *Manager) Run(ctx context.Context) {
+	m.notify(ctx, m.Enforce(time.Now()))
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			m.notify(ctx, m.Enforce(now))
+		}
+	}
+}
+
+// Enforce removes files past their target's age limit, then the oldest
+// files of targets over their size limit, then the oldest files overall
+// while the total limit is exceeded. It returns what was removed.
+func (m *Manager) Enforce(now time.Time) []Event {
+	files := m.scan(now)
+	var events []Event
+	remove := func(f *file, reason string) bool {
+		if err := os.Remove(f.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			m.logger.Warn("failed to prune file", "path", f.path, "error", err)
+			return false
+		}
+		target := m.targets[f.target].Name
+		i := 0
+		for i < len(events) && (events[i].Target != target || events[i].Reason != reason) {
+			i++
+		}
+		if i == len(events) {
+			events = append(events, Event{Target: target, Reason: reason, Timestamp: now})
+		}
+		events[i].Files = append(events[i].Files, f.path)
+		events[i].Bytes += f.size
+		return true
+	}
+
+	// Oldest first, so size limits drop the oldest files
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime.Before(files[j].modTime) })
+
+	kept := files[:0]
+	for _, f := range files {
+		maxAge := m.targets[f.target].MaxAge
+		if !f.protected && maxAge > 0 && now.Sub(f.modTime) > maxAge && remove(f, ReasonAge) {
+			continue
+		}
+		kept = append(kept, f)
+	}
+	files = kept
+
+	sizes := make([]int64, len(m.targets))
+	var total int64
+	for _, f := range files {
+		sizes[f.target] += f.size
+		total += f.size
+	}
+
+	kept = files[:0]
+	for _, f := range files {
+		maxSize := m.targets[f.target].MaxSize
+		if !f.protected && maxSize > 0 && sizes[f.target] > maxSize && remove(f, ReasonSize) {
+			sizes[f.target] -= f.size
+			total -= f.size
+			continue
+		}
+		kept = append(kept, f)
+	}
+	files = kept
+
+	kept = files[:0]
+	for _, f := range files {
+		if !f.protected && m.maxTotal > 0 && total > m.maxTotal && remove(f, ReasonTotal) {
+			total -= f.size
+			continue
+		}
+		kept = append(kept, f)
+	}
+	files = kept
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.lastRun = now
+	for _, t := range m.targets {
+		m.usage[t.Name] = usage{}
+	}
+	for _, f := range files {
+		u := m.usage[m.targets[f.target].Name]
+		u.files++
+		u.bytes += f.size
+		m.usage[m.targets[f.target].Name] = u
+	}
+	for _, event := range events {
+		key := prunedKey{event.Target, event.Reason}
+		p := m.pruned[key]
+		p.files += len(event.Files)
+		p.bytes += event.Bytes
+		m.pruned[key] = p
+	}
+	if total > m.maxTotal && m.maxTotal > 0 {
+		m.logger.Warn("agent files exceed the total size limit; remaining files are in use", "bytes", total, "limit", m.maxTotal)
+	}
+	return events
+}
+
+// scan lists the regular files of every target
+func (m *Manager) scan(now time.Time) []*file {
+	var files []*file
+	for i, t := range m.targets {
+		err := filepath.WalkDir(t.Dir, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			if !entry.Type().IsRegular() {
+				return nil
+			}
+			info, err := entry.Info()
+			if err != nil {
+				// Removed while walking
+				return nil
+			}
+			files = append(files, &file{
+				target:    i,
+				path:      path,
+				size:      info.Size(),
+				modTime:   info.ModTime(),
+				protected: now.Sub(info.ModTime()) < activeWindow || (t.InUse != nil && t.InUse(path)),
+			})
+			return nil
+		})
+		if err != nil {
+			m.logger.Warn("failed to scan agent files", "target", t.Name, "directory", t.Dir, "error", err)
+		}
+	}
+	return files
+}
+
+// notify logs events and delivers them to every notifier
+func (m *Manager) notify(ctx context.Context, events []Event) {
+	for _, event := range events {
+		m.logger.Info("pruned agent files", "target", event.Target, "reason", event.Reason, "files", len(event.Files), "bytes", event.Bytes)
+		for _, n := range m.notifiers {
+			if err := n.Notify(ctx, event); err != nil {
+				m.logger.Warn("failed to deliver prune notification", "target", event.Target, "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package serial
+
+import (
+	"math"
+	"time"
+)
+
+// BatchMode selects how a Batcher groups the reads of a stream
+type BatchMode int
+
+// Batch modes
+const (
+	// BatchAdaptive switches between low latency and throughput by the
+	// observed data rate
+	BatchAdaptive BatchMode = iota
+	// BatchLowLatency sends every read at once
+	BatchLowLatency
+	// BatchThroughput always batches reads
+	BatchThroughput
+)
+
+// Adaptive batching thresholds. The gap between the rates keeps a stream
+// near one of them from flapping between modes.
+const (
+	// BatchHighRate is the rate, in bytes per second, above which an
+	// adaptive stream batches
+	BatchHighRate = 16 << 10
+	// BatchLowRate is the rate below which it sends reads at once again
+	BatchLowRate = 4 << 10
+	// BatchDelay is the longest data is held back for a batch
+	BatchDelay = 20 * time.Millisecond
+	// DefaultBatchSize is the size a batch is sent at
+	DefaultBatchSize = 16 << 10
+)
+
+// batchRateWindow is the time constant of the rate estimate
+const batchRateWindow = time.Second
+
+// Batcher groups the reads of a stream: while data trickles in, such as on
+// an idle console, each read is sent as it arrives; while it floods in,
+// such as from a sensor streaming samples, reads are merged into chunks of
+// up to a batch size, held no longer than BatchDelay. It is not safe for
+// concurrent use.
+type Batcher struct {
+	mode BatchMode
+	size int
+
+	// rate is the decaying estimate of the data rate in bytes per second
+	rate       float64
+	rateAt     time.Time
+	throughput bool
+
+	held   DataEvent
+	heldAt time.Time
+}
+
+// NewBatcher returns a batcher sending chunks of up to size bytes
+// (DefaultBatchSize when not positive)
+func NewBatcher(mode BatchMode, size int) *Batcher {
+	if size <= 0 {
+		size = DefaultBatchSize
+	}
+	return &Batcher{mode: mode, size: size, throughput: mode == BatchThroughput}
+}
+
+// Add takes a read and returns the events to send now
+func (b *Batcher) Add(event DataEvent, now time.Time) []DataEvent {
+	b.measure(len(event.Data), now)
+	if !b.throughput {
+		return append(b.Flush(), event)
+	}
+
+	var out []DataEvent
+	if len(b.held.Data) > 0 && len(b.held.Data)+len(event.Data) > b.size {
+		out = b.Flush()
+	}
+	if len(b.held.Data) == 0 {
+		b.held = DataEvent{Data: append([]byte(nil), event.Data...), Timestamp: event.Timestamp, Sequence: event.Sequence}
+		b.heldAt = now
+	} else {
+		b.held.Data = append(b.held.Data, event.Data...)
+		b.held.Sequence = event.Sequence
+	}
+	if len(b.held.Data) >= b.size {
+		out = append(out, b.Flush()...)
+	}
+	return out
+}
+
+// Tick returns the batch due at now, if any
+func (b *Batcher) Tick(now time.Time) []DataEvent {
+	b.measure(0, now)
+	if len(b.held.Data) > 0 && (!b.throughput || now.Sub(b.heldAt) >= BatchDelay) {
+		return b.Flush()
+	}
+	return nil
+}
+
+// Flush returns the data held back, if any
+func (b *Batcher) Flush() []DataEvent {
+	if len(b.held.Data) == 0 {
+		return nil
+	}
+	held := b.held
+	b.held = DataEvent{}
+	return []DataEvent{held}
+}
+
+// Throughput reports whether reads are being batched
+func (b *Batcher) Throughput() bool {
+	return b.throughput
+}
+
+// Rate returns the estimated data rate in bytes per second
+func (b *Batcher) Rate() float64 {
+	return b.rate
+}
+
+// measure updates the rate estimate with n bytes received at now and
+// switches modes when an adaptive stream crosses the thresholds
+func (b *Batcher) measure(n int, now time.Time) {
+	if !b.rateAt.IsZero() {
+		if elapsed := now.Sub(b.rateAt); elapsed > 0 {
+			b.rate *= math.Exp(-float64(elapsed) / float64(batchRateWindow))
+		}
+	}
+	b.rate += float64(n) / batchRateWindow.Seconds()
+	b.rateAt = now
+
+	if b.mode != BatchAdaptive {
+		return
+	}
+	switch {
+	case !b.throughput && b.rate >= BatchHighRate:
+		b.throughput = true
+	case b.throughput && b.rate < BatchLowRate:
+		b.throughput = false
+	}
+}
@@ -0,0 +1,60 @@
+package serial
+
+import "time"
+
+// CloseReason says why a session ended
+type CloseReason string
+
+// Close reasons
+const (
+	// CloseReasonClient is a close requested by the session's client
+	CloseReasonClient CloseReason = "client"
+	// CloseReasonForced is a close against the client's will, e.g. when
+	// another client's reservation starts or an administrator closes it
+	CloseReasonForced CloseReason = "forced"
+	// CloseReasonDeviceRemoved is a close after the device disappeared
+	CloseReasonDeviceRemoved CloseReason = "device_removed"
+	// CloseReasonShutdown is a close as the agent shuts down
+	CloseReasonShutdown CloseReason = "agent_shutdown"
+)
+
+// ClosedSession describes how the last session of a closed port ended
+type ClosedSession struct {
+	SessionID string
+	ClientID  string
+	Reason    CloseReason
+	ClosedAt  time.Time
+}
+
+// ClosePortWithReason is ClosePort recording why the session ends, for the
+// closed event and the port's status until it is opened again
+func (m *Manager) ClosePortWithReason(portName string, sessionID string, reason CloseReason) error {
+	// Writes held back go out before the port closes
+	if session, err := m.validateSession(portName, sessionID); err == nil {
+		_ = m.flushCoalesced(session)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	session, exists := m.sessions[portName]
+	if !exists {
+		return ErrPortNotOpen
+	}
+
+	if !session.HasID(sessionID) {
+		return ErrInvalidSession
+	}
+
+	return m.closeSessionLocked(session, reason)
+}
+
+// LastClose returns how the last session of a port ended, while the port
+// stays closed
+func (m *Manager) LastClose(portName string) (ClosedSession, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	closed, ok := m.lastClose[portName]
+	return closed, ok
+}
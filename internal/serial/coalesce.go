@@ -0,0 +1,139 @@
+package serial
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/clock"
+)
+
+// MaxCoalesceWindow bounds the time writes may be held back
+const MaxCoalesceWindow = 100 * time.Millisecond
+
+// maxCoalesced bounds the bytes a session holds back; a write that would
+// go over is sent at once, after those held
+const maxCoalesced = 4096
+
+// writeCoalescer holds back the writes of a session for a short window and
+// sends them together, like Nagle's algorithm, so a client sending single
+// characters (e.g. a web terminal) costs one write per window instead of
+// one per byte
+type writeCoalescer struct {
+	window time.Duration
+
+	mu    sync.Mutex
+	held  []byte
+	timer clock.Timer
+	// err is the error of a send in the background, returned by the next
+	// write
+	err error
+
+	// sending orders sends of held data before later writes
+	sending sync.Mutex
+}
+
+// SetWriteCoalescing holds back the session's writes for up to window and
+// sends them in one write; zero sends every write at once. Data held when
+// coalescing is turned off is sent first.
+func (m *Manager) SetWriteCoalescing(portName string, sessionID string, window time.Duration) error {
+	if window < 0 || window > MaxCoalesceWindow {
+		return fmt.Errorf("%w: coalescing window must be between 0 and %s", ErrInvalidConfig, MaxCoalesceWindow)
+	}
+	session, err := m.ValidateSession(portName, sessionID)
+	if err != nil {
+		return err
+	}
+
+	var c *writeCoalescer
+	if window > 0 {
+		c = &writeCoalescer{window: window}
+	}
+	if previous := session.coalescer.Swap(c); previous != nil {
+		return m.sendHeld(session, previous)
+	}
+	return nil
+}
+
+// WriteCoalescing returns the session's coalescing window, zero when off
+func (s *Session) WriteCoalescing() time.Duration {
+	if c := s.coalescer.Load(); c != nil {
+		return c.window
+	}
+	return 0
+}
+
+// writeCoalesced holds data back for the session's window, or sends it at
+// once after the data held when it does not fit
+func (m *Manager) writeCoalesced(session *Session, c *writeCoalescer, data []byte) (int, error) {
+	c.mu.Lock()
+	if err := c.err; err != nil {
+		c.err = nil
+		c.mu.Unlock()
+		return 0, err
+	}
+	if len(c.held)+len(data) <= maxCoalesced {
+		c.held = append(c.held, data...)
+		if c.timer == nil {
+			c.timer = m.clock.AfterFunc(c.window, func() {
+				if err := m.sendHeld(session, c); err != nil {
+					c.mu.Lock()
+					c.err = err
+					c.mu.Unlock()
+				}
+			})
+		}
+		c.mu.Unlock()
+		return len(data), nil
+	}
+	c.mu.Unlock()
+
+	c.sending.Lock()
+	defer c.sending.Unlock()
+	if err := m.sendHeldLocked(session, c); err != nil {
+		return 0, err
+	}
+	return m.writeGated(session, data)
+}
+
+// flushCoalesced sends the data a session holds back, before another write
+// to the port
+func (m *Manager) flushCoalesced(session *Session) error {
+	c := session.coalescer.Load()
+	if c == nil {
+		return nil
+	}
+	return m.sendHeld(session, c)
+}
+
+// sendHeld sends the data held by c
+func (m *Manager) sendHeld(session *Session, c *writeCoalescer) error {
+	c.sending.Lock()
+	defer c.sending.Unlock()
+	return m.sendHeldLocked(session, c)
+}
+
+// sendHeldLocked is sendHeld with c.sending held
+func (m *Manager) sendHeldLocked(session *Session, c *writeCoalescer) error {
+	c.mu.Lock()
+	held := c.held
+	c.held = nil
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+	c.mu.Unlock()
+
+	if len(held) == 0 || session.IsClosed() {
+		return nil
+	}
+	_, err := m.writeGated(session, held)
+	return err
+}
+
+// writeGated writes data in turn with other writers to the port
+func (m *Manager) writeGated(session *Session, data []byte) (int, error) {
+	session.writes.acquire(session.Priority())
+	defer session.writes.release()
+	return m.writeShaped(session, data)
+}
@@ -0,0 +1,160 @@
+package serial
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DeviceProfile maps a USB VID/PID to a device family and the line settings
+// such devices usually need. Zero values leave the default setting alone.
+type DeviceProfile struct {
+	VID string
+	// PID may be empty to match every product of the vendor
+	PID         string
+	Family      string
+	BaudRate    int
+	DataBits    int
+	StopBits    int
+	Parity      string
+	FlowControl string
+	// LatencyProfile is "low" for devices driven in tight request/response
+	// loops
+	LatencyProfile string
+	// DTR and RTS are line states, e.g. "untouched" for boards that reset
+	// on a DTR edge
+	DTR string
+	RTS string
+}
+
+// builtinDeviceProfiles is the shipped device database. USB-serial bridge
+// chips carry no settings since they can be attached to anything; entries
+// for complete devices suggest the settings they ship with.
+var builtinDeviceProfiles = []DeviceProfile{
+	// USB-serial bridges
+	{VID: "0403", PID: "6001", Family: "FTDI FT232R"},
+	{VID: "0403", PID: "6010", Family: "FTDI FT2232"},
+	{VID: "0403", PID: "6011", Family: "FTDI FT4232"},
+	{VID: "0403", PID: "6014", Family: "FTDI FT232H"},
+	{VID: "0403", PID: "6015", Family: "FTDI FT-X"},
+	{VID: "0403", Family: "FTDI"},
+	{VID: "10c4", PID: "ea60", Family: "Silicon Labs CP210x"},
+	{VID: "10c4", PID: "ea70", Family: "Silicon Labs CP2105"},
+	{VID: "10c4", PID: "ea71", Family: "Silicon Labs CP2108"},
+	{VID: "1a86", PID: "7523", Family: "WCH CH340"},
+	{VID: "1a86", PID: "55d4", Family: "WCH CH9102"},
+	{VID: "067b", PID: "2303", Family: "Prolific PL2303"},
+
+	// GNSS receivers: NMEA output at 9600 8N1 out of the box
+	{VID: "1546", PID: "01a5", Family: "u-blox 5 GPS", BaudRate: 9600, DataBits: 8, StopBits: 1, Parity: "none"},
+	{VID: "1546", PID: "01a6", Family: "u-blox 6 GPS", BaudRate: 9600, DataBits: 8, StopBits: 1, Parity: "none"},
+	{VID: "1546", PID: "01a7", Family: "u-blox 7 GPS", BaudRate: 9600, DataBits: 8, StopBits: 1, Parity: "none"},
+	{VID: "1546", PID: "01a8", Family: "u-blox M8 GPS", BaudRate: 9600, DataBits: 8, StopBits: 1, Parity: "none"},
+	{VID: "1546", PID: "01a9", Family: "u-blox M9 GPS", BaudRate: 38400, DataBits: 8, StopBits: 1, Parity: "none"},
+
+	// Development boards
+	{VID: "2341", Family: "Arduino", BaudRate: 9600, DataBits: 8, StopBits: 1, Parity: "none"},
+	{VID: "303a", PID: "1001", Family: "Espressif USB Serial/JTAG", BaudRate: 115200, DataBits: 8, StopBits: 1, Parity: "none"},
+	{VID: "2e8a", PID: "000a", Family: "Raspberry Pi Pico", BaudRate: 115200, DataBits: 8, StopBits: 1, Parity: "none"},
+
+	// Industrial (Modbus RTU) gateways: 9600 8E1 per the Modbus serial line spec
+	{VID: "110a", Family: "Moxa UPort", BaudRate: 9600, DataBits: 8, StopBits: 1, Parity: "even"},
+}
+
+// DeviceDatabase looks up device profiles by VID/PID
+type DeviceDatabase struct {
+	profiles []DeviceProfile
+}
+
+// NewDeviceDatabase creates a database of the built-in profiles extended by
+// extra. Extra profiles take precedence over built-in ones.
+func NewDeviceDatabase(extra []DeviceProfile) *DeviceDatabase {
+	profiles := make([]DeviceProfile, 0, len(extra)+len(builtinDeviceProfiles))
+	profiles = append(profiles, extra...)
+	profiles = append(profiles, builtinDeviceProfiles...)
+	return &DeviceDatabase{profiles: profiles}
+}
+
+// Lookup returns the profile for a VID/PID, preferring an exact product match
+// over a vendor-wide entry. It returns nil for unknown devices.
+func (d *DeviceDatabase) Lookup(vid, pid string) *DeviceProfile {
+	if d == nil || vid == "" {
+		return nil
+	}
+
+	var vendorMatch *DeviceProfile
+	for i := range d.profiles {
+		p := &d.profiles[i]
+		if !strings.EqualFold(p.VID, vid) {
+			continue
+		}
+		if p.PID == "" {
+			if vendorMatch == nil {
+				vendorMatch = p
+			}
+			continue
+		}
+		if strings.EqualFold(p.PID, pid) {
+			return p
+		}
+	}
+	return vendorMatch
+}
+
+// Apply returns base with the profile's settings applied
+func (p DeviceProfile) Apply(base PortConfig) (PortConfig, error) {
+	config := base
+	if p.BaudRate > 0 {
+		config.BaudRate = p.BaudRate
+	}
+	if p.DataBits > 0 {
+		config.DataBits = p.DataBits
+	}
+	if p.StopBits > 0 {
+		stopBits, err := ParseStopBits(p.StopBits)
+		if err != nil {
+			return base, err
+		}
+		config.StopBits = stopBits
+	}
+	if p.Parity != "" {
+		parity, err := ParseParity(p.Parity)
+		if err != nil {
+			return base, err
+		}
+		config.Parity = parity
+	}
+	if p.FlowControl != "" {
+		flowControl, err := ParseFlowControl(p.FlowControl)
+		if err != nil {
+			return base, err
+		}
+		config.FlowControl = flowControl
+	}
+	if p.LatencyProfile != "" {
+		profile, err := ParseLatencyProfile(p.LatencyProfile)
+		if err != nil {
+			return base, err
+		}
+		config.LatencyProfile = profile
+	}
+	if p.DTR != "" {
+		dtr, err := ParseLineState(p.DTR)
+		if err != nil {
+			return base, fmt.Errorf("dtr: %w", err)
+		}
+		config.DTR = dtr
+	}
+	if p.RTS != "" {
+		rts, err := ParseLineState(p.RTS)
+		if err != nil {
+			return base, fmt.Errorf("rts: %w", err)
+		}
+		config.RTS = rts
+	}
+	return config, config.Validate()
+}
+
+// HasSettings reports whether the profile changes any line setting
+func (p DeviceProfile) HasSettings() bool {
+	return p.BaudRate > 0 || p.DataBits > 0 || p.StopBits > 0 || p.Parity != "" || p.FlowControl != "" || p.LatencyProfile != "" || p.DTR != "" || p.RTS != ""
+}
@@ -0,0 +1,160 @@
+package serial
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DiagnoseOptions configures a line parameter sweep
+type DiagnoseOptions struct {
+	// BaudRates to try; common rates when empty
+	BaudRates []int
+	// Sample is how long output is collected per candidate
+	Sample time.Duration
+	// Probe is written after each reconfiguration to prompt silent devices
+	Probe []byte
+}
+
+// LineCandidate is one parameter combination and how readable it was
+type LineCandidate struct {
+	Config PortConfig
+	// Score is the share of text bytes received, 0 when nothing arrived
+	Score         float64
+	BytesReceived int
+	// Sample holds the first bytes received with these settings
+	Sample []byte
+}
+
+// diagnoseSampleSize bounds the sample kept per candidate
+const diagnoseSampleSize = 64
+
+// diagnoseBaudRates are tried when no rates are given, most common first
+var diagnoseBaudRates = []int{115200, 9600, 57600, 38400, 19200, 4800, 2400, 1200, 230400, 460800, 921600}
+
+// diagnoseFramings are the data bits/parity combinations tried per baud rate
+var diagnoseFramings = []struct {
+	dataBits int
+	parity   Parity
+}{
+	{8, ParityNone},
+	{7, ParityEven},
+	{7, ParityOdd},
+	{8, ParityEven},
+	{8, ParityOdd},
+}
+
+// DiagnoseLine cycles an open port through common baud rate, data bits and
+// parity combinations, scores how readable the received output is for each,
+// and returns the candidates best first. The session's configuration is
+// restored afterwards. The port is unavailable to other operations while
+// the sweep runs.
+func (m *Manager) DiagnoseLine(ctx context.Context, portName, sessionID string, opts DiagnoseOptions) ([]LineCandidate, error) {
+	session, err := m.ValidateSession(portName, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.filterWrite(session, opts.Probe); err != nil {
+		return nil, err
+	}
+
+	bauds := opts.BaudRates
+	if len(bauds) == 0 {
+		bauds = diagnoseBaudRates
+	}
+	sample := opts.Sample
+	if sample <= 0 {
+		sample = 500 * time.Millisecond
+	}
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	original := session.Config
+	defer func() {
+		_ = session.port.SetMode(original.ToSerialMode())
+		if original.ReadTimeoutMs > 0 {
+			_ = session.port.SetReadTimeout(time.Duration(original.ReadTimeoutMs) * time.Millisecond)
+		}
+	}()
+
+	// Short reads so each sample ends on time
+	if err := session.port.SetReadTimeout(50 * time.Millisecond); err != nil {
+		return nil, fmt.Errorf("failed to set read timeout: %w", err)
+	}
+
+	var candidates []LineCandidate
+	for _, baud := range bauds {
+		for _, framing := range diagnoseFramings {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			config := original
+			config.BaudRate = baud
+			config.DataBits = framing.dataBits
+			config.Parity = framing.parity
+			if err := config.Validate(); err != nil {
+				return nil, err
+			}
+
+			candidate, err := sampleLine(ctx, session, config, sample, opts.Probe)
+			if err != nil {
+				return nil, err
+			}
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].BytesReceived > candidates[j].BytesReceived
+	})
+
+	return candidates, nil
+}
+
+// sampleLine applies config, optionally sends the probe and collects output
+// for the sample duration (session lock held)
+func sampleLine(ctx context.Context, session *Session, config PortConfig, sample time.Duration, probe []byte) (LineCandidate, error) {
+	candidate := LineCandidate{Config: config}
+
+	if err := session.port.SetMode(config.ToSerialMode()); err != nil {
+		return candidate, fmt.Errorf("failed to apply %d baud: %w", config.BaudRate, err)
+	}
+	_ = session.port.ResetInputBuffer()
+
+	if len(probe) > 0 {
+		if _, err := session.port.Write(probe); err != nil {
+			return candidate, fmt.Errorf("failed to write probe: %w", err)
+		}
+	}
+
+	quality := &lineQuality{}
+	garbage := 0
+	buffer := make([]byte, 1024)
+	deadline := time.Now().Add(sample)
+	for time.Now().Before(deadline) && ctx.Err() == nil {
+		n, err := session.port.Read(buffer)
+		if err != nil {
+			return candidate, fmt.Errorf("read failed: %w", err)
+		}
+		if n == 0 {
+			continue
+		}
+
+		garbage += quality.analyze(buffer[:n]).garbage
+		candidate.BytesReceived += n
+		if len(candidate.Sample) < diagnoseSampleSize {
+			candidate.Sample = append(candidate.Sample, buffer[:min(n, diagnoseSampleSize-len(candidate.Sample))]...)
+		}
+	}
+
+	if candidate.BytesReceived > 0 {
+		candidate.Score = 1 - float64(garbage)/float64(candidate.BytesReceived)
+	}
+	return candidate, nil
+}
@@ -0,0 +1,111 @@
+package serial
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Error classes
+const (
+	// ErrorClassTemporary is a temporary error that outlasted the retries
+	ErrorClassTemporary = "temporary"
+	// ErrorClassFatal is an error that is not retried, e.g. an unplugged
+	// device
+	ErrorClassFatal = "fatal"
+)
+
+// maxRecentErrors is the number of errors kept per session
+const maxRecentErrors = 32
+
+// ErrorRecord is a failed operation on a session
+type ErrorRecord struct {
+	Timestamp time.Time
+	// Operation is what failed: read, write, scheduled_write, sync_write or
+	// timed_read
+	Operation string
+	Class     string
+	Message   string
+}
+
+// errorLog keeps the last maxRecentErrors errors of a session
+type errorLog struct {
+	mu      sync.Mutex
+	records []ErrorRecord
+	next    int
+}
+
+// add keeps a record, replacing the oldest once full
+func (l *errorLog) add(record ErrorRecord) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.records) < maxRecentErrors {
+		l.records = append(l.records, record)
+		return
+	}
+	l.records[l.next] = record
+	l.next = (l.next + 1) % maxRecentErrors
+}
+
+// recent returns up to limit of the latest records, oldest first (0 for all)
+func (l *errorLog) recent(limit int) []ErrorRecord {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	records := make([]ErrorRecord, 0, len(l.records))
+	records = append(records, l.records[l.next:]...)
+	records = append(records, l.records[:l.next]...)
+	if limit > 0 && len(records) > limit {
+		records = records[len(records)-limit:]
+	}
+	return records
+}
+
+// classifyError returns the class of an error reported to a client
+func classifyError(err error) string {
+	if IsTemporary(err) {
+		return ErrorClassTemporary
+	}
+	return ErrorClassFatal
+}
+
+// recordError counts a failed operation on a session, keeps it in the
+// session's recent errors and publishes it as an error event
+func (m *Manager) recordError(session *Session, operation string, err error) {
+	atomic.AddUint64(&session.Statistics.Errors, 1)
+
+	record := ErrorRecord{
+		Timestamp: time.Now(),
+		Operation: operation,
+		Class:     classifyError(err),
+		Message:   err.Error(),
+	}
+	session.errors.add(record)
+	if session.IsClosed() {
+		// The port was closed under the operation
+		return
+	}
+	m.emitEventMessage(PortEventError, session, fmt.Sprintf("%s %s error: %s", operation, record.Class, record.Message))
+}
+
+// RecentErrors returns up to limit of the latest errors of a session,
+// oldest first (0 for all). An empty sessionID selects the port's current
+// session.
+func (m *Manager) RecentErrors(portName, sessionID string, limit int) (*Session, []ErrorRecord, error) {
+	var session *Session
+	if sessionID == "" {
+		session = m.GetSession(portName)
+		if session == nil {
+			return nil, nil, ErrPortNotOpen
+		}
+	} else {
+		var err error
+		session, err = m.ValidateSession(portName, sessionID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return session, session.errors.recent(limit), nil
+}
@@ -31,4 +31,16 @@ var (
 
 	// ErrPortClosed is returned when port has been closed during operation
 	ErrPortClosed = errors.New("port has been closed")
+
+	// ErrScheduleInvalid is returned when a scheduled write time has already
+	// passed or is too far ahead
+	ErrScheduleInvalid = errors.New("invalid scheduled write time")
+
+	// ErrInitFailed is returned when the initialization sequence run while
+	// opening a port fails; the port is closed again
+	ErrInitFailed = errors.New("port initialization failed")
+
+	// ErrScanTimeout is returned when a scan provider takes too long to list
+	// its ports
+	ErrScanTimeout = errors.New("port scan timed out")
 )
@@ -0,0 +1,121 @@
+package serial
+
+import (
+	"time"
+)
+
+// PortEventType identifies a port lifecycle event
+type PortEventType string
+
+// Port event types
+const (
+	PortEventOpened     PortEventType = "opened"
+	PortEventClosed     PortEventType = "closed"
+	PortEventConfigured PortEventType = "configured"
+	// PortEventLineQuality carries a line-quality warning in Message
+	PortEventLineQuality PortEventType = "line_quality"
+	// PortEventAlarm carries a threshold alarm change in Message
+	PortEventAlarm PortEventType = "alarm"
+	// PortEventDeviceState carries a device state transition in Message
+	PortEventDeviceState PortEventType = "device_state"
+	// PortEventPowerState carries a session's new power state in Message
+	PortEventPowerState PortEventType = "power_state"
+	// PortEventError carries a failed read or write on the session in
+	// Message
+	PortEventError PortEventType = "error"
+	// PortEventCrashDump carries a crash dump saved from console output in
+	// Message
+	PortEventCrashDump PortEventType = "crash_dump"
+	// PortEventNotice carries a message to the session's client in
+	// Message, such as an administrator's warning before closing it
+	PortEventNotice PortEventType = "notice"
+)
+
+// PortEvent describes a change to a port session
+type PortEvent struct {
+	Type      PortEventType
+	PortName  string
+	SessionID string
+	ClientID  string
+	Message   string
+	// CloseReason says why the session ended (closed events)
+	CloseReason CloseReason
+	Timestamp   time.Time
+	// Metadata is the session's metadata
+	Metadata map[string]string
+}
+
+// SubscribeEvents creates a channel that receives port lifecycle events for
+// all ports. Slow subscribers miss events rather than blocking the manager.
+func (m *Manager) SubscribeEvents() <-chan PortEvent {
+	ch := make(chan PortEvent, 32)
+
+	m.eventsMu.Lock()
+	m.eventSubs = append(m.eventSubs, ch)
+	m.eventsMu.Unlock()
+
+	return ch
+}
+
+// UnsubscribeEvents removes an event subscription
+func (m *Manager) UnsubscribeEvents(ch <-chan PortEvent) {
+	m.eventsMu.Lock()
+	defer m.eventsMu.Unlock()
+
+	for i, sub := range m.eventSubs {
+		if sub == ch {
+			close(sub)
+			m.eventSubs = append(m.eventSubs[:i], m.eventSubs[i+1:]...)
+			return
+		}
+	}
+}
+
+// emitEvent sends an event for a session to all subscribers
+func (m *Manager) emitEvent(eventType PortEventType, session *Session) {
+	m.emitEventMessage(eventType, session, "")
+}
+
+// emitEventMessage sends an event with a message to all subscribers
+func (m *Manager) emitEventMessage(eventType PortEventType, session *Session, message string) {
+	m.broadcastEvent(PortEvent{
+		Type:      eventType,
+		PortName:  session.PortName,
+		SessionID: session.ID,
+		ClientID:  session.ClientID,
+		Message:   message,
+		Timestamp: time.Now(),
+		Metadata:  session.Metadata,
+	})
+}
+
+// EmitPortEvent publishes an event about a port on behalf of another
+// subsystem, such as alarms. The port's current session, if any, is attached.
+func (m *Manager) EmitPortEvent(portName string, eventType PortEventType, message string) {
+	event := PortEvent{
+		Type:      eventType,
+		PortName:  portName,
+		Message:   message,
+		Timestamp: time.Now(),
+	}
+	if session := m.GetSession(portName); session != nil {
+		event.SessionID = session.ID
+		event.ClientID = session.ClientID
+		event.Metadata = session.Metadata
+	}
+	m.broadcastEvent(event)
+}
+
+// broadcastEvent sends an event to all subscribers
+func (m *Manager) broadcastEvent(event PortEvent) {
+	m.eventsMu.RLock()
+	defer m.eventsMu.RUnlock()
+
+	for _, ch := range m.eventSubs {
+		select {
+		case ch <- event:
+		default:
+			// Subscriber too slow, drop the event
+		}
+	}
+}
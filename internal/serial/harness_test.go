@@ -0,0 +1,120 @@
+package serial
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/clock"
+)
+
+// deviceTimeout bounds how long a test waits on the simulated device before
+// failing; tests never wait it out when they pass
+const deviceTimeout = 5 * time.Second
+
+// virtualDevice is a simulated device at the far end of a tcp:// port
+type virtualDevice struct {
+	t    *testing.T
+	conn net.Conn
+}
+
+// newVirtualPort listens for the manager on a loopback tcp:// port. accept
+// returns the device once the port has been opened.
+func newVirtualPort(t *testing.T) (name string, accept func() *virtualDevice) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	accept = func() *virtualDevice {
+		t.Helper()
+		conn, err := listener.Accept()
+		if err != nil {
+			t.Fatalf("accept: %v", err)
+		}
+		t.Cleanup(func() { conn.Close() })
+		return &virtualDevice{t: t, conn: conn}
+	}
+	return tcpPortPrefix + listener.Addr().String(), accept
+}
+
+// openVirtual opens a virtual port on a manager timed by clk and returns
+// the session and the device behind it
+func openVirtual(t *testing.T, clk clock.Clock) (*Manager, *Session, *virtualDevice) {
+	t.Helper()
+	m := NewManager(false, DefaultConfig())
+	m.SetClock(clk)
+	t.Cleanup(m.CloseAll)
+
+	name, accept := newVirtualPort(t)
+	session, err := m.OpenPort(name, DefaultConfig(), "test", true)
+	if err != nil {
+		t.Fatalf("open %s: %v", name, err)
+	}
+	return m, session, accept()
+}
+
+// expect reads len(want) bytes from the device and fails unless they are
+// want
+func (d *virtualDevice) expect(want string) {
+	d.t.Helper()
+	_ = d.conn.SetReadDeadline(time.Now().Add(deviceTimeout))
+	got := make([]byte, len(want))
+	if _, err := io.ReadFull(d.conn, got); err != nil {
+		d.t.Fatalf("device read: %v (got %q, want %q)", err, got, want)
+	}
+	if string(got) != want {
+		d.t.Fatalf("device received %q, want %q", got, want)
+	}
+}
+
+// respond answers every line the device receives with answer(line), until
+// the port closes
+func (d *virtualDevice) respond(answer func(line string) string) {
+	go func() {
+		lines := bufio.NewScanner(d.conn)
+		lines.Split(scanCRLines)
+		for lines.Scan() {
+			if _, err := io.WriteString(d.conn, answer(lines.Text())); err != nil {
+				return
+			}
+		}
+	}()
+}
+
+// scanCRLines splits at CR or LF, as modems and instruments end commands
+func scanCRLines(data []byte, atEOF bool) (int, []byte, error) {
+	if i := bytes.IndexAny(data, "\r\n"); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// readUntil reads from a Transact reader until the data received contains
+// suffix, giving up after five read timeouts without data
+func readUntil(r io.Reader, suffix string) (string, error) {
+	var received strings.Builder
+	buf := make([]byte, 64)
+	for empty := 0; !strings.Contains(received.String(), suffix); {
+		n, err := r.Read(buf)
+		if err != nil {
+			return received.String(), err
+		}
+		if n == 0 {
+			if empty++; empty == 5 {
+				return received.String(), io.ErrNoProgress
+			}
+		}
+		received.Write(buf[:n])
+	}
+	return received.String(), nil
+}
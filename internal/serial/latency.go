@@ -0,0 +1,39 @@
+package serial
+
+// lowLatencyTimerMs is the FTDI latency timer of the low latency profile.
+// The driver default of 16 ms delays short replies by up to that long.
+const lowLatencyTimerMs = 1
+
+// applyLatencyProfile tunes a local port, whose descriptor is fd or -1, for
+// a latency profile. It returns a function undoing changes that outlive the
+// port, such as the FTDI latency timer, or nil when there is nothing to
+// undo.
+func applyLatencyProfile(portName string, fd int, profile LatencyProfile) (func(), error) {
+	if profile != LatencyProfileLow || IsNetworkPort(portName) {
+		return nil, nil
+	}
+	return platform.tuneLowLatency(portName, fd)
+}
+
+// setLatencyProfile switches a session to the profile of config, undoing
+// the previous profile first (session lock held)
+func (s *Session) setLatencyProfile(config PortConfig) error {
+	if config.LatencyProfile == s.Config.LatencyProfile {
+		return nil
+	}
+	s.restoreLatency()
+	restore, err := applyLatencyProfile(s.PortName, s.fd, config.LatencyProfile)
+	if err != nil {
+		return err
+	}
+	s.latencyRestore = restore
+	return nil
+}
+
+// restoreLatency undoes the session's latency profile, if any
+func (s *Session) restoreLatency() {
+	if s.latencyRestore != nil {
+		s.latencyRestore()
+		s.latencyRestore = nil
+	}
+}
@@ -0,0 +1,65 @@
+//go:build linux
+
+package serial
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+// sysClassTTY is where the kernel exposes tty devices and their drivers
+const sysClassTTY = "/sys/class/tty"
+
+// tuneLowLatency lowers the latency timer of FTDI adapters through sysfs and
+// makes reads return as soon as one byte arrives (VMIN=1, VTIME=0). The
+// latency timer belongs to the device rather than the open file, so the
+// returned function puts the previous value back.
+func (linuxPlatform) tuneLowLatency(portName string, fd int) (func(), error) {
+	device, err := filepath.EvalSymlinks(portName)
+	if err != nil {
+		return nil, err
+	}
+
+	if fd >= 0 {
+		if err := setReadReturnsEarly(fd); err != nil {
+			return nil, fmt.Errorf("failed to set VMIN/VTIME: %w", err)
+		}
+	}
+
+	// Only ftdi_sio exposes a latency timer; other drivers return data
+	// without one
+	timerPath := filepath.Join(sysClassTTY, filepath.Base(device), "device", "latency_timer")
+	previous, err := os.ReadFile(timerPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read latency timer: %w", err)
+	}
+	if err := os.WriteFile(timerPath, []byte(strconv.Itoa(lowLatencyTimerMs)), 0o644); err != nil {
+		return nil, fmt.Errorf("failed to set latency timer: %w", err)
+	}
+	return func() {
+		_ = os.WriteFile(timerPath, []byte(strings.TrimSpace(string(previous))), 0o644)
+	}, nil
+}
+
+// setReadReturnsEarly sets VMIN=1 and VTIME=0 on the port's descriptor
+func setReadReturnsEarly(fd int) error {
+	termios, err := unix.IoctlGetTermios(fd, unix.TCGETS)
+	if err != nil {
+		return err
+	}
+	if termios.Cc[unix.VMIN] == 1 && termios.Cc[unix.VTIME] == 0 {
+		return nil
+	}
+	termios.Cc[unix.VMIN] = 1
+	termios.Cc[unix.VTIME] = 0
+	return unix.IoctlSetTermios(fd, unix.TCSETS, termios)
+}
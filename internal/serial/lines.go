@@ -0,0 +1,24 @@
+package serial
+
+import "go.bug.st/serial"
+
+// setInitialLines puts the control lines of a newly opened port in the
+// states of config. Lines set through the mode on open are set again,
+// which is harmless and covers network ports, whose mode carries no lines.
+func setInitialLines(portName string, port serial.Port, fd int, config PortConfig) error {
+	if config.DTR.explicit() {
+		if err := port.SetDTR(config.DTR == LineStateHigh); err != nil {
+			return err
+		}
+	}
+	if config.RTS.explicit() {
+		if err := port.SetRTS(config.RTS == LineStateHigh); err != nil {
+			return err
+		}
+	}
+
+	if (config.DTR == LineStateUntouched || config.RTS == LineStateUntouched) && !IsNetworkPort(portName) {
+		return platform.keepLinesOnClose(fd)
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+//go:build linux
+
+package serial
+
+import "golang.org/x/sys/unix"
+
+// keepLinesOnClose clears HUPCL, which makes the kernel drop DTR and RTS
+// when the last descriptor of the port closes. The flag belongs to the
+// tty, so later opens keep the lines up until it is set again.
+func (linuxPlatform) keepLinesOnClose(fd int) error {
+	if fd < 0 {
+		return nil
+	}
+
+	termios, err := unix.IoctlGetTermios(fd, unix.TCGETS)
+	if err != nil {
+		return err
+	}
+	if termios.Cflag&unix.HUPCL == 0 {
+		return nil
+	}
+	termios.Cflag &^= unix.HUPCL
+	return unix.IoctlSetTermios(fd, unix.TCSETS, termios)
+}
@@ -2,10 +2,13 @@ package serial
 
 import (
 	"fmt"
+	"io"
+	"maps"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/Shoaibashk/SerialLink/internal/clock"
 	"github.com/charmbracelet/log"
 	"github.com/google/uuid"
 	"go.bug.st/serial"
@@ -22,8 +25,42 @@ type Session struct {
 	port       serial.Port
 	mu         sync.Mutex
 	closed     atomic.Bool
-	readers    []chan []byte
+	readers    []*dataQueue[[]byte]
 	readersMu  sync.RWMutex
+	quality    *lineQuality
+	traffic    *trafficLog
+	// latencyRestore undoes the latency profile when the port closes
+	latencyRestore func()
+	// fd is the port's descriptor for polling, or -1
+	fd int
+	// pollWake interrupts polls of fd, or is -1
+	pollWake int
+	// priority ranks the session's writes and streams
+	priority atomic.Int32
+	// writes orders writers to the port by priority
+	writes *writeGate
+	// memory is guarded by the manager's memory account
+	memory sessionMemory
+	// power pauses the session while it is dormant
+	power sessionPower
+	// initializing is set while the open's init sequence runs
+	initializing atomic.Bool
+	// retry is the manager's retry policy when the session opened
+	retry RetryPolicy
+	// errors keeps the session's recent errors
+	errors errorLog
+	// owner is the authenticated identity of the opener, then its groups
+	owner atomic.Value
+	// Metadata is what the client said about the session when opening it,
+	// e.g. purpose or operator (read-only)
+	Metadata map[string]string
+	// ShortID is a human-friendly alternative to ID, or "" when short IDs
+	// are disabled
+	ShortID string
+	// shaping throttles the session's traffic, nil when unlimited
+	shaping atomic.Pointer[shaper]
+	// coalescer holds back small writes, nil when writes go out at once
+	coalescer atomic.Pointer[writeCoalescer]
 }
 
 // IsClosed returns whether the session has been closed
@@ -31,6 +68,28 @@ func (s *Session) IsClosed() bool {
 	return s.closed.Load()
 }
 
+// Owner returns the authenticated identity of the client that opened the
+// session, or "" for sessions opened by the agent itself
+func (s *Session) Owner() string {
+	if owner := s.OwnerIdentities(); len(owner) > 0 {
+		return owner[0]
+	}
+	return ""
+}
+
+// OwnerIdentities returns the authenticated identity of the session's
+// opener followed by its groups, or nil for sessions opened by the agent
+func (s *Session) OwnerIdentities() []string {
+	owner, _ := s.owner.Load().([]string)
+	return owner
+}
+
+// SetOwner records the authenticated identity of the session's opener and
+// the groups it belongs to
+func (s *Session) SetOwner(identity string, groups ...string) {
+	s.owner.Store(append([]string{identity}, groups...))
+}
+
 // Manager handles serial port sessions and operations
 type Manager struct {
 	mu                sync.RWMutex
@@ -38,6 +97,25 @@ type Manager struct {
 	sessionsByID      map[string]*Session // key: session ID
 	allowSharedAccess bool
 	defaultConfig     PortConfig
+	eventSubs         []chan PortEvent
+	eventsMu          sync.RWMutex
+	monitorQuality    bool
+	trafficLines      int
+	memory            memoryAccount
+	retry             RetryPolicy
+	// lastClose records how the last session of each closed port ended
+	lastClose map[string]ClosedSession
+	// shortIDs makes short session IDs, nil when disabled
+	shortIDs          ShortIDGenerator
+	sessionsByShortID map[string]*Session
+	// totals carries the traffic of closed sessions
+	totals closedTotals
+	// portTotals carries it by port
+	portTotals map[string]*closedTotals
+	// writeFilter refuses writes, nil when every write goes out
+	writeFilter atomic.Pointer[WriteFilter]
+	// clock times write deadlines and coalescing windows
+	clock clock.Clock
 }
 
 // NewManager creates a new serial port manager
@@ -45,13 +123,79 @@ func NewManager(allowSharedAccess bool, defaultConfig PortConfig) *Manager {
 	return &Manager{
 		sessions:          make(map[string]*Session),
 		sessionsByID:      make(map[string]*Session),
+		lastClose:         make(map[string]ClosedSession),
+		sessionsByShortID: make(map[string]*Session),
+		portTotals:        make(map[string]*closedTotals),
 		allowSharedAccess: allowSharedAccess,
 		defaultConfig:     defaultConfig,
+		memory:            memoryAccount{limits: DefaultMemoryLimits()},
+		retry:             DefaultRetryPolicy(),
+		clock:             clock.System,
 	}
 }
 
+// SetClock replaces the wall clock timing write deadlines and coalescing
+// windows, for tests. Call before opening ports.
+func (m *Manager) SetClock(c clock.Clock) {
+	m.clock = c
+}
+
+// SetLineQualityMonitoring enables passive line-quality analysis for
+// sessions opened afterwards. It assumes ports carry text.
+func (m *Manager) SetLineQualityMonitoring(enabled bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.monitorQuality = enabled
+}
+
 // OpenPort opens a serial port and creates a new session
 func (m *Manager) OpenPort(portName string, config PortConfig, clientID string, exclusive bool) (*Session, error) {
+	return m.OpenPortWithInit(portName, config, clientID, exclusive, nil, 0, nil)
+}
+
+// OpenPortWithInit opens a port like OpenPort, then runs initialize with
+// raw access to it as in Transact before the session is announced or
+// returned, so no other traffic interleaves with device initialization.
+// Reads on the ReadWriter return (0, nil) after readTimeout without data.
+// Initialization needs the port to itself and is refused with ErrPortLocked
+// when the port is shared. When it fails the port is closed again and
+// ErrInitFailed is returned. Metadata is attached to the session and its
+// events as given.
+func (m *Manager) OpenPortWithInit(portName string, config PortConfig, clientID string, exclusive bool, metadata map[string]string, readTimeout time.Duration, initialize func(rw io.ReadWriter) error) (*Session, error) {
+	session, err := m.openSession(portName, config, clientID, exclusive, metadata, initialize != nil)
+	if err != nil {
+		return nil, err
+	}
+	if initialize == nil {
+		return session, nil
+	}
+
+	// The session is registered with its locks held; initialize it, then
+	// let everyone else in
+	err = session.port.SetReadTimeout(readTimeout)
+	if err == nil {
+		err = initialize(&transactConn{manager: m, session: session})
+		_ = session.port.SetReadTimeout(session.readTimeout())
+	}
+	session.mu.Unlock()
+	session.writes.release()
+	if err != nil {
+		m.mu.Lock()
+		if m.sessions[portName] == session {
+			m.discardSessionLocked(session)
+		}
+		m.mu.Unlock()
+		return nil, fmt.Errorf("%w: %v", ErrInitFailed, err)
+	}
+
+	session.initializing.Store(false)
+	m.emitEvent(PortEventOpened, session)
+	return session, nil
+}
+
+// openSession opens a port and registers its session. With hold set the
+// session is returned with its write gate and lock held and not announced.
+func (m *Manager) openSession(portName string, config PortConfig, clientID string, exclusive bool, metadata map[string]string, hold bool) (*Session, error) {
 	if err := config.Validate(); err != nil {
 		return nil, err
 	}
@@ -60,17 +204,26 @@ func (m *Manager) OpenPort(portName string, config PortConfig, clientID string,
 	defer m.mu.Unlock()
 
 	// Check if port is already open
-	if existingSession, exists := m.sessions[portName]; exists {
-		if existingSession.Exclusive || exclusive || !m.allowSharedAccess {
+	writes := newWriteGate(m.clock)
+	existingSession, joining := m.sessions[portName]
+	if joining {
+		if existingSession.Exclusive || exclusive || !m.allowSharedAccess || hold || existingSession.initializing.Load() {
 			return nil, ErrPortLocked
 		}
+		writes = existingSession.writes
 	}
 
-	// Open the serial port
-	port, err := serial.Open(portName, config.ToSerialMode())
+	// Open the serial port (local device or tcp:// / rfc2217:// endpoint).
+	// A session joining a shared port leaves the lines to the first.
+	openConfig := config
+	if joining {
+		openConfig.DTR, openConfig.RTS = LineStateDefault, LineStateDefault
+	}
+	port, err := openPort(portName, openConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open port %s: %w", portName, err)
 	}
+	fd := platform.portDescriptor(portName, port)
 
 	// Set read timeout
 	if config.ReadTimeoutMs > 0 {
@@ -80,6 +233,17 @@ func (m *Manager) OpenPort(portName string, config PortConfig, clientID string,
 		}
 	}
 
+	if err := setInitialLines(portName, port, fd, openConfig); err != nil {
+		port.Close()
+		return nil, fmt.Errorf("failed to set control lines: %w", err)
+	}
+
+	latencyRestore, err := applyLatencyProfile(portName, fd, config.LatencyProfile)
+	if err != nil {
+		port.Close()
+		return nil, fmt.Errorf("failed to apply latency profile: %w", err)
+	}
+
 	// Create session
 	session := &Session{
 		ID:        uuid.New().String(),
@@ -87,45 +251,93 @@ func (m *Manager) OpenPort(portName string, config PortConfig, clientID string,
 		ClientID:  clientID,
 		Exclusive: exclusive,
 		Config:    config,
+		Metadata:  maps.Clone(metadata),
+		ShortID:   m.newShortID(portName),
 		Statistics: PortStatistics{
 			OpenedAt:     time.Now(),
 			LastActivity: time.Now(),
+			LineQuality:  1,
 		},
-		port:    port,
-		readers: make([]chan []byte, 0),
+		port:           port,
+		readers:        make([]*dataQueue[[]byte], 0),
+		latencyRestore: latencyRestore,
+		fd:             fd,
+		pollWake:       -1,
+		writes:         writes,
+		retry:          m.retry,
+	}
+	if fd >= 0 {
+		session.pollWake = platform.newPollWake()
+	}
+	if m.monitorQuality {
+		session.quality = &lineQuality{}
+	}
+	if m.trafficLines > 0 {
+		session.traffic = newTrafficLog(m.trafficLines)
+	}
+
+	if hold {
+		session.initializing.Store(true)
+		session.writes.acquire(session.Priority())
+		session.mu.Lock()
 	}
 
 	m.sessions[portName] = session
 	m.sessionsByID[session.ID] = session
+	if session.ShortID != "" {
+		m.sessionsByShortID[session.ShortID] = session
+	}
+	m.countOpen(portName)
+	delete(m.lastClose, portName)
+	if !hold {
+		m.emitEvent(PortEventOpened, session)
+	}
 
 	return session, nil
 }
 
-// ClosePort closes a serial port session
+// ClosePort closes a serial port session at its client's request
 func (m *Manager) ClosePort(portName string, sessionID string) error {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+	return m.ClosePortWithReason(portName, sessionID, CloseReasonClient)
+}
 
-	session, exists := m.sessions[portName]
-	if !exists {
-		return ErrPortNotOpen
-	}
+// closeSessionLocked closes a session and announces why (must be called
+// with lock held)
+func (m *Manager) closeSessionLocked(session *Session, reason CloseReason) error {
+	err := m.discardSessionLocked(session)
 
-	if session.ID != sessionID {
-		return ErrInvalidSession
+	closed := ClosedSession{
+		SessionID: session.ID,
+		ClientID:  session.ClientID,
+		Reason:    reason,
+		ClosedAt:  time.Now(),
 	}
-
-	return m.closeSessionLocked(session)
+	m.lastClose[session.PortName] = closed
+	m.broadcastEvent(PortEvent{
+		Type:        PortEventClosed,
+		PortName:    session.PortName,
+		SessionID:   session.ID,
+		ClientID:    session.ClientID,
+		Message:     string(reason),
+		CloseReason: reason,
+		Timestamp:   closed.ClosedAt,
+		Metadata:    session.Metadata,
+	})
+	return err
 }
 
-// closeSessionLocked closes a session (must be called with lock held)
-func (m *Manager) closeSessionLocked(session *Session) error {
+// discardSessionLocked closes a session without announcing it (must be
+// called with lock held)
+func (m *Manager) discardSessionLocked(session *Session) error {
 	session.closed.Store(true)
+	// Readers paused by dormancy or waiting for data find the port closed
+	session.power.release()
+	session.wakeReaders()
 
 	// Close all reader channels
 	session.readersMu.Lock()
-	for _, ch := range session.readers {
-		close(ch)
+	for _, q := range session.readers {
+		q.close()
 	}
 	session.readers = nil
 	session.readersMu.Unlock()
@@ -135,9 +347,18 @@ func (m *Manager) closeSessionLocked(session *Session) error {
 	if session.port != nil {
 		err = session.port.Close()
 	}
+	if session.pollWake >= 0 {
+		platform.closePollWake(session.pollWake)
+	}
+	session.restoreLatency()
 
 	delete(m.sessions, session.PortName)
 	delete(m.sessionsByID, session.ID)
+	delete(m.sessionsByShortID, session.ShortID)
+	m.totals.add(session)
+	if port := m.portTotals[session.PortName]; port != nil {
+		port.add(session)
+	}
 
 	return err
 }
@@ -149,15 +370,29 @@ func (m *Manager) GetSession(portName string) *Session {
 	return m.sessions[portName]
 }
 
-// GetSessionByID returns a session by its ID
+// GetSessionByID returns a session by its ID or short ID
 func (m *Manager) GetSessionByID(sessionID string) *Session {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
-	return m.sessionsByID[sessionID]
+	if session, ok := m.sessionsByID[sessionID]; ok {
+		return session
+	}
+	return m.sessionsByShortID[sessionID]
 }
 
-// ValidateSession checks if a session is valid
+// ValidateSession checks if a session is valid. Every client operation on
+// a session goes through it, so it wakes dormant sessions.
 func (m *Manager) ValidateSession(portName string, sessionID string) (*Session, error) {
+	session, err := m.validateSession(portName, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	m.wake(session, "client activity")
+	return session, nil
+}
+
+// validateSession checks if a session is valid without waking it
+func (m *Manager) validateSession(portName string, sessionID string) (*Session, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
@@ -166,7 +401,7 @@ func (m *Manager) ValidateSession(portName string, sessionID string) (*Session,
 		return nil, ErrPortNotOpen
 	}
 
-	if session.ID != sessionID {
+	if !session.HasID(sessionID) {
 		return nil, ErrInvalidSession
 	}
 
@@ -183,57 +418,158 @@ func (m *Manager) Write(portName string, sessionID string, data []byte) (int, er
 	if err != nil {
 		return 0, err
 	}
+	if err := m.filterWrite(session, data); err != nil {
+		return 0, err
+	}
 
-	session.mu.Lock()
-	defer session.mu.Unlock()
+	if c := session.coalescer.Load(); c != nil {
+		return m.writeCoalesced(session, c, data)
+	}
+	return m.writeGated(session, data)
+}
+
+// WriteWithin is Write bounded by timeout, covering both the wait for other
+// writes on the port and the write itself, without reconfiguring the
+// session. The driver cannot abort a write in progress, so on
+// ErrWriteTimeout part of the data may still be sent.
+func (m *Manager) WriteWithin(portName string, sessionID string, data []byte, timeout time.Duration) (int, error) {
+	session, err := m.ValidateSession(portName, sessionID)
+	if err != nil {
+		return 0, err
+	}
+	if err := m.filterWrite(session, data); err != nil {
+		return 0, err
+	}
 
-	n, err := session.port.Write(data)
+	if err := m.flushCoalesced(session); err != nil {
+		return 0, err
+	}
+
+	deadline := m.clock.Now().Add(timeout)
+	if !session.writes.acquireBy(session.Priority(), deadline) {
+		return 0, ErrWriteTimeout
+	}
+
+	type writeResult struct {
+		n   int
+		err error
+	}
+	resultChan := make(chan writeResult, 1)
+	go func() {
+		defer session.writes.release()
+		n, err := m.writeShaped(session, data)
+		resultChan <- writeResult{n: n, err: err}
+	}()
+
+	timer := m.clock.NewTimer(deadline.Sub(m.clock.Now()))
+	defer timer.Stop()
+	select {
+	case result := <-resultChan:
+		return result.n, result.err
+	case <-timer.C():
+		return 0, ErrWriteTimeout
+	}
+}
+
+// writeLocked writes data to the port and accounts it (session lock held)
+func (m *Manager) writeLocked(session *Session, data []byte) (int, error) {
+	n, err := session.writePort(data)
 	if err != nil {
-		atomic.AddUint64(&session.Statistics.Errors, 1)
+		m.recordError(session, "write", err)
 		return n, fmt.Errorf("write failed: %w", err)
 	}
 
 	atomic.AddUint64(&session.Statistics.BytesSent, uint64(n))
 	session.Statistics.LastActivity = time.Now()
+	session.recordTraffic(DirectionTX, data[:n])
 
 	return n, nil
 }
 
 // Read reads data from a port
 func (m *Manager) Read(portName string, sessionID string, maxBytes int) ([]byte, error) {
+	return m.ReadWithin(portName, sessionID, maxBytes, 0)
+}
+
+// ReadWithin is Read with the driver read timeout set to timeout for this
+// read only, e.g. one slow response on an otherwise fast session. The
+// session's configured timeout applies again afterwards; zero keeps it.
+func (m *Manager) ReadWithin(portName string, sessionID string, maxBytes int, timeout time.Duration) ([]byte, error) {
 	session, err := m.ValidateSession(portName, sessionID)
 	if err != nil {
 		return nil, err
 	}
 
+	rx := session.shaping.Load().receive()
+	maxBytes = rx.wait(maxBytes)
+
+	if held := session.takeHeld(maxBytes); held != nil {
+		session.mu.Lock()
+		defer session.mu.Unlock()
+		rx.consume(len(held))
+		m.afterRead(session, held)
+		return held, nil
+	}
+
 	session.mu.Lock()
 	defer session.mu.Unlock()
 
+	if timeout > 0 {
+		if err := session.port.SetReadTimeout(timeout); err != nil {
+			return nil, fmt.Errorf("failed to set read timeout: %w", err)
+		}
+		defer func() { _ = session.port.SetReadTimeout(session.readTimeout()) }()
+	}
+
 	buffer := make([]byte, maxBytes)
-	n, err := session.port.Read(buffer)
+	n, err := session.readPort(buffer)
+	rx.consume(n)
 	if err != nil {
-		atomic.AddUint64(&session.Statistics.Errors, 1)
+		m.recordError(session, "read", err)
 		return nil, fmt.Errorf("read failed: %w", err)
 	}
 
-	atomic.AddUint64(&session.Statistics.BytesReceived, uint64(n))
+	m.afterRead(session, buffer[:n])
+	return buffer[:n], nil
+}
+
+// afterRead accounts for received data and passes it to subscribed readers
+// (session lock held)
+func (m *Manager) afterRead(session *Session, data []byte) {
+	atomic.AddUint64(&session.Statistics.BytesReceived, uint64(len(data)))
 	session.Statistics.LastActivity = time.Now()
 
+	if session.quality != nil && len(data) > 0 {
+		m.trackQuality(session, data)
+	}
+	session.recordTraffic(DirectionRX, data)
+
 	// Broadcast to all subscribed readers
-	if n > 0 {
-		data := buffer[:n]
+	if len(data) > 0 {
 		session.readersMu.RLock()
-		for _, ch := range session.readers {
-			select {
-			case ch <- data:
-			default:
-				// Channel full, skip
-			}
+		for _, q := range session.readers {
+			// Dropped when the subscriber is too far behind
+			q.push(data)
 		}
 		session.readersMu.RUnlock()
 	}
+}
 
-	return buffer[:n], nil
+// trackQuality updates line-quality statistics and publishes warnings
+// (session lock held)
+func (m *Manager) trackQuality(session *Session, data []byte) {
+	result := session.quality.analyze(data)
+
+	atomic.AddUint64(&session.Statistics.GarbageBytes, uint64(result.garbage))
+	atomic.AddUint64(&session.Statistics.BreakCount, uint64(result.breaks))
+	if result.evaluated {
+		session.Statistics.LineQuality = result.quality
+	}
+
+	for _, warning := range result.warnings {
+		log.Warn("line quality", "port", session.PortName, "message", warning)
+		m.emitEventMessage(PortEventLineQuality, session, warning)
+	}
 }
 
 // Configure updates port configuration
@@ -254,13 +590,26 @@ func (m *Manager) Configure(portName string, sessionID string, config PortConfig
 		return fmt.Errorf("failed to configure port: %w", err)
 	}
 
+	if fc, ok := session.port.(flowControlSetter); ok {
+		if err := fc.setFlowControl(config.FlowControl); err != nil {
+			return fmt.Errorf("failed to set flow control: %w", err)
+		}
+	}
+
 	if config.ReadTimeoutMs > 0 {
 		if err := session.port.SetReadTimeout(time.Duration(config.ReadTimeoutMs) * time.Millisecond); err != nil {
 			return fmt.Errorf("failed to set read timeout: %w", err)
 		}
 	}
 
+	if err := session.setLatencyProfile(config); err != nil {
+		return fmt.Errorf("failed to apply latency profile: %w", err)
+	}
+
+	// Line states only apply on open
+	config.DTR, config.RTS = session.Config.DTR, session.Config.RTS
 	session.Config = config
+	m.emitEvent(PortEventConfigured, session)
 	return nil
 }
 
@@ -289,32 +638,57 @@ func (m *Manager) ListOpenPorts() []string {
 	return ports
 }
 
-// CloseAll closes all open ports
+// Sessions returns all open sessions
+func (m *Manager) Sessions() []*Session {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	sessions := make([]*Session, 0, len(m.sessionsByID))
+	for _, session := range m.sessionsByID {
+		sessions = append(sessions, session)
+	}
+	return sessions
+}
+
+// CloseAll closes all open ports as the agent shuts down
 func (m *Manager) CloseAll() {
+	// Writes held back go out before the ports close, as on ClosePort
+	for _, session := range m.Sessions() {
+		if err := m.flushCoalesced(session); err != nil {
+			log.Warn("failed to send held writes during CloseAll", "port", session.PortName, "error", err)
+		}
+	}
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	for portName, session := range m.sessions {
-		if err := m.closeSessionLocked(session); err != nil {
+		if err := m.closeSessionLocked(session, CloseReasonShutdown); err != nil {
 			log.Warn("failed to close session during CloseAll", "port", portName, "error", err)
 		}
 	}
 }
 
-// SubscribeToReads creates a channel that receives data read from the port
-func (m *Manager) SubscribeToReads(portName string, sessionID string) (<-chan []byte, error) {
+// SubscribeToReads creates a channel that receives data read from the port.
+// Data waits for the subscriber within the memory limits; data beyond them
+// is dropped. consumer names the subscriber in Streams.
+func (m *Manager) SubscribeToReads(portName string, sessionID string, consumer string) (<-chan []byte, error) {
 	session, err := m.ValidateSession(portName, sessionID)
 	if err != nil {
 		return nil, err
 	}
 
-	ch := make(chan []byte, 100)
+	q := newDataQueue(&m.memory, session, session.Priority(), consumer, func(data []byte) int { return len(data) })
 
 	session.readersMu.Lock()
-	session.readers = append(session.readers, ch)
-	session.readersMu.Unlock()
+	defer session.readersMu.Unlock()
+	if session.IsClosed() {
+		q.close()
+		return nil, ErrPortClosed
+	}
+	session.readers = append(session.readers, q)
 
-	return ch, nil
+	return q.out, nil
 }
 
 // UnsubscribeFromReads removes a read subscription
@@ -328,8 +702,8 @@ func (m *Manager) UnsubscribeFromReads(portName string, sessionID string, ch <-c
 	defer session.readersMu.Unlock()
 
 	for i, sub := range session.readers {
-		if sub == ch {
-			close(sub)
+		if sub.out == ch {
+			sub.close()
 			session.readers = append(session.readers[:i], session.readers[i+1:]...)
 			return nil
 		}
@@ -359,6 +733,38 @@ func (m *Manager) Flush(portName string, sessionID string) error {
 	return nil
 }
 
+// SetDTR sets the DTR modem line
+func (m *Manager) SetDTR(portName string, sessionID string, dtr bool) error {
+	session, err := m.ValidateSession(portName, sessionID)
+	if err != nil {
+		return err
+	}
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if err := session.port.SetDTR(dtr); err != nil {
+		return fmt.Errorf("failed to set DTR: %w", err)
+	}
+	return nil
+}
+
+// SetRTS sets the RTS modem line
+func (m *Manager) SetRTS(portName string, sessionID string, rts bool) error {
+	session, err := m.ValidateSession(portName, sessionID)
+	if err != nil {
+		return err
+	}
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if err := session.port.SetRTS(rts); err != nil {
+		return fmt.Errorf("failed to set RTS: %w", err)
+	}
+	return nil
+}
+
 // GetDefaultConfig returns the manager's default port configuration
 func (m *Manager) GetDefaultConfig() PortConfig {
 	return m.defaultConfig
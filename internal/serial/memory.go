@@ -0,0 +1,404 @@
+package serial
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// queueItemOverhead approximates the memory an item costs on top of its
+// data, so streams of tiny chunks are accounted too
+const queueItemOverhead = 64
+
+// MemoryLimits caps the bytes waiting in read subscription queues for
+// consumers to take them. A fast device with a slow consumer then loses
+// data instead of growing the queue. Zero means unlimited.
+type MemoryLimits struct {
+	Global     int64
+	PerSession int64
+	PerStream  int64
+}
+
+// DefaultMemoryLimits suit a small gateway such as a Raspberry Pi Zero
+func DefaultMemoryLimits() MemoryLimits {
+	return MemoryLimits{
+		Global:     64 << 20,
+		PerSession: 16 << 20,
+		PerStream:  4 << 20,
+	}
+}
+
+// SessionMemory is the memory accounted to one session
+type SessionMemory struct {
+	SessionID string
+	PortName  string
+	ClientID  string
+	Streams   int
+	Buffered  int64
+	Peak      int64
+	// Dropped counts bytes discarded because a limit was reached
+	Dropped uint64
+}
+
+// MemoryStats is a snapshot of the memory accounting
+type MemoryStats struct {
+	Limits   MemoryLimits
+	Streams  int
+	Buffered int64
+	Peak     int64
+	Dropped  uint64
+	Sessions []SessionMemory
+}
+
+// StreamStats describes one read subscription: a stream, SSE subscription,
+// console logger, device tracker or bridge taking a session's received data
+type StreamStats struct {
+	ID        uint64
+	PortName  string
+	SessionID string
+	ClientID  string
+	// Consumer names what takes the data, e.g. "StreamRead"
+	Consumer string
+	Priority Priority
+	Started  time.Time
+	// Delivered and Dropped count chunks, the Bytes fields their data
+	Delivered      uint64
+	DeliveredBytes uint64
+	Dropped        uint64
+	DroppedBytes   uint64
+	// Queued chunks wait for the consumer; Lag is how long the oldest has
+	// waited, zero when the consumer keeps up
+	Queued      int
+	QueuedBytes int64
+	Lag         time.Duration
+}
+
+// statsSource is a queue of any item type, for listing
+type statsSource interface {
+	stats(now time.Time) StreamStats
+}
+
+// memoryAccount tracks the bytes queued globally and per session
+type memoryAccount struct {
+	mu       sync.Mutex
+	limits   MemoryLimits
+	streams  int
+	buffered int64
+	peak     int64
+	dropped  uint64
+	// queues lists the open queues by ID
+	queues map[uint64]statsSource
+	nextID uint64
+}
+
+// sessionMemory is a session's share of the accounting (account lock held)
+type sessionMemory struct {
+	streams  int
+	buffered int64
+	peak     int64
+	dropped  uint64
+}
+
+// SetMemoryLimits caps the memory of read subscription queues
+func (m *Manager) SetMemoryLimits(limits MemoryLimits) {
+	m.memory.mu.Lock()
+	defer m.memory.mu.Unlock()
+	m.memory.limits = limits
+}
+
+// MemoryStats returns the current memory accounting
+func (m *Manager) MemoryStats() MemoryStats {
+	m.mu.RLock()
+	sessions := make([]*Session, 0, len(m.sessionsByID))
+	for _, session := range m.sessionsByID {
+		sessions = append(sessions, session)
+	}
+	m.mu.RUnlock()
+
+	m.memory.mu.Lock()
+	defer m.memory.mu.Unlock()
+
+	stats := MemoryStats{
+		Limits:   m.memory.limits,
+		Streams:  m.memory.streams,
+		Buffered: m.memory.buffered,
+		Peak:     m.memory.peak,
+		Dropped:  m.memory.dropped,
+		Sessions: make([]SessionMemory, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		stats.Sessions = append(stats.Sessions, SessionMemory{
+			SessionID: session.ID,
+			PortName:  session.PortName,
+			ClientID:  session.ClientID,
+			Streams:   session.memory.streams,
+			Buffered:  session.memory.buffered,
+			Peak:      session.memory.peak,
+			Dropped:   session.memory.dropped,
+		})
+	}
+	sort.Slice(stats.Sessions, func(i, j int) bool {
+		return stats.Sessions[i].PortName < stats.Sessions[j].PortName
+	})
+	return stats
+}
+
+// Streams returns the statistics of every read subscription, by port
+func (m *Manager) Streams() []StreamStats {
+	m.memory.mu.Lock()
+	queues := make([]statsSource, 0, len(m.memory.queues))
+	for _, q := range m.memory.queues {
+		queues = append(queues, q)
+	}
+	m.memory.mu.Unlock()
+
+	// Queue locks are taken before the account's
+	now := time.Now()
+	streams := make([]StreamStats, 0, len(queues))
+	for _, q := range queues {
+		streams = append(streams, q.stats(now))
+	}
+	sort.Slice(streams, func(i, j int) bool {
+		if streams[i].PortName != streams[j].PortName {
+			return streams[i].PortName < streams[j].PortName
+		}
+		return streams[i].ID < streams[j].ID
+	})
+	return streams
+}
+
+// reserve accounts n bytes queued on a stream holding queued bytes
+// already. It reports false, counting the bytes as dropped, when a limit
+// would be exceeded. Critical streams are only held to the stream limit.
+func (a *memoryAccount) reserve(session *Session, priority Priority, queued, n int64) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	limits := a.limits
+	if priority >= PriorityCritical {
+		limits.PerSession, limits.Global = 0, 0
+	}
+	if (limits.PerStream > 0 && queued+n > limits.PerStream) ||
+		(limits.PerSession > 0 && session.memory.buffered+n > limits.PerSession) ||
+		(limits.Global > 0 && a.buffered+n > limits.Global) {
+		a.dropped += uint64(n)
+		session.memory.dropped += uint64(n)
+		return false
+	}
+
+	a.buffered += n
+	a.peak = max(a.peak, a.buffered)
+	session.memory.buffered += n
+	session.memory.peak = max(session.memory.peak, session.memory.buffered)
+	return true
+}
+
+// release returns n reserved bytes
+func (a *memoryAccount) release(session *Session, n int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.buffered -= n
+	session.memory.buffered -= n
+}
+
+// addStream counts and lists an opened queue, returning its ID
+func (a *memoryAccount) addStream(session *Session, q statsSource) uint64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.streams++
+	session.memory.streams++
+	if a.queues == nil {
+		a.queues = make(map[uint64]statsSource)
+	}
+	a.nextID++
+	a.queues[a.nextID] = q
+	return a.nextID
+}
+
+// removeStream uncounts and unlists a closed queue
+func (a *memoryAccount) removeStream(session *Session, id uint64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.streams--
+	session.memory.streams--
+	delete(a.queues, id)
+}
+
+// dataQueue hands items to a consumer channel in order. The backlog is
+// held in memory accounted against the stream, session and global limits;
+// items beyond a limit are dropped rather than blocking the producer.
+type dataQueue[T any] struct {
+	account  *memoryAccount
+	session  *Session
+	priority Priority
+	size     func(T) int
+	out      chan T
+	id       uint64
+	consumer string
+	started  time.Time
+
+	mu     sync.Mutex
+	items  []T
+	bytes  int64
+	closed bool
+	wake   chan struct{}
+	done   chan struct{}
+	// queuedAt holds when each item was queued
+	queuedAt []time.Time
+	// traffic counts chunks and bytes for Streams
+	delivered, deliveredBytes uint64
+	dropped, droppedBytes     uint64
+}
+
+// newDataQueue starts a queue delivering to its out channel until closed.
+// consumer names what takes the items in Streams.
+func newDataQueue[T any](account *memoryAccount, session *Session, priority Priority, consumer string, size func(T) int) *dataQueue[T] {
+	q := &dataQueue[T]{
+		account:  account,
+		session:  session,
+		priority: priority,
+		size:     size,
+		out:      make(chan T),
+		consumer: consumer,
+		started:  time.Now(),
+		wake:     make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	q.mu.Lock()
+	q.id = account.addStream(session, q)
+	q.mu.Unlock()
+	go q.run()
+	return q
+}
+
+// push queues an item, reporting false when it was dropped
+func (q *dataQueue[T]) push(item T) bool {
+	size := q.size(item)
+	n := int64(size + queueItemOverhead)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return false
+	}
+	if !q.account.reserve(q.session, q.priority, q.bytes, n) {
+		q.dropped++
+		q.droppedBytes += uint64(size)
+		return false
+	}
+	q.items = append(q.items, item)
+	q.queuedAt = append(q.queuedAt, time.Now())
+	q.bytes += n
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// close stops delivery, releases the backlog and closes out
+func (q *dataQueue[T]) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+	q.closed = true
+	close(q.done)
+}
+
+// discard drops the backlog, releasing its memory. The head item is kept
+// as it may be on its way to the consumer.
+func (q *dataQueue[T]) discard() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.items) <= 1 {
+		return
+	}
+
+	var released int64
+	for i, item := range q.items[1:] {
+		size := q.size(item)
+		released += int64(size + queueItemOverhead)
+		q.dropped++
+		q.droppedBytes += uint64(size)
+		var zero T
+		q.items[i+1] = zero
+	}
+	q.items = q.items[:1]
+	q.queuedAt = q.queuedAt[:1]
+	q.bytes -= released
+	q.account.release(q.session, released)
+}
+
+// run delivers queued items to out
+func (q *dataQueue[T]) run() {
+	defer close(q.out)
+	defer func() {
+		q.mu.Lock()
+		q.account.release(q.session, q.bytes)
+		q.items, q.queuedAt, q.bytes = nil, nil, 0
+		q.mu.Unlock()
+		q.account.removeStream(q.session, q.id)
+	}()
+
+	for {
+		q.mu.Lock()
+		if len(q.items) == 0 {
+			q.mu.Unlock()
+			select {
+			case <-q.wake:
+				continue
+			case <-q.done:
+				return
+			}
+		}
+		item := q.items[0]
+		q.mu.Unlock()
+
+		select {
+		case q.out <- item:
+		case <-q.done:
+			return
+		}
+
+		size := q.size(item)
+		n := int64(size + queueItemOverhead)
+		q.mu.Lock()
+		var zero T
+		q.items[0] = zero
+		q.items = q.items[1:]
+		q.queuedAt = q.queuedAt[1:]
+		q.bytes -= n
+		q.delivered++
+		q.deliveredBytes += uint64(size)
+		q.mu.Unlock()
+		q.account.release(q.session, n)
+	}
+}
+
+// stats returns the queue's statistics
+func (q *dataQueue[T]) stats(now time.Time) StreamStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	stats := StreamStats{
+		ID:             q.id,
+		PortName:       q.session.PortName,
+		SessionID:      q.session.ID,
+		ClientID:       q.session.ClientID,
+		Consumer:       q.consumer,
+		Priority:       q.priority,
+		Started:        q.started,
+		Delivered:      q.delivered,
+		DeliveredBytes: q.deliveredBytes,
+		Dropped:        q.dropped,
+		DroppedBytes:   q.droppedBytes,
+		Queued:         len(q.items),
+		QueuedBytes:    q.bytes - int64(len(q.items)*queueItemOverhead),
+	}
+	if len(q.queuedAt) > 0 {
+		stats.Lag = now.Sub(q.queuedAt[0])
+	}
+	return stats
+}
@@ -0,0 +1,52 @@
+package serial
+
+import "github.com/Shoaibashk/SerialLink/internal/metrics"
+
+// Collect implements metrics.Collector
+func (m *Manager) Collect() []metrics.Family {
+	open := metrics.Family{
+		Name: "seriallink_port_open_sessions",
+		Help: "Sessions holding the port open.",
+		Type: metrics.TypeGauge,
+	}
+	opened := metrics.Family{
+		Name: "seriallink_port_sessions_opened_total",
+		Help: "Sessions opened on the port.",
+		Type: metrics.TypeCounter,
+	}
+	sent := metrics.Family{
+		Name: "seriallink_port_bytes_sent_total",
+		Help: "Bytes written to the port.",
+		Type: metrics.TypeCounter,
+	}
+	received := metrics.Family{
+		Name: "seriallink_port_bytes_received_total",
+		Help: "Bytes read from the port.",
+		Type: metrics.TypeCounter,
+	}
+	errors := metrics.Family{
+		Name: "seriallink_port_errors_total",
+		Help: "Failed reads and writes on the port.",
+		Type: metrics.TypeCounter,
+	}
+	reconnects := metrics.Family{
+		Name: "seriallink_port_reconnects_total",
+		Help: "Opens of the port after its device was removed.",
+		Type: metrics.TypeCounter,
+	}
+
+	for _, port := range m.PortTotals() {
+		labels := []metrics.Label{{Name: "port", Value: port.PortName}}
+		sessions := 0.0
+		if port.Open {
+			sessions = 1
+		}
+		open.Samples = append(open.Samples, metrics.Sample{Labels: labels, Value: sessions})
+		opened.Samples = append(opened.Samples, metrics.Sample{Labels: labels, Value: float64(port.SessionsOpened)})
+		sent.Samples = append(sent.Samples, metrics.Sample{Labels: labels, Value: float64(port.BytesSent)})
+		received.Samples = append(received.Samples, metrics.Sample{Labels: labels, Value: float64(port.BytesReceived)})
+		errors.Samples = append(errors.Samples, metrics.Sample{Labels: labels, Value: float64(port.Errors)})
+		reconnects.Samples = append(reconnects.Samples, metrics.Sample{Labels: labels, Value: float64(port.Reconnects)})
+	}
+	return []metrics.Family{open, opened, sent, received, errors, reconnects}
+}
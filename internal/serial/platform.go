@@ -0,0 +1,134 @@
+package serial
+
+import (
+	"strings"
+	"time"
+
+	"go.bug.st/serial"
+	"go.bug.st/serial/enumerator"
+)
+
+// Capabilities are what a port supports on this platform
+type Capabilities struct {
+	// Platform names the provider: the OS for local ports, "tcp" or
+	// "rfc2217" for network ports
+	Platform string
+	// LowLatency reports whether the low latency profile tunes the port
+	// (FTDI latency timer, VMIN/VTIME)
+	LowLatency bool
+	// ReadPolling reports whether reads wait for data in the kernel
+	// without holding the session lock
+	ReadPolling bool
+	// Driver names the kernel driver of a local port, when known
+	Driver string
+	// MaxBaudRate is the highest baud rate the port's UART supports, or 0
+	// when the driver does not report it
+	MaxBaudRate int
+	// CustomBaud reports whether non-standard baud rates can be set
+	CustomBaud bool
+	// MarkSpaceParity reports whether mark and space parity can be set
+	MarkSpaceParity bool
+	// ModemStatus reports whether the CTS, DSR, RI and DCD lines are read
+	ModemStatus bool
+	// ControlLines reports whether DTR and RTS can be set
+	ControlLines bool
+	// Break reports whether a break condition can be sent
+	Break bool
+	// RS485 reports whether the driver supports kernel RS-485 mode. It is
+	// only detected while the port is open.
+	RS485 bool
+}
+
+// platformProvider implements the handling of local ports that differs
+// between operating systems. Each OS has an implementation in a
+// build-tagged file; platform is the one of the running OS.
+type platformProvider interface {
+	// capabilities returns the capabilities of a local port; fd is its
+	// descriptor in this process, or -1 when it is not open
+	capabilities(portName string, fd int) Capabilities
+	// tuneLowLatency tunes an open port, whose descriptor is fd or -1, for
+	// the low latency profile and returns a function undoing changes that
+	// outlive the port, or nil
+	tuneLowLatency(portName string, fd int) (func(), error)
+	// portDescriptor returns the descriptor of an open port, or -1 when
+	// reads cannot be polled
+	portDescriptor(portName string, port serial.Port) int
+	// newPollWake returns a descriptor that interrupts pollReadable when
+	// signalled, or -1 where reads are not polled
+	newPollWake() int
+	// signalPollWake interrupts the waits on wake
+	signalPollWake(wake int)
+	// closePollWake releases wake
+	closePollWake(wake int)
+	// pollReadable waits up to timeout for fd to have data to read, or for
+	// wake (-1 for none) to be signalled, which reports no data
+	pollReadable(fd, wake int, timeout time.Duration) (bool, error)
+	// keepLinesOnClose stops closing an open port, whose descriptor is fd
+	// or -1, from dropping its control lines, where the OS does that
+	keepLinesOnClose(fd int) error
+	// portType classifies Bluetooth and virtual ports by name, returning
+	// PortTypeUnknown for others
+	portType(portName string) PortType
+	// portSources returns the sources of local ports, each listed by the
+	// scanner as a provider of its own
+	portSources() []portSource
+}
+
+// portSource lists the local ports of one kind
+type portSource struct {
+	name string
+	list func() ([]*enumerator.PortDetails, error)
+}
+
+// basePlatform provides what a platform without tuning or polling does;
+// providers embed it for the methods they do not implement
+type basePlatform struct{}
+
+func (basePlatform) tuneLowLatency(portName string, fd int) (func(), error) {
+	return nil, nil
+}
+
+func (basePlatform) portDescriptor(portName string, port serial.Port) int {
+	return -1
+}
+
+func (basePlatform) newPollWake() int {
+	return -1
+}
+
+func (basePlatform) signalPollWake(wake int) {}
+
+func (basePlatform) closePollWake(wake int) {}
+
+func (basePlatform) pollReadable(fd, wake int, timeout time.Duration) (bool, error) {
+	return true, nil
+}
+
+func (basePlatform) keepLinesOnClose(fd int) error {
+	return nil
+}
+
+func (basePlatform) portType(portName string) PortType {
+	return PortTypeUnknown
+}
+
+func (basePlatform) portSources() []portSource {
+	return []portSource{{name: ScanProviderSystem, list: enumerator.GetDetailedPortsList}}
+}
+
+// PortCapabilities returns what a port supports: network ports by their
+// protocol, local ports by the platform and, while open, their driver
+func (m *Manager) PortCapabilities(portName string) Capabilities {
+	if IsNetworkPort(portName) {
+		if strings.HasPrefix(portName, rfc2217PortPrefix) {
+			return Capabilities{Platform: "rfc2217", CustomBaud: true, MarkSpaceParity: true, ControlLines: true, Break: true}
+		}
+		return Capabilities{Platform: "tcp"}
+	}
+
+	fd := -1
+	if session := m.GetSession(portName); session != nil {
+		fd = session.fd
+	}
+	return platform.capabilities(portName, fd)
+}
@@ -0,0 +1,32 @@
+//go:build darwin
+
+package serial
+
+import "strings"
+
+// platform is the provider of the running OS
+var platform platformProvider = darwinPlatform{}
+
+// darwinPlatform has no tuning or polling; custom baud rates are set with
+// IOSSIOSPEED. termios has no CMSPAR here, so mark and space parity are
+// not available.
+type darwinPlatform struct {
+	basePlatform
+}
+
+func (darwinPlatform) capabilities(portName string, fd int) Capabilities {
+	return Capabilities{
+		Platform:     "darwin",
+		CustomBaud:   true,
+		ModemStatus:  true,
+		ControlLines: true,
+		Break:        true,
+	}
+}
+
+func (darwinPlatform) portType(portName string) PortType {
+	if strings.HasPrefix(portName, "/dev/") && strings.Contains(portName, "Bluetooth") {
+		return PortTypeBluetooth
+	}
+	return PortTypeUnknown
+}
@@ -0,0 +1,97 @@
+//go:build linux
+
+package serial
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// platform is the provider of the running OS
+var platform platformProvider = linuxPlatform{}
+
+// linuxPlatform tunes ports through sysfs and termios, polls their
+// descriptors, reads drivers and UART clocks from sysfs and detects RS-485
+// support with TIOCGRS485
+type linuxPlatform struct{}
+
+func (linuxPlatform) capabilities(portName string, fd int) Capabilities {
+	caps := Capabilities{
+		Platform:        "linux",
+		LowLatency:      true,
+		ReadPolling:     true,
+		CustomBaud:      true,
+		MarkSpaceParity: true,
+		ModemStatus:     true,
+		ControlLines:    true,
+		Break:           true,
+		RS485:           fd >= 0 && supportsRS485(fd),
+	}
+	if device, err := filepath.EvalSymlinks(portName); err == nil {
+		dir := filepath.Join(sysClassTTY, filepath.Base(device))
+		caps.Driver = ttyDriver(dir)
+		caps.MaxBaudRate = uartMaxBaud(dir)
+	}
+	return caps
+}
+
+func (linuxPlatform) portType(portName string) PortType {
+	switch {
+	case strings.HasPrefix(portName, "/dev/rfcomm"):
+		return PortTypeBluetooth
+	case strings.HasPrefix(portName, "/dev/pts/"), strings.HasPrefix(portName, "/dev/pty"):
+		return PortTypeVirtual
+	}
+	return PortTypeUnknown
+}
+
+// supportsRS485 reports whether the driver of fd answers TIOCGRS485; drivers
+// without RS-485 support fail it with ENOTTY
+func supportsRS485(fd int) bool {
+	// struct serial_rs485: flags, two delays and five reserved words
+	var config [8]uint32
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, uintptr(fd), unix.TIOCGRS485, uintptr(unsafe.Pointer(&config)))
+	return errno == 0
+}
+
+// ttyDriver returns the name of the driver bound to the tty at dir in
+// sysfs, or "" for ttys without a device such as ptys. Since Linux 6.5
+// serial core ports sit on serial-base devices; the UART's driver is the
+// one of their parent.
+func ttyDriver(dir string) string {
+	device, err := filepath.EvalSymlinks(filepath.Join(dir, "device"))
+	if err != nil {
+		return ""
+	}
+	for device != "/" && device != "." {
+		driver, err := os.Readlink(filepath.Join(device, "driver"))
+		if err != nil {
+			return ""
+		}
+		if !strings.Contains(driver, "/serial-base/") {
+			return filepath.Base(driver)
+		}
+		device = filepath.Dir(device)
+	}
+	return ""
+}
+
+// uartMaxBaud returns the highest baud rate of a serial core UART: its
+// clock divided by the 16x oversampling. USB adapters do not expose a
+// clock, so they report 0.
+func uartMaxBaud(dir string) int {
+	data, err := os.ReadFile(filepath.Join(dir, "uartclk"))
+	if err != nil {
+		return 0
+	}
+	clock, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil || clock <= 0 {
+		return 0
+	}
+	return clock / 16
+}
@@ -0,0 +1,23 @@
+//go:build !linux && !darwin && !windows
+
+package serial
+
+import "runtime"
+
+// platform is the provider of the running OS
+var platform platformProvider = otherPlatform{}
+
+// otherPlatform covers the BSDs and other systems: standard baud rates
+// and no mark or space parity, no tuning or polling
+type otherPlatform struct {
+	basePlatform
+}
+
+func (otherPlatform) capabilities(portName string, fd int) Capabilities {
+	return Capabilities{
+		Platform:     runtime.GOOS,
+		ModemStatus:  true,
+		ControlLines: true,
+		Break:        true,
+	}
+}
@@ -0,0 +1,34 @@
+//go:build windows
+
+package serial
+
+import "strings"
+
+// platform is the provider of the running OS
+var platform platformProvider = windowsPlatform{}
+
+// windowsPlatform has no tuning or polling; the driver accepts any baud rate
+// it supports
+type windowsPlatform struct {
+	basePlatform
+}
+
+func (windowsPlatform) capabilities(portName string, fd int) Capabilities {
+	return Capabilities{
+		Platform:        "windows",
+		CustomBaud:      true,
+		MarkSpaceParity: true,
+		ModemStatus:     true,
+		ControlLines:    true,
+		Break:           true,
+	}
+}
+
+func (windowsPlatform) portType(portName string) PortType {
+	// Bluetooth COM ports are named after the Bluetooth stack
+	name := strings.ToLower(portName)
+	if strings.Contains(name, "bluetooth") || strings.Contains(name, "bth") {
+		return PortTypeBluetooth
+	}
+	return PortTypeUnknown
+}
@@ -0,0 +1,224 @@
+package serial
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// dormantHoldLimit caps the data kept while a dormant session watches for
+// wake patterns; older data is discarded
+const dormantHoldLimit = 4096
+
+// PowerState is whether a session is serviced. Dormant sessions cost next
+// to nothing, for agents with hundreds of mostly idle ports.
+type PowerState int
+
+const (
+	PowerActive  PowerState = iota // read and streamed as usual
+	PowerDormant                   // reader paused and buffers released
+)
+
+// String returns the string representation of PowerState
+func (p PowerState) String() string {
+	switch p {
+	case PowerActive:
+		return "active"
+	case PowerDormant:
+		return "dormant"
+	default:
+		return "unknown"
+	}
+}
+
+// sessionPower tracks a session's power state
+type sessionPower struct {
+	mu      sync.Mutex
+	dormant bool
+	// awake is closed when the session wakes
+	awake    chan struct{}
+	patterns []*regexp.Regexp
+	// held is data read while watching for wake patterns, handed to the
+	// next read once the session wakes
+	held []byte
+	// watching is closed when the wake pattern watcher exits
+	watching chan struct{}
+}
+
+// PowerState returns the session's power state
+func (s *Session) PowerState() PowerState {
+	s.power.mu.Lock()
+	defer s.power.mu.Unlock()
+	if s.power.dormant {
+		return PowerDormant
+	}
+	return PowerActive
+}
+
+// awaitWake blocks while the session is dormant. It reports false when ctx
+// or stop ended the wait first.
+func (s *Session) awaitWake(ctx context.Context, stop <-chan struct{}) bool {
+	s.power.mu.Lock()
+	if !s.power.dormant {
+		s.power.mu.Unlock()
+		return true
+	}
+	awake := s.power.awake
+	s.power.mu.Unlock()
+
+	select {
+	case <-awake:
+		return true
+	case <-ctx.Done():
+		return false
+	case <-stop:
+		return false
+	}
+}
+
+// release ends dormancy without notice, e.g. when the session closes
+func (p *sessionPower) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.dormant {
+		p.dormant = false
+		close(p.awake)
+	}
+}
+
+// takeHeld returns up to maxBytes of the data held while dormant. It waits
+// for the wake pattern watcher to stop first, so data is read in order.
+func (s *Session) takeHeld(maxBytes int) []byte {
+	s.power.mu.Lock()
+	watching := s.power.watching
+	s.power.mu.Unlock()
+	if watching != nil {
+		<-watching
+	}
+
+	s.power.mu.Lock()
+	defer s.power.mu.Unlock()
+	if s.power.dormant || len(s.power.held) == 0 {
+		return nil
+	}
+	n := min(maxBytes, len(s.power.held))
+	data := s.power.held[:n:n]
+	s.power.held = s.power.held[n:]
+	if len(s.power.held) == 0 {
+		s.power.held = nil
+	}
+	return data
+}
+
+// SetDormant pauses a session: stream readers stop reading, data queued for
+// subscribers is discarded and, without wake patterns, the port is not read
+// at all. The session wakes on its next use through the manager (a write,
+// read, configuration change or new stream), on Wake, or when data
+// received matches one of the wake patterns.
+func (m *Manager) SetDormant(portName string, sessionID string, wakePatterns []*regexp.Regexp) error {
+	session, err := m.validateSession(portName, sessionID)
+	if err != nil {
+		return err
+	}
+
+	session.power.mu.Lock()
+	if session.power.dormant {
+		session.power.mu.Unlock()
+		return nil
+	}
+	session.power.dormant = true
+	session.power.awake = make(chan struct{})
+	session.power.patterns = wakePatterns
+	session.power.held = nil
+	session.power.watching = nil
+	if len(wakePatterns) > 0 {
+		session.power.watching = make(chan struct{})
+		go m.watchDormant(session, session.power.awake, session.power.watching)
+	}
+	session.power.mu.Unlock()
+	// A reader waiting for data finds the session dormant
+	session.wakeReaders()
+
+	session.readersMu.RLock()
+	for _, q := range session.readers {
+		q.discard()
+	}
+	session.readersMu.RUnlock()
+
+	m.emitEventMessage(PortEventPowerState, session, PowerDormant.String())
+	return nil
+}
+
+// Wake resumes a dormant session
+func (m *Manager) Wake(portName string, sessionID string) error {
+	session, err := m.validateSession(portName, sessionID)
+	if err != nil {
+		return err
+	}
+	m.wake(session, "requested")
+	return nil
+}
+
+// wake resumes a session if it is dormant
+func (m *Manager) wake(session *Session, reason string) {
+	session.power.mu.Lock()
+	if !session.power.dormant {
+		session.power.mu.Unlock()
+		return
+	}
+	session.power.dormant = false
+	session.power.patterns = nil
+	close(session.power.awake)
+	session.power.mu.Unlock()
+	// The port's watcher stops for the reader
+	session.wakeReaders()
+
+	m.emitEventMessage(PortEventPowerState, session, PowerActive.String()+" ("+reason+")")
+}
+
+// watchDormant reads a dormant session's port until data matches a wake
+// pattern, the session wakes otherwise or it closes. Data is held rather
+// than delivered, so a match hands subscribers what woke the session.
+func (m *Manager) watchDormant(session *Session, awake <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+
+	for {
+		select {
+		case <-awake:
+			return
+		default:
+		}
+
+		data, err := m.pumpRead(session, dormantHoldLimit, false)
+		if err != nil {
+			if session.IsClosed() {
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+			continue
+		}
+		if len(data) == 0 {
+			continue
+		}
+
+		session.power.mu.Lock()
+		session.power.held = append(session.power.held, data...)
+		if excess := len(session.power.held) - dormantHoldLimit; excess > 0 {
+			session.power.held = session.power.held[excess:]
+		}
+		var matched string
+		for _, pattern := range session.power.patterns {
+			if pattern.Match(session.power.held) {
+				matched = pattern.String()
+				break
+			}
+		}
+		session.power.mu.Unlock()
+
+		if matched != "" {
+			m.wake(session, "wake pattern "+matched)
+			return
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package serial
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/clock"
+)
+
+// Priority ranks sessions and streams sharing the agent. Writes of a
+// higher-priority session go first when writers wait for a port, and
+// critical streams are exempt from the session and global memory limits.
+type Priority int
+
+const (
+	PriorityBulk     Priority = iota - 1 // logging and other bulk transfers
+	PriorityNormal                       // default
+	PriorityCritical                     // control sessions
+)
+
+// String returns the string representation of Priority
+func (p Priority) String() string {
+	switch p {
+	case PriorityBulk:
+		return "bulk"
+	case PriorityNormal:
+		return "normal"
+	case PriorityCritical:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
+// ParsePriority converts a priority string into a Priority enum.
+func ParsePriority(value string) (Priority, error) {
+	switch strings.ToLower(value) {
+	case "", "normal":
+		return PriorityNormal, nil
+	case "bulk":
+		return PriorityBulk, nil
+	case "critical":
+		return PriorityCritical, nil
+	default:
+		return PriorityNormal, fmt.Errorf("%w: invalid priority %q", ErrInvalidConfig, value)
+	}
+}
+
+// Priority returns the session's priority
+func (s *Session) Priority() Priority {
+	return Priority(s.priority.Load())
+}
+
+// SetPriority changes the session's priority. Streams subscribed before
+// the change keep their priority.
+func (s *Session) SetPriority(p Priority) {
+	s.priority.Store(int32(p))
+}
+
+// writeGate lets writers waiting for a port in by priority. Shared sessions
+// on a port share a gate.
+type writeGate struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	busy    bool
+	waiting map[Priority]int
+	// clock times out acquireBy
+	clock clock.Clock
+}
+
+// newWriteGate creates an open gate
+func newWriteGate(clk clock.Clock) *writeGate {
+	g := &writeGate{waiting: make(map[Priority]int), clock: clk}
+	g.cond = sync.NewCond(&g.mu)
+	return g
+}
+
+// acquire waits until no write is in progress and no higher-priority
+// writer is waiting
+func (g *writeGate) acquire(p Priority) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.waiting[p]++
+	for g.busy || g.higherWaiting(p) {
+		g.cond.Wait()
+	}
+	g.waiting[p]--
+	g.busy = true
+}
+
+// acquireBy is acquire giving up at deadline. It reports whether the gate
+// was acquired.
+func (g *writeGate) acquireBy(p Priority, deadline time.Time) bool {
+	// Taking the lock before waking waiters ensures none misses the wakeup
+	timer := g.clock.AfterFunc(deadline.Sub(g.clock.Now()), func() {
+		g.mu.Lock()
+		g.mu.Unlock()
+		g.cond.Broadcast()
+	})
+	defer timer.Stop()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.waiting[p]++
+	for g.busy || g.higherWaiting(p) {
+		if !g.clock.Now().Before(deadline) {
+			g.waiting[p]--
+			// Lower-priority writers held back by this one may proceed
+			g.cond.Broadcast()
+			return false
+		}
+		g.cond.Wait()
+	}
+	g.waiting[p]--
+	g.busy = true
+	return true
+}
+
+// release lets the next writer in
+func (g *writeGate) release() {
+	g.mu.Lock()
+	g.busy = false
+	g.mu.Unlock()
+	g.cond.Broadcast()
+}
+
+// higherWaiting reports whether a writer above p is waiting (gate lock held)
+func (g *writeGate) higherWaiting(p Priority) bool {
+	for q, n := range g.waiting {
+		if q > p && n > 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package serial
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Line quality heuristics. Received data is assumed to be text; framing and
+// parity errors are not reported by the drivers, so they are inferred from
+// what arrives instead.
+const (
+	// qualityWindow is the number of received bytes per evaluation
+	qualityWindow = 512
+
+	// garbageWarnRatio is the share of non-text bytes that raises a warning;
+	// the warning clears once the ratio drops below half of it
+	garbageWarnRatio = 0.25
+
+	// highBitMismatchRatio is the share of garbage bytes with the high bit
+	// set above which a baud rate mismatch is the likely cause
+	highBitMismatchRatio = 0.5
+)
+
+// lineQuality tracks passive line-quality statistics for a session
+type lineQuality struct {
+	windowBytes   int
+	windowGarbage int
+	windowHighBit int
+	windowBreaks  int
+	inBreak       bool
+	degraded      bool
+}
+
+// qualityResult is the outcome of analysing one chunk
+type qualityResult struct {
+	garbage   int
+	breaks    int
+	quality   float64
+	evaluated bool
+	warnings  []string
+}
+
+// analyze classifies received bytes. A run of NUL bytes is counted as one
+// BREAK, which most UARTs deliver as a NUL with a framing error.
+func (q *lineQuality) analyze(data []byte) qualityResult {
+	var result qualityResult
+
+	for i := 0; i < len(data); {
+		b := data[i]
+		size := 1
+
+		switch {
+		case b == 0x00:
+			result.garbage++
+			q.windowGarbage++
+			if !q.inBreak {
+				q.inBreak = true
+				result.breaks++
+				q.windowBreaks++
+			}
+		case b >= 0x20 && b < 0x7f, b == '\r', b == '\n', b == '\t', b == 0x1b, b == '\b':
+			q.inBreak = false
+		case b >= 0x80:
+			q.inBreak = false
+			r, n := utf8.DecodeRune(data[i:])
+			if r == utf8.RuneError && n <= 1 && utf8.FullRune(data[i:]) {
+				result.garbage++
+				q.windowGarbage++
+				q.windowHighBit++
+			} else {
+				// Valid (or chunk-split) multi-byte character
+				size = max(n, 1)
+			}
+		default:
+			q.inBreak = false
+			result.garbage++
+			q.windowGarbage++
+		}
+
+		q.windowBytes += size
+		i += size
+
+		if q.windowBytes >= qualityWindow {
+			result.quality = q.evaluate(&result)
+			result.evaluated = true
+		}
+	}
+
+	return result
+}
+
+// evaluate closes the current window, adding warnings on state changes, and
+// returns the share of text bytes in it
+func (q *lineQuality) evaluate(result *qualityResult) float64 {
+	ratio := float64(q.windowGarbage) / float64(q.windowBytes)
+
+	switch {
+	case !q.degraded && ratio >= garbageWarnRatio:
+		q.degraded = true
+		cause := "check baud rate, parity and data bits"
+		if float64(q.windowHighBit) >= highBitMismatchRatio*float64(q.windowGarbage) {
+			cause = "likely baud rate mismatch"
+		}
+		result.warnings = append(result.warnings,
+			fmt.Sprintf("%.0f%% of received bytes are not text: %s", ratio*100, cause))
+	case q.degraded && ratio < garbageWarnRatio/2:
+		q.degraded = false
+		result.warnings = append(result.warnings, "line quality recovered")
+	}
+
+	if q.windowBreaks > 0 {
+		result.warnings = append(result.warnings,
+			fmt.Sprintf("%d BREAK condition(s) or NUL runs received", q.windowBreaks))
+	}
+
+	q.windowBytes, q.windowGarbage, q.windowHighBit, q.windowBreaks = 0, 0, 0, 0
+	return 1 - ratio
+}
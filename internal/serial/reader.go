@@ -12,10 +12,12 @@ type Reader struct {
 	manager     *Manager
 	portName    string
 	sessionID   string
+	session     *Session
 	bufferSize  int
+	consumer    string
 	running     atomic.Bool
 	stopChan    chan struct{}
-	subscribers []chan DataEvent
+	subscribers []*dataQueue[DataEvent]
 	subMu       sync.RWMutex
 }
 
@@ -37,12 +39,20 @@ func NewReader(manager *Manager, portName, sessionID string, bufferSize int) *Re
 		manager:     manager,
 		portName:    portName,
 		sessionID:   sessionID,
+		session:     manager.GetSessionByID(sessionID),
 		bufferSize:  bufferSize,
+		consumer:    "reader",
 		stopChan:    make(chan struct{}),
-		subscribers: make([]chan DataEvent, 0),
+		subscribers: make([]*dataQueue[DataEvent], 0),
 	}
 }
 
+// SetConsumer names what takes the data of subscriptions made from now on,
+// as listed by Manager.Streams
+func (r *Reader) SetConsumer(consumer string) {
+	r.consumer = consumer
+}
+
 // Start begins continuous reading from the port
 func (r *Reader) Start(ctx context.Context) error {
 	if r.running.Load() {
@@ -50,14 +60,17 @@ func (r *Reader) Start(ctx context.Context) error {
 	}
 
 	// Validate session
-	_, err := r.manager.ValidateSession(r.portName, r.sessionID)
+	session, err := r.manager.ValidateSession(r.portName, r.sessionID)
 	if err != nil {
 		return err
 	}
+	r.session = session
 
 	r.running.Store(true)
 	r.stopChan = make(chan struct{})
 
+	// The loop may be waiting for data when the context ends
+	context.AfterFunc(ctx, session.wakeReaders)
 	go r.readLoop(ctx)
 
 	return nil
@@ -78,25 +91,45 @@ func (r *Reader) Stop() {
 	default:
 		close(r.stopChan)
 	}
+	if r.session != nil {
+		r.session.wakeReaders()
+	}
 
 	// Close all subscriber channels
 	r.subMu.Lock()
-	for _, ch := range r.subscribers {
-		close(ch)
+	for _, q := range r.subscribers {
+		q.close()
 	}
 	r.subscribers = nil
 	r.subMu.Unlock()
 }
 
-// Subscribe creates a new subscription to read events
+// Subscribe creates a new subscription to read events. Events wait for the
+// subscriber within the manager's memory limits; events beyond them are
+// dropped. The channel is closed at once for an unknown session.
 func (r *Reader) Subscribe() <-chan DataEvent {
-	ch := make(chan DataEvent, 100)
+	priority := PriorityNormal
+	if r.session != nil {
+		priority = r.session.Priority()
+	}
+	return r.SubscribeWithPriority(priority)
+}
+
+// SubscribeWithPriority creates a subscription at a priority other than
+// the session's
+func (r *Reader) SubscribeWithPriority(priority Priority) <-chan DataEvent {
+	if r.session == nil {
+		ch := make(chan DataEvent)
+		close(ch)
+		return ch
+	}
+	q := newDataQueue(&r.manager.memory, r.session, priority, r.consumer, func(e DataEvent) int { return len(e.Data) })
 
 	r.subMu.Lock()
-	r.subscribers = append(r.subscribers, ch)
+	r.subscribers = append(r.subscribers, q)
 	r.subMu.Unlock()
 
-	return ch
+	return q.out
 }
 
 // Unsubscribe removes a subscription
@@ -105,8 +138,8 @@ func (r *Reader) Unsubscribe(ch <-chan DataEvent) {
 	defer r.subMu.Unlock()
 
 	for i, sub := range r.subscribers {
-		if sub == ch {
-			close(sub)
+		if sub.out == ch {
+			sub.close()
 			r.subscribers = append(r.subscribers[:i], r.subscribers[i+1:]...)
 			return
 		}
@@ -132,11 +165,18 @@ func (r *Reader) readLoop(ctx context.Context) {
 		case <-r.stopChan:
 			return
 		default:
-			data, err := r.manager.Read(r.portName, r.sessionID, r.bufferSize)
+			// Dormant sessions are not read until they wake
+			if r.session.PowerState() == PowerDormant {
+				r.discardBacklog()
+				r.session.awaitWake(ctx, r.stopChan)
+				continue
+			}
+
+			// Blocks until data arrives or the pump interval elapses
+			data, err := r.manager.waitRead(r.session, r.bufferSize)
 
 			// Skip if no data (timeout with no data is normal)
 			if err == nil && len(data) == 0 {
-				time.Sleep(1 * time.Millisecond) // Small sleep to prevent busy loop
 				continue
 			}
 
@@ -167,12 +207,19 @@ func (r *Reader) broadcast(event DataEvent) {
 	r.subMu.RLock()
 	defer r.subMu.RUnlock()
 
-	for _, ch := range r.subscribers {
-		select {
-		case ch <- event:
-		default:
-			// Channel full, drop the event to prevent blocking
-		}
+	for _, q := range r.subscribers {
+		// Dropped when the subscriber is too far behind
+		q.push(event)
+	}
+}
+
+// discardBacklog drops the events waiting for subscribers
+func (r *Reader) discardBacklog() {
+	r.subMu.RLock()
+	defer r.subMu.RUnlock()
+
+	for _, q := range r.subscribers {
+		q.discard()
 	}
 }
 
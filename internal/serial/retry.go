@@ -0,0 +1,87 @@
+package serial
+
+import (
+	"errors"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+// RetryPolicy retries port reads and writes that fail with a temporary
+// error, such as EAGAIN from a driver whose buffer is momentarily full,
+// before the error is counted and returned to the client. Scheduled and
+// synchronized writes are never retried, as a late retry would defeat
+// their timing.
+type RetryPolicy struct {
+	// Attempts is the number of retries after the first failure (0 disables)
+	Attempts int
+	// Backoff is the wait before the first retry, doubled for each further
+	// one
+	Backoff time.Duration
+}
+
+// DefaultRetryPolicy returns the policy used unless configured otherwise
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{Attempts: 3, Backoff: 10 * time.Millisecond}
+}
+
+// delay returns the wait before retry number attempt (from 0)
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	return p.Backoff << min(attempt, 16)
+}
+
+// IsTemporary reports whether err is a transient condition that may
+// succeed when retried, as opposed to a fatal one such as a closed or
+// unplugged port
+func IsTemporary(err error) bool {
+	if errors.Is(err, syscall.EAGAIN) || errors.Is(err, syscall.EWOULDBLOCK) ||
+		errors.Is(err, syscall.EINTR) || errors.Is(err, syscall.ENOBUFS) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// SetRetryPolicy sets the retry policy of sessions opened afterwards
+func (m *Manager) SetRetryPolicy(policy RetryPolicy) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.retry = policy
+}
+
+// readPort reads from the port, retrying temporary errors (session lock
+// held)
+func (s *Session) readPort(buffer []byte) (int, error) {
+	for attempt := 0; ; attempt++ {
+		n, err := s.port.Read(buffer)
+		if err == nil || n > 0 || !s.retryable(err, attempt) {
+			return n, err
+		}
+	}
+}
+
+// writePort writes all of data to the port, resuming after temporary
+// errors (session lock held)
+func (s *Session) writePort(data []byte) (int, error) {
+	written := 0
+	for attempt := 0; ; attempt++ {
+		n, err := s.port.Write(data[written:])
+		written += n
+		if err == nil || !s.retryable(err, attempt) {
+			return written, err
+		}
+	}
+}
+
+// retryable waits before retrying err, or reports that it must not be
+// retried
+func (s *Session) retryable(err error, attempt int) bool {
+	if attempt >= s.retry.Attempts || s.IsClosed() || !IsTemporary(err) {
+		return false
+	}
+	log.Debug("retrying after temporary error", "port", s.PortName, "error", err, "attempt", attempt+1)
+	time.Sleep(s.retry.delay(attempt))
+	return true
+}
@@ -0,0 +1,124 @@
+//go:build linux
+
+package serial
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"go.bug.st/serial/enumerator"
+	"golang.org/x/sys/unix"
+)
+
+// ttyPortPattern matches the names of serial ports under /sys/class/tty,
+// the ones the enumerator lists
+var ttyPortPattern = regexp.MustCompile(`^(ttyS|ttyHS|ttyUSB|ttyACM|ttyAMA|rfcomm|ttyO|ttymxc)[0-9]{1,3}$`)
+
+// portSources lists ports from /sys/class/tty by the subsystem of their
+// device, so a stuck UART probe does not hold up USB adapters and the other
+// way round
+func (linuxPlatform) portSources() []portSource {
+	return []portSource{
+		{name: ScanProviderUSB, list: func() ([]*enumerator.PortDetails, error) { return sysfsPorts(ScanProviderUSB) }},
+		{name: ScanProviderNative, list: func() ([]*enumerator.PortDetails, error) { return sysfsPorts(ScanProviderNative) }},
+		{name: ScanProviderBluetooth, list: func() ([]*enumerator.PortDetails, error) { return sysfsPorts(ScanProviderBluetooth) }},
+	}
+}
+
+// sysfsPorts lists the ports of /sys/class/tty that belong to source
+func sysfsPorts(source string) ([]*enumerator.PortDetails, error) {
+	entries, err := os.ReadDir(sysClassTTY)
+	if err != nil {
+		return nil, err
+	}
+
+	var ports []*enumerator.PortDetails
+	for _, entry := range entries {
+		name := entry.Name()
+		if !ttyPortPattern.MatchString(name) {
+			continue
+		}
+		devPath := "/dev/" + name
+		if _, err := os.Stat(devPath); err != nil {
+			continue
+		}
+
+		dir := filepath.Join(sysClassTTY, name)
+		device, err := filepath.EvalSymlinks(filepath.Join(dir, "device"))
+		if err != nil {
+			// Bound RFCOMM ports are virtual ttys without a device
+			if source == ScanProviderBluetooth && strings.HasPrefix(name, "rfcomm") {
+				ports = append(ports, &enumerator.PortDetails{Name: devPath})
+			}
+			continue
+		}
+
+		subsystem, err := filepath.EvalSymlinks(filepath.Join(device, "subsystem"))
+		if err != nil {
+			continue
+		}
+		switch filepath.Base(subsystem) {
+		case "usb-serial":
+			if source == ScanProviderUSB {
+				ports = append(ports, usbPortDetails(devPath, filepath.Dir(filepath.Dir(device))))
+			}
+		case "usb":
+			if source == ScanProviderUSB {
+				ports = append(ports, usbPortDetails(devPath, filepath.Dir(device)))
+			}
+		case "bluetooth":
+			if source == ScanProviderBluetooth {
+				ports = append(ports, &enumerator.PortDetails{Name: devPath})
+			}
+		default:
+			if source == ScanProviderNative && uartPresent(name, dir) {
+				ports = append(ports, &enumerator.PortDetails{Name: devPath})
+			}
+		}
+	}
+	return ports, nil
+}
+
+// usbPortDetails reads the IDs and strings of the USB device at dir
+func usbPortDetails(devPath, dir string) *enumerator.PortDetails {
+	return &enumerator.PortDetails{
+		Name:         devPath,
+		IsUSB:        true,
+		VID:          sysfsLine(filepath.Join(dir, "idVendor")),
+		PID:          sysfsLine(filepath.Join(dir, "idProduct")),
+		SerialNumber: sysfsLine(filepath.Join(dir, "serial")),
+		Product:      sysfsLine(filepath.Join(dir, "product")),
+	}
+}
+
+// uartPresent reports whether a native port has a UART behind it. The 8250
+// driver registers ttyS ports for every possible UART; those without one
+// have type 0 (PORT_UNKNOWN). Where the driver does not report the type,
+// the port is opened, which fails for missing UARTs.
+func uartPresent(name, dir string) bool {
+	if !strings.HasPrefix(name, "ttyS") && !strings.HasPrefix(name, "ttyHS") {
+		return true
+	}
+	if portType := sysfsLine(filepath.Join(dir, "type")); portType != "" {
+		return portType != "0"
+	}
+	fd, err := unix.Open("/dev/"+name, unix.O_RDWR|unix.O_NOCTTY|unix.O_NONBLOCK|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return errors.Is(err, unix.EBUSY)
+	}
+	unix.Close(fd)
+	return true
+}
+
+// sysfsLine returns the value of a sysfs attribute, or "" when it cannot
+// be read
+func sysfsLine(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
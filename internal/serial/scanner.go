@@ -2,10 +2,12 @@
 package serial
 
 import (
+	"fmt"
 	"regexp"
-	"runtime"
 	"sort"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.bug.st/serial/enumerator"
@@ -20,6 +22,7 @@ const (
 	PortTypeNative
 	PortTypeBluetooth
 	PortTypeVirtual
+	PortTypeNetwork
 )
 
 // String returns the string representation of PortType
@@ -33,6 +36,8 @@ func (p PortType) String() string {
 		return "Bluetooth"
 	case PortTypeVirtual:
 		return "Virtual"
+	case PortTypeNetwork:
+		return "Network"
 	default:
 		return "Unknown"
 	}
@@ -51,21 +56,84 @@ type PortInfo struct {
 	PortType     PortType `json:"port_type"`
 	IsOpen       bool     `json:"is_open"`
 	LockedBy     string   `json:"locked_by"`
+	DeviceFamily string   `json:"device_family,omitempty"`
 }
 
+// DefaultScanTimeout bounds the listing of one scan provider
+const DefaultScanTimeout = 5 * time.Second
+
+// Scan providers
+const (
+	// ScanProviderSystem lists the USB, native and Bluetooth ports found by
+	// the platform enumerator, where the OS has no finer sources
+	ScanProviderSystem = "system"
+	// ScanProviderUSB lists USB serial adapters and CDC ACM devices
+	ScanProviderUSB = "usb"
+	// ScanProviderNative lists on-board and expansion-card UARTs
+	ScanProviderNative = "native"
+	// ScanProviderBluetooth lists bound Bluetooth serial ports
+	ScanProviderBluetooth = "bluetooth"
+	// ScanProviderRemote lists the configured network ports
+	ScanProviderRemote = "remote"
+)
+
 // Scanner handles serial port discovery and enumeration
 type Scanner struct {
 	mu              sync.RWMutex
 	excludePatterns []*regexp.Regexp
 	cachedPorts     []PortInfo
+	remotePorts     []string
+	devices         *DeviceDatabase
 	manager         *Manager
+	providers       []*scanProvider
+	scanTimeout     time.Duration
+	// providerTimeouts override scanTimeout by provider name
+	providerTimeouts map[string]time.Duration
+}
+
+// scanProvider is a source of ports, listed in parallel with the others
+type scanProvider struct {
+	name string
+	list func() ([]PortInfo, error)
+	// busy is set while a listing runs, so a stuck provider is not listed
+	// again on top of it
+	busy atomic.Bool
+}
+
+// ScanResult is the outcome of a scan
+type ScanResult struct {
+	Ports []PortInfo
+	// Incomplete lists the providers that failed or timed out; their ports
+	// are missing from Ports
+	Incomplete []ProviderError
+}
+
+// Partial reports whether ports of some provider may be missing
+func (r ScanResult) Partial() bool {
+	return len(r.Incomplete) > 0
+}
+
+// ProviderError is the failure of one scan provider
+type ProviderError struct {
+	Provider string
+	Err      error
+}
+
+// Error returns the provider and its error
+func (e ProviderError) Error() string {
+	return e.Provider + ": " + e.Err.Error()
 }
 
 // NewScanner creates a new port scanner
 func NewScanner(excludePatterns []string, manager *Manager) (*Scanner, error) {
 	s := &Scanner{
-		manager: manager,
+		manager:     manager,
+		scanTimeout: DefaultScanTimeout,
 	}
+	for _, source := range platform.portSources() {
+		s.AddProvider(source.name, s.localPorts(source.list))
+	}
+	s.AddProvider(ScanProviderRemote, s.remotePortInfos)
 
 	for _, pattern := range excludePatterns {
 		re, err := regexp.Compile(pattern)
@@ -78,21 +146,165 @@ func NewScanner(excludePatterns []string, manager *Manager) (*Scanner, error) {
 	return s, nil
 }
 
-// Scan discovers all available serial ports
-func (s *Scanner) Scan() ([]PortInfo, error) {
-	ports, err := enumerator.GetDetailedPortsList()
-	if err != nil {
-		return nil, err
+// SetRemotePorts sets the configured tcp:// and rfc2217:// endpoints that are
+// listed alongside locally enumerated ports
+func (s *Scanner) SetRemotePorts(names []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.remotePorts = append([]string(nil), names...)
+}
+
+// SetDeviceDatabase sets the database used to identify device families
+func (s *Scanner) SetDeviceDatabase(devices *DeviceDatabase) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.devices = devices
+}
+
+// AddProvider adds a source of ports, listed in parallel with the built-in
+// ones
+func (s *Scanner) AddProvider(name string, list func() ([]PortInfo, error)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.providers = append(s.providers, &scanProvider{name: name, list: list})
+}
+
+// SetScanTimeouts bounds the listing of each provider, by default to
+// timeout and to the overrides given by provider name
+func (s *Scanner) SetScanTimeouts(timeout time.Duration, overrides map[string]time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if timeout > 0 {
+		s.scanTimeout = timeout
 	}
+	s.providerTimeouts = overrides
+}
 
-	var result []PortInfo
+// Scan discovers all available serial ports. Ports of providers that fail
+// or time out are left out; ScanPorts reports which.
+func (s *Scanner) Scan() ([]PortInfo, error) {
+	result, err := s.ScanPorts()
+	return result.Ports, err
+}
 
-	for _, port := range ports {
-		// Check if port should be excluded
-		if s.isExcluded(port.Name) {
+// ScanPorts lists the ports of all providers in parallel, so one stuck
+// device delays the scan by no more than its provider's timeout. It fails
+// only when no provider could be listed.
+func (s *Scanner) ScanPorts() (ScanResult, error) {
+	s.mu.RLock()
+	providers := s.providers
+	s.mu.RUnlock()
+
+	type listing struct {
+		ports []PortInfo
+		err   error
+	}
+	listings := make([]listing, len(providers))
+	var wg sync.WaitGroup
+	for i, provider := range providers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			listings[i].ports, listings[i].err = s.listProvider(provider)
+		}()
+	}
+	wg.Wait()
+
+	var result ScanResult
+	for i, l := range listings {
+		if l.err != nil {
+			result.Incomplete = append(result.Incomplete, ProviderError{Provider: providers[i].name, Err: l.err})
 			continue
 		}
+		for _, info := range l.ports {
+			if s.isExcluded(info.Name) {
+				continue
+			}
+			result.Ports = append(result.Ports, s.portStatus(info))
+		}
+	}
+	if len(providers) > 0 && len(result.Incomplete) == len(providers) {
+		return result, result.Incomplete[0]
+	}
+
+	// Sort ports by name
+	sort.Slice(result.Ports, func(i, j int) bool {
+		return result.Ports[i].Name < result.Ports[j].Name
+	})
+
+	// Cache the results; a partial scan leaves the last complete one
+	if !result.Partial() {
+		s.mu.Lock()
+		s.cachedPorts = result.Ports
+		s.mu.Unlock()
+	}
+
+	return result, nil
+}
+
+// listProvider lists the ports of a provider within its timeout. A listing
+// still running after the timeout is left to finish in the background.
+func (s *Scanner) listProvider(provider *scanProvider) ([]PortInfo, error) {
+	s.mu.RLock()
+	timeout := s.scanTimeout
+	if override, ok := s.providerTimeouts[provider.name]; ok && override > 0 {
+		timeout = override
+	}
+	s.mu.RUnlock()
+
+	if !provider.busy.CompareAndSwap(false, true) {
+		return nil, fmt.Errorf("%w: previous listing still running", ErrScanTimeout)
+	}
 
+	type listing struct {
+		ports []PortInfo
+		err   error
+	}
+	done := make(chan listing, 1)
+	go func() {
+		defer provider.busy.Store(false)
+		ports, err := provider.list()
+		done <- listing{ports, err}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case l := <-done:
+		return l.ports, l.err
+	case <-timer.C:
+		return nil, fmt.Errorf("%w after %s", ErrScanTimeout, timeout)
+	}
+}
+
+// portStatus adds whether the port is open, and by whom
+func (s *Scanner) portStatus(info PortInfo) PortInfo {
+	if s.manager != nil {
+		if session := s.manager.GetSession(info.Name); session != nil {
+			info.IsOpen = true
+			info.LockedBy = session.ClientID
+		}
+	}
+	return info
+}
+
+// localPorts returns a provider listing the ports of a platform source
+func (s *Scanner) localPorts(list func() ([]*enumerator.PortDetails, error)) func() ([]PortInfo, error) {
+	return func() ([]PortInfo, error) {
+		ports, err := list()
+		if err != nil {
+			return nil, err
+		}
+		return s.portInfos(ports), nil
+	}
+}
+
+// portInfos describes the ports found by a platform source
+func (s *Scanner) portInfos(ports []*enumerator.PortDetails) []PortInfo {
+
+	var result []PortInfo
+
+	for _, port := range ports {
 		info := PortInfo{
 			Name:         port.Name,
 			Product:      port.Product,
@@ -110,27 +322,37 @@ func (s *Scanner) Scan() ([]PortInfo, error) {
 		// Set description based on available info
 		info.Description = s.buildDescription(port)
 
-		// Check if port is currently open/locked
-		if s.manager != nil {
-			if session := s.manager.GetSession(port.Name); session != nil {
-				info.IsOpen = true
-				info.LockedBy = session.ClientID
-			}
+		s.mu.RLock()
+		if profile := s.devices.Lookup(port.VID, port.PID); profile != nil {
+			info.DeviceFamily = profile.Family
 		}
+		s.mu.RUnlock()
 
 		result = append(result, info)
 	}
 
-	// Sort ports by name
-	sort.Slice(result, func(i, j int) bool {
-		return result[i].Name < result[j].Name
-	})
+	return result
+}
 
-	// Cache the results
-	s.mu.Lock()
-	s.cachedPorts = result
-	s.mu.Unlock()
+// remotePortInfos builds port entries for configured network ports
+func (s *Scanner) remotePortInfos() ([]PortInfo, error) {
+	s.mu.RLock()
+	names := s.remotePorts
+	s.mu.RUnlock()
 
+	var result []PortInfo
+	for _, name := range names {
+		info := PortInfo{
+			Name:        name,
+			Description: "Remote serial server (raw TCP)",
+			PortType:    PortTypeNetwork,
+		}
+		if strings.HasPrefix(name, rfc2217PortPrefix) {
+			info.Description = "Remote serial server (RFC 2217)"
+		}
+
+		result = append(result, info)
+	}
 	return result, nil
 }
 
@@ -164,6 +386,36 @@ func (s *Scanner) GetPort(name string) (*PortInfo, error) {
 	return nil, ErrPortNotFound
 }
 
+// DeviceProfile returns the profile of the device behind a port, or nil if
+// the device is unknown
+func (s *Scanner) DeviceProfile(name string) *DeviceProfile {
+	s.mu.RLock()
+	devices := s.devices
+	var info *PortInfo
+	for i := range s.cachedPorts {
+		if s.cachedPorts[i].Name == name {
+			port := s.cachedPorts[i]
+			info = &port
+			break
+		}
+	}
+	s.mu.RUnlock()
+
+	if devices == nil || IsNetworkPort(name) {
+		return nil
+	}
+
+	if info == nil {
+		port, err := s.GetPort(name)
+		if err != nil {
+			return nil
+		}
+		info = port
+	}
+
+	return devices.Lookup(info.VID, info.PID)
+}
+
 // isExcluded checks if a port should be excluded based on patterns
 func (s *Scanner) isExcluded(name string) bool {
 	for _, pattern := range s.excludePatterns {
@@ -180,28 +432,9 @@ func (s *Scanner) detectPortType(port *enumerator.PortDetails) PortType {
 		return PortTypeUSB
 	}
 
-	// Check for Bluetooth ports
-	switch runtime.GOOS {
-	case "windows":
-		// Windows Bluetooth COM ports often have specific names
-		if matched, _ := regexp.MatchString(`(?i)bluetooth|bth`, port.Name); matched {
-			return PortTypeBluetooth
-		}
-	case "linux":
-		if matched, _ := regexp.MatchString(`/dev/rfcomm`, port.Name); matched {
-			return PortTypeBluetooth
-		}
-	case "darwin":
-		if matched, _ := regexp.MatchString(`/dev/.*Bluetooth`, port.Name); matched {
-			return PortTypeBluetooth
-		}
-	}
-
-	// Check for virtual/pseudo terminals
-	if runtime.GOOS == "linux" {
-		if matched, _ := regexp.MatchString(`/dev/pts/|/dev/pty`, port.Name); matched {
-			return PortTypeVirtual
-		}
+	// Bluetooth and virtual ports are named differently on each platform
+	if portType := platform.portType(port.Name); portType != PortTypeUnknown {
+		return portType
 	}
 
 	return PortTypeNative
@@ -240,10 +473,13 @@ func (s *Scanner) WatchPorts(intervalSeconds int, callback PortChangeCallback) c
 			case <-stop:
 				return
 			case <-ticker.C:
-				ports, err := s.Scan()
-				if err != nil {
+				// A partial scan would report the ports of a stuck
+				// provider as removed
+				result, err := s.ScanPorts()
+				if err != nil || result.Partial() {
 					continue
 				}
+				ports := result.Ports
 
 				currentPorts := make(map[string]PortInfo)
 				for _, p := range ports {
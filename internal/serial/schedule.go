@@ -0,0 +1,109 @@
+package serial
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// MaxScheduleAhead is how far in the future a write may be scheduled
+	MaxScheduleAhead = 24 * time.Hour
+
+	// scheduleLateTolerance is how late a scheduled write may still be sent
+	scheduleLateTolerance = 100 * time.Millisecond
+
+	// scheduleSpin is the final stretch that is busy-waited, since sleeping
+	// timers can overshoot by a millisecond or more
+	scheduleSpin = 2 * time.Millisecond
+
+	// scheduleLockAhead is how early the session is locked so another
+	// operation cannot delay the write
+	scheduleLockAhead = 10 * time.Millisecond
+)
+
+// WriteAt writes data to a port at the given wall-clock time, with
+// millisecond accuracy on an otherwise idle port. It returns the number of
+// bytes written and the time transmission started. Times that passed less
+// than scheduleLateTolerance ago are sent immediately.
+func (m *Manager) WriteAt(ctx context.Context, portName, sessionID string, data []byte, at time.Time) (int, time.Time, error) {
+	session, err := m.ValidateSession(portName, sessionID)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	if err := m.filterWrite(session, data); err != nil {
+		return 0, time.Time{}, err
+	}
+	if err := ValidateSchedule(at); err != nil {
+		return 0, time.Time{}, err
+	}
+
+	if err := sleepUntil(ctx, at.Add(-scheduleLockAhead)); err != nil {
+		return 0, time.Time{}, err
+	}
+
+	// The session may have been closed while waiting
+	session, err = m.ValidateSession(portName, sessionID)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	if err := m.flushCoalesced(session); err != nil {
+		return 0, time.Time{}, err
+	}
+
+	session.writes.acquire(session.Priority())
+	defer session.writes.release()
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if err := sleepUntil(ctx, at.Add(-scheduleSpin)); err != nil {
+		return 0, time.Time{}, err
+	}
+	for time.Now().Before(at) {
+	}
+
+	sentAt := time.Now()
+	n, err := session.port.Write(data)
+	if err != nil {
+		m.recordError(session, "scheduled_write", err)
+		return n, sentAt, fmt.Errorf("write failed: %w", err)
+	}
+
+	atomic.AddUint64(&session.Statistics.BytesSent, uint64(n))
+	session.Statistics.LastActivity = time.Now()
+	session.recordTraffic(DirectionTX, data[:n])
+
+	return n, sentAt, nil
+}
+
+// ValidateSchedule checks that a write can be scheduled at the given time
+func ValidateSchedule(at time.Time) error {
+	now := time.Now()
+	if at.Before(now.Add(-scheduleLateTolerance)) {
+		return fmt.Errorf("%w: %s already passed", ErrScheduleInvalid, at.Format(time.RFC3339Nano))
+	}
+	if at.After(now.Add(MaxScheduleAhead)) {
+		return fmt.Errorf("%w: more than %s ahead", ErrScheduleInvalid, MaxScheduleAhead)
+	}
+	return nil
+}
+
+// sleepUntil waits until t or until ctx is cancelled
+func sleepUntil(ctx context.Context, t time.Time) error {
+	d := time.Until(t)
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
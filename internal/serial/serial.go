@@ -83,6 +83,64 @@ func (f FlowControl) String() string {
 	}
 }
 
+// LatencyProfile trades CPU time and USB bandwidth for response time
+type LatencyProfile int
+
+const (
+	LatencyProfileDefault LatencyProfile = iota // driver settings
+	LatencyProfileLow                           // for tight request/response loops
+)
+
+// String returns the string representation of LatencyProfile
+func (l LatencyProfile) String() string {
+	switch l {
+	case LatencyProfileDefault:
+		return "default"
+	case LatencyProfileLow:
+		return "low"
+	default:
+		return "unknown"
+	}
+}
+
+// LineState is the state a modem control line is put in when a port opens
+type LineState int
+
+const (
+	// LineStateDefault leaves the line as the driver sets it on open,
+	// which is asserted
+	LineStateDefault LineState = iota
+	LineStateHigh
+	LineStateLow
+	// LineStateUntouched ("no touch") never changes the line: the agent
+	// does not set it on open and, on Linux, closing the port does not drop
+	// it (HUPCL is cleared), so devices that reset on a DTR edge, such as
+	// Arduinos, keep running across reconnects. Windows asserts the lines
+	// on open regardless.
+	LineStateUntouched
+)
+
+// String returns the string representation of LineState
+func (l LineState) String() string {
+	switch l {
+	case LineStateDefault:
+		return "default"
+	case LineStateHigh:
+		return "high"
+	case LineStateLow:
+		return "low"
+	case LineStateUntouched:
+		return "untouched"
+	default:
+		return "unknown"
+	}
+}
+
+// explicit reports whether the line is set to a level on open
+func (l LineState) explicit() bool {
+	return l == LineStateHigh || l == LineStateLow
+}
+
 // PortConfig represents serial port configuration
 type PortConfig struct {
 	BaudRate       int
@@ -92,6 +150,10 @@ type PortConfig struct {
 	FlowControl    FlowControl
 	ReadTimeoutMs  int
 	WriteTimeoutMs int
+	LatencyProfile LatencyProfile
+	// DTR and RTS are the states of the control lines on open
+	DTR LineState
+	RTS LineState
 }
 
 // DefaultConfig returns a default port configuration
@@ -140,6 +202,16 @@ func (c PortConfig) Validate() error {
 		return fmt.Errorf("%w: invalid flow control value", ErrInvalidConfig)
 	}
 
+	if c.LatencyProfile < LatencyProfileDefault || c.LatencyProfile > LatencyProfileLow {
+		return fmt.Errorf("%w: invalid latency profile value", ErrInvalidConfig)
+	}
+
+	for _, line := range []LineState{c.DTR, c.RTS} {
+		if line < LineStateDefault || line > LineStateUntouched {
+			return fmt.Errorf("%w: invalid line state value", ErrInvalidConfig)
+		}
+	}
+
 	return nil
 }
 
@@ -172,6 +244,15 @@ func (c PortConfig) ToSerialMode() *serial.Mode {
 		mode.Parity = serial.SpaceParity
 	}
 
+	// The library sets both lines or neither; a single explicit line is
+	// set once the port is open
+	if c.DTR.explicit() && c.RTS.explicit() {
+		mode.InitialStatusBits = &serial.ModemOutputBits{
+			DTR: c.DTR == LineStateHigh,
+			RTS: c.RTS == LineStateHigh,
+		}
+	}
+
 	return mode
 }
 
@@ -182,6 +263,12 @@ type PortStatistics struct {
 	Errors        uint64
 	OpenedAt      time.Time
 	LastActivity  time.Time
+
+	// Line quality, tracked when monitoring is enabled on the manager.
+	// LineQuality is the share of text bytes in the last evaluated window.
+	GarbageBytes uint64
+	BreakCount   uint64
+	LineQuality  float64
 }
 
 // ReadResult represents the result of a read operation with timeout
@@ -239,6 +326,34 @@ func ParseFlowControl(value string) (FlowControl, error) {
 	}
 }
 
+// ParseLatencyProfile converts a latency profile string into a LatencyProfile enum.
+func ParseLatencyProfile(value string) (LatencyProfile, error) {
+	switch strings.ToLower(value) {
+	case "", "default":
+		return LatencyProfileDefault, nil
+	case "low":
+		return LatencyProfileLow, nil
+	default:
+		return LatencyProfileDefault, fmt.Errorf("%w: invalid latency profile %q", ErrInvalidConfig, value)
+	}
+}
+
+// ParseLineState converts a line state string into a LineState enum.
+func ParseLineState(value string) (LineState, error) {
+	switch strings.ToLower(value) {
+	case "", "default":
+		return LineStateDefault, nil
+	case "high":
+		return LineStateHigh, nil
+	case "low":
+		return LineStateLow, nil
+	case "untouched":
+		return LineStateUntouched, nil
+	default:
+		return LineStateDefault, fmt.Errorf("%w: invalid line state %q", ErrInvalidConfig, value)
+	}
+}
+
 // ParseStopBits converts a stop bits integer into a StopBits enum.
 func ParseStopBits(value int) (StopBits, error) {
 	switch value {
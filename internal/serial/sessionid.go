@@ -0,0 +1,69 @@
+package serial
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/charmbracelet/log"
+)
+
+// shortIDAttempts bounds the tries for a short ID not in use
+const shortIDAttempts = 16
+
+// ShortIDGenerator makes a short, human-friendly session ID for a port. It
+// is called again when the ID is already in use.
+type ShortIDGenerator func(portName string) string
+
+// DefaultShortID makes IDs such as "COM3-7f3a" or "ttyUSB0-03bc" from the
+// last element of the port name and four random hex digits
+func DefaultShortID(portName string) string {
+	base := portName[strings.LastIndexAny(portName, `/\`)+1:]
+	base = strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '.' || r == '_' {
+			return r
+		}
+		return '-'
+	}, base)
+	if base == "" {
+		base = "port"
+	}
+	return fmt.Sprintf("%s-%04x", base, rand.IntN(0x10000))
+}
+
+// SetShortIDGenerator gives sessions opened afterwards a short ID from gen
+// next to their UUID; either is accepted wherever a session ID is. Nil
+// disables short IDs.
+func (m *Manager) SetShortIDGenerator(gen ShortIDGenerator) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.shortIDs = gen
+}
+
+// newShortID returns a short ID not in use for a port, or "" when disabled
+// or none was found (lock held)
+func (m *Manager) newShortID(portName string) string {
+	if m.shortIDs == nil {
+		return ""
+	}
+	for range shortIDAttempts {
+		id := m.shortIDs(portName)
+		if id == "" {
+			return ""
+		}
+		if _, used := m.sessionsByShortID[id]; used {
+			continue
+		}
+		if _, used := m.sessionsByID[id]; used {
+			continue
+		}
+		return id
+	}
+	log.Warn("no free short session ID", "port", portName)
+	return ""
+}
+
+// HasID reports whether id is the session's ID or short ID
+func (s *Session) HasID(id string) bool {
+	return id != "" && (id == s.ID || id == s.ShortID)
+}
@@ -0,0 +1,185 @@
+package serial
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Shaping throttles a session's traffic to emulate a slow or congested
+// link, e.g. to test how an application copes with timeouts, without
+// touching the port's line settings. Received data the session is not yet
+// allowed to take waits in the driver, so a device that sends faster than
+// the limit for long may overrun the port's buffer.
+type Shaping struct {
+	// RxBytesPerSec and TxBytesPerSec limit received and sent data
+	// (0: unlimited)
+	RxBytesPerSec int
+	TxBytesPerSec int
+	// RxBurst and TxBurst are the bytes that pass at once after a quiet
+	// spell (0: a tenth of a second's worth, at least 1)
+	RxBurst int
+	TxBurst int
+}
+
+// Validate checks that a shaping is usable
+func (s Shaping) Validate() error {
+	if s.RxBytesPerSec < 0 || s.TxBytesPerSec < 0 || s.RxBurst < 0 || s.TxBurst < 0 {
+		return fmt.Errorf("%w: shaping rates and bursts cannot be negative", ErrInvalidConfig)
+	}
+	if s.RxBurst > 0 && s.RxBytesPerSec == 0 || s.TxBurst > 0 && s.TxBytesPerSec == 0 {
+		return fmt.Errorf("%w: a burst needs a rate", ErrInvalidConfig)
+	}
+	return nil
+}
+
+// Enabled reports whether a shaping limits any traffic
+func (s Shaping) Enabled() bool {
+	return s.RxBytesPerSec > 0 || s.TxBytesPerSec > 0
+}
+
+// tokenBucket lets bytes pass at a rate, up to a burst at once. A nil
+// bucket lets everything pass.
+type tokenBucket struct {
+	mu sync.Mutex
+	// rate is in bytes per second
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket creates a full bucket, or nil for an unlimited rate
+func newTokenBucket(rate, burst int) *tokenBucket {
+	if rate <= 0 {
+		return nil
+	}
+	if burst <= 0 {
+		burst = max(1, rate/10)
+	}
+	return &tokenBucket{
+		rate:   float64(rate),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// refill adds the tokens earned since the last refill (lock held)
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+}
+
+// wait blocks until at least one byte may pass and returns how many of n
+// may. The caller consumes what it actually moved.
+func (b *tokenBucket) wait(n int) int {
+	if b == nil {
+		return n
+	}
+	for {
+		b.mu.Lock()
+		b.refill(time.Now())
+		if b.tokens >= 1 {
+			allowed := min(n, int(b.tokens))
+			b.mu.Unlock()
+			return allowed
+		}
+		delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+		time.Sleep(delay)
+	}
+}
+
+// consume takes n bytes from the bucket
+func (b *tokenBucket) consume(n int) {
+	if b == nil || n <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refill(time.Now())
+	b.tokens -= float64(n)
+}
+
+// shaper applies a session's shaping
+type shaper struct {
+	shaping Shaping
+	rx, tx  *tokenBucket
+}
+
+// receive returns the bucket for received data, nil when unlimited
+func (sh *shaper) receive() *tokenBucket {
+	if sh == nil {
+		return nil
+	}
+	return sh.rx
+}
+
+// send returns the bucket for sent data, nil when unlimited
+func (sh *shaper) send() *tokenBucket {
+	if sh == nil {
+		return nil
+	}
+	return sh.tx
+}
+
+// SetShaping throttles a session's traffic from now on; the zero Shaping
+// lifts the limits
+func (m *Manager) SetShaping(portName string, sessionID string, shaping Shaping) error {
+	if err := shaping.Validate(); err != nil {
+		return err
+	}
+	session, err := m.ValidateSession(portName, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if !shaping.Enabled() {
+		session.shaping.Store(nil)
+		return nil
+	}
+	session.shaping.Store(&shaper{
+		shaping: shaping,
+		rx:      newTokenBucket(shaping.RxBytesPerSec, shaping.RxBurst),
+		tx:      newTokenBucket(shaping.TxBytesPerSec, shaping.TxBurst),
+	})
+	return nil
+}
+
+// Shaping returns the session's shaping, the zero Shaping when unlimited
+func (s *Session) Shaping() Shaping {
+	if sh := s.shaping.Load(); sh != nil {
+		return sh.shaping
+	}
+	return Shaping{}
+}
+
+// writeShaped writes data in the pieces the session's shaping lets pass,
+// taking the session lock for each so reads go on in between (write gate
+// held)
+func (m *Manager) writeShaped(session *Session, data []byte) (int, error) {
+	tx := session.shaping.Load().send()
+	if tx == nil {
+		session.mu.Lock()
+		defer session.mu.Unlock()
+		return m.writeLocked(session, data)
+	}
+
+	written := 0
+	for written < len(data) {
+		n := tx.wait(len(data) - written)
+		if session.IsClosed() {
+			return written, ErrPortClosed
+		}
+		session.mu.Lock()
+		n, err := m.writeLocked(session, data[written:written+n])
+		session.mu.Unlock()
+		tx.consume(n)
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
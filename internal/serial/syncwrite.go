@@ -0,0 +1,128 @@
+package serial
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// SyncWrite is one payload of a synchronized write
+type SyncWrite struct {
+	PortName  string
+	SessionID string
+	Data      []byte
+}
+
+// SyncWriteResult reports when a payload actually went out
+type SyncWriteResult struct {
+	PortName     string
+	BytesWritten int
+	StartedAt    time.Time
+	CompletedAt  time.Time
+	Error        error
+}
+
+// SynchronizedWrite stages payloads for several open ports and releases them
+// together, so transmission starts on all ports as close to the same instant
+// as the OS allows. Every session is validated and locked before anything
+// is sent; results are returned in request order.
+func (m *Manager) SynchronizedWrite(writes []SyncWrite) ([]SyncWriteResult, error) {
+	sessions, err := m.syncSessions(writes)
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		if err := m.flushCoalesced(session); err != nil {
+			return nil, err
+		}
+	}
+
+	// Lock in name order so concurrent synchronized writes cannot deadlock
+	order := make([]int, len(writes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return writes[order[a]].PortName < writes[order[b]].PortName })
+	for _, i := range order {
+		sessions[i].mu.Lock()
+	}
+	defer func() {
+		for _, i := range order {
+			sessions[i].mu.Unlock()
+		}
+	}()
+
+	results := make([]SyncWriteResult, len(writes))
+	start := make(chan struct{})
+	var ready, done sync.WaitGroup
+	ready.Add(len(writes))
+	done.Add(len(writes))
+
+	for i := range writes {
+		go func(i int) {
+			defer done.Done()
+			session := sessions[i]
+
+			// Pin the goroutine so it is already scheduled on a thread when
+			// the start signal arrives
+			runtime.LockOSThread()
+			defer runtime.UnlockOSThread()
+
+			ready.Done()
+			<-start
+
+			result := SyncWriteResult{PortName: writes[i].PortName, StartedAt: time.Now()}
+			n, err := session.port.Write(writes[i].Data)
+			result.CompletedAt = time.Now()
+			result.BytesWritten = n
+
+			if err != nil {
+				m.recordError(session, "sync_write", err)
+				result.Error = fmt.Errorf("write failed: %w", err)
+			}
+			atomic.AddUint64(&session.Statistics.BytesSent, uint64(n))
+			session.Statistics.LastActivity = result.CompletedAt
+			session.recordTraffic(DirectionTX, writes[i].Data[:n])
+
+			results[i] = result
+		}(i)
+	}
+
+	ready.Wait()
+	close(start)
+	done.Wait()
+
+	return results, nil
+}
+
+// ValidateSynchronizedWrite checks a synchronized write without sending it
+func (m *Manager) ValidateSynchronizedWrite(writes []SyncWrite) error {
+	_, err := m.syncSessions(writes)
+	return err
+}
+
+// syncSessions validates the session of every payload, in request order,
+// and applies the write filter to the payloads
+func (m *Manager) syncSessions(writes []SyncWrite) ([]*Session, error) {
+	sessions := make([]*Session, len(writes))
+	seen := make(map[string]bool, len(writes))
+	for i, w := range writes {
+		if seen[w.PortName] {
+			return nil, fmt.Errorf("%w: port %s listed more than once", ErrInvalidConfig, w.PortName)
+		}
+		seen[w.PortName] = true
+
+		session, err := m.ValidateSession(w.PortName, w.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", w.PortName, err)
+		}
+		if err := m.filterWrite(session, w.Data); err != nil {
+			return nil, fmt.Errorf("%s: %w", w.PortName, err)
+		}
+		sessions[i] = session
+	}
+	return sessions, nil
+}
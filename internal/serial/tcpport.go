@@ -0,0 +1,363 @@
+package serial
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"go.bug.st/serial"
+)
+
+// Network port name prefixes. A port named "tcp://host:port" is a raw TCP
+// socket (ser2net "raw" mode); "rfc2217://host:port" additionally negotiates
+// the Telnet COM-PORT-OPTION so line settings are applied on the remote side.
+const (
+	tcpPortPrefix     = "tcp://"
+	rfc2217PortPrefix = "rfc2217://"
+)
+
+// Telnet and RFC 2217 protocol constants
+const (
+	telnetIAC  byte = 255
+	telnetDONT byte = 254
+	telnetDO   byte = 253
+	telnetWONT byte = 252
+	telnetWILL byte = 251
+	telnetSB   byte = 250
+	telnetSE   byte = 240
+
+	telnetOptBinary   byte = 0
+	telnetOptSGA      byte = 3
+	telnetOptComPort  byte = 44
+	comPortSetBaud    byte = 1
+	comPortSetData    byte = 2
+	comPortSetParity  byte = 3
+	comPortSetStop    byte = 4
+	comPortSetControl byte = 5
+	comPortPurgeData  byte = 12
+
+	comPortControlFlowNone     byte = 1
+	comPortControlFlowSoftware byte = 2
+	comPortControlFlowHardware byte = 3
+	comPortControlBreakOn      byte = 5
+	comPortControlBreakOff     byte = 6
+	comPortControlDTROn        byte = 8
+	comPortControlDTROff       byte = 9
+	comPortControlRTSOn        byte = 11
+	comPortControlRTSOff       byte = 12
+	comPortPurgeRX             byte = 1
+	comPortPurgeTX             byte = 2
+)
+
+// IsNetworkPort reports whether a port name refers to a remote serial server
+func IsNetworkPort(name string) bool {
+	return strings.HasPrefix(name, tcpPortPrefix) || strings.HasPrefix(name, rfc2217PortPrefix)
+}
+
+// flowControlSetter is implemented by ports that can apply flow control
+// themselves; the local driver does not expose it
+type flowControlSetter interface {
+	setFlowControl(fc FlowControl) error
+}
+
+// openPort opens a local serial device or a remote network port by name
+func openPort(name string, config PortConfig) (serial.Port, error) {
+	if IsNetworkPort(name) {
+		return openNetworkPort(name, config)
+	}
+	return serial.Open(name, config.ToSerialMode())
+}
+
+// tcpPort implements serial.Port on top of a TCP connection
+type tcpPort struct {
+	conn        net.Conn
+	telnet      bool
+	readTimeout time.Duration
+	writeMu     sync.Mutex
+
+	// Telnet parser state, only touched by Read
+	pending []byte
+	inIAC   bool
+	inSB    bool
+	sbIAC   bool
+	cmd     byte
+}
+
+// openNetworkPort dials a tcp:// or rfc2217:// endpoint
+func openNetworkPort(name string, config PortConfig) (serial.Port, error) {
+	telnet := strings.HasPrefix(name, rfc2217PortPrefix)
+	address := strings.TrimPrefix(strings.TrimPrefix(name, tcpPortPrefix), rfc2217PortPrefix)
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		return nil, fmt.Errorf("%w: invalid network port address %q", ErrInvalidConfig, address)
+	}
+
+	conn, err := net.DialTimeout("tcp", address, 10*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &tcpPort{conn: conn, telnet: telnet, readTimeout: serial.NoTimeout}
+	if telnet {
+		if err := p.negotiate(); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	if err := p.SetMode(config.ToSerialMode()); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	if err := p.setFlowControl(config.FlowControl); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// negotiate announces the binary, suppress-go-ahead and COM-PORT options
+func (p *tcpPort) negotiate() error {
+	return p.writeRaw([]byte{
+		telnetIAC, telnetWILL, telnetOptBinary,
+		telnetIAC, telnetDO, telnetOptBinary,
+		telnetIAC, telnetWILL, telnetOptSGA,
+		telnetIAC, telnetDO, telnetOptSGA,
+		telnetIAC, telnetWILL, telnetOptComPort,
+	})
+}
+
+// comPortCommand sends an RFC 2217 subnegotiation; a no-op for raw TCP ports
+func (p *tcpPort) comPortCommand(command byte, value []byte) error {
+	if !p.telnet {
+		return nil
+	}
+
+	msg := []byte{telnetIAC, telnetSB, telnetOptComPort, command}
+	msg = append(msg, escapeIAC(value)...)
+	msg = append(msg, telnetIAC, telnetSE)
+	return p.writeRaw(msg)
+}
+
+func (p *tcpPort) writeRaw(data []byte) error {
+	p.writeMu.Lock()
+	defer p.writeMu.Unlock()
+	_, err := p.conn.Write(data)
+	return err
+}
+
+// SetMode applies line settings on the remote port (RFC 2217 only)
+func (p *tcpPort) SetMode(mode *serial.Mode) error {
+	if mode == nil || !p.telnet {
+		return nil
+	}
+
+	baud := uint32(mode.BaudRate)
+	if err := p.comPortCommand(comPortSetBaud, []byte{byte(baud >> 24), byte(baud >> 16), byte(baud >> 8), byte(baud)}); err != nil {
+		return err
+	}
+	if err := p.comPortCommand(comPortSetData, []byte{byte(mode.DataBits)}); err != nil {
+		return err
+	}
+
+	// RFC 2217 numbers parity NONE=1, ODD=2, EVEN=3, MARK=4, SPACE=5
+	if err := p.comPortCommand(comPortSetParity, []byte{byte(mode.Parity) + 1}); err != nil {
+		return err
+	}
+
+	// RFC 2217 numbers stop bits 1=1, 2=2, 1.5=3
+	stop := byte(1)
+	switch mode.StopBits {
+	case serial.TwoStopBits:
+		stop = 2
+	case serial.OnePointFiveStopBits:
+		stop = 3
+	}
+	return p.comPortCommand(comPortSetStop, []byte{stop})
+}
+
+// setFlowControl applies the flow control mode on the remote port
+func (p *tcpPort) setFlowControl(fc FlowControl) error {
+	value := comPortControlFlowNone
+	switch fc {
+	case FlowControlHardware:
+		value = comPortControlFlowHardware
+	case FlowControlSoftware:
+		value = comPortControlFlowSoftware
+	}
+	return p.comPortCommand(comPortSetControl, []byte{value})
+}
+
+// Read reads payload bytes, stripping Telnet commands for RFC 2217 ports.
+// Like the local driver it returns 0 bytes and no error on read timeout.
+func (p *tcpPort) Read(buf []byte) (int, error) {
+	for {
+		if len(p.pending) > 0 {
+			n := copy(buf, p.pending)
+			p.pending = p.pending[n:]
+			return n, nil
+		}
+
+		if p.readTimeout > 0 {
+			_ = p.conn.SetReadDeadline(time.Now().Add(p.readTimeout))
+		} else {
+			_ = p.conn.SetReadDeadline(time.Time{})
+		}
+
+		n, err := p.conn.Read(buf)
+		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				return 0, nil
+			}
+			return 0, err
+		}
+
+		if !p.telnet {
+			return n, nil
+		}
+
+		p.pending = p.filterTelnet(buf[:n])
+	}
+}
+
+// filterTelnet removes Telnet command sequences from incoming data and
+// refuses any option the remote side proposes that we did not ask for
+func (p *tcpPort) filterTelnet(in []byte) []byte {
+	out := make([]byte, 0, len(in))
+	for _, b := range in {
+		switch {
+		case p.inSB:
+			// Subnegotiation replies (e.g. baud acknowledgements) are ignored
+			if p.sbIAC {
+				p.sbIAC = false
+				if b == telnetSE {
+					p.inSB = false
+				}
+			} else if b == telnetIAC {
+				p.sbIAC = true
+			}
+		case p.cmd != 0:
+			p.replyToOption(p.cmd, b)
+			p.cmd = 0
+		case p.inIAC:
+			p.inIAC = false
+			switch b {
+			case telnetIAC:
+				out = append(out, telnetIAC)
+			case telnetSB:
+				p.inSB = true
+			case telnetWILL, telnetWONT, telnetDO, telnetDONT:
+				p.cmd = b
+			}
+		case b == telnetIAC:
+			p.inIAC = true
+		default:
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+func (p *tcpPort) replyToOption(cmd, option byte) {
+	switch option {
+	case telnetOptBinary, telnetOptSGA, telnetOptComPort:
+		return
+	}
+	switch cmd {
+	case telnetWILL:
+		_ = p.writeRaw([]byte{telnetIAC, telnetDONT, option})
+	case telnetDO:
+		_ = p.writeRaw([]byte{telnetIAC, telnetWONT, option})
+	}
+}
+
+// Write sends payload bytes, escaping IAC for RFC 2217 ports
+func (p *tcpPort) Write(data []byte) (int, error) {
+	if !p.telnet {
+		p.writeMu.Lock()
+		defer p.writeMu.Unlock()
+		return p.conn.Write(data)
+	}
+
+	if err := p.writeRaw(escapeIAC(data)); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// Drain is a no-op; TCP writes are handed to the kernel synchronously
+func (p *tcpPort) Drain() error {
+	return nil
+}
+
+// ResetInputBuffer discards buffered input and asks the server to purge RX
+func (p *tcpPort) ResetInputBuffer() error {
+	p.pending = nil
+	return p.comPortCommand(comPortPurgeData, []byte{comPortPurgeRX})
+}
+
+// ResetOutputBuffer asks the server to purge TX
+func (p *tcpPort) ResetOutputBuffer() error {
+	return p.comPortCommand(comPortPurgeData, []byte{comPortPurgeTX})
+}
+
+// SetDTR sets the remote DTR line (RFC 2217 only)
+func (p *tcpPort) SetDTR(dtr bool) error {
+	if dtr {
+		return p.comPortCommand(comPortSetControl, []byte{comPortControlDTROn})
+	}
+	return p.comPortCommand(comPortSetControl, []byte{comPortControlDTROff})
+}
+
+// SetRTS sets the remote RTS line (RFC 2217 only)
+func (p *tcpPort) SetRTS(rts bool) error {
+	if rts {
+		return p.comPortCommand(comPortSetControl, []byte{comPortControlRTSOn})
+	}
+	return p.comPortCommand(comPortSetControl, []byte{comPortControlRTSOff})
+}
+
+// GetModemStatusBits is not tracked for network ports and reports all lines low
+func (p *tcpPort) GetModemStatusBits() (*serial.ModemStatusBits, error) {
+	return &serial.ModemStatusBits{}, nil
+}
+
+// SetReadTimeout sets the timeout applied to each Read
+func (p *tcpPort) SetReadTimeout(t time.Duration) error {
+	p.readTimeout = t
+	return nil
+}
+
+// Close closes the TCP connection
+func (p *tcpPort) Close() error {
+	return p.conn.Close()
+}
+
+// Break asserts a break condition on the remote port for the given duration
+func (p *tcpPort) Break(d time.Duration) error {
+	if !p.telnet {
+		return nil
+	}
+	if err := p.comPortCommand(comPortSetControl, []byte{comPortControlBreakOn}); err != nil {
+		return err
+	}
+	time.Sleep(d)
+	return p.comPortCommand(comPortSetControl, []byte{comPortControlBreakOff})
+}
+
+// escapeIAC doubles every IAC byte as required inside a Telnet stream
+func escapeIAC(data []byte) []byte {
+	escaped := make([]byte, 0, len(data))
+	for _, b := range data {
+		escaped = append(escaped, b)
+		if b == telnetIAC {
+			escaped = append(escaped, telnetIAC)
+		}
+	}
+	return escaped
+}
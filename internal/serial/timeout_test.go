@@ -0,0 +1,94 @@
+package serial
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/clock"
+)
+
+func TestWriteWithinTimesOutWhileGateIsHeld(t *testing.T) {
+	clk := clock.NewFake(time.Unix(0, 0))
+	m, session, device := openVirtual(t, clk)
+
+	// Another writer holds the port
+	session.writes.acquire(PriorityNormal)
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := m.WriteWithin(session.PortName, session.ID, []byte("late"), time.Second)
+		result <- err
+	}()
+
+	// The write waits on the gate's deadline timer
+	clk.BlockUntil(1)
+	clk.Advance(999 * time.Millisecond)
+	select {
+	case err := <-result:
+		t.Fatalf("write returned %v before its deadline", err)
+	default:
+	}
+
+	clk.Advance(time.Millisecond)
+	if err := <-result; !errors.Is(err, ErrWriteTimeout) {
+		t.Fatalf("write returned %v, want %v", err, ErrWriteTimeout)
+	}
+
+	session.writes.release()
+	if _, err := m.WriteWithin(session.PortName, session.ID, []byte("ok"), time.Second); err != nil {
+		t.Fatalf("write after release: %v", err)
+	}
+	device.expect("ok")
+}
+
+func TestCoalescedWritesWaitForWindow(t *testing.T) {
+	clk := clock.NewFake(time.Unix(0, 0))
+	m, session, device := openVirtual(t, clk)
+
+	if err := m.SetWriteCoalescing(session.PortName, session.ID, 50*time.Millisecond); err != nil {
+		t.Fatalf("set coalescing: %v", err)
+	}
+	for _, data := range []string{"A", "T", "\r"} {
+		if _, err := m.Write(session.PortName, session.ID, []byte(data)); err != nil {
+			t.Fatalf("write %q: %v", data, err)
+		}
+	}
+
+	// Nothing goes out before the window ends
+	clk.Advance(49 * time.Millisecond)
+	c := session.coalescer.Load()
+	c.mu.Lock()
+	held := string(c.held)
+	c.mu.Unlock()
+	if held != "AT\r" {
+		t.Fatalf("held %q before the window ended, want %q", held, "AT\r")
+	}
+
+	clk.Advance(time.Millisecond)
+	device.expect("AT\r")
+}
+
+func TestTransactWithSimulatedModem(t *testing.T) {
+	m, session, device := openVirtual(t, clock.System)
+	device.respond(func(line string) string {
+		if line == "AT" {
+			return "\r\nOK\r\n"
+		}
+		return "\r\nERROR\r\n"
+	})
+
+	var answer string
+	err := m.Transact(session.PortName, session.ID, 100*time.Millisecond, func(rw io.ReadWriter) error {
+		if _, err := rw.Write([]byte("AT\r")); err != nil {
+			return err
+		}
+		var err error
+		answer, err = readUntil(rw, "OK\r\n")
+		return err
+	})
+	if err != nil {
+		t.Fatalf("transact: %v (received %q)", err, answer)
+	}
+}
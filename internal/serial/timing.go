@@ -0,0 +1,124 @@
+package serial
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// timingPollInterval is the read timeout during a timing capture. Data is
+// returned as soon as it arrives; the timeout only bounds how long other
+// operations wait for the port between reads.
+const timingPollInterval = 10 * time.Millisecond
+
+// TimedRead is data returned by one read system call. The data arrived
+// between Started and Completed; with per-byte reads, Completed is the best
+// available estimate of a byte's arrival. The serial drivers used do not
+// expose hardware receive timestamps.
+type TimedRead struct {
+	Data      []byte
+	Started   time.Time
+	Completed time.Time
+	// Gap is the time since the previous read that returned data
+	Gap      time.Duration
+	Sequence uint32
+}
+
+// TimingOptions control a timing capture
+type TimingOptions struct {
+	// PerByte reads one byte per system call, timestamping every byte at
+	// the cost of CPU time
+	PerByte bool
+	// BufferSize is the read size without PerByte (default: 4096)
+	BufferSize int
+}
+
+// CharTime is the time one character occupies on the line: start bit, data
+// bits, parity and stop bits
+func (c PortConfig) CharTime() time.Duration {
+	if c.BaudRate <= 0 {
+		return 0
+	}
+	bits := 1 + float64(c.DataBits)
+	if c.Parity != ParityNone {
+		bits++
+	}
+	switch c.StopBits {
+	case StopBits1Half:
+		bits += 1.5
+	case StopBits2:
+		bits += 2
+	default:
+		bits++
+	}
+	return time.Duration(bits * float64(time.Second) / float64(c.BaudRate))
+}
+
+// CaptureTiming reads from a session until ctx is cancelled, passing every
+// read that returned data to fn with timestamps taken around the system
+// call. Data is consumed like Read; other operations on the port run
+// between reads. It returns nil when ctx is cancelled.
+func (m *Manager) CaptureTiming(ctx context.Context, portName, sessionID string, opts TimingOptions, fn func(TimedRead) error) error {
+	session, err := m.ValidateSession(portName, sessionID)
+	if err != nil {
+		return err
+	}
+
+	size := opts.BufferSize
+	if opts.PerByte {
+		size = 1
+	} else if size <= 0 {
+		size = 4096
+	}
+
+	var previous time.Time
+	var sequence uint32
+	for ctx.Err() == nil {
+		if session.IsClosed() {
+			return ErrPortClosed
+		}
+
+		buffer := make([]byte, size)
+		session.mu.Lock()
+		if err := session.port.SetReadTimeout(timingPollInterval); err != nil {
+			session.mu.Unlock()
+			return fmt.Errorf("failed to set read timeout: %w", err)
+		}
+		started := time.Now()
+		n, err := session.port.Read(buffer)
+		completed := time.Now()
+		_ = session.port.SetReadTimeout(session.readTimeout())
+		if err != nil {
+			m.recordError(session, "timed_read", err)
+		} else {
+			m.afterRead(session, buffer[:n])
+		}
+		session.mu.Unlock()
+
+		if err != nil {
+			if session.IsClosed() {
+				return ErrPortClosed
+			}
+			return fmt.Errorf("read failed: %w", err)
+		}
+		if n == 0 {
+			continue
+		}
+
+		read := TimedRead{
+			Data:      buffer[:n],
+			Started:   started,
+			Completed: completed,
+			Sequence:  sequence,
+		}
+		if !previous.IsZero() {
+			read.Gap = completed.Sub(previous)
+		}
+		previous = completed
+		sequence++
+		if err := fn(read); err != nil {
+			return err
+		}
+	}
+	return nil
+}
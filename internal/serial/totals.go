@@ -0,0 +1,114 @@
+package serial
+
+import (
+	"sort"
+	"sync/atomic"
+)
+
+// Totals are the sessions and traffic of a manager since it was created,
+// closed sessions included
+type Totals struct {
+	OpenPorts      int
+	OpenSessions   int
+	SessionsOpened uint64
+	BytesSent      uint64
+	BytesReceived  uint64
+	Errors         uint64
+	Reconnects     uint64
+}
+
+// PortTotals are the sessions and traffic of one port since the manager was
+// created
+type PortTotals struct {
+	PortName string
+	// Open reports whether a session has the port open
+	Open           bool
+	SessionsOpened uint64
+	BytesSent      uint64
+	BytesReceived  uint64
+	Errors         uint64
+	// Reconnects counts opens of the port after its device was removed
+	Reconnects uint64
+}
+
+// closedTotals accumulates the traffic of closed sessions (manager lock
+// held)
+type closedTotals struct {
+	sessionsOpened uint64
+	bytesSent      uint64
+	bytesReceived  uint64
+	errors         uint64
+	reconnects     uint64
+}
+
+// add accounts a session that is closing (manager lock held)
+func (t *closedTotals) add(session *Session) {
+	t.bytesSent += atomic.LoadUint64(&session.Statistics.BytesSent)
+	t.bytesReceived += atomic.LoadUint64(&session.Statistics.BytesReceived)
+	t.errors += atomic.LoadUint64(&session.Statistics.Errors)
+}
+
+// Totals returns the sessions and traffic since the manager was created
+func (m *Manager) Totals() Totals {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	totals := Totals{
+		OpenPorts:      len(m.sessions),
+		OpenSessions:   len(m.sessionsByID),
+		SessionsOpened: m.totals.sessionsOpened,
+		BytesSent:      m.totals.bytesSent,
+		BytesReceived:  m.totals.bytesReceived,
+		Errors:         m.totals.errors,
+		Reconnects:     m.totals.reconnects,
+	}
+	for _, session := range m.sessionsByID {
+		totals.BytesSent += atomic.LoadUint64(&session.Statistics.BytesSent)
+		totals.BytesReceived += atomic.LoadUint64(&session.Statistics.BytesReceived)
+		totals.Errors += atomic.LoadUint64(&session.Statistics.Errors)
+	}
+	return totals
+}
+
+// countOpen accounts a session opening on a port (manager lock held)
+func (m *Manager) countOpen(portName string) {
+	port := m.portTotals[portName]
+	if port == nil {
+		port = &closedTotals{}
+		m.portTotals[portName] = port
+	}
+	port.sessionsOpened++
+	m.totals.sessionsOpened++
+	if last, ok := m.lastClose[portName]; ok && last.Reason == CloseReasonDeviceRemoved {
+		port.reconnects++
+		m.totals.reconnects++
+	}
+}
+
+// PortTotals returns the totals of every port opened since the manager was
+// created, by port name
+func (m *Manager) PortTotals() []PortTotals {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ports := make([]PortTotals, 0, len(m.portTotals))
+	for portName, port := range m.portTotals {
+		totals := PortTotals{
+			PortName:       portName,
+			SessionsOpened: port.sessionsOpened,
+			BytesSent:      port.bytesSent,
+			BytesReceived:  port.bytesReceived,
+			Errors:         port.errors,
+			Reconnects:     port.reconnects,
+		}
+		if session, ok := m.sessions[portName]; ok {
+			totals.Open = true
+			totals.BytesSent += atomic.LoadUint64(&session.Statistics.BytesSent)
+			totals.BytesReceived += atomic.LoadUint64(&session.Statistics.BytesReceived)
+			totals.Errors += atomic.LoadUint64(&session.Statistics.Errors)
+		}
+		ports = append(ports, totals)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i].PortName < ports[j].PortName })
+	return ports
+}
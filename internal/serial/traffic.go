@@ -0,0 +1,134 @@
+package serial
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Traffic directions
+const (
+	DirectionRX = "rx"
+	DirectionTX = "tx"
+)
+
+// maxTrafficLineLength truncates long lines of captured traffic
+const maxTrafficLineLength = 256
+
+// TrafficLine is a line of data sent or received on a session
+type TrafficLine struct {
+	Direction string
+	Time      time.Time
+	Text      string
+}
+
+// trafficLog keeps the first and last lines of a session's traffic
+type trafficLog struct {
+	mu      sync.Mutex
+	size    int
+	first   []TrafficLine
+	last    []TrafficLine
+	next    int
+	partial map[string][]byte
+	started map[string]time.Time
+}
+
+func newTrafficLog(size int) *trafficLog {
+	return &trafficLog{
+		size:    size,
+		partial: make(map[string][]byte),
+		started: make(map[string]time.Time),
+	}
+}
+
+// record adds data sent or received, split into lines
+func (t *trafficLog) record(direction string, data []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	for _, c := range data {
+		buf := t.partial[direction]
+		if len(buf) == 0 {
+			t.started[direction] = now
+		}
+		if c == '\n' || len(buf) >= maxTrafficLineLength {
+			t.add(TrafficLine{Direction: direction, Time: t.started[direction], Text: strings.TrimRight(string(buf), "\r")})
+			t.partial[direction] = buf[:0]
+			if c == '\n' {
+				continue
+			}
+			t.started[direction] = now
+		}
+		t.partial[direction] = append(t.partial[direction], c)
+	}
+}
+
+// add keeps a complete line (lock held)
+func (t *trafficLog) add(line TrafficLine) {
+	if len(t.first) < t.size {
+		t.first = append(t.first, line)
+		return
+	}
+	if len(t.last) < t.size {
+		t.last = append(t.last, line)
+		return
+	}
+	t.last[t.next] = line
+	t.next = (t.next + 1) % t.size
+}
+
+// lines returns the first and last lines in order, including lines still
+// waiting for their newline
+func (t *trafficLog) lines() (first, last []TrafficLine) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	first = append([]TrafficLine(nil), t.first...)
+	last = append(append([]TrafficLine(nil), t.last[t.next:]...), t.last[:t.next]...)
+
+	var pending []TrafficLine
+	for _, direction := range []string{DirectionRX, DirectionTX} {
+		if buf := t.partial[direction]; len(buf) > 0 {
+			pending = append(pending, TrafficLine{Direction: direction, Time: t.started[direction], Text: strings.TrimRight(string(buf), "\r")})
+		}
+	}
+	if len(pending) == 2 && pending[1].Time.Before(pending[0].Time) {
+		pending[0], pending[1] = pending[1], pending[0]
+	}
+	for _, line := range pending {
+		if len(first) < t.size {
+			first = append(first, line)
+			continue
+		}
+		last = append(last, line)
+		if len(last) > t.size {
+			last = last[1:]
+		}
+	}
+	return first, last
+}
+
+// recordTraffic adds data to the session's traffic capture, if enabled
+func (s *Session) recordTraffic(direction string, data []byte) {
+	if s.traffic != nil && len(data) > 0 {
+		s.traffic.record(direction, data)
+	}
+}
+
+// Traffic returns the first and last lines of traffic on the session, or
+// nil when capture was not enabled when the session was opened
+func (s *Session) Traffic() (first, last []TrafficLine) {
+	if s.traffic == nil {
+		return nil, nil
+	}
+	return s.traffic.lines()
+}
+
+// SetTrafficCapture keeps the first and last lines of traffic, up to lines
+// each, of sessions opened afterwards; zero disables capture
+func (m *Manager) SetTrafficCapture(lines int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.trafficLines = lines
+}
@@ -0,0 +1,147 @@
+package serial
+
+import (
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"go.bug.st/serial"
+)
+
+// LineSwitcher is implemented by the ReadWriter Transact passes to fn, for
+// protocols that change line settings within one exchange, such as the baud
+// rate switch of IEC 62056-21 mode C. The session's own settings are
+// restored when fn returns.
+type LineSwitcher interface {
+	// Line returns the settings in effect
+	Line() PortConfig
+	// SetLine applies settings for the rest of the transaction
+	SetLine(config PortConfig) error
+}
+
+// ControlLines is implemented by the ReadWriter Transact passes to fn, for
+// protocols that signal on the modem control lines, such as the DTR pulse
+// that resets Arduino boards into their bootloader
+type ControlLines interface {
+	SetDTR(dtr bool) error
+	SetRTS(rts bool) error
+}
+
+// Transact runs fn with exclusive, raw access to an open port, for
+// request/response protocols layered on the line such as AT commands.
+// Reads on the ReadWriter return (0, nil) after readTimeout without data.
+// Other operations on the port wait until fn returns.
+func (m *Manager) Transact(portName, sessionID string, readTimeout time.Duration, fn func(rw io.ReadWriter) error) error {
+	session, err := m.ValidateSession(portName, sessionID)
+	if err != nil {
+		return err
+	}
+
+	if err := m.flushCoalesced(session); err != nil {
+		return err
+	}
+
+	session.writes.acquire(session.Priority())
+	defer session.writes.release()
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if err := session.port.SetReadTimeout(readTimeout); err != nil {
+		return fmt.Errorf("failed to set read timeout: %w", err)
+	}
+	defer func() {
+		timeout := serial.NoTimeout
+		if session.Config.ReadTimeoutMs > 0 {
+			timeout = time.Duration(session.Config.ReadTimeoutMs) * time.Millisecond
+		}
+		_ = session.port.SetReadTimeout(timeout)
+	}()
+
+	conn := &transactConn{manager: m, session: session, line: session.Config}
+	defer func() {
+		if conn.switched {
+			_ = session.port.SetMode(session.Config.ToSerialMode())
+		}
+	}()
+	return fn(conn)
+}
+
+// transactConn counts traffic on a session during a transaction (session lock held)
+type transactConn struct {
+	manager *Manager
+	session *Session
+	// line is in effect until the transaction ends; switched is set once it
+	// differs from the session's configuration
+	line     PortConfig
+	switched bool
+}
+
+func (c *transactConn) Line() PortConfig {
+	return c.line
+}
+
+func (c *transactConn) SetLine(config PortConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	c.switched = true
+	if err := c.session.port.SetMode(config.ToSerialMode()); err != nil {
+		return fmt.Errorf("failed to switch to %d baud: %w", config.BaudRate, err)
+	}
+	c.line = config
+	return nil
+}
+
+func (c *transactConn) SetDTR(dtr bool) error {
+	if err := c.session.port.SetDTR(dtr); err != nil {
+		return fmt.Errorf("failed to set DTR: %w", err)
+	}
+	return nil
+}
+
+func (c *transactConn) SetRTS(rts bool) error {
+	if err := c.session.port.SetRTS(rts); err != nil {
+		return fmt.Errorf("failed to set RTS: %w", err)
+	}
+	return nil
+}
+
+func (c *transactConn) Read(p []byte) (int, error) {
+	rx := c.session.shaping.Load().receive()
+	n, err := c.session.readPort(p[:rx.wait(len(p))])
+	rx.consume(n)
+	if err != nil {
+		c.manager.recordError(c.session, "read", err)
+		return n, fmt.Errorf("read failed: %w", err)
+	}
+	if n > 0 {
+		atomic.AddUint64(&c.session.Statistics.BytesReceived, uint64(n))
+		c.session.Statistics.LastActivity = time.Now()
+		c.session.recordTraffic(DirectionRX, p[:n])
+	}
+	return n, nil
+}
+
+func (c *transactConn) Write(p []byte) (int, error) {
+	if err := c.manager.filterWrite(c.session, p); err != nil {
+		return 0, err
+	}
+	tx := c.session.shaping.Load().send()
+	n := 0
+	var err error
+	for n < len(p) && err == nil {
+		var written int
+		written, err = c.session.writePort(p[n : n+tx.wait(len(p)-n)])
+		tx.consume(written)
+		n += written
+	}
+	if err != nil {
+		c.manager.recordError(c.session, "write", err)
+		return n, fmt.Errorf("write failed: %w", err)
+	}
+	atomic.AddUint64(&c.session.Statistics.BytesSent, uint64(n))
+	c.session.Statistics.LastActivity = time.Now()
+	c.session.recordTraffic(DirectionTX, p[:n])
+	return n, nil
+}
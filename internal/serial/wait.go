@@ -0,0 +1,107 @@
+package serial
+
+import (
+	"fmt"
+	"time"
+
+	"go.bug.st/serial"
+)
+
+// Read pump timing. Where the port's descriptor can be polled, the pump
+// waits for data without holding the session lock, so writes are never
+// delayed. It is woken when the session closes, sleeps or its reader
+// stops, so an idle port wakes only once per pollWait. Elsewhere it reads
+// with the lock held, bounded by the driver timeout lockedWait so writes
+// wait at most that long.
+const (
+	pollWait   = 30 * time.Second
+	lockedWait = 100 * time.Millisecond
+)
+
+// readTimeout is the driver read timeout of the session's configuration
+func (s *Session) readTimeout() time.Duration {
+	if s.Config.ReadTimeoutMs > 0 {
+		return time.Duration(s.Config.ReadTimeoutMs) * time.Millisecond
+	}
+	return serial.NoTimeout
+}
+
+// waitRead blocks until data arrives or the pump interval elapses and
+// returns up to maxBytes; no data and no error means the interval elapsed.
+// The wait happens in the kernel rather than in a sleep loop, so idle ports
+// cost next to no CPU time.
+func (m *Manager) waitRead(session *Session, maxBytes int) ([]byte, error) {
+	// Shaping lets the data wait in the driver until the session may take it
+	rx := session.shaping.Load().receive()
+	maxBytes = rx.wait(maxBytes)
+
+	// Data held while the session was dormant comes first
+	if held := session.takeHeld(maxBytes); held != nil {
+		session.mu.Lock()
+		defer session.mu.Unlock()
+		rx.consume(len(held))
+		m.afterRead(session, held)
+		return held, nil
+	}
+
+	data, err := m.pumpRead(session, maxBytes, true)
+	rx.consume(len(data))
+	return data, err
+}
+
+// wakeReaders interrupts the read pump's wait for data, so it notices the
+// session closing, going dormant or its reader stopping
+func (s *Session) wakeReaders() {
+	if s.pollWake >= 0 {
+		platform.signalPollWake(s.pollWake)
+	}
+}
+
+// pumpRead waits for and reads data from the port. Unless deliver is set,
+// the data is neither accounted nor passed to subscribed readers.
+func (m *Manager) pumpRead(session *Session, maxBytes int, deliver bool) ([]byte, error) {
+	timeout := lockedWait
+	if session.fd >= 0 {
+		// The descriptor is the port's only while the session is open
+		if session.IsClosed() {
+			return nil, ErrPortClosed
+		}
+		ready, err := platform.pollReadable(session.fd, session.pollWake, pollWait)
+		if err != nil {
+			if session.IsClosed() {
+				return nil, ErrPortClosed
+			}
+			return nil, fmt.Errorf("poll failed: %w", err)
+		}
+		if !ready {
+			return nil, nil
+		}
+		// Another reader may have taken the data in the meantime
+		timeout = 0
+	}
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.IsClosed() {
+		return nil, ErrPortClosed
+	}
+
+	if err := session.port.SetReadTimeout(timeout); err != nil {
+		return nil, fmt.Errorf("failed to set read timeout: %w", err)
+	}
+	buffer := make([]byte, maxBytes)
+	n, err := session.readPort(buffer)
+	_ = session.port.SetReadTimeout(session.readTimeout())
+	if err != nil {
+		m.recordError(session, "read", err)
+		if session.IsClosed() {
+			return nil, ErrPortClosed
+		}
+		return nil, fmt.Errorf("read failed: %w", err)
+	}
+
+	if deliver {
+		m.afterRead(session, buffer[:n])
+	}
+	return buffer[:n], nil
+}
@@ -0,0 +1,95 @@
+//go:build linux
+
+package serial
+
+import (
+	"reflect"
+	"time"
+
+	"go.bug.st/serial"
+	"golang.org/x/sys/unix"
+)
+
+// portDescriptor returns the descriptor of an open local port, or -1 for
+// network ports and when it cannot be found. It is taken from the port's
+// own handle and checked to be the device, so sessions sharing a device
+// each poll their own descriptor.
+func (linuxPlatform) portDescriptor(portName string, port serial.Port) int {
+	if IsNetworkPort(portName) {
+		return -1
+	}
+	fd := portHandle(port)
+	if fd < 0 {
+		return -1
+	}
+
+	var device, open unix.Stat_t
+	if unix.Stat(portName, &device) != nil || unix.Fstat(fd, &open) != nil {
+		return -1
+	}
+	if open.Mode&unix.S_IFMT != unix.S_IFCHR || open.Rdev != device.Rdev {
+		return -1
+	}
+	return fd
+}
+
+// portHandle returns the descriptor the serial library holds for a local
+// port. The library keeps it unexported, so it is read by reflection; -1
+// when the port has none, e.g. after a library change.
+func portHandle(port serial.Port) int {
+	v := reflect.ValueOf(port)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return -1
+	}
+	handle := v.Elem().FieldByName("handle")
+	if !handle.IsValid() || !handle.CanInt() {
+		return -1
+	}
+	return int(handle.Int())
+}
+
+// newPollWake creates an eventfd for interrupting pollReadable
+func (linuxPlatform) newPollWake() int {
+	fd, err := unix.Eventfd(0, unix.EFD_NONBLOCK|unix.EFD_CLOEXEC)
+	if err != nil {
+		return -1
+	}
+	return fd
+}
+
+// signalPollWake interrupts the waits on wake
+func (linuxPlatform) signalPollWake(wake int) {
+	var one [8]byte
+	one[0] = 1
+	_, _ = unix.Write(wake, one[:])
+}
+
+// closePollWake releases wake
+func (linuxPlatform) closePollWake(wake int) {
+	_ = unix.Close(wake)
+}
+
+// pollReadable waits up to timeout for fd to have data to read, or for
+// wake to be signalled, which reports no data. Errors and hangups count as
+// readable so the read reports them.
+func (linuxPlatform) pollReadable(fd, wake int, timeout time.Duration) (bool, error) {
+	fds := []unix.PollFd{{Fd: int32(fd), Events: unix.POLLIN}}
+	if wake >= 0 {
+		fds = append(fds, unix.PollFd{Fd: int32(wake), Events: unix.POLLIN})
+	}
+	for {
+		n, err := unix.Poll(fds, int(timeout.Milliseconds()))
+		if err == unix.EINTR {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		if n > 0 && len(fds) > 1 && fds[1].Revents != 0 {
+			var count [8]byte
+			_, _ = unix.Read(wake, count[:])
+			return false, nil
+		}
+		return n > 0, nil
+	}
+}
@@ -0,0 +1,25 @@
+package serial
+
+// WriteFilter decides whether data may be written to a session's port; an
+// error refuses the write and is returned to the writer
+type WriteFilter func(portName, sessionID string, data []byte) error
+
+// SetWriteFilter applies filter to everything written to a port: writes
+// in all their forms, transactions such as AT commands or firmware
+// uploads, init sequences and line diagnosis probes. Nil removes it.
+func (m *Manager) SetWriteFilter(filter WriteFilter) {
+	if filter == nil {
+		m.writeFilter.Store(nil)
+		return
+	}
+	m.writeFilter.Store(&filter)
+}
+
+// filterWrite applies the write filter to data for a session
+func (m *Manager) filterWrite(session *Session, data []byte) error {
+	filter := m.writeFilter.Load()
+	if filter == nil || len(data) == 0 {
+		return nil
+	}
+	return (*filter)(session.PortName, session.ID, data)
+}
@@ -0,0 +1,89 @@
+package sessionsummary
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// webhookTimeout bounds a webhook delivery
+const webhookTimeout = 10 * time.Second
+
+// unsafeFileChars are replaced in summary file names
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// WebhookNotifier POSTs every summary as JSON to a URL
+type WebhookNotifier struct {
+	URL    string
+	Client *http.Client
+}
+
+// Notify implements Notifier
+func (w WebhookNotifier) Notify(ctx context.Context, summary Summary) error {
+	body, err := summary.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// FileNotifier writes every summary to a JSON file in Dir and passes the
+// file to Upload, e.g. to ship it to the storage backend
+type FileNotifier struct {
+	Dir    string
+	Upload func(path string)
+}
+
+// Notify implements Notifier
+func (f FileNotifier) Notify(_ context.Context, summary Summary) error {
+	data, err := summary.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(f.Dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create summary directory: %w", err)
+	}
+
+	name := fmt.Sprintf("%s_%s_%s.json",
+		summary.ClosedAt.UTC().Format("20060102T150405.000Z"),
+		unsafeFileChars.ReplaceAllString(strings.TrimLeft(summary.PortName, `/\`), "_"),
+		summary.SessionID)
+	path := filepath.Join(f.Dir, name)
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write session summary: %w", err)
+	}
+
+	if f.Upload != nil {
+		f.Upload(path)
+	}
+	return nil
+}
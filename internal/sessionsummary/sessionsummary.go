@@ -0,0 +1,278 @@
+// Package sessionsummary produces a summary of every port session when it
+// closes (duration, traffic counters, errors, first and last lines of
+// traffic and the events raised on the port) and delivers it to a webhook
+// or the storage backend.
+package sessionsummary
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/redact"
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/charmbracelet/log"
+)
+
+// maxEvents caps the events kept per session
+const maxEvents = 100
+
+// Event is a port event raised while the session was open, such as an
+// alarm, a device state change or a line-quality warning
+type Event struct {
+	Type    string
+	Message string
+	Time    time.Time
+}
+
+// Summary describes a closed session
+type Summary struct {
+	SessionID     string
+	PortName      string
+	ClientID      string
+	OpenedAt      time.Time
+	ClosedAt      time.Time
+	BytesSent     uint64
+	BytesReceived uint64
+	Errors        uint64
+	GarbageBytes  uint64
+	BreakCount    uint64
+	// FirstLines and LastLines are the captured traffic, oldest first
+	FirstLines []serial.TrafficLine
+	LastLines  []serial.TrafficLine
+	Events     []Event
+	// DroppedEvents counts events beyond the cap
+	DroppedEvents int
+}
+
+// Duration is how long the session was open
+func (s Summary) Duration() time.Duration {
+	return s.ClosedAt.Sub(s.OpenedAt)
+}
+
+// Notifier delivers summaries, e.g. to a webhook
+type Notifier interface {
+	Notify(ctx context.Context, summary Summary) error
+}
+
+// tracked is an open session and the events raised on its port
+type tracked struct {
+	session *serial.Session
+	events  []Event
+	dropped int
+}
+
+// Collector follows sessions and delivers a summary of each when it closes
+type Collector struct {
+	manager   *serial.Manager
+	logger    *log.Logger
+	notifiers []Notifier
+	// skip lists client IDs whose sessions are not summarized
+	skip []string
+	// redactor masks secrets in the lines of traffic
+	redactor *redact.Redactor
+	wg       sync.WaitGroup
+
+	mu   sync.Mutex
+	open map[string]*tracked
+}
+
+// NewCollector creates a collector. Sessions of the clients in skip, e.g.
+// agent subsystems, are not summarized.
+func NewCollector(manager *serial.Manager, skip []string, logger *log.Logger) *Collector {
+	return &Collector{
+		manager: manager,
+		logger:  logger,
+		skip:    skip,
+		open:    make(map[string]*tracked),
+	}
+}
+
+// SetRedactor masks secrets in the lines of traffic of summaries. Call
+// before Run.
+func (c *Collector) SetRedactor(redactor *redact.Redactor) {
+	c.redactor = redactor
+}
+
+// AddNotifier delivers summaries to n. Call before Run.
+func (c *Collector) AddNotifier(n Notifier) {
+	c.notifiers = append(c.notifiers, n)
+}
+
+// Run follows sessions until ctx is cancelled, then waits for deliveries
+// in progress
+func (c *Collector) Run(ctx context.Context) {
+	events := c.manager.SubscribeEvents()
+	defer c.manager.UnsubscribeEvents(events)
+	defer c.wg.Wait()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-events:
+			c.handle(ctx, event)
+		}
+	}
+}
+
+// handle updates the tracked sessions for a port event
+func (c *Collector) handle(ctx context.Context, event serial.PortEvent) {
+	switch event.Type {
+	case serial.PortEventOpened:
+		if slices.Contains(c.skip, event.ClientID) {
+			return
+		}
+		session := c.manager.GetSessionByID(event.SessionID)
+		if session == nil {
+			// Closed before the event was handled
+			return
+		}
+		c.mu.Lock()
+		c.open[event.SessionID] = &tracked{session: session}
+		c.mu.Unlock()
+
+	case serial.PortEventClosed:
+		c.mu.Lock()
+		t, ok := c.open[event.SessionID]
+		delete(c.open, event.SessionID)
+		c.mu.Unlock()
+		if !ok {
+			return
+		}
+
+		summary := summarize(t, event.Timestamp, c.redactor)
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			c.deliver(ctx, summary)
+		}()
+
+	default:
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		t, ok := c.open[event.SessionID]
+		if !ok {
+			return
+		}
+		if len(t.events) >= maxEvents {
+			t.dropped++
+			return
+		}
+		t.events = append(t.events, Event{Type: string(event.Type), Message: event.Message, Time: event.Timestamp})
+	}
+}
+
+// summarize builds the summary of a closed session
+func summarize(t *tracked, closedAt time.Time, redactor *redact.Redactor) Summary {
+	stats := &t.session.Statistics
+	first, last := t.session.Traffic()
+	for _, lines := range [][]serial.TrafficLine{first, last} {
+		for i := range lines {
+			lines[i].Text = redactor.String(lines[i].Text)
+		}
+	}
+	return Summary{
+		SessionID:     t.session.ID,
+		PortName:      t.session.PortName,
+		ClientID:      t.session.ClientID,
+		OpenedAt:      stats.OpenedAt,
+		ClosedAt:      closedAt,
+		BytesSent:     atomic.LoadUint64(&stats.BytesSent),
+		BytesReceived: atomic.LoadUint64(&stats.BytesReceived),
+		Errors:        atomic.LoadUint64(&stats.Errors),
+		GarbageBytes:  atomic.LoadUint64(&stats.GarbageBytes),
+		BreakCount:    atomic.LoadUint64(&stats.BreakCount),
+		FirstLines:    first,
+		LastLines:     last,
+		Events:        t.events,
+		DroppedEvents: t.dropped,
+	}
+}
+
+// deliver sends a summary to every notifier
+func (c *Collector) deliver(ctx context.Context, summary Summary) {
+	c.logger.Debug("session summary", "port", summary.PortName, "session", summary.SessionID, "duration", summary.Duration(),
+		"sent", summary.BytesSent, "received", summary.BytesReceived, "errors", summary.Errors)
+	for _, n := range c.notifiers {
+		if err := n.Notify(ctx, summary); err != nil {
+			c.logger.Warn("failed to deliver session summary", "port", summary.PortName, "session", summary.SessionID, "error", err)
+		}
+	}
+}
+
+// summaryMessage is the JSON form of a summary
+type summaryMessage struct {
+	SessionID     string         `json:"session_id"`
+	Port          string         `json:"port"`
+	ClientID      string         `json:"client_id"`
+	OpenedAt      string         `json:"opened_at"`
+	ClosedAt      string         `json:"closed_at"`
+	DurationMs    int64          `json:"duration_ms"`
+	BytesSent     uint64         `json:"bytes_sent"`
+	BytesReceived uint64         `json:"bytes_received"`
+	Errors        uint64         `json:"errors"`
+	GarbageBytes  uint64         `json:"garbage_bytes"`
+	BreakCount    uint64         `json:"break_count"`
+	FirstLines    []lineMessage  `json:"first_lines"`
+	LastLines     []lineMessage  `json:"last_lines"`
+	Events        []eventMessage `json:"events"`
+	DroppedEvents int            `json:"dropped_events,omitempty"`
+}
+
+type lineMessage struct {
+	Direction string `json:"direction"`
+	Time      string `json:"time"`
+	Text      string `json:"text"`
+}
+
+type eventMessage struct {
+	Type    string `json:"type"`
+	Time    string `json:"time"`
+	Message string `json:"message,omitempty"`
+}
+
+// Marshal returns the JSON form of a summary
+func (s Summary) Marshal() ([]byte, error) {
+	msg := summaryMessage{
+		SessionID:     s.SessionID,
+		Port:          s.PortName,
+		ClientID:      s.ClientID,
+		OpenedAt:      s.OpenedAt.Format(time.RFC3339Nano),
+		ClosedAt:      s.ClosedAt.Format(time.RFC3339Nano),
+		DurationMs:    s.Duration().Milliseconds(),
+		BytesSent:     s.BytesSent,
+		BytesReceived: s.BytesReceived,
+		Errors:        s.Errors,
+		GarbageBytes:  s.GarbageBytes,
+		BreakCount:    s.BreakCount,
+		FirstLines:    lineMessages(s.FirstLines),
+		LastLines:     lineMessages(s.LastLines),
+		Events:        make([]eventMessage, 0, len(s.Events)),
+		DroppedEvents: s.DroppedEvents,
+	}
+	for _, e := range s.Events {
+		msg.Events = append(msg.Events, eventMessage{Type: e.Type, Time: e.Time.Format(time.RFC3339Nano), Message: e.Message})
+	}
+
+	// Traffic is kept readable rather than HTML-escaped
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(msg); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func lineMessages(lines []serial.TrafficLine) []lineMessage {
+	result := make([]lineMessage, 0, len(lines))
+	for _, l := range lines {
+		result = append(result, lineMessage{Direction: l.Direction, Time: l.Time.Format(time.RFC3339Nano), Text: l.Text})
+	}
+	return result
+}
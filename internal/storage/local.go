@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// local copies files into a directory, e.g. a USB drive or network mount
+type local struct {
+	dir string
+}
+
+func newLocal(opts Options) (*local, error) {
+	if opts.Directory == "" {
+		return nil, fmt.Errorf("local storage requires a directory")
+	}
+	return &local{dir: opts.Directory}, nil
+}
+
+func (l *local) Name() string {
+	return l.dir
+}
+
+func (l *local) Put(_ context.Context, key string, body io.ReadSeeker, _ int64) error {
+	target := filepath.Join(l.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so readers never see a partial copy
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), target)
+}
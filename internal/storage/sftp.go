@@ -0,0 +1,131 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// sftpDialTimeout bounds connecting to the SFTP server
+const sftpDialTimeout = 30 * time.Second
+
+// sftpBackend uploads files over SFTP. A connection is made per upload,
+// since uploads are infrequent and gateways often sit behind flaky links.
+type sftpBackend struct {
+	address string
+	dir     string
+	config  *ssh.ClientConfig
+}
+
+func newSFTP(opts Options) (*sftpBackend, error) {
+	if opts.Address == "" || opts.User == "" {
+		return nil, fmt.Errorf("sftp storage requires an address and user")
+	}
+	if opts.KnownHostsFile == "" {
+		return nil, fmt.Errorf("sftp storage requires a known_hosts file to verify the server")
+	}
+
+	hostKeys, err := knownhosts.New(opts.KnownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read known_hosts: %w", err)
+	}
+
+	var auth []ssh.AuthMethod
+	if opts.KeyFile != "" {
+		key, err := os.ReadFile(opts.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read sftp key: %w", err)
+		}
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse sftp key: %w", err)
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if opts.Password != "" {
+		auth = append(auth, ssh.Password(opts.Password))
+	}
+	if len(auth) == 0 {
+		return nil, fmt.Errorf("sftp storage requires a key_file or password")
+	}
+
+	address := opts.Address
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, "22")
+	}
+
+	return &sftpBackend{
+		address: address,
+		dir:     opts.Directory,
+		config: &ssh.ClientConfig{
+			User:            opts.User,
+			Auth:            auth,
+			HostKeyCallback: hostKeys,
+			Timeout:         sftpDialTimeout,
+		},
+	}, nil
+}
+
+func (s *sftpBackend) Name() string {
+	return "sftp://" + s.config.User + "@" + s.address
+}
+
+func (s *sftpBackend) Put(ctx context.Context, key string, body io.ReadSeeker, _ int64) error {
+	dialer := net.Dialer{Timeout: sftpDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return err
+	}
+
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, s.address, s.config)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	sshClient := ssh.NewClient(sshConn, chans, reqs)
+	defer sshClient.Close()
+
+	// Abort the transfer when ctx ends
+	stop := context.AfterFunc(ctx, func() { sshClient.Close() })
+	defer stop()
+
+	client, err := sftp.NewClient(sshClient)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	target := path.Join(s.dir, key)
+	if err := client.MkdirAll(path.Dir(target)); err != nil {
+		return fmt.Errorf("failed to create remote directory: %w", err)
+	}
+
+	// Upload under a temporary name so readers never see a partial file
+	tmp := target + ".part"
+	file, err := client.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := file.ReadFrom(body); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	if err := client.PosixRename(tmp, target); err != nil {
+		// Servers without the posix-rename extension cannot replace files
+		_ = client.Remove(target)
+		return client.Rename(tmp, target)
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+// Package storage ships files produced by the agent, such as rotated
+// console logs and session recordings, to a local directory, an
+// S3-compatible bucket or an SFTP server.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+// Backend types
+const (
+	TypeLocal = "local"
+	TypeS3    = "s3"
+	TypeSFTP  = "sftp"
+)
+
+// Backend stores files under slash-separated keys
+type Backend interface {
+	// Name describes the destination for logs, e.g. "s3://bucket"
+	Name() string
+	// Put stores size bytes from body under key, replacing any existing file
+	Put(ctx context.Context, key string, body io.ReadSeeker, size int64) error
+}
+
+// Options selects and configures a backend
+type Options struct {
+	Type string
+
+	// Directory is the target directory (local) or remote directory (sftp)
+	Directory string
+
+	// S3-compatible object storage
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+
+	// SFTP server
+	Address        string
+	User           string
+	Password       string
+	KeyFile        string
+	KnownHostsFile string
+}
+
+// New creates the backend selected by opts.Type
+func New(opts Options) (Backend, error) {
+	switch opts.Type {
+	case TypeLocal:
+		return newLocal(opts)
+	case TypeS3:
+		return newS3(opts)
+	case TypeSFTP:
+		return newSFTP(opts)
+	}
+	return nil, fmt.Errorf("unknown storage type %q (local, s3 or sftp)", opts.Type)
+}
+
+const (
+	// uploadTimeout bounds one upload
+	uploadTimeout = 10 * time.Minute
+
+	// maxRetryDelay caps the delay between attempts of a failing upload
+	maxRetryDelay = 5 * time.Minute
+)
+
+// upload is a queued file
+type upload struct {
+	path     string
+	category string
+}
+
+// Shipper uploads files in the background, retrying until they succeed
+type Shipper struct {
+	backend     Backend
+	prefix      string
+	deleteAfter bool
+	logger      *log.Logger
+	queue       chan upload
+}
+
+// NewShipper creates a shipper storing files under
+// "<prefix>/<category>/<file name>". With deleteAfter set, local files are
+// removed once uploaded.
+func NewShipper(backend Backend, prefix string, deleteAfter bool, logger *log.Logger) *Shipper {
+	return &Shipper{
+		backend:     backend,
+		prefix:      strings.Trim(prefix, "/"),
+		deleteAfter: deleteAfter,
+		logger:      logger,
+		queue:       make(chan upload, 256),
+	}
+}
+
+// Ship queues a finished file for upload
+func (s *Shipper) Ship(filePath, category string) {
+	select {
+	case s.queue <- upload{path: filePath, category: category}:
+	default:
+		s.logger.Warn("upload queue full, file stays local", "file", filePath)
+	}
+}
+
+// Run uploads queued files until ctx is cancelled
+func (s *Shipper) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case u := <-s.queue:
+			s.uploadWithRetry(ctx, u)
+		}
+	}
+}
+
+// uploadWithRetry uploads a file, backing off while the backend fails
+func (s *Shipper) uploadWithRetry(ctx context.Context, u upload) {
+	key := path.Join(s.prefix, u.category, filepath.Base(u.path))
+	delay := 5 * time.Second
+
+	for {
+		if _, err := os.Stat(u.path); os.IsNotExist(err) {
+			// Removed locally (e.g. by rotation) before it could be uploaded
+			s.logger.Warn("file to upload no longer exists", "file", u.path)
+			return
+		}
+
+		err := s.put(ctx, key, u.path)
+		if err == nil {
+			s.logger.Debug("file uploaded", "file", u.path, "destination", s.backend.Name(), "key", key)
+			if s.deleteAfter {
+				if err := os.Remove(u.path); err != nil {
+					s.logger.Warn("failed to delete uploaded file", "file", u.path, "error", err)
+				}
+			}
+			return
+		}
+		s.logger.Warn("upload failed, retrying", "file", u.path, "destination", s.backend.Name(), "retry_in", delay, "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxRetryDelay)
+	}
+}
+
+// put uploads one file
+func (s *Shipper) put(ctx context.Context, key, filePath string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, uploadTimeout)
+	defer cancel()
+	return s.backend.Put(ctx, key, file, info.Size())
+}
@@ -0,0 +1,236 @@
+package testrunner
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Report formats
+const (
+	FormatJUnit = "junit"
+	FormatJSON  = "json"
+)
+
+// unsafeFileChars are replaced in report file names
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// ValidFormat reports whether f is a report format
+func ValidFormat(f string) bool {
+	return f == FormatJUnit || f == FormatJSON
+}
+
+// ReportWriter writes reports to a directory, one file per format and run
+type ReportWriter struct {
+	Dir     string
+	Formats []string
+}
+
+// Write writes a report in every format and returns the paths written
+func (w *ReportWriter) Write(report Report) ([]string, error) {
+	if err := os.MkdirAll(w.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create report directory: %w", err)
+	}
+
+	base := fmt.Sprintf("%s_%s_%s",
+		report.StartedAt.UTC().Format("20060102T150405.000Z"),
+		unsafeFileChars.ReplaceAllString(report.Suite, "_"),
+		unsafeFileChars.ReplaceAllString(strings.TrimLeft(report.PortName, `/\`), "_"))
+
+	var files []string
+	for _, format := range w.Formats {
+		var data []byte
+		var err error
+		var ext string
+		switch format {
+		case FormatJUnit:
+			data, err = report.JUnit()
+			ext = ".xml"
+		case FormatJSON:
+			data, err = report.Marshal()
+			ext = ".json"
+		default:
+			return files, fmt.Errorf("unknown report format %q", format)
+		}
+		if err != nil {
+			return files, err
+		}
+
+		path := filepath.Join(w.Dir, base+ext)
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			return files, fmt.Errorf("failed to write report: %w", err)
+		}
+		files = append(files, path)
+	}
+	return files, nil
+}
+
+// stepMessage is the JSON form of a step result
+type stepMessage struct {
+	Name       string   `json:"name"`
+	Result     string   `json:"result"`
+	Message    string   `json:"message"`
+	Response   string   `json:"response,omitempty"`
+	Value      *float64 `json:"value,omitempty"`
+	Diff       string   `json:"diff,omitempty"`
+	DurationMs int64    `json:"duration_ms"`
+}
+
+// reportMessage is the JSON form of a report
+type reportMessage struct {
+	Suite      string        `json:"suite"`
+	Port       string        `json:"port"`
+	Trigger    string        `json:"trigger"`
+	Host       string        `json:"host,omitempty"`
+	StartedAt  string        `json:"started_at"`
+	DurationMs int64         `json:"duration_ms"`
+	Result     string        `json:"result"`
+	Passed     int           `json:"passed"`
+	Failed     int           `json:"failed"`
+	Skipped    int           `json:"skipped"`
+	Steps      []stepMessage `json:"steps"`
+}
+
+// Marshal returns the JSON form of a report
+func (r Report) Marshal() ([]byte, error) {
+	passed, failed, skipped := r.Counts()
+	host, _ := os.Hostname()
+	msg := reportMessage{
+		Suite:      r.Suite,
+		Port:       r.PortName,
+		Trigger:    r.Trigger,
+		Host:       host,
+		StartedAt:  r.StartedAt.Format(time.RFC3339Nano),
+		DurationMs: r.Duration.Milliseconds(),
+		Result:     result(r.Passed, false),
+		Passed:     passed,
+		Failed:     failed,
+		Skipped:    skipped,
+		Steps:      make([]stepMessage, 0, len(r.Steps)),
+	}
+	for _, step := range r.Steps {
+		msg.Steps = append(msg.Steps, stepMessage{
+			Name:       step.Name,
+			Result:     result(step.Passed, step.Skipped),
+			Message:    step.Message,
+			Response:   quote(step.Response),
+			Value:      step.Value,
+			Diff:       step.Diff,
+			DurationMs: step.Duration.Milliseconds(),
+		})
+	}
+	return json.MarshalIndent(msg, "", "  ")
+}
+
+// junitSuites is the root of a JUnit XML report
+type junitSuites struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name       string          `xml:"name,attr"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Time       string          `xml:"time,attr"`
+	Timestamp  string          `xml:"timestamp,attr"`
+	Hostname   string          `xml:"hostname,attr,omitempty"`
+	Properties []junitProperty `xml:"properties>property"`
+	Cases      []junitCase     `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// JUnit returns the JUnit XML form of a report, which CI systems and test
+// dashboards understand
+func (r Report) JUnit() ([]byte, error) {
+	passed, failed, skipped := r.Counts()
+	host, _ := os.Hostname()
+	suite := junitSuite{
+		Name:      r.Suite,
+		Tests:     passed + failed + skipped,
+		Failures:  failed,
+		Skipped:   skipped,
+		Time:      seconds(r.Duration),
+		Timestamp: r.StartedAt.UTC().Format("2006-01-02T15:04:05"),
+		Hostname:  host,
+		Properties: []junitProperty{
+			{Name: "port", Value: r.PortName},
+			{Name: "trigger", Value: r.Trigger},
+		},
+	}
+	for _, step := range r.Steps {
+		c := junitCase{
+			Name:      step.Name,
+			Classname: r.Suite,
+			Time:      seconds(step.Duration),
+			SystemOut: quote(step.Response),
+		}
+		switch {
+		case step.Skipped:
+			c.Skipped = &junitSkipped{Message: step.Message}
+		case !step.Passed:
+			c.Failure = &junitFailure{Message: step.Message, Text: step.Diff}
+		}
+		suite.Cases = append(suite.Cases, c)
+	}
+
+	data, err := xml.MarshalIndent(junitSuites{Suites: []junitSuite{suite}}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+// result names the outcome of a suite or step
+func result(passed, skipped bool) string {
+	switch {
+	case skipped:
+		return "skipped"
+	case passed:
+		return "passed"
+	}
+	return "failed"
+}
+
+// quote shows a response with control characters escaped
+func quote(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	s := strconv.QuoteToASCII(string(data))
+	return s[1 : len(s)-1]
+}
+
+// seconds formats a duration for JUnit
+func seconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+}
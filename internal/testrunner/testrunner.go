@@ -0,0 +1,338 @@
+// Package testrunner runs test suites, sequences of commands and expected
+// responses, against a device and reports pass/fail results, for
+// end-of-line tests on production lines.
+package testrunner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/Shoaibashk/SerialLink/internal/verify"
+	"github.com/charmbracelet/log"
+)
+
+// Triggers of a run
+const (
+	TriggerOpen    = "open"
+	TriggerRequest = "request"
+)
+
+// Errors returned by Run
+var (
+	ErrUnknownSuite = errors.New("unknown test suite")
+	ErrBusy         = errors.New("a test suite is already running on the port")
+)
+
+// Tolerance accepts a measured value within Delta of Nominal
+type Tolerance struct {
+	Nominal float64
+	Delta   float64
+}
+
+// Step is one command of a suite. A step sends Send, then checks the
+// response against Expect, or Pattern when Expect is nil. With Tolerance,
+// the first capture group of Pattern must be a number within tolerance.
+// A step without Expect and Pattern only sends.
+type Step struct {
+	Name      string
+	Send      []byte
+	Expect    []byte
+	Pattern   *regexp.Regexp
+	Tolerance *Tolerance
+	// Options control how the response is captured
+	Options verify.Options
+	// Delay waits before the step, e.g. for the device to settle
+	Delay time.Duration
+}
+
+// checks reports whether the step captures a response
+func (s Step) checks() bool {
+	return s.Expect != nil || s.Pattern != nil
+}
+
+// Suite is a sequence of steps
+type Suite struct {
+	Name string
+	// Port selects the ports the suite runs on when they are opened
+	Port *regexp.Regexp
+	// RunOnOpen runs the suite whenever another client opens a matching port
+	RunOnOpen bool
+	// StopOnFailure skips the remaining steps after a failure
+	StopOnFailure bool
+	Steps         []Step
+}
+
+// StepResult is the outcome of a step
+type StepResult struct {
+	Name     string
+	Passed   bool
+	Skipped  bool
+	Response []byte
+	// Value is the measured value of a step with a tolerance
+	Value    *float64
+	Message  string
+	Diff     string
+	Duration time.Duration
+}
+
+// Report is the outcome of a suite run
+type Report struct {
+	Suite     string
+	PortName  string
+	SessionID string
+	Trigger   string
+	StartedAt time.Time
+	Duration  time.Duration
+	Passed    bool
+	Steps     []StepResult
+	// Files are the report files written for the run
+	Files []string
+}
+
+// Counts returns the number of passed, failed and skipped steps
+func (r Report) Counts() (passed, failed, skipped int) {
+	for _, step := range r.Steps {
+		switch {
+		case step.Skipped:
+			skipped++
+		case step.Passed:
+			passed++
+		default:
+			failed++
+		}
+	}
+	return passed, failed, skipped
+}
+
+// Runner runs suites on request and when matching ports are opened, and
+// writes a report for every run
+type Runner struct {
+	manager *serial.Manager
+	suites  []Suite
+	writer  *ReportWriter
+	logger  *log.Logger
+	wg      sync.WaitGroup
+
+	mu      sync.Mutex
+	running map[string]bool
+}
+
+// NewRunner creates a runner. writer may be nil to keep reports in memory
+// only.
+func NewRunner(manager *serial.Manager, suites []Suite, writer *ReportWriter, logger *log.Logger) *Runner {
+	return &Runner{
+		manager: manager,
+		suites:  suites,
+		writer:  writer,
+		logger:  logger,
+		running: make(map[string]bool),
+	}
+}
+
+// Suites returns the configured suites
+func (r *Runner) Suites() []Suite {
+	return r.suites
+}
+
+// Start runs the suites marked RunOnOpen whenever another client opens a
+// matching port, until ctx is cancelled
+func (r *Runner) Start(ctx context.Context) {
+	events := r.manager.SubscribeEvents()
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer r.manager.UnsubscribeEvents(events)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-events:
+				if event.Type != serial.PortEventOpened {
+					continue
+				}
+				for _, suite := range r.suites {
+					if !suite.RunOnOpen || suite.Port == nil || !suite.Port.MatchString(event.PortName) {
+						continue
+					}
+					r.wg.Add(1)
+					go func(name string) {
+						defer r.wg.Done()
+						if _, err := r.Run(ctx, name, event.PortName, event.SessionID, TriggerOpen); err != nil {
+							r.logger.Warn("test suite not run", "suite", name, "port", event.PortName, "error", err)
+						}
+					}(suite.Name)
+				}
+			}
+		}
+	}()
+}
+
+// Wait blocks until the event watcher and runs it started have stopped
+func (r *Runner) Wait() {
+	r.wg.Wait()
+}
+
+// Run runs a suite on an open session and writes its report. Only one
+// suite runs on a port at a time.
+func (r *Runner) Run(ctx context.Context, suiteName, portName, sessionID, trigger string) (Report, error) {
+	suite, ok := r.suite(suiteName)
+	if !ok {
+		return Report{}, ErrUnknownSuite
+	}
+	if _, err := r.manager.ValidateSession(portName, sessionID); err != nil {
+		return Report{}, err
+	}
+
+	r.mu.Lock()
+	if r.running[portName] {
+		r.mu.Unlock()
+		return Report{}, ErrBusy
+	}
+	r.running[portName] = true
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		delete(r.running, portName)
+		r.mu.Unlock()
+	}()
+
+	r.logger.Info("running test suite", "suite", suite.Name, "port", portName, "trigger", trigger)
+	report := r.run(ctx, suite, portName, sessionID)
+	report.Trigger = trigger
+
+	passed, failed, skipped := report.Counts()
+	if report.Passed {
+		r.logger.Info("test suite passed", "suite", suite.Name, "port", portName, "steps", passed, "duration", report.Duration)
+	} else {
+		r.logger.Warn("test suite failed", "suite", suite.Name, "port", portName, "passed", passed, "failed", failed, "skipped", skipped)
+	}
+
+	if r.writer != nil {
+		files, err := r.writer.Write(report)
+		if err != nil {
+			r.logger.Warn("failed to write test report", "suite", suite.Name, "port", portName, "error", err)
+		}
+		report.Files = files
+	}
+	return report, nil
+}
+
+// suite finds a suite by name
+func (r *Runner) suite(name string) (Suite, bool) {
+	for _, suite := range r.suites {
+		if suite.Name == name {
+			return suite, true
+		}
+	}
+	return Suite{}, false
+}
+
+// run executes the steps of a suite in order
+func (r *Runner) run(ctx context.Context, suite Suite, portName, sessionID string) Report {
+	report := Report{
+		Suite:     suite.Name,
+		PortName:  portName,
+		SessionID: sessionID,
+		StartedAt: time.Now(),
+		Passed:    true,
+	}
+
+	failed := false
+	for _, step := range suite.Steps {
+		if (failed && suite.StopOnFailure) || ctx.Err() != nil {
+			report.Steps = append(report.Steps, StepResult{Name: step.Name, Skipped: true, Message: "skipped"})
+			continue
+		}
+
+		result := r.runStep(ctx, step, portName, sessionID)
+		if !result.Passed {
+			failed = true
+			report.Passed = false
+		}
+		report.Steps = append(report.Steps, result)
+	}
+	if ctx.Err() != nil {
+		report.Passed = false
+	}
+
+	report.Duration = time.Since(report.StartedAt)
+	return report
+}
+
+// runStep sends a step's command and checks the response. The port is held
+// for the exchange only, so delays do not block other clients.
+func (r *Runner) runStep(ctx context.Context, step Step, portName, sessionID string) (result StepResult) {
+	result.Name = step.Name
+	started := time.Now()
+	defer func() { result.Duration = time.Since(started) }()
+
+	if step.Delay > 0 {
+		select {
+		case <-ctx.Done():
+			result.Message = "cancelled"
+			return result
+		case <-time.After(step.Delay):
+		}
+	}
+
+	var response []byte
+	err := r.manager.Transact(portName, sessionID, 50*time.Millisecond, func(rw io.ReadWriter) error {
+		if !step.checks() {
+			_, err := rw.Write(step.Send)
+			return err
+		}
+		var err error
+		response, err = verify.Exchange(ctx, rw, step.Send, step.Options)
+		return err
+	})
+	result.Response = response
+	if err != nil {
+		result.Message = err.Error()
+		return result
+	}
+	if !step.checks() {
+		result.Passed = true
+		result.Message = fmt.Sprintf("sent %d bytes", len(step.Send))
+		return result
+	}
+
+	passed, diff := verify.Check(response, step.Expect, step.Pattern)
+	if !passed {
+		result.Message = "response differs from expected"
+		result.Diff = diff
+		return result
+	}
+
+	if step.Tolerance != nil {
+		match := step.Pattern.FindSubmatch(response)
+		if len(match) < 2 {
+			result.Message = "pattern captured no value"
+			return result
+		}
+		value, err := strconv.ParseFloat(string(match[1]), 64)
+		if err != nil {
+			result.Message = fmt.Sprintf("captured value %q is not a number", match[1])
+			return result
+		}
+		result.Value = &value
+		if math.Abs(value-step.Tolerance.Nominal) > step.Tolerance.Delta {
+			result.Message = fmt.Sprintf("value %g outside %g ± %g", value, step.Tolerance.Nominal, step.Tolerance.Delta)
+			return result
+		}
+		result.Passed = true
+		result.Message = fmt.Sprintf("value %g within %g ± %g", value, step.Tolerance.Nominal, step.Tolerance.Delta)
+		return result
+	}
+
+	result.Passed = true
+	result.Message = "response matches"
+	return result
+}
@@ -0,0 +1,84 @@
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// ACMEOptions configures automatic certificate provisioning
+type ACMEOptions struct {
+	Domains      []string
+	Email        string
+	CacheDir     string
+	DirectoryURL string
+	CAFile       string
+}
+
+// ACMESource obtains and renews certificates from an ACME CA (Let's Encrypt
+// by default). Certificates and the account key are cached in CacheDir and
+// renewed in the background before they expire.
+type ACMESource struct {
+	manager *autocert.Manager
+	domain  string
+	caPool  *x509.CertPool
+}
+
+// NewACMESource creates an ACME certificate source
+func NewACMESource(opts ACMEOptions) (*ACMESource, error) {
+	if len(opts.Domains) == 0 {
+		return nil, fmt.Errorf("at least one ACME domain is required")
+	}
+
+	if err := os.MkdirAll(opts.CacheDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create ACME cache directory: %w", err)
+	}
+
+	manager := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		Cache:      autocert.DirCache(opts.CacheDir),
+		HostPolicy: autocert.HostWhitelist(opts.Domains...),
+		Email:      opts.Email,
+	}
+	if opts.DirectoryURL != "" {
+		manager.Client = &acme.Client{DirectoryURL: opts.DirectoryURL}
+	}
+
+	source := &ACMESource{manager: manager, domain: opts.Domains[0]}
+
+	if opts.CAFile != "" {
+		pool, err := loadCAPool(opts.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		source.caPool = pool
+	}
+
+	return source, nil
+}
+
+// GetCertificate returns a certificate for the requested name. Clients that
+// dial by IP send no SNI, so those get the certificate of the first domain.
+func (s *ACMESource) GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if hello.ServerName == "" {
+		clone := *hello
+		clone.ServerName = s.domain
+		hello = &clone
+	}
+	return s.manager.GetCertificate(hello)
+}
+
+// ClientCAs returns the pool used to verify client certificates
+func (s *ACMESource) ClientCAs() *x509.CertPool {
+	return s.caPool
+}
+
+// HTTPHandler answers HTTP-01 challenges; serve it on port 80
+func (s *ACMESource) HTTPHandler() http.Handler {
+	return s.manager.HTTPHandler(nil)
+}
@@ -0,0 +1,152 @@
+package tlsutil
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/spiffetls/tlsconfig"
+	"github.com/spiffe/go-spiffe/v2/workloadapi"
+)
+
+// spiffeFetchTimeout bounds the wait for the first SVID from the Workload API
+const spiffeFetchTimeout = 30 * time.Second
+
+// SPIFFEOptions configures workload identity from a SPIFFE Workload API
+type SPIFFEOptions struct {
+	// SocketPath is the Workload API address, e.g. "unix:///run/spire/agent.sock".
+	// Empty uses the SPIFFE_ENDPOINT_SOCKET environment variable.
+	SocketPath string
+
+	// TrustDomain authorizes any peer in the trust domain
+	TrustDomain string
+
+	// AllowedIDs authorizes only the listed SPIFFE IDs; takes precedence over TrustDomain
+	AllowedIDs []string
+}
+
+// Authorizer builds the peer authorizer described by the options. Without
+// a trust domain or ID list, peers of the workload's own trust domain are
+// accepted.
+func (o SPIFFEOptions) Authorizer(own spiffeid.TrustDomain) (tlsconfig.Authorizer, error) {
+	if len(o.AllowedIDs) > 0 {
+		ids := make([]spiffeid.ID, 0, len(o.AllowedIDs))
+		for _, value := range o.AllowedIDs {
+			id, err := spiffeid.FromString(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid SPIFFE ID %q: %w", value, err)
+			}
+			ids = append(ids, id)
+		}
+		return tlsconfig.AuthorizeOneOf(ids...), nil
+	}
+
+	if o.TrustDomain != "" {
+		td, err := spiffeid.TrustDomainFromString(o.TrustDomain)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SPIFFE trust domain %q: %w", o.TrustDomain, err)
+		}
+		return tlsconfig.AuthorizeMemberOf(td), nil
+	}
+
+	return tlsconfig.AuthorizeMemberOf(own), nil
+}
+
+// newX509Source connects to the Workload API and waits for the first SVID
+func newX509Source(ctx context.Context, opts SPIFFEOptions) (*workloadapi.X509Source, error) {
+	ctx, cancel := context.WithTimeout(ctx, spiffeFetchTimeout)
+	defer cancel()
+
+	var sourceOpts []workloadapi.X509SourceOption
+	if opts.SocketPath != "" {
+		sourceOpts = append(sourceOpts, workloadapi.WithClientOptions(workloadapi.WithAddr(opts.SocketPath)))
+	}
+
+	source, err := workloadapi.NewX509Source(ctx, sourceOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch SVID from Workload API: %w", err)
+	}
+	return source, nil
+}
+
+// SPIFFEServerConfig builds a mutual TLS server config whose certificate and
+// trust bundle are streamed from the Workload API, so rotation is automatic.
+// Close the returned io.Closer on shutdown.
+func SPIFFEServerConfig(ctx context.Context, spiffe SPIFFEOptions, opts Options) (*tls.Config, io.Closer, error) {
+	source, err := newX509Source(ctx, spiffe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	svid, err := source.GetX509SVID()
+	if err != nil {
+		source.Close()
+		return nil, nil, err
+	}
+
+	authorizer, err := spiffe.Authorizer(svid.ID.TrustDomain())
+	if err != nil {
+		source.Close()
+		return nil, nil, err
+	}
+
+	tlsConfig := tlsconfig.MTLSServerConfig(source, source, authorizer)
+	if err := applyProtocolOptions(tlsConfig, opts); err != nil {
+		source.Close()
+		return nil, nil, err
+	}
+
+	return tlsConfig, source, nil
+}
+
+// SPIFFEClientConfig builds a mutual TLS client config presenting the
+// workload's SVID and authorizing the agent's SPIFFE ID
+func SPIFFEClientConfig(ctx context.Context, spiffe SPIFFEOptions) (*tls.Config, io.Closer, error) {
+	source, err := newX509Source(ctx, spiffe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	svid, err := source.GetX509SVID()
+	if err != nil {
+		source.Close()
+		return nil, nil, err
+	}
+
+	authorizer, err := spiffe.Authorizer(svid.ID.TrustDomain())
+	if err != nil {
+		source.Close()
+		return nil, nil, err
+	}
+
+	return tlsconfig.MTLSClientConfig(source, source, authorizer), source, nil
+}
+
+// applyProtocolOptions applies version and cipher settings to a config built
+// elsewhere; client authentication is left to the caller
+func applyProtocolOptions(cfg *tls.Config, opts Options) error {
+	minVersion, err := ParseVersion(opts.MinVersion)
+	if err != nil {
+		return err
+	}
+	if minVersion != 0 {
+		cfg.MinVersion = minVersion
+	}
+
+	maxVersion, err := ParseVersion(opts.MaxVersion)
+	if err != nil {
+		return err
+	}
+	cfg.MaxVersion = maxVersion
+
+	suites, err := ParseCipherSuites(opts.CipherSuites)
+	if err != nil {
+		return err
+	}
+	cfg.CipherSuites = suites
+
+	return nil
+}
@@ -0,0 +1,282 @@
+// Package tlsutil builds server TLS configurations from agent settings and
+// keeps certificates fresh when they are rotated on disk.
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+// ParseVersion converts "1.0".."1.3" into a tls.Version constant; empty returns 0
+func ParseVersion(value string) (uint16, error) {
+	switch strings.TrimPrefix(strings.ToLower(value), "tls") {
+	case "":
+		return 0, nil
+	case "1.0", "10":
+		return tls.VersionTLS10, nil
+	case "1.1", "11":
+		return tls.VersionTLS11, nil
+	case "1.2", "12":
+		return tls.VersionTLS12, nil
+	case "1.3", "13":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, fmt.Errorf("invalid TLS version %q", value)
+	}
+}
+
+// ParseClientAuth converts a client_auth setting into a tls.ClientAuthType.
+// "require_any" demands a certificate without verifying its chain, so any
+// self-signed one passes; it is named so nobody mistakes it for
+// "require_and_verify".
+func ParseClientAuth(value string) (tls.ClientAuthType, error) {
+	switch strings.ToLower(value) {
+	case "", "none":
+		return tls.NoClientCert, nil
+	case "request":
+		return tls.RequestClientCert, nil
+	case "require_any":
+		return tls.RequireAnyClientCert, nil
+	case "verify_if_given":
+		return tls.VerifyClientCertIfGiven, nil
+	case "require_and_verify":
+		return tls.RequireAndVerifyClientCert, nil
+	default:
+		return tls.NoClientCert, fmt.Errorf("invalid client_auth %q (none, request, require_any, verify_if_given, require_and_verify)", value)
+	}
+}
+
+// ParseCipherSuites converts IANA cipher suite names into IDs. Insecure suites
+// are rejected. TLS 1.3 suites are not configurable in Go and are ignored.
+func ParseCipherSuites(names []string) ([]uint16, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	available := make(map[string]uint16)
+	for _, suite := range tls.CipherSuites() {
+		available[suite.Name] = suite.ID
+	}
+
+	var ids []uint16
+	for _, name := range names {
+		id, ok := available[strings.ToUpper(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown or insecure cipher suite %q", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// Options are the protocol settings for a server; key material comes from a Reloader
+type Options struct {
+	MinVersion   string
+	MaxVersion   string
+	CipherSuites []string
+	ClientAuth   string
+}
+
+// CertificateSource supplies the server certificate and client CA pool
+type CertificateSource interface {
+	GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error)
+	ClientCAs() *x509.CertPool
+}
+
+// ServerConfig builds a tls.Config whose certificate and client CA pool are
+// served from source
+func ServerConfig(opts Options, source CertificateSource) (*tls.Config, error) {
+	minVersion, err := ParseVersion(opts.MinVersion)
+	if err != nil {
+		return nil, err
+	}
+	if minVersion == 0 {
+		minVersion = tls.VersionTLS12
+	}
+
+	maxVersion, err := ParseVersion(opts.MaxVersion)
+	if err != nil {
+		return nil, err
+	}
+	if maxVersion != 0 && maxVersion < minVersion {
+		return nil, fmt.Errorf("max_version %s is lower than min_version %s", opts.MaxVersion, opts.MinVersion)
+	}
+
+	suites, err := ParseCipherSuites(opts.CipherSuites)
+	if err != nil {
+		return nil, err
+	}
+
+	clientAuth, err := ParseClientAuth(opts.ClientAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	base := &tls.Config{
+		MinVersion:     minVersion,
+		MaxVersion:     maxVersion,
+		CipherSuites:   suites,
+		ClientAuth:     clientAuth,
+		GetCertificate: source.GetCertificate,
+	}
+
+	// Resolve the client CA pool per handshake so CA rotation is picked up too
+	base.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		cfg := base.Clone()
+		cfg.GetConfigForClient = nil
+		cfg.ClientCAs = source.ClientCAs()
+		return cfg, nil
+	}
+
+	return base, nil
+}
+
+// Reloader holds the current certificate and CA pool and reloads them when
+// the files change on disk
+type Reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+	logger   *log.Logger
+
+	mu       sync.RWMutex
+	cert     *tls.Certificate
+	caPool   *x509.CertPool
+	modTimes map[string]time.Time
+}
+
+// NewReloader loads the certificate (and optional CA) for the first time
+func NewReloader(certFile, keyFile, caFile string, logger *log.Logger) (*Reloader, error) {
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+		logger:   logger,
+		modTimes: make(map[string]time.Time),
+	}
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate returns the current certificate for tls.Config
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// ClientCAs returns the current client CA pool (nil when no CA file is set)
+func (r *Reloader) ClientCAs() *x509.CertPool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.caPool
+}
+
+// load reads the files and swaps in the new material
+func (r *Reloader) load() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificates: %w", err)
+	}
+
+	var pool *x509.CertPool
+	if r.caFile != "" {
+		pool, err = loadCAPool(r.caFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	modTimes := r.statFiles()
+
+	r.mu.Lock()
+	r.cert = &cert
+	r.caPool = pool
+	r.modTimes = modTimes
+	r.mu.Unlock()
+
+	return nil
+}
+
+// loadCAPool reads a PEM bundle into a certificate pool
+func loadCAPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read TLS CA file: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in CA file %s", path)
+	}
+	return pool, nil
+}
+
+// statFiles returns the modification times of the watched files
+func (r *Reloader) statFiles() map[string]time.Time {
+	times := make(map[string]time.Time)
+	for _, path := range []string{r.certFile, r.keyFile, r.caFile} {
+		if path == "" {
+			continue
+		}
+		if info, err := os.Stat(path); err == nil {
+			times[path] = info.ModTime()
+		}
+	}
+	return times
+}
+
+// changed reports whether any watched file was modified since the last load
+func (r *Reloader) changed() bool {
+	current := r.statFiles()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for path, modTime := range current {
+		if !modTime.Equal(r.modTimes[path]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Watch polls the files every interval and reloads them when they change.
+// A failed reload keeps serving the previous certificate. Close the returned
+// channel to stop watching.
+func (r *Reloader) Watch(interval time.Duration) chan struct{} {
+	stop := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if !r.changed() {
+					continue
+				}
+				if err := r.load(); err != nil {
+					r.logger.Warn("TLS certificate reload failed, keeping previous certificate", "error", err)
+					continue
+				}
+				r.logger.Info("TLS certificate reloaded", "cert", r.certFile)
+			}
+		}
+	}()
+
+	return stop
+}
@@ -0,0 +1,238 @@
+// Package ups decodes the Megatec protocol (Q1) spoken by the serial ports
+// of many small UPSes, naming their readings as Network UPS Tools does.
+package ups
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalid is returned for a response that is not of the protocol
+var ErrInvalid = errors.New("invalid UPS response")
+
+// Megatec queries; each ends with CR, as do the answers
+const (
+	StatusQuery = "Q1\r"
+	RatingQuery = "F\r"
+	InfoQuery   = "I\r"
+)
+
+// Terminator ends requests and responses
+const Terminator = "\r"
+
+// DefaultBaudRate is the speed of Megatec UPSes (8N1)
+const DefaultBaudRate = 2400
+
+// Flags are the status bits of a Q1 response
+type Flags uint8
+
+// Status bits, b0 to b7 of the response
+const (
+	FlagBeeper Flags = 1 << iota
+	FlagShutdown
+	FlagTest
+	// FlagStandby is set for standby and line-interactive UPSes, clear for
+	// online ones
+	FlagStandby
+	FlagFailed
+	FlagBypass
+	FlagBatteryLow
+	FlagUtilityFail
+)
+
+// Status is the answer to StatusQuery. Readings the UPS does not report
+// ("---.-") are NaN.
+type Status struct {
+	InputVoltage      float64
+	InputFaultVoltage float64
+	OutputVoltage     float64
+	// Load is the percentage of the rated output in use
+	Load           float64
+	InputFrequency float64
+	// BatteryVoltage is per cell on online UPSes ("2.27") and for the
+	// pack on others ("13.7")
+	BatteryVoltage float64
+	Temperature    float64
+	Flags          Flags
+}
+
+// Rating is the answer to RatingQuery
+type Rating struct {
+	Voltage        float64
+	Current        float64
+	BatteryVoltage float64
+	Frequency      float64
+}
+
+// Info is the answer to InfoQuery
+type Info struct {
+	Manufacturer string
+	Model        string
+	Version      string
+}
+
+// Reading is a value named after the NUT variable it corresponds to, e.g.
+// "input.voltage"
+type Reading struct {
+	Name  string
+	Value float64
+	Unit  string
+}
+
+// statusTokens are the NUT ups.status tokens reported as readings, so
+// every one has a series even while it is off
+var statusTokens = []string{"OL", "OB", "LB", "BYPASS", "BOOST", "CAL", "FSD", "ALARM"}
+
+// ParseStatus parses a Q1 response such as
+// "(208.4 140.0 208.4 034 59.9 2.05 35.0 00110000"
+func ParseStatus(response []byte) (Status, error) {
+	fields, err := split(response, '(', 8)
+	if err != nil {
+		return Status{}, err
+	}
+	bits := fields[7]
+	if len(bits) != 8 || strings.Trim(bits, "01") != "" {
+		return Status{}, fmt.Errorf("%w: status bits %q", ErrInvalid, bits)
+	}
+
+	var flags Flags
+	for i, bit := range bits {
+		if bit == '1' {
+			flags |= 1 << (7 - i)
+		}
+	}
+	return Status{
+		InputVoltage:      number(fields[0]),
+		InputFaultVoltage: number(fields[1]),
+		OutputVoltage:     number(fields[2]),
+		Load:              number(fields[3]),
+		InputFrequency:    number(fields[4]),
+		BatteryVoltage:    number(fields[5]),
+		Temperature:       number(fields[6]),
+		Flags:             flags,
+	}, nil
+}
+
+// ParseRating parses an F response such as "#220.0 000 024.0 50.0"
+func ParseRating(response []byte) (Rating, error) {
+	fields, err := split(response, '#', 4)
+	if err != nil {
+		return Rating{}, err
+	}
+	for _, field := range fields {
+		if _, err := strconv.ParseFloat(field, 64); err != nil {
+			return Rating{}, fmt.Errorf("%w: rating %q", ErrInvalid, field)
+		}
+	}
+	return Rating{
+		Voltage:        number(fields[0]),
+		Current:        number(fields[1]),
+		BatteryVoltage: number(fields[2]),
+		Frequency:      number(fields[3]),
+	}, nil
+}
+
+// ParseInfo parses an I response, "#" followed by columns of 15, 10 and 10
+// characters: "#SOMECOMPANY    UPS-1000   VER 1.0   "
+func ParseInfo(response []byte) (Info, error) {
+	line := strings.TrimRight(string(response), "\r\n")
+	if !strings.HasPrefix(line, "#") {
+		return Info{}, fmt.Errorf("%w: %q", ErrInvalid, line)
+	}
+	line = line[1:]
+	column := func(from, to int) string {
+		from, to = min(from, len(line)), min(to, len(line))
+		return strings.TrimSpace(line[from:to])
+	}
+	return Info{Manufacturer: column(0, 15), Model: column(16, 26), Version: column(27, len(line))}, nil
+}
+
+// Status returns the NUT ups.status tokens of the flags, e.g. "OB LB"
+func (f Flags) Status() string {
+	var tokens []string
+	for _, token := range statusTokens {
+		if f.has(token) {
+			tokens = append(tokens, token)
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// has reports whether a ups.status token applies. Boost and bypass share
+// a bit: line-interactive UPSes boost a low input, online ones bypass.
+func (f Flags) has(token string) bool {
+	switch token {
+	case "OL":
+		return f&FlagUtilityFail == 0
+	case "OB":
+		return f&FlagUtilityFail != 0
+	case "LB":
+		return f&FlagBatteryLow != 0
+	case "BYPASS":
+		return f&FlagBypass != 0 && f&FlagStandby == 0
+	case "BOOST":
+		return f&FlagBypass != 0 && f&FlagStandby != 0
+	case "CAL":
+		return f&FlagTest != 0
+	case "FSD":
+		return f&FlagShutdown != 0
+	case "ALARM":
+		return f&FlagFailed != 0
+	}
+	return false
+}
+
+// Readings returns the readings of the status the UPS reported, and a
+// "ups.status.<token>" reading of 1 or 0 for each status token
+func (s Status) Readings() []Reading {
+	var readings []Reading
+	add := func(name string, value float64, unit string) {
+		if !math.IsNaN(value) {
+			readings = append(readings, Reading{Name: name, Value: value, Unit: unit})
+		}
+	}
+	add("input.voltage", s.InputVoltage, "volts")
+	add("input.voltage.fault", s.InputFaultVoltage, "volts")
+	add("output.voltage", s.OutputVoltage, "volts")
+	add("ups.load", s.Load, "percent")
+	add("input.frequency", s.InputFrequency, "hertz")
+	add("battery.voltage", s.BatteryVoltage, "volts")
+	add("ups.temperature", s.Temperature, "celsius")
+	for _, token := range statusTokens {
+		add("ups.status."+token, flag(s.Flags.has(token)), "")
+	}
+	add("ups.beeper.status", flag(s.Flags&FlagBeeper != 0), "")
+	return readings
+}
+
+// split checks the lead character of a response and returns its fields
+func split(response []byte, lead byte, count int) ([]string, error) {
+	line := strings.TrimRight(string(response), "\r\n")
+	if len(line) == 0 || line[0] != lead {
+		return nil, fmt.Errorf("%w: %q", ErrInvalid, line)
+	}
+	fields := strings.Fields(line[1:])
+	if len(fields) != count {
+		return nil, fmt.Errorf("%w: %d fields instead of %d in %q", ErrInvalid, len(fields), count, line)
+	}
+	return fields, nil
+}
+
+// number parses a reading, NaN for the dashes of one not reported
+func number(field string) float64 {
+	v, err := strconv.ParseFloat(field, 64)
+	if err != nil {
+		return math.NaN()
+	}
+	return v
+}
+
+func flag(on bool) float64 {
+	if on {
+		return 1
+	}
+	return 0
+}
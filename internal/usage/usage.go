@@ -0,0 +1,298 @@
+// Package usage accounts port use per client (sessions, open time and bytes
+// transferred per port) so the administrators of shared labs can attribute
+// usage. Totals are kept on disk across restarts.
+package usage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Shoaibashk/SerialLink/internal/serial"
+	"github.com/charmbracelet/log"
+)
+
+// flushInterval is how often the usage of open sessions is accounted and
+// saved, bounding what a crash loses
+const flushInterval = time.Minute
+
+// Record is the cumulative use of a port by a client
+type Record struct {
+	ClientID      string        `json:"client_id"`
+	PortName      string        `json:"port_name"`
+	Sessions      uint64        `json:"sessions"`
+	OpenTime      time.Duration `json:"open_time"`
+	BytesSent     uint64        `json:"bytes_sent"`
+	BytesReceived uint64        `json:"bytes_received"`
+	FirstUsed     time.Time     `json:"first_used"`
+	LastUsed      time.Time     `json:"last_used"`
+}
+
+// key identifies a record
+type key struct {
+	client string
+	port   string
+}
+
+// tracked is an open session and the usage already accounted for it
+type tracked struct {
+	session  *serial.Session
+	openedAt time.Time
+	accounted
+}
+
+// accounted is the part of a session's usage added to its record
+type accounted struct {
+	openTime      time.Duration
+	bytesSent     uint64
+	bytesReceived uint64
+}
+
+// ledgerFile is the saved form of a ledger
+type ledgerFile struct {
+	Since   time.Time `json:"since"`
+	Records []Record  `json:"records"`
+}
+
+// Ledger accounts the sessions of a manager
+type Ledger struct {
+	manager *serial.Manager
+	path    string
+	logger  *log.Logger
+	// skip lists client IDs whose sessions are not accounted
+	skip []string
+
+	mu      sync.Mutex
+	since   time.Time
+	records map[key]*Record
+	open    map[string]*tracked
+	dirty   bool
+}
+
+// Open loads the totals saved at path; an empty path keeps them in memory.
+// Sessions of the clients in skip, e.g. agent subsystems, are not accounted.
+func Open(manager *serial.Manager, path string, skip []string, logger *log.Logger) (*Ledger, error) {
+	l := &Ledger{
+		manager: manager,
+		path:    path,
+		logger:  logger,
+		skip:    skip,
+		since:   time.Now(),
+		records: make(map[key]*Record),
+		open:    make(map[string]*tracked),
+	}
+	if path == "" {
+		return l, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read usage: %w", err)
+	}
+	var saved ledgerFile
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if !saved.Since.IsZero() {
+		l.since = saved.Since
+	}
+	for _, r := range saved.Records {
+		l.records[key{r.ClientID, r.PortName}] = &r
+	}
+	return l, nil
+}
+
+// Since is when accounting started
+func (l *Ledger) Since() time.Time {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.since
+}
+
+// Run accounts sessions until ctx is cancelled, then saves the totals
+func (l *Ledger) Run(ctx context.Context) {
+	events := l.manager.SubscribeEvents()
+	defer l.manager.UnsubscribeEvents(events)
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			l.flush(time.Now())
+			return
+		case <-ticker.C:
+			l.flush(time.Now())
+		case event := <-events:
+			l.handle(event)
+		}
+	}
+}
+
+// handle starts or finishes accounting a session
+func (l *Ledger) handle(event serial.PortEvent) {
+	switch event.Type {
+	case serial.PortEventOpened:
+		if slices.Contains(l.skip, event.ClientID) {
+			return
+		}
+		session := l.manager.GetSessionByID(event.SessionID)
+		if session == nil {
+			// Closed before the event was handled
+			return
+		}
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.open[event.SessionID] = &tracked{session: session, openedAt: session.Statistics.OpenedAt}
+		r := l.recordLocked(session.ClientID, session.PortName, event.Timestamp)
+		r.Sessions++
+		l.dirty = true
+
+	case serial.PortEventClosed:
+		l.mu.Lock()
+		t, ok := l.open[event.SessionID]
+		delete(l.open, event.SessionID)
+		if ok {
+			l.accountLocked(t, event.Timestamp)
+		}
+		l.mu.Unlock()
+		if ok {
+			l.save()
+		}
+	}
+}
+
+// recordLocked returns the record of a client and port, creating it (lock
+// held)
+func (l *Ledger) recordLocked(client, port string, at time.Time) *Record {
+	k := key{client, port}
+	r, ok := l.records[k]
+	if !ok {
+		r = &Record{ClientID: client, PortName: port, FirstUsed: at}
+		l.records[k] = r
+	}
+	r.LastUsed = at
+	return r
+}
+
+// accountLocked adds the usage of a session since it was last accounted
+// (lock held)
+func (l *Ledger) accountLocked(t *tracked, now time.Time) {
+	stats := &t.session.Statistics
+	current := accounted{
+		openTime:      now.Sub(t.openedAt),
+		bytesSent:     atomic.LoadUint64(&stats.BytesSent),
+		bytesReceived: atomic.LoadUint64(&stats.BytesReceived),
+	}
+	if current == t.accounted {
+		return
+	}
+
+	r := l.recordLocked(t.session.ClientID, t.session.PortName, now)
+	r.OpenTime += current.openTime - t.openTime
+	r.BytesSent += current.bytesSent - t.bytesSent
+	r.BytesReceived += current.bytesReceived - t.bytesReceived
+	t.accounted = current
+	l.dirty = true
+}
+
+// flush accounts open sessions and saves the totals
+func (l *Ledger) flush(now time.Time) {
+	l.mu.Lock()
+	for _, t := range l.open {
+		l.accountLocked(t, now)
+	}
+	l.mu.Unlock()
+	l.save()
+}
+
+// Report returns the totals, including open sessions, of one client and
+// port when set, by client then port
+func (l *Ledger) Report(client, port string) []Record {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, t := range l.open {
+		l.accountLocked(t, now)
+	}
+
+	result := make([]Record, 0, len(l.records))
+	for _, r := range l.records {
+		if (client == "" || r.ClientID == client) && (port == "" || r.PortName == port) {
+			result = append(result, *r)
+		}
+	}
+	sortRecords(result)
+	return result
+}
+
+// sortRecords orders records by client then port
+func sortRecords(records []Record) {
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].ClientID != records[j].ClientID {
+			return records[i].ClientID < records[j].ClientID
+		}
+		return records[i].PortName < records[j].PortName
+	})
+}
+
+// save writes the totals to disk atomically when they changed
+func (l *Ledger) save() {
+	l.mu.Lock()
+	if l.path == "" || !l.dirty {
+		l.mu.Unlock()
+		return
+	}
+	saved := ledgerFile{Since: l.since, Records: make([]Record, 0, len(l.records))}
+	for _, r := range l.records {
+		saved.Records = append(saved.Records, *r)
+	}
+	l.dirty = false
+	l.mu.Unlock()
+
+	if err := writeFile(l.path, saved); err != nil {
+		l.logger.Warn("failed to save usage", "path", l.path, "error", err)
+		l.mu.Lock()
+		l.dirty = true
+		l.mu.Unlock()
+	}
+}
+
+// writeFile replaces path with the JSON form of saved
+func writeFile(path string, saved ledgerFile) error {
+	sortRecords(saved.Records)
+	data, err := json.MarshalIndent(saved, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, ".usage-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
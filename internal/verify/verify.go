@@ -0,0 +1,252 @@
+// Package verify sends a command to a device and compares the response with
+// what was expected, reporting differences as a hex diff, for functional
+// tests on production lines.
+package verify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultTimeout bounds waiting for a response
+	DefaultTimeout = 2 * time.Second
+
+	// DefaultIdle ends a response without terminator or length after this
+	// much silence
+	DefaultIdle = 100 * time.Millisecond
+
+	// maxResponseSize caps a captured response
+	maxResponseSize = 64 * 1024
+
+	// rowSize is the number of bytes per row of a hex diff
+	rowSize = 16
+)
+
+// Options control how a response is captured. A response ends at the first
+// Terminator (included), after Length bytes, or after Idle without data.
+type Options struct {
+	Terminator []byte
+	Length     int
+	Timeout    time.Duration
+	Idle       time.Duration
+}
+
+// Exchange discards stale input, sends command and captures the response.
+// Reads on rw must return (0, nil) after a short timeout without data.
+func Exchange(ctx context.Context, rw io.ReadWriter, command []byte, opts Options) ([]byte, error) {
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	if opts.Idle <= 0 {
+		opts.Idle = DefaultIdle
+	}
+
+	buffer := make([]byte, 512)
+	for {
+		n, err := rw.Read(buffer)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			break
+		}
+	}
+
+	if len(command) > 0 {
+		if _, err := rw.Write(command); err != nil {
+			return nil, err
+		}
+	}
+
+	var response []byte
+	deadline := time.Now().Add(opts.Timeout)
+	lastData := time.Now()
+	for time.Now().Before(deadline) && ctx.Err() == nil {
+		n, err := rw.Read(buffer)
+		if err != nil {
+			return response, err
+		}
+		if n > 0 {
+			response = append(response, buffer[:n]...)
+			lastData = time.Now()
+		}
+
+		switch {
+		case len(opts.Terminator) > 0:
+			if i := bytes.Index(response, opts.Terminator); i >= 0 {
+				return response[:i+len(opts.Terminator)], nil
+			}
+		case opts.Length > 0:
+			if len(response) >= opts.Length {
+				return response[:opts.Length], nil
+			}
+		default:
+			if len(response) > 0 && time.Since(lastData) >= opts.Idle {
+				return response, nil
+			}
+		}
+		if len(response) >= maxResponseSize {
+			break
+		}
+	}
+
+	if len(response) == 0 {
+		return nil, fmt.Errorf("no response within %s", opts.Timeout)
+	}
+	return response, nil
+}
+
+// Check compares a response with the expected bytes, or matches it against
+// pattern when expected is nil. It returns whether the response passed and,
+// on failure, a readable explanation with a hex diff.
+func Check(response, expected []byte, pattern *regexp.Regexp) (bool, string) {
+	if expected == nil {
+		if pattern.Match(response) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("response does not match %q\n%s", pattern, HexDump(response))
+	}
+	if bytes.Equal(response, expected) {
+		return true, ""
+	}
+	return false, HexDiff(expected, response)
+}
+
+// Step is a command of a sequence and the response it must get, compared
+// as in Check. A step without Expected or Pattern only sends its command.
+type Step struct {
+	Command  []byte
+	Expected []byte
+	Pattern  *regexp.Regexp
+	Options  Options
+}
+
+// RunSequence runs steps in order, stopping at the first that fails, and
+// returns the responses received. Reads on rw must return (0, nil) after a
+// short timeout without data.
+func RunSequence(ctx context.Context, rw io.ReadWriter, steps []Step) ([][]byte, error) {
+	responses := make([][]byte, 0, len(steps))
+	for i, step := range steps {
+		if step.Expected == nil && step.Pattern == nil {
+			if _, err := rw.Write(step.Command); err != nil {
+				return responses, fmt.Errorf("step %d: %w", i+1, err)
+			}
+			responses = append(responses, nil)
+			continue
+		}
+
+		response, err := Exchange(ctx, rw, step.Command, step.Options)
+		responses = append(responses, response)
+		if err != nil {
+			return responses, fmt.Errorf("step %d: %w", i+1, err)
+		}
+		if passed, diff := Check(response, step.Expected, step.Pattern); !passed {
+			return responses, fmt.Errorf("step %d: response differs from expected\n%s", i+1, diff)
+		}
+	}
+	return responses, nil
+}
+
+// HexDiff compares two byte strings position by position in rows of 16
+// bytes. Differing rows are shown as "-" (expected) and "+" (received) with
+// the differing bytes marked below; equal rows are shown once.
+func HexDiff(expected, received []byte) string {
+	var b strings.Builder
+
+	first := 0
+	for first < len(expected) && first < len(received) && expected[first] == received[first] {
+		first++
+	}
+	fmt.Fprintf(&b, "first difference at offset 0x%04x (expected %d bytes, received %d)\n", first, len(expected), len(received))
+
+	size := max(len(expected), len(received))
+	for offset := 0; offset < size; offset += rowSize {
+		want := row(expected, offset)
+		got := row(received, offset)
+		if bytes.Equal(want, got) {
+			b.WriteString("  " + formatRow(offset, want) + "\n")
+			continue
+		}
+
+		if want != nil {
+			b.WriteString("- " + formatRow(offset, want) + "\n")
+		}
+		if got != nil {
+			b.WriteString("+ " + formatRow(offset, got) + "\n")
+		}
+		b.WriteString("  " + markRow(want, got) + "\n")
+	}
+	return b.String()
+}
+
+// HexDump formats data in rows of 16 bytes
+func HexDump(data []byte) string {
+	var b strings.Builder
+	for offset := 0; offset < len(data); offset += rowSize {
+		b.WriteString("  " + formatRow(offset, row(data, offset)) + "\n")
+	}
+	return b.String()
+}
+
+// row returns the bytes of data in the row starting at offset, or nil past
+// the end
+func row(data []byte, offset int) []byte {
+	if offset >= len(data) {
+		return nil
+	}
+	return data[offset:min(offset+rowSize, len(data))]
+}
+
+// formatRow formats a row as offset, hex bytes and printable characters
+func formatRow(offset int, data []byte) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%04x  ", offset)
+	for i := 0; i < rowSize; i++ {
+		if i == rowSize/2 {
+			b.WriteByte(' ')
+		}
+		if i < len(data) {
+			fmt.Fprintf(&b, "%02x ", data[i])
+		} else {
+			b.WriteString("   ")
+		}
+	}
+	b.WriteString(" |")
+	for _, c := range data {
+		if c >= 0x20 && c < 0x7f {
+			b.WriteByte(c)
+		} else {
+			b.WriteByte('.')
+		}
+	}
+	b.WriteByte('|')
+	return b.String()
+}
+
+// markRow puts ^^ under the bytes that differ between two rows, aligned
+// with formatRow
+func markRow(want, got []byte) string {
+	var b strings.Builder
+	b.WriteString("      ")
+	last := 0
+	for i := 0; i < rowSize; i++ {
+		if i == rowSize/2 {
+			b.WriteByte(' ')
+		}
+		inWant, inGot := i < len(want), i < len(got)
+		if (inWant || inGot) && (inWant != inGot || want[i] != got[i]) {
+			b.WriteString("^^")
+			last = b.Len()
+			b.WriteByte(' ')
+		} else {
+			b.WriteString("   ")
+		}
+	}
+	return b.String()[:last]
+}
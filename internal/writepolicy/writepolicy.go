@@ -0,0 +1,276 @@
+// Package writepolicy decides which payloads clients may write to a port:
+// allow and deny patterns, and a two-person rule that holds flagged
+// commands until a second operator approves them.
+package writepolicy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/google/uuid"
+)
+
+// DefaultApprovalTimeout is how long a held write waits for approval
+const DefaultApprovalTimeout = 5 * time.Minute
+
+// Verdict is what happens to a payload
+type Verdict int
+
+// Verdicts
+const (
+	// Allowed payloads are written
+	Allowed Verdict = iota
+	// Denied payloads are rejected
+	Denied
+	// NeedsApproval payloads are held until a second operator approves them
+	NeedsApproval
+)
+
+// Errors returned by Approve and Reject
+var (
+	ErrNotFound     = errors.New("pending write not found")
+	ErrSelfApproval = errors.New("a write cannot be approved by the client that requested it")
+	ErrNotApprover  = errors.New("not an approver for this port")
+)
+
+// Errors returned by Admit
+var (
+	ErrDenied        = errors.New("write denied by policy")
+	ErrNeedsApproval = errors.New("write needs approval")
+)
+
+// Policy restricts writes to a port. Patterns are matched against the
+// payload with trailing CR and LF removed, so "^RESET$" matches "RESET\r\n".
+type Policy struct {
+	Port string
+	// Allow, when set, rejects payloads matching none of its patterns
+	Allow []*regexp.Regexp
+	// Deny rejects payloads matching any of its patterns
+	Deny []*regexp.Regexp
+	// RequireApproval holds matching payloads for a second operator
+	RequireApproval []*regexp.Regexp
+	// Approvers are the identities allowed to approve; nobody may when
+	// empty
+	Approvers []string
+	// ApprovalTimeout discards held writes not approved in time
+	ApprovalTimeout time.Duration
+}
+
+// Check decides what happens to a payload and explains why
+func (p *Policy) Check(data []byte) (Verdict, string) {
+	payload := bytes.TrimRight(data, "\r\n")
+	for _, re := range p.Deny {
+		if re.Match(payload) {
+			return Denied, fmt.Sprintf("payload matches deny pattern %q", re)
+		}
+	}
+	if len(p.Allow) > 0 && !slices.ContainsFunc(p.Allow, func(re *regexp.Regexp) bool { return re.Match(payload) }) {
+		return Denied, "payload matches no allow pattern"
+	}
+	for _, re := range p.RequireApproval {
+		if re.Match(payload) {
+			return NeedsApproval, fmt.Sprintf("payload matches approval pattern %q", re)
+		}
+	}
+	return Allowed, ""
+}
+
+// Pending is a write held for approval
+type Pending struct {
+	ID        string
+	PortName  string
+	SessionID string
+	Data      []byte
+	// Reason is the pattern that flagged the write
+	Reason      string
+	RequestedBy string
+	RequestedAt time.Time
+	ExpiresAt   time.Time
+}
+
+// Guard applies the policies of every port and keeps held writes
+type Guard struct {
+	policies map[string]*Policy
+	logger   *log.Logger
+
+	mu      sync.Mutex
+	pending map[string]Pending
+	// approved holds approved writes until Admit lets them through
+	approved map[string]Pending
+}
+
+// NewGuard creates a guard. Ports without a policy accept every payload.
+func NewGuard(policies []Policy, logger *log.Logger) *Guard {
+	g := &Guard{
+		policies: make(map[string]*Policy, len(policies)),
+		logger:   logger,
+		pending:  make(map[string]Pending),
+		approved: make(map[string]Pending),
+	}
+	for i := range policies {
+		g.policies[policies[i].Port] = &policies[i]
+	}
+	return g
+}
+
+// Covers reports whether a port has a policy
+func (g *Guard) Covers(portName string) bool {
+	_, ok := g.policies[portName]
+	return ok
+}
+
+// Check decides what happens to a payload for a port. Denials are logged.
+func (g *Guard) Check(portName string, data []byte, client string) (Verdict, string) {
+	policy, ok := g.policies[portName]
+	if !ok {
+		return Allowed, ""
+	}
+	verdict, reason := policy.Check(data)
+	if verdict == Denied {
+		g.logger.Warn("write denied by policy", "port", portName, "client", client, "reason", reason)
+	}
+	return verdict, reason
+}
+
+// Admit is the check every payload passes on its way to a port, whatever
+// sent it. Payloads needing approval are refused unless approved for the
+// session; each approval lets its payload through once.
+func (g *Guard) Admit(portName, sessionID string, data []byte) error {
+	policy, ok := g.policies[portName]
+	if !ok {
+		return nil
+	}
+	switch verdict, reason := policy.Check(data); verdict {
+	case Denied:
+		g.logger.Warn("write denied by policy", "port", portName, "session", sessionID, "reason", reason)
+		return fmt.Errorf("%w: %s", ErrDenied, reason)
+	case NeedsApproval:
+		if !g.release(sessionID, data) {
+			return fmt.Errorf("%w: %s", ErrNeedsApproval, reason)
+		}
+	}
+	return nil
+}
+
+// release consumes the approval of a payload for a session
+func (g *Guard) release(sessionID string, data []byte) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.expireLocked(time.Now())
+
+	for id, p := range g.approved {
+		if p.SessionID == sessionID && bytes.Equal(p.Data, data) {
+			delete(g.approved, id)
+			return true
+		}
+	}
+	return false
+}
+
+// Hold keeps a flagged write until it is approved, rejected or expires
+func (g *Guard) Hold(portName, sessionID string, data []byte, reason, requestedBy string) Pending {
+	timeout := DefaultApprovalTimeout
+	if policy, ok := g.policies[portName]; ok && policy.ApprovalTimeout > 0 {
+		timeout = policy.ApprovalTimeout
+	}
+	now := time.Now()
+	p := Pending{
+		ID:          uuid.New().String(),
+		PortName:    portName,
+		SessionID:   sessionID,
+		Data:        slices.Clone(data),
+		Reason:      reason,
+		RequestedBy: requestedBy,
+		RequestedAt: now,
+		ExpiresAt:   now.Add(timeout),
+	}
+
+	g.mu.Lock()
+	g.expireLocked(now)
+	g.pending[p.ID] = p
+	g.mu.Unlock()
+
+	g.logger.Warn("write held for approval", "port", portName, "approval_id", p.ID, "client", requestedBy, "reason", reason)
+	return p
+}
+
+// List returns the held writes, oldest first
+func (g *Guard) List() []Pending {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.expireLocked(time.Now())
+
+	result := make([]Pending, 0, len(g.pending))
+	for _, p := range g.pending {
+		result = append(result, p)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].RequestedAt.Before(result[j].RequestedAt) })
+	return result
+}
+
+// Approve releases a held write for transmission. The approver must differ
+// from the requester and be one of the port's approvers.
+func (g *Guard) Approve(id, approver string) (Pending, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.expireLocked(time.Now())
+
+	p, ok := g.pending[id]
+	if !ok {
+		return Pending{}, ErrNotFound
+	}
+	if approver == p.RequestedBy {
+		return Pending{}, ErrSelfApproval
+	}
+	if policy, ok := g.policies[p.PortName]; !ok || !slices.Contains(policy.Approvers, approver) {
+		return Pending{}, ErrNotApprover
+	}
+	delete(g.pending, id)
+	g.approved[id] = p
+
+	g.logger.Warn("held write approved", "port", p.PortName, "approval_id", id, "requested_by", p.RequestedBy, "approved_by", approver)
+	return p, nil
+}
+
+// Reject discards a held write. The requester and the port's approvers may
+// reject it.
+func (g *Guard) Reject(id, by string) (Pending, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.expireLocked(time.Now())
+
+	p, ok := g.pending[id]
+	if !ok {
+		return Pending{}, ErrNotFound
+	}
+	if policy, ok := g.policies[p.PortName]; by != p.RequestedBy && (!ok || !slices.Contains(policy.Approvers, by)) {
+		return Pending{}, ErrNotApprover
+	}
+	delete(g.pending, id)
+
+	g.logger.Info("held write rejected", "port", p.PortName, "approval_id", id, "requested_by", p.RequestedBy, "rejected_by", by)
+	return p, nil
+}
+
+// expireLocked drops held and unsent approved writes past their deadline
+// (lock held)
+func (g *Guard) expireLocked(now time.Time) {
+	for id, p := range g.pending {
+		if now.After(p.ExpiresAt) {
+			delete(g.pending, id)
+			g.logger.Info("held write expired", "port", p.PortName, "approval_id", id, "requested_by", p.RequestedBy)
+		}
+	}
+	for id, p := range g.approved {
+		if now.After(p.ExpiresAt) {
+			delete(g.approved, id)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package wsframe
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// errMsgpackTruncated reports a length running past the end of a frame
+var errMsgpackTruncated = errors.New("msgpack: declared length exceeds data")
+
+// checkMsgpackLengths walks the headers of a MessagePack value and fails
+// when a declared length exceeds the data left. The decoder allocates by
+// declared length, so without this an 11-byte frame claiming a huge map or
+// binary makes it allocate hundreds of megabytes before finding the data
+// missing.
+func checkMsgpackLengths(data []byte) error {
+	// items is the number of values still to read; each takes at least a
+	// byte
+	for items := 1; items > 0; items-- {
+		if items > len(data) {
+			return errMsgpackTruncated
+		}
+		code := data[0]
+		data = data[1:]
+
+		var payload, children int
+		switch {
+		case code <= 0x7f, code >= 0xe0, code == 0xc0, code == 0xc2, code == 0xc3:
+			// fixint, nil, false, true
+		case code <= 0x8f:
+			children = 2 * int(code&0x0f)
+		case code <= 0x9f:
+			children = int(code & 0x0f)
+		case code <= 0xbf:
+			payload = int(code & 0x1f)
+		default:
+			size, ok := msgpackSizes[code]
+			if !ok {
+				return fmt.Errorf("msgpack: invalid code %#x", code)
+			}
+			if size.header > len(data) {
+				return errMsgpackTruncated
+			}
+			n := size.fixed
+			switch size.header {
+			case 1:
+				n = int(data[0])
+			case 2:
+				n = int(binary.BigEndian.Uint16(data))
+			case 4:
+				n = int(binary.BigEndian.Uint32(data))
+			}
+			data = data[size.header:]
+			if n < 0 || n > len(data) {
+				return errMsgpackTruncated
+			}
+			switch size.kind {
+			case msgpackBytes:
+				payload = n
+			case msgpackExt:
+				payload = n + 1
+			case msgpackArray:
+				children = n
+			case msgpackMap:
+				children = 2 * n
+			default:
+				payload = n
+			}
+		}
+
+		if payload > len(data) {
+			return errMsgpackTruncated
+		}
+		data = data[payload:]
+		items += children
+	}
+	return nil
+}
+
+// msgpackKind is what the length of a MessagePack header counts
+type msgpackKind int
+
+const (
+	msgpackFixed msgpackKind = iota
+	msgpackBytes
+	msgpackExt
+	msgpackArray
+	msgpackMap
+)
+
+// msgpackSize describes a MessagePack code: header bytes holding a length
+// of kind, or a fixed payload when header is 0
+type msgpackSize struct {
+	header int
+	fixed  int
+	kind   msgpackKind
+}
+
+// msgpackSizes are the codes from 0xc4 up; 0xc1 is never used
+var msgpackSizes = map[byte]msgpackSize{
+	0xc4: {header: 1, kind: msgpackBytes}, // bin 8
+	0xc5: {header: 2, kind: msgpackBytes}, // bin 16
+	0xc6: {header: 4, kind: msgpackBytes}, // bin 32
+	0xc7: {header: 1, kind: msgpackExt},   // ext 8
+	0xc8: {header: 2, kind: msgpackExt},   // ext 16
+	0xc9: {header: 4, kind: msgpackExt},   // ext 32
+	0xca: {fixed: 4},                      // float 32
+	0xcb: {fixed: 8},                      // float 64
+	0xcc: {fixed: 1},                      // uint 8
+	0xcd: {fixed: 2},                      // uint 16
+	0xce: {fixed: 4},                      // uint 32
+	0xcf: {fixed: 8},                      // uint 64
+	0xd0: {fixed: 1},                      // int 8
+	0xd1: {fixed: 2},                      // int 16
+	0xd2: {fixed: 4},                      // int 32
+	0xd3: {fixed: 8},                      // int 64
+	0xd4: {fixed: 2},                      // fixext 1
+	0xd5: {fixed: 3},                      // fixext 2
+	0xd6: {fixed: 5},                      // fixext 4
+	0xd7: {fixed: 9},                      // fixext 8
+	0xd8: {fixed: 17},                     // fixext 16
+	0xd9: {header: 1, kind: msgpackBytes}, // str 8
+	0xda: {header: 2, kind: msgpackBytes}, // str 16
+	0xdb: {header: 4, kind: msgpackBytes}, // str 32
+	0xdc: {header: 2, kind: msgpackArray}, // array 16
+	0xdd: {header: 4, kind: msgpackArray}, // array 32
+	0xde: {header: 2, kind: msgpackMap},   // map 16
+	0xdf: {header: 4, kind: msgpackMap},   // map 32
+}
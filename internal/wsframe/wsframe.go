@@ -0,0 +1,122 @@
+// Package wsframe defines the frames exchanged with WebSocket gateway clients
+// and the wire encodings (JSON, CBOR, MessagePack) they can be sent in.
+package wsframe
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Frame types
+const (
+	TypeOpen    = "open"
+	TypeClose   = "close"
+	TypeRead    = "read"
+	TypeWrite   = "write"
+	TypeStream  = "stream"
+	TypeMonitor = "monitor"
+	TypeUpload  = "upload"
+	TypeData    = "data"
+	TypeResult  = "result"
+	TypeError   = "error"
+)
+
+// Frame is a single gateway message. Requests carry an ID that is echoed in
+// the matching result so clients can pipeline operations.
+type Frame struct {
+	Type      string            `json:"type" cbor:"type" msgpack:"type"`
+	ID        string            `json:"id,omitempty" cbor:"id,omitempty" msgpack:"id,omitempty"`
+	Port      string            `json:"port,omitempty" cbor:"port,omitempty" msgpack:"port,omitempty"`
+	SessionID string            `json:"session_id,omitempty" cbor:"session_id,omitempty" msgpack:"session_id,omitempty"`
+	Data      []byte            `json:"data,omitempty" cbor:"data,omitempty" msgpack:"data,omitempty"`
+	Timestamp int64             `json:"timestamp,omitempty" cbor:"timestamp,omitempty" msgpack:"timestamp,omitempty"`
+	Sequence  uint32            `json:"sequence,omitempty" cbor:"sequence,omitempty" msgpack:"sequence,omitempty"`
+	Options   map[string]string `json:"options,omitempty" cbor:"options,omitempty" msgpack:"options,omitempty"`
+	Error     string            `json:"error,omitempty" cbor:"error,omitempty" msgpack:"error,omitempty"`
+}
+
+// Codec encodes frames for the wire
+type Codec interface {
+	// Name is the encoding name used in configuration and negotiation
+	Name() string
+	// Binary reports whether frames go out as binary rather than text messages
+	Binary() bool
+	Marshal(f *Frame) ([]byte, error)
+	Unmarshal(data []byte, f *Frame) error
+}
+
+// Encoding names
+const (
+	EncodingJSON        = "json"
+	EncodingCBOR        = "cbor"
+	EncodingMessagePack = "msgpack"
+)
+
+// SubprotocolPrefix prefixes encoding names in Sec-WebSocket-Protocol, e.g.
+// "seriallink.cbor"
+const SubprotocolPrefix = "seriallink."
+
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string                          { return EncodingJSON }
+func (jsonCodec) Binary() bool                          { return false }
+func (jsonCodec) Marshal(f *Frame) ([]byte, error)      { return json.Marshal(f) }
+func (jsonCodec) Unmarshal(data []byte, f *Frame) error { return json.Unmarshal(data, f) }
+
+type cborCodec struct{}
+
+func (cborCodec) Name() string                          { return EncodingCBOR }
+func (cborCodec) Binary() bool                          { return true }
+func (cborCodec) Marshal(f *Frame) ([]byte, error)      { return cbor.Marshal(f) }
+func (cborCodec) Unmarshal(data []byte, f *Frame) error { return cbor.Unmarshal(data, f) }
+
+type msgpackCodec struct{}
+
+func (msgpackCodec) Name() string                     { return EncodingMessagePack }
+func (msgpackCodec) Binary() bool                     { return true }
+func (msgpackCodec) Marshal(f *Frame) ([]byte, error) { return msgpack.Marshal(f) }
+func (msgpackCodec) Unmarshal(data []byte, f *Frame) error {
+	if err := checkMsgpackLengths(data); err != nil {
+		return err
+	}
+	return msgpack.Unmarshal(data, f)
+}
+
+// Lookup returns the codec for an encoding name
+func Lookup(name string) (Codec, error) {
+	switch strings.ToLower(name) {
+	case "", EncodingJSON:
+		return jsonCodec{}, nil
+	case EncodingCBOR:
+		return cborCodec{}, nil
+	case EncodingMessagePack, "messagepack":
+		return msgpackCodec{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported frame encoding %q (json, cbor, msgpack)", name)
+	}
+}
+
+// Negotiate picks the first requested encoding that is allowed. Requested
+// values may be bare names or "seriallink.<name>" subprotocols. JSON is used
+// when nothing matches and is always allowed.
+func Negotiate(requested, allowed []string) Codec {
+	allowedSet := map[string]bool{EncodingJSON: true}
+	for _, name := range allowed {
+		if codec, err := Lookup(name); err == nil {
+			allowedSet[codec.Name()] = true
+		}
+	}
+
+	for _, name := range requested {
+		codec, err := Lookup(strings.TrimPrefix(strings.TrimSpace(name), SubprotocolPrefix))
+		if err == nil && allowedSet[codec.Name()] {
+			return codec
+		}
+	}
+
+	return jsonCodec{}
+}
@@ -0,0 +1,69 @@
+package wsframe
+
+import (
+	"bytes"
+	"maps"
+	"testing"
+)
+
+// fuzzCodec checks that whatever a codec decodes, it encodes again and
+// decodes to the same frame
+func fuzzCodec(f *testing.F, name string, seeds ...[]byte) {
+	codec, err := Lookup(name)
+	if err != nil {
+		f.Fatalf("codec %s: %v", name, err)
+	}
+	for _, frame := range []*Frame{
+		{Type: TypeOpen, ID: "1", Port: "/dev/ttyUSB0", Options: map[string]string{"baud_rate": "115200", "parity": "none"}},
+		{Type: TypeWrite, ID: "2", Port: "COM3", SessionID: "COM3-7f3a", Data: []byte("AT\r\n\x00\xff")},
+		{Type: TypeData, Port: "COM3", Data: []byte{0x01, 0x03, 0x00, 0x00}, Timestamp: 1700000000000000000, Sequence: 42},
+		{Type: TypeError, ID: "3", Error: "port not open"},
+	} {
+		data, err := codec.Marshal(frame)
+		if err != nil {
+			f.Fatalf("marshal seed: %v", err)
+		}
+		f.Add(data)
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var frame Frame
+		if err := codec.Unmarshal(data, &frame); err != nil {
+			return
+		}
+		encoded, err := codec.Marshal(&frame)
+		if err != nil {
+			t.Fatalf("marshal of decoded frame %+v: %v", frame, err)
+		}
+		var again Frame
+		if err := codec.Unmarshal(encoded, &again); err != nil {
+			t.Fatalf("unmarshal of %q: %v", encoded, err)
+		}
+		if !sameFrame(frame, again) {
+			t.Fatalf("round trip changed %+v to %+v", frame, again)
+		}
+	})
+}
+
+// sameFrame compares frames, taking empty and missing data and options as
+// equal since both are omitted on the wire
+func sameFrame(a, b Frame) bool {
+	return a.Type == b.Type && a.ID == b.ID && a.Port == b.Port && a.SessionID == b.SessionID &&
+		bytes.Equal(a.Data, b.Data) && a.Timestamp == b.Timestamp && a.Sequence == b.Sequence &&
+		maps.Equal(a.Options, b.Options) && a.Error == b.Error
+}
+
+func FuzzJSONCodec(f *testing.F) {
+	fuzzCodec(f, EncodingJSON, []byte(`{"type":"open","options":{"a":"\ud800"}}`), []byte(`{"data":"!!"}`), []byte(`{"sequence":-1}`))
+}
+
+func FuzzCBORCodec(f *testing.F) {
+	fuzzCodec(f, EncodingCBOR, []byte{0xa1, 0x64, 't', 'y', 'p', 'e', 0x7f}, []byte{0x9f, 0xff})
+}
+
+func FuzzMessagePackCodec(f *testing.F) {
+	fuzzCodec(f, EncodingMessagePack, []byte{0x81, 0xa4, 'd', 'a', 't', 'a', 0xc6, 0xff, 0xff, 0xff, 0xff}, []byte{0xdf, 0xff, 0xff, 0xff, 0xff})
+}